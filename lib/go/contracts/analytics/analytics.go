@@ -0,0 +1,275 @@
+// Package analytics aggregates NFTStorefrontV2 sales from ListingAvailable
+// and ListingCompleted events: the volume, median and last sale price of
+// each NFT type and payment token over time windows, and the floor price of
+// their open listings.
+//
+// An Aggregator is fed blocks of events, either live from an Access API or
+// replayed from recorded fixtures, with flowclient.Feed or AddBlock.
+package analytics
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/blocks"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+)
+
+// Key identifies a market: an NFT type sold for a payment token.
+type Key struct {
+	// NFTType and PaymentType are type identifiers, for example
+	// A.0b2a3299cc857e29.TopShot.NFT and A.1654653399040a61.FlowToken.Vault.
+	NFTType     string
+	PaymentType string
+}
+
+func (k Key) String() string {
+	return k.NFTType + " for " + k.PaymentType
+}
+
+// Sale is a purchased listing.
+type Sale struct {
+	Key
+	Time              time.Time
+	Height            uint64
+	TransactionID     flowclient.Identifier
+	ListingResourceID uint64
+	NFTID             uint64
+	Price             cadence.UFix64
+	CommissionAmount  cadence.UFix64
+}
+
+// Aggregator records the sales and open listings of the events it is fed.
+type Aggregator struct {
+	availableType string
+	completedType string
+
+	blocks.Cursor
+	sales []Sale
+	open  map[uint64]nftstorefrontv2.ListingAvailable
+}
+
+var _ flowclient.Consumer = (*Aggregator)(nil)
+
+// New returns an aggregator of the events of the NFTStorefrontV2 contract
+// deployed to the given address.
+func New(storefrontContract cadence.Address) *Aggregator {
+	return &Aggregator{
+		availableType: nftstorefrontv2.ListingAvailableEventType(storefrontContract),
+		completedType: nftstorefrontv2.ListingCompletedEventType(storefrontContract),
+		open:          map[uint64]nftstorefrontv2.ListingAvailable{},
+	}
+}
+
+// EventTypes returns the types of the events the aggregator needs.
+func (a *Aggregator) EventTypes() []string {
+	return []string{a.availableType, a.completedType}
+}
+
+// AddBlock records the sales and listings of a block. Blocks must be added
+// in order of height, with their events in the order they were emitted.
+func (a *Aggregator) AddBlock(_ context.Context, block flowclient.BlockEvents) error {
+	if err := blocks.Advance(&a.Cursor, block); err != nil {
+		return err
+	}
+
+	for _, event := range block.Events {
+		switch event.Type {
+		case a.availableType:
+			available, err := nftstorefrontv2.DecodeListingAvailable(event.Value)
+			if err != nil {
+				return fmt.Errorf("block %d: %w", block.Height, err)
+			}
+			a.open[available.ListingResourceID] = available
+
+		case a.completedType:
+			completed, err := nftstorefrontv2.DecodeListingCompleted(event.Value)
+			if err != nil {
+				return fmt.Errorf("block %d: %w", block.Height, err)
+			}
+			delete(a.open, completed.ListingResourceID)
+			if !completed.Purchased {
+				continue
+			}
+			a.sales = append(a.sales, Sale{
+				Key:               Key{NFTType: cadenceconv.TypeID(completed.NFTType), PaymentType: cadenceconv.TypeID(completed.SalePaymentVaultType)},
+				Time:              block.BlockTimestamp,
+				Height:            block.Height,
+				TransactionID:     event.TransactionID,
+				ListingResourceID: completed.ListingResourceID,
+				NFTID:             completed.NFTID,
+				Price:             completed.SalePrice,
+				CommissionAmount:  completed.CommissionAmount,
+			})
+		}
+	}
+
+	return nil
+}
+
+// Sales returns the sales recorded so far, in the order they happened.
+func (a *Aggregator) Sales() []Sale {
+	return append([]Sale(nil), a.sales...)
+}
+
+// Stats are the statistics of a market over a time window.
+type Stats struct {
+	Key
+	// Start and End bound the window: sales from Start, inclusive, to End,
+	// exclusive, are counted.
+	Start time.Time
+	End   time.Time
+
+	Sales int
+	// Volume is the sum of the sale prices, and Commission the sum of the
+	// commissions.
+	Volume     cadence.UFix64
+	Commission cadence.UFix64
+	// Median and Last are the median and the last sale prices, or nil if
+	// there was no sale. The median of an even number of sales is the mean
+	// of the middle two, rounded down.
+	Median *cadence.UFix64
+	Last   *cadence.UFix64
+	// LastSale is the time of the last sale.
+	LastSale time.Time
+
+	// Listings is the number of open listings that have not expired at End,
+	// and Floor their lowest price, or nil if there are none. Only Report
+	// sets them.
+	Listings int
+	Floor    *cadence.UFix64
+}
+
+func newStats(key Key, start, end time.Time, sales []Sale) (Stats, error) {
+	stats := Stats{Key: key, Start: start, End: end, Sales: len(sales)}
+	if len(sales) == 0 {
+		return stats, nil
+	}
+
+	prices := make([]cadence.UFix64, len(sales))
+	for i, sale := range sales {
+		volume, overflow := bits.Add64(uint64(stats.Volume), uint64(sale.Price), 0)
+		if overflow != 0 {
+			return stats, fmt.Errorf("volume of %s overflows UFix64", key)
+		}
+		commission, overflow := bits.Add64(uint64(stats.Commission), uint64(sale.CommissionAmount), 0)
+		if overflow != 0 {
+			return stats, fmt.Errorf("commission of %s overflows UFix64", key)
+		}
+		stats.Volume, stats.Commission = cadence.UFix64(volume), cadence.UFix64(commission)
+		prices[i] = sale.Price
+	}
+
+	last := sales[len(sales)-1]
+	stats.Last = &last.Price
+	stats.LastSale = last.Time
+
+	sort.Slice(prices, func(i, j int) bool { return prices[i] < prices[j] })
+	median := prices[len(prices)/2]
+	if len(prices)%2 == 0 {
+		a, b := prices[len(prices)/2-1], median
+		median = a/2 + b/2 + (a%2+b%2)/2
+	}
+	stats.Median = &median
+
+	return stats, nil
+}
+
+// salesBetween returns the sales from start, inclusive, to end, exclusive,
+// grouped by market.
+func (a *Aggregator) salesBetween(start, end time.Time) map[Key][]Sale {
+	sales := map[Key][]Sale{}
+	for _, sale := range a.sales {
+		if sale.Time.Before(start) || !sale.Time.Before(end) {
+			continue
+		}
+		sales[sale.Key] = append(sales[sale.Key], sale)
+	}
+	return sales
+}
+
+// Report returns the statistics of each market with sales in the window or
+// open listings, ordered by decreasing volume.
+//
+// The floor price is computed from the listings open after the last block
+// added, so it only accounts for the listings created and completed in the
+// blocks fed to the aggregator.
+func (a *Aggregator) Report(start, end time.Time) ([]Stats, error) {
+	sales := a.salesBetween(start, end)
+
+	listings := map[Key][]cadence.UFix64{}
+	for _, listing := range a.open {
+		if listing.Expiry <= uint64(end.Unix()) {
+			continue
+		}
+		key := Key{NFTType: cadenceconv.TypeID(listing.NFTType), PaymentType: cadenceconv.TypeID(listing.SalePaymentVaultType)}
+		listings[key] = append(listings[key], listing.SalePrice)
+	}
+
+	keys := map[Key]bool{}
+	for key := range sales {
+		keys[key] = true
+	}
+	for key := range listings {
+		keys[key] = true
+	}
+
+	report := make([]Stats, 0, len(keys))
+	for key := range keys {
+		stats, err := newStats(key, start, end, sales[key])
+		if err != nil {
+			return nil, err
+		}
+
+		stats.Listings = len(listings[key])
+		for _, price := range listings[key] {
+			if stats.Floor == nil || price < *stats.Floor {
+				price := price
+				stats.Floor = &price
+			}
+		}
+
+		report = append(report, stats)
+	}
+
+	sort.Slice(report, func(i, j int) bool {
+		if report[i].Volume != report[j].Volume {
+			return report[i].Volume > report[j].Volume
+		}
+		if report[i].NFTType != report[j].NFTType {
+			return report[i].NFTType < report[j].NFTType
+		}
+		return report[i].PaymentType < report[j].PaymentType
+	})
+	return report, nil
+}
+
+// History returns the statistics of a market in consecutive windows of the
+// given length, from start to end: its price history.
+func (a *Aggregator) History(key Key, start, end time.Time, interval time.Duration) ([]Stats, error) {
+	if interval <= 0 {
+		return nil, fmt.Errorf("interval must be positive, got %s", interval)
+	}
+
+	var history []Stats
+	for windowStart := start; windowStart.Before(end); windowStart = windowStart.Add(interval) {
+		windowEnd := windowStart.Add(interval)
+		if windowEnd.After(end) {
+			windowEnd = end
+		}
+
+		stats, err := newStats(key, windowStart, windowEnd, a.salesBetween(windowStart, windowEnd)[key])
+		if err != nil {
+			return nil, err
+		}
+		history = append(history, stats)
+	}
+	return history, nil
+}
@@ -0,0 +1,205 @@
+package analytics_test
+
+import (
+	"context"
+	"math"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/analytics"
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+)
+
+var (
+	storefrontContract = testfixtures.StorefrontContract
+	ufix64             = testfixtures.UFix64
+	seller             = testfixtures.Seller
+
+	start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	exampleNFT = testfixtures.ExampleNFT
+	otherNFT   = testfixtures.ResourceType("OtherNFT", "OtherNFT.NFT")
+	flowToken  = testfixtures.FlowToken
+
+	market = analytics.Key{
+		NFTType:     "A.f8d6e0586b0a20c7.ExampleNFT.NFT",
+		PaymentType: "A.f8d6e0586b0a20c7.FlowToken.Vault",
+	}
+)
+
+type listing struct {
+	id     uint64
+	nft    cadence.Type
+	price  string
+	expiry time.Time
+}
+
+func available(t *testing.T, l listing) flowclient.Event {
+	t.Helper()
+
+	return flowclient.Event{
+		Type: nftstorefrontv2.ListingAvailableEventType(storefrontContract),
+		Value: nftstorefrontv2.ListingAvailable{
+			StorefrontAddress:    seller,
+			ListingResourceID:    l.id,
+			NFTType:              l.nft,
+			NFTID:                l.id * 10,
+			SalePaymentVaultType: flowToken,
+			SalePrice:            ufix64(t, l.price),
+			Expiry:               uint64(l.expiry.Unix()),
+		}.Encode(storefrontContract),
+	}
+}
+
+func completed(t *testing.T, l listing, purchased bool, commission string) flowclient.Event {
+	t.Helper()
+
+	return flowclient.Event{
+		Type: nftstorefrontv2.ListingCompletedEventType(storefrontContract),
+		Value: nftstorefrontv2.ListingCompleted{
+			ListingResourceID:    l.id,
+			Purchased:            purchased,
+			NFTType:              l.nft,
+			NFTID:                l.id * 10,
+			SalePaymentVaultType: flowToken,
+			SalePrice:            ufix64(t, l.price),
+			CommissionAmount:     ufix64(t, commission),
+			Expiry:               uint64(l.expiry.Unix()),
+		}.Encode(storefrontContract),
+	}
+}
+
+// recorded returns a fake replaying these blocks:
+//
+//	10 at 0h: listings 1, 2 and 3 of ExampleNFT and 4 of OtherNFT
+//	11 at 1h: listing 1 purchased for 10.0
+//	12 at 2h: listing 2 purchased for 12.00000001, listing 4 removed and
+//	          listing 5 of ExampleNFT
+func recorded(t *testing.T) *flowclient.Fake {
+	t.Helper()
+
+	later := start.Add(24 * time.Hour)
+	l1 := listing{1, exampleNFT, "10.0", later}
+	l2 := listing{2, exampleNFT, "12.00000001", later}
+	l3 := listing{3, exampleNFT, "8.0", start.Add(90 * time.Minute)}
+	l4 := listing{4, otherNFT, "5.0", later}
+	l5 := listing{5, exampleNFT, "15.0", later}
+
+	var fixtures flowclient.Fixtures
+	for _, block := range []flowclient.BlockEvents{
+		{
+			Height:         10,
+			BlockTimestamp: start,
+			Events:         []flowclient.Event{available(t, l1), available(t, l2), available(t, l3), available(t, l4)},
+		},
+		{
+			Height:         11,
+			BlockTimestamp: start.Add(time.Hour),
+			Events:         []flowclient.Event{completed(t, l1, true, "1.0")},
+		},
+		{
+			Height:         12,
+			BlockTimestamp: start.Add(2 * time.Hour),
+			Events:         []flowclient.Event{completed(t, l2, true, "0.0"), completed(t, l4, false, "0.0"), available(t, l5)},
+		},
+	} {
+		for i := range block.Events {
+			block.Events[i].EventIndex = i
+		}
+		require.NoError(t, fixtures.AddBlock(block))
+	}
+
+	fake, err := flowclient.NewFake(&fixtures)
+	require.NoError(t, err)
+	return fake
+}
+
+func feed(t *testing.T) *analytics.Aggregator {
+	t.Helper()
+
+	aggregator := analytics.New(storefrontContract)
+	require.NoError(t, flowclient.Feed(context.Background(), recorded(t), aggregator, 1, 12))
+	return aggregator
+}
+
+func TestReport(t *testing.T) {
+	aggregator := feed(t)
+	assert.Equal(t, uint64(12), aggregator.Height())
+	assert.Equal(t, start.Add(2*time.Hour), aggregator.Time().UTC())
+	require.Len(t, aggregator.Sales(), 2)
+
+	report, err := aggregator.Report(start, start.Add(3*time.Hour))
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+
+	stats := report[0]
+	assert.Equal(t, market, stats.Key)
+	assert.Equal(t, 2, stats.Sales)
+	assert.Equal(t, ufix64(t, "22.00000001"), stats.Volume)
+	assert.Equal(t, ufix64(t, "1.0"), stats.Commission)
+	// The mean of the middle prices is rounded down.
+	assert.Equal(t, ufix64(t, "11.0"), *stats.Median)
+	assert.Equal(t, ufix64(t, "12.00000001"), *stats.Last)
+	assert.Equal(t, start.Add(2*time.Hour), stats.LastSale.UTC())
+	// Listing 3 expired, listing 4 was removed.
+	assert.Equal(t, 1, stats.Listings)
+	assert.Equal(t, ufix64(t, "15.0"), *stats.Floor)
+
+	// Listing 3 has not expired yet at the end of this window.
+	report, err = aggregator.Report(start, start.Add(80*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, report, 1)
+	assert.Equal(t, 1, report[0].Sales)
+	assert.Equal(t, ufix64(t, "10.0"), *report[0].Median)
+	assert.Equal(t, 2, report[0].Listings)
+	assert.Equal(t, ufix64(t, "8.0"), *report[0].Floor)
+}
+
+func TestHistory(t *testing.T) {
+	aggregator := feed(t)
+
+	history, err := aggregator.History(market, start, start.Add(150*time.Minute), time.Hour)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+
+	assert.Equal(t, 0, history[0].Sales)
+	assert.Nil(t, history[0].Median)
+
+	assert.Equal(t, start.Add(time.Hour), history[1].Start)
+	assert.Equal(t, 1, history[1].Sales)
+	assert.Equal(t, ufix64(t, "10.0"), *history[1].Last)
+
+	assert.Equal(t, start.Add(150*time.Minute), history[2].End)
+	assert.Equal(t, ufix64(t, "12.00000001"), history[2].Volume)
+	assert.Nil(t, history[2].Floor)
+
+	_, err = aggregator.History(market, start, start.Add(time.Hour), 0)
+	assert.EqualError(t, err, "interval must be positive, got 0s")
+}
+
+func TestAddBlockErrors(t *testing.T) {
+	aggregator := analytics.New(storefrontContract)
+
+	l := listing{1, exampleNFT, "0.0", start}
+	sale := completed(t, l, true, "0.0")
+	sale.Value = nftstorefrontv2.ListingCompleted{
+		ListingResourceID:    1,
+		Purchased:            true,
+		NFTType:              exampleNFT,
+		SalePaymentVaultType: flowToken,
+		SalePrice:            cadence.UFix64(math.MaxUint64),
+	}.Encode(storefrontContract)
+
+	require.NoError(t, aggregator.AddBlock(context.Background(), flowclient.BlockEvents{Height: 2, BlockTimestamp: start, Events: []flowclient.Event{sale, sale}}))
+	_, err := aggregator.Report(start, start.Add(time.Hour))
+	assert.EqualError(t, err, "volume of A.f8d6e0586b0a20c7.ExampleNFT.NFT for A.f8d6e0586b0a20c7.FlowToken.Vault overflows UFix64")
+
+	err = aggregator.AddBlock(context.Background(), flowclient.BlockEvents{Height: 1})
+	assert.EqualError(t, err, "block 1 added after block 2")
+}
@@ -0,0 +1,145 @@
+// Package arguments reads the parameters declared by the embedded scripts and
+// transactions and checks the arguments of a script execution or transaction
+// against them before it is submitted to an access node.
+package arguments
+
+import (
+	"bytes"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/parser"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+)
+
+// Kind is the kind of program a signature belongs to.
+type Kind int
+
+const (
+	Script Kind = iota
+	Transaction
+)
+
+func (k Kind) String() string {
+	switch k {
+	case Script:
+		return "script"
+	case Transaction:
+		return "transaction"
+	default:
+		return fmt.Sprintf("Kind(%d)", int(k))
+	}
+}
+
+// Parameter is a parameter of a script or transaction.
+type Parameter struct {
+	Name string
+	Type string
+
+	annotation *ast.TypeAnnotation
+}
+
+// Signature is the parameter list of a script's main function or of a
+// transaction.
+type Signature struct {
+	Kind       Kind
+	Parameters []Parameter
+}
+
+func (s *Signature) String() string {
+	parameters := make([]string, 0, len(s.Parameters))
+	for _, p := range s.Parameters {
+		parameters = append(parameters, p.Name+": "+p.Type)
+	}
+
+	name := "transaction"
+	if s.Kind == Script {
+		name = "main"
+	}
+	return name + "(" + strings.Join(parameters, ", ") + ")"
+}
+
+// Names returns the names of the embedded scripts and transactions, for
+// example scripts/read_listing_details.cdc.
+func Names() []string {
+	return templates.AssetNames()
+}
+
+// Lookup returns the signature of the embedded script or transaction with
+// the given name, for example transactions/sell_item.cdc.
+func Lookup(name string) (*Signature, error) {
+	code, err := templates.Asset(name)
+	if err != nil {
+		return nil, err
+	}
+
+	signature, err := Parse(code)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+	return signature, nil
+}
+
+// header matches the parameter list of a transaction or of a script's main
+// function.
+var header = regexp.MustCompile(`(?s)(\btransaction|\bfun\s+main)\s*\(([^)]*)\)`)
+
+// Parse returns the signature of the given script or transaction.
+//
+// Some of the hybrid custody transactions still use syntax that was removed
+// in Cadence 1.0. If the program does not parse, only its parameter list is
+// parsed.
+func Parse(code []byte) (*Signature, error) {
+	program, err := parser.ParseProgram(nil, code, parser.Config{})
+	if err != nil {
+		match := header.FindSubmatch(code)
+		if match == nil {
+			return nil, err
+		}
+
+		stub := fmt.Sprintf("transaction(%s) {}", match[2])
+		if !bytes.Equal(match[1], []byte("transaction")) {
+			stub = fmt.Sprintf("access(all) fun main(%s) {}", match[2])
+		}
+
+		var stubErr error
+		program, stubErr = parser.ParseProgram(nil, []byte(stub), parser.Config{})
+		if stubErr != nil {
+			return nil, err
+		}
+	}
+
+	if transactions := program.TransactionDeclarations(); len(transactions) > 0 {
+		if len(transactions) > 1 {
+			return nil, fmt.Errorf("expected one transaction declaration, found %d", len(transactions))
+		}
+		return newSignature(Transaction, transactions[0].ParameterList), nil
+	}
+
+	for _, function := range program.FunctionDeclarations() {
+		if function.Identifier.Identifier == "main" {
+			return newSignature(Script, function.ParameterList), nil
+		}
+	}
+
+	return nil, fmt.Errorf("neither a transaction declaration nor a main function found")
+}
+
+func newSignature(kind Kind, list *ast.ParameterList) *Signature {
+	signature := &Signature{Kind: kind}
+	if list == nil {
+		return signature
+	}
+
+	for _, p := range list.Parameters {
+		signature.Parameters = append(signature.Parameters, Parameter{
+			Name:       p.Identifier.Identifier,
+			Type:       p.TypeAnnotation.String(),
+			annotation: p.TypeAnnotation,
+		})
+	}
+	return signature
+}
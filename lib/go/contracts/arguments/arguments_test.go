@@ -0,0 +1,106 @@
+package arguments_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+)
+
+func TestLookupEmbedded(t *testing.T) {
+	for _, name := range arguments.Names() {
+		_, err := arguments.Lookup(name)
+		assert.NoError(t, err, name)
+	}
+
+	signature, err := arguments.Lookup("scripts/read_listing_details.cdc")
+	require.NoError(t, err)
+	assert.Equal(t, arguments.Script, signature.Kind)
+	assert.Equal(t, "main(account: Address, listingResourceID: UInt64)", signature.String())
+
+	_, err = arguments.Lookup("transactions/missing.cdc")
+	assert.Error(t, err)
+}
+
+func sellItemArguments() []cadence.Value {
+	return []cadence.Value{
+		cadence.NewUInt64(1),
+		cadence.UFix64(10_00000000),
+		cadence.NewOptional(nil),
+		cadence.UFix64(0),
+		cadence.NewUInt64(1_700_000_000),
+		cadence.NewArray([]cadence.Value{cadence.BytesToAddress([]byte{1})}),
+		cadence.String("A.f8d6e0586b0a20c7.ExampleNFT.NFT"),
+		cadence.String("A.0ae53cb6e3f42a79.FlowToken.Vault"),
+	}
+}
+
+func TestCheck(t *testing.T) {
+	const sellItem = "transactions/sell_item.cdc"
+
+	require.NoError(t, arguments.Check(sellItem, sellItemArguments()))
+
+	args := sellItemArguments()
+	args[2] = cadence.NewOptional(cadence.String("flowty"))
+	require.NoError(t, arguments.Check(sellItem, args))
+
+	var countErr *arguments.CountError
+	err := arguments.Check(sellItem, sellItemArguments()[:7])
+	require.ErrorAs(t, err, &countErr)
+	assert.Equal(t, 8, countErr.Expected)
+	assert.Equal(t, 7, countErr.Actual)
+
+	var argumentErr *arguments.ArgumentError
+
+	args = sellItemArguments()
+	args[0] = cadence.NewUInt32(1)
+	err = arguments.Check(sellItem, args)
+	require.ErrorAs(t, err, &argumentErr)
+	assert.Equal(t, 0, argumentErr.Index)
+	assert.Equal(t, "saleItemID", argumentErr.Parameter)
+	assert.EqualError(t, err, "transactions/sell_item.cdc: argument 0 (saleItemID: UInt64): expected UInt64, got UInt32")
+
+	args = sellItemArguments()
+	args[2] = cadence.String("flowty")
+	err = arguments.Check(sellItem, args)
+	assert.EqualError(t, err, "transactions/sell_item.cdc: argument 2 (customID: String?): expected String?, got String")
+
+	args = sellItemArguments()
+	args[5] = cadence.NewArray([]cadence.Value{cadence.BytesToAddress([]byte{1}), cadence.String("0x2")})
+	err = arguments.Check(sellItem, args)
+	assert.EqualError(t, err, "transactions/sell_item.cdc: argument 5 (marketplacesAddress: [Address]): element 1: expected Address, got String")
+}
+
+func TestCheckPaths(t *testing.T) {
+	signature, err := arguments.Parse([]byte(`
+access(all) fun main(address: Address, path: PublicPath, ids: {String: [UInt64; 2]}): Bool {
+    return true
+}
+`))
+	require.NoError(t, err)
+
+	ids := cadence.NewDictionary([]cadence.KeyValuePair{{
+		Key:   cadence.String("a"),
+		Value: cadence.NewArray([]cadence.Value{cadence.NewUInt64(1), cadence.NewUInt64(2)}),
+	}})
+
+	address := cadence.BytesToAddress([]byte{1})
+	publicPath := cadence.Path{Domain: common.PathDomainPublic, Identifier: "collection"}
+	storagePath := cadence.Path{Domain: common.PathDomainStorage, Identifier: "collection"}
+
+	assert.NoError(t, signature.Check([]cadence.Value{address, publicPath, ids}))
+
+	err = signature.Check([]cadence.Value{address, storagePath, ids})
+	assert.EqualError(t, err, "argument 1 (path: PublicPath): expected PublicPath, got /storage/collection")
+
+	short := cadence.NewDictionary([]cadence.KeyValuePair{{
+		Key:   cadence.String("a"),
+		Value: cadence.NewArray([]cadence.Value{cadence.NewUInt64(1)}),
+	}})
+	err = signature.Check([]cadence.Value{address, publicPath, short})
+	assert.EqualError(t, err, `argument 2 (ids: {String: [UInt64; 2]}): value of key "a": expected 2 elements, got 1`)
+}
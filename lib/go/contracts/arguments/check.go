@@ -0,0 +1,217 @@
+package arguments
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+)
+
+// CountError is returned when the number of arguments does not match the
+// number of parameters.
+type CountError struct {
+	Signature string
+	Expected  int
+	Actual    int
+}
+
+func (e *CountError) Error() string {
+	return fmt.Sprintf("%s: expected %d arguments, got %d", e.Signature, e.Expected, e.Actual)
+}
+
+// ArgumentError is returned when an argument does not have the type of the
+// parameter at its position.
+type ArgumentError struct {
+	Index     int
+	Parameter string
+	Expected  string
+	Reason    string
+}
+
+func (e *ArgumentError) Error() string {
+	return fmt.Sprintf("argument %d (%s: %s): %s", e.Index, e.Parameter, e.Expected, e.Reason)
+}
+
+// Check checks that the arguments match the parameters in count, order and
+// type. It returns a *CountError or the *ArgumentError of the first
+// mismatching argument.
+func (s *Signature) Check(arguments []cadence.Value) error {
+	if len(arguments) != len(s.Parameters) {
+		return &CountError{
+			Signature: s.String(),
+			Expected:  len(s.Parameters),
+			Actual:    len(arguments),
+		}
+	}
+
+	for i, p := range s.Parameters {
+		if err := checkValue(p.annotation.Type, arguments[i]); err != nil {
+			return &ArgumentError{
+				Index:     i,
+				Parameter: p.Name,
+				Expected:  p.Type,
+				Reason:    err.Error(),
+			}
+		}
+	}
+	return nil
+}
+
+// Check checks the arguments of the embedded script or transaction with the
+// given name.
+func Check(name string, arguments []cadence.Value) error {
+	signature, err := Lookup(name)
+	if err != nil {
+		return err
+	}
+	if err := signature.Check(arguments); err != nil {
+		return fmt.Errorf("%s: %w", name, err)
+	}
+	return nil
+}
+
+var pathDomains = map[string][]common.PathDomain{
+	"StoragePath":    {common.PathDomainStorage},
+	"PublicPath":     {common.PathDomainPublic},
+	"PrivatePath":    {common.PathDomainPrivate},
+	"CapabilityPath": {common.PathDomainPublic, common.PathDomainPrivate},
+	"Path":           {common.PathDomainStorage, common.PathDomainPublic, common.PathDomainPrivate},
+}
+
+func checkValue(t ast.Type, value cadence.Value) error {
+	if value == nil {
+		return fmt.Errorf("missing value")
+	}
+
+	switch t := t.(type) {
+	case *ast.OptionalType:
+		optional, ok := value.(cadence.Optional)
+		if !ok {
+			return mismatch(t, value)
+		}
+		if optional.Value == nil {
+			return nil
+		}
+		return checkValue(t.Type, optional.Value)
+
+	case *ast.VariableSizedType:
+		array, ok := value.(cadence.Array)
+		if !ok {
+			return mismatch(t, value)
+		}
+		return checkElements(t.Type, array.Values)
+
+	case *ast.ConstantSizedType:
+		array, ok := value.(cadence.Array)
+		if !ok {
+			return mismatch(t, value)
+		}
+		if size := t.Size.Value.Int64(); int64(len(array.Values)) != size {
+			return fmt.Errorf("expected %d elements, got %d", size, len(array.Values))
+		}
+		return checkElements(t.Type, array.Values)
+
+	case *ast.DictionaryType:
+		dictionary, ok := value.(cadence.Dictionary)
+		if !ok {
+			return mismatch(t, value)
+		}
+		for _, pair := range dictionary.Pairs {
+			if err := checkValue(t.KeyType, pair.Key); err != nil {
+				return fmt.Errorf("key %s: %w", pair.Key, err)
+			}
+			if err := checkValue(t.ValueType, pair.Value); err != nil {
+				return fmt.Errorf("value of key %s: %w", pair.Key, err)
+			}
+		}
+		return nil
+
+	case *ast.InstantiationType:
+		if t.Type.String() == "Capability" {
+			if _, ok := value.(cadence.Capability); !ok {
+				return mismatch(t, value)
+			}
+		}
+		return nil
+
+	case *ast.NominalType:
+		return checkNominal(t, value)
+	}
+
+	// References and intersection types cannot be checked without the
+	// deployed contracts, so the access node has to do it.
+	return nil
+}
+
+func checkElements(t ast.Type, values []cadence.Value) error {
+	for i, element := range values {
+		if err := checkValue(t, element); err != nil {
+			return fmt.Errorf("element %d: %w", i, err)
+		}
+	}
+	return nil
+}
+
+func checkNominal(t *ast.NominalType, value cadence.Value) error {
+	name := t.String()
+
+	if domains, ok := pathDomains[name]; ok {
+		path, ok := value.(cadence.Path)
+		if !ok {
+			return mismatch(t, value)
+		}
+		for _, domain := range domains {
+			if path.Domain == domain {
+				return nil
+			}
+		}
+		return fmt.Errorf("expected %s, got %s", name, path)
+	}
+
+	if name == "AnyStruct" {
+		return nil
+	}
+
+	switch valueType := value.Type().(type) {
+	case cadence.PrimitiveType:
+		if valueType.ID() == name {
+			return nil
+		}
+	case cadence.CompositeType:
+		if valueType.CompositeTypeQualifiedIdentifier() == name {
+			return nil
+		}
+	}
+	return mismatch(t, value)
+}
+
+func mismatch(t ast.Type, value cadence.Value) error {
+	return fmt.Errorf("expected %s, got %s", t, describe(value))
+}
+
+// describe returns the type of the value for error messages.
+func describe(value cadence.Value) string {
+	switch v := value.(type) {
+	case cadence.Optional:
+		if v.Value == nil {
+			return "nil"
+		}
+		return describe(v.Value) + "?"
+	case cadence.Array:
+		if v.ArrayType != nil {
+			return v.ArrayType.ID()
+		}
+		return "array"
+	case cadence.Dictionary:
+		if v.DictionaryType != nil {
+			return v.DictionaryType.ID()
+		}
+		return "dictionary"
+	}
+
+	if value.Type() == nil {
+		return fmt.Sprintf("%T", value)
+	}
+	return value.Type().ID()
+}
@@ -0,0 +1,140 @@
+// Package assets lists the Cadence files shipped with this repository: the
+// contracts, and the transactions and scripts of both storefront versions.
+package assets
+
+import (
+	"fmt"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+	contractassets "github.com/onflow/nft-storefront/lib/go/contracts/internal/assets"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cdc"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+	"github.com/onflow/nft-storefront/lib/go/contracts/versions"
+)
+
+// Kind is the kind of a Cadence file.
+type Kind string
+
+const (
+	KindContract    Kind = "contract"
+	KindTransaction Kind = "transaction"
+	KindScript      Kind = "script"
+)
+
+// File describes a shipped Cadence file.
+type File struct {
+	// Name is the path of the file relative to the repository root, for
+	// example transactions/buy_item.cdc.
+	Name string
+	Kind Kind
+	// Contract is the storefront contract the file is written for, either
+	// versions.NFTStorefront or versions.NFTStorefrontV2. It is empty for
+	// files that do not use a storefront, such as the example NFT helpers.
+	Contract string
+	// Parameters are the parameters of a transaction or of the main
+	// function of a script.
+	Parameters []arguments.Parameter
+	// Imports are the names of the imported contracts.
+	Imports []string
+
+	code []byte
+}
+
+// Code returns the source of the file, with string imports.
+func (f File) Code() []byte {
+	return f.code
+}
+
+var files = load()
+
+func load() []File {
+	var result []File
+
+	for _, name := range contractassets.AssetNames() {
+		code := contractassets.MustAsset(name)
+		result = append(result, newFile(KindContract, "contracts/"+name, code))
+	}
+
+	for _, name := range templates.AssetNames() {
+		code := templates.MustAsset(name)
+		signature, err := arguments.Parse(code)
+		if err != nil {
+			panic(fmt.Sprintf("%s: %s", name, err))
+		}
+
+		f := newFile(KindScript, name, code)
+		if signature.Kind == arguments.Transaction {
+			f.Kind = KindTransaction
+		}
+		f.Parameters = signature.Parameters
+		result = append(result, f)
+	}
+
+	sort.Slice(result, func(i, j int) bool {
+		return result[i].Name < result[j].Name
+	})
+	return result
+}
+
+func newFile(kind Kind, name string, code []byte) File {
+	f := File{
+		Name:    name,
+		Kind:    kind,
+		Imports: cdc.Imports(code),
+		code:    code,
+	}
+
+	for _, contract := range []string{versions.NFTStorefrontV2, versions.NFTStorefront} {
+		if name == "contracts/"+contract+".cdc" || slices.Contains(f.Imports, contract) {
+			f.Contract = contract
+			break
+		}
+	}
+	return f
+}
+
+// All returns every shipped Cadence file, sorted by name.
+func All() []File {
+	return append([]File(nil), files...)
+}
+
+// Contracts returns the contracts.
+func Contracts() []File {
+	return filter(KindContract)
+}
+
+// Transactions returns the transactions of both storefront versions and the
+// helper transactions.
+func Transactions() []File {
+	return filter(KindTransaction)
+}
+
+// Scripts returns the scripts of both storefront versions and the helper
+// scripts.
+func Scripts() []File {
+	return filter(KindScript)
+}
+
+func filter(kind Kind) []File {
+	var result []File
+	for _, f := range files {
+		if f.Kind == kind {
+			result = append(result, f)
+		}
+	}
+	return result
+}
+
+// Lookup returns the file with the given name.
+func Lookup(name string) (File, bool) {
+	name = strings.TrimPrefix(name, "./")
+	for _, f := range files {
+		if f.Name == name {
+			return f, true
+		}
+	}
+	return File{}, false
+}
@@ -0,0 +1,61 @@
+package assets_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/assets"
+	"github.com/onflow/nft-storefront/lib/go/contracts/versions"
+)
+
+func TestRegistry(t *testing.T) {
+	assert.Len(t, assets.Contracts(), 10)
+
+	kinds := make(map[string]assets.Kind)
+	for _, f := range assets.All() {
+		kinds[f.Name] = f.Kind
+	}
+	assert.Equal(t, assets.KindTransaction, kinds["transactions-v1/utility/mint_example_nft.cdc"])
+	assert.Equal(t, assets.KindScript, kinds["transactions-v1/scripts-v1/read_listing_details.cdc"])
+	assert.Equal(t, assets.KindScript, kinds["scripts/read_listing_details.cdc"])
+	assert.NotContains(t, kinds, "transactions-v1/README.md")
+
+	for _, f := range assets.Transactions() {
+		assert.Equal(t, assets.KindTransaction, f.Kind, f.Name)
+	}
+	for _, f := range assets.Scripts() {
+		assert.Equal(t, assets.KindScript, f.Kind, f.Name)
+	}
+}
+
+func TestLookup(t *testing.T) {
+	f, ok := assets.Lookup("transactions/cleanup_expired_listings.cdc")
+	require.True(t, ok)
+	assert.Equal(t, versions.NFTStorefrontV2, f.Contract)
+	assert.Equal(t, []string{"NFTStorefrontV2"}, f.Imports)
+
+	names := make([]string, 0, len(f.Parameters))
+	for _, p := range f.Parameters {
+		names = append(names, p.Name+": "+p.Type)
+	}
+	assert.Equal(t, []string{"fromIndex: UInt64", "toIndex: UInt64", "storefrontAddress: Address"}, names)
+
+	f, ok = assets.Lookup("./transactions-v1/buy_item.cdc")
+	require.True(t, ok)
+	assert.Equal(t, versions.NFTStorefront, f.Contract)
+	assert.Contains(t, string(f.Code()), "transaction(listingResourceID: UInt64, storefrontAddress: Address)")
+
+	f, ok = assets.Lookup("transactions/example-nft/mint_nft.cdc")
+	require.True(t, ok)
+	assert.Empty(t, f.Contract)
+
+	f, ok = assets.Lookup("contracts/NFTStorefront.cdc")
+	require.True(t, ok)
+	assert.Equal(t, assets.KindContract, f.Kind)
+	assert.Equal(t, versions.NFTStorefront, f.Contract)
+
+	_, ok = assets.Lookup("transactions/missing.cdc")
+	assert.False(t, ok)
+}
@@ -0,0 +1,572 @@
+// Code generated by bindgen from NFTStorefront.cdc. DO NOT EDIT.
+
+// Package nftstorefront contains Go types for the structs and events of the
+// NFTStorefront contract.
+package nftstorefront
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+)
+
+// ContractName is the name of the contract.
+const ContractName = "NFTStorefront"
+
+// SaleCut is the NFTStorefront.SaleCut struct.
+type SaleCut struct {
+	Receiver cadence.Capability
+	Amount   cadence.UFix64
+}
+
+// SaleCutQualifiedIdentifier is the qualified identifier of SaleCut.
+const SaleCutQualifiedIdentifier = "NFTStorefront.SaleCut"
+
+// SaleCutType returns the Cadence type of SaleCut for the contract
+// deployed to the given address.
+func SaleCutType(address cadence.Address) *cadence.StructType {
+	return cadence.NewStructType(
+		cadenceconv.Location(address, ContractName),
+		SaleCutQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "receiver", Type: cadence.NewCapabilityType(nil)},
+			{Identifier: "amount", Type: cadence.UFix64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeSaleCut decodes a NFTStorefront.SaleCut value.
+func DecodeSaleCut(value cadence.Value) (SaleCut, error) {
+	var result SaleCut
+
+	fields, err := cadenceconv.Fields(value, SaleCutQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.Receiver, err = cadenceconv.Field(fields, "receiver", cadenceconv.Capability)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.SaleCut: %w", err)
+	}
+
+	result.Amount, err = cadenceconv.Field(fields, "amount", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.SaleCut: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v SaleCut) Encode(address cadence.Address) cadence.Struct {
+	return cadence.NewStruct([]cadence.Value{
+		cadenceconv.EncodeCapability(v.Receiver),
+		cadenceconv.EncodeUFix64(v.Amount),
+	}).WithType(SaleCutType(address))
+}
+
+// ListingDetails is the NFTStorefront.ListingDetails struct.
+type ListingDetails struct {
+	StorefrontID         uint64
+	Purchased            bool
+	NFTType              cadence.Type
+	NFTID                uint64
+	SalePaymentVaultType cadence.Type
+	SalePrice            cadence.UFix64
+	SaleCuts             []SaleCut
+}
+
+// ListingDetailsQualifiedIdentifier is the qualified identifier of ListingDetails.
+const ListingDetailsQualifiedIdentifier = "NFTStorefront.ListingDetails"
+
+// ListingDetailsType returns the Cadence type of ListingDetails for the contract
+// deployed to the given address.
+func ListingDetailsType(address cadence.Address) *cadence.StructType {
+	return cadence.NewStructType(
+		cadenceconv.Location(address, ContractName),
+		ListingDetailsQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontID", Type: cadence.UInt64Type},
+			{Identifier: "purchased", Type: cadence.BoolType},
+			{Identifier: "nftType", Type: cadence.MetaType},
+			{Identifier: "nftID", Type: cadence.UInt64Type},
+			{Identifier: "salePaymentVaultType", Type: cadence.MetaType},
+			{Identifier: "salePrice", Type: cadence.UFix64Type},
+			{Identifier: "saleCuts", Type: cadence.NewVariableSizedArrayType(SaleCutType(address))},
+		},
+		nil,
+	)
+}
+
+// DecodeListingDetails decodes a NFTStorefront.ListingDetails value.
+func DecodeListingDetails(value cadence.Value) (ListingDetails, error) {
+	var result ListingDetails
+
+	fields, err := cadenceconv.Fields(value, ListingDetailsQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontID, err = cadenceconv.Field(fields, "storefrontID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingDetails: %w", err)
+	}
+
+	result.Purchased, err = cadenceconv.Field(fields, "purchased", cadenceconv.Bool)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingDetails: %w", err)
+	}
+
+	result.NFTType, err = cadenceconv.Field(fields, "nftType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingDetails: %w", err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingDetails: %w", err)
+	}
+
+	result.SalePaymentVaultType, err = cadenceconv.Field(fields, "salePaymentVaultType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingDetails: %w", err)
+	}
+
+	result.SalePrice, err = cadenceconv.Field(fields, "salePrice", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingDetails: %w", err)
+	}
+
+	result.SaleCuts, err = cadenceconv.Field(fields, "saleCuts", cadenceconv.ArrayOf(DecodeSaleCut))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingDetails: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v ListingDetails) Encode(address cadence.Address) cadence.Struct {
+	return cadence.NewStruct([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.StorefrontID),
+		cadenceconv.EncodeBool(v.Purchased),
+		cadenceconv.EncodeType(v.NFTType),
+		cadenceconv.EncodeUInt64(v.NFTID),
+		cadenceconv.EncodeType(v.SalePaymentVaultType),
+		cadenceconv.EncodeUFix64(v.SalePrice),
+		cadenceconv.EncodeArrayOf(SaleCutType(address), func(v SaleCut) cadence.Value { return v.Encode(address) })(v.SaleCuts),
+	}).WithType(ListingDetailsType(address))
+}
+
+// StorefrontInitialized is the NFTStorefront.StorefrontInitialized event.
+type StorefrontInitialized struct {
+	StorefrontResourceID uint64
+}
+
+// StorefrontInitializedQualifiedIdentifier is the qualified identifier of StorefrontInitialized.
+const StorefrontInitializedQualifiedIdentifier = "NFTStorefront.StorefrontInitialized"
+
+// StorefrontInitializedEventType returns the type ID of StorefrontInitialized events emitted by
+// the contract deployed to the given address.
+func StorefrontInitializedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, StorefrontInitializedQualifiedIdentifier))
+}
+
+// StorefrontInitializedType returns the Cadence type of StorefrontInitialized for the contract
+// deployed to the given address.
+func StorefrontInitializedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		StorefrontInitializedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeStorefrontInitialized decodes a NFTStorefront.StorefrontInitialized value.
+func DecodeStorefrontInitialized(value cadence.Value) (StorefrontInitialized, error) {
+	var result StorefrontInitialized
+
+	fields, err := cadenceconv.Fields(value, StorefrontInitializedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.StorefrontInitialized: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v StorefrontInitialized) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+	}).WithType(StorefrontInitializedType(address))
+}
+
+// StorefrontDestroyed is the NFTStorefront.StorefrontDestroyed event.
+type StorefrontDestroyed struct {
+	StorefrontResourceID uint64
+}
+
+// StorefrontDestroyedQualifiedIdentifier is the qualified identifier of StorefrontDestroyed.
+const StorefrontDestroyedQualifiedIdentifier = "NFTStorefront.StorefrontDestroyed"
+
+// StorefrontDestroyedEventType returns the type ID of StorefrontDestroyed events emitted by
+// the contract deployed to the given address.
+func StorefrontDestroyedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, StorefrontDestroyedQualifiedIdentifier))
+}
+
+// StorefrontDestroyedType returns the Cadence type of StorefrontDestroyed for the contract
+// deployed to the given address.
+func StorefrontDestroyedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		StorefrontDestroyedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeStorefrontDestroyed decodes a NFTStorefront.StorefrontDestroyed value.
+func DecodeStorefrontDestroyed(value cadence.Value) (StorefrontDestroyed, error) {
+	var result StorefrontDestroyed
+
+	fields, err := cadenceconv.Fields(value, StorefrontDestroyedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.StorefrontDestroyed: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v StorefrontDestroyed) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+	}).WithType(StorefrontDestroyedType(address))
+}
+
+// ListingAvailable is the NFTStorefront.ListingAvailable event.
+type ListingAvailable struct {
+	StorefrontAddress cadence.Address
+	ListingResourceID uint64
+	NFTType           cadence.Type
+	NFTID             uint64
+	FTVaultType       cadence.Type
+	Price             cadence.UFix64
+}
+
+// ListingAvailableQualifiedIdentifier is the qualified identifier of ListingAvailable.
+const ListingAvailableQualifiedIdentifier = "NFTStorefront.ListingAvailable"
+
+// ListingAvailableEventType returns the type ID of ListingAvailable events emitted by
+// the contract deployed to the given address.
+func ListingAvailableEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, ListingAvailableQualifiedIdentifier))
+}
+
+// ListingAvailableType returns the Cadence type of ListingAvailable for the contract
+// deployed to the given address.
+func ListingAvailableType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		ListingAvailableQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontAddress", Type: cadence.AddressType},
+			{Identifier: "listingResourceID", Type: cadence.UInt64Type},
+			{Identifier: "nftType", Type: cadence.MetaType},
+			{Identifier: "nftID", Type: cadence.UInt64Type},
+			{Identifier: "ftVaultType", Type: cadence.MetaType},
+			{Identifier: "price", Type: cadence.UFix64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeListingAvailable decodes a NFTStorefront.ListingAvailable value.
+func DecodeListingAvailable(value cadence.Value) (ListingAvailable, error) {
+	var result ListingAvailable
+
+	fields, err := cadenceconv.Fields(value, ListingAvailableQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontAddress, err = cadenceconv.Field(fields, "storefrontAddress", cadenceconv.Address)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingAvailable: %w", err)
+	}
+
+	result.ListingResourceID, err = cadenceconv.Field(fields, "listingResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingAvailable: %w", err)
+	}
+
+	result.NFTType, err = cadenceconv.Field(fields, "nftType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingAvailable: %w", err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingAvailable: %w", err)
+	}
+
+	result.FTVaultType, err = cadenceconv.Field(fields, "ftVaultType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingAvailable: %w", err)
+	}
+
+	result.Price, err = cadenceconv.Field(fields, "price", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingAvailable: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v ListingAvailable) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeAddress(v.StorefrontAddress),
+		cadenceconv.EncodeUInt64(v.ListingResourceID),
+		cadenceconv.EncodeType(v.NFTType),
+		cadenceconv.EncodeUInt64(v.NFTID),
+		cadenceconv.EncodeType(v.FTVaultType),
+		cadenceconv.EncodeUFix64(v.Price),
+	}).WithType(ListingAvailableType(address))
+}
+
+// ListingCompleted is the NFTStorefront.ListingCompleted event.
+type ListingCompleted struct {
+	ListingResourceID    uint64
+	StorefrontResourceID uint64
+	Purchased            bool
+	NFTType              cadence.Type
+	NFTID                uint64
+}
+
+// ListingCompletedQualifiedIdentifier is the qualified identifier of ListingCompleted.
+const ListingCompletedQualifiedIdentifier = "NFTStorefront.ListingCompleted"
+
+// ListingCompletedEventType returns the type ID of ListingCompleted events emitted by
+// the contract deployed to the given address.
+func ListingCompletedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, ListingCompletedQualifiedIdentifier))
+}
+
+// ListingCompletedType returns the Cadence type of ListingCompleted for the contract
+// deployed to the given address.
+func ListingCompletedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		ListingCompletedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "listingResourceID", Type: cadence.UInt64Type},
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+			{Identifier: "purchased", Type: cadence.BoolType},
+			{Identifier: "nftType", Type: cadence.MetaType},
+			{Identifier: "nftID", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeListingCompleted decodes a NFTStorefront.ListingCompleted value.
+func DecodeListingCompleted(value cadence.Value) (ListingCompleted, error) {
+	var result ListingCompleted
+
+	fields, err := cadenceconv.Fields(value, ListingCompletedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.ListingResourceID, err = cadenceconv.Field(fields, "listingResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingCompleted: %w", err)
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingCompleted: %w", err)
+	}
+
+	result.Purchased, err = cadenceconv.Field(fields, "purchased", cadenceconv.Bool)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingCompleted: %w", err)
+	}
+
+	result.NFTType, err = cadenceconv.Field(fields, "nftType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingCompleted: %w", err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.ListingCompleted: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v ListingCompleted) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.ListingResourceID),
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+		cadenceconv.EncodeBool(v.Purchased),
+		cadenceconv.EncodeType(v.NFTType),
+		cadenceconv.EncodeUInt64(v.NFTID),
+	}).WithType(ListingCompletedType(address))
+}
+
+// ListingResourceDestroyed is the NFTStorefront.Listing.ResourceDestroyed event.
+type ListingResourceDestroyed struct {
+	ListingResourceID    uint64
+	StorefrontResourceID uint64
+	Purchased            bool
+	NFTType              string
+	NFTID                uint64
+}
+
+// ListingResourceDestroyedQualifiedIdentifier is the qualified identifier of ListingResourceDestroyed.
+const ListingResourceDestroyedQualifiedIdentifier = "NFTStorefront.Listing.ResourceDestroyed"
+
+// ListingResourceDestroyedEventType returns the type ID of ListingResourceDestroyed events emitted by
+// the contract deployed to the given address.
+func ListingResourceDestroyedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, ListingResourceDestroyedQualifiedIdentifier))
+}
+
+// ListingResourceDestroyedType returns the Cadence type of ListingResourceDestroyed for the contract
+// deployed to the given address.
+func ListingResourceDestroyedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		ListingResourceDestroyedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "listingResourceID", Type: cadence.UInt64Type},
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+			{Identifier: "purchased", Type: cadence.BoolType},
+			{Identifier: "nftType", Type: cadence.StringType},
+			{Identifier: "nftID", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeListingResourceDestroyed decodes a NFTStorefront.Listing.ResourceDestroyed value.
+func DecodeListingResourceDestroyed(value cadence.Value) (ListingResourceDestroyed, error) {
+	var result ListingResourceDestroyed
+
+	fields, err := cadenceconv.Fields(value, ListingResourceDestroyedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.ListingResourceID, err = cadenceconv.Field(fields, "listingResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.Purchased, err = cadenceconv.Field(fields, "purchased", cadenceconv.Bool)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.NFTType, err = cadenceconv.Field(fields, "nftType", cadenceconv.String)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.Listing.ResourceDestroyed: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v ListingResourceDestroyed) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.ListingResourceID),
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+		cadenceconv.EncodeBool(v.Purchased),
+		cadenceconv.EncodeString(v.NFTType),
+		cadenceconv.EncodeUInt64(v.NFTID),
+	}).WithType(ListingResourceDestroyedType(address))
+}
+
+// StorefrontResourceDestroyed is the NFTStorefront.Storefront.ResourceDestroyed event.
+type StorefrontResourceDestroyed struct {
+	StorefrontResourceID uint64
+}
+
+// StorefrontResourceDestroyedQualifiedIdentifier is the qualified identifier of StorefrontResourceDestroyed.
+const StorefrontResourceDestroyedQualifiedIdentifier = "NFTStorefront.Storefront.ResourceDestroyed"
+
+// StorefrontResourceDestroyedEventType returns the type ID of StorefrontResourceDestroyed events emitted by
+// the contract deployed to the given address.
+func StorefrontResourceDestroyedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, StorefrontResourceDestroyedQualifiedIdentifier))
+}
+
+// StorefrontResourceDestroyedType returns the Cadence type of StorefrontResourceDestroyed for the contract
+// deployed to the given address.
+func StorefrontResourceDestroyedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		StorefrontResourceDestroyedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeStorefrontResourceDestroyed decodes a NFTStorefront.Storefront.ResourceDestroyed value.
+func DecodeStorefrontResourceDestroyed(value cadence.Value) (StorefrontResourceDestroyed, error) {
+	var result StorefrontResourceDestroyed
+
+	fields, err := cadenceconv.Fields(value, StorefrontResourceDestroyedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefront.Storefront.ResourceDestroyed: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v StorefrontResourceDestroyed) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+	}).WithType(StorefrontResourceDestroyedType(address))
+}
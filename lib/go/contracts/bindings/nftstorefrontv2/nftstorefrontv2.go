@@ -0,0 +1,764 @@
+// Code generated by bindgen from NFTStorefrontV2.cdc. DO NOT EDIT.
+
+// Package nftstorefrontv2 contains Go types for the structs and events of the
+// NFTStorefrontV2 contract.
+package nftstorefrontv2
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+)
+
+// ContractName is the name of the contract.
+const ContractName = "NFTStorefrontV2"
+
+// SaleCut is the NFTStorefrontV2.SaleCut struct.
+type SaleCut struct {
+	Receiver cadence.Capability
+	Amount   cadence.UFix64
+}
+
+// SaleCutQualifiedIdentifier is the qualified identifier of SaleCut.
+const SaleCutQualifiedIdentifier = "NFTStorefrontV2.SaleCut"
+
+// SaleCutType returns the Cadence type of SaleCut for the contract
+// deployed to the given address.
+func SaleCutType(address cadence.Address) *cadence.StructType {
+	return cadence.NewStructType(
+		cadenceconv.Location(address, ContractName),
+		SaleCutQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "receiver", Type: cadence.NewCapabilityType(nil)},
+			{Identifier: "amount", Type: cadence.UFix64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeSaleCut decodes a NFTStorefrontV2.SaleCut value.
+func DecodeSaleCut(value cadence.Value) (SaleCut, error) {
+	var result SaleCut
+
+	fields, err := cadenceconv.Fields(value, SaleCutQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.Receiver, err = cadenceconv.Field(fields, "receiver", cadenceconv.Capability)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.SaleCut: %w", err)
+	}
+
+	result.Amount, err = cadenceconv.Field(fields, "amount", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.SaleCut: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v SaleCut) Encode(address cadence.Address) cadence.Struct {
+	return cadence.NewStruct([]cadence.Value{
+		cadenceconv.EncodeCapability(v.Receiver),
+		cadenceconv.EncodeUFix64(v.Amount),
+	}).WithType(SaleCutType(address))
+}
+
+// ListingDetails is the NFTStorefrontV2.ListingDetails struct.
+type ListingDetails struct {
+	StorefrontID         uint64
+	Purchased            bool
+	NFTType              cadence.Type
+	NFTUUID              uint64
+	NFTID                uint64
+	SalePaymentVaultType cadence.Type
+	SalePrice            cadence.UFix64
+	SaleCuts             []SaleCut
+	CustomID             *string
+	CommissionAmount     cadence.UFix64
+	Expiry               uint64
+}
+
+// ListingDetailsQualifiedIdentifier is the qualified identifier of ListingDetails.
+const ListingDetailsQualifiedIdentifier = "NFTStorefrontV2.ListingDetails"
+
+// ListingDetailsType returns the Cadence type of ListingDetails for the contract
+// deployed to the given address.
+func ListingDetailsType(address cadence.Address) *cadence.StructType {
+	return cadence.NewStructType(
+		cadenceconv.Location(address, ContractName),
+		ListingDetailsQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontID", Type: cadence.UInt64Type},
+			{Identifier: "purchased", Type: cadence.BoolType},
+			{Identifier: "nftType", Type: cadence.MetaType},
+			{Identifier: "nftUUID", Type: cadence.UInt64Type},
+			{Identifier: "nftID", Type: cadence.UInt64Type},
+			{Identifier: "salePaymentVaultType", Type: cadence.MetaType},
+			{Identifier: "salePrice", Type: cadence.UFix64Type},
+			{Identifier: "saleCuts", Type: cadence.NewVariableSizedArrayType(SaleCutType(address))},
+			{Identifier: "customID", Type: cadence.NewOptionalType(cadence.StringType)},
+			{Identifier: "commissionAmount", Type: cadence.UFix64Type},
+			{Identifier: "expiry", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeListingDetails decodes a NFTStorefrontV2.ListingDetails value.
+func DecodeListingDetails(value cadence.Value) (ListingDetails, error) {
+	var result ListingDetails
+
+	fields, err := cadenceconv.Fields(value, ListingDetailsQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontID, err = cadenceconv.Field(fields, "storefrontID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.Purchased, err = cadenceconv.Field(fields, "purchased", cadenceconv.Bool)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.NFTType, err = cadenceconv.Field(fields, "nftType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.NFTUUID, err = cadenceconv.Field(fields, "nftUUID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.SalePaymentVaultType, err = cadenceconv.Field(fields, "salePaymentVaultType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.SalePrice, err = cadenceconv.Field(fields, "salePrice", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.SaleCuts, err = cadenceconv.Field(fields, "saleCuts", cadenceconv.ArrayOf(DecodeSaleCut))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.CustomID, err = cadenceconv.Field(fields, "customID", cadenceconv.OptionalOf(cadenceconv.String))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.CommissionAmount, err = cadenceconv.Field(fields, "commissionAmount", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	result.Expiry, err = cadenceconv.Field(fields, "expiry", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingDetails: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v ListingDetails) Encode(address cadence.Address) cadence.Struct {
+	return cadence.NewStruct([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.StorefrontID),
+		cadenceconv.EncodeBool(v.Purchased),
+		cadenceconv.EncodeType(v.NFTType),
+		cadenceconv.EncodeUInt64(v.NFTUUID),
+		cadenceconv.EncodeUInt64(v.NFTID),
+		cadenceconv.EncodeType(v.SalePaymentVaultType),
+		cadenceconv.EncodeUFix64(v.SalePrice),
+		cadenceconv.EncodeArrayOf(SaleCutType(address), func(v SaleCut) cadence.Value { return v.Encode(address) })(v.SaleCuts),
+		cadenceconv.EncodeOptionalOf(cadenceconv.EncodeString)(v.CustomID),
+		cadenceconv.EncodeUFix64(v.CommissionAmount),
+		cadenceconv.EncodeUInt64(v.Expiry),
+	}).WithType(ListingDetailsType(address))
+}
+
+// StorefrontInitialized is the NFTStorefrontV2.StorefrontInitialized event.
+type StorefrontInitialized struct {
+	StorefrontResourceID uint64
+}
+
+// StorefrontInitializedQualifiedIdentifier is the qualified identifier of StorefrontInitialized.
+const StorefrontInitializedQualifiedIdentifier = "NFTStorefrontV2.StorefrontInitialized"
+
+// StorefrontInitializedEventType returns the type ID of StorefrontInitialized events emitted by
+// the contract deployed to the given address.
+func StorefrontInitializedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, StorefrontInitializedQualifiedIdentifier))
+}
+
+// StorefrontInitializedType returns the Cadence type of StorefrontInitialized for the contract
+// deployed to the given address.
+func StorefrontInitializedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		StorefrontInitializedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeStorefrontInitialized decodes a NFTStorefrontV2.StorefrontInitialized value.
+func DecodeStorefrontInitialized(value cadence.Value) (StorefrontInitialized, error) {
+	var result StorefrontInitialized
+
+	fields, err := cadenceconv.Fields(value, StorefrontInitializedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.StorefrontInitialized: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v StorefrontInitialized) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+	}).WithType(StorefrontInitializedType(address))
+}
+
+// ListingAvailable is the NFTStorefrontV2.ListingAvailable event.
+type ListingAvailable struct {
+	StorefrontAddress    cadence.Address
+	ListingResourceID    uint64
+	NFTType              cadence.Type
+	NFTUUID              uint64
+	NFTID                uint64
+	SalePaymentVaultType cadence.Type
+	SalePrice            cadence.UFix64
+	CustomID             *string
+	CommissionAmount     cadence.UFix64
+	CommissionReceivers  *[]cadence.Address
+	Expiry               uint64
+}
+
+// ListingAvailableQualifiedIdentifier is the qualified identifier of ListingAvailable.
+const ListingAvailableQualifiedIdentifier = "NFTStorefrontV2.ListingAvailable"
+
+// ListingAvailableEventType returns the type ID of ListingAvailable events emitted by
+// the contract deployed to the given address.
+func ListingAvailableEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, ListingAvailableQualifiedIdentifier))
+}
+
+// ListingAvailableType returns the Cadence type of ListingAvailable for the contract
+// deployed to the given address.
+func ListingAvailableType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		ListingAvailableQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontAddress", Type: cadence.AddressType},
+			{Identifier: "listingResourceID", Type: cadence.UInt64Type},
+			{Identifier: "nftType", Type: cadence.MetaType},
+			{Identifier: "nftUUID", Type: cadence.UInt64Type},
+			{Identifier: "nftID", Type: cadence.UInt64Type},
+			{Identifier: "salePaymentVaultType", Type: cadence.MetaType},
+			{Identifier: "salePrice", Type: cadence.UFix64Type},
+			{Identifier: "customID", Type: cadence.NewOptionalType(cadence.StringType)},
+			{Identifier: "commissionAmount", Type: cadence.UFix64Type},
+			{Identifier: "commissionReceivers", Type: cadence.NewOptionalType(cadence.NewVariableSizedArrayType(cadence.AddressType))},
+			{Identifier: "expiry", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeListingAvailable decodes a NFTStorefrontV2.ListingAvailable value.
+func DecodeListingAvailable(value cadence.Value) (ListingAvailable, error) {
+	var result ListingAvailable
+
+	fields, err := cadenceconv.Fields(value, ListingAvailableQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontAddress, err = cadenceconv.Field(fields, "storefrontAddress", cadenceconv.Address)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.ListingResourceID, err = cadenceconv.Field(fields, "listingResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.NFTType, err = cadenceconv.Field(fields, "nftType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.NFTUUID, err = cadenceconv.Field(fields, "nftUUID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.SalePaymentVaultType, err = cadenceconv.Field(fields, "salePaymentVaultType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.SalePrice, err = cadenceconv.Field(fields, "salePrice", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.CustomID, err = cadenceconv.Field(fields, "customID", cadenceconv.OptionalOf(cadenceconv.String))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.CommissionAmount, err = cadenceconv.Field(fields, "commissionAmount", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.CommissionReceivers, err = cadenceconv.Field(fields, "commissionReceivers", cadenceconv.OptionalOf(cadenceconv.ArrayOf(cadenceconv.Address)))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	result.Expiry, err = cadenceconv.Field(fields, "expiry", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingAvailable: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v ListingAvailable) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeAddress(v.StorefrontAddress),
+		cadenceconv.EncodeUInt64(v.ListingResourceID),
+		cadenceconv.EncodeType(v.NFTType),
+		cadenceconv.EncodeUInt64(v.NFTUUID),
+		cadenceconv.EncodeUInt64(v.NFTID),
+		cadenceconv.EncodeType(v.SalePaymentVaultType),
+		cadenceconv.EncodeUFix64(v.SalePrice),
+		cadenceconv.EncodeOptionalOf(cadenceconv.EncodeString)(v.CustomID),
+		cadenceconv.EncodeUFix64(v.CommissionAmount),
+		cadenceconv.EncodeOptionalOf(cadenceconv.EncodeArrayOf(cadence.AddressType, cadenceconv.EncodeAddress))(v.CommissionReceivers),
+		cadenceconv.EncodeUInt64(v.Expiry),
+	}).WithType(ListingAvailableType(address))
+}
+
+// ListingCompleted is the NFTStorefrontV2.ListingCompleted event.
+type ListingCompleted struct {
+	ListingResourceID    uint64
+	StorefrontResourceID uint64
+	Purchased            bool
+	NFTType              cadence.Type
+	NFTUUID              uint64
+	NFTID                uint64
+	SalePaymentVaultType cadence.Type
+	SalePrice            cadence.UFix64
+	CustomID             *string
+	CommissionAmount     cadence.UFix64
+	CommissionReceiver   *cadence.Address
+	Expiry               uint64
+}
+
+// ListingCompletedQualifiedIdentifier is the qualified identifier of ListingCompleted.
+const ListingCompletedQualifiedIdentifier = "NFTStorefrontV2.ListingCompleted"
+
+// ListingCompletedEventType returns the type ID of ListingCompleted events emitted by
+// the contract deployed to the given address.
+func ListingCompletedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, ListingCompletedQualifiedIdentifier))
+}
+
+// ListingCompletedType returns the Cadence type of ListingCompleted for the contract
+// deployed to the given address.
+func ListingCompletedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		ListingCompletedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "listingResourceID", Type: cadence.UInt64Type},
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+			{Identifier: "purchased", Type: cadence.BoolType},
+			{Identifier: "nftType", Type: cadence.MetaType},
+			{Identifier: "nftUUID", Type: cadence.UInt64Type},
+			{Identifier: "nftID", Type: cadence.UInt64Type},
+			{Identifier: "salePaymentVaultType", Type: cadence.MetaType},
+			{Identifier: "salePrice", Type: cadence.UFix64Type},
+			{Identifier: "customID", Type: cadence.NewOptionalType(cadence.StringType)},
+			{Identifier: "commissionAmount", Type: cadence.UFix64Type},
+			{Identifier: "commissionReceiver", Type: cadence.NewOptionalType(cadence.AddressType)},
+			{Identifier: "expiry", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeListingCompleted decodes a NFTStorefrontV2.ListingCompleted value.
+func DecodeListingCompleted(value cadence.Value) (ListingCompleted, error) {
+	var result ListingCompleted
+
+	fields, err := cadenceconv.Fields(value, ListingCompletedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.ListingResourceID, err = cadenceconv.Field(fields, "listingResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.Purchased, err = cadenceconv.Field(fields, "purchased", cadenceconv.Bool)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.NFTType, err = cadenceconv.Field(fields, "nftType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.NFTUUID, err = cadenceconv.Field(fields, "nftUUID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.SalePaymentVaultType, err = cadenceconv.Field(fields, "salePaymentVaultType", cadenceconv.Type)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.SalePrice, err = cadenceconv.Field(fields, "salePrice", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.CustomID, err = cadenceconv.Field(fields, "customID", cadenceconv.OptionalOf(cadenceconv.String))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.CommissionAmount, err = cadenceconv.Field(fields, "commissionAmount", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.CommissionReceiver, err = cadenceconv.Field(fields, "commissionReceiver", cadenceconv.OptionalOf(cadenceconv.Address))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	result.Expiry, err = cadenceconv.Field(fields, "expiry", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.ListingCompleted: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v ListingCompleted) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.ListingResourceID),
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+		cadenceconv.EncodeBool(v.Purchased),
+		cadenceconv.EncodeType(v.NFTType),
+		cadenceconv.EncodeUInt64(v.NFTUUID),
+		cadenceconv.EncodeUInt64(v.NFTID),
+		cadenceconv.EncodeType(v.SalePaymentVaultType),
+		cadenceconv.EncodeUFix64(v.SalePrice),
+		cadenceconv.EncodeOptionalOf(cadenceconv.EncodeString)(v.CustomID),
+		cadenceconv.EncodeUFix64(v.CommissionAmount),
+		cadenceconv.EncodeOptionalOf(cadenceconv.EncodeAddress)(v.CommissionReceiver),
+		cadenceconv.EncodeUInt64(v.Expiry),
+	}).WithType(ListingCompletedType(address))
+}
+
+// UnpaidReceiver is the NFTStorefrontV2.UnpaidReceiver event.
+type UnpaidReceiver struct {
+	Receiver        cadence.Address
+	EntitledSaleCut cadence.UFix64
+}
+
+// UnpaidReceiverQualifiedIdentifier is the qualified identifier of UnpaidReceiver.
+const UnpaidReceiverQualifiedIdentifier = "NFTStorefrontV2.UnpaidReceiver"
+
+// UnpaidReceiverEventType returns the type ID of UnpaidReceiver events emitted by
+// the contract deployed to the given address.
+func UnpaidReceiverEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, UnpaidReceiverQualifiedIdentifier))
+}
+
+// UnpaidReceiverType returns the Cadence type of UnpaidReceiver for the contract
+// deployed to the given address.
+func UnpaidReceiverType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		UnpaidReceiverQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "receiver", Type: cadence.AddressType},
+			{Identifier: "entitledSaleCut", Type: cadence.UFix64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeUnpaidReceiver decodes a NFTStorefrontV2.UnpaidReceiver value.
+func DecodeUnpaidReceiver(value cadence.Value) (UnpaidReceiver, error) {
+	var result UnpaidReceiver
+
+	fields, err := cadenceconv.Fields(value, UnpaidReceiverQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.Receiver, err = cadenceconv.Field(fields, "receiver", cadenceconv.Address)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.UnpaidReceiver: %w", err)
+	}
+
+	result.EntitledSaleCut, err = cadenceconv.Field(fields, "entitledSaleCut", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.UnpaidReceiver: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v UnpaidReceiver) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeAddress(v.Receiver),
+		cadenceconv.EncodeUFix64(v.EntitledSaleCut),
+	}).WithType(UnpaidReceiverType(address))
+}
+
+// ListingResourceDestroyed is the NFTStorefrontV2.Listing.ResourceDestroyed event.
+type ListingResourceDestroyed struct {
+	ListingResourceID    uint64
+	StorefrontResourceID uint64
+	Purchased            bool
+	NFTType              string
+	NFTUUID              uint64
+	NFTID                uint64
+	SalePaymentVaultType string
+	SalePrice            cadence.UFix64
+	CustomID             *string
+	CommissionAmount     cadence.UFix64
+	CommissionReceiver   *cadence.Address
+	Expiry               uint64
+}
+
+// ListingResourceDestroyedQualifiedIdentifier is the qualified identifier of ListingResourceDestroyed.
+const ListingResourceDestroyedQualifiedIdentifier = "NFTStorefrontV2.Listing.ResourceDestroyed"
+
+// ListingResourceDestroyedEventType returns the type ID of ListingResourceDestroyed events emitted by
+// the contract deployed to the given address.
+func ListingResourceDestroyedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, ListingResourceDestroyedQualifiedIdentifier))
+}
+
+// ListingResourceDestroyedType returns the Cadence type of ListingResourceDestroyed for the contract
+// deployed to the given address.
+func ListingResourceDestroyedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		ListingResourceDestroyedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "listingResourceID", Type: cadence.UInt64Type},
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+			{Identifier: "purchased", Type: cadence.BoolType},
+			{Identifier: "nftType", Type: cadence.StringType},
+			{Identifier: "nftUUID", Type: cadence.UInt64Type},
+			{Identifier: "nftID", Type: cadence.UInt64Type},
+			{Identifier: "salePaymentVaultType", Type: cadence.StringType},
+			{Identifier: "salePrice", Type: cadence.UFix64Type},
+			{Identifier: "customID", Type: cadence.NewOptionalType(cadence.StringType)},
+			{Identifier: "commissionAmount", Type: cadence.UFix64Type},
+			{Identifier: "commissionReceiver", Type: cadence.NewOptionalType(cadence.AddressType)},
+			{Identifier: "expiry", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeListingResourceDestroyed decodes a NFTStorefrontV2.Listing.ResourceDestroyed value.
+func DecodeListingResourceDestroyed(value cadence.Value) (ListingResourceDestroyed, error) {
+	var result ListingResourceDestroyed
+
+	fields, err := cadenceconv.Fields(value, ListingResourceDestroyedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.ListingResourceID, err = cadenceconv.Field(fields, "listingResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.Purchased, err = cadenceconv.Field(fields, "purchased", cadenceconv.Bool)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.NFTType, err = cadenceconv.Field(fields, "nftType", cadenceconv.String)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.NFTUUID, err = cadenceconv.Field(fields, "nftUUID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.SalePaymentVaultType, err = cadenceconv.Field(fields, "salePaymentVaultType", cadenceconv.String)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.SalePrice, err = cadenceconv.Field(fields, "salePrice", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.CustomID, err = cadenceconv.Field(fields, "customID", cadenceconv.OptionalOf(cadenceconv.String))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.CommissionAmount, err = cadenceconv.Field(fields, "commissionAmount", cadenceconv.UFix64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.CommissionReceiver, err = cadenceconv.Field(fields, "commissionReceiver", cadenceconv.OptionalOf(cadenceconv.Address))
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	result.Expiry, err = cadenceconv.Field(fields, "expiry", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Listing.ResourceDestroyed: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v ListingResourceDestroyed) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.ListingResourceID),
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+		cadenceconv.EncodeBool(v.Purchased),
+		cadenceconv.EncodeString(v.NFTType),
+		cadenceconv.EncodeUInt64(v.NFTUUID),
+		cadenceconv.EncodeUInt64(v.NFTID),
+		cadenceconv.EncodeString(v.SalePaymentVaultType),
+		cadenceconv.EncodeUFix64(v.SalePrice),
+		cadenceconv.EncodeOptionalOf(cadenceconv.EncodeString)(v.CustomID),
+		cadenceconv.EncodeUFix64(v.CommissionAmount),
+		cadenceconv.EncodeOptionalOf(cadenceconv.EncodeAddress)(v.CommissionReceiver),
+		cadenceconv.EncodeUInt64(v.Expiry),
+	}).WithType(ListingResourceDestroyedType(address))
+}
+
+// StorefrontResourceDestroyed is the NFTStorefrontV2.Storefront.ResourceDestroyed event.
+type StorefrontResourceDestroyed struct {
+	StorefrontResourceID uint64
+}
+
+// StorefrontResourceDestroyedQualifiedIdentifier is the qualified identifier of StorefrontResourceDestroyed.
+const StorefrontResourceDestroyedQualifiedIdentifier = "NFTStorefrontV2.Storefront.ResourceDestroyed"
+
+// StorefrontResourceDestroyedEventType returns the type ID of StorefrontResourceDestroyed events emitted by
+// the contract deployed to the given address.
+func StorefrontResourceDestroyedEventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, StorefrontResourceDestroyedQualifiedIdentifier))
+}
+
+// StorefrontResourceDestroyedType returns the Cadence type of StorefrontResourceDestroyed for the contract
+// deployed to the given address.
+func StorefrontResourceDestroyedType(address cadence.Address) *cadence.EventType {
+	return cadence.NewEventType(
+		cadenceconv.Location(address, ContractName),
+		StorefrontResourceDestroyedQualifiedIdentifier,
+		[]cadence.Field{
+			{Identifier: "storefrontResourceID", Type: cadence.UInt64Type},
+		},
+		nil,
+	)
+}
+
+// DecodeStorefrontResourceDestroyed decodes a NFTStorefrontV2.Storefront.ResourceDestroyed value.
+func DecodeStorefrontResourceDestroyed(value cadence.Value) (StorefrontResourceDestroyed, error) {
+	var result StorefrontResourceDestroyed
+
+	fields, err := cadenceconv.Fields(value, StorefrontResourceDestroyedQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.StorefrontResourceID, err = cadenceconv.Field(fields, "storefrontResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("NFTStorefrontV2.Storefront.ResourceDestroyed: %w", err)
+	}
+
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v StorefrontResourceDestroyed) Encode(address cadence.Address) cadence.Event {
+	return cadence.NewEvent([]cadence.Value{
+		cadenceconv.EncodeUInt64(v.StorefrontResourceID),
+	}).WithType(StorefrontResourceDestroyedType(address))
+}
@@ -0,0 +1,72 @@
+package nftstorefrontv2_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+)
+
+var storefrontAddress = cadence.BytesToAddress([]byte{0x4e, 0xb8, 0xa1, 0x0c, 0xb9, 0xf8, 0x73, 0x57})
+
+func TestListingAvailableRoundTrip(t *testing.T) {
+	customID := "flowty"
+	receivers := []cadence.Address{cadence.BytesToAddress([]byte{1})}
+
+	event := nftstorefrontv2.ListingAvailable{
+		StorefrontAddress:    cadence.BytesToAddress([]byte{2}),
+		ListingResourceID:    42,
+		NFTType:              cadence.NewStructType(nil, "ExampleNFT.NFT", nil, nil),
+		NFTUUID:              7,
+		NFTID:                1,
+		SalePaymentVaultType: cadence.NewStructType(nil, "FlowToken.Vault", nil, nil),
+		SalePrice:            cadence.UFix64(10_00000000),
+		CustomID:             &customID,
+		CommissionAmount:     cadence.UFix64(50000000),
+		CommissionReceivers:  &receivers,
+		Expiry:               1_700_000_000,
+	}
+
+	encoded, err := jsoncdc.Encode(event.Encode(storefrontAddress))
+	require.NoError(t, err)
+
+	value, err := jsoncdc.Decode(nil, encoded)
+	require.NoError(t, err)
+
+	decoded, err := nftstorefrontv2.DecodeListingAvailable(value)
+	require.NoError(t, err)
+
+	assert.Equal(t, event.ListingResourceID, decoded.ListingResourceID)
+	assert.Equal(t, event.SalePrice, decoded.SalePrice)
+	assert.Equal(t, event.CustomID, decoded.CustomID)
+	assert.Equal(t, event.CommissionReceivers, decoded.CommissionReceivers)
+	assert.Equal(t, "ExampleNFT.NFT", decoded.NFTType.ID())
+
+	assert.Equal(t, "A.4eb8a10cb9f87357.NFTStorefrontV2.ListingAvailable", nftstorefrontv2.ListingAvailableEventType(storefrontAddress))
+}
+
+func TestListingDetailsRoundTrip(t *testing.T) {
+	details := nftstorefrontv2.ListingDetails{
+		StorefrontID:         3,
+		NFTType:              cadence.MetaType,
+		SalePaymentVaultType: cadence.MetaType,
+		SalePrice:            cadence.UFix64(5_00000000),
+		SaleCuts: []nftstorefrontv2.SaleCut{
+			{
+				Receiver: cadence.NewCapability(1, cadence.BytesToAddress([]byte{3}), nil),
+				Amount:   cadence.UFix64(5_00000000),
+			},
+		},
+	}
+
+	decoded, err := nftstorefrontv2.DecodeListingDetails(details.Encode(storefrontAddress))
+	require.NoError(t, err)
+	assert.Equal(t, details, decoded)
+
+	_, err = nftstorefrontv2.DecodeSaleCut(details.Encode(storefrontAddress))
+	assert.EqualError(t, err, "expected NFTStorefrontV2.SaleCut, got A.4eb8a10cb9f87357.NFTStorefrontV2.ListingDetails")
+}
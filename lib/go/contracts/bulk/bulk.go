@@ -0,0 +1,345 @@
+// Package bulk lists many NFTs of a collection at once, in transactions
+// creating several listings each.
+//
+// transactions/sell_item.cdc lists one NFT per transaction. A Planner splits
+// rows of (NFT ID, price, expiry, custom ID) into batches whose estimated
+// computation fits under the computation limit of a transaction, and builds
+// the transaction of each batch. A Lister sends the batches, splits again
+// the ones that still exceed the limit, and reports the outcome of each row
+// by matching the ListingAvailable events the transactions emit.
+package bulk
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txerrors"
+)
+
+// The default estimates are twice the computation measured on the emulator
+// for ExampleNFT listings with one royalty, about 36 per transaction and 8
+// per listing, as NFTs resolving more royalties and views cost more. The
+// TestBulkComputation test of lib/go/test checks them.
+const (
+	// DefaultBaseComputation is the estimated computation of a transaction
+	// listing no NFT: the storefront, metadata view and capability lookups.
+	DefaultBaseComputation uint64 = 72
+	// DefaultListingComputation is the estimated computation of each
+	// listing, royalties included. Budget.Observe raises it when a
+	// transaction uses more.
+	DefaultListingComputation uint64 = 16
+)
+
+// Row is an NFT to list.
+type Row struct {
+	NFTID    uint64
+	Price    cadence.UFix64
+	Expiry   uint64
+	CustomID *string
+}
+
+// Options are the settings shared by all the listings of a bulk listing.
+type Options struct {
+	// NFTTypeIdentifier is the type identifier of the NFTs, for example
+	// A.0b2a3299cc857e29.TopShot.NFT.
+	NFTTypeIdentifier string
+	// TokenTypeIdentifier is the type identifier of the payment vault, for
+	// example A.1654653399040a61.FlowToken.Vault.
+	TokenTypeIdentifier string
+	CommissionAmount    cadence.UFix64
+	MarketplacesAddress []cadence.Address
+}
+
+// Budget estimates the computation of bulk listing transactions.
+type Budget struct {
+	// Limit is the computation limit of each transaction, or zero for
+	// txbuilder.DefaultGasLimit.
+	Limit uint64
+	// Base is the computation of a transaction listing no NFT, or zero for
+	// DefaultBaseComputation.
+	Base uint64
+	// Listing is the computation of each listing, or zero for
+	// DefaultListingComputation.
+	Listing uint64
+	// MaxListings caps the number of listings of a transaction, or zero for
+	// no cap beyond the computation limit.
+	MaxListings int
+}
+
+func (b Budget) limit() uint64 {
+	if b.Limit == 0 {
+		return txbuilder.DefaultGasLimit
+	}
+	return b.Limit
+}
+
+func (b Budget) base() uint64 {
+	if b.Base == 0 {
+		return DefaultBaseComputation
+	}
+	return b.Base
+}
+
+func (b Budget) listing() uint64 {
+	if b.Listing == 0 {
+		return DefaultListingComputation
+	}
+	return b.Listing
+}
+
+// Estimate returns the estimated computation of a transaction creating the
+// given number of listings.
+func (b Budget) Estimate(listings int) uint64 {
+	return b.base() + uint64(listings)*b.listing()
+}
+
+// Capacity returns the number of listings a transaction can create within
+// the computation limit, at least one.
+func (b Budget) Capacity() int {
+	capacity := 1
+	if limit, base := b.limit(), b.base(); limit > base {
+		capacity = max(int((limit-base)/b.listing()), 1)
+	}
+	if b.MaxListings > 0 {
+		capacity = min(capacity, b.MaxListings)
+	}
+	return capacity
+}
+
+// Observe raises the estimated computation of a listing when a transaction
+// creating the given number of listings used more computation than
+// estimated. The estimate is never lowered, so that it stays conservative.
+func (b *Budget) Observe(listings int, computationUsage uint64) {
+	if listings <= 0 || computationUsage <= b.base() {
+		return
+	}
+	n := uint64(listings)
+	perListing := (computationUsage - b.base() + n - 1) / n
+	if perListing > b.listing() {
+		b.Listing = perListing
+	}
+}
+
+// Batch is the rows listed by a transaction.
+type Batch struct {
+	// Offset is the index of the first row of the batch in the planned
+	// rows.
+	Offset int
+	Rows   []Row
+}
+
+// Split returns the two halves of the batch.
+func (b Batch) Split() (Batch, Batch) {
+	return b.splitAt(len(b.Rows) / 2)
+}
+
+func (b Batch) splitAt(n int) (Batch, Batch) {
+	return Batch{Offset: b.Offset, Rows: b.Rows[:n]},
+		Batch{Offset: b.Offset + n, Rows: b.Rows[n:]}
+}
+
+// Planner plans and builds the transactions of bulk listings.
+type Planner struct {
+	options   Options
+	contract  cadence.Address
+	script    []byte
+	signature *arguments.Signature
+	// Budget sizes the batches.
+	Budget Budget
+}
+
+// NewPlanner returns a planner of the listings with the given options on
+// the given network.
+func NewPlanner(config *flowconfig.Config, network string, options Options) (*Planner, error) {
+	if options.NFTTypeIdentifier == "" {
+		return nil, fmt.Errorf("nftTypeIdentifier is empty")
+	}
+	if options.TokenTypeIdentifier == "" {
+		return nil, fmt.Errorf("ftTypeIdentifier is empty")
+	}
+
+	contract, err := config.Address(nftstorefrontv2.ContractName, network)
+	if err != nil {
+		return nil, err
+	}
+	script, err := config.ResolveImports([]byte(listTransaction), network)
+	if err != nil {
+		return nil, fmt.Errorf("bulk listing transaction: %w", err)
+	}
+	signature, err := arguments.Parse([]byte(listTransaction))
+	if err != nil {
+		return nil, fmt.Errorf("bulk listing transaction: %w", err)
+	}
+
+	return &Planner{
+		options:   options,
+		contract:  contract,
+		script:    script,
+		signature: signature,
+	}, nil
+}
+
+// Transaction returns the bulk listing transaction, with its imports
+// resolved.
+func (p *Planner) Transaction() []byte {
+	return p.script
+}
+
+// Check checks the rows before they are planned. The contract would reject
+// a zero price, and a commission above the price underflows the seller cut;
+// an NFT listed twice would be matched to a single ListingAvailable event.
+func (p *Planner) Check(rows []Row) error {
+	seen := make(map[uint64]int, len(rows))
+	for i, row := range rows {
+		if row.Price == 0 {
+			return fmt.Errorf("row %d: NFT %d has a zero price", i, row.NFTID)
+		}
+		if p.options.CommissionAmount > row.Price {
+			return fmt.Errorf("row %d: commission %s exceeds the price %s of NFT %d", i, p.options.CommissionAmount, row.Price, row.NFTID)
+		}
+		if j, ok := seen[row.NFTID]; ok {
+			return fmt.Errorf("row %d: NFT %d is already in row %d", i, row.NFTID, j)
+		}
+		seen[row.NFTID] = i
+	}
+	return nil
+}
+
+// Plan checks the rows and splits them into batches of at most
+// Budget.Capacity rows, in order.
+func (p *Planner) Plan(rows []Row) ([]Batch, error) {
+	if err := p.Check(rows); err != nil {
+		return nil, err
+	}
+
+	capacity := p.Budget.Capacity()
+	var batches []Batch
+	for start := 0; start < len(rows); start += capacity {
+		batches = append(batches, Batch{
+			Offset: start,
+			Rows:   rows[start:min(start+capacity, len(rows))],
+		})
+	}
+	return batches, nil
+}
+
+// Arguments returns the arguments of the bulk listing transaction listing
+// the rows of the batch.
+func (p *Planner) Arguments(batch Batch) ([]cadence.Value, error) {
+	ids := make([]cadence.Value, len(batch.Rows))
+	prices := make([]cadence.Value, len(batch.Rows))
+	customIDs := make([]cadence.Value, len(batch.Rows))
+	expiries := make([]cadence.Value, len(batch.Rows))
+	for i, row := range batch.Rows {
+		ids[i] = cadence.NewUInt64(row.NFTID)
+		prices[i] = row.Price
+		customIDs[i] = cadence.NewOptional(nil)
+		if row.CustomID != nil {
+			customIDs[i] = cadence.NewOptional(cadence.String(*row.CustomID))
+		}
+		expiries[i] = cadence.NewUInt64(row.Expiry)
+	}
+
+	marketplaces := make([]cadence.Value, len(p.options.MarketplacesAddress))
+	for i, address := range p.options.MarketplacesAddress {
+		marketplaces[i] = address
+	}
+
+	values := []cadence.Value{
+		cadence.NewArray(ids).WithType(cadence.NewVariableSizedArrayType(cadence.UInt64Type)),
+		cadence.NewArray(prices).WithType(cadence.NewVariableSizedArrayType(cadence.UFix64Type)),
+		cadence.NewArray(customIDs).WithType(cadence.NewVariableSizedArrayType(cadence.NewOptionalType(cadence.StringType))),
+		cadence.NewArray(expiries).WithType(cadence.NewVariableSizedArrayType(cadence.UInt64Type)),
+		p.options.CommissionAmount,
+		cadence.NewArray(marketplaces).WithType(cadence.NewVariableSizedArrayType(cadence.AddressType)),
+		cadence.String(p.options.NFTTypeIdentifier),
+		cadence.String(p.options.TokenTypeIdentifier),
+	}
+	if err := p.signature.Check(values); err != nil {
+		return nil, fmt.Errorf("bulk listing transaction: %w", err)
+	}
+	return values, nil
+}
+
+// Build returns the unsigned transaction listing the rows of the batch in
+// the storefront of the seller, who authorizes it. Its computation limit is
+// Budget.Limit.
+func (p *Planner) Build(batch Batch, seller cadence.Address, roles txbuilder.Roles) (*txbuilder.Transaction, error) {
+	args, err := p.Arguments(batch)
+	if err != nil {
+		return nil, err
+	}
+	roles.GasLimit = p.Budget.Limit
+	roles.Authorizers = []cadence.Address{seller}
+	return txbuilder.NewTransaction(p.script, args, roles)
+}
+
+// Result is the outcome of listing a row.
+type Result struct {
+	Row Row
+	// Index is the index of the row in the planned rows.
+	Index int
+	// TransactionID is the transaction that listed the row, or the last one
+	// that tried to.
+	TransactionID flowclient.Identifier
+	// ListingResourceID is the listing created for the row, if Err is nil.
+	ListingResourceID uint64
+	// Err is why the row is not listed: the error of the transaction, or
+	// txerrors.ErrNFTMissing if the transaction skipped the NFT.
+	Err error
+}
+
+// Listed reports whether a listing was created for the row.
+func (r Result) Listed() bool {
+	return r.Err == nil
+}
+
+// Match returns the outcome of each row of the batch from the result of its
+// transaction: the rows of a failed transaction fail with its error, and
+// the rows of a successful one are listed if it emitted a ListingAvailable
+// event for their NFT in the storefront of the seller.
+func (p *Planner) Match(batch Batch, seller cadence.Address, result *flowclient.TransactionResult) ([]Result, error) {
+	results := make([]Result, len(batch.Rows))
+	for i, row := range batch.Rows {
+		results[i] = Result{Row: row, Index: batch.Offset + i, TransactionID: result.TransactionID}
+	}
+
+	if err := txerrors.FromResult(result); err != nil {
+		for i := range results {
+			results[i].Err = err
+		}
+		return results, nil
+	}
+
+	listings := map[uint64]uint64{}
+	eventType := nftstorefrontv2.ListingAvailableEventType(p.contract)
+	for _, event := range result.Events {
+		if event.Type != eventType {
+			continue
+		}
+		listing, err := nftstorefrontv2.DecodeListingAvailable(event.Value)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", result.TransactionID, err)
+		}
+		if listing.StorefrontAddress != seller || listing.NFTType == nil || listing.NFTType.ID() != p.options.NFTTypeIdentifier {
+			continue
+		}
+		listings[listing.NFTID] = listing.ListingResourceID
+	}
+
+	for i := range results {
+		id, ok := listings[results[i].Row.NFTID]
+		if !ok {
+			results[i].Err = fmt.Errorf("NFT %d: %w", results[i].Row.NFTID, txerrors.ErrNFTMissing)
+			continue
+		}
+		results[i].ListingResourceID = id
+	}
+	return results, nil
+}
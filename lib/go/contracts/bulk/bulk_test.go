@@ -0,0 +1,333 @@
+package bulk_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/bulk"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txerrors"
+)
+
+var (
+	storefrontContract = testfixtures.StorefrontContract
+	seller             = testfixtures.Seller
+	marketplace        = testfixtures.Marketplace
+)
+
+const (
+	nftType   = "A.f8d6e0586b0a20c7.ExampleNFT.NFT"
+	tokenType = "A.0ae53cb6e3f42a79.FlowToken.Vault"
+)
+
+func newPlanner(t *testing.T) *bulk.Planner {
+	t.Helper()
+
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+	planner, err := bulk.NewPlanner(config, "emulator", bulk.Options{
+		NFTTypeIdentifier:   nftType,
+		TokenTypeIdentifier: tokenType,
+		CommissionAmount:    cadence.UFix64(1_00000000),
+		MarketplacesAddress: []cadence.Address{marketplace},
+	})
+	require.NoError(t, err)
+	return planner
+}
+
+func rows(ids ...uint64) []bulk.Row {
+	result := make([]bulk.Row, len(ids))
+	for i, id := range ids {
+		result[i] = bulk.Row{NFTID: id, Price: cadence.UFix64(id * 10_00000000), Expiry: 2_000_000_000}
+	}
+	return result
+}
+
+func nftIDs(batches []bulk.Batch) [][]uint64 {
+	var result [][]uint64
+	for _, batch := range batches {
+		var ids []uint64
+		for _, row := range batch.Rows {
+			ids = append(ids, row.NFTID)
+		}
+		result = append(result, ids)
+	}
+	return result
+}
+
+func TestBudget(t *testing.T) {
+	var budget bulk.Budget
+	assert.Equal(t, bulk.DefaultBaseComputation+3*bulk.DefaultListingComputation, budget.Estimate(3))
+	assert.Equal(t, int((txbuilder.DefaultGasLimit-bulk.DefaultBaseComputation)/bulk.DefaultListingComputation), budget.Capacity())
+
+	budget = bulk.Budget{Limit: 450, Base: 150, Listing: 100}
+	assert.Equal(t, 3, budget.Capacity())
+	budget.MaxListings = 2
+	assert.Equal(t, 2, budget.Capacity())
+
+	budget = bulk.Budget{Limit: 100, Base: 150, Listing: 100}
+	assert.Equal(t, 1, budget.Capacity())
+
+	budget = bulk.Budget{Limit: 450, Base: 150, Listing: 100}
+	budget.Observe(2, 300)
+	assert.Equal(t, uint64(100), budget.Listing)
+	budget.Observe(2, 371)
+	assert.Equal(t, uint64(111), budget.Listing)
+	assert.Equal(t, 2, budget.Capacity())
+}
+
+func TestPlan(t *testing.T) {
+	planner := newPlanner(t)
+	planner.Budget = bulk.Budget{Limit: 450, Base: 150, Listing: 100}
+
+	batches, err := planner.Plan(rows(1, 2, 3, 4, 5, 6, 7))
+	require.NoError(t, err)
+	assert.Equal(t, [][]uint64{{1, 2, 3}, {4, 5, 6}, {7}}, nftIDs(batches))
+	assert.Equal(t, 3, batches[1].Offset)
+
+	first, second := batches[0].Split()
+	assert.Equal(t, [][]uint64{{1}, {2, 3}}, nftIDs([]bulk.Batch{first, second}))
+	assert.Equal(t, 1, second.Offset)
+
+	batches, err = planner.Plan(nil)
+	require.NoError(t, err)
+	assert.Empty(t, batches)
+}
+
+func TestCheck(t *testing.T) {
+	planner := newPlanner(t)
+
+	invalid := rows(1, 2)
+	invalid[1].Price = 0
+	assert.EqualError(t, planner.Check(invalid), "row 1: NFT 2 has a zero price")
+
+	invalid = rows(1, 2)
+	invalid[0].Price = cadence.UFix64(50000000)
+	assert.EqualError(t, planner.Check(invalid), "row 0: commission 1.00000000 exceeds the price 0.50000000 of NFT 1")
+
+	assert.EqualError(t, planner.Check(rows(1, 2, 1)), "row 2: NFT 1 is already in row 0")
+}
+
+func TestBuild(t *testing.T) {
+	planner := newPlanner(t)
+	assert.Contains(t, string(planner.Transaction()), "import NFTStorefrontV2 from 0xf8d6e0586b0a20c7")
+
+	customID := "dapp"
+	batch := bulk.Batch{Rows: rows(1, 2)}
+	batch.Rows[1].CustomID = &customID
+
+	roles := txbuilder.Roles{
+		Proposer: flowclient.ProposalKey{Address: seller, SequenceNumber: 7},
+		Payer:    seller,
+	}
+	tx, err := planner.Build(batch, seller, roles)
+	require.NoError(t, err)
+	assert.Equal(t, planner.Transaction(), tx.Script)
+	assert.Equal(t, []cadence.Address{seller}, tx.Authorizers)
+	assert.Equal(t, txbuilder.DefaultGasLimit, tx.GasLimit)
+
+	require.Len(t, tx.Arguments, 8)
+	assert.Equal(t, "[1, 2]", tx.Arguments[0].String())
+	assert.Equal(t, "[10.00000000, 20.00000000]", tx.Arguments[1].String())
+	assert.Equal(t, `[nil, "dapp"]`, tx.Arguments[2].String())
+	assert.Equal(t, "[2000000000, 2000000000]", tx.Arguments[3].String())
+	assert.Equal(t, cadence.UFix64(1_00000000), tx.Arguments[4])
+	assert.Equal(t, "[0x0000000000000003]", tx.Arguments[5].String())
+	assert.Equal(t, cadence.String(nftType), tx.Arguments[6])
+	assert.Equal(t, cadence.String(tokenType), tx.Arguments[7])
+
+	planner.Budget.Limit = 5000
+	tx, err = planner.Build(batch, seller, roles)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5000), tx.GasLimit)
+}
+
+func listingAvailable(listingResourceID, nftID uint64, storefront cadence.Address) flowclient.Event {
+	event := nftstorefrontv2.ListingAvailable{
+		StorefrontAddress:    storefront,
+		ListingResourceID:    listingResourceID,
+		NFTType:              testfixtures.ExampleNFT,
+		NFTID:                nftID,
+		SalePaymentVaultType: cadence.NewResourceType(cadenceconv.Location(cadence.BytesToAddress([]byte{0x0a, 0xe5, 0x3c, 0xb6, 0xe3, 0xf4, 0x2a, 0x79}), "FlowToken"), "FlowToken.Vault", nil, nil),
+		SalePrice:            cadence.UFix64(nftID * 10_00000000),
+		CommissionAmount:     cadence.UFix64(1_00000000),
+		Expiry:               2_000_000_000,
+	}
+	return flowclient.Event{
+		Type:  nftstorefrontv2.ListingAvailableEventType(storefrontContract),
+		Value: event.Encode(storefrontContract),
+	}
+}
+
+func TestMatch(t *testing.T) {
+	planner := newPlanner(t)
+	batch := bulk.Batch{Offset: 10, Rows: rows(1, 2, 3)}
+	id := flowclient.Identifier{0x01}
+
+	results, err := planner.Match(batch, seller, &flowclient.TransactionResult{
+		TransactionID: id,
+		Status:        flowclient.StatusSealed,
+		Events: []flowclient.Event{
+			listingAvailable(100, 1, seller),
+			// The same NFT ID listed in another storefront.
+			listingAvailable(101, 2, marketplace),
+			listingAvailable(102, 3, seller),
+		},
+	})
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.True(t, results[0].Listed())
+	assert.Equal(t, uint64(100), results[0].ListingResourceID)
+	assert.Equal(t, 10, results[0].Index)
+	assert.Equal(t, id, results[0].TransactionID)
+
+	assert.False(t, results[1].Listed())
+	assert.ErrorIs(t, results[1].Err, txerrors.ErrNFTMissing)
+	assert.EqualError(t, results[1].Err, "NFT 2: nft missing from collection")
+	assert.Equal(t, 11, results[1].Index)
+
+	assert.Equal(t, uint64(102), results[2].ListingResourceID)
+
+	results, err = planner.Match(batch, seller, &flowclient.TransactionResult{
+		TransactionID: id,
+		Status:        flowclient.StatusSealed,
+		ErrorMessage:  "[Error Code: 1110] computation exceeds limit (9999)",
+	})
+	require.NoError(t, err)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, txerrors.ErrComputationLimitExceeded)
+	}
+}
+
+// sender executes bulk listing transactions: it lists the NFTs of its
+// collection, and fails the transactions listing more than maxListings
+// NFTs with a computation limit error.
+type sender struct {
+	collection  map[uint64]bool
+	maxListings int
+	sent        []flowclient.Transaction
+	results     map[flowclient.Identifier]*flowclient.TransactionResult
+	polls       int
+}
+
+func (s *sender) SendTransaction(_ context.Context, tx flowclient.Transaction) (flowclient.Identifier, error) {
+	ids, err := cadenceconv.ArrayOf(cadenceconv.UInt64)(tx.Arguments[0])
+	if err != nil {
+		return flowclient.Identifier{}, err
+	}
+
+	s.sent = append(s.sent, tx)
+	id := flowclient.Identifier{byte(len(s.sent))}
+	result := &flowclient.TransactionResult{
+		TransactionID:    id,
+		Status:           flowclient.StatusSealed,
+		ComputationUsage: 150 + 110*uint64(len(ids)),
+	}
+	if len(ids) > s.maxListings {
+		result.ErrorMessage = fmt.Sprintf("[Error Code: 1110] computation exceeds limit (%d)", tx.GasLimit)
+	} else {
+		for _, nftID := range ids {
+			if s.collection[nftID] {
+				result.Events = append(result.Events, listingAvailable(1000+nftID, nftID, seller))
+			}
+		}
+	}
+	s.results[id] = result
+	return id, nil
+}
+
+// roles returns the roles of the next transaction: the sequence number of
+// the proposal key, starting at the given one, is incremented by each
+// transaction sent, and each transaction has a new reference block.
+func (s *sender) roles(sequenceNumber uint64) bulk.RolesFunc {
+	return func(_ context.Context, address cadence.Address) (txbuilder.Roles, error) {
+		return txbuilder.Roles{
+			ReferenceBlockID: flowclient.Identifier{0xb, byte(len(s.sent) + 1)},
+			Proposer:         flowclient.ProposalKey{Address: address, SequenceNumber: sequenceNumber + uint64(len(s.sent))},
+			Payer:            address,
+		}, nil
+	}
+}
+
+func (s *sender) GetTransactionResult(_ context.Context, id flowclient.Identifier) (*flowclient.TransactionResult, error) {
+	// The first poll of each transaction finds it pending.
+	s.polls++
+	if s.polls%2 == 1 {
+		return &flowclient.TransactionResult{TransactionID: id, Status: flowclient.StatusPending}, nil
+	}
+	return s.results[id], nil
+}
+
+func TestLister(t *testing.T) {
+	planner := newPlanner(t)
+	planner.Budget = bulk.Budget{Limit: 450, Base: 150, Listing: 100}
+
+	signer, err := txbuilder.NewInMemorySigner("4f3a1c5b2d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708", flowclient.ECDSA_P256, flowclient.SHA3_256)
+	require.NoError(t, err)
+
+	s := &sender{
+		collection:  map[uint64]bool{1: true, 2: true, 3: true, 5: true, 6: true},
+		maxListings: 2,
+		results:     map[flowclient.Identifier]*flowclient.TransactionResult{},
+	}
+	lister := bulk.NewLister(planner, s, seller, s.roles(7), txbuilder.AccountSigner{Address: seller, Signer: signer})
+	lister.PollInterval = 1
+
+	results, err := lister.List(context.Background(), rows(1, 2, 3, 4, 5, 6))
+	require.NoError(t, err)
+
+	// {1, 2, 3} exceeds the limit and is split into {1} and {2, 3}. {1}
+	// uses 110 per listing, so that {4, 5, 6} is cut to {4, 5} and {6}.
+	var sent [][]uint64
+	var sequenceNumbers []uint64
+	for _, tx := range s.sent {
+		ids, err := cadenceconv.ArrayOf(cadenceconv.UInt64)(tx.Arguments[0])
+		require.NoError(t, err)
+		sent = append(sent, ids)
+		sequenceNumbers = append(sequenceNumbers, tx.ProposalKey.SequenceNumber)
+		assert.Len(t, tx.EnvelopeSignatures, 1)
+	}
+	assert.Equal(t, [][]uint64{{1, 2, 3}, {1}, {2, 3}, {4, 5}, {6}}, sent)
+	assert.Equal(t, []uint64{7, 8, 9, 10, 11}, sequenceNumbers)
+	assert.Equal(t, flowclient.Identifier{0xb, 5}, s.sent[4].ReferenceBlockID)
+	assert.Equal(t, uint64(110), planner.Budget.Listing)
+
+	require.Len(t, results, 6)
+	for i, result := range results {
+		assert.Equal(t, i, result.Index)
+		assert.Equal(t, uint64(i+1), result.Row.NFTID)
+		if result.Row.NFTID == 4 {
+			assert.ErrorIs(t, result.Err, txerrors.ErrNFTMissing)
+			continue
+		}
+		require.NoError(t, result.Err)
+		assert.Equal(t, 1000+result.Row.NFTID, result.ListingResourceID)
+	}
+	assert.Equal(t, flowclient.Identifier{5}, results[5].TransactionID)
+}
+
+func TestListerSingleRowOverLimit(t *testing.T) {
+	planner := newPlanner(t)
+	s := &sender{maxListings: 0, results: map[flowclient.Identifier]*flowclient.TransactionResult{}}
+	lister := bulk.NewLister(planner, s, seller, s.roles(0))
+	lister.PollInterval = 1
+
+	results, err := lister.List(context.Background(), rows(1, 2))
+	require.NoError(t, err)
+	assert.Len(t, s.sent, 3)
+	require.Len(t, results, 2)
+	for _, result := range results {
+		assert.ErrorIs(t, result.Err, txerrors.ErrComputationLimitExceeded)
+	}
+}
@@ -0,0 +1,150 @@
+package bulk
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txerrors"
+)
+
+// DefaultPollInterval is the default interval between two requests for the
+// result of a transaction being sealed.
+const DefaultPollInterval = time.Second
+
+// TransactionSender sends transactions and reads their results. It is
+// implemented by flowclient.Client.
+type TransactionSender interface {
+	SendTransaction(ctx context.Context, tx flowclient.Transaction) (flowclient.Identifier, error)
+	GetTransactionResult(ctx context.Context, id flowclient.Identifier) (*flowclient.TransactionResult, error)
+}
+
+// RolesFunc returns the roles of the next transaction of a seller, for
+// example with the latest block as reference block and the current sequence
+// number of the seller's proposal key.
+type RolesFunc func(ctx context.Context, seller cadence.Address) (txbuilder.Roles, error)
+
+// Lister sends the bulk listing transactions of a seller, one at a time.
+type Lister struct {
+	planner *Planner
+	sender  TransactionSender
+	seller  cadence.Address
+	signers []txbuilder.AccountSigner
+	// Roles is called before each transaction is built, once the previous
+	// one is sealed, so that a long run of batches never uses an expired
+	// reference block. The seller is the sole authorizer.
+	Roles RolesFunc
+	// PollInterval is the interval between two requests for the result of
+	// a transaction being sealed.
+	PollInterval time.Duration
+}
+
+// NewLister returns a lister of the NFTs of the seller, building its
+// transactions with the roles returned by roles and signing them with the
+// given account keys.
+func NewLister(planner *Planner, sender TransactionSender, seller cadence.Address, roles RolesFunc, signers ...txbuilder.AccountSigner) *Lister {
+	return &Lister{
+		planner:      planner,
+		sender:       sender,
+		seller:       seller,
+		signers:      signers,
+		Roles:        roles,
+		PollInterval: DefaultPollInterval,
+	}
+}
+
+// List lists the rows and returns the outcome of each of them, in order.
+//
+// A batch whose transaction exceeds the computation limit is split in two
+// and each half sent again; the computation usage of the other transactions
+// refines the estimate of the planner's budget for the batches after them.
+// List returns an error, with the results of the rows sent so far, if a
+// transaction cannot be sent or its result read.
+func (l *Lister) List(ctx context.Context, rows []Row) ([]Result, error) {
+	queue, err := l.planner.Plan(rows)
+	if err != nil {
+		return nil, err
+	}
+
+	var results []Result
+	for len(queue) > 0 {
+		batch := queue[0]
+		queue = queue[1:]
+
+		// The budget may have grown since the batch was planned.
+		if capacity := l.planner.Budget.Capacity(); len(batch.Rows) > capacity {
+			first, second := batch.splitAt(capacity)
+			queue = append([]Batch{first, second}, queue...)
+			continue
+		}
+
+		result, err := l.send(ctx, batch)
+		if err != nil {
+			return results, err
+		}
+
+		if errors.Is(txerrors.FromResult(result), txerrors.ErrComputationLimitExceeded) && len(batch.Rows) > 1 {
+			first, second := batch.Split()
+			queue = append([]Batch{first, second}, queue...)
+			continue
+		}
+		if result.Err() == nil {
+			l.planner.Budget.Observe(len(batch.Rows), result.ComputationUsage)
+		}
+
+		matched, err := l.planner.Match(batch, l.seller, result)
+		if err != nil {
+			return results, err
+		}
+		results = append(results, matched...)
+	}
+	return results, nil
+}
+
+// send sends the transaction of the batch and waits for it to be sealed.
+func (l *Lister) send(ctx context.Context, batch Batch) (*flowclient.TransactionResult, error) {
+	roles, err := l.Roles(ctx, l.seller)
+	if err != nil {
+		return nil, fmt.Errorf("roles of %s: %w", l.seller, err)
+	}
+	tx, err := l.planner.Build(batch, l.seller, roles)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Sign(l.signers...); err != nil {
+		return nil, err
+	}
+
+	id, err := l.sender.SendTransaction(ctx, tx.Transaction)
+	if err != nil {
+		return nil, fmt.Errorf("send rows %d to %d: %w", batch.Offset, batch.Offset+len(batch.Rows)-1, err)
+	}
+
+	interval := l.PollInterval
+	if interval <= 0 {
+		interval = DefaultPollInterval
+	}
+	for {
+		result, err := l.sender.GetTransactionResult(ctx, id)
+		if err != nil {
+			return nil, fmt.Errorf("result of transaction %s: %w", id, err)
+		}
+		switch result.Status {
+		case flowclient.StatusSealed:
+			return result, nil
+		case flowclient.StatusExpired:
+			return nil, fmt.Errorf("transaction %s expired", id)
+		}
+
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-time.After(interval):
+		}
+	}
+}
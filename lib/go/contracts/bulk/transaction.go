@@ -0,0 +1,194 @@
+package bulk
+
+// listTransaction is the transaction listing several NFTs of a collection in
+// the storefront of the signer. It is transactions/sell_item.cdc with the
+// listing of a single NFT turned into a loop over the rows, so that the
+// account and collection lookups are paid once per transaction rather than
+// once per NFT.
+//
+// NFTs missing from the collection are skipped instead of failing the whole
+// transaction: only the NFTs listed emit ListingAvailable, which Match uses
+// to report each row.
+const listTransaction = `import "FungibleToken"
+import "FungibleTokenMetadataViews"
+import "NonFungibleToken"
+import "MetadataViews"
+import "NFTStorefrontV2"
+
+/// Transaction used to create several listings under the signer's owned storefront resource,
+/// all of NFTs of the same collection sold for the same fungible token.
+///
+/// The row arrays are indexed together, row i listing the NFT saleItemIDs[i]:
+///
+/// saleItemIDs - IDs of the NFTs that are put on sale by the seller.
+/// saleItemPrices - Amounts of tokens (FT) buyers need to pay for the purchase of the listed NFTs.
+/// customIDs - Optional strings to represent identifier of the dapp.
+/// expiries - Unix timestamps at which created listings become expired.
+///
+/// commissionAmount - Commission amount of each listing that will be taken away by the purchase facilitator.
+/// marketplacesAddress - List of addresses that are allowed to get the commission.
+///
+/// If a given nft has a support of the RoyaltyView then royalties will added as the sale cut.
+/// NFTs that are not in the signer's collection are skipped.
+
+transaction(
+    saleItemIDs: [UInt64],
+    saleItemPrices: [UFix64],
+    customIDs: [String?],
+    expiries: [UInt64],
+    commissionAmount: UFix64,
+    marketplacesAddress: [Address],
+    nftTypeIdentifier: String,
+    ftTypeIdentifier: String
+) {
+
+    let tokenReceiver: Capability<&{FungibleToken.Receiver}>
+    let nftProvider: Capability<auth(NonFungibleToken.Withdraw) &{NonFungibleToken.Collection}>
+    let collection: &{NonFungibleToken.Collection}
+    let storefront: auth(NFTStorefrontV2.CreateListing) &NFTStorefrontV2.Storefront
+    var marketplacesCapability: [Capability<&{FungibleToken.Receiver}>]
+
+    prepare(acct: auth(BorrowValue, IssueStorageCapabilityController, PublishCapability, SaveValue, StorageCapabilities) &Account) {
+
+        // If the account doesn't already have a Storefront
+        // Create a new empty Storefront
+        if acct.storage.borrow<&NFTStorefrontV2.Storefront>(from: NFTStorefrontV2.StorefrontStoragePath) == nil {
+
+            // Create a new empty Storefront
+            let storefront <- NFTStorefrontV2.createStorefront() as! @NFTStorefrontV2.Storefront
+
+            // save it to the account
+            acct.storage.save(<-storefront, to: NFTStorefrontV2.StorefrontStoragePath)
+
+            // create a public capability for the Storefront
+            let storefrontPublicCap = acct.capabilities.storage.issue<&{NFTStorefrontV2.StorefrontPublic}>(
+                    NFTStorefrontV2.StorefrontStoragePath
+                )
+            acct.capabilities.publish(storefrontPublicCap, at: NFTStorefrontV2.StorefrontPublicPath)
+        }
+
+        // Get the metadata views for the NFT and FT types that are used in this transaction
+        let collectionData = MetadataViews.resolveContractViewFromTypeIdentifier(
+            resourceTypeIdentifier: nftTypeIdentifier,
+            viewType: Type<MetadataViews.NFTCollectionData>()
+        ) as? MetadataViews.NFTCollectionData
+            ?? panic("Could not construct valid NFT type and view from identifier \(nftTypeIdentifier)")
+
+        let vaultData = MetadataViews.resolveContractViewFromTypeIdentifier(
+            resourceTypeIdentifier: ftTypeIdentifier,
+            viewType: Type<FungibleTokenMetadataViews.FTVaultData>()
+        ) as? FungibleTokenMetadataViews.FTVaultData
+            ?? panic("Could not construct valid FT type and view from identifier \(ftTypeIdentifier)")
+
+        self.marketplacesCapability = []
+
+        // Receiver for the sale cuts.
+        self.tokenReceiver = acct.capabilities.get<&{FungibleToken.Receiver}>(vaultData.receiverPath)
+        assert(self.tokenReceiver.borrow() != nil, message: "Missing or mis-typed Fungible Token receiver for token \(ftTypeIdentifier) at path \(vaultData.receiverPath)")
+
+        var nftProviderCap: Capability<auth(NonFungibleToken.Withdraw) &{NonFungibleToken.Collection}>? = nil
+        // check if there is an existing capability/capability controller for the storage path
+        let nftCollectionControllers = acct.capabilities.storage.getControllers(forPath: collectionData.storagePath)
+        for controller in nftCollectionControllers {
+            if let maybeProviderCap = controller.capability as? Capability<auth(NonFungibleToken.Withdraw) &{NonFungibleToken.Collection}>? {
+                nftProviderCap = maybeProviderCap
+                break
+            }
+        }
+
+        // if there are no capabilities created for that storage path
+        // or if existing capability is no longer valid, issue a new one
+        if nftProviderCap == nil || !(nftProviderCap!.check()) {
+            nftProviderCap = acct.capabilities.storage.issue<auth(NonFungibleToken.Withdraw) &{NonFungibleToken.Collection}>(
+                collectionData.storagePath
+            )
+        }
+        assert(nftProviderCap?.check() ?? false, message: "Could not assign Provider Capability")
+
+        self.nftProvider = nftProviderCap!
+
+        self.collection = acct.capabilities.borrow<&{NonFungibleToken.Collection}>(
+                collectionData.publicPath
+            ) ?? panic("Could not borrow a reference to the signer's collection")
+
+        self.storefront = acct.storage.borrow<auth(NFTStorefrontV2.CreateListing) &NFTStorefrontV2.Storefront>(
+                from: NFTStorefrontV2.StorefrontStoragePath
+            ) ?? panic("Could not get a Storefront from the signer's account at path \(NFTStorefrontV2.StorefrontStoragePath)!"
+                        .concat("Make sure the signer has initialized their account with a NFTStorefrontV2 storefront!"))
+
+        for marketplace in marketplacesAddress {
+            // Here we are making a fair assumption that all given addresses would have
+            // the capability to receive the fungible token
+            self.marketplacesCapability.append(
+                getAccount(marketplace).capabilities.get<&{FungibleToken.Receiver}>(vaultData.receiverPath)
+            )
+        }
+    }
+
+    pre {
+        saleItemPrices.length == saleItemIDs.length: "Expected \(saleItemIDs.length) sale item prices, got \(saleItemPrices.length)"
+        customIDs.length == saleItemIDs.length: "Expected \(saleItemIDs.length) custom IDs, got \(customIDs.length)"
+        expiries.length == saleItemIDs.length: "Expected \(saleItemIDs.length) expiries, got \(expiries.length)"
+    }
+
+    execute {
+        let nftType = CompositeType(nftTypeIdentifier)!
+        let ftType = CompositeType(ftTypeIdentifier)!
+        let marketplacesCapability: [Capability<&{FungibleToken.Receiver}>]? = self.marketplacesCapability.length == 0 ? nil : self.marketplacesCapability
+
+        var i = 0
+        while i < saleItemIDs.length {
+            let saleItemID = saleItemIDs[i]
+            let saleItemPrice = saleItemPrices[i]
+            let customID = customIDs[i]
+            let expiry = expiries[i]
+            i = i + 1
+
+            // Skip the NFTs that are not in the collection
+            let nft = self.collection.borrowNFT(saleItemID)
+            if nft == nil {
+                continue
+            }
+
+            var saleCuts: [NFTStorefrontV2.SaleCut] = []
+            var totalRoyaltyCut = 0.0
+            let effectiveSaleItemPrice = saleItemPrice - commissionAmount
+            // Check whether the NFT implements the MetadataResolver or not.
+            if nft!.getViews().contains(Type<MetadataViews.Royalties>()) {
+                let royaltiesRef = nft!.resolveView(Type<MetadataViews.Royalties>())
+                    ?? panic("Unable to retrieve the Royalties metadata from the NFT for sale with ID \(saleItemID).")
+                let royalties = (royaltiesRef as! MetadataViews.Royalties).getRoyalties()
+                for royalty in royalties {
+                    saleCuts.append(
+                        NFTStorefrontV2.SaleCut(
+                            receiver: royalty.receiver,
+                            amount: royalty.cut * effectiveSaleItemPrice
+                        )
+                    )
+                    totalRoyaltyCut = totalRoyaltyCut + (royalty.cut * effectiveSaleItemPrice)
+                }
+            }
+            // Append the cut for the seller.
+            saleCuts.append(
+                NFTStorefrontV2.SaleCut(
+                    receiver: self.tokenReceiver,
+                    amount: effectiveSaleItemPrice - totalRoyaltyCut
+                )
+            )
+
+            // Create listing
+            self.storefront.createListing(
+                nftProviderCapability: self.nftProvider,
+                nftType: nftType,
+                nftID: saleItemID,
+                salePaymentVaultType: ftType,
+                saleCuts: saleCuts,
+                marketplacesCapability: marketplacesCapability,
+                customID: customID,
+                commissionAmount: commissionAmount,
+                expiry: expiry
+            )
+        }
+    }
+}
+`
@@ -0,0 +1,268 @@
+// Command bulk-list plans the listing of many NFTs of a collection at once.
+//
+// It reads rows of nftID,price,expiry[,customID] from a CSV file, or from
+// the standard input if the file is -, and splits them into bulk listing
+// transactions whose estimated computation fits under -limit. The expiry is
+// a Unix timestamp; a first row starting with nftID is a header and is
+// skipped.
+//
+// With -o, the bulk listing transaction is written to the given directory
+// with the arguments of each batch, and the commands sending them with the
+// seller account are printed. Package bulk sends the batches itself, splits
+// the ones exceeding the limit and reports the outcome of each row.
+//
+// Usage:
+//
+//	go run ./cmd/bulk-list -network testnet -nft-type A.0b2a3299cc857e29.TopShot.NFT -token-type A.7e60df042a9c0868.FlowToken.Vault rows.csv
+//	go run ./cmd/bulk-list -network testnet -nft-type ... -token-type ... -commission 0.5 -marketplaces 0x1234567890abcdef -o out rows.csv
+package main
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bulk"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+const filenameTransaction = "bulk_list.cdc"
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the contract addresses are read from")
+	network := flag.String("network", "mainnet", "network of the configuration")
+	nftType := flag.String("nft-type", "", "type identifier of the NFTs, for example A.0b2a3299cc857e29.TopShot.NFT")
+	tokenType := flag.String("token-type", "", "type identifier of the payment vault, for example A.1654653399040a61.FlowToken.Vault")
+	commission := flag.String("commission", "0.0", "commission amount of each listing")
+	marketplaces := flag.String("marketplaces", "", "comma-separated addresses allowed to receive the commission")
+	limit := flag.Uint64("limit", txbuilder.DefaultGasLimit, "computation limit of each transaction")
+	base := flag.Uint64("base", bulk.DefaultBaseComputation, "estimated computation of a transaction listing no NFT")
+	perListing := flag.Uint64("per-listing", bulk.DefaultListingComputation, "estimated computation of each listing")
+	maxListings := flag.Int("max", 0, "maximum number of listings of a transaction, or 0 for no maximum")
+	out := flag.String("o", "", "directory to write the transaction and the arguments of each batch to")
+	asJSON := flag.Bool("json", false, "print the batches and their arguments as JSON")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: bulk-list [flags] rows.csv\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	options := bulk.Options{NFTTypeIdentifier: *nftType, TokenTypeIdentifier: *tokenType}
+	var err error
+	if options.CommissionAmount, err = cadenceconv.ParseUFix64(*commission); err != nil {
+		fail("invalid -commission %q: %s", *commission, err)
+	}
+	if *marketplaces != "" {
+		for _, s := range strings.Split(*marketplaces, ",") {
+			address, err := flowclient.HexToAddress(strings.TrimSpace(s))
+			if err != nil {
+				fail("%s", err)
+			}
+			options.MarketplacesAddress = append(options.MarketplacesAddress, address)
+		}
+	}
+
+	rows, err := readRows(flag.Arg(0))
+	if err != nil {
+		fail("%s", err)
+	}
+
+	config, err := flowconfig.Load(*configPath)
+	if err != nil {
+		fail("%s", err)
+	}
+	planner, err := bulk.NewPlanner(config, *network, options)
+	if err != nil {
+		fail("%s", err)
+	}
+	planner.Budget = bulk.Budget{Limit: *limit, Base: *base, Listing: *perListing, MaxListings: *maxListings}
+
+	batches, err := planner.Plan(rows)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	arguments := make([][]json.RawMessage, len(batches))
+	for i, batch := range batches {
+		values, err := planner.Arguments(batch)
+		if err != nil {
+			fail("%s", err)
+		}
+		for _, value := range values {
+			encoded, err := jsoncdc.Encode(value)
+			if err != nil {
+				fail("%s", err)
+			}
+			arguments[i] = append(arguments[i], encoded)
+		}
+	}
+
+	if *out != "" {
+		if err := write(*out, planner.Transaction(), arguments); err != nil {
+			fail("%s", err)
+		}
+	}
+
+	if *asJSON {
+		printJSON(planner, batches, arguments)
+	} else {
+		printText(planner, batches, *out, *network)
+	}
+}
+
+// readRows reads the rows of a CSV file.
+func readRows(path string) ([]bulk.Row, error) {
+	var r io.Reader = os.Stdin
+	if path != "-" {
+		f, err := os.Open(path)
+		if err != nil {
+			return nil, err
+		}
+		defer f.Close()
+		r = f
+	}
+
+	reader := csv.NewReader(r)
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	var rows []bulk.Row
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return rows, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], "nftID") {
+			continue
+		}
+
+		row, err := parseRow(record)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: %w", path, line, err)
+		}
+		rows = append(rows, row)
+	}
+}
+
+func parseRow(record []string) (bulk.Row, error) {
+	var row bulk.Row
+	if len(record) < 3 || len(record) > 4 {
+		return row, fmt.Errorf("expected nftID,price,expiry[,customID], got %d fields", len(record))
+	}
+
+	var err error
+	if row.NFTID, err = strconv.ParseUint(record[0], 10, 64); err != nil {
+		return row, fmt.Errorf("invalid NFT ID %q", record[0])
+	}
+	if row.Price, err = cadenceconv.ParseUFix64(record[1]); err != nil {
+		return row, fmt.Errorf("invalid price %q: %w", record[1], err)
+	}
+	if row.Expiry, err = strconv.ParseUint(record[2], 10, 64); err != nil {
+		return row, fmt.Errorf("invalid expiry %q", record[2])
+	}
+	if len(record) == 4 && record[3] != "" {
+		customID := record[3]
+		row.CustomID = &customID
+	}
+	return row, nil
+}
+
+func batchFilename(i int) string {
+	return fmt.Sprintf("batch-%03d.json", i+1)
+}
+
+// write writes the transaction and the arguments of each batch, in the form
+// of the --args-json flag of the Flow CLI.
+func write(dir string, transaction []byte, arguments [][]json.RawMessage) error {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(dir, filenameTransaction), transaction, 0o644); err != nil {
+		return err
+	}
+	for i, args := range arguments {
+		encoded, err := json.Marshal(args)
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dir, batchFilename(i)), encoded, 0o644); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func printText(planner *bulk.Planner, batches []bulk.Batch, out, network string) {
+	rows := 0
+	for i, batch := range batches {
+		first, last := batch.Rows[0], batch.Rows[len(batch.Rows)-1]
+		fmt.Printf("batch %d: rows %d to %d, NFTs %d to %d, estimated computation %d\n",
+			i+1, batch.Offset, batch.Offset+len(batch.Rows)-1, first.NFTID, last.NFTID, planner.Budget.Estimate(len(batch.Rows)))
+		rows += len(batch.Rows)
+	}
+	fmt.Printf("%d rows in %d transactions of at most %d listings\n", rows, len(batches), planner.Budget.Capacity())
+
+	if out != "" && len(batches) > 0 {
+		limit := planner.Budget.Limit
+		if limit == 0 {
+			limit = txbuilder.DefaultGasLimit
+		}
+		fmt.Printf("\nList the NFTs with the seller account:\n\n")
+		for i := range batches {
+			fmt.Printf("flow transactions send %s --args-json \"$(cat %s)\" --gas-limit %d --network %s\n",
+				filepath.Join(out, filenameTransaction), filepath.Join(out, batchFilename(i)), limit, network)
+		}
+	}
+}
+
+func printJSON(planner *bulk.Planner, batches []bulk.Batch, arguments [][]json.RawMessage) {
+	type batch struct {
+		Offset               int               `json:"offset"`
+		NFTIDs               []uint64          `json:"nftIds"`
+		EstimatedComputation uint64            `json:"estimatedComputation"`
+		Arguments            []json.RawMessage `json:"arguments"`
+	}
+
+	result := make([]batch, len(batches))
+	for i, b := range batches {
+		result[i] = batch{
+			Offset:               b.Offset,
+			EstimatedComputation: planner.Budget.Estimate(len(b.Rows)),
+			Arguments:            arguments[i],
+		}
+		for _, row := range b.Rows {
+			result[i].NFTIDs = append(result[i].NFTIDs, row.NFTID)
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		fail("%s", err)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "bulk-list: "+format+"\n", args...)
+	os.Exit(2)
+}
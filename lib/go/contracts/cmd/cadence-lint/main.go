@@ -0,0 +1,56 @@
+// Command cadence-lint reports syntax removed in Cadence 1.0 and missing
+// account entitlements in the embedded contracts, scripts and transactions,
+// or in the given files. It exits with status 1 if anything is found.
+//
+// Usage:
+//
+//	go run ./cmd/cadence-lint
+//	go run ./cmd/cadence-lint ../../../transactions/hybrid-custody/sell_item_in_child_from_parent.cdc
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/lint"
+)
+
+func main() {
+	asJSON := flag.Bool("json", false, "print the findings as JSON")
+	flag.Parse()
+
+	var findings []lint.Finding
+	if flag.NArg() == 0 {
+		findings = lint.Embedded()
+	}
+	for _, path := range flag.Args() {
+		code, err := os.ReadFile(path)
+		if err != nil {
+			fail("%s", err)
+		}
+		findings = append(findings, lint.File(path, code)...)
+	}
+
+	if *asJSON {
+		encoder := json.NewEncoder(os.Stdout)
+		encoder.SetIndent("", "  ")
+		if err := encoder.Encode(findings); err != nil {
+			fail("%s", err)
+		}
+	} else {
+		for _, finding := range findings {
+			fmt.Println(finding)
+		}
+	}
+
+	if len(findings) > 0 {
+		os.Exit(1)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "cadence-lint: "+format+"\n", args...)
+	os.Exit(2)
+}
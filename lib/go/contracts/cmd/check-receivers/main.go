@@ -0,0 +1,187 @@
+// Command check-receivers checks that the sale cut receivers and allowed
+// commission receivers of the open NFTStorefrontV2 listings of storefronts
+// can still be borrowed, and fails if a purchase of any of them would leave a
+// receiver unpaid or abort. Sellers fix such listings by relisting them with
+// transactions/sell_item_and_replace_current_listing.cdc; with -json, the
+// arguments of that transaction are printed for each of them.
+//
+// Scripts are executed through the REST Access API of the network, or
+// replayed from fixtures recorded with flowclient.Recorder.
+//
+// Usage:
+//
+//	go run ./cmd/check-receivers -network mainnet 0x1234567890abcdef 0xfedcba0987654321
+//	go run ./cmd/check-receivers -fixtures scripts.json -network emulator -json 0x01cf0e2f2f715450
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+)
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the contract addresses are read from")
+	network := flag.String("network", "mainnet", "network of the configuration, and to execute scripts on")
+	host := flag.String("rest", "", "REST Access API host (defaults to the public host of the network)")
+	fixtures := flag.String("fixtures", "", "recorded fixtures to replay scripts from instead of the network")
+	batchSize := flag.Int("batch", receivers.DefaultBatchSize, "number of listings checked by a script execution")
+	all := flag.Bool("all", false, "also print the listings without problems")
+	asJSON := flag.Bool("json", false, "print the reports as JSON")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: check-receivers [flags] storefront-address...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	config, err := flowconfig.Load(*configPath)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	executor, err := scriptExecutor(*fixtures, *host, *network)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	checker, err := receivers.NewChecker(config, *network, executor)
+	if err != nil {
+		fail("%s", err)
+	}
+	checker.BatchSize = *batchSize
+
+	ctx := context.Background()
+	var reports []receivers.Report
+	for _, arg := range flag.Args() {
+		storefront, err := flowclient.HexToAddress(arg)
+		if err != nil {
+			fail("%s", err)
+		}
+		checked, err := checker.CheckStorefront(ctx, storefront)
+		if err != nil {
+			fail("%s", err)
+		}
+		reports = append(reports, checked...)
+	}
+
+	unhealthy := 0
+	var printed []receivers.Report
+	for _, report := range reports {
+		if !report.Healthy() {
+			unhealthy++
+		}
+		if *all || !report.Healthy() {
+			printed = append(printed, report)
+		}
+	}
+
+	if *asJSON {
+		printJSON(printed)
+	} else {
+		printTable(printed)
+		fmt.Printf("%d of %d open listings have problems\n", unhealthy, len(reports))
+	}
+	if unhealthy > 0 {
+		os.Exit(1)
+	}
+}
+
+func scriptExecutor(fixtures, host, network string) (flowclient.ScriptExecutor, error) {
+	if fixtures != "" {
+		return flowclient.LoadFake(fixtures)
+	}
+	if host == "" {
+		var ok bool
+		if host, ok = flowclient.RESTHosts[network]; !ok {
+			return nil, fmt.Errorf("either -rest or -fixtures must be given for network %s", network)
+		}
+	}
+	return flowclient.NewREST(host, nil), nil
+}
+
+func printTable(reports []receivers.Report) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "STOREFRONT\tLISTING\tNFT\tPRICE\tPROBLEM\tMESSAGE")
+	for _, r := range reports {
+		nft := fmt.Sprintf("%s #%d", r.Listing.Details.NFTType.ID(), r.Listing.Details.NFTID)
+		if r.Healthy() {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t-\t-\n", r.Storefront, r.Listing.ListingResourceID, nft, r.Listing.Details.SalePrice)
+		}
+		for _, f := range r.Findings {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\t%s\n", r.Storefront, r.Listing.ListingResourceID, nft, r.Listing.Details.SalePrice, f.Problem, f.Message)
+		}
+	}
+	w.Flush()
+}
+
+func printJSON(reports []receivers.Report) {
+	type finding struct {
+		Problem  receivers.Problem `json:"problem"`
+		Message  string            `json:"message"`
+		Blocking bool              `json:"blocking"`
+	}
+	type report struct {
+		Storefront        string            `json:"storefront"`
+		ListingResourceID uint64            `json:"listingResourceId"`
+		NFTType           string            `json:"nftType"`
+		NFTID             uint64            `json:"nftId"`
+		SalePrice         string            `json:"salePrice"`
+		Findings          []finding         `json:"findings"`
+		RelistArguments   []json.RawMessage `json:"relistArguments,omitempty"`
+	}
+
+	result := make([]report, len(reports))
+	for i, r := range reports {
+		result[i] = report{
+			Storefront:        r.Storefront.String(),
+			ListingResourceID: r.Listing.ListingResourceID,
+			NFTType:           r.Listing.Details.NFTType.ID(),
+			NFTID:             r.Listing.Details.NFTID,
+			SalePrice:         r.Listing.Details.SalePrice.String(),
+			Findings:          []finding{},
+		}
+		for _, f := range r.Findings {
+			result[i].Findings = append(result[i].Findings, finding{f.Problem, f.Message, f.Problem.Blocking()})
+		}
+		if r.Healthy() {
+			continue
+		}
+
+		values, err := r.Listing.RelistArguments()
+		if err != nil {
+			fail("%s", err)
+		}
+		for _, value := range values {
+			encoded, err := jsoncdc.Encode(value)
+			if err != nil {
+				fail("%s", err)
+			}
+			result[i].RelistArguments = append(result[i].RelistArguments, encoded)
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		fail("%s", err)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "check-receivers: "+format+"\n", args...)
+	os.Exit(2)
+}
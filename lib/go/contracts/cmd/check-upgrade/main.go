@@ -0,0 +1,145 @@
+// Command check-upgrade compares a storefront contract embedded in this module
+// with the version deployed on a network, and fails if the update would be
+// rejected by the network or would break off-chain consumers.
+//
+// The release of the deployed version is printed when it is found in the
+// releases embedded in the versions package, or in the sources given with
+// -releases, laid out as TAG/CONTRACT.cdc.
+//
+// Usage:
+//
+//	go run ./cmd/check-upgrade -network mainnet -releases releases
+//	go run ./cmd/check-upgrade -deployed deployed.cdc -contract NFTStorefront
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts"
+	"github.com/onflow/nft-storefront/lib/go/contracts/compat"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/versions"
+)
+
+const (
+	// Import addresses do not affect the comparison.
+	placeholderAddress = "0000000000000000"
+
+	fetchTimeout = 30 * time.Second
+)
+
+var embedded = map[string]func(ftAddr, nftAddr string) []byte{
+	"NFTStorefront":   contracts.NFTStorefront,
+	"NFTStorefrontV2": contracts.NFTStorefrontV2,
+}
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the deployment addresses are read from")
+	contract := flag.String("contract", "NFTStorefrontV2", "name of the contract to check")
+	deployedPath := flag.String("deployed", "", "file containing the deployed contract source")
+	network := flag.String("network", "", "network to fetch the deployed contract from (mainnet or testnet)")
+	address := flag.String("address", "", "account the contract is deployed to (defaults to its address in the configuration)")
+	updatedPath := flag.String("updated", "", "file containing the updated contract source (defaults to the embedded source)")
+	allowBreaking := flag.Bool("allow-breaking", false, "only fail on changes the network would reject")
+	releasesPath := flag.String("releases", "", "directory of further released sources laid out as TAG/CONTRACT.cdc")
+	flag.Parse()
+
+	code, ok := embedded[*contract]
+	if !ok {
+		fail("unknown contract %s", *contract)
+	}
+
+	deployed, err := loadDeployed(*configPath, *contract, *deployedPath, *network, *address)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	catalog := versions.NewCatalog()
+	if *releasesPath != "" {
+		if _, err := catalog.AddReleases(os.DirFS(*releasesPath)); err != nil {
+			fail("%s", err)
+		}
+	}
+	if release, ok := catalog.Identify(deployed); ok {
+		fmt.Printf("%s: deployed source is release %s\n", *contract, release.Tag)
+	} else {
+		fmt.Printf("%s: deployed source matches no known release\n", *contract)
+	}
+
+	updated := code(placeholderAddress, placeholderAddress)
+	if *updatedPath != "" {
+		updated, err = os.ReadFile(*updatedPath)
+		if err != nil {
+			fail("%s", err)
+		}
+	}
+
+	report, err := compat.Check(deployed, updated)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	for _, finding := range report.Findings {
+		fmt.Println(finding)
+	}
+
+	if report.Rejected() || (report.Breaking() && !*allowBreaking) {
+		os.Exit(1)
+	}
+
+	fmt.Printf("%s: update is compatible\n", *contract)
+}
+
+func loadDeployed(configPath, contract, path, network, address string) ([]byte, error) {
+	if path != "" {
+		return os.ReadFile(path)
+	}
+
+	host, ok := flowclient.RESTHosts[network]
+	if !ok {
+		return nil, fmt.Errorf("either -deployed or -network (mainnet or testnet) must be given")
+	}
+
+	var account cadence.Address
+	if address != "" {
+		var err error
+		if account, err = flowclient.HexToAddress(address); err != nil {
+			return nil, err
+		}
+	} else {
+		config, err := flowconfig.Load(configPath)
+		if err != nil {
+			return nil, err
+		}
+		if account, err = config.Address(contract, network); err != nil {
+			return nil, err
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), fetchTimeout)
+	defer cancel()
+	client := flowclient.NewREST(host, &http.Client{Timeout: fetchTimeout})
+	deployed, err := client.GetAccount(ctx, account)
+	if err != nil {
+		return nil, fmt.Errorf("failed to fetch account %s: %w", account, err)
+	}
+
+	code, ok := deployed.Contracts[contract]
+	if !ok {
+		return nil, fmt.Errorf("contract %s is not deployed to account %s", contract, account)
+	}
+	return code, nil
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "check-upgrade: "+format+"\n", args...)
+	os.Exit(2)
+}
@@ -0,0 +1,271 @@
+// Command dapp-listings reports the NFTStorefrontV2 listings and sales of
+// the dApps that tagged them with a customID.
+//
+// With storefront addresses, their listings are read with scripts and the
+// ones of the scope are printed with a summary of each customID. Otherwise
+// the listings and sales of a range of blocks are summarized from events.
+//
+// The scope is given with -custom-id and -untagged, or defaults to the
+// customIDs of the dApps of the -registry file, a JSON array of
+// {"customID", "name", "url", "description"} objects whose names label the
+// customIDs. With neither, every listing is reported.
+//
+// Scripts are executed and events fetched through the REST Access API of the
+// network, or replayed from fixtures recorded with flowclient.Recorder.
+//
+// Usage:
+//
+//	go run ./cmd/dapp-listings -network mainnet -custom-id flowty 0x1234567890abcdef
+//	go run ./cmd/dapp-listings -network mainnet -registry dapps.json -start 85000000
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/customid"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/listings"
+)
+
+// client executes scripts and fetches events.
+type client interface {
+	flowclient.ScriptExecutor
+	flowclient.EventSource
+}
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the contract addresses are read from")
+	network := flag.String("network", "mainnet", "network of the configuration, and to execute scripts on")
+	host := flag.String("rest", "", "REST Access API host (defaults to the public host of the network)")
+	fixtures := flag.String("fixtures", "", "recorded fixtures to replay scripts and events from instead of the network")
+	registryPath := flag.String("registry", "", "JSON file of the dApps and their customIDs")
+	customIDs := flag.String("custom-id", "", "comma-separated customIDs to report (defaults to the ones of the registry)")
+	untagged := flag.Bool("untagged", false, "also report the listings created without a customID")
+	batchSize := flag.Int("batch", listings.DefaultBatchSize, "number of listings read by a script execution")
+	startHeight := flag.Uint64("start", 0, "first block height, to report events instead of storefronts")
+	endHeight := flag.Uint64("end", 0, "last block height (defaults to the latest sealed block)")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: dapp-listings [flags] [storefront-address...]\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 && *startHeight == 0 {
+		fail("either storefront addresses or -start must be given")
+	}
+
+	var registry *customid.Registry
+	if *registryPath != "" {
+		var err error
+		if registry, err = customid.LoadRegistry(*registryPath); err != nil {
+			fail("%s", err)
+		}
+	}
+	scope := newScope(registry, *customIDs, *untagged)
+
+	config, err := flowconfig.Load(*configPath)
+	if err != nil {
+		fail("%s", err)
+	}
+	c, err := newClient(*fixtures, *host, *network)
+	if err != nil {
+		fail("%s", err)
+	}
+	ctx := context.Background()
+
+	var (
+		summaries []customid.Summary
+		selected  []listings.Listing
+	)
+	if flag.NArg() > 0 {
+		reader, err := listings.NewReader(config, *network, c)
+		if err != nil {
+			fail("%s", err)
+		}
+		reader.BatchSize = *batchSize
+
+		for _, arg := range flag.Args() {
+			storefront, err := flowclient.HexToAddress(arg)
+			if err != nil {
+				fail("%s", err)
+			}
+			read, err := reader.Listings(ctx, storefront)
+			if err != nil {
+				fail("%s", err)
+			}
+			selected = append(selected, scope.Listings(read)...)
+		}
+		if summaries, err = customid.SummarizeListings(scope, selected, time.Now()); err != nil {
+			fail("%s", err)
+		}
+	} else {
+		contract, err := config.Address("NFTStorefrontV2", *network)
+		if err != nil {
+			fail("%s", err)
+		}
+		if *endHeight == 0 {
+			if *endHeight, err = c.GetLatestBlockHeight(ctx); err != nil {
+				fail("%s", err)
+			}
+		}
+
+		view := customid.NewView(contract, scope)
+		if err := flowclient.Feed(ctx, c, view, *startHeight, *endHeight); err != nil {
+			fail("%s", err)
+		}
+		summaries = view.Summaries()
+	}
+
+	if *asJSON {
+		printJSON(registry, summaries, selected)
+		return
+	}
+	printTable(registry, summaries, selected)
+}
+
+func newScope(registry *customid.Registry, customIDs string, untagged bool) customid.Scope {
+	var scope customid.Scope
+	switch {
+	case customIDs != "":
+		var ids []string
+		for _, id := range strings.Split(customIDs, ",") {
+			ids = append(ids, strings.TrimSpace(id))
+		}
+		scope = customid.Only(ids...)
+	case registry != nil:
+		scope = registry.Scope()
+	case untagged:
+		return customid.Untagged()
+	}
+	if untagged {
+		scope = scope.WithUntagged()
+	}
+	return scope
+}
+
+func newClient(fixtures, host, network string) (client, error) {
+	if fixtures != "" {
+		return flowclient.LoadFake(fixtures)
+	}
+	if host == "" {
+		var ok bool
+		if host, ok = flowclient.RESTHosts[network]; !ok {
+			return nil, fmt.Errorf("either -rest or -fixtures must be given for network %s", network)
+		}
+	}
+	return flowclient.NewREST(host, nil), nil
+}
+
+func status(listing listings.Listing, now time.Time) string {
+	switch {
+	case listing.Details.Purchased:
+		return "purchased"
+	case listing.Expired(now):
+		return "expired"
+	default:
+		return "open"
+	}
+}
+
+func printTable(registry *customid.Registry, summaries []customid.Summary, selected []listings.Listing) {
+	now := time.Now()
+	if len(selected) > 0 {
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "LISTING\tDAPP\tNFT TYPE\tNFT ID\tPRICE\tEXPIRY\tSTATUS")
+		for _, l := range selected {
+			fmt.Fprintf(w, "%d\t%s\t%s\t%d\t%s\t%s\t%s\n",
+				l.ListingResourceID, registry.Name(l.Details.CustomID), cadenceconv.TypeID(l.Details.NFTType), l.Details.NFTID,
+				l.Details.SalePrice, time.Unix(int64(l.Details.Expiry), 0).UTC().Format(time.RFC3339), status(l, now))
+		}
+		w.Flush()
+		fmt.Println()
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "DAPP\tCUSTOM ID\tLISTED\tOPEN\tEXPIRED\tREMOVED\tSALES\tVOLUME\tCOMMISSION")
+	for _, s := range summaries {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%d\t%d\t%d\t%d\t%s\t%s\n",
+			registry.Name(s.CustomID), customid.Label(s.CustomID), s.Listed, s.Open, s.Expired, s.Removed, s.Sales, s.Volume, s.Commission)
+	}
+	w.Flush()
+}
+
+func printJSON(registry *customid.Registry, summaries []customid.Summary, selected []listings.Listing) {
+	type summary struct {
+		CustomID   *string        `json:"customID"`
+		DApp       *customid.DApp `json:"dApp,omitempty"`
+		Listed     int            `json:"listed"`
+		Open       int            `json:"open"`
+		Expired    int            `json:"expired"`
+		Removed    int            `json:"removed"`
+		Sales      int            `json:"sales"`
+		Volume     string         `json:"volume"`
+		Commission string         `json:"commission"`
+	}
+	type listing struct {
+		ListingResourceID uint64  `json:"listingResourceId"`
+		CustomID          *string `json:"customID"`
+		NFTType           string  `json:"nftType"`
+		NFTID             uint64  `json:"nftId"`
+		PaymentType       string  `json:"paymentType"`
+		Price             string  `json:"price"`
+		Expiry            uint64  `json:"expiry"`
+		Status            string  `json:"status"`
+	}
+	var result struct {
+		Summaries []summary `json:"summaries"`
+		Listings  []listing `json:"listings,omitempty"`
+	}
+
+	result.Summaries = make([]summary, len(summaries))
+	for i, s := range summaries {
+		result.Summaries[i] = summary{
+			CustomID:   s.CustomID,
+			Listed:     s.Listed,
+			Open:       s.Open,
+			Expired:    s.Expired,
+			Removed:    s.Removed,
+			Sales:      s.Sales,
+			Volume:     s.Volume.String(),
+			Commission: s.Commission.String(),
+		}
+		if dapp, ok := registry.Lookup(s.CustomID); ok {
+			result.Summaries[i].DApp = &dapp
+		}
+	}
+
+	now := time.Now()
+	for _, l := range selected {
+		result.Listings = append(result.Listings, listing{
+			ListingResourceID: l.ListingResourceID,
+			CustomID:          l.Details.CustomID,
+			NFTType:           cadenceconv.TypeID(l.Details.NFTType),
+			NFTID:             l.Details.NFTID,
+			PaymentType:       cadenceconv.TypeID(l.Details.SalePaymentVaultType),
+			Price:             l.Details.SalePrice.String(),
+			Expiry:            l.Details.Expiry,
+			Status:            status(l, now),
+		})
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		fail("%s", err)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "dapp-listings: "+format+"\n", args...)
+	os.Exit(2)
+}
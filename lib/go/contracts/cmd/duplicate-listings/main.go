@@ -0,0 +1,233 @@
+// Command duplicate-listings shows the NFTs a NFTStorefrontV2 storefront lists
+// more than once, with the prices and payment tokens they are listed at, and
+// plans the transactions/remove_item.cdc transactions collapsing each of them
+// to one listing: the one given with -keep, or the one chosen by -strategy.
+//
+// Scripts are executed through the REST Access API of the network, or
+// replayed from fixtures recorded with flowclient.Recorder.
+//
+// Usage:
+//
+//	go run ./cmd/duplicate-listings -network mainnet 0x1234567890abcdef
+//	go run ./cmd/duplicate-listings -network testnet -strategy cheapest -keep 123,456 0x1234567890abcdef
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strconv"
+	"strings"
+	"time"
+
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/duplicates"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/listings"
+)
+
+const filenameRemoveItem = "transactions/remove_item.cdc"
+
+// plan is the collapse of a group of listings.
+type plan struct {
+	group  duplicates.Group
+	keep   uint64
+	remove []uint64
+	err    error
+}
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the contract addresses are read from")
+	network := flag.String("network", "mainnet", "network of the configuration, and to execute scripts on")
+	host := flag.String("rest", "", "REST Access API host (defaults to the public host of the network)")
+	fixtures := flag.String("fixtures", "", "recorded fixtures to replay scripts from instead of the network")
+	batchSize := flag.Int("batch", listings.DefaultBatchSize, "number of listings read by a script execution")
+	keep := flag.String("keep", "", "comma-separated IDs of the listings to keep")
+	strategy := flag.String("strategy", "", "listing to keep for the NFTs without one in -keep: newest, oldest or cheapest")
+	asJSON := flag.Bool("json", false, "print the groups and the removals as JSON")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: duplicate-listings [flags] storefront-address\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	storefront, err := flowclient.HexToAddress(flag.Arg(0))
+	if err != nil {
+		fail("%s", err)
+	}
+
+	kept := map[uint64]bool{}
+	if *keep != "" {
+		for _, s := range strings.Split(*keep, ",") {
+			id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				fail("invalid listing ID %q in -keep", s)
+			}
+			kept[id] = true
+		}
+	}
+
+	config, err := flowconfig.Load(*configPath)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	executor, err := scriptExecutor(*fixtures, *host, *network)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	reader, err := listings.NewReader(config, *network, executor)
+	if err != nil {
+		fail("%s", err)
+	}
+	reader.BatchSize = *batchSize
+
+	read, err := reader.Listings(context.Background(), storefront)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	var plans []plan
+	for _, group := range duplicates.GroupListings(read) {
+		if !group.Duplicated() {
+			continue
+		}
+		p := plan{group: group}
+		for _, listing := range group.Listings {
+			if kept[listing.ListingResourceID] {
+				p.keep = listing.ListingResourceID
+				delete(kept, listing.ListingResourceID)
+			}
+		}
+		if p.keep == 0 && *strategy != "" {
+			p.keep, p.err = group.Choose(duplicates.Strategy(*strategy), time.Now())
+		}
+		if p.keep != 0 {
+			p.remove, p.err = group.Collapse(p.keep)
+		}
+		plans = append(plans, p)
+	}
+	for id := range kept {
+		fail("listing %d is not a duplicate listing of %s", id, storefront)
+	}
+
+	if *asJSON {
+		printJSON(plans)
+	} else {
+		printText(plans, *network)
+	}
+}
+
+func scriptExecutor(fixtures, host, network string) (flowclient.ScriptExecutor, error) {
+	if fixtures != "" {
+		return flowclient.LoadFake(fixtures)
+	}
+	if host == "" {
+		var ok bool
+		if host, ok = flowclient.RESTHosts[network]; !ok {
+			return nil, fmt.Errorf("either -rest or -fixtures must be given for network %s", network)
+		}
+	}
+	return flowclient.NewREST(host, nil), nil
+}
+
+func formatIDs(ids []uint64) string {
+	s := make([]string, len(ids))
+	for i, id := range ids {
+		s[i] = strconv.FormatUint(id, 10)
+	}
+	return strings.Join(s, ", ")
+}
+
+func printText(plans []plan, network string) {
+	var removals []uint64
+	for _, p := range plans {
+		fmt.Printf("%s #%d: %d listings\n", p.group.NFTType, p.group.NFTID, len(p.group.Listings))
+		for _, v := range p.group.Variants() {
+			fmt.Printf("  %s %s: %s\n", v.Price, v.TokenType, formatIDs(v.ListingResourceIDs))
+		}
+		switch {
+		case p.err != nil:
+			fmt.Printf("  not collapsed: %s\n", p.err)
+		case p.keep != 0:
+			fmt.Printf("  keep %d, remove %s\n", p.keep, formatIDs(p.remove))
+			removals = append(removals, p.remove...)
+		}
+	}
+	fmt.Printf("%d NFTs listed more than once\n", len(plans))
+
+	if len(removals) > 0 {
+		fmt.Printf("\nRemove the listings with the storefront account:\n\n")
+		for _, id := range removals {
+			fmt.Printf("flow transactions send %s %d --network %s\n", filenameRemoveItem, id, network)
+		}
+	}
+}
+
+func printJSON(plans []plan) {
+	type variant struct {
+		TokenType          string   `json:"tokenType"`
+		Price              string   `json:"price"`
+		ListingResourceIDs []uint64 `json:"listingResourceIds"`
+	}
+	type removal struct {
+		ListingResourceID uint64            `json:"listingResourceId"`
+		Arguments         []json.RawMessage `json:"arguments"`
+	}
+	type group struct {
+		NFTType  string    `json:"nftType"`
+		NFTID    uint64    `json:"nftId"`
+		Variants []variant `json:"variants"`
+		Keep     *uint64   `json:"keep,omitempty"`
+		Removals []removal `json:"removals,omitempty"`
+		Error    string    `json:"error,omitempty"`
+	}
+
+	result := make([]group, len(plans))
+	for i, p := range plans {
+		result[i] = group{NFTType: p.group.NFTType, NFTID: p.group.NFTID}
+		for _, v := range p.group.Variants() {
+			result[i].Variants = append(result[i].Variants, variant{v.TokenType, v.Price.String(), v.ListingResourceIDs})
+		}
+		if p.err != nil {
+			result[i].Error = p.err.Error()
+			continue
+		}
+		if p.keep == 0 {
+			continue
+		}
+		keep := p.keep
+		result[i].Keep = &keep
+		for _, id := range p.remove {
+			r := removal{ListingResourceID: id}
+			for _, value := range duplicates.RemoveItemArguments(id) {
+				encoded, err := jsoncdc.Encode(value)
+				if err != nil {
+					fail("%s", err)
+				}
+				r.Arguments = append(r.Arguments, encoded)
+			}
+			result[i].Removals = append(result[i].Removals, r)
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		fail("%s", err)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "duplicate-listings: "+format+"\n", args...)
+	os.Exit(2)
+}
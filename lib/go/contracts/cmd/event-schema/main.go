@@ -0,0 +1,84 @@
+// Command event-schema writes the schema of the events declared by the
+// embedded contracts and optionally compares it with a previous schema,
+// failing if any change breaks event consumers.
+//
+// Usage:
+//
+//	go run ./cmd/event-schema -o events.json
+//	go run ./cmd/event-schema -previous events.json -o events.json
+package main
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/eventschema"
+)
+
+func main() {
+	output := flag.String("o", "", "file to write the current schema to (defaults to stdout when -previous is not given)")
+	previousPath := flag.String("previous", "", "previous schema to compare the current schema with")
+	asJSON := flag.Bool("json", false, "print the changes as JSON")
+	flag.Parse()
+
+	current, err := eventschema.Embedded()
+	if err != nil {
+		fail("%s", err)
+	}
+
+	var changes []eventschema.Change
+	if *previousPath != "" {
+		f, err := os.Open(*previousPath)
+		if err != nil {
+			fail("%s", err)
+		}
+		previous, err := eventschema.Read(f)
+		f.Close()
+		if err != nil {
+			fail("%s", err)
+		}
+
+		changes = eventschema.Diff(previous, current)
+		if *asJSON {
+			encoder := json.NewEncoder(os.Stdout)
+			encoder.SetIndent("", "  ")
+			if err := encoder.Encode(changes); err != nil {
+				fail("%s", err)
+			}
+		} else {
+			for _, change := range changes {
+				fmt.Println(change)
+			}
+		}
+	}
+
+	switch {
+	case *output != "":
+		f, err := os.Create(*output)
+		if err != nil {
+			fail("%s", err)
+		}
+		err = current.Write(f)
+		if closeErr := f.Close(); err == nil {
+			err = closeErr
+		}
+		if err != nil {
+			fail("%s", err)
+		}
+	case *previousPath == "":
+		if err := current.Write(os.Stdout); err != nil {
+			fail("%s", err)
+		}
+	}
+
+	if eventschema.Breaking(changes) {
+		os.Exit(1)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "event-schema: "+format+"\n", args...)
+	os.Exit(2)
+}
@@ -0,0 +1,153 @@
+// Command export-payouts exports the payouts of the NFTStorefrontV2 purchases
+// of a range of blocks for finance reconciliation: one row for the commission,
+// each sale cut and the residual of every purchase, with its amount, receiver,
+// token type and listing ID, as CSV or Parquet.
+//
+// The sale cuts of a listing are read from the network when its
+// ListingAvailable event is seen, so only the purchases of listings created
+// in the range are exported; the others are reported on standard error.
+// Events and scripts are sent to the REST Access API of the network, or
+// replayed from fixtures recorded with flowclient.Recorder.
+//
+// Usage:
+//
+//	go run ./cmd/export-payouts -network mainnet -address 4eb8a10cb9f87357 -start 85000000 -o payouts.csv
+//	go run ./cmd/export-payouts -fixtures events.json -start 1 -format parquet -o payouts.parquet
+package main
+
+import (
+	"bytes"
+	"context"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+	"github.com/onflow/nft-storefront/lib/go/contracts/payouts"
+)
+
+const filenameReadListingDetails = "scripts/read_listing_details.cdc"
+
+// source is where events are fetched from and listing details read.
+type source interface {
+	flowclient.EventSource
+	flowclient.ScriptExecutor
+}
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the NFTStorefrontV2 address is read from")
+	network := flag.String("network", "mainnet", "network of the configuration, and to fetch events from")
+	address := flag.String("address", "", "address of the NFTStorefrontV2 contract (defaults to the one of the configuration)")
+	host := flag.String("rest", "", "REST Access API host (defaults to the public host of the network)")
+	fixtures := flag.String("fixtures", "", "recorded fixtures to replay events and scripts from instead of the network")
+	startHeight := flag.Uint64("start", 0, "first block height")
+	endHeight := flag.Uint64("end", 0, "last block height (defaults to the latest sealed block)")
+	format := flag.String("format", "csv", "output format: csv or parquet")
+	output := flag.String("o", "", "file to write (defaults to standard output)")
+	flag.Parse()
+
+	if *startHeight == 0 {
+		fail("-start is required")
+	}
+
+	var write func(io.Writer, []payouts.Line) error
+	switch *format {
+	case "csv":
+		write = payouts.WriteCSV
+	case "parquet":
+		write = payouts.WriteParquet
+	default:
+		fail("unknown format %q", *format)
+	}
+
+	contract, script, err := contractAndScript(*configPath, *network, *address)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	src, err := newSource(*fixtures, *host, *network)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	ctx := context.Background()
+	if *endHeight == 0 {
+		*endHeight, err = src.GetLatestBlockHeight(ctx)
+		if err != nil {
+			fail("%s", err)
+		}
+	}
+
+	collector := payouts.NewForContract(contract, src, script)
+	if err := flowclient.Feed(ctx, src, collector, *startHeight, *endHeight); err != nil {
+		fail("%s", err)
+	}
+	for _, id := range collector.Missing() {
+		fmt.Fprintf(os.Stderr, "export-payouts: no sale cuts for purchased listing %d\n", id)
+	}
+
+	var buf bytes.Buffer
+	if err := write(&buf, collector.Lines()); err != nil {
+		fail("%s", err)
+	}
+	if *output == "" {
+		_, err = os.Stdout.Write(buf.Bytes())
+	} else {
+		err = os.WriteFile(*output, buf.Bytes(), 0o644)
+	}
+	if err != nil {
+		fail("%s", err)
+	}
+}
+
+// contractAndScript returns the address of the contract and the script
+// reading listing details, importing the contract from that address.
+func contractAndScript(configPath, network, address string) (cadence.Address, []byte, error) {
+	code := templates.MustAsset(filenameReadListingDetails)
+
+	if address != "" {
+		contract, err := flowclient.HexToAddress(address)
+		if err != nil {
+			return cadence.Address{}, nil, err
+		}
+		script := bytes.ReplaceAll(code, []byte(`import "NFTStorefrontV2"`), []byte("import NFTStorefrontV2 from "+contract.String()))
+		return contract, script, nil
+	}
+
+	config, err := flowconfig.Load(configPath)
+	if err != nil {
+		return cadence.Address{}, nil, err
+	}
+	contract, err := config.Address("NFTStorefrontV2", network)
+	if err != nil {
+		return cadence.Address{}, nil, err
+	}
+	script, err := config.ResolveImports(code, network)
+	if err != nil {
+		return cadence.Address{}, nil, fmt.Errorf("%s: %w", filenameReadListingDetails, err)
+	}
+	return contract, script, nil
+}
+
+func newSource(fixtures, host, network string) (source, error) {
+	if fixtures != "" {
+		return flowclient.LoadFake(fixtures)
+	}
+	if host == "" {
+		var ok bool
+		if host, ok = flowclient.RESTHosts[network]; !ok {
+			return nil, fmt.Errorf("either -rest or -fixtures must be given for network %s", network)
+		}
+	}
+	return flowclient.NewREST(host, nil), nil
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "export-payouts: "+format+"\n", args...)
+	os.Exit(2)
+}
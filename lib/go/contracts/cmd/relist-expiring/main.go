@@ -0,0 +1,259 @@
+// Command relist-expiring renews the NFTStorefrontV2 listings of storefronts
+// that expire within a window, according to the relist policy of each
+// seller.
+//
+// A policy is lapse, extend:DURATION, renewing at the same price, or
+// decay:DURATION:CHANGE:FLOOR[:lapse], renewing at a price changed by a
+// negative percentage and never below the floor, lapsing once at the floor
+// with :lapse. -policy is the policy of every seller, and -policies a JSON
+// file of the policies of some sellers by address, such as
+//
+//	{"0x1234567890abcdef": "decay:24h:-10:5.0", "0xfedcba0987654321": "extend:168h"}
+//
+// The commands sending the transactions/sell_item_and_replace_current_listing.cdc
+// transactions renewing the listings with the seller accounts are printed,
+// or their arguments with -json. Package relist runs the scheduler
+// continuously and queues the transactions for signing.
+//
+// Scripts are executed through the REST Access API of the network, or
+// replayed from fixtures recorded with flowclient.Recorder.
+//
+// Usage:
+//
+//	go run ./cmd/relist-expiring -network mainnet -policy extend:168h -window 24h 0x1234567890abcdef
+//	go run ./cmd/relist-expiring -network mainnet -policies policies.json -step 0.01 0x1234567890abcdef 0xfedcba0987654321
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+	"github.com/onflow/nft-storefront/lib/go/contracts/relist"
+)
+
+const filenameRelist = "transactions/sell_item_and_replace_current_listing.cdc"
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the contract addresses are read from")
+	network := flag.String("network", "mainnet", "network of the configuration, and to execute scripts on")
+	host := flag.String("rest", "", "REST Access API host (defaults to the public host of the network)")
+	fixtures := flag.String("fixtures", "", "recorded fixtures to replay scripts from instead of the network")
+	batchSize := flag.Int("batch", receivers.DefaultBatchSize, "number of listings read by a script execution")
+	policy := flag.String("policy", "lapse", "policy of the sellers without one in -policies")
+	policiesPath := flag.String("policies", "", "JSON file of the policies of sellers by address")
+	step := flag.String("step", "", "multiple decayed prices are rounded down to, for example 0.01")
+	window := flag.Duration("window", relist.DefaultWindow, "renew the listings expiring within this time")
+	at := flag.Int64("at", 0, "Unix timestamp to compare expiries with (defaults to now)")
+	asJSON := flag.Bool("json", false, "print the renewals as JSON")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: relist-expiring [flags] storefront-address...\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	var storefronts []cadence.Address
+	for _, arg := range flag.Args() {
+		storefront, err := flowclient.HexToAddress(arg)
+		if err != nil {
+			fail("%s", err)
+		}
+		storefronts = append(storefronts, storefront)
+	}
+
+	var roundingStep cadence.UFix64
+	if *step != "" {
+		var err error
+		if roundingStep, err = cadenceconv.ParseUFix64(*step); err != nil {
+			fail("invalid -step %q: %s", *step, err)
+		}
+	}
+	defaultPolicy, err := relist.ParsePolicy(*policy, roundingStep)
+	if err != nil {
+		fail("%s", err)
+	}
+	policies, err := readPolicies(*policiesPath, roundingStep)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	config, err := flowconfig.Load(*configPath)
+	if err != nil {
+		fail("%s", err)
+	}
+	executor, err := scriptExecutor(*fixtures, *host, *network)
+	if err != nil {
+		fail("%s", err)
+	}
+	checker, err := receivers.NewChecker(config, *network, executor)
+	if err != nil {
+		fail("%s", err)
+	}
+	checker.BatchSize = *batchSize
+
+	scheduler := relist.NewScheduler(checker, &relist.MemoryQueue{}, defaultPolicy)
+	scheduler.Policies = policies
+	scheduler.Window = *window
+
+	now := time.Now()
+	if *at != 0 {
+		now = time.Unix(*at, 0)
+	}
+	result, err := scheduler.Tick(context.Background(), storefronts, now)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	if *asJSON {
+		printJSON(result)
+		return
+	}
+	printCommands(result, *network)
+}
+
+func scriptExecutor(fixtures, host, network string) (flowclient.ScriptExecutor, error) {
+	if fixtures != "" {
+		return flowclient.LoadFake(fixtures)
+	}
+	if host == "" {
+		var ok bool
+		if host, ok = flowclient.RESTHosts[network]; !ok {
+			return nil, fmt.Errorf("either -rest or -fixtures must be given for network %s", network)
+		}
+	}
+	return flowclient.NewREST(host, nil), nil
+}
+
+// readPolicies reads the policies of sellers from a JSON object mapping
+// addresses to policies.
+func readPolicies(path string, step cadence.UFix64) (map[cadence.Address]relist.Policy, error) {
+	policies := map[cadence.Address]relist.Policy{}
+	if path == "" {
+		return policies, nil
+	}
+
+	b, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	var raw map[string]string
+	if err := json.Unmarshal(b, &raw); err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	for s, p := range raw {
+		seller, err := flowclient.HexToAddress(s)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", path, err)
+		}
+		policy, err := relist.ParsePolicy(p, step)
+		if err != nil {
+			return nil, fmt.Errorf("%s: seller %s: %w", path, seller, err)
+		}
+		policies[seller] = policy
+	}
+	return policies, nil
+}
+
+func encode(values []cadence.Value) []json.RawMessage {
+	encoded := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		b, err := jsoncdc.Encode(value)
+		if err != nil {
+			fail("%s", err)
+		}
+		encoded[i] = b
+	}
+	return encoded
+}
+
+func printCommands(result relist.Result, network string) {
+	for _, job := range result.Queued {
+		values, err := job.Arguments()
+		if err != nil {
+			fail("%s", err)
+		}
+		args, err := json.Marshal(encode(values))
+		if err != nil {
+			fail("%s", err)
+		}
+		fmt.Printf("# listing %d of %s: %s until %s\n", job.Listing.ListingResourceID, job.Seller, job.Decision.Price,
+			time.Unix(int64(job.Decision.Expiry), 0).UTC().Format(time.RFC3339))
+		fmt.Printf("flow transactions send %s --args-json '%s' --network %s\n", filenameRelist, args, network)
+	}
+	for _, id := range result.Lapsed {
+		fmt.Fprintf(os.Stderr, "listing %d lapses\n", id)
+	}
+	for id, err := range result.Failed {
+		fmt.Fprintf(os.Stderr, "listing %d not renewed: %s\n", id, err)
+	}
+	fmt.Fprintf(os.Stderr, "%d renewed, %d lapsing, %d failed\n", len(result.Queued), len(result.Lapsed), len(result.Failed))
+}
+
+func printJSON(result relist.Result) {
+	type renewal struct {
+		Seller            string            `json:"seller"`
+		ListingResourceID uint64            `json:"listingResourceId"`
+		NFTID             uint64            `json:"nftId"`
+		Price             string            `json:"price"`
+		Expiry            uint64            `json:"expiry"`
+		NewPrice          string            `json:"newPrice"`
+		NewExpiry         uint64            `json:"newExpiry"`
+		Transaction       string            `json:"transaction"`
+		Arguments         []json.RawMessage `json:"arguments"`
+	}
+	var output struct {
+		Renewed []renewal         `json:"renewed"`
+		Lapsed  []uint64          `json:"lapsed"`
+		Failed  map[uint64]string `json:"failed"`
+	}
+
+	output.Renewed = make([]renewal, len(result.Queued))
+	for i, job := range result.Queued {
+		values, err := job.Arguments()
+		if err != nil {
+			fail("%s", err)
+		}
+		details := job.Listing.Details
+		output.Renewed[i] = renewal{
+			Seller:            job.Seller.String(),
+			ListingResourceID: job.Listing.ListingResourceID,
+			NFTID:             details.NFTID,
+			Price:             details.SalePrice.String(),
+			Expiry:            details.Expiry,
+			NewPrice:          job.Decision.Price.String(),
+			NewExpiry:         job.Decision.Expiry,
+			Transaction:       filenameRelist,
+			Arguments:         encode(values),
+		}
+	}
+	output.Lapsed = append([]uint64{}, result.Lapsed...)
+	output.Failed = map[uint64]string{}
+	for id, err := range result.Failed {
+		output.Failed[id] = err.Error()
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(output); err != nil {
+		fail("%s", err)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "relist-expiring: "+format+"\n", args...)
+	os.Exit(2)
+}
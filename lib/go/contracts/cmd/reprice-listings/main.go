@@ -0,0 +1,355 @@
+// Command reprice-listings reprices and delists the open NFTStorefrontV2
+// listings of a storefront in bulk.
+//
+// The new prices are computed by a rule: -percent changes every price by a
+// percentage, -floor prices listings at a percentage of the floor of the
+// storefront's listings of the same NFT type and payment token, and -table
+// reads prices by NFT ID from a CSV file of nftID,price rows. Listings are
+// delisted with -delist and -delist-below.
+//
+// With -dry-run, the ListingDetails fields that would change are printed.
+// Otherwise the commands sending the transactions/remove_item.cdc and
+// transactions/sell_item_and_replace_current_listing.cdc transactions with
+// the storefront account are printed, or their arguments with -json.
+//
+// Scripts are executed through the REST Access API of the network, or
+// replayed from fixtures recorded with flowclient.Recorder.
+//
+// Usage:
+//
+//	go run ./cmd/reprice-listings -network mainnet -percent -10 -step 0.01 -dry-run 0x1234567890abcdef
+//	go run ./cmd/reprice-listings -network testnet -floor 5 -delist-below 1.0 0x1234567890abcdef
+//	go run ./cmd/reprice-listings -network testnet -table prices.csv -delist 12,34 0x1234567890abcdef
+package main
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/json"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+	"github.com/onflow/nft-storefront/lib/go/contracts/reprice"
+)
+
+const (
+	filenameRemoveItem = "transactions/remove_item.cdc"
+	filenameRelist     = "transactions/sell_item_and_replace_current_listing.cdc"
+)
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the contract addresses are read from")
+	network := flag.String("network", "mainnet", "network of the configuration, and to execute scripts on")
+	host := flag.String("rest", "", "REST Access API host (defaults to the public host of the network)")
+	fixtures := flag.String("fixtures", "", "recorded fixtures to replay scripts from instead of the network")
+	batchSize := flag.Int("batch", receivers.DefaultBatchSize, "number of listings read by a script execution")
+	percent := flag.String("percent", "", "percentage to change every price by, for example -12.5")
+	floor := flag.String("floor", "", "percentage of the floor price to price every listing at, for example 5 for 5% above it")
+	table := flag.String("table", "", "CSV file of nftID,price rows to price the listed NFTs at")
+	rounding := flag.String("rounding", string(reprice.RoundDown), "rounding of computed prices: down, half-up or up")
+	step := flag.String("step", "", "multiple computed prices are rounded to, for example 0.01")
+	delist := flag.String("delist", "", "comma-separated IDs of the NFTs to delist")
+	delistBelow := flag.String("delist-below", "", "delist the listings priced below this price")
+	dryRun := flag.Bool("dry-run", false, "print the changes of the ListingDetails instead of the transactions")
+	asJSON := flag.Bool("json", false, "print the transactions as JSON")
+	flag.Usage = func() {
+		fmt.Fprintf(flag.CommandLine.Output(), "usage: reprice-listings [flags] storefront-address\n")
+		flag.PrintDefaults()
+	}
+	flag.Parse()
+
+	if flag.NArg() != 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+	storefront, err := flowclient.HexToAddress(flag.Arg(0))
+	if err != nil {
+		fail("%s", err)
+	}
+
+	round := reprice.Rounding{Mode: reprice.RoundingMode(*rounding)}
+	if *step != "" {
+		if round.Step, err = cadenceconv.ParseUFix64(*step); err != nil {
+			fail("invalid -step %q: %s", *step, err)
+		}
+	}
+
+	filter, err := delistFilter(*delist, *delistBelow)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	config, err := flowconfig.Load(*configPath)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	executor, err := scriptExecutor(*fixtures, *host, *network)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	checker, err := receivers.NewChecker(config, *network, executor)
+	if err != nil {
+		fail("%s", err)
+	}
+	checker.BatchSize = *batchSize
+
+	reports, err := checker.CheckStorefront(context.Background(), storefront)
+	if err != nil {
+		fail("%s", err)
+	}
+	listings := make([]receivers.ListingReceivers, len(reports))
+	for i, report := range reports {
+		listings[i] = report.Listing
+	}
+
+	rule, err := newRule(*percent, *floor, *table, round, listings)
+	if err != nil {
+		fail("%s", err)
+	}
+	if rule == nil && filter == nil {
+		fail("one of -percent, -floor, -table, -delist or -delist-below must be given")
+	}
+
+	changes := reprice.Plan(listings, rule, filter)
+	switch {
+	case *dryRun:
+		if err := reprice.WriteDiff(os.Stdout, changes); err != nil {
+			fail("%s", err)
+		}
+	case *asJSON:
+		printJSON(changes)
+	default:
+		printCommands(changes, *network)
+	}
+}
+
+func scriptExecutor(fixtures, host, network string) (flowclient.ScriptExecutor, error) {
+	if fixtures != "" {
+		return flowclient.LoadFake(fixtures)
+	}
+	if host == "" {
+		var ok bool
+		if host, ok = flowclient.RESTHosts[network]; !ok {
+			return nil, fmt.Errorf("either -rest or -fixtures must be given for network %s", network)
+		}
+	}
+	return flowclient.NewREST(host, nil), nil
+}
+
+func delistFilter(ids, below string) (reprice.Filter, error) {
+	var filters []reprice.Filter
+	if ids != "" {
+		var nftIDs []uint64
+		for _, s := range strings.Split(ids, ",") {
+			id, err := strconv.ParseUint(strings.TrimSpace(s), 10, 64)
+			if err != nil {
+				return nil, fmt.Errorf("invalid NFT ID %q in -delist", s)
+			}
+			nftIDs = append(nftIDs, id)
+		}
+		filters = append(filters, reprice.NFTIDs(nftIDs...))
+	}
+	if below != "" {
+		price, err := cadenceconv.ParseUFix64(below)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -delist-below %q: %w", below, err)
+		}
+		filters = append(filters, reprice.PricedBelow(price))
+	}
+
+	if len(filters) == 0 {
+		return nil, nil
+	}
+	return func(details nftstorefrontv2.ListingDetails) bool {
+		for _, filter := range filters {
+			if filter(details) {
+				return true
+			}
+		}
+		return false
+	}, nil
+}
+
+func newRule(percent, floor, table string, rounding reprice.Rounding, listings []receivers.ListingReceivers) (reprice.Rule, error) {
+	given := 0
+	for _, flag := range []string{percent, floor, table} {
+		if flag != "" {
+			given++
+		}
+	}
+	if given > 1 {
+		return nil, fmt.Errorf("only one of -percent, -floor and -table can be given")
+	}
+
+	switch {
+	case percent != "":
+		change, err := reprice.ParsePercentage(percent)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -percent %q: %w", percent, err)
+		}
+		return reprice.Percentage{Change: change, Rounding: rounding}, nil
+
+	case floor != "":
+		change, err := reprice.ParsePercentage(floor)
+		if err != nil {
+			return nil, fmt.Errorf("invalid -floor %q: %w", floor, err)
+		}
+		details := make([]nftstorefrontv2.ListingDetails, len(listings))
+		for i, listing := range listings {
+			details[i] = listing.Details
+		}
+		return reprice.FloorRelative{Floors: reprice.Floors(details), Change: change, Rounding: rounding}, nil
+
+	case table != "":
+		return readTable(table)
+	}
+	return nil, nil
+}
+
+// readTable reads a CSV file of nftID,price rows. A first row starting with
+// nftID is a header and is skipped.
+func readTable(path string) (reprice.FixedTable, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	reader := csv.NewReader(f)
+	reader.FieldsPerRecord = 2
+	reader.TrimLeadingSpace = true
+
+	table := reprice.FixedTable{}
+	for line := 1; ; line++ {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			return table, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+		if line == 1 && strings.EqualFold(record[0], "nftID") {
+			continue
+		}
+
+		id, err := strconv.ParseUint(record[0], 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid NFT ID %q", path, line, record[0])
+		}
+		price, err := cadenceconv.ParseUFix64(record[1])
+		if err != nil {
+			return nil, fmt.Errorf("%s:%d: invalid price %q: %w", path, line, record[1], err)
+		}
+		table[id] = price
+	}
+}
+
+func encode(values []cadence.Value) []json.RawMessage {
+	encoded := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		b, err := jsoncdc.Encode(value)
+		if err != nil {
+			fail("%s", err)
+		}
+		encoded[i] = b
+	}
+	return encoded
+}
+
+func printCommands(changes []reprice.Change, network string) {
+	for _, change := range reprice.Order(changes) {
+		if change.Action == reprice.Delist {
+			fmt.Printf("flow transactions send %s %d --network %s\n", filenameRemoveItem, change.Listing.ListingResourceID, network)
+			continue
+		}
+		values, err := change.RelistArguments()
+		if err != nil {
+			fail("%s", err)
+		}
+		args, err := json.Marshal(encode(values))
+		if err != nil {
+			fail("%s", err)
+		}
+		fmt.Printf("flow transactions send %s --args-json '%s' --network %s\n", filenameRelist, args, network)
+	}
+
+	kept := 0
+	for _, change := range changes {
+		if change.Action != reprice.Keep {
+			continue
+		}
+		kept++
+		if change.Err != nil {
+			fmt.Fprintf(os.Stderr, "listing %d kept: %s\n", change.Listing.ListingResourceID, change.Err)
+		}
+	}
+	fmt.Fprintf(os.Stderr, "%d listings kept\n", kept)
+}
+
+func printJSON(changes []reprice.Change) {
+	type transaction struct {
+		ListingResourceID uint64            `json:"listingResourceId"`
+		NFTID             uint64            `json:"nftId"`
+		Action            reprice.Action    `json:"action"`
+		Price             string            `json:"price"`
+		NewPrice          string            `json:"newPrice,omitempty"`
+		Transaction       string            `json:"transaction,omitempty"`
+		Arguments         []json.RawMessage `json:"arguments,omitempty"`
+		Error             string            `json:"error,omitempty"`
+	}
+
+	result := make([]transaction, len(changes))
+	for i, change := range changes {
+		t := transaction{
+			ListingResourceID: change.Listing.ListingResourceID,
+			NFTID:             change.Listing.Details.NFTID,
+			Action:            change.Action,
+			Price:             change.Listing.Details.SalePrice.String(),
+		}
+		switch change.Action {
+		case reprice.Delist:
+			values, err := change.RemoveItemArguments()
+			if err != nil {
+				fail("%s", err)
+			}
+			t.Transaction, t.Arguments = filenameRemoveItem, encode(values)
+		case reprice.Reprice:
+			values, err := change.RelistArguments()
+			if err != nil {
+				fail("%s", err)
+			}
+			t.NewPrice = change.Price.String()
+			t.Transaction, t.Arguments = filenameRelist, encode(values)
+		}
+		if change.Err != nil {
+			t.Error = change.Err.Error()
+		}
+		result[i] = t
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		fail("%s", err)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "reprice-listings: "+format+"\n", args...)
+	os.Exit(2)
+}
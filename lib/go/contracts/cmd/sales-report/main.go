@@ -0,0 +1,218 @@
+// Command sales-report reports the NFTStorefrontV2 sales of a range of
+// blocks: the volume, median and last sale price of each NFT type and payment
+// token, and the floor price of their open listings. Events are fetched from
+// the REST Access API of the network, or replayed from fixtures recorded with
+// flowclient.Recorder.
+//
+// Usage:
+//
+//	go run ./cmd/sales-report -network mainnet -address 4eb8a10cb9f87357 -start 85000000
+//	go run ./cmd/sales-report -fixtures events.json -since 24h -interval 1h
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/analytics"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+)
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the NFTStorefrontV2 address is read from")
+	network := flag.String("network", "mainnet", "network of the configuration, and to fetch events from")
+	address := flag.String("address", "", "address of the NFTStorefrontV2 contract (defaults to the one of the configuration)")
+	host := flag.String("rest", "", "REST Access API host (defaults to the public host of the network)")
+	fixtures := flag.String("fixtures", "", "recorded fixtures to replay events from instead of the network")
+	startHeight := flag.Uint64("start", 0, "first block height")
+	endHeight := flag.Uint64("end", 0, "last block height (defaults to the latest sealed block)")
+	since := flag.Duration("since", 0, "only count the sales of this period before the last block (defaults to all)")
+	interval := flag.Duration("interval", 0, "also print the price history of each market in windows of this length")
+	asJSON := flag.Bool("json", false, "print the report as JSON")
+	flag.Parse()
+
+	if *startHeight == 0 {
+		fail("-start is required")
+	}
+
+	contract, err := contractAddress(*configPath, *network, *address)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	source, err := eventSource(*fixtures, *host, *network)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	ctx := context.Background()
+	if *endHeight == 0 {
+		*endHeight, err = source.GetLatestBlockHeight(ctx)
+		if err != nil {
+			fail("%s", err)
+		}
+	}
+
+	aggregator := analytics.New(contract)
+	if err := flowclient.Feed(ctx, source, aggregator, *startHeight, *endHeight); err != nil {
+		fail("%s", err)
+	}
+
+	// Sales of the last block are included.
+	end := aggregator.Time().Add(time.Nanosecond)
+	var start time.Time
+	if *since > 0 {
+		start = end.Add(-*since)
+	} else if sales := aggregator.Sales(); len(sales) > 0 {
+		start = sales[0].Time
+	}
+
+	report, err := aggregator.Report(start, end)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	histories := map[analytics.Key][]analytics.Stats{}
+	if *interval > 0 {
+		for _, stats := range report {
+			histories[stats.Key], err = aggregator.History(stats.Key, start, end, *interval)
+			if err != nil {
+				fail("%s", err)
+			}
+		}
+	}
+
+	if *asJSON {
+		printJSON(report, histories)
+		return
+	}
+	printTable(report, histories)
+}
+
+func contractAddress(configPath, network, address string) (cadence.Address, error) {
+	if address != "" {
+		return flowclient.HexToAddress(address)
+	}
+	config, err := flowconfig.Load(configPath)
+	if err != nil {
+		return cadence.Address{}, err
+	}
+	return config.Address("NFTStorefrontV2", network)
+}
+
+func eventSource(fixtures, host, network string) (flowclient.EventSource, error) {
+	if fixtures != "" {
+		return flowclient.LoadFake(fixtures)
+	}
+	if host == "" {
+		var ok bool
+		if host, ok = flowclient.RESTHosts[network]; !ok {
+			return nil, fmt.Errorf("either -rest or -fixtures must be given for network %s", network)
+		}
+	}
+	return flowclient.NewREST(host, nil), nil
+}
+
+func price(p *cadence.UFix64) string {
+	if p == nil {
+		return "-"
+	}
+	return p.String()
+}
+
+func printTable(report []analytics.Stats, histories map[analytics.Key][]analytics.Stats) {
+	w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintln(w, "NFT TYPE\tPAYMENT TYPE\tSALES\tVOLUME\tMEDIAN\tLAST\tLISTINGS\tFLOOR")
+	for _, s := range report {
+		fmt.Fprintf(w, "%s\t%s\t%d\t%s\t%s\t%s\t%d\t%s\n", s.NFTType, s.PaymentType, s.Sales, s.Volume, price(s.Median), price(s.Last), s.Listings, price(s.Floor))
+	}
+	w.Flush()
+
+	for _, s := range report {
+		history, ok := histories[s.Key]
+		if !ok {
+			continue
+		}
+		fmt.Printf("\n%s\n", s.Key)
+		w := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintln(w, "START\tSALES\tVOLUME\tMEDIAN\tLAST")
+		for _, h := range history {
+			fmt.Fprintf(w, "%s\t%d\t%s\t%s\t%s\n", h.Start.UTC().Format(time.RFC3339), h.Sales, h.Volume, price(h.Median), price(h.Last))
+		}
+		w.Flush()
+	}
+}
+
+func printJSON(report []analytics.Stats, histories map[analytics.Key][]analytics.Stats) {
+	type stats struct {
+		NFTType     string     `json:"nftType"`
+		PaymentType string     `json:"paymentType"`
+		Start       time.Time  `json:"start"`
+		End         time.Time  `json:"end"`
+		Sales       int        `json:"sales"`
+		Volume      string     `json:"volume"`
+		Commission  string     `json:"commission"`
+		Median      *string    `json:"median"`
+		Last        *string    `json:"last"`
+		LastSale    *time.Time `json:"lastSale,omitempty"`
+		Listings    *int       `json:"listings,omitempty"`
+		Floor       *string    `json:"floor,omitempty"`
+		History     []stats    `json:"history,omitempty"`
+	}
+
+	optional := func(p *cadence.UFix64) *string {
+		if p == nil {
+			return nil
+		}
+		s := p.String()
+		return &s
+	}
+	convert := func(s analytics.Stats) stats {
+		converted := stats{
+			NFTType:     s.NFTType,
+			PaymentType: s.PaymentType,
+			Start:       s.Start.UTC(),
+			End:         s.End.UTC(),
+			Sales:       s.Sales,
+			Volume:      s.Volume.String(),
+			Commission:  s.Commission.String(),
+			Median:      optional(s.Median),
+			Last:        optional(s.Last),
+		}
+		if s.Sales > 0 {
+			lastSale := s.LastSale.UTC()
+			converted.LastSale = &lastSale
+		}
+		return converted
+	}
+
+	result := make([]stats, len(report))
+	for i, s := range report {
+		result[i] = convert(s)
+		listings := s.Listings
+		result[i].Listings = &listings
+		result[i].Floor = optional(s.Floor)
+		for _, h := range histories[s.Key] {
+			result[i].History = append(result[i].History, convert(h))
+		}
+	}
+
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err := encoder.Encode(result); err != nil {
+		fail("%s", err)
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "sales-report: "+format+"\n", args...)
+	os.Exit(2)
+}
@@ -0,0 +1,128 @@
+// Command unpaid-monitor watches NFTStorefrontV2 purchases for sale cuts that
+// could not be paid, which the contract reports with UnpaidReceiver events.
+// Each unpaid cut is logged to standard output and, with -webhook, posted as
+// JSON to a URL. With -listen, the unpaid amounts of each receiver are served
+// as JSON, for a single receiver with ?receiver=0x....
+//
+// Events are fetched from the REST Access API of the network as blocks are
+// sealed, or, with -fixtures or -end, from a fixed range of blocks.
+//
+// Usage:
+//
+//	go run ./cmd/unpaid-monitor -network mainnet -address 4eb8a10cb9f87357 -webhook https://example.com/alerts -listen :8080
+//	go run ./cmd/unpaid-monitor -fixtures events.json -start 1
+package main
+
+import (
+	"context"
+	"flag"
+	"fmt"
+	"net/http"
+	"os"
+	"os/signal"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/unpaid"
+)
+
+func main() {
+	configPath := flag.String("config", flowconfig.DefaultPath, "configuration the NFTStorefrontV2 address is read from")
+	network := flag.String("network", "mainnet", "network of the configuration, and to fetch events from")
+	address := flag.String("address", "", "address of the NFTStorefrontV2 contract (defaults to the one of the configuration)")
+	host := flag.String("rest", "", "REST Access API host (defaults to the public host of the network)")
+	fixtures := flag.String("fixtures", "", "recorded fixtures to replay events from instead of the network")
+	startHeight := flag.Uint64("start", 0, "first block height (defaults to the latest sealed block)")
+	endHeight := flag.Uint64("end", 0, "last block height (defaults to watching new blocks, or the last recorded block with -fixtures)")
+	interval := flag.Duration("interval", 10*time.Second, "interval between polls for new blocks")
+	webhook := flag.String("webhook", "", "URL to post alerts to")
+	listen := flag.String("listen", "", "address to serve the unpaid amounts of each receiver on")
+	flag.Parse()
+
+	contract, err := contractAddress(*configPath, *network, *address)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	source, err := eventSource(*fixtures, *host, *network)
+	if err != nil {
+		fail("%s", err)
+	}
+
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt)
+	defer stop()
+
+	if *startHeight == 0 {
+		*startHeight, err = source.GetLatestBlockHeight(ctx)
+		if err != nil {
+			fail("%s", err)
+		}
+	}
+
+	ledger := unpaid.NewLedger()
+	sinks := []unpaid.Sink{ledger, unpaid.NewLogSink(os.Stdout)}
+	if *webhook != "" {
+		sinks = append(sinks, unpaid.NewWebhookSink(*webhook, &http.Client{Timeout: 10 * time.Second}))
+	}
+	monitor := unpaid.New(contract, sinks...)
+	monitor.SinkError = func(_ unpaid.Sink, alert unpaid.Alert, err error) {
+		fmt.Fprintf(os.Stderr, "unpaid-monitor: alert of height %d: %s\n", alert.Height, err)
+	}
+
+	if *listen != "" {
+		server := &http.Server{Addr: *listen, Handler: ledger.Handler(), ReadHeaderTimeout: 10 * time.Second}
+		go func() {
+			if err := server.ListenAndServe(); err != http.ErrServerClosed {
+				fail("%s", err)
+			}
+		}()
+		defer server.Close()
+	}
+
+	if *fixtures != "" && *endHeight == 0 {
+		*endHeight, err = source.GetLatestBlockHeight(ctx)
+		if err != nil {
+			fail("%s", err)
+		}
+	}
+	if *endHeight != 0 {
+		err = flowclient.Feed(ctx, source, monitor, *startHeight, *endHeight)
+	} else {
+		err = flowclient.Watch(ctx, source, monitor, *startHeight, *interval)
+	}
+	if err != nil && ctx.Err() == nil {
+		fail("%s", err)
+	}
+}
+
+func contractAddress(configPath, network, address string) (cadence.Address, error) {
+	if address != "" {
+		return flowclient.HexToAddress(address)
+	}
+	config, err := flowconfig.Load(configPath)
+	if err != nil {
+		return cadence.Address{}, err
+	}
+	return config.Address("NFTStorefrontV2", network)
+}
+
+func eventSource(fixtures, host, network string) (flowclient.EventSource, error) {
+	if fixtures != "" {
+		return flowclient.LoadFake(fixtures)
+	}
+	if host == "" {
+		var ok bool
+		if host, ok = flowclient.RESTHosts[network]; !ok {
+			return nil, fmt.Errorf("either -rest or -fixtures must be given for network %s", network)
+		}
+	}
+	return flowclient.NewREST(host, nil), nil
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "unpaid-monitor: "+format+"\n", args...)
+	os.Exit(2)
+}
@@ -0,0 +1,183 @@
+// Package compat checks whether a new version of a storefront contract can
+// replace the deployed one.
+//
+// Cadence validates contract updates: stored fields may not be added or
+// retyped, declarations may not be removed and conformances may not be
+// dropped. Other changes are accepted by the network but still break the
+// off-chain consumers of the contract, for example a changed event signature.
+package compat
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cdc"
+)
+
+// Severity classifies a finding.
+type Severity int
+
+const (
+	// Breaking changes are accepted by the network but break scripts,
+	// transactions or event consumers written against the old version.
+	Breaking Severity = iota
+	// Rejected changes fail the contract update validation of Cadence.
+	Rejected
+)
+
+func (s Severity) String() string {
+	switch s {
+	case Breaking:
+		return "breaking"
+	case Rejected:
+		return "rejected"
+	default:
+		return fmt.Sprintf("Severity(%d)", int(s))
+	}
+}
+
+// Finding is a single incompatible change.
+type Finding struct {
+	Severity Severity
+	// Declaration is the qualified name of the changed declaration or event.
+	Declaration string
+	Message     string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s: %s", f.Severity, f.Declaration, f.Message)
+}
+
+// Report is the result of comparing two contract versions.
+type Report struct {
+	Findings []Finding
+}
+
+// Rejected reports whether the network would reject the update.
+func (r Report) Rejected() bool {
+	return r.has(Rejected)
+}
+
+// Breaking reports whether the update contains breaking changes.
+func (r Report) Breaking() bool {
+	return r.has(Breaking)
+}
+
+func (r Report) has(severity Severity) bool {
+	for _, f := range r.Findings {
+		if f.Severity == severity {
+			return true
+		}
+	}
+	return false
+}
+
+func (r *Report) add(severity Severity, declaration, format string, args ...interface{}) {
+	r.Findings = append(r.Findings, Finding{
+		Severity:    severity,
+		Declaration: declaration,
+		Message:     fmt.Sprintf(format, args...),
+	})
+}
+
+// Check compares the deployed contract source with the source that should
+// replace it.
+func Check(deployed, updated []byte) (Report, error) {
+	old, err := cdc.Parse(deployed)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to parse deployed contract: %w", err)
+	}
+	next, err := cdc.Parse(updated)
+	if err != nil {
+		return Report{}, fmt.Errorf("failed to parse updated contract: %w", err)
+	}
+
+	var report Report
+
+	if old.Name != next.Name {
+		report.add(Rejected, old.Name, "contract is renamed to %s", next.Name)
+		return report, nil
+	}
+
+	for _, o := range old.Declarations {
+		n := next.Declaration(o.QualifiedName)
+		if n == nil {
+			report.add(Rejected, o.QualifiedName, "%s is removed", o.KindDescription())
+			continue
+		}
+		checkDeclaration(&report, o, n)
+	}
+
+	for _, o := range old.Events {
+		n := next.Event(o.QualifiedName)
+		if n == nil {
+			report.add(Breaking, o.QualifiedName, "event is removed")
+			continue
+		}
+		checkEvent(&report, o, n)
+	}
+
+	return report, nil
+}
+
+func checkDeclaration(report *Report, old, next *cdc.Declaration) {
+	name := old.QualifiedName
+
+	if old.Kind != next.Kind || old.Interface != next.Interface {
+		report.add(Rejected, name, "%s is changed to %s", old.KindDescription(), next.KindDescription())
+		return
+	}
+
+	for _, conformance := range old.Conformances {
+		if !contains(next.Conformances, conformance) {
+			report.add(Rejected, name, "conformance to %s is removed", conformance)
+		}
+	}
+
+	nextFields := make(map[string]cdc.Field, len(next.Fields))
+	for _, f := range next.Fields {
+		nextFields[f.Name] = f
+	}
+
+	for _, o := range old.Fields {
+		n, ok := nextFields[o.Name]
+		if !ok {
+			report.add(Breaking, name, "field %s is removed", o.Name)
+			continue
+		}
+		delete(nextFields, o.Name)
+
+		if o.Type != n.Type {
+			report.add(Rejected, name, "field %s changes type from %s to %s", o.Name, o.Type, n.Type)
+		}
+	}
+
+	for _, n := range next.Fields {
+		if _, ok := nextFields[n.Name]; ok {
+			report.add(Rejected, name, "field %s: %s is added", n.Name, n.Type)
+		}
+	}
+}
+
+func checkEvent(report *Report, old, next *cdc.Event) {
+	if signature(old) != signature(next) {
+		report.add(Breaking, old.QualifiedName, "signature changes from %s to %s", signature(old), signature(next))
+	}
+}
+
+func signature(event *cdc.Event) string {
+	parameters := make([]string, 0, len(event.Parameters))
+	for _, p := range event.Parameters {
+		parameters = append(parameters, p.Name+": "+p.Type)
+	}
+	return "(" + strings.Join(parameters, ", ") + ")"
+}
+
+func contains(values []string, value string) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
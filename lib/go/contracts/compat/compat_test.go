@@ -0,0 +1,91 @@
+package compat_test
+
+import (
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts"
+	"github.com/onflow/nft-storefront/lib/go/contracts/compat"
+)
+
+const addrA = "0A"
+
+func storefront(t *testing.T, replacements ...string) []byte {
+	code := string(contracts.NFTStorefrontV2(addrA, addrA))
+	for i := 0; i < len(replacements); i += 2 {
+		require.Contains(t, code, replacements[i])
+		code = strings.Replace(code, replacements[i], replacements[i+1], 1)
+	}
+	return []byte(code)
+}
+
+func TestCheckIdenticalContract(t *testing.T) {
+	report, err := compat.Check(storefront(t), storefront(t))
+	require.NoError(t, err)
+
+	assert.Empty(t, report.Findings)
+}
+
+func TestCheckFieldChanges(t *testing.T) {
+	updated := storefront(t,
+		"access(all) let salePrice: UFix64", "access(all) let salePrice: UInt64",
+		"access(all) let amount: UFix64", "access(all) let amount: UFix64\n        access(all) let note: String",
+		"access(contract) var listedNFTs: {String: {UInt64 : [UInt64]}}", "",
+	)
+
+	report, err := compat.Check(storefront(t), updated)
+	require.NoError(t, err)
+
+	assert.True(t, report.Rejected())
+	assert.Equal(t, []compat.Finding{
+		{
+			Severity:    compat.Rejected,
+			Declaration: "NFTStorefrontV2.SaleCut",
+			Message:     "field note: String is added",
+		},
+		{
+			Severity:    compat.Rejected,
+			Declaration: "NFTStorefrontV2.ListingDetails",
+			Message:     "field salePrice changes type from UFix64 to UInt64",
+		},
+		{
+			Severity:    compat.Breaking,
+			Declaration: "NFTStorefrontV2.Storefront",
+			Message:     "field listedNFTs is removed",
+		},
+	}, report.Findings)
+}
+
+func TestCheckConformanceChanges(t *testing.T) {
+	updated := storefront(t,
+		"access(all) resource Storefront : StorefrontManager, StorefrontPublic {",
+		"access(all) resource Storefront : StorefrontPublic {",
+	)
+
+	report, err := compat.Check(storefront(t), updated)
+	require.NoError(t, err)
+
+	require.Len(t, report.Findings, 1)
+	assert.Equal(t, compat.Rejected, report.Findings[0].Severity)
+	assert.Equal(t, "NFTStorefrontV2.Storefront", report.Findings[0].Declaration)
+}
+
+func TestCheckEventChanges(t *testing.T) {
+	updated := storefront(t,
+		"commissionReceivers: [Address]?,", "commissionReceiver: Address?,",
+		"access(all) event UnpaidReceiver(receiver: Address, entitledSaleCut: UFix64)", "",
+	)
+
+	report, err := compat.Check(storefront(t), updated)
+	require.NoError(t, err)
+
+	assert.False(t, report.Rejected())
+	assert.True(t, report.Breaking())
+	require.Len(t, report.Findings, 2)
+	assert.Equal(t, "NFTStorefrontV2.ListingAvailable", report.Findings[0].Declaration)
+	assert.Contains(t, report.Findings[0].Message, "commissionReceiver: Address?")
+	assert.Equal(t, "NFTStorefrontV2.UnpaidReceiver", report.Findings[1].Declaration)
+}
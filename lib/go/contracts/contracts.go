@@ -1,6 +1,8 @@
 package contracts
 
 //go:generate go run github.com/kevinburke/go-bindata/go-bindata -prefix ../../../contracts -o internal/assets/assets.go -pkg assets -nometadata -nomemcopy ../../../contracts/...
+//go:generate go run github.com/kevinburke/go-bindata/go-bindata -prefix ../../../ -ignore \.md$ -o internal/templates/templates.go -pkg templates -nometadata -nomemcopy ../../../scripts/... ../../../transactions/... ../../../transactions-v1/...
+//go:generate go run ./internal/cmd/bindgen -o bindings NFTStorefrontV2.cdc NFTStorefront.cdc
 
 import (
 	"regexp"
@@ -15,18 +17,34 @@ var (
 	fungibleTokenImport         = "FungibleToken from "
 	placeholderNonFungibleToken = regexp.MustCompile(`"NonFungibleToken"`)
 	nftImport                   = "NonFungibleToken from "
+	placeholderBurner           = regexp.MustCompile(`"Burner"`)
+	burnerImport                = "Burner from "
 )
 
 const (
+	filenameNFTStorefront   = "NFTStorefront.cdc"
 	filenameNFTStorefrontV2 = "NFTStorefrontV2.cdc"
 )
 
-// NFTStorefrontV2 returns the NFTStorefrontV2 contract.
+// NFTStorefront returns the NFTStorefront contract.
+func NFTStorefront(ftAddr, nftAddr string) []byte {
+	code := assets.MustAssetString(filenameNFTStorefront)
+
+	code = placeholderFungibleToken.ReplaceAllString(code, fungibleTokenImport+"0x"+ftAddr)
+	code = placeholderNonFungibleToken.ReplaceAllString(code, nftImport+"0x"+nftAddr)
+
+	return []byte(code)
+}
+
+// NFTStorefrontV2 returns the NFTStorefrontV2 contract. Burner is imported
+// from the non-fungible token account, as both are deployed to the service
+// account when a Flow chain is bootstrapped.
 func NFTStorefrontV2(ftAddr, nftAddr string) []byte {
 	code := assets.MustAssetString(filenameNFTStorefrontV2)
 
 	code = placeholderFungibleToken.ReplaceAllString(code, fungibleTokenImport+"0x"+ftAddr)
 	code = placeholderNonFungibleToken.ReplaceAllString(code, nftImport+"0x"+nftAddr)
+	code = placeholderBurner.ReplaceAllString(code, burnerImport+"0x"+nftAddr)
 
 	return []byte(code)
 }
@@ -10,7 +10,14 @@ import (
 
 const addrA = "0A"
 
+func TestNFTStorefrontContract(t *testing.T) {
+	contract := contracts.NFTStorefront(addrA, addrA)
+	assert.NotNil(t, contract)
+}
+
 func TestNFTStorefrontV2Contract(t *testing.T) {
 	contract := contracts.NFTStorefrontV2(addrA, addrA)
 	assert.NotNil(t, contract)
+	assert.Contains(t, string(contract), "import Burner from 0x"+addrA)
+	assert.NotContains(t, string(contract), `"Burner"`)
 }
@@ -0,0 +1,394 @@
+// Package customid scopes NFTStorefrontV2 listings to the dApps that created
+// them.
+//
+// The contract lets the transaction creating a listing tag it with a
+// customID, which it stores in ListingDetails and emits in the
+// ListingAvailable and ListingCompleted events, so that the dApps sharing a
+// storefront can tell their listings apart. A Scope selects listings by
+// customID, a View summarizes the listings and sales of each customID from
+// events, and a Registry maps customIDs to the dApps using them.
+package customid
+
+import (
+	"context"
+	"fmt"
+	"math/bits"
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/blocks"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/listings"
+)
+
+// Scope selects listings by customID. The zero Scope selects every listing.
+type Scope struct {
+	customIDs map[string]bool
+	untagged  bool
+}
+
+// Only returns the scope of the listings tagged with one of the given
+// customIDs.
+func Only(customIDs ...string) Scope {
+	scope := Scope{customIDs: make(map[string]bool, len(customIDs))}
+	for _, customID := range customIDs {
+		scope.customIDs[customID] = true
+	}
+	return scope
+}
+
+// Untagged returns the scope of the listings created without a customID.
+func Untagged() Scope {
+	return Scope{customIDs: map[string]bool{}, untagged: true}
+}
+
+// WithUntagged returns the scope that also selects the listings created
+// without a customID.
+func (s Scope) WithUntagged() Scope {
+	if s.all() {
+		return s
+	}
+	s.untagged = true
+	return s
+}
+
+func (s Scope) all() bool {
+	return s.customIDs == nil && !s.untagged
+}
+
+// Contains reports whether the scope selects the listings tagged with the
+// given customID, nil for the listings created without one.
+func (s Scope) Contains(customID *string) bool {
+	switch {
+	case s.all():
+		return true
+	case customID == nil:
+		return s.untagged
+	default:
+		return s.customIDs[*customID]
+	}
+}
+
+// Listings returns the listings the scope selects, for example read by
+// listings.Reader.
+func (s Scope) Listings(all []listings.Listing) []listings.Listing {
+	var selected []listings.Listing
+	for _, listing := range all {
+		if s.Contains(listing.Details.CustomID) {
+			selected = append(selected, listing)
+		}
+	}
+	return selected
+}
+
+// key is the map key of a customID.
+type key struct {
+	customID string
+	tagged   bool
+}
+
+func keyOf(customID *string) key {
+	if customID == nil {
+		return key{}
+	}
+	return key{customID: *customID, tagged: true}
+}
+
+func (k key) pointer() *string {
+	if !k.tagged {
+		return nil
+	}
+	customID := k.customID
+	return &customID
+}
+
+// Summary is the listings and sales of a customID.
+type Summary struct {
+	// CustomID is nil for the listings created without a customID.
+	CustomID *string
+
+	// Listed is the number of listings created, Open the number of those
+	// neither purchased nor removed, and Expired the number of the open ones
+	// that have expired.
+	Listed  int
+	Open    int
+	Expired int
+	// Removed is the number of listings removed or cleaned up without being
+	// purchased.
+	Removed int
+
+	Sales int
+	// Volume is the sum of the sale prices, and Commission the sum of the
+	// commissions.
+	Volume     cadence.UFix64
+	Commission cadence.UFix64
+}
+
+func (s *Summary) addSale(price, commission cadence.UFix64) error {
+	volume, overflow := bits.Add64(uint64(s.Volume), uint64(price), 0)
+	if overflow != 0 {
+		return fmt.Errorf("volume of customID %s overflows UFix64", Label(s.CustomID))
+	}
+	total, overflow := bits.Add64(uint64(s.Commission), uint64(commission), 0)
+	if overflow != 0 {
+		return fmt.Errorf("commission of customID %s overflows UFix64", Label(s.CustomID))
+	}
+	s.Sales++
+	s.Volume, s.Commission = cadence.UFix64(volume), cadence.UFix64(total)
+	return nil
+}
+
+// Label returns the customID, or "-" for nil.
+func Label(customID *string) string {
+	if customID == nil {
+		return "-"
+	}
+	return *customID
+}
+
+// sortSummaries orders summaries by decreasing volume, then decreasing
+// number of listings, then customID, untagged first.
+func sortSummaries(summaries []Summary) {
+	sort.Slice(summaries, func(i, j int) bool {
+		a, b := summaries[i], summaries[j]
+		if a.Volume != b.Volume {
+			return a.Volume > b.Volume
+		}
+		if a.Listed != b.Listed {
+			return a.Listed > b.Listed
+		}
+		if (a.CustomID == nil) != (b.CustomID == nil) {
+			return a.CustomID == nil
+		}
+		return Label(a.CustomID) < Label(b.CustomID)
+	})
+}
+
+// SummarizeListings summarizes the listings of the scope read from
+// storefronts, for example by listings.Reader. They count as listed, and as
+// sales if they are purchased but not yet cleaned up; the listings removed
+// from the storefronts are unknown.
+func SummarizeListings(scope Scope, all []listings.Listing, now time.Time) ([]Summary, error) {
+	summaries := map[key]*Summary{}
+	for _, listing := range scope.Listings(all) {
+		k := keyOf(listing.Details.CustomID)
+		summary, ok := summaries[k]
+		if !ok {
+			summary = &Summary{CustomID: k.pointer()}
+			summaries[k] = summary
+		}
+
+		summary.Listed++
+		switch {
+		case listing.Details.Purchased:
+			if err := summary.addSale(listing.Details.SalePrice, listing.Details.CommissionAmount); err != nil {
+				return nil, err
+			}
+		case listing.Expired(now):
+			summary.Open++
+			summary.Expired++
+		default:
+			summary.Open++
+		}
+	}
+	return collect(summaries), nil
+}
+
+func collect(summaries map[key]*Summary) []Summary {
+	result := make([]Summary, 0, len(summaries))
+	for _, summary := range summaries {
+		result = append(result, *summary)
+	}
+	sortSummaries(result)
+	return result
+}
+
+// Sale is a purchased listing of the scope of a View.
+type Sale struct {
+	CustomID          *string
+	Time              time.Time
+	Height            uint64
+	TransactionID     flowclient.Identifier
+	ListingResourceID uint64
+	// NFTType and PaymentType are type identifiers.
+	NFTType          string
+	PaymentType      string
+	NFTID            uint64
+	Price            cadence.UFix64
+	CommissionAmount cadence.UFix64
+}
+
+// View records the listings and sales of a scope from the events it is fed.
+type View struct {
+	scope         Scope
+	availableType string
+	completedType string
+
+	blocks.Cursor
+	summaries map[key]*Summary
+	open      map[uint64]nftstorefrontv2.ListingAvailable
+	sales     []Sale
+}
+
+var _ flowclient.Consumer = (*View)(nil)
+
+// NewView returns a view of the listings of the scope created by the
+// NFTStorefrontV2 contract deployed to the given address.
+func NewView(storefrontContract cadence.Address, scope Scope) *View {
+	return &View{
+		scope:         scope,
+		availableType: nftstorefrontv2.ListingAvailableEventType(storefrontContract),
+		completedType: nftstorefrontv2.ListingCompletedEventType(storefrontContract),
+		summaries:     map[key]*Summary{},
+		open:          map[uint64]nftstorefrontv2.ListingAvailable{},
+	}
+}
+
+// EventTypes returns the types of the events the view needs.
+func (v *View) EventTypes() []string {
+	return []string{v.availableType, v.completedType}
+}
+
+// customID returns the customID of a ListingAvailable or ListingCompleted
+// event, and false for the events of other types.
+func (v *View) customID(event flowclient.Event) (*string, bool, error) {
+	switch event.Type {
+	case v.availableType:
+		available, err := nftstorefrontv2.DecodeListingAvailable(event.Value)
+		return available.CustomID, err == nil, err
+	case v.completedType:
+		completed, err := nftstorefrontv2.DecodeListingCompleted(event.Value)
+		return completed.CustomID, err == nil, err
+	}
+	return nil, false, nil
+}
+
+// FilterBlock returns the block without the ListingAvailable and
+// ListingCompleted events of the listings out of the scope, for example to
+// feed an analytics.Aggregator the sales of a dApp only. Events of other
+// types are kept.
+func (v *View) FilterBlock(block flowclient.BlockEvents) (flowclient.BlockEvents, error) {
+	filtered := block
+	filtered.Events = nil
+	for _, event := range block.Events {
+		customID, ok, err := v.customID(event)
+		if err != nil {
+			return block, fmt.Errorf("block %d: %w", block.Height, err)
+		}
+		if ok && !v.scope.Contains(customID) {
+			continue
+		}
+		filtered.Events = append(filtered.Events, event)
+	}
+	return filtered, nil
+}
+
+func (v *View) summary(customID *string) *Summary {
+	k := keyOf(customID)
+	summary, ok := v.summaries[k]
+	if !ok {
+		summary = &Summary{CustomID: k.pointer()}
+		v.summaries[k] = summary
+	}
+	return summary
+}
+
+// AddBlock records the listings and sales of the scope in a block. Blocks
+// must be added in order of height, with their events in the order they
+// were emitted.
+func (v *View) AddBlock(_ context.Context, block flowclient.BlockEvents) error {
+	if err := blocks.Advance(&v.Cursor, block); err != nil {
+		return err
+	}
+
+	for _, event := range block.Events {
+		switch event.Type {
+		case v.availableType:
+			available, err := nftstorefrontv2.DecodeListingAvailable(event.Value)
+			if err != nil {
+				return fmt.Errorf("block %d: %w", block.Height, err)
+			}
+			if !v.scope.Contains(available.CustomID) {
+				continue
+			}
+			v.summary(available.CustomID).Listed++
+			v.open[available.ListingResourceID] = available
+
+		case v.completedType:
+			completed, err := nftstorefrontv2.DecodeListingCompleted(event.Value)
+			if err != nil {
+				return fmt.Errorf("block %d: %w", block.Height, err)
+			}
+			if !v.scope.Contains(completed.CustomID) {
+				continue
+			}
+			delete(v.open, completed.ListingResourceID)
+
+			summary := v.summary(completed.CustomID)
+			if !completed.Purchased {
+				summary.Removed++
+				continue
+			}
+			if err := summary.addSale(completed.SalePrice, completed.CommissionAmount); err != nil {
+				return fmt.Errorf("block %d: %w", block.Height, err)
+			}
+			v.sales = append(v.sales, Sale{
+				CustomID:          completed.CustomID,
+				Time:              block.BlockTimestamp,
+				Height:            block.Height,
+				TransactionID:     event.TransactionID,
+				ListingResourceID: completed.ListingResourceID,
+				NFTType:           cadenceconv.TypeID(completed.NFTType),
+				PaymentType:       cadenceconv.TypeID(completed.SalePaymentVaultType),
+				NFTID:             completed.NFTID,
+				Price:             completed.SalePrice,
+				CommissionAmount:  completed.CommissionAmount,
+			})
+		}
+	}
+
+	return nil
+}
+
+// Sales returns the sales recorded so far, in the order they happened.
+func (v *View) Sales() []Sale {
+	return append([]Sale(nil), v.sales...)
+}
+
+// Listings returns the listings created in the blocks added and still open
+// after the last one, in increasing order of listing resource ID.
+func (v *View) Listings() []nftstorefrontv2.ListingAvailable {
+	listings := make([]nftstorefrontv2.ListingAvailable, 0, len(v.open))
+	for _, listing := range v.open {
+		listings = append(listings, listing)
+	}
+	sort.Slice(listings, func(i, j int) bool {
+		return listings[i].ListingResourceID < listings[j].ListingResourceID
+	})
+	return listings
+}
+
+// Summaries returns the summary of each customID with listings or sales in
+// the blocks added, ordered by decreasing volume. Listings are open if they
+// were created in the blocks added and not completed since, and expired if
+// they expired before the last block.
+func (v *View) Summaries() []Summary {
+	summaries := make(map[key]*Summary, len(v.summaries))
+	for k, summary := range v.summaries {
+		s := *summary
+		s.Open, s.Expired = 0, 0
+		summaries[k] = &s
+	}
+	for _, listing := range v.open {
+		summary := summaries[keyOf(listing.CustomID)]
+		summary.Open++
+		if listing.Expiry <= uint64(v.Time().Unix()) {
+			summary.Expired++
+		}
+	}
+	return collect(summaries)
+}
@@ -0,0 +1,296 @@
+package customid_test
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/analytics"
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/customid"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/listings"
+)
+
+var (
+	storefrontContract = testfixtures.StorefrontContract
+	ufix64             = testfixtures.UFix64
+	seller             = testfixtures.Seller
+
+	start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	exampleNFT = testfixtures.ExampleNFT
+	flowToken  = testfixtures.FlowToken
+
+	flowty = "flowty"
+	other  = "other"
+)
+
+type listing struct {
+	id       uint64
+	customID *string
+	price    string
+	expiry   time.Time
+}
+
+func available(t *testing.T, l listing) flowclient.Event {
+	t.Helper()
+
+	return flowclient.Event{
+		Type: nftstorefrontv2.ListingAvailableEventType(storefrontContract),
+		Value: nftstorefrontv2.ListingAvailable{
+			StorefrontAddress:    seller,
+			ListingResourceID:    l.id,
+			NFTType:              exampleNFT,
+			NFTID:                l.id * 10,
+			SalePaymentVaultType: flowToken,
+			SalePrice:            ufix64(t, l.price),
+			CustomID:             l.customID,
+			Expiry:               uint64(l.expiry.Unix()),
+		}.Encode(storefrontContract),
+	}
+}
+
+func completed(t *testing.T, l listing, purchased bool, commission string) flowclient.Event {
+	t.Helper()
+
+	return flowclient.Event{
+		Type: nftstorefrontv2.ListingCompletedEventType(storefrontContract),
+		Value: nftstorefrontv2.ListingCompleted{
+			ListingResourceID:    l.id,
+			Purchased:            purchased,
+			NFTType:              exampleNFT,
+			NFTID:                l.id * 10,
+			SalePaymentVaultType: flowToken,
+			SalePrice:            ufix64(t, l.price),
+			CustomID:             l.customID,
+			CommissionAmount:     ufix64(t, commission),
+			Expiry:               uint64(l.expiry.Unix()),
+		}.Encode(storefrontContract),
+	}
+}
+
+// blocks are these blocks:
+//
+//	10 at 0h: listings 1 and 2 of flowty, 3 of other and 4 untagged
+//	11 at 1h: listings 1 and 3 purchased
+//	12 at 2h: listing 2 removed, listing 5 of flowty, expired by then, and
+//	          listing 6 of flowty
+func blocks(t *testing.T) []flowclient.BlockEvents {
+	t.Helper()
+
+	later := start.Add(24 * time.Hour)
+	l1 := listing{1, &flowty, "10.0", later}
+	l2 := listing{2, &flowty, "12.0", later}
+	l3 := listing{3, &other, "8.0", later}
+	l4 := listing{4, nil, "5.0", later}
+	l5 := listing{5, &flowty, "6.0", start.Add(90 * time.Minute)}
+	l6 := listing{6, &flowty, "7.0", later}
+
+	blocks := []flowclient.BlockEvents{
+		{
+			Height:         10,
+			BlockTimestamp: start,
+			Events:         []flowclient.Event{available(t, l1), available(t, l2), available(t, l3), available(t, l4)},
+		},
+		{
+			Height:         11,
+			BlockTimestamp: start.Add(time.Hour),
+			Events:         []flowclient.Event{completed(t, l1, true, "1.0"), completed(t, l3, true, "0.0")},
+		},
+		{
+			Height:         12,
+			BlockTimestamp: start.Add(2 * time.Hour),
+			Events:         []flowclient.Event{completed(t, l2, false, "0.0"), available(t, l5), available(t, l6)},
+		},
+	}
+	for _, block := range blocks {
+		for i := range block.Events {
+			block.Events[i].EventIndex = i
+		}
+	}
+	return blocks
+}
+
+func recorded(t *testing.T) *flowclient.Fake {
+	t.Helper()
+
+	var fixtures flowclient.Fixtures
+	for _, block := range blocks(t) {
+		require.NoError(t, fixtures.AddBlock(block))
+	}
+	fake, err := flowclient.NewFake(&fixtures)
+	require.NoError(t, err)
+	return fake
+}
+
+func feed(t *testing.T, scope customid.Scope) *customid.View {
+	t.Helper()
+
+	view := customid.NewView(storefrontContract, scope)
+	require.NoError(t, flowclient.Feed(context.Background(), recorded(t), view, 1, 12))
+	return view
+}
+
+func TestScope(t *testing.T) {
+	t.Parallel()
+
+	for name, tc := range map[string]struct {
+		scope                   customid.Scope
+		flowty, other, untagged bool
+	}{
+		"zero":                {customid.Scope{}, true, true, true},
+		"only":                {customid.Only(flowty), true, false, false},
+		"only none":           {customid.Only(), false, false, false},
+		"untagged":            {customid.Untagged(), false, false, true},
+		"only with untagged":  {customid.Only(flowty).WithUntagged(), true, false, true},
+		"zero with untagged":  {customid.Scope{}.WithUntagged(), true, true, true},
+		"only several":        {customid.Only(flowty, other), true, true, false},
+		"empty customID only": {customid.Only(""), false, false, false},
+	} {
+		tc := tc
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, tc.flowty, tc.scope.Contains(&flowty))
+			assert.Equal(t, tc.other, tc.scope.Contains(&other))
+			assert.Equal(t, tc.untagged, tc.scope.Contains(nil))
+		})
+	}
+}
+
+func TestViewSummaries(t *testing.T) {
+	t.Parallel()
+
+	view := feed(t, customid.Scope{})
+	assert.Equal(t, uint64(12), view.Height())
+	assert.Equal(t, []customid.Summary{
+		{CustomID: &flowty, Listed: 4, Open: 2, Expired: 1, Removed: 1, Sales: 1, Volume: ufix64(t, "10.0"), Commission: ufix64(t, "1.0")},
+		{CustomID: &other, Listed: 1, Sales: 1, Volume: ufix64(t, "8.0")},
+		{Listed: 1, Open: 1},
+	}, view.Summaries())
+
+	sales := view.Sales()
+	require.Len(t, sales, 2)
+	assert.Equal(t, &flowty, sales[0].CustomID)
+	assert.Equal(t, uint64(1), sales[0].ListingResourceID)
+	assert.Equal(t, "A.f8d6e0586b0a20c7.ExampleNFT.NFT", sales[0].NFTType)
+	assert.Equal(t, uint64(11), sales[0].Height)
+}
+
+func TestViewScoped(t *testing.T) {
+	t.Parallel()
+
+	view := feed(t, customid.Only(flowty))
+	summaries := view.Summaries()
+	require.Len(t, summaries, 1)
+	assert.Equal(t, &flowty, summaries[0].CustomID)
+	assert.Len(t, view.Sales(), 1)
+
+	var open []uint64
+	for _, listing := range view.Listings() {
+		open = append(open, listing.ListingResourceID)
+	}
+	assert.Equal(t, []uint64{5, 6}, open)
+
+	view = feed(t, customid.Untagged())
+	assert.Equal(t, []customid.Summary{{Listed: 1, Open: 1}}, view.Summaries())
+}
+
+func TestFilterBlock(t *testing.T) {
+	t.Parallel()
+
+	view := customid.NewView(storefrontContract, customid.Only(flowty))
+	aggregator := analytics.New(storefrontContract)
+
+	unrelated := flowclient.Event{Type: "A.0000000000000001.Other.Event"}
+	for _, block := range blocks(t) {
+		block.Events = append(block.Events, unrelated)
+
+		filtered, err := view.FilterBlock(block)
+		require.NoError(t, err)
+		assert.Contains(t, filtered.Events, unrelated)
+		assert.Equal(t, block.Height, filtered.Height)
+		require.NoError(t, aggregator.AddBlock(context.Background(), filtered))
+	}
+
+	// Only the sale of listing 1, created with the flowty customID, is
+	// aggregated.
+	sales := aggregator.Sales()
+	require.Len(t, sales, 1)
+	assert.Equal(t, uint64(1), sales[0].ListingResourceID)
+}
+
+func TestSummarizeListings(t *testing.T) {
+	t.Parallel()
+
+	details := func(customID *string, price string, purchased bool, expiry time.Time) nftstorefrontv2.ListingDetails {
+		return nftstorefrontv2.ListingDetails{
+			Purchased:            purchased,
+			NFTType:              exampleNFT,
+			SalePaymentVaultType: flowToken,
+			SalePrice:            ufix64(t, price),
+			CustomID:             customID,
+			Expiry:               uint64(expiry.Unix()),
+		}
+	}
+	later := start.Add(time.Hour)
+	read := []listings.Listing{
+		{ListingResourceID: 1, Details: details(&flowty, "1.0", false, later)},
+		{ListingResourceID: 2, Details: details(&flowty, "2.0", true, later)},
+		{ListingResourceID: 3, Details: details(&flowty, "3.0", false, start)},
+		{ListingResourceID: 4, Details: details(&other, "4.0", false, later)},
+		{ListingResourceID: 5, Details: details(nil, "5.0", false, later)},
+	}
+
+	scope := customid.Only(flowty).WithUntagged()
+	selected := scope.Listings(read)
+	require.Len(t, selected, 4)
+	assert.Equal(t, uint64(5), selected[3].ListingResourceID)
+
+	summaries, err := customid.SummarizeListings(scope, read, start)
+	require.NoError(t, err)
+	assert.Equal(t, []customid.Summary{
+		{CustomID: &flowty, Listed: 3, Open: 2, Expired: 1, Sales: 1, Volume: ufix64(t, "2.0")},
+		{Listed: 1, Open: 1},
+	}, summaries)
+}
+
+func TestRegistry(t *testing.T) {
+	t.Parallel()
+
+	registry, err := customid.ParseRegistry(strings.NewReader(`[
+		{"customID": "other", "name": "Other"},
+		{"customID": "flowty", "name": "Flowty", "url": "https://www.flowty.io"}
+	]`))
+	require.NoError(t, err)
+
+	dapp, ok := registry.Lookup(&flowty)
+	require.True(t, ok)
+	assert.Equal(t, customid.DApp{CustomID: "flowty", Name: "Flowty", URL: "https://www.flowty.io"}, dapp)
+
+	unknown := "unknown"
+	assert.Equal(t, "Flowty", registry.Name(&flowty))
+	assert.Equal(t, "unknown", registry.Name(&unknown))
+	assert.Equal(t, "-", registry.Name(nil))
+
+	dapps := registry.DApps()
+	require.Len(t, dapps, 2)
+	assert.Equal(t, "flowty", dapps[0].CustomID)
+
+	scope := registry.Scope()
+	assert.True(t, scope.Contains(&other))
+	assert.False(t, scope.Contains(&unknown))
+	assert.False(t, scope.Contains(nil))
+
+	err = registry.Register(customid.DApp{CustomID: "flowty", Name: "Copy"})
+	assert.EqualError(t, err, `customID "flowty" of dApp "Copy" is already registered to "Flowty"`)
+
+	_, err = customid.NewRegistry(customid.DApp{Name: "Nameless"})
+	assert.EqualError(t, err, `dApp "Nameless" has no customID`)
+}
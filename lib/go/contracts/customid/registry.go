@@ -0,0 +1,109 @@
+package customid
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+)
+
+// DApp is the metadata of a dApp tagging the listings it creates with a
+// customID.
+type DApp struct {
+	CustomID    string `json:"customID"`
+	Name        string `json:"name"`
+	URL         string `json:"url,omitempty"`
+	Description string `json:"description,omitempty"`
+}
+
+// Registry maps customIDs to the dApps using them.
+type Registry struct {
+	dapps map[string]DApp
+}
+
+// NewRegistry returns a registry of the given dApps.
+func NewRegistry(dapps ...DApp) (*Registry, error) {
+	r := &Registry{dapps: make(map[string]DApp, len(dapps))}
+	for _, dapp := range dapps {
+		if err := r.Register(dapp); err != nil {
+			return nil, err
+		}
+	}
+	return r, nil
+}
+
+// LoadRegistry reads a registry from the given file.
+func LoadRegistry(path string) (*Registry, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	r, err := ParseRegistry(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return r, nil
+}
+
+// ParseRegistry reads a registry from a JSON array of dApps, such as
+//
+//	[{"customID": "flowty", "name": "Flowty", "url": "https://www.flowty.io"}]
+func ParseRegistry(r io.Reader) (*Registry, error) {
+	var dapps []DApp
+	if err := json.NewDecoder(r).Decode(&dapps); err != nil {
+		return nil, err
+	}
+	return NewRegistry(dapps...)
+}
+
+// Register adds a dApp to the registry. Each customID belongs to one dApp.
+func (r *Registry) Register(dapp DApp) error {
+	if dapp.CustomID == "" {
+		return fmt.Errorf("dApp %q has no customID", dapp.Name)
+	}
+	if existing, ok := r.dapps[dapp.CustomID]; ok {
+		return fmt.Errorf("customID %q of dApp %q is already registered to %q", dapp.CustomID, dapp.Name, existing.Name)
+	}
+	r.dapps[dapp.CustomID] = dapp
+	return nil
+}
+
+// Lookup returns the dApp tagging its listings with the given customID.
+func (r *Registry) Lookup(customID *string) (DApp, bool) {
+	if r == nil || customID == nil {
+		return DApp{}, false
+	}
+	dapp, ok := r.dapps[*customID]
+	return dapp, ok
+}
+
+// Name returns the name of the dApp of the given customID, or the customID
+// itself if it is not registered, or "-" for nil.
+func (r *Registry) Name(customID *string) string {
+	if dapp, ok := r.Lookup(customID); ok {
+		return dapp.Name
+	}
+	return Label(customID)
+}
+
+// DApps returns the registered dApps, ordered by customID.
+func (r *Registry) DApps() []DApp {
+	dapps := make([]DApp, 0, len(r.dapps))
+	for _, dapp := range r.dapps {
+		dapps = append(dapps, dapp)
+	}
+	sort.Slice(dapps, func(i, j int) bool { return dapps[i].CustomID < dapps[j].CustomID })
+	return dapps
+}
+
+// Scope returns the scope of the listings of the registered dApps.
+func (r *Registry) Scope() Scope {
+	customIDs := make([]string, 0, len(r.dapps))
+	for customID := range r.dapps {
+		customIDs = append(customIDs, customID)
+	}
+	return Only(customIDs...)
+}
@@ -0,0 +1,208 @@
+// Package duplicates manages the duplicate listings of a NFTStorefrontV2
+// storefront: listings of the same NFT at several prices or in several
+// payment tokens.
+//
+// The contract tracks the listings of each NFT and removes the others when
+// one of them is purchased, but sellers have no view of them. GroupListings
+// groups the listings of a storefront, as read by listings.Reader, by NFT,
+// and Collapse and BuildRemoval turn a group into the
+// transactions/remove_items.cdc transaction keeping only one chosen listing.
+package duplicates
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/listings"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+const filenameRemoveItems = "transactions/remove_items.cdc"
+
+// Group is the listings of an NFT.
+type Group struct {
+	// NFTType is a type identifier.
+	NFTType  string
+	NFTID    uint64
+	Listings []listings.Listing
+}
+
+// Duplicated reports whether the NFT has more than one listing.
+func (g Group) Duplicated() bool {
+	return len(g.Listings) > 1
+}
+
+// Variant is the listings of an NFT at a price in a payment token.
+type Variant struct {
+	// TokenType is a type identifier.
+	TokenType          string
+	Price              cadence.UFix64
+	ListingResourceIDs []uint64
+}
+
+// Variants returns the prices and payment tokens the NFT is listed at,
+// ordered by token type and price.
+func (g Group) Variants() []Variant {
+	type key struct {
+		tokenType string
+		price     cadence.UFix64
+	}
+
+	var variants []Variant
+	index := map[key]int{}
+	for _, listing := range g.Listings {
+		k := key{cadenceconv.TypeID(listing.Details.SalePaymentVaultType), listing.Details.SalePrice}
+		i, ok := index[k]
+		if !ok {
+			i = len(variants)
+			index[k] = i
+			variants = append(variants, Variant{TokenType: k.tokenType, Price: k.price})
+		}
+		variants[i].ListingResourceIDs = append(variants[i].ListingResourceIDs, listing.ListingResourceID)
+	}
+
+	sort.Slice(variants, func(i, j int) bool {
+		if variants[i].TokenType != variants[j].TokenType {
+			return variants[i].TokenType < variants[j].TokenType
+		}
+		return variants[i].Price < variants[j].Price
+	})
+	return variants
+}
+
+// GroupListings groups the listings that are not purchased by NFT. Groups
+// are ordered by NFT type and ID, and their listings by listing resource ID.
+func GroupListings(listings []listings.Listing) []Group {
+	type key struct {
+		nftType string
+		nftID   uint64
+	}
+
+	var groups []Group
+	index := map[key]int{}
+	for _, listing := range listings {
+		if listing.Details.Purchased {
+			continue
+		}
+		k := key{cadenceconv.TypeID(listing.Details.NFTType), listing.Details.NFTID}
+		i, ok := index[k]
+		if !ok {
+			i = len(groups)
+			index[k] = i
+			groups = append(groups, Group{NFTType: k.nftType, NFTID: k.nftID})
+		}
+		groups[i].Listings = append(groups[i].Listings, listing)
+	}
+
+	for _, group := range groups {
+		sort.Slice(group.Listings, func(i, j int) bool {
+			return group.Listings[i].ListingResourceID < group.Listings[j].ListingResourceID
+		})
+	}
+	sort.Slice(groups, func(i, j int) bool {
+		if groups[i].NFTType != groups[j].NFTType {
+			return groups[i].NFTType < groups[j].NFTType
+		}
+		return groups[i].NFTID < groups[j].NFTID
+	})
+	return groups
+}
+
+// Strategy chooses the listing of a group to keep.
+type Strategy string
+
+const (
+	// KeepNewest keeps the listing with the highest resource ID, the last
+	// one created.
+	KeepNewest Strategy = "newest"
+	// KeepOldest keeps the listing with the lowest resource ID, the first
+	// one created.
+	KeepOldest Strategy = "oldest"
+	// KeepCheapest keeps the listing with the lowest price, or the newest of
+	// those. The listings must be in a single payment token.
+	KeepCheapest Strategy = "cheapest"
+)
+
+// Choose returns the listing to keep according to the strategy, among those
+// that are not expired at the given time.
+func (g Group) Choose(strategy Strategy, now time.Time) (uint64, error) {
+	var candidates []listings.Listing
+	for _, listing := range g.Listings {
+		if !listing.Expired(now) {
+			candidates = append(candidates, listing)
+		}
+	}
+	if len(candidates) == 0 {
+		return 0, fmt.Errorf("%s #%d has no listing that is not expired", g.NFTType, g.NFTID)
+	}
+
+	switch strategy {
+	case KeepNewest:
+		return candidates[len(candidates)-1].ListingResourceID, nil
+	case KeepOldest:
+		return candidates[0].ListingResourceID, nil
+	case KeepCheapest:
+		cheapest := candidates[0]
+		for _, listing := range candidates[1:] {
+			if cadenceconv.TypeID(listing.Details.SalePaymentVaultType) != cadenceconv.TypeID(cheapest.Details.SalePaymentVaultType) {
+				return 0, fmt.Errorf("%s #%d is listed in several payment tokens", g.NFTType, g.NFTID)
+			}
+			if listing.Details.SalePrice <= cheapest.Details.SalePrice {
+				cheapest = listing
+			}
+		}
+		return cheapest.ListingResourceID, nil
+	default:
+		return 0, fmt.Errorf("unknown strategy %q", strategy)
+	}
+}
+
+// Collapse returns the IDs of the listings to remove to keep only the given
+// one, in increasing order.
+func (g Group) Collapse(keep uint64) ([]uint64, error) {
+	var remove []uint64
+	found := false
+	for _, listing := range g.Listings {
+		if listing.ListingResourceID == keep {
+			found = true
+			continue
+		}
+		remove = append(remove, listing.ListingResourceID)
+	}
+	if !found {
+		return nil, fmt.Errorf("listing %d is not a listing of %s #%d", keep, g.NFTType, g.NFTID)
+	}
+	return remove, nil
+}
+
+// RemoveItemArguments returns the arguments of transactions/remove_item.cdc
+// removing the given listing.
+func RemoveItemArguments(listingResourceID uint64) []cadence.Value {
+	return []cadence.Value{cadence.NewUInt64(listingResourceID)}
+}
+
+// RemoveItemsArguments returns the arguments of transactions/remove_items.cdc
+// removing the given listings.
+func RemoveItemsArguments(listingResourceIDs []uint64) []cadence.Value {
+	ids := make([]cadence.Value, len(listingResourceIDs))
+	for i, id := range listingResourceIDs {
+		ids[i] = cadence.NewUInt64(id)
+	}
+	return []cadence.Value{cadence.NewArray(ids)}
+}
+
+// BuildRemoval returns the unsigned transactions/remove_items.cdc transaction
+// removing the given listings at once. The storefront owner authorizes it.
+func BuildRemoval(builder *txbuilder.Builder, storefront cadence.Address, listingResourceIDs []uint64, roles txbuilder.Roles) (*txbuilder.Transaction, error) {
+	roles.Authorizers = []cadence.Address{storefront}
+
+	tx, err := builder.Build(filenameRemoveItems, RemoveItemsArguments(listingResourceIDs), roles)
+	if err != nil {
+		return nil, fmt.Errorf("remove listings %v: %w", listingResourceIDs, err)
+	}
+	return tx, nil
+}
@@ -0,0 +1,155 @@
+package duplicates_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/duplicates"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/listings"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+var (
+	seller = testfixtures.Seller
+	payer  = testfixtures.Creator
+
+	now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	flowToken    = testfixtures.FlowToken
+	exampleToken = testfixtures.ExampleToken
+)
+
+func listing(id, nftID uint64, token cadence.Type, price cadence.UFix64) listings.Listing {
+	return listings.Listing{
+		ListingResourceID: id,
+		Details: nftstorefrontv2.ListingDetails{
+			NFTType:              testfixtures.ExampleNFT,
+			NFTID:                nftID,
+			SalePaymentVaultType: token,
+			SalePrice:            price,
+			Expiry:               uint64(now.Add(time.Hour).Unix()),
+		},
+	}
+}
+
+// storefront returns NFT 1 listed three times, twice in FlowToken and once in
+// ExampleToken, NFT 2 listed twice in FlowToken, NFT 3 listed once and NFT 4
+// listed once but purchased.
+func storefront() []listings.Listing {
+	purchased := listing(8, 4, flowToken, 1_00000000)
+	purchased.Details.Purchased = true
+	return []listings.Listing{
+		listing(5, 2, flowToken, 3_00000000),
+		listing(1, 1, flowToken, 10_00000000),
+		listing(2, 1, exampleToken, 10_00000000),
+		listing(3, 1, flowToken, 10_00000000),
+		listing(4, 2, flowToken, 2_00000000),
+		listing(6, 3, flowToken, 5_00000000),
+		purchased,
+	}
+}
+
+func ids(all []listings.Listing) []uint64 {
+	var result []uint64
+	for _, l := range all {
+		result = append(result, l.ListingResourceID)
+	}
+	return result
+}
+
+func TestGroupListings(t *testing.T) {
+	groups := duplicates.GroupListings(storefront())
+	require.Len(t, groups, 3)
+
+	assert.Equal(t, "A.f8d6e0586b0a20c7.ExampleNFT.NFT", groups[0].NFTType)
+	assert.Equal(t, uint64(1), groups[0].NFTID)
+	assert.Equal(t, []uint64{1, 2, 3}, ids(groups[0].Listings))
+	assert.True(t, groups[0].Duplicated())
+	assert.Equal(t, []duplicates.Variant{
+		{TokenType: "A.f8d6e0586b0a20c7.ExampleToken.Vault", Price: 10_00000000, ListingResourceIDs: []uint64{2}},
+		{TokenType: "A.f8d6e0586b0a20c7.FlowToken.Vault", Price: 10_00000000, ListingResourceIDs: []uint64{1, 3}},
+	}, groups[0].Variants())
+
+	assert.Equal(t, uint64(2), groups[1].NFTID)
+	assert.Equal(t, []uint64{4, 5}, ids(groups[1].Listings))
+	assert.Len(t, groups[1].Variants(), 2)
+
+	assert.Equal(t, uint64(3), groups[2].NFTID)
+	assert.False(t, groups[2].Duplicated())
+}
+
+func TestChoose(t *testing.T) {
+	groups := duplicates.GroupListings(storefront())
+	nft1, nft2 := groups[0], groups[1]
+
+	for _, test := range []struct {
+		group    duplicates.Group
+		strategy duplicates.Strategy
+		keep     uint64
+		err      string
+	}{
+		{group: nft1, strategy: duplicates.KeepNewest, keep: 3},
+		{group: nft1, strategy: duplicates.KeepOldest, keep: 1},
+		{group: nft1, strategy: duplicates.KeepCheapest, err: "A.f8d6e0586b0a20c7.ExampleNFT.NFT #1 is listed in several payment tokens"},
+		{group: nft2, strategy: duplicates.KeepCheapest, keep: 4},
+		{group: nft2, strategy: "random", err: `unknown strategy "random"`},
+	} {
+		keep, err := test.group.Choose(test.strategy, now)
+		if test.err != "" {
+			assert.EqualError(t, err, test.err, test.strategy)
+			continue
+		}
+		require.NoError(t, err, test.strategy)
+		assert.Equal(t, test.keep, keep, test.strategy)
+	}
+
+	// Expired listings are not kept.
+	nft2.Listings[0].Details.Expiry = uint64(now.Unix())
+	keep, err := nft2.Choose(duplicates.KeepCheapest, now)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(5), keep)
+
+	_, err = nft2.Choose(duplicates.KeepNewest, now.Add(2*time.Hour))
+	assert.EqualError(t, err, "A.f8d6e0586b0a20c7.ExampleNFT.NFT #2 has no listing that is not expired")
+}
+
+func TestCollapse(t *testing.T) {
+	group := duplicates.GroupListings(storefront())[0]
+
+	remove, err := group.Collapse(2)
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{1, 3}, remove)
+
+	_, err = group.Collapse(4)
+	assert.EqualError(t, err, "listing 4 is not a listing of A.f8d6e0586b0a20c7.ExampleNFT.NFT #1")
+}
+
+func TestBuildRemoval(t *testing.T) {
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+
+	roles := txbuilder.Roles{
+		ReferenceBlockID: flowclient.Identifier{1},
+		Proposer:         flowclient.ProposalKey{Address: seller, KeyIndex: 1, SequenceNumber: 7},
+		Payer:            payer,
+	}
+	tx, err := duplicates.BuildRemoval(txbuilder.New(config, "testnet"), seller, []uint64{1, 3}, roles)
+	require.NoError(t, err)
+
+	assert.Contains(t, string(tx.Script), "import NFTStorefrontV2 from 0x2d55b98eb200daef")
+	assert.Equal(t, uint64(7), tx.ProposalKey.SequenceNumber)
+	assert.Equal(t, uint32(1), tx.ProposalKey.KeyIndex)
+	assert.Equal(t, payer, tx.Payer)
+	assert.Equal(t, []cadence.Address{seller}, tx.Authorizers)
+	assert.Equal(t, []cadence.Value{
+		cadence.NewArray([]cadence.Value{cadence.NewUInt64(1), cadence.NewUInt64(3)}),
+	}, tx.Arguments)
+}
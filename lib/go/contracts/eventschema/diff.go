@@ -0,0 +1,141 @@
+package eventschema
+
+import (
+	"fmt"
+)
+
+// ChangeKind is the kind of a change between two event schemas.
+type ChangeKind string
+
+const (
+	EventAdded           ChangeKind = "event-added"
+	EventRemoved         ChangeKind = "event-removed"
+	ParameterAdded       ChangeKind = "parameter-added"
+	ParameterRemoved     ChangeKind = "parameter-removed"
+	ParameterTypeChanged ChangeKind = "parameter-type-changed"
+	ParameterMoved       ChangeKind = "parameter-moved"
+)
+
+// Change is a single difference between two event schemas.
+type Change struct {
+	Kind      ChangeKind `json:"kind"`
+	Event     string     `json:"event"`
+	Parameter string     `json:"parameter,omitempty"`
+	Previous  string     `json:"previous,omitempty"`
+	Current   string     `json:"current,omitempty"`
+	// Breaking is set when consumers decoding the previous schema cannot
+	// decode events of the current schema. Consumers may decode fields by
+	// name or by position, so only new events and new trailing parameters
+	// are non-breaking.
+	Breaking bool `json:"breaking"`
+}
+
+func (c Change) String() string {
+	severity := "non-breaking"
+	if c.Breaking {
+		severity = "breaking"
+	}
+
+	switch c.Kind {
+	case EventAdded, EventRemoved:
+		return fmt.Sprintf("%s: %s: %s", severity, c.Event, c.Kind)
+	default:
+		return fmt.Sprintf("%s: %s.%s: %s (%s -> %s)", severity, c.Event, c.Parameter, c.Kind, c.Previous, c.Current)
+	}
+}
+
+// Diff returns the changes from the previous to the current schema.
+func Diff(previous, current Schema) []Change {
+	var changes []Change
+
+	for _, p := range previous.Events {
+		c, ok := current.Event(p.Name)
+		if !ok {
+			changes = append(changes, Change{Kind: EventRemoved, Event: p.Name, Breaking: true})
+			continue
+		}
+		changes = append(changes, diffParameters(p, c)...)
+	}
+
+	for _, c := range current.Events {
+		if _, ok := previous.Event(c.Name); !ok {
+			changes = append(changes, Change{Kind: EventAdded, Event: c.Name})
+		}
+	}
+
+	return changes
+}
+
+// Breaking reports whether any of the changes is breaking.
+func Breaking(changes []Change) bool {
+	for _, c := range changes {
+		if c.Breaking {
+			return true
+		}
+	}
+	return false
+}
+
+func diffParameters(previous, current Event) []Change {
+	var changes []Change
+
+	currentIndex := make(map[string]int, len(current.Parameters))
+	for i, p := range current.Parameters {
+		currentIndex[p.Name] = i
+	}
+	previousIndex := make(map[string]int, len(previous.Parameters))
+	for i, p := range previous.Parameters {
+		previousIndex[p.Name] = i
+	}
+
+	for i, p := range previous.Parameters {
+		j, ok := currentIndex[p.Name]
+		if !ok {
+			changes = append(changes, Change{
+				Kind:      ParameterRemoved,
+				Event:     previous.Name,
+				Parameter: p.Name,
+				Previous:  p.Type,
+				Breaking:  true,
+			})
+			continue
+		}
+
+		c := current.Parameters[j]
+		if c.Type != p.Type {
+			changes = append(changes, Change{
+				Kind:      ParameterTypeChanged,
+				Event:     previous.Name,
+				Parameter: p.Name,
+				Previous:  p.Type,
+				Current:   c.Type,
+				Breaking:  true,
+			})
+		}
+		if i != j {
+			changes = append(changes, Change{
+				Kind:      ParameterMoved,
+				Event:     previous.Name,
+				Parameter: p.Name,
+				Previous:  fmt.Sprint(i),
+				Current:   fmt.Sprint(j),
+				Breaking:  true,
+			})
+		}
+	}
+
+	for j, c := range current.Parameters {
+		if _, ok := previousIndex[c.Name]; ok {
+			continue
+		}
+		changes = append(changes, Change{
+			Kind:      ParameterAdded,
+			Event:     current.Name,
+			Parameter: c.Name,
+			Current:   c.Type,
+			Breaking:  j < len(previous.Parameters),
+		})
+	}
+
+	return changes
+}
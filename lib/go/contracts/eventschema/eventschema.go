@@ -0,0 +1,103 @@
+// Package eventschema describes the events declared by the storefront contracts
+// and compares event schemas, so that changes which break event consumers
+// such as indexers are noticed before a release.
+package eventschema
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/assets"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cdc"
+)
+
+// Schema is the set of events declared by a set of contracts.
+type Schema struct {
+	Events []Event `json:"events"`
+}
+
+// Event is the signature of a single event.
+type Event struct {
+	Contract string `json:"contract"`
+	// Name is the event name qualified by its contract and enclosing
+	// declarations, for example NFTStorefrontV2.ListingAvailable.
+	Name       string      `json:"name"`
+	Parameters []Parameter `json:"parameters"`
+}
+
+// Parameter is a parameter of an event.
+type Parameter struct {
+	Name string `json:"name"`
+	Type string `json:"type"`
+}
+
+// storefrontContracts are the embedded contracts whose events Embedded
+// describes. The utility and test contracts are deployed next to them in
+// tests only, and their events are not part of the storefront interface.
+var storefrontContracts = []string{"NFTStorefront.cdc", "NFTStorefrontV2.cdc"}
+
+// Embedded returns the schema of the events declared by the storefront
+// contracts embedded in this module.
+func Embedded() (Schema, error) {
+	sources := make([][]byte, len(storefrontContracts))
+	for i, name := range storefrontContracts {
+		sources[i] = assets.MustAsset(name)
+	}
+	return FromSources(sources...)
+}
+
+// FromSources returns the schema of the events declared by the given contract sources.
+func FromSources(sources ...[]byte) (Schema, error) {
+	var schema Schema
+	for _, code := range sources {
+		contract, err := cdc.Parse(code)
+		if err != nil {
+			return Schema{}, err
+		}
+		for _, e := range contract.Events {
+			event := Event{
+				Contract:   contract.Name,
+				Name:       e.QualifiedName,
+				Parameters: []Parameter{},
+			}
+			for _, p := range e.Parameters {
+				event.Parameters = append(event.Parameters, Parameter{Name: p.Name, Type: p.Type})
+			}
+			schema.Events = append(schema.Events, event)
+		}
+	}
+
+	sort.Slice(schema.Events, func(i, j int) bool {
+		return schema.Events[i].Name < schema.Events[j].Name
+	})
+
+	return schema, nil
+}
+
+// Event returns the event with the given qualified name.
+func (s Schema) Event(name string) (Event, bool) {
+	for _, e := range s.Events {
+		if e.Name == name {
+			return e, true
+		}
+	}
+	return Event{}, false
+}
+
+// Read decodes a JSON schema.
+func Read(r io.Reader) (Schema, error) {
+	var schema Schema
+	if err := json.NewDecoder(r).Decode(&schema); err != nil {
+		return Schema{}, fmt.Errorf("failed to decode event schema: %w", err)
+	}
+	return schema, nil
+}
+
+// Write encodes the schema as indented JSON.
+func (s Schema) Write(w io.Writer) error {
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(s)
+}
@@ -0,0 +1,92 @@
+package eventschema_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/eventschema"
+)
+
+func TestEmbedded(t *testing.T) {
+	schema, err := eventschema.Embedded()
+	require.NoError(t, err)
+
+	event, ok := schema.Event("NFTStorefrontV2.ListingAvailable")
+	require.True(t, ok)
+
+	assert.Equal(t, "NFTStorefrontV2", event.Contract)
+	assert.Contains(t, event.Parameters, eventschema.Parameter{Name: "commissionReceivers", Type: "[Address]?"})
+
+	_, ok = schema.Event("NFTStorefrontV2.Listing.ResourceDestroyed")
+	assert.True(t, ok)
+
+	for _, event := range schema.Events {
+		assert.Contains(t, []string{"NFTStorefront", "NFTStorefrontV2"}, event.Contract)
+	}
+
+	var buf bytes.Buffer
+	require.NoError(t, schema.Write(&buf))
+
+	decoded, err := eventschema.Read(&buf)
+	require.NoError(t, err)
+	assert.Equal(t, schema, decoded)
+	assert.Empty(t, eventschema.Diff(schema, decoded))
+}
+
+func TestDiff(t *testing.T) {
+	previous, err := eventschema.FromSources([]byte(`
+access(all) contract C {
+    access(all) event ListingAvailable(listingResourceID: UInt64, commissionReceiver: Address?)
+    access(all) event ListingCompleted(listingResourceID: UInt64)
+    access(all) event Removed(id: UInt64)
+}
+`))
+	require.NoError(t, err)
+
+	current, err := eventschema.FromSources([]byte(`
+access(all) contract C {
+    access(all) event ListingAvailable(listingResourceID: UInt64, commissionReceivers: [Address]?)
+    access(all) event ListingCompleted(listingResourceID: UInt64, customID: String?)
+    access(all) event Added(id: UInt64)
+}
+`))
+	require.NoError(t, err)
+
+	changes := eventschema.Diff(previous, current)
+
+	assert.True(t, eventschema.Breaking(changes))
+	assert.Equal(t, []eventschema.Change{
+		{
+			Kind:      eventschema.ParameterRemoved,
+			Event:     "C.ListingAvailable",
+			Parameter: "commissionReceiver",
+			Previous:  "Address?",
+			Breaking:  true,
+		},
+		{
+			Kind:      eventschema.ParameterAdded,
+			Event:     "C.ListingAvailable",
+			Parameter: "commissionReceivers",
+			Current:   "[Address]?",
+			Breaking:  true,
+		},
+		{
+			Kind:      eventschema.ParameterAdded,
+			Event:     "C.ListingCompleted",
+			Parameter: "customID",
+			Current:   "String?",
+		},
+		{
+			Kind:     eventschema.EventRemoved,
+			Event:    "C.Removed",
+			Breaking: true,
+		},
+		{
+			Kind:  eventschema.EventAdded,
+			Event: "C.Added",
+		},
+	}, changes)
+}
@@ -0,0 +1,116 @@
+package flowclient
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+)
+
+// MaxHeightRange is the largest number of blocks the Access API returns
+// events for in one request.
+const MaxHeightRange = 250
+
+// ForEachBlock calls fn with the blocks from startHeight to endHeight, in
+// order of height, with their events of the given types in the order they
+// were emitted. It fetches at most MaxHeightRange blocks at a time.
+func ForEachBlock(ctx context.Context, source EventSource, eventTypes []string, startHeight, endHeight uint64, fn func(BlockEvents) error) error {
+	if startHeight > endHeight {
+		return fmt.Errorf("start height %d is greater than end height %d", startHeight, endHeight)
+	}
+
+	for start := startHeight; ; start += MaxHeightRange {
+		end := endHeight
+		if endHeight-start >= MaxHeightRange {
+			end = start + MaxHeightRange - 1
+		}
+
+		blocks := map[uint64]*BlockEvents{}
+		for _, eventType := range eventTypes {
+			fetched, err := source.GetEventsForHeightRange(ctx, eventType, start, end)
+			if err != nil {
+				return fmt.Errorf("failed to get %s events for heights %d to %d: %w", eventType, start, end, err)
+			}
+			for _, block := range fetched {
+				merged, ok := blocks[block.Height]
+				if !ok {
+					merged = &BlockEvents{BlockID: block.BlockID, Height: block.Height, BlockTimestamp: block.BlockTimestamp}
+					blocks[block.Height] = merged
+				}
+				merged.Events = append(merged.Events, block.Events...)
+			}
+		}
+
+		ordered := make([]*BlockEvents, 0, len(blocks))
+		for _, block := range blocks {
+			sort.SliceStable(block.Events, func(i, j int) bool {
+				a, b := block.Events[i], block.Events[j]
+				if a.TransactionIndex != b.TransactionIndex {
+					return a.TransactionIndex < b.TransactionIndex
+				}
+				return a.EventIndex < b.EventIndex
+			})
+			ordered = append(ordered, block)
+		}
+		sort.Slice(ordered, func(i, j int) bool { return ordered[i].Height < ordered[j].Height })
+
+		for _, block := range ordered {
+			if err := fn(*block); err != nil {
+				return err
+			}
+		}
+
+		if end == endHeight {
+			return nil
+		}
+	}
+}
+
+// Consumer is fed blocks of events, for example analytics.Aggregator.
+type Consumer interface {
+	// EventTypes returns the types of the events the consumer needs.
+	EventTypes() []string
+	// AddBlock adds a block with its events of those types. Blocks are added
+	// in order of height, with their events in the order they were emitted.
+	AddBlock(ctx context.Context, block BlockEvents) error
+}
+
+// Feed adds the blocks from startHeight to endHeight of the given source, a
+// live Access API or a Fake replaying recorded events, to the consumer.
+func Feed(ctx context.Context, source EventSource, consumer Consumer, startHeight, endHeight uint64) error {
+	return ForEachBlock(ctx, source, consumer.EventTypes(), startHeight, endHeight, func(block BlockEvents) error {
+		return consumer.AddBlock(ctx, block)
+	})
+}
+
+// Watch adds the blocks of the given source from startHeight to the
+// consumer as they are sealed, polling for the latest sealed block at the
+// given interval, until the context is done or the source fails.
+func Watch(ctx context.Context, source EventSource, consumer Consumer, startHeight uint64, interval time.Duration) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %s", interval)
+	}
+
+	next := startHeight
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		latest, err := source.GetLatestBlockHeight(ctx)
+		if err != nil {
+			return err
+		}
+		if latest >= next {
+			if err := Feed(ctx, source, consumer, next, latest); err != nil {
+				return err
+			}
+			next = latest + 1
+		}
+		timer.Reset(interval)
+	}
+}
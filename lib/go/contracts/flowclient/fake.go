@@ -0,0 +1,271 @@
+package flowclient
+
+import (
+	"bytes"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/onflow/cadence"
+)
+
+// ErrNotRecorded is returned by Fake for calls missing from its fixtures.
+var ErrNotRecorded = errors.New("not recorded")
+
+// Fake is a Client replaying fixtures. Scripts are matched by code and
+// arguments, transactions by script and arguments in the order they were
+// recorded, and events are served from the recorded blocks.
+//
+// Fake is safe for concurrent use.
+type Fake struct {
+	mu           sync.Mutex
+	scripts      map[string]fakeScript
+	transactions []*fakeTransaction
+	results      map[Identifier]*TransactionResult
+	blocks       []BlockEvents
+	latestHeight uint64
+	accounts     map[cadence.Address]*Account
+	sent         []Transaction
+}
+
+type fakeScript struct {
+	result cadence.Value
+	err    string
+}
+
+type fakeTransaction struct {
+	key  string
+	id   Identifier
+	err  string
+	sent bool
+}
+
+var _ Client = (*Fake)(nil)
+
+// NewFake returns a Fake replaying the given fixtures.
+func NewFake(fixtures *Fixtures) (*Fake, error) {
+	f := &Fake{
+		scripts:  map[string]fakeScript{},
+		results:  map[Identifier]*TransactionResult{},
+		accounts: map[cadence.Address]*Account{},
+	}
+
+	for i, fixture := range fixtures.Scripts {
+		key, err := fixtureKey(fixture.Code, fixture.Arguments)
+		if err != nil {
+			return nil, fmt.Errorf("script %d: %w", i, err)
+		}
+		script := fakeScript{err: fixture.Error}
+		if fixture.Error == "" {
+			script.result, err = decodeValue(fixture.Result)
+			if err != nil {
+				return nil, fmt.Errorf("script %d: result: %w", i, err)
+			}
+		}
+		f.scripts[key] = script
+	}
+
+	for _, fixture := range fixtures.Transactions {
+		key, err := fixtureKey(fixture.Script, fixture.Arguments)
+		if err != nil {
+			return nil, fmt.Errorf("transaction %s: %w", fixture.ID, err)
+		}
+		f.transactions = append(f.transactions, &fakeTransaction{key: key, id: fixture.ID, err: fixture.Error})
+		if fixture.Result != nil {
+			f.results[fixture.ID], err = decodeTransactionResult(fixture.ID, fixture.Result)
+			if err != nil {
+				return nil, fmt.Errorf("transaction %s: %w", fixture.ID, err)
+			}
+		}
+	}
+
+	for _, fixture := range fixtures.Blocks {
+		events, err := decodeEvents(fixture.Events)
+		if err != nil {
+			return nil, fmt.Errorf("block %d: %w", fixture.Height, err)
+		}
+		f.blocks = append(f.blocks, BlockEvents{
+			BlockID:        fixture.ID,
+			Height:         fixture.Height,
+			BlockTimestamp: fixture.Timestamp,
+			Events:         events,
+		})
+	}
+	sort.SliceStable(f.blocks, func(i, j int) bool { return f.blocks[i].Height < f.blocks[j].Height })
+
+	f.latestHeight = fixtures.LatestHeight
+	if f.latestHeight == 0 && len(f.blocks) > 0 {
+		f.latestHeight = f.blocks[len(f.blocks)-1].Height
+	}
+
+	for _, fixture := range fixtures.Accounts {
+		account, err := decodeAccount(fixture)
+		if err != nil {
+			return nil, err
+		}
+		f.accounts[account.Address] = account
+	}
+
+	return f, nil
+}
+
+// LoadFake returns a Fake replaying the fixtures of the given JSON file.
+func LoadFake(path string) (*Fake, error) {
+	fixtures, err := LoadFixtures(path)
+	if err != nil {
+		return nil, err
+	}
+	return NewFake(fixtures)
+}
+
+// fixtureKey returns the key matching calls with the given code and
+// recorded arguments. The arguments are re-encoded so that the formatting of
+// the fixture file does not matter.
+func fixtureKey(code string, arguments []json.RawMessage) (string, error) {
+	values, err := decodeValues(arguments)
+	if err != nil {
+		return "", err
+	}
+	return callKey([]byte(code), values)
+}
+
+func callKey(code []byte, arguments []cadence.Value) (string, error) {
+	encoded, err := encodeValues(arguments)
+	if err != nil {
+		return "", err
+	}
+
+	var key strings.Builder
+	key.Write(code)
+	for _, argument := range encoded {
+		key.WriteByte(0)
+		key.Write(bytes.TrimSpace(argument))
+	}
+	return key.String(), nil
+}
+
+func (f *Fake) ExecuteScriptAtLatestBlock(_ context.Context, code []byte, arguments []cadence.Value) (cadence.Value, error) {
+	key, err := callKey(code, arguments)
+	if err != nil {
+		return nil, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	script, ok := f.scripts[key]
+	if !ok {
+		return nil, fmt.Errorf("script %s: %w", summary(code), ErrNotRecorded)
+	}
+	if script.err != "" {
+		return nil, errors.New(script.err)
+	}
+	return script.result, nil
+}
+
+// SendTransaction returns the ID of the first recorded transaction with the
+// same script and arguments that was not sent yet.
+func (f *Fake) SendTransaction(_ context.Context, tx Transaction) (Identifier, error) {
+	key, err := callKey(tx.Script, tx.Arguments)
+	if err != nil {
+		return Identifier{}, err
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	for _, recorded := range f.transactions {
+		if recorded.sent || recorded.key != key {
+			continue
+		}
+		recorded.sent = true
+		if recorded.err != "" {
+			return Identifier{}, errors.New(recorded.err)
+		}
+		f.sent = append(f.sent, tx)
+		return recorded.id, nil
+	}
+	return Identifier{}, fmt.Errorf("transaction %s: %w", summary(tx.Script), ErrNotRecorded)
+}
+
+func (f *Fake) GetTransactionResult(_ context.Context, id Identifier) (*TransactionResult, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	result, ok := f.results[id]
+	if !ok {
+		return nil, fmt.Errorf("result of transaction %s: %w", id, ErrNotRecorded)
+	}
+	return result, nil
+}
+
+// GetLatestBlockHeight returns the recorded latest height, or the height of
+// the last recorded block.
+func (f *Fake) GetLatestBlockHeight(context.Context) (uint64, error) {
+	if f.latestHeight == 0 {
+		return 0, fmt.Errorf("latest block height: %w", ErrNotRecorded)
+	}
+	return f.latestHeight, nil
+}
+
+// GetEventsForHeightRange returns the recorded blocks in the range, with
+// their events of the given type.
+func (f *Fake) GetEventsForHeightRange(_ context.Context, eventType string, startHeight, endHeight uint64) ([]BlockEvents, error) {
+	if startHeight > endHeight {
+		return nil, fmt.Errorf("start height %d is greater than end height %d", startHeight, endHeight)
+	}
+
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	var result []BlockEvents
+	for _, block := range f.blocks {
+		if block.Height < startHeight || block.Height > endHeight {
+			continue
+		}
+		filtered := BlockEvents{
+			BlockID:        block.BlockID,
+			Height:         block.Height,
+			BlockTimestamp: block.BlockTimestamp,
+		}
+		for _, event := range block.Events {
+			if event.Type == eventType {
+				filtered.Events = append(filtered.Events, event)
+			}
+		}
+		result = append(result, filtered)
+	}
+	return result, nil
+}
+
+func (f *Fake) GetAccount(_ context.Context, address cadence.Address) (*Account, error) {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	account, ok := f.accounts[address]
+	if !ok {
+		return nil, fmt.Errorf("account %s: %w", address, ErrNotRecorded)
+	}
+	return account, nil
+}
+
+// Sent returns the transactions sent so far, in order.
+func (f *Fake) Sent() []Transaction {
+	f.mu.Lock()
+	defer f.mu.Unlock()
+
+	return append([]Transaction(nil), f.sent...)
+}
+
+// summary identifies a script or transaction in errors by the start of the
+// SHA-256 hash of its code.
+func summary(code []byte) string {
+	hash := sha256.Sum256(code)
+	return hex.EncodeToString(hash[:4])
+}
@@ -0,0 +1,413 @@
+package flowclient
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+)
+
+// Fixtures are recorded Access API calls, stored as JSON with Cadence values
+// in JSON-Cadence.
+type Fixtures struct {
+	Scripts      []ScriptFixture      `json:"scripts,omitempty"`
+	Transactions []TransactionFixture `json:"transactions,omitempty"`
+	Blocks       []BlockFixture       `json:"blocks,omitempty"`
+	Accounts     []AccountFixture     `json:"accounts,omitempty"`
+	// LatestHeight is the latest sealed block height, or zero for the
+	// height of the last recorded block.
+	LatestHeight uint64 `json:"latestHeight,omitempty"`
+}
+
+// ScriptFixture is a script execution.
+type ScriptFixture struct {
+	Code      string            `json:"code"`
+	Arguments []json.RawMessage `json:"arguments"`
+	Result    json.RawMessage   `json:"result,omitempty"`
+	Error     string            `json:"error,omitempty"`
+}
+
+// TransactionFixture is a sent transaction and its last known result.
+// Transactions are replayed by script and arguments: signatures, keys and
+// reference blocks are not recorded.
+type TransactionFixture struct {
+	ID        Identifier                `json:"id"`
+	Script    string                    `json:"script"`
+	Arguments []json.RawMessage         `json:"arguments"`
+	Error     string                    `json:"error,omitempty"`
+	Result    *TransactionResultFixture `json:"result,omitempty"`
+}
+
+// TransactionResultFixture is a transaction result.
+type TransactionResultFixture struct {
+	Status           TransactionStatus `json:"status"`
+	ErrorMessage     string            `json:"errorMessage,omitempty"`
+	Events           []EventFixture    `json:"events,omitempty"`
+	BlockID          Identifier        `json:"blockId"`
+	BlockHeight      uint64            `json:"blockHeight"`
+	ComputationUsage uint64            `json:"computationUsage,omitempty"`
+}
+
+// EventFixture is an event.
+type EventFixture struct {
+	Type             string          `json:"type"`
+	TransactionID    Identifier      `json:"transactionId"`
+	TransactionIndex int             `json:"transactionIndex"`
+	EventIndex       int             `json:"eventIndex"`
+	Payload          json.RawMessage `json:"payload"`
+}
+
+// BlockFixture is a block and the recorded events emitted in it.
+type BlockFixture struct {
+	ID        Identifier     `json:"id"`
+	Height    uint64         `json:"height"`
+	Timestamp time.Time      `json:"timestamp"`
+	Events    []EventFixture `json:"events,omitempty"`
+}
+
+// AccountFixture is an account.
+type AccountFixture struct {
+	Address   string              `json:"address"`
+	Balance   uint64              `json:"balance"`
+	Keys      []AccountKeyFixture `json:"keys,omitempty"`
+	Contracts map[string]string   `json:"contracts,omitempty"`
+}
+
+// AccountKeyFixture is an account key.
+type AccountKeyFixture struct {
+	Index          uint32             `json:"index"`
+	PublicKey      string             `json:"publicKey"`
+	SigAlgo        SignatureAlgorithm `json:"sigAlgo"`
+	HashAlgo       HashAlgorithm      `json:"hashAlgo"`
+	Weight         int                `json:"weight"`
+	SequenceNumber uint64             `json:"sequenceNumber"`
+	Revoked        bool               `json:"revoked,omitempty"`
+}
+
+// LoadFixtures reads fixtures from a JSON file.
+func LoadFixtures(path string) (*Fixtures, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var fixtures Fixtures
+	if err := json.Unmarshal(data, &fixtures); err != nil {
+		return nil, fmt.Errorf("failed to decode fixtures %s: %w", path, err)
+	}
+	return &fixtures, nil
+}
+
+// Save writes the fixtures to a JSON file.
+func (f *Fixtures) Save(path string) error {
+	data, err := json.MarshalIndent(f, "", "  ")
+	if err != nil {
+		return err
+	}
+	return os.WriteFile(path, append(data, '\n'), 0o644)
+}
+
+func encodeValue(value cadence.Value) (json.RawMessage, error) {
+	data, err := jsoncdc.Encode(value)
+	if err != nil {
+		return nil, err
+	}
+	return json.RawMessage(data), nil
+}
+
+func decodeValue(data json.RawMessage) (cadence.Value, error) {
+	return jsoncdc.Decode(nil, data)
+}
+
+func encodeValues(values []cadence.Value) ([]json.RawMessage, error) {
+	result := make([]json.RawMessage, len(values))
+	for i, value := range values {
+		data, err := encodeValue(value)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		result[i] = data
+	}
+	return result, nil
+}
+
+func decodeValues(data []json.RawMessage) ([]cadence.Value, error) {
+	result := make([]cadence.Value, len(data))
+	for i, d := range data {
+		value, err := decodeValue(d)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		result[i] = value
+	}
+	return result, nil
+}
+
+func encodeEvent(event Event) (EventFixture, error) {
+	payload, err := encodeValue(event.Value)
+	if err != nil {
+		return EventFixture{}, fmt.Errorf("event %s: %w", event.Type, err)
+	}
+	return EventFixture{
+		Type:             event.Type,
+		TransactionID:    event.TransactionID,
+		TransactionIndex: event.TransactionIndex,
+		EventIndex:       event.EventIndex,
+		Payload:          payload,
+	}, nil
+}
+
+func decodeEvent(fixture EventFixture) (Event, error) {
+	value, err := decodeValue(fixture.Payload)
+	if err != nil {
+		return Event{}, fmt.Errorf("event %s: %w", fixture.Type, err)
+	}
+	event, ok := value.(cadence.Event)
+	if !ok {
+		return Event{}, fmt.Errorf("event %s: expected event, got %T", fixture.Type, value)
+	}
+	return Event{
+		Type:             fixture.Type,
+		TransactionID:    fixture.TransactionID,
+		TransactionIndex: fixture.TransactionIndex,
+		EventIndex:       fixture.EventIndex,
+		Value:            event,
+	}, nil
+}
+
+func encodeEvents(events []Event) ([]EventFixture, error) {
+	var result []EventFixture
+	for _, event := range events {
+		fixture, err := encodeEvent(event)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, fixture)
+	}
+	return result, nil
+}
+
+func decodeEvents(fixtures []EventFixture) ([]Event, error) {
+	var result []Event
+	for _, fixture := range fixtures {
+		event, err := decodeEvent(fixture)
+		if err != nil {
+			return nil, err
+		}
+		result = append(result, event)
+	}
+	return result, nil
+}
+
+func encodeTransactionResult(result *TransactionResult) (*TransactionResultFixture, error) {
+	events, err := encodeEvents(result.Events)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionResultFixture{
+		Status:           result.Status,
+		ErrorMessage:     result.ErrorMessage,
+		Events:           events,
+		BlockID:          result.BlockID,
+		BlockHeight:      result.BlockHeight,
+		ComputationUsage: result.ComputationUsage,
+	}, nil
+}
+
+func decodeTransactionResult(id Identifier, fixture *TransactionResultFixture) (*TransactionResult, error) {
+	events, err := decodeEvents(fixture.Events)
+	if err != nil {
+		return nil, err
+	}
+	return &TransactionResult{
+		TransactionID:    id,
+		Status:           fixture.Status,
+		ErrorMessage:     fixture.ErrorMessage,
+		Events:           events,
+		BlockID:          fixture.BlockID,
+		BlockHeight:      fixture.BlockHeight,
+		ComputationUsage: fixture.ComputationUsage,
+	}, nil
+}
+
+func encodeAccount(account *Account) AccountFixture {
+	fixture := AccountFixture{
+		Address: account.Address.String(),
+		Balance: account.Balance,
+	}
+	for _, key := range account.Keys {
+		fixture.Keys = append(fixture.Keys, AccountKeyFixture{
+			Index:          key.Index,
+			PublicKey:      hex.EncodeToString(key.PublicKey),
+			SigAlgo:        key.SigAlgo,
+			HashAlgo:       key.HashAlgo,
+			Weight:         key.Weight,
+			SequenceNumber: key.SequenceNumber,
+			Revoked:        key.Revoked,
+		})
+	}
+	if len(account.Contracts) > 0 {
+		fixture.Contracts = make(map[string]string, len(account.Contracts))
+		for name, code := range account.Contracts {
+			fixture.Contracts[name] = string(code)
+		}
+	}
+	return fixture
+}
+
+func decodeAccount(fixture AccountFixture) (*Account, error) {
+	address, err := HexToAddress(fixture.Address)
+	if err != nil {
+		return nil, err
+	}
+
+	account := &Account{
+		Address: address,
+		Balance: fixture.Balance,
+	}
+	for _, key := range fixture.Keys {
+		publicKey, err := hex.DecodeString(key.PublicKey)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: key %d: %w", fixture.Address, key.Index, err)
+		}
+		account.Keys = append(account.Keys, AccountKey{
+			Index:          key.Index,
+			PublicKey:      publicKey,
+			SigAlgo:        key.SigAlgo,
+			HashAlgo:       key.HashAlgo,
+			Weight:         key.Weight,
+			SequenceNumber: key.SequenceNumber,
+			Revoked:        key.Revoked,
+		})
+	}
+	if len(fixture.Contracts) > 0 {
+		account.Contracts = make(map[string][]byte, len(fixture.Contracts))
+		for name, code := range fixture.Contracts {
+			account.Contracts[name] = []byte(code)
+		}
+	}
+	return account, nil
+}
+
+// AddScript records the execution of a script. Either result or err is
+// recorded.
+func (f *Fixtures) AddScript(code []byte, arguments []cadence.Value, result cadence.Value, err error) error {
+	fixture := ScriptFixture{Code: string(code)}
+
+	var encodeErr error
+	fixture.Arguments, encodeErr = encodeValues(arguments)
+	if encodeErr != nil {
+		return encodeErr
+	}
+
+	if err != nil {
+		fixture.Error = err.Error()
+	} else {
+		fixture.Result, encodeErr = encodeValue(result)
+		if encodeErr != nil {
+			return fmt.Errorf("result: %w", encodeErr)
+		}
+	}
+
+	f.Scripts = append(f.Scripts, fixture)
+	return nil
+}
+
+// AddTransaction records the sending of a transaction. Either id or err is
+// recorded.
+func (f *Fixtures) AddTransaction(tx Transaction, id Identifier, err error) error {
+	arguments, encodeErr := encodeValues(tx.Arguments)
+	if encodeErr != nil {
+		return encodeErr
+	}
+
+	fixture := TransactionFixture{
+		ID:        id,
+		Script:    string(tx.Script),
+		Arguments: arguments,
+	}
+	if err != nil {
+		fixture.Error = err.Error()
+	}
+
+	f.Transactions = append(f.Transactions, fixture)
+	return nil
+}
+
+// SetTransactionResult records the result of a transaction, replacing any
+// earlier result of it.
+func (f *Fixtures) SetTransactionResult(result *TransactionResult) error {
+	fixture, err := encodeTransactionResult(result)
+	if err != nil {
+		return err
+	}
+
+	for i := range f.Transactions {
+		if f.Transactions[i].ID == result.TransactionID && f.Transactions[i].Error == "" {
+			f.Transactions[i].Result = fixture
+			return nil
+		}
+	}
+
+	// The transaction was sent by another client.
+	f.Transactions = append(f.Transactions, TransactionFixture{
+		ID:     result.TransactionID,
+		Result: fixture,
+	})
+	return nil
+}
+
+// AddBlock records the events of a block, merging them with the events
+// already recorded for it.
+func (f *Fixtures) AddBlock(block BlockEvents) error {
+	events, err := encodeEvents(block.Events)
+	if err != nil {
+		return err
+	}
+
+	for i := range f.Blocks {
+		recorded := &f.Blocks[i]
+		if recorded.Height != block.Height {
+			continue
+		}
+		for _, event := range events {
+			if !containsEvent(recorded.Events, event) {
+				recorded.Events = append(recorded.Events, event)
+			}
+		}
+		return nil
+	}
+
+	f.Blocks = append(f.Blocks, BlockFixture{
+		ID:        block.BlockID,
+		Height:    block.Height,
+		Timestamp: block.BlockTimestamp,
+		Events:    events,
+	})
+	return nil
+}
+
+func containsEvent(events []EventFixture, event EventFixture) bool {
+	for _, e := range events {
+		if e.TransactionID == event.TransactionID && e.EventIndex == event.EventIndex {
+			return true
+		}
+	}
+	return false
+}
+
+// SetAccount records an account, replacing any earlier record of it.
+func (f *Fixtures) SetAccount(account *Account) {
+	fixture := encodeAccount(account)
+	for i := range f.Accounts {
+		if f.Accounts[i].Address == fixture.Address {
+			f.Accounts[i] = fixture
+			return
+		}
+	}
+	f.Accounts = append(f.Accounts, fixture)
+}
@@ -0,0 +1,209 @@
+// Package flowclient is the part of the Flow Access API the storefront tools
+// use to run the shipped scripts and transactions and to fetch storefront
+// events.
+//
+// Client is implemented for the official Go SDK by package
+// github.com/onflow/nft-storefront/lib/go/sdkclient, and by Fake, which
+// replays fixtures recorded with Recorder so that code built on Client can be
+// tested without a network.
+package flowclient
+
+import (
+	"context"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+)
+
+// Client is an Access API client.
+type Client interface {
+	ScriptExecutor
+	// SendTransaction submits a signed transaction and returns its ID.
+	SendTransaction(ctx context.Context, tx Transaction) (Identifier, error)
+	// GetTransactionResult returns the current result of a transaction.
+	GetTransactionResult(ctx context.Context, id Identifier) (*TransactionResult, error)
+	EventSource
+	// GetAccount returns the account at the latest sealed block.
+	GetAccount(ctx context.Context, address cadence.Address) (*Account, error)
+}
+
+// ScriptExecutor is the part of Client executing scripts. It is implemented
+// by REST too, for tools that only read state.
+type ScriptExecutor interface {
+	// ExecuteScriptAtLatestBlock executes a script against the latest sealed
+	// state and returns its result.
+	ExecuteScriptAtLatestBlock(ctx context.Context, code []byte, arguments []cadence.Value) (cadence.Value, error)
+}
+
+// EventSource is the part of Client fetching events. It is implemented by
+// REST too, for tools that only read events.
+type EventSource interface {
+	// GetLatestBlockHeight returns the height of the latest sealed block.
+	GetLatestBlockHeight(ctx context.Context) (uint64, error)
+	// GetEventsForHeightRange returns the events of the given type emitted in
+	// the blocks from startHeight to endHeight, inclusive.
+	GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64) ([]BlockEvents, error)
+}
+
+// Identifier identifies a transaction or a block.
+type Identifier [32]byte
+
+// HexToIdentifier parses a hex-encoded identifier.
+func HexToIdentifier(s string) (Identifier, error) {
+	var id Identifier
+	b, err := hex.DecodeString(s)
+	if err != nil {
+		return id, fmt.Errorf("invalid identifier %q: %w", s, err)
+	}
+	if len(b) != len(id) {
+		return id, fmt.Errorf("invalid identifier %q: expected %d bytes, got %d", s, len(id), len(b))
+	}
+	copy(id[:], b)
+	return id, nil
+}
+
+func (id Identifier) String() string {
+	return hex.EncodeToString(id[:])
+}
+
+func (id Identifier) MarshalText() ([]byte, error) {
+	return []byte(id.String()), nil
+}
+
+func (id *Identifier) UnmarshalText(text []byte) error {
+	parsed, err := HexToIdentifier(string(text))
+	if err != nil {
+		return err
+	}
+	*id = parsed
+	return nil
+}
+
+// HexToAddress parses a hex-encoded address, with or without the 0x prefix.
+func HexToAddress(s string) (cadence.Address, error) {
+	address, err := common.HexToAddress(s)
+	if err != nil {
+		return cadence.Address{}, fmt.Errorf("invalid address %q: %w", s, err)
+	}
+	return cadence.Address(address), nil
+}
+
+// ProposalKey is the key whose sequence number a transaction increments.
+type ProposalKey struct {
+	Address        cadence.Address
+	KeyIndex       uint32
+	SequenceNumber uint64
+}
+
+// Signature is the signature of a transaction by an account key.
+type Signature struct {
+	Address   cadence.Address
+	KeyIndex  uint32
+	Signature []byte
+}
+
+// Transaction is a signed transaction.
+type Transaction struct {
+	Script             []byte
+	Arguments          []cadence.Value
+	ReferenceBlockID   Identifier
+	GasLimit           uint64
+	ProposalKey        ProposalKey
+	Payer              cadence.Address
+	Authorizers        []cadence.Address
+	PayloadSignatures  []Signature
+	EnvelopeSignatures []Signature
+}
+
+// TransactionStatus is the status of a transaction, as named by the Access
+// API.
+type TransactionStatus string
+
+const (
+	StatusUnknown   TransactionStatus = "UNKNOWN"
+	StatusPending   TransactionStatus = "PENDING"
+	StatusFinalized TransactionStatus = "FINALIZED"
+	StatusExecuted  TransactionStatus = "EXECUTED"
+	StatusSealed    TransactionStatus = "SEALED"
+	StatusExpired   TransactionStatus = "EXPIRED"
+)
+
+// TransactionResult is the result of a transaction.
+type TransactionResult struct {
+	TransactionID Identifier
+	Status        TransactionStatus
+	// ErrorMessage is the error the transaction failed with, or empty if it
+	// succeeded or is not executed yet.
+	ErrorMessage     string
+	Events           []Event
+	BlockID          Identifier
+	BlockHeight      uint64
+	ComputationUsage uint64
+}
+
+// Err returns the error the transaction failed with, or nil.
+func (r *TransactionResult) Err() error {
+	if r.ErrorMessage == "" {
+		return nil
+	}
+	return errors.New(r.ErrorMessage)
+}
+
+// Event is an event emitted by a transaction.
+type Event struct {
+	// Type is the type ID of the event, such as
+	// A.4eb8a10cb9f87357.NFTStorefrontV2.ListingAvailable.
+	Type             string
+	TransactionID    Identifier
+	TransactionIndex int
+	EventIndex       int
+	Value            cadence.Event
+}
+
+// BlockEvents are the events emitted in a block.
+type BlockEvents struct {
+	BlockID        Identifier
+	Height         uint64
+	BlockTimestamp time.Time
+	Events         []Event
+}
+
+// SignatureAlgorithm is a signature algorithm, as named by the Access API.
+type SignatureAlgorithm string
+
+const (
+	ECDSA_P256      SignatureAlgorithm = "ECDSA_P256"
+	ECDSA_secp256k1 SignatureAlgorithm = "ECDSA_secp256k1"
+)
+
+// HashAlgorithm is a hash algorithm, as named by the Access API.
+type HashAlgorithm string
+
+const (
+	SHA2_256 HashAlgorithm = "SHA2_256"
+	SHA3_256 HashAlgorithm = "SHA3_256"
+)
+
+// AccountKey is a key of an account.
+type AccountKey struct {
+	Index          uint32
+	PublicKey      []byte
+	SigAlgo        SignatureAlgorithm
+	HashAlgo       HashAlgorithm
+	Weight         int
+	SequenceNumber uint64
+	Revoked        bool
+}
+
+// Account is an account and its contracts.
+type Account struct {
+	Address cadence.Address
+	// Balance is the FLOW balance in units of 10^-8 FLOW.
+	Balance   uint64
+	Keys      []AccountKey
+	Contracts map[string][]byte
+}
@@ -0,0 +1,423 @@
+package flowclient_test
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+)
+
+var (
+	storefrontAddress = cadence.BytesToAddress([]byte{0x4e, 0xb8, 0xa1, 0x0c, 0xb9, 0xf8, 0x73, 0x57})
+	seller            = cadence.BytesToAddress([]byte{0x01})
+	availableType     = nftstorefrontv2.ListingAvailableEventType(storefrontAddress)
+)
+
+func id(b byte) flowclient.Identifier {
+	return flowclient.Identifier{b}
+}
+
+func listingAvailable(t *testing.T, listingResourceID uint64) cadence.Event {
+	t.Helper()
+
+	event := nftstorefrontv2.ListingAvailable{
+		StorefrontAddress:    seller,
+		ListingResourceID:    listingResourceID,
+		NFTType:              cadence.NewStructType(nil, "ExampleNFT.NFT", nil, nil),
+		NFTID:                1,
+		SalePaymentVaultType: cadence.NewStructType(nil, "FlowToken.Vault", nil, nil),
+		SalePrice:            cadence.UFix64(10_00000000),
+		CommissionAmount:     cadence.UFix64(0),
+		Expiry:               4102444800,
+	}
+	return event.Encode(storefrontAddress)
+}
+
+// chain is a Client answering with fixed values, standing in for an access
+// node.
+type chain struct {
+	blocks []flowclient.BlockEvents
+}
+
+var errScript = errors.New("[Error Code: 1101] cadence runtime error")
+
+func (c *chain) ExecuteScriptAtLatestBlock(_ context.Context, code []byte, arguments []cadence.Value) (cadence.Value, error) {
+	if string(code) == "fail" {
+		return nil, errScript
+	}
+	return cadence.NewArray(arguments), nil
+}
+
+func (c *chain) SendTransaction(_ context.Context, tx flowclient.Transaction) (flowclient.Identifier, error) {
+	return id(byte(len(tx.Arguments))), nil
+}
+
+func (c *chain) GetTransactionResult(_ context.Context, txID flowclient.Identifier) (*flowclient.TransactionResult, error) {
+	return &flowclient.TransactionResult{
+		TransactionID: txID,
+		Status:        flowclient.StatusSealed,
+		Events:        c.blocks[0].Events,
+		BlockID:       c.blocks[0].BlockID,
+		BlockHeight:   c.blocks[0].Height,
+	}, nil
+}
+
+func (c *chain) GetLatestBlockHeight(context.Context) (uint64, error) {
+	return 12, nil
+}
+
+func (c *chain) GetEventsForHeightRange(_ context.Context, eventType string, startHeight, endHeight uint64) ([]flowclient.BlockEvents, error) {
+	var result []flowclient.BlockEvents
+	for _, block := range c.blocks {
+		if block.Height >= startHeight && block.Height <= endHeight {
+			result = append(result, block)
+		}
+	}
+	return result, nil
+}
+
+func (c *chain) GetAccount(_ context.Context, address cadence.Address) (*flowclient.Account, error) {
+	return &flowclient.Account{
+		Address: address,
+		Balance: 100_000_00000000,
+		Keys: []flowclient.AccountKey{
+			{
+				PublicKey:      []byte{1, 2, 3},
+				SigAlgo:        flowclient.ECDSA_P256,
+				HashAlgo:       flowclient.SHA3_256,
+				Weight:         1000,
+				SequenceNumber: 7,
+			},
+		},
+		Contracts: map[string][]byte{"ExampleNFT": []byte("access(all) contract ExampleNFT {}")},
+	}, nil
+}
+
+func newChain(t *testing.T) *chain {
+	timestamp := time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+	return &chain{
+		blocks: []flowclient.BlockEvents{
+			{
+				BlockID:        id(10),
+				Height:         10,
+				BlockTimestamp: timestamp,
+				Events: []flowclient.Event{
+					{Type: availableType, TransactionID: id(1), Value: listingAvailable(t, 1)},
+				},
+			},
+			{
+				BlockID:        id(11),
+				Height:         11,
+				BlockTimestamp: timestamp.Add(time.Second),
+				Events: []flowclient.Event{
+					{Type: availableType, TransactionID: id(2), Value: listingAvailable(t, 2)},
+				},
+			},
+		},
+	}
+}
+
+// record runs the same calls through a recorder and, once the fixtures are
+// saved and loaded, through a fake.
+func record(t *testing.T, calls func(flowclient.Client)) *flowclient.Fake {
+	t.Helper()
+
+	recorder := flowclient.NewRecorder(newChain(t))
+	calls(recorder)
+
+	fixtures, err := recorder.Fixtures()
+	require.NoError(t, err)
+
+	path := filepath.Join(t.TempDir(), "fixtures.json")
+	require.NoError(t, fixtures.Save(path))
+
+	fake, err := flowclient.LoadFake(path)
+	require.NoError(t, err)
+	return fake
+}
+
+func TestFakeScripts(t *testing.T) {
+	ctx := context.Background()
+	arguments := []cadence.Value{cadence.Address(seller), cadence.NewUInt64(42)}
+
+	fake := record(t, func(client flowclient.Client) {
+		_, _ = client.ExecuteScriptAtLatestBlock(ctx, []byte("script"), arguments)
+		_, _ = client.ExecuteScriptAtLatestBlock(ctx, []byte("fail"), nil)
+	})
+
+	result, err := fake.ExecuteScriptAtLatestBlock(ctx, []byte("script"), arguments)
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewArray(arguments), result)
+
+	_, err = fake.ExecuteScriptAtLatestBlock(ctx, []byte("fail"), nil)
+	assert.EqualError(t, err, errScript.Error())
+
+	_, err = fake.ExecuteScriptAtLatestBlock(ctx, []byte("script"), arguments[:1])
+	assert.ErrorIs(t, err, flowclient.ErrNotRecorded)
+
+	_, err = fake.GetLatestBlockHeight(ctx)
+	assert.ErrorIs(t, err, flowclient.ErrNotRecorded)
+}
+
+func TestFakeTransactions(t *testing.T) {
+	ctx := context.Background()
+	tx := flowclient.Transaction{
+		Script:      []byte("transaction(id: UInt64) {}"),
+		Arguments:   []cadence.Value{cadence.NewUInt64(1)},
+		Payer:       seller,
+		Authorizers: []cadence.Address{seller},
+	}
+
+	fake := record(t, func(client flowclient.Client) {
+		txID, err := client.SendTransaction(ctx, tx)
+		require.NoError(t, err)
+		_, err = client.GetTransactionResult(ctx, txID)
+		require.NoError(t, err)
+	})
+
+	txID, err := fake.SendTransaction(ctx, tx)
+	require.NoError(t, err)
+	assert.Equal(t, id(1), txID)
+	assert.Equal(t, []flowclient.Transaction{tx}, fake.Sent())
+
+	result, err := fake.GetTransactionResult(ctx, txID)
+	require.NoError(t, err)
+	assert.Equal(t, flowclient.StatusSealed, result.Status)
+	assert.NoError(t, result.Err())
+	require.Len(t, result.Events, 1)
+	event, err := nftstorefrontv2.DecodeListingAvailable(result.Events[0].Value)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(1), event.ListingResourceID)
+
+	// Each recorded transaction is replayed once.
+	_, err = fake.SendTransaction(ctx, tx)
+	assert.ErrorIs(t, err, flowclient.ErrNotRecorded)
+}
+
+func TestFakeEvents(t *testing.T) {
+	ctx := context.Background()
+
+	fake := record(t, func(client flowclient.Client) {
+		_, err := client.GetLatestBlockHeight(ctx)
+		require.NoError(t, err)
+		_, err = client.GetEventsForHeightRange(ctx, availableType, 10, 11)
+		require.NoError(t, err)
+	})
+
+	height, err := fake.GetLatestBlockHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(12), height)
+
+	blocks, err := fake.GetEventsForHeightRange(ctx, availableType, 11, 20)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	assert.Equal(t, uint64(11), blocks[0].Height)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 1, 0, time.UTC), blocks[0].BlockTimestamp.UTC())
+	require.Len(t, blocks[0].Events, 1)
+	assert.Equal(t, id(2), blocks[0].Events[0].TransactionID)
+
+	blocks, err = fake.GetEventsForHeightRange(ctx, nftstorefrontv2.ListingCompletedEventType(storefrontAddress), 10, 11)
+	require.NoError(t, err)
+	require.Len(t, blocks, 2)
+	assert.Empty(t, blocks[0].Events)
+	assert.Empty(t, blocks[1].Events)
+
+	_, err = fake.GetEventsForHeightRange(ctx, availableType, 11, 10)
+	assert.Error(t, err)
+}
+
+func TestFakeAccounts(t *testing.T) {
+	ctx := context.Background()
+
+	var recorded *flowclient.Account
+	fake := record(t, func(client flowclient.Client) {
+		var err error
+		recorded, err = client.GetAccount(ctx, seller)
+		require.NoError(t, err)
+	})
+
+	account, err := fake.GetAccount(ctx, seller)
+	require.NoError(t, err)
+	assert.Equal(t, recorded, account)
+
+	_, err = fake.GetAccount(ctx, storefrontAddress)
+	assert.ErrorIs(t, err, flowclient.ErrNotRecorded)
+}
+
+func TestIdentifier(t *testing.T) {
+	text, err := id(0xab).MarshalText()
+	require.NoError(t, err)
+	assert.Equal(t, "ab00000000000000000000000000000000000000000000000000000000000000", string(text))
+
+	var parsed flowclient.Identifier
+	require.NoError(t, parsed.UnmarshalText(text))
+	assert.Equal(t, id(0xab), parsed)
+
+	_, err = flowclient.HexToIdentifier("ab")
+	assert.EqualError(t, err, `invalid identifier "ab": expected 32 bytes, got 1`)
+}
+
+// ranges is an EventSource recording the height ranges it is asked for.
+type ranges struct {
+	flowclient.EventSource
+	requested [][2]uint64
+}
+
+func (r *ranges) GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64) ([]flowclient.BlockEvents, error) {
+	r.requested = append(r.requested, [2]uint64{startHeight, endHeight})
+	return r.EventSource.GetEventsForHeightRange(ctx, eventType, startHeight, endHeight)
+}
+
+func TestForEachBlock(t *testing.T) {
+	ctx := context.Background()
+	completedType := nftstorefrontv2.ListingCompletedEventType(storefrontAddress)
+
+	c := newChain(t)
+	c.blocks[0].Events = append(c.blocks[0].Events,
+		flowclient.Event{Type: completedType, TransactionID: id(1), EventIndex: 2},
+		flowclient.Event{Type: availableType, TransactionID: id(3), TransactionIndex: 1},
+	)
+	c.blocks = append(c.blocks, flowclient.BlockEvents{BlockID: id(12), Height: 300})
+	source := &ranges{EventSource: c}
+
+	var heights []uint64
+	var events []flowclient.Identifier
+	err := flowclient.ForEachBlock(ctx, source, []string{completedType, availableType}, 10, 300, func(block flowclient.BlockEvents) error {
+		heights = append(heights, block.Height)
+		for _, event := range block.Events {
+			events = append(events, event.TransactionID)
+		}
+		return nil
+	})
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{10, 11, 300}, heights)
+	// The chain stub ignores the event type, so each event comes twice.
+	assert.Equal(t, []flowclient.Identifier{id(1), id(1), id(1), id(1), id(3), id(3), id(2), id(2)}, events)
+	assert.Equal(t, [][2]uint64{{10, 259}, {10, 259}, {260, 300}, {260, 300}}, source.requested)
+
+	stop := errors.New("stop")
+	err = flowclient.ForEachBlock(ctx, source, []string{availableType}, 10, 11, func(flowclient.BlockEvents) error { return stop })
+	assert.ErrorIs(t, err, stop)
+}
+
+func TestREST(t *testing.T) {
+	ctx := context.Background()
+
+	payload, err := jsoncdc.Encode(listingAvailable(t, 7))
+	require.NoError(t, err)
+
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		var response interface{}
+		switch r.URL.Path {
+		case "/v1/blocks":
+			assert.Equal(t, "sealed", r.URL.Query().Get("height"))
+			response = []map[string]interface{}{{"header": map[string]string{"height": "42"}}}
+		case "/v1/scripts":
+			assert.Equal(t, http.MethodPost, r.Method)
+			assert.Equal(t, "sealed", r.URL.Query().Get("block_height"))
+			var body struct {
+				Script    string   `json:"script"`
+				Arguments []string `json:"arguments"`
+			}
+			require.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+			script, err := base64.StdEncoding.DecodeString(body.Script)
+			require.NoError(t, err)
+			assert.Equal(t, "access(all) fun main(x: UInt64): UInt64 { return x }", string(script))
+			require.Len(t, body.Arguments, 1)
+			response = body.Arguments[0]
+		case "/v1/accounts/" + seller.Hex():
+			assert.Equal(t, "contracts,keys", r.URL.Query().Get("expand"))
+			response = map[string]interface{}{
+				"address": seller.String(),
+				"balance": "100000000",
+				"keys": []map[string]interface{}{{
+					"index":             "0",
+					"public_key":        "0x0102",
+					"signing_algorithm": "ECDSA_P256",
+					"hashing_algorithm": "SHA3_256",
+					"sequence_number":   "7",
+					"weight":            "1000",
+					"revoked":           false,
+				}},
+				"contracts": map[string]string{"C": base64.StdEncoding.EncodeToString([]byte("access(all) contract C {}"))},
+			}
+		case "/v1/events":
+			assert.Equal(t, availableType, r.URL.Query().Get("type"))
+			assert.Equal(t, "10", r.URL.Query().Get("start_height"))
+			assert.Equal(t, "11", r.URL.Query().Get("end_height"))
+			response = []map[string]interface{}{{
+				"block_id":        id(10).String(),
+				"block_height":    "10",
+				"block_timestamp": "2024-01-01T00:00:00Z",
+				"events": []map[string]string{{
+					"type":              availableType,
+					"transaction_id":    id(1).String(),
+					"transaction_index": "1",
+					"event_index":       "3",
+					"payload":           base64.StdEncoding.EncodeToString(payload),
+				}},
+			}}
+		default:
+			http.NotFound(w, r)
+			return
+		}
+		assert.NoError(t, json.NewEncoder(w).Encode(response))
+	}))
+	defer server.Close()
+
+	rest := flowclient.NewREST(server.URL, nil)
+
+	height, err := rest.GetLatestBlockHeight(ctx)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(42), height)
+
+	blocks, err := rest.GetEventsForHeightRange(ctx, availableType, 10, 11)
+	require.NoError(t, err)
+	require.Len(t, blocks, 1)
+	assert.Equal(t, uint64(10), blocks[0].Height)
+	assert.Equal(t, id(10), blocks[0].BlockID)
+	assert.Equal(t, time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC), blocks[0].BlockTimestamp)
+	require.Len(t, blocks[0].Events, 1)
+	event := blocks[0].Events[0]
+	assert.Equal(t, id(1), event.TransactionID)
+	assert.Equal(t, 1, event.TransactionIndex)
+	assert.Equal(t, 3, event.EventIndex)
+	decoded, err := nftstorefrontv2.DecodeListingAvailable(event.Value)
+	require.NoError(t, err)
+	assert.Equal(t, uint64(7), decoded.ListingResourceID)
+
+	account, err := rest.GetAccount(ctx, seller)
+	require.NoError(t, err)
+	assert.Equal(t, &flowclient.Account{
+		Address: seller,
+		Balance: 1_00000000,
+		Keys: []flowclient.AccountKey{{
+			PublicKey:      []byte{1, 2},
+			SigAlgo:        flowclient.ECDSA_P256,
+			HashAlgo:       flowclient.SHA3_256,
+			Weight:         1000,
+			SequenceNumber: 7,
+		}},
+		Contracts: map[string][]byte{"C": []byte("access(all) contract C {}")},
+	}, account)
+
+	result, err := rest.ExecuteScriptAtLatestBlock(ctx, []byte("access(all) fun main(x: UInt64): UInt64 { return x }"), []cadence.Value{cadence.NewUInt64(7)})
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewUInt64(7), result)
+
+	_, err = flowclient.NewREST(server.URL+"/missing", nil).GetLatestBlockHeight(ctx)
+	assert.EqualError(t, err, "GET /v1/blocks: 404 Not Found: 404 page not found")
+}
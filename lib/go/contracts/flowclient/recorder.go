@@ -0,0 +1,124 @@
+package flowclient
+
+import (
+	"context"
+	"sync"
+
+	"github.com/onflow/cadence"
+)
+
+// Recorder is a Client recording the calls made through it to another
+// client, for Fake to replay them. Failed scripts and sends are recorded too,
+// unless the context was done.
+//
+// Recorder is safe for concurrent use.
+type Recorder struct {
+	client Client
+
+	mu       sync.Mutex
+	fixtures Fixtures
+	err      error
+}
+
+var _ Client = (*Recorder)(nil)
+
+// NewRecorder returns a Recorder recording the calls made to client.
+func NewRecorder(client Client) *Recorder {
+	return &Recorder{client: client}
+}
+
+// record runs f on the fixtures, keeping the first error.
+func (r *Recorder) record(f func(*Fixtures) error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if err := f(&r.fixtures); err != nil && r.err == nil {
+		r.err = err
+	}
+}
+
+func (r *Recorder) ExecuteScriptAtLatestBlock(ctx context.Context, code []byte, arguments []cadence.Value) (cadence.Value, error) {
+	result, err := r.client.ExecuteScriptAtLatestBlock(ctx, code, arguments)
+	if ctx.Err() == nil {
+		r.record(func(f *Fixtures) error {
+			return f.AddScript(code, arguments, result, err)
+		})
+	}
+	return result, err
+}
+
+func (r *Recorder) SendTransaction(ctx context.Context, tx Transaction) (Identifier, error) {
+	id, err := r.client.SendTransaction(ctx, tx)
+	if ctx.Err() == nil {
+		r.record(func(f *Fixtures) error {
+			return f.AddTransaction(tx, id, err)
+		})
+	}
+	return id, err
+}
+
+func (r *Recorder) GetTransactionResult(ctx context.Context, id Identifier) (*TransactionResult, error) {
+	result, err := r.client.GetTransactionResult(ctx, id)
+	if err == nil {
+		r.record(func(f *Fixtures) error {
+			return f.SetTransactionResult(result)
+		})
+	}
+	return result, err
+}
+
+func (r *Recorder) GetLatestBlockHeight(ctx context.Context) (uint64, error) {
+	height, err := r.client.GetLatestBlockHeight(ctx)
+	if err == nil {
+		r.record(func(f *Fixtures) error {
+			f.LatestHeight = height
+			return nil
+		})
+	}
+	return height, err
+}
+
+func (r *Recorder) GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64) ([]BlockEvents, error) {
+	blocks, err := r.client.GetEventsForHeightRange(ctx, eventType, startHeight, endHeight)
+	if err == nil {
+		r.record(func(f *Fixtures) error {
+			for _, block := range blocks {
+				if err := f.AddBlock(block); err != nil {
+					return err
+				}
+			}
+			return nil
+		})
+	}
+	return blocks, err
+}
+
+func (r *Recorder) GetAccount(ctx context.Context, address cadence.Address) (*Account, error) {
+	account, err := r.client.GetAccount(ctx, address)
+	if err == nil {
+		r.record(func(f *Fixtures) error {
+			f.SetAccount(account)
+			return nil
+		})
+	}
+	return account, err
+}
+
+// Fixtures returns the calls recorded so far, or the first error that
+// prevented recording one.
+func (r *Recorder) Fixtures() (*Fixtures, error) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	if r.err != nil {
+		return nil, r.err
+	}
+	fixtures := Fixtures{
+		Scripts:      append([]ScriptFixture(nil), r.fixtures.Scripts...),
+		Transactions: append([]TransactionFixture(nil), r.fixtures.Transactions...),
+		Blocks:       append([]BlockFixture(nil), r.fixtures.Blocks...),
+		Accounts:     append([]AccountFixture(nil), r.fixtures.Accounts...),
+		LatestHeight: r.fixtures.LatestHeight,
+	}
+	return &fixtures, nil
+}
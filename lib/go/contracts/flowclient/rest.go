@@ -0,0 +1,252 @@
+package flowclient
+
+import (
+	"bytes"
+	"context"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+)
+
+// RESTHosts are the public REST Access API hosts of the networks.
+var RESTHosts = map[string]string{
+	"mainnet": "https://rest-mainnet.onflow.org",
+	"testnet": "https://rest-testnet.onflow.org",
+}
+
+// REST is an EventSource backed by the REST Access API, which also executes
+// scripts and reads accounts, for tools that only read the chain and so do not need the SDK.
+type REST struct {
+	host   string
+	client *http.Client
+}
+
+var _ EventSource = (*REST)(nil)
+
+// NewREST returns an EventSource for the REST Access API at the given host,
+// such as RESTHosts["mainnet"]. A nil client uses http.DefaultClient.
+func NewREST(host string, client *http.Client) *REST {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return &REST{host: host, client: client}
+}
+
+func (r *REST) get(ctx context.Context, path string, query url.Values, result interface{}) error {
+	return r.do(ctx, http.MethodGet, path, query, nil, result)
+}
+
+func (r *REST) do(ctx context.Context, method, path string, query url.Values, body interface{}, result interface{}) error {
+	u := r.host + path
+	if len(query) > 0 {
+		u += "?" + query.Encode()
+	}
+
+	var reader io.Reader
+	if body != nil {
+		encoded, err := json.Marshal(body)
+		if err != nil {
+			return err
+		}
+		reader = bytes.NewReader(encoded)
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, reader)
+	if err != nil {
+		return err
+	}
+	if body != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+
+	resp, err := r.client.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode != http.StatusOK {
+		message, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+		if len(bytes.TrimSpace(message)) > 0 {
+			return fmt.Errorf("%s %s: %s: %s", method, path, resp.Status, bytes.TrimSpace(message))
+		}
+		return fmt.Errorf("%s %s: %s", method, path, resp.Status)
+	}
+	if err := json.NewDecoder(resp.Body).Decode(result); err != nil {
+		return fmt.Errorf("%s %s: %w", method, path, err)
+	}
+	return nil
+}
+
+// ExecuteScriptAtLatestBlock executes a script against the latest sealed
+// state, like Client.
+func (r *REST) ExecuteScriptAtLatestBlock(ctx context.Context, code []byte, arguments []cadence.Value) (cadence.Value, error) {
+	encodedArguments := make([]string, len(arguments))
+	for i, argument := range arguments {
+		encoded, err := jsoncdc.Encode(argument)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		encodedArguments[i] = base64.StdEncoding.EncodeToString(encoded)
+	}
+
+	var result string
+	err := r.do(ctx, http.MethodPost, "/v1/scripts", url.Values{"block_height": {"sealed"}}, map[string]interface{}{
+		"script":    base64.StdEncoding.EncodeToString(code),
+		"arguments": encodedArguments,
+	}, &result)
+	if err != nil {
+		return nil, err
+	}
+
+	decoded, err := base64.StdEncoding.DecodeString(result)
+	if err != nil {
+		return nil, fmt.Errorf("script result: %w", err)
+	}
+	return jsoncdc.Decode(nil, decoded)
+}
+
+func (r *REST) GetLatestBlockHeight(ctx context.Context) (uint64, error) {
+	var blocks []struct {
+		Header struct {
+			Height string `json:"height"`
+		} `json:"header"`
+	}
+	if err := r.get(ctx, "/v1/blocks", url.Values{"height": {"sealed"}}, &blocks); err != nil {
+		return 0, err
+	}
+	if len(blocks) != 1 {
+		return 0, fmt.Errorf("expected the latest sealed block, got %d blocks", len(blocks))
+	}
+	return strconv.ParseUint(blocks[0].Header.Height, 10, 64)
+}
+
+type restAccount struct {
+	Balance string `json:"balance"`
+	Keys    []struct {
+		Index            string `json:"index"`
+		PublicKey        string `json:"public_key"`
+		SigningAlgorithm string `json:"signing_algorithm"`
+		HashingAlgorithm string `json:"hashing_algorithm"`
+		SequenceNumber   string `json:"sequence_number"`
+		Weight           string `json:"weight"`
+		Revoked          bool   `json:"revoked"`
+	} `json:"keys"`
+	Contracts map[string]string `json:"contracts"`
+}
+
+// GetAccount returns the account at the latest sealed block with its keys
+// and contracts, like Client.
+func (r *REST) GetAccount(ctx context.Context, address cadence.Address) (*Account, error) {
+	var account restAccount
+	err := r.get(ctx, "/v1/accounts/"+address.Hex(), url.Values{"block_height": {"sealed"}, "expand": {"contracts,keys"}}, &account)
+	if err != nil {
+		return nil, err
+	}
+
+	result := &Account{Address: address, Contracts: make(map[string][]byte, len(account.Contracts))}
+	if result.Balance, err = strconv.ParseUint(account.Balance, 10, 64); err != nil {
+		return nil, fmt.Errorf("account %s: invalid balance: %w", address, err)
+	}
+	for _, k := range account.Keys {
+		key := AccountKey{
+			SigAlgo:  SignatureAlgorithm(k.SigningAlgorithm),
+			HashAlgo: HashAlgorithm(k.HashingAlgorithm),
+			Revoked:  k.Revoked,
+		}
+		index, err := strconv.ParseUint(k.Index, 10, 32)
+		if err != nil {
+			return nil, fmt.Errorf("account %s: invalid key index: %w", address, err)
+		}
+		key.Index = uint32(index)
+		if key.PublicKey, err = hex.DecodeString(strings.TrimPrefix(k.PublicKey, "0x")); err != nil {
+			return nil, fmt.Errorf("account %s: key %d: %w", address, index, err)
+		}
+		if key.SequenceNumber, err = strconv.ParseUint(k.SequenceNumber, 10, 64); err != nil {
+			return nil, fmt.Errorf("account %s: key %d: invalid sequence number: %w", address, index, err)
+		}
+		if key.Weight, err = strconv.Atoi(k.Weight); err != nil {
+			return nil, fmt.Errorf("account %s: key %d: invalid weight: %w", address, index, err)
+		}
+		result.Keys = append(result.Keys, key)
+	}
+	for name, code := range account.Contracts {
+		if result.Contracts[name], err = base64.StdEncoding.DecodeString(code); err != nil {
+			return nil, fmt.Errorf("account %s: contract %s: %w", address, name, err)
+		}
+	}
+	return result, nil
+}
+
+type restBlockEvents struct {
+	BlockID        Identifier `json:"block_id"`
+	BlockHeight    string     `json:"block_height"`
+	BlockTimestamp time.Time  `json:"block_timestamp"`
+	Events         []struct {
+		Type             string     `json:"type"`
+		TransactionID    Identifier `json:"transaction_id"`
+		TransactionIndex string     `json:"transaction_index"`
+		EventIndex       string     `json:"event_index"`
+		Payload          string     `json:"payload"`
+	} `json:"events"`
+}
+
+func (r *REST) GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64) ([]BlockEvents, error) {
+	var blocks []restBlockEvents
+	err := r.get(ctx, "/v1/events", url.Values{
+		"type":         {eventType},
+		"start_height": {strconv.FormatUint(startHeight, 10)},
+		"end_height":   {strconv.FormatUint(endHeight, 10)},
+	}, &blocks)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]BlockEvents, len(blocks))
+	for i, block := range blocks {
+		height, err := strconv.ParseUint(block.BlockHeight, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("block %s: invalid height: %w", block.BlockID, err)
+		}
+		result[i] = BlockEvents{
+			BlockID:        block.BlockID,
+			Height:         height,
+			BlockTimestamp: block.BlockTimestamp,
+		}
+
+		for _, e := range block.Events {
+			event := Event{Type: e.Type, TransactionID: e.TransactionID}
+			if event.TransactionIndex, err = strconv.Atoi(e.TransactionIndex); err != nil {
+				return nil, fmt.Errorf("block %d: invalid transaction index: %w", height, err)
+			}
+			if event.EventIndex, err = strconv.Atoi(e.EventIndex); err != nil {
+				return nil, fmt.Errorf("block %d: invalid event index: %w", height, err)
+			}
+
+			payload, err := base64.StdEncoding.DecodeString(e.Payload)
+			if err != nil {
+				return nil, fmt.Errorf("block %d: event %s: %w", height, e.Type, err)
+			}
+			value, err := jsoncdc.Decode(nil, payload)
+			if err != nil {
+				return nil, fmt.Errorf("block %d: event %s: %w", height, e.Type, err)
+			}
+			var ok bool
+			if event.Value, ok = value.(cadence.Event); !ok {
+				return nil, fmt.Errorf("block %d: event %s: expected an event, got %s", height, e.Type, value.Type().ID())
+			}
+
+			result[i].Events = append(result[i].Events, event)
+		}
+	}
+	return result, nil
+}
@@ -0,0 +1,173 @@
+// Package flowconfig reads the contract addresses of a flow.json
+// configuration and uses them to resolve the string imports of the
+// scripts and transactions of this repository.
+package flowconfig
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"regexp"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+)
+
+// DefaultPath is the configuration of this repository, relative to
+// lib/go/contracts, the directory its commands are run from.
+const DefaultPath = "../../../flow.json"
+
+// Config is the part of a flow.json configuration needed to resolve
+// contract addresses.
+type Config struct {
+	Contracts    map[string]Contract                     `json:"contracts"`
+	Dependencies map[string]Contract                     `json:"dependencies"`
+	Accounts     map[string]Account                      `json:"accounts"`
+	Deployments  map[string]map[string][]json.RawMessage `json:"deployments"`
+}
+
+// Contract is a contract or dependency entry.
+type Contract struct {
+	Source  string            `json:"source"`
+	Aliases map[string]string `json:"aliases"`
+}
+
+// Account is an account entry. Keys are not read.
+type Account struct {
+	Address string `json:"address"`
+}
+
+// Load reads the configuration from the given file.
+func Load(path string) (*Config, error) {
+	f, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer f.Close()
+
+	config, err := Parse(f)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", path, err)
+	}
+	return config, nil
+}
+
+// Parse reads the configuration from r.
+func Parse(r io.Reader) (*Config, error) {
+	var config Config
+	if err := json.NewDecoder(r).Decode(&config); err != nil {
+		return nil, err
+	}
+	return &config, nil
+}
+
+// coreContracts are the addresses of the core contracts, used for contracts
+// that the configuration does not list, such as FlowToken.
+var coreContracts = map[string]map[string]string{
+	"FlowToken": {
+		"emulator": "0ae53cb6e3f42a79",
+		"testnet":  "7e60df042a9c0868",
+		"mainnet":  "1654653399040a61",
+	},
+	"FungibleToken": {
+		"emulator": "ee82856bf20e2aa6",
+		"testnet":  "9a0766d93b6608b7",
+		"mainnet":  "f233dcee88fe0abe",
+	},
+	"NonFungibleToken": {
+		"emulator": "f8d6e0586b0a20c7",
+		"testnet":  "631e88ae7f1d7c20",
+		"mainnet":  "1d7e57aa55817448",
+	},
+	"MetadataViews": {
+		"emulator": "f8d6e0586b0a20c7",
+		"testnet":  "631e88ae7f1d7c20",
+		"mainnet":  "1d7e57aa55817448",
+	},
+	"ViewResolver": {
+		"emulator": "f8d6e0586b0a20c7",
+		"testnet":  "631e88ae7f1d7c20",
+		"mainnet":  "1d7e57aa55817448",
+	},
+}
+
+// Address returns the address of the contract on the given network. The
+// account the contract is deployed to on that network takes precedence over
+// its aliases.
+func (c *Config) Address(contract, network string) (cadence.Address, error) {
+	if address, ok := c.deployment(contract, network); ok {
+		return parseAddress(contract, address)
+	}
+
+	for _, entries := range []map[string]Contract{c.Contracts, c.Dependencies} {
+		if address, ok := entries[contract].Aliases[network]; ok {
+			return parseAddress(contract, address)
+		}
+	}
+
+	if address, ok := coreContracts[contract][network]; ok {
+		return parseAddress(contract, address)
+	}
+
+	return cadence.Address{}, fmt.Errorf("no address for %s on %s", contract, network)
+}
+
+func (c *Config) deployment(contract, network string) (string, bool) {
+	for account, entries := range c.Deployments[network] {
+		for _, raw := range entries {
+			// A deployment is either the contract name or an object with
+			// the name and the initializer arguments.
+			var name string
+			if err := json.Unmarshal(raw, &name); err != nil {
+				var entry struct {
+					Name string `json:"name"`
+				}
+				if err := json.Unmarshal(raw, &entry); err != nil {
+					continue
+				}
+				name = entry.Name
+			}
+
+			if name == contract {
+				address := c.Accounts[account].Address
+				return address, address != ""
+			}
+		}
+	}
+	return "", false
+}
+
+func parseAddress(contract, address string) (cadence.Address, error) {
+	result, err := common.HexToAddress(address)
+	if err != nil {
+		return cadence.Address{}, fmt.Errorf("invalid address of %s: %w", contract, err)
+	}
+	return cadence.Address(result), nil
+}
+
+var stringImport = regexp.MustCompile(`(?m)^(\s*)import\s+"(\w+)"`)
+
+// ResolveImports replaces the string imports of the given script or
+// transaction with imports from the addresses of the contracts on the given
+// network.
+func (c *Config) ResolveImports(code []byte, network string) ([]byte, error) {
+	var err error
+	resolved := stringImport.ReplaceAllFunc(code, func(match []byte) []byte {
+		groups := stringImport.FindSubmatch(match)
+		contract := string(groups[2])
+
+		address, addressErr := c.Address(contract, network)
+		if addressErr != nil {
+			if err == nil {
+				err = addressErr
+			}
+			return match
+		}
+		return []byte(fmt.Sprintf("%simport %s from %s", groups[1], contract, address))
+	})
+	if err != nil {
+		return nil, err
+	}
+	return resolved, nil
+}
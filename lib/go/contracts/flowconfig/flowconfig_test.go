@@ -0,0 +1,53 @@
+package flowconfig_test
+
+import (
+	"path"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+)
+
+func TestAddress(t *testing.T) {
+	// DefaultPath is relative to the parent directory.
+	config, err := flowconfig.Load(path.Join("..", flowconfig.DefaultPath))
+	require.NoError(t, err)
+
+	for _, test := range []struct {
+		contract, network, address string
+	}{
+		// Deployed by this project.
+		{"NFTStorefrontV2", "mainnet", "0x4eb8a10cb9f87357"},
+		// Alias.
+		{"NFTStorefrontV2", "testnet", "0x2d55b98eb200daef"},
+		{"HybridCustody", "mainnet", "0xd8a7e05a7ac670c0"},
+		// Core contract missing from the configuration.
+		{"FlowToken", "testnet", "0x7e60df042a9c0868"},
+	} {
+		address, err := config.Address(test.contract, test.network)
+		require.NoError(t, err)
+		assert.Equal(t, test.address, address.String(), "%s on %s", test.contract, test.network)
+	}
+
+	_, err = config.Address("ExampleNFT", "mainnet")
+	assert.EqualError(t, err, "no address for ExampleNFT on mainnet")
+}
+
+func TestResolveImports(t *testing.T) {
+	config, err := flowconfig.Parse(strings.NewReader(`{
+		"contracts": {"NFTStorefrontV2": {"aliases": {"testnet": "2d55b98eb200daef"}}}
+	}`))
+	require.NoError(t, err)
+
+	code := []byte("import \"FungibleToken\"\nimport \"NFTStorefrontV2\"\n\ntransaction {}\n")
+
+	resolved, err := config.ResolveImports(code, "testnet")
+	require.NoError(t, err)
+	assert.Equal(t, "import FungibleToken from 0x9a0766d93b6608b7\nimport NFTStorefrontV2 from 0x2d55b98eb200daef\n\ntransaction {}\n", string(resolved))
+
+	_, err = config.ResolveImports(code, "previewnet")
+	assert.EqualError(t, err, "no address for FungibleToken on previewnet")
+}
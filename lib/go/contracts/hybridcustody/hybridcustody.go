@@ -0,0 +1,181 @@
+// Package hybridcustody builds the transaction that lists an NFT held in a
+// child account linked to the signing parent account through hybrid
+// custody.
+package hybridcustody
+
+import (
+	"bytes"
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+)
+
+const filenameSellItemInChildFromParent = "transactions/hybrid-custody/sell_item_in_child_from_parent.cdc"
+
+// Addresses are the addresses of the hybrid custody contracts.
+type Addresses struct {
+	HybridCustody       cadence.Address
+	CapabilityFactory   cadence.Address
+	CapabilityFilter    cadence.Address
+	CapabilityDelegator cadence.Address
+}
+
+// ResolveAddresses returns the addresses of the hybrid custody contracts on
+// the given network.
+func ResolveAddresses(config *flowconfig.Config, network string) (Addresses, error) {
+	var addresses Addresses
+	for _, c := range []struct {
+		name    string
+		address *cadence.Address
+	}{
+		{"HybridCustody", &addresses.HybridCustody},
+		{"CapabilityFactory", &addresses.CapabilityFactory},
+		{"CapabilityFilter", &addresses.CapabilityFilter},
+		{"CapabilityDelegator", &addresses.CapabilityDelegator},
+	} {
+		address, err := config.Address(c.name, network)
+		if err != nil {
+			return addresses, err
+		}
+		*c.address = address
+	}
+	return addresses, nil
+}
+
+// SellItemInChildFromParentTransaction returns the transaction listing an NFT
+// of a child account in the storefront of the parent account, with its
+// imports resolved for the given network.
+func SellItemInChildFromParentTransaction(config *flowconfig.Config, network string) ([]byte, error) {
+	// Resolve the hybrid custody contracts first, so that a configuration
+	// without them fails even though the transaction only imports
+	// HybridCustody directly.
+	addresses, err := ResolveAddresses(config, network)
+	if err != nil {
+		return nil, err
+	}
+
+	return config.ResolveImports(addresses.resolveImports(templates.MustAsset(filenameSellItemInChildFromParent)), network)
+}
+
+// resolveImports replaces the string imports of the hybrid custody
+// contracts with imports from their addresses.
+func (a Addresses) resolveImports(code []byte) []byte {
+	for _, c := range []struct {
+		name    string
+		address cadence.Address
+	}{
+		{"HybridCustody", a.HybridCustody},
+		{"CapabilityFactory", a.CapabilityFactory},
+		{"CapabilityFilter", a.CapabilityFilter},
+		{"CapabilityDelegator", a.CapabilityDelegator},
+	} {
+		code = bytes.ReplaceAll(code, []byte(fmt.Sprintf("import %q", c.name)), []byte(fmt.Sprintf("import %s from %s", c.name, c.address)))
+	}
+	return code
+}
+
+// SellItemInChildFromParentArguments are the arguments of the
+// sell_item_in_child_from_parent transaction.
+type SellItemInChildFromParentArguments struct {
+	ChildAddress          cadence.Address
+	CollectionStoragePath cadence.Path
+	CollectionPublicPath  cadence.Path
+	NFTTypeIdentifier     string
+	SaleItemID            uint64
+	SaleItemPrice         cadence.UFix64
+	CustomID              *string
+	CommissionAmount      cadence.UFix64
+	Expiry                uint64
+	MarketplacesAddress   []cadence.Address
+}
+
+// Validate checks the arguments without encoding them. The collection of a
+// child account is stored under a storage path, which the provider
+// capability is issued for, and published under a public path; passing them
+// the other way round only fails once the transaction executes.
+func (a SellItemInChildFromParentArguments) Validate() error {
+	if err := checkPath("collectionStoragePath", a.CollectionStoragePath, common.PathDomainStorage); err != nil {
+		return err
+	}
+	if err := checkPath("collectionPublicPath", a.CollectionPublicPath, common.PathDomainPublic); err != nil {
+		return err
+	}
+	if a.NFTTypeIdentifier == "" {
+		return fmt.Errorf("nftTypeIdentifier is empty")
+	}
+	if a.CommissionAmount > a.SaleItemPrice {
+		return fmt.Errorf("commissionAmount %s exceeds saleItemPrice %s", a.CommissionAmount, a.SaleItemPrice)
+	}
+	return nil
+}
+
+func checkPath(name string, path cadence.Path, domain common.PathDomain) error {
+	if path.Domain != domain {
+		return fmt.Errorf("%s must be a %s path, got %s", name, domain.Identifier(), path)
+	}
+	if path.Identifier == "" {
+		return fmt.Errorf("%s has an empty identifier", name)
+	}
+	return nil
+}
+
+// Values validates the arguments and returns them in the order of the
+// transaction parameters.
+func (a SellItemInChildFromParentArguments) Values() ([]cadence.Value, error) {
+	if err := a.Validate(); err != nil {
+		return nil, err
+	}
+
+	var customID cadence.Optional
+	if a.CustomID != nil {
+		customID = cadence.NewOptional(cadence.String(*a.CustomID))
+	}
+
+	marketplaces := make([]cadence.Value, 0, len(a.MarketplacesAddress))
+	for _, address := range a.MarketplacesAddress {
+		marketplaces = append(marketplaces, address)
+	}
+
+	values := []cadence.Value{
+		a.ChildAddress,
+		a.CollectionStoragePath,
+		a.CollectionPublicPath,
+		cadence.String(a.NFTTypeIdentifier),
+		cadence.NewUInt64(a.SaleItemID),
+		a.SaleItemPrice,
+		customID,
+		a.CommissionAmount,
+		cadence.NewUInt64(a.Expiry),
+		cadence.NewArray(marketplaces).WithType(cadence.NewVariableSizedArrayType(cadence.AddressType)),
+	}
+
+	if err := arguments.Check(filenameSellItemInChildFromParent, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// Encode validates the arguments and returns them encoded as JSON-Cadence,
+// ready to be added to a transaction.
+func (a SellItemInChildFromParentArguments) Encode() ([][]byte, error) {
+	values, err := a.Values()
+	if err != nil {
+		return nil, err
+	}
+
+	encoded := make([][]byte, 0, len(values))
+	for _, value := range values {
+		b, err := jsoncdc.Encode(value)
+		if err != nil {
+			return nil, err
+		}
+		encoded = append(encoded, b)
+	}
+	return encoded, nil
+}
@@ -0,0 +1,63 @@
+package hybridcustody_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/hybridcustody"
+)
+
+func TestSellItemInChildFromParentTransaction(t *testing.T) {
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+
+	addresses, err := hybridcustody.ResolveAddresses(config, "testnet")
+	require.NoError(t, err)
+	assert.Equal(t, "0x294e44e1ec6993c6", addresses.CapabilityDelegator.String())
+
+	code, err := hybridcustody.SellItemInChildFromParentTransaction(config, "testnet")
+	require.NoError(t, err)
+	assert.Contains(t, string(code), "import HybridCustody from 0x294e44e1ec6993c6\n")
+	assert.Contains(t, string(code), "import NFTStorefrontV2 from 0x2d55b98eb200daef\n")
+	assert.NotContains(t, string(code), `import "`)
+}
+
+func TestSellItemInChildFromParentArguments(t *testing.T) {
+	customID := "flowty"
+	args := hybridcustody.SellItemInChildFromParentArguments{
+		ChildAddress:          cadence.BytesToAddress([]byte{1}),
+		CollectionStoragePath: cadence.Path{Domain: common.PathDomainStorage, Identifier: "exampleNFTCollection"},
+		CollectionPublicPath:  cadence.Path{Domain: common.PathDomainPublic, Identifier: "exampleNFTCollection"},
+		NFTTypeIdentifier:     "A.f8d6e0586b0a20c7.ExampleNFT.NFT",
+		SaleItemID:            1,
+		SaleItemPrice:         cadence.UFix64(10_00000000),
+		CustomID:              &customID,
+		CommissionAmount:      cadence.UFix64(1_00000000),
+		Expiry:                1_700_000_000,
+	}
+
+	encoded, err := args.Encode()
+	require.NoError(t, err)
+	require.Len(t, encoded, 10)
+	assert.JSONEq(t, `{"type":"Path","value":{"domain":"storage","identifier":"exampleNFTCollection"}}`, string(encoded[1]))
+	assert.JSONEq(t, `{"type":"Array","value":[]}`, string(encoded[9]))
+
+	swapped := args
+	swapped.CollectionStoragePath, swapped.CollectionPublicPath = args.CollectionPublicPath, args.CollectionStoragePath
+	_, err = swapped.Encode()
+	assert.EqualError(t, err, "collectionStoragePath must be a storage path, got /public/exampleNFTCollection")
+
+	storage := args
+	storage.CollectionPublicPath = cadence.Path{Domain: common.PathDomainStorage, Identifier: "exampleNFTCollection"}
+	_, err = storage.Values()
+	assert.EqualError(t, err, "collectionPublicPath must be a public path, got /storage/exampleNFTCollection")
+
+	expensive := args
+	expensive.CommissionAmount = cadence.UFix64(11_00000000)
+	assert.EqualError(t, expensive.Validate(), "commissionAmount 11.00000000 exceeds saleItemPrice 10.00000000")
+}
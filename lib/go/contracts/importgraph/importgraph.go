@@ -0,0 +1,133 @@
+// Package importgraph builds the import graph of the shipped Cadence files
+// and resolves the contracts a file depends on to their addresses, so that
+// deployers can check that every dependency exists on a network before
+// sending a transaction.
+package importgraph
+
+import (
+	"bytes"
+	"fmt"
+	"path"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/assets"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+)
+
+// Graph maps shipped files and contracts to the contracts they import.
+// Contracts that are not shipped, such as FungibleToken, have no outgoing
+// edges: whatever they import is already deployed wherever they are.
+type Graph struct {
+	// imports maps file names and contract names to imported contract
+	// names.
+	imports map[string][]string
+	// contracts maps contract names to the names of their files.
+	contracts map[string]string
+}
+
+// Embedded returns the import graph of the shipped files.
+func Embedded() *Graph {
+	g := &Graph{
+		imports:   make(map[string][]string),
+		contracts: make(map[string]string),
+	}
+	for _, f := range assets.All() {
+		g.imports[f.Name] = f.Imports
+		if f.Kind == assets.KindContract {
+			contract := strings.TrimSuffix(path.Base(f.Name), ".cdc")
+			g.contracts[contract] = f.Name
+			g.imports[contract] = f.Imports
+		}
+	}
+	return g
+}
+
+// Imports returns the contracts directly imported by the given file or
+// contract.
+func (g *Graph) Imports(name string) ([]string, error) {
+	imports, ok := g.imports[name]
+	if !ok {
+		return nil, fmt.Errorf("unknown file or contract %s", name)
+	}
+	return append([]string(nil), imports...), nil
+}
+
+// Dependencies returns every contract the given file or contract depends on,
+// directly or through the shipped contracts it imports, sorted by name.
+func (g *Graph) Dependencies(name string) ([]string, error) {
+	if _, ok := g.imports[name]; !ok {
+		return nil, fmt.Errorf("unknown file or contract %s", name)
+	}
+
+	seen := make(map[string]bool)
+	var visit func(string)
+	visit = func(node string) {
+		for _, contract := range g.imports[node] {
+			if seen[contract] {
+				continue
+			}
+			seen[contract] = true
+			visit(contract)
+		}
+	}
+	visit(name)
+
+	dependencies := make([]string, 0, len(seen))
+	for contract := range seen {
+		dependencies = append(dependencies, contract)
+	}
+	sort.Strings(dependencies)
+	return dependencies, nil
+}
+
+// Requirement is a contract that must be deployed at an address.
+type Requirement struct {
+	Contract string
+	Address  cadence.Address
+}
+
+// Requirements returns the dependencies of the given file or contract with
+// their addresses on the given network. Every missing address is reported.
+func (g *Graph) Requirements(name string, config *flowconfig.Config, network string) ([]Requirement, error) {
+	dependencies, err := g.Dependencies(name)
+	if err != nil {
+		return nil, err
+	}
+
+	requirements := make([]Requirement, 0, len(dependencies))
+	var missing []string
+	for _, contract := range dependencies {
+		address, err := config.Address(contract, network)
+		if err != nil {
+			missing = append(missing, contract)
+			continue
+		}
+		requirements = append(requirements, Requirement{Contract: contract, Address: address})
+	}
+	if len(missing) > 0 {
+		return nil, fmt.Errorf("%s: no address on %s for %s", name, network, strings.Join(missing, ", "))
+	}
+	return requirements, nil
+}
+
+// Addresses returns the distinct addresses of the requirements, sorted.
+func Addresses(requirements []Requirement) []cadence.Address {
+	var addresses []cadence.Address
+	for _, r := range requirements {
+		addresses = append(addresses, r.Address)
+	}
+	sort.Slice(addresses, func(i, j int) bool {
+		return bytes.Compare(addresses[i][:], addresses[j][:]) < 0
+	})
+
+	result := addresses[:0]
+	for i, address := range addresses {
+		if i == 0 || address != addresses[i-1] {
+			result = append(result, address)
+		}
+	}
+	return result
+}
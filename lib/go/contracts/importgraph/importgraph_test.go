@@ -0,0 +1,60 @@
+package importgraph_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/importgraph"
+)
+
+func TestDependencies(t *testing.T) {
+	g := importgraph.Embedded()
+
+	imports, err := g.Imports("transactions/cleanup_expired_listings.cdc")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"NFTStorefrontV2"}, imports)
+
+	dependencies, err := g.Dependencies("transactions/cleanup_expired_listings.cdc")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"Burner", "FungibleToken", "NFTStorefrontV2", "NonFungibleToken"}, dependencies)
+
+	dependencies, err = g.Dependencies("NFTCatalogAdmin")
+	require.NoError(t, err)
+	assert.Equal(t, []string{"MetadataViews", "NFTCatalog"}, dependencies)
+
+	_, err = g.Dependencies("transactions/missing.cdc")
+	assert.EqualError(t, err, "unknown file or contract transactions/missing.cdc")
+}
+
+func TestRequirements(t *testing.T) {
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+
+	g := importgraph.Embedded()
+
+	requirements, err := g.Requirements("transactions/cleanup_expired_listings.cdc", config, "testnet")
+	require.NoError(t, err)
+
+	contracts := make(map[string]string)
+	for _, r := range requirements {
+		contracts[r.Contract] = r.Address.String()
+	}
+	assert.Equal(t, map[string]string{
+		"Burner":           "0x9a0766d93b6608b7",
+		"FungibleToken":    "0x9a0766d93b6608b7",
+		"NFTStorefrontV2":  "0x2d55b98eb200daef",
+		"NonFungibleToken": "0x631e88ae7f1d7c20",
+	}, contracts)
+
+	var addresses []string
+	for _, address := range importgraph.Addresses(requirements) {
+		addresses = append(addresses, address.String())
+	}
+	assert.Equal(t, []string{"0x2d55b98eb200daef", "0x631e88ae7f1d7c20", "0x9a0766d93b6608b7"}, addresses)
+
+	_, err = g.Requirements("transactions-v1/buy_item.cdc", config, "mainnet")
+	assert.EqualError(t, err, "transactions-v1/buy_item.cdc: no address on mainnet for ExampleNFT, ExampleToken")
+}
@@ -6,6 +6,8 @@
 // ../../../contracts/utility/ExampleToken.cdc (9.619kB)
 // ../../../contracts/utility/NFTCatalog.cdc (16.383kB)
 // ../../../contracts/utility/NFTCatalogAdmin.cdc (7.969kB)
+// ../../../contracts/utility/test/HybridCustody.cdc (2.76kB)
+// ../../../contracts/utility/test/MaliciousCollection.cdc (2.464kB)
 // ../../../contracts/utility/test/MaliciousStorefrontV1.cdc (6.24kB)
 // ../../../contracts/utility/test/MaliciousStorefrontV2.cdc (8.243kB)
 
@@ -77,7 +79,7 @@ func (fi bindataFileInfo) Sys() interface{} {
 	return nil
 }
 
-var _nftstorefrontCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x4d\x93\x1b\xb7\xb1\xf7\xfd\x15\xad\x3d\x38\xa4\x1e\x45\xa5\x5e\xa5\x72\xd8\xd2\x4a\x96\x57\xd1\xab\xad\xd8\x8a\x4a\x59\xc7\x07\xdb\x07\x70\xa6\x87\x44\xed\x10\x60\x00\x0c\x69\x46\xd1\x7f\x7f\x85\xc6\xc7\x00\x33\x18\x2e\x57\x56\x5e\x2a\xe5\xc7\x83\xbd\x22\x81\x46\xa3\xd1\xdf\xdd\x00\xdf\xee\xa4\x32\x70\xf9\xb6\x13\x6b\xbe\x6a\xf1\x4e\xde\xa3\xb8\xbc\x08\x5f\xbf\x93\x62\xe2\x97\x6f\x3a\x25\x50\x5d\x5e\x5c\x3c\x7f\xfe\x1c\xde\x7d\x73\x05\x77\x1b\xae\xa1\x92\xc2\x28\x56\x19\xe0\x1a\x84\x84\x56\x8a\x35\x2a\xd0\xdd\xce\x4e\xc2\x7a\x09\xef\xde\xde\xc1\x5f\x8d\x54\xd8\x28\x29\x0c\xfc\xed\xbf\xed\x48\x85\x95\xdc\x6e\x51\xd4\x58\x5b\x70\x0e\xe4\xdb\xbb\x7e\xdc\x12\xe2\xf7\xaf\x61\x8d\x02\x15\x6b\x61\xd7\xa9\x9d\xd4\x08\x9a\xb5\x18\x96\xe8\x11\x68\xa4\x82\xb7\xad\x3c\xc0\x70\x0b\x7a\x49\x70\xe8\x3f\x7f\x62\xd5\x06\x58\x55\xc9\x4e\x18\x30\x1b\x66\xe0\xc0\x84\xd1\x60\x24\xb4\x5c\x1b\x8b\x84\x26\x48\xb4\x06\x17\xda\xb0\xb6\xd5\xc0\x92\x2d\x2c\x08\x10\x13\x35\xcd\xd0\xc0\x45\xcd\xf7\xbc\xee\x58\x4b\x93\x34\x1c\xb8\xd9\x70\xe1\xa0\x27\x3b\x67\x1a\xbe\xe5\xda\x70\xb1\xf6\x08\xdd\x6d\x50\xa1\xa5\x86\x14\x98\x0e\xdc\xa1\x0a\x28\x2e\x80\x1b\xd8\x30\x51\x5b\xb8\x0e\xba\x6c\x80\xb5\x2d\x91\xd5\x1c\x77\xa8\x09\x94\xc5\x98\xd6\xf3\xf3\x96\x91\x7a\xb4\x61\xbf\x2e\x54\x4c\xc0\x86\xed\x91\x56\x94\x0a\xb6\x52\x21\x5c\x56\x9d\xb9\x24\xb8\x66\xe3\x69\xbb\x53\xbc\x42\x02\x48\x30\xd6\x12\x89\x42\xe9\x2c\x56\xd7\x0a\xb5\x46\xbd\x84\x9b\xce\x68\x02\xbd\x42\xe8\x34\xd6\x76\xe8\x8e\x1d\x89\x3c\x76\xd5\x06\x3d\x96\x52\x81\x34\x1b\x54\xf6\xcc\x34\xaf\x51\x31\xc3\x65\x38\x1e\x42\xd4\xee\x6a\xcb\x8e\x16\x92\x9d\x8d\x35\x70\x91\x2d\x1b\x28\xb8\x20\x64\xf7\xac\xe5\x35\x37\x47\x8b\x3c\xb2\x6a\x43\x80\xd2\xcd\x22\xd3\xbc\x25\x70\xd5\x06\xab\x7b\xac\x13\x56\x78\xdf\xa9\x6a\xc3\x34\x2a\x87\xfc\x81\x99\x6a\x43\x84\x0c\x00\x70\x8f\x96\x35\xec\x49\xd3\x6c\x5a\x32\xd0\xdd\x7e\x4d\x60\x6e\xdf\xd8\xfd\x6a\x44\xe0\x44\xc1\x23\x1c\xb8\xde\xd8\xef\x56\xdd\x91\xa6\x84\x9d\x18\xdc\xba\xe5\xbf\x63\xea\x1e\xcd\xae\x65\x15\x3a\xf0\x8e\x2a\x6c\xbd\x56\xb8\x66\x46\x3e\x80\x51\xc6\x7f\x04\x96\x4e\x8f\x0b\x83\x0a\xb5\x3f\x7b\x56\x55\xa8\xf5\x8c\xb5\xed\xbc\x17\x91\x4c\xc4\xe0\xe3\xc5\x05\x00\x40\x3a\x12\x85\xe1\xa6\xc5\x2d\x0a\x03\x37\x0a\x99\x41\xbf\xf4\xc9\x91\x1f\x70\x2b\xf7\x71\x24\x0d\xb5\x28\xf6\x4b\xdd\x0a\x6e\x38\x6b\xf9\x3f\xb0\x8e\xbf\xbe\x4e\x59\x5e\xa1\x96\x9d\xaa\x10\x36\x4c\xc3\x0a\x51\x40\x45\xab\xd7\xcb\x38\xfe\x4f\x76\xef\xc4\x39\xdd\x36\x9c\x99\x90\x07\xc0\x5f\x76\x58\x99\x70\x58\x8d\x92\x5b\x30\x56\x31\x25\xba\x24\xc2\x78\x27\x0d\x7a\xa9\x47\xa8\x25\x08\x69\x40\xef\xb0\xe2\xcd\x11\x98\x08\x3c\x7d\x65\x7f\xad\x98\xb0\xbf\x5a\x32\xeb\x8d\xec\xda\xda\x0e\xee\x21\x39\xe2\xd4\x11\x71\x1d\xc0\x91\x78\x31\x01\xf2\x20\xec\x91\x3a\x88\x0b\x82\xe3\x19\xdb\xd2\xaa\x27\x03\x6b\x8c\x15\x08\x0b\x8e\x4b\x61\xd9\xfd\xc0\x8e\x3a\xc1\x80\x58\xaf\x5f\xd8\x53\xf9\xf5\x9e\xf1\x96\xad\x5a\x0c\x1b\x1f\xc8\x5f\x8d\x06\xd5\x96\x0b\x24\x0e\xf4\x68\x44\x20\x5e\xd6\x1d\x8e\xfe\x1f\xc9\x69\xcc\x96\xcb\x25\x37\x1a\x5a\x59\x11\x56\x73\x60\x86\xc6\x18\xbe\x45\x90\x4d\x84\x13\xb8\xdb\xf2\xe6\xaa\x33\x20\x45\x7b\x74\x63\x99\x81\x9d\xc2\x8a\x6b\xbb\x5f\x62\x92\xa0\x11\xc3\xd7\x46\x31\xa1\x59\x65\xe1\xf7\xbb\xbb\xf5\x4a\x08\xdb\x16\x15\x51\x4a\x0f\x91\x3b\x6c\x78\x8b\xd9\xca\x5c\x3b\x45\xb0\x80\x04\x31\x66\x9c\x66\xb5\x23\xb7\x71\x81\x31\x1b\x13\x57\x15\x39\x75\xa6\xe3\xb7\x1f\xfc\x29\xdf\xbe\xb9\x82\xef\x6f\x85\xf9\xe3\x1f\xe6\x25\x36\x7f\x83\xda\x28\x79\x9c\x62\xf2\xc8\xdb\x75\x18\xf7\x30\x77\x6b\x23\x77\xb0\x53\xd2\x62\x9c\xe8\xa4\x2f\xc1\xe6\xe7\x13\x25\xee\xeb\x5c\x92\x0c\x99\x34\xa1\x47\x38\xb4\xa1\xa0\x93\x84\xb0\xba\x76\xec\xcb\x4a\xca\xa1\xdf\xe0\xdd\x06\xe1\xb5\xdb\x85\x3d\xfa\x0e\x35\x90\x2d\x65\xca\xdb\x04\x38\x6c\x90\x8e\xde\xef\x85\x6b\xc0\x2d\x37\x06\xeb\x85\x65\xd4\x8c\x81\xb5\x61\x06\x83\x14\x78\xfb\xa9\x9d\x26\x57\xd8\xa0\xb2\xf8\x78\xd1\xad\x36\x4c\xac\xb1\x06\xd9\x19\x6b\xbf\xfc\xa4\x9e\xee\x99\x7a\x3d\x48\x75\xdf\xb4\xf2\xb0\x00\x2d\x69\x32\x53\xd8\x74\xad\x05\xe7\xcc\x09\xe1\xd8\xd1\xb1\x9e\xc3\xa4\x43\xa2\xce\x68\x9c\xfd\xf4\xa7\xf2\x3a\x68\x30\xff\xc7\x22\x8e\xf1\x74\x1f\x1f\x5b\x3f\x44\x34\xe6\xee\xb8\xc3\x2b\xb0\xff\xcd\xbe\x2e\x8d\x6e\xcc\xdf\x58\xd7\x16\x67\x90\xfb\x70\x05\xdf\xbf\xe5\xbf\xfc\xf1\x0f\xf4\xed\x98\x37\x6e\xe4\x76\xd7\xa2\x49\x64\xe5\x2e\x11\xe9\xc8\x1d\xf6\xec\xdb\xbd\xf5\x23\x6f\x9d\x00\x21\x27\x5b\x49\x3f\xee\xbc\x05\xaf\x17\xd6\x3f\x50\x64\x85\x1c\x23\x8d\x25\xec\x34\x51\x23\x36\xb3\x33\x08\x06\x05\xc2\x9f\x1c\x16\xf1\xbc\x82\x6f\xa4\x6c\x1f\x47\xf1\x21\xfd\x7a\x0e\xb3\x7f\xb1\x35\xbe\x67\x66\x93\x13\xd1\xab\x6d\xab\x72\xb5\x1b\xe3\x9d\xc3\xa2\xc9\xf5\xe6\x6d\xe5\x27\x06\x9a\xa5\xb4\x6a\xd1\x94\xd7\xbd\x82\x14\x89\x02\x8e\xef\xbb\x55\xcb\xab\x11\x8a\x3b\xfa\xba\xc7\xd4\x3a\x39\x19\x76\x2d\x17\xf7\x0f\xe1\xd1\xc3\xbe\x82\x64\x9d\x04\x0d\xd6\xe2\x4d\x22\xed\xaf\x41\x1b\xd5\x55\x76\xef\x3b\x85\xda\xba\x30\x62\x0d\xcc\x46\x23\x7c\xc7\x31\x44\x04\xdb\x4e\x1b\x4b\x0e\x3b\x00\x18\x54\xa8\x0c\xe3\x02\xd8\xd6\x6a\x86\xa1\x0d\xdd\xb1\x23\x99\x37\x12\x66\x06\xc6\x06\x1c\x56\xdd\x68\xd9\x9e\x60\x3e\x8f\x87\x47\x10\x3e\xc6\xa3\x0f\x04\x52\x58\x21\xdf\xa3\xf2\x9e\x7d\x5c\x67\x99\x8d\x2c\x2a\x7a\x43\xee\x79\x54\xf3\x56\xdf\x34\x5c\xd4\x04\x85\x44\xd6\xcd\x20\xeb\x11\x09\x91\x68\x0a\xf2\x4a\xb4\xb7\xb0\xe2\xde\x89\x96\xe7\x95\xb0\x56\x83\xd6\x35\x25\x93\xce\xb5\xf5\x58\xa2\x73\xc3\x04\xdf\x75\xad\x65\xa3\x1c\xa2\x96\x19\x22\x11\x39\xc7\x97\x55\x67\x62\x94\x71\x94\x9d\x3b\x82\x35\x7a\x2c\x3d\xb5\x98\x9b\x2b\x32\xb8\x95\x0d\x86\x02\xb5\x96\x2b\xa9\x94\x3c\xcc\xe6\x4f\x96\xe4\xdb\x2c\xc3\x32\x56\x18\x06\xb4\x73\xd1\xab\xf3\x9a\x6a\x1b\x62\x60\xd3\xf0\xca\x72\x41\x7b\xb4\x3b\x63\xa0\x2b\xc5\x77\xc9\xac\x21\x27\x86\x55\xaf\xe0\x86\xed\xd8\x8a\xb7\xdc\x1c\x5f\x7c\xf5\x31\x8b\x3d\x97\x1f\xfc\xa0\x4f\x2f\x2f\x46\x87\xec\x38\x6a\xc8\x49\xd9\x7c\x4f\x71\xde\xb6\x16\xcf\x1d\xe3\x64\x1e\x4d\xc2\x21\xd3\xf8\x39\xf0\x51\x0f\x67\xcb\xf3\xe8\xe7\xa8\xf4\xfb\xf8\xb7\xfd\x7d\xf6\xc8\x0d\x2e\x06\x2b\xce\x13\xbe\x26\x6d\x89\x6d\xb3\x8c\x8c\x7d\x1d\x77\x30\x1e\xe4\x09\x73\x9d\xca\x9c\xfd\x7c\xba\x70\xff\x1d\x59\x93\x37\x68\x18\x6f\xf5\x58\xd2\x6d\xe8\xc3\xb8\x70\x72\xee\x07\xff\x4e\x43\xcd\x0c\x7b\x50\x3a\x73\xd8\x05\x21\x4d\xf4\x95\x17\xa9\x18\xa0\x92\x0a\xb0\x3f\xdb\x08\x76\x4a\x68\xbd\x08\x7a\xd9\xf2\xfe\x7e\x88\x10\x9c\x1b\x54\xf3\xa6\x41\x95\xbb\x63\x63\xc1\xb2\x70\xb8\x86\xbf\xfc\x79\x69\x3d\xe8\x03\x79\x3d\x0a\xb6\xec\x1e\x81\x1b\x37\xc2\xfa\xc3\x06\x9e\x56\x4c\x3c\x8d\x4b\xe4\x80\x9c\xa8\x45\x63\xa0\x70\xcf\x35\x8f\xe6\x60\x48\xa3\x3d\x53\x89\xf9\x1b\x98\xab\x00\xf2\x87\x0d\x92\xb5\x26\xd0\x23\xdb\x1e\xcd\xa2\x55\x31\x31\xba\x2a\x2d\x34\x30\xa0\xa3\x83\xb0\xe6\x33\x88\xd1\x30\xfb\xb3\xa4\x88\xdd\xc7\x03\x2b\xb4\x18\xb8\x80\x7c\x5a\x70\x32\xb3\x3c\x5a\xec\xf6\x4d\x5c\xea\xed\x5d\x96\xec\x31\xc7\x1d\x9e\x84\x3a\x41\xa6\xe1\x16\x0a\x1a\xc0\x2b\x07\x1d\x8d\xd3\x96\xd5\x98\xb1\xd6\x70\x39\xcd\x5a\x7c\xef\x66\x0d\x1c\xb5\x29\x3d\x94\x19\x3f\x52\x35\xdc\xf9\xd0\x2e\x76\xe0\x58\xe7\x98\x3d\xb0\xf6\xc8\x09\x84\x54\xf3\x06\x98\xce\xd0\xd4\xdc\x32\x9b\x14\x96\x02\x41\x0f\xae\xd0\x1c\x9c\x0b\xe8\xad\xb3\x3e\xbd\xe0\x4d\x67\xf4\x15\xfc\xe8\x6d\xea\xcf\xb9\xbe\xd3\x68\xee\x64\xc8\xf8\xd4\xd9\x4f\xb7\x4a\x59\x71\xd1\x7c\xd5\x1e\xed\xb8\x9c\x5b\x99\xee\xb9\x6f\x59\x54\x95\x1e\x97\x90\x64\x99\x43\xd3\x89\xc1\x7a\xb3\xa2\x2a\xec\xf9\xff\x1a\x8c\xea\x30\x51\x73\x8f\xd6\xd5\x30\xcb\xe0\x4f\x38\x96\x30\xed\xce\x13\x56\x93\x2c\x33\x1e\x37\xa0\xf6\x60\xc0\x29\xc5\x50\xa4\x45\x3a\x03\xae\x33\x00\xa7\x09\xd7\xb0\x56\xe3\x78\x88\x27\x00\x5c\x07\x52\x14\x87\xd0\x5a\xf4\xff\x02\x46\x05\x62\x58\xcc\x0a\x5f\x67\x93\x83\xfb\x4b\x7c\x5d\x75\x46\x67\xbf\x32\xad\x51\x99\x59\xa0\xe0\xb2\x45\xb1\x36\x1b\x78\x09\xbf\x5f\xc0\x16\xb5\x66\x6b\xbc\x82\xcb\x60\x44\x48\x18\x5d\x46\xc9\x40\x8b\x4c\x1b\x4a\x86\x06\x09\xb1\x5e\x53\x94\x8e\xcb\x79\x79\x0f\x94\x9d\xbd\x8e\x67\x76\x31\x44\xf6\x86\xb5\x15\x39\x6c\x2e\xc3\x23\x0d\x6b\x7d\xfe\xd7\x27\x19\x0a\xbb\x20\xe5\x1f\x64\x1c\xae\xe1\xf7\xcb\xdf\x0f\xc1\xbe\x47\xd5\x48\xb5\x0d\xcc\xeb\x43\x5e\x29\xa0\x0a\xae\x04\x47\x9f\x17\xab\x22\x06\x49\xf2\x99\x16\xb7\x1b\x74\xca\x29\x91\x7d\xfb\xb1\x5e\xb1\xfd\xd1\x46\x38\xd9\x46\x73\xd6\xf2\xb8\x7c\x67\x0d\xa1\xee\x14\xfa\xb4\x1a\x38\x37\x71\xc2\x8d\x4a\x26\xfe\x80\xce\xf5\x0a\xe9\x5f\xae\x81\xad\x6d\x2c\x10\xd3\x0b\x05\x7f\x3f\xfd\x54\x9d\x59\x8e\x9c\xd3\xd1\x28\xfb\x79\xf5\x0a\x76\x4c\xf0\x6a\x76\x79\xe3\xfd\x00\x87\x63\x98\x7d\x39\x9e\x66\xfd\x9c\xba\x0e\x27\x14\xb5\xb8\x1c\x1e\xe4\x68\x62\x7a\x74\xfd\xdf\xff\x45\xd8\x0e\x3c\x2e\x88\x5e\x57\xf8\x24\x1c\xec\xe6\xbd\xb4\xc7\x9f\x72\xef\x5d\xe2\x06\xf5\x1c\x2c\xa4\x78\xf6\x0f\x54\xd2\xe1\x74\x39\x1f\x31\x62\x22\x35\x81\x21\xea\x84\x23\x26\x24\x74\xb8\x8d\x87\x7d\x45\x17\x2a\xf6\xae\xa2\x70\x99\xf3\x86\x55\x76\x25\xb9\xe7\xb5\xf3\x16\x3b\x4d\xe9\x1a\x1f\xaa\xf6\x63\xc8\x2f\xf3\xb0\xa6\x7d\xc8\xe8\xd6\xf5\x13\xb3\xf5\x07\xee\xa4\x3b\xed\x77\x6f\xef\xc6\x96\x92\x38\xd0\x51\x3d\x1a\x64\xb6\x45\x8a\xba\x7c\x9c\x66\x1d\x11\x6d\x98\xa8\x99\xaa\x7b\x50\x09\xa7\x91\x19\xe9\x9d\x16\xcb\xc7\x2b\x1b\xf6\x2d\x48\x92\xf0\x17\xb6\xdd\xb5\x54\xc0\xe0\x49\x9a\xd2\xb2\x34\xec\x39\x03\x26\x5c\x81\xa2\x4d\x37\x0d\x65\x33\x48\x9b\xb7\x16\x30\x41\xe3\x0a\xbe\xfa\x58\x72\xcb\x3e\xbd\xca\x0d\x5d\xd0\xeb\xd9\x97\xc1\x88\xa6\x29\xdf\x05\xac\xba\xa3\xcf\x9b\x39\x11\x2c\xc4\x76\x3b\x76\x74\xd4\x59\xa1\x40\x1b\xda\x31\xc5\x7d\xbd\x45\xa1\xe9\x94\xd0\x89\x04\x7b\xa9\x59\x75\xc7\x54\x15\x9c\xda\x5f\x40\x76\xe6\xb5\xf1\x15\x7c\x3d\x88\x8c\xc8\x3e\x7c\x9a\xdb\x1f\x8a\xbb\xcf\x37\xbf\x46\x93\x46\x31\xa7\x96\xdf\x73\x3c\x10\x0e\xfd\x1c\x4b\xe4\x41\x2c\x34\xc5\xff\x49\x90\x94\xc7\xf5\xac\x6d\xe5\xc1\x12\xc8\x15\xb9\x28\x69\x49\x3c\x40\xd9\x19\x91\x84\xab\x0c\xd6\x7c\x8f\x22\x77\x07\x17\x11\xb0\x25\x71\xcc\x5b\x28\x59\x21\xd6\xbe\xbe\xc8\x9c\xab\x16\x80\xef\x5a\xde\xfb\x79\xda\x7a\x61\xac\x1d\xf9\x7b\x54\xb0\x9b\x14\x2f\xbf\xa9\xab\x5c\xba\x16\xe0\xaa\xd5\x4b\xfb\x3f\x2a\x93\xa4\xe2\xe6\xb3\xed\x0e\x09\x9f\x17\x0e\x2a\x3d\x71\xfd\xb8\x1e\xe6\x11\xfd\x74\x5f\xa5\x48\x03\x1a\x17\xb9\xe5\x39\xc9\x83\xb5\x2e\x6b\x2b\x92\x9c\xaa\xbf\x55\x48\x32\x52\xb2\x3a\x83\x18\x4b\xc1\x0c\x34\xee\x98\xb2\xc6\xd0\x67\xae\x7b\x90\x44\xd3\xde\xf7\xf1\xcb\xeb\x22\x8b\xb8\xc9\x21\x2b\xd9\x27\xf0\x33\x2d\x3a\x99\xe2\xb4\x0a\xd5\x6a\xd8\xae\xe3\xf5\x94\x67\x37\x3d\xab\x76\x0c\x98\xf9\x74\x39\x94\x41\x3c\x37\x9c\xd8\xd3\xb0\xec\xd4\xfe\xd5\x28\x4b\xf7\xc1\x2c\xff\xf3\x92\xd7\x28\x8c\x0d\x2d\xd4\x09\xc7\xb7\x30\x39\x71\x02\x13\xe3\x54\xf4\xee\x57\x9d\x12\x37\xac\x6d\x57\xac\xba\x1f\xf9\xf6\x5f\x9e\x41\x3c\xd4\xbf\x58\x25\x7c\xe0\x1a\x4f\x4c\x4c\xd8\x65\x36\x1f\x41\xb8\xf3\x89\x82\x15\x56\xac\x73\x70\x3c\x87\xe7\x05\x35\x91\x96\x95\x54\x5a\xdd\x1d\x78\x2f\xae\xa8\x9f\x0c\xae\x5a\x64\xa2\xdb\xcd\xe6\x30\x5a\xdc\x65\x26\x5c\x09\xc5\x09\x1a\xad\xdd\x55\x46\xaa\x45\x48\x37\x80\xd9\x48\xcb\xe9\x9d\xa8\x7c\x6f\x40\x0a\x85\x37\xf0\xa4\xcc\x29\x05\xc7\xcf\x0a\xf6\x89\xfc\x7e\xfa\x29\x08\xc2\x04\xff\x87\x4f\x59\x0e\xce\x64\xff\xf0\x49\xc4\xe0\x1c\xfe\x0f\x9f\x28\x07\x25\xf6\x9f\x9c\x32\xc2\x6f\x1c\xf7\x00\xb1\x7e\xfa\xaf\x4f\x53\x31\xa9\xf5\xf1\x34\x27\xaf\x61\x66\x3d\xbb\x3e\x41\xb8\x20\x4f\xcf\x71\xe4\x2f\x73\xf0\xab\xa5\xed\x25\x43\xd9\xb2\x58\xb9\x30\xde\x0f\x2e\x9b\x32\x88\x66\x2b\x86\x10\x47\x67\xb3\x9c\x27\x90\xe6\xd2\x8c\xa4\xc4\x4c\xad\xd8\x21\xcb\xd4\xd0\x3f\x9c\xed\xba\x7d\xe3\x62\x0c\x6e\xac\xfc\xb4\x2d\x26\xa5\x68\xc8\x73\xc4\xf9\x6a\xda\xc7\x0d\x85\xa5\x9e\x32\x71\x7c\x6a\x17\xa3\x9a\xdf\x51\x76\x49\x3e\x2d\x94\xff\xc8\xce\xac\xf9\x3e\x58\xe2\xb0\x96\xee\xaa\x0d\xb0\x74\x35\x72\x34\xe3\x3a\x54\x1f\x6d\xa9\x39\xc0\x85\x23\x0e\xf1\x1a\x5d\x7d\x32\x44\x38\xdc\x67\x8b\xad\x78\xf3\xe0\x30\x66\x0b\x59\xbf\x31\xe4\x03\xab\x96\xf1\xed\x38\xaf\xd2\x6b\x3b\x9f\xb8\x7a\x4f\x7e\x31\xaa\xfe\x98\xb3\x9c\x30\xeb\xcc\x66\x36\xf2\x71\x7e\xf0\x64\x99\x97\xdc\xbf\x00\x71\x31\x4e\xd8\xdd\xc4\xd3\x70\xc6\x7c\x98\x3a\xff\x8d\xfa\xca\x03\x15\xe7\x2a\x10\x4d\x30\x64\xc5\x43\x4a\xea\x21\xfd\x12\x34\x3c\xf5\x1c\x9d\x26\x98\x97\xa2\x3c\xbb\xc0\x93\x6b\x10\xbc\x4d\x03\xbc\xbc\x53\x2f\x84\x42\x71\x85\x2b\xb8\x09\x0d\x33\x21\x8a\x65\xae\x94\x8e\xc2\x09\x4c\xa4\xad\x48\xed\xce\x93\xcb\x22\x0e\x23\x15\xa5\xb0\x79\xb2\xe4\xfa\x56\xd8\x63\xac\xb0\xbc\x21\xab\x0b\xe7\x63\x65\xf8\x88\x4d\x58\x15\x67\xd2\xc4\xf2\xdb\x3b\x1f\x1f\x62\x0d\xab\x63\xd2\x41\xf3\xe2\xa7\x19\xe1\xb4\x46\x5a\x76\x36\x4f\x7c\x90\xf9\x4b\xa8\x25\x3a\xfb\xbf\xa5\x86\x2e\x13\xe0\xe6\xbb\xb7\x50\x26\x77\x92\x01\x7c\x72\x99\x6d\xeb\x6c\xa2\xbd\x5a\xf2\x1a\xae\x3d\xbf\x8c\x19\xe0\x57\x13\xeb\xf6\x0d\x78\x42\xf0\x7a\x0e\xb3\x07\xc9\x56\xa0\x0b\x81\x98\x62\xd0\x11\xbb\x9c\x20\x83\x8b\xf0\x80\x18\x98\xe9\x49\x99\x9a\x4f\xd9\xb7\x72\x34\x06\xff\x83\xae\xca\x33\xb0\x6a\x55\xa7\xa8\x46\x93\xb5\x92\x7c\xdb\x67\x90\x99\x2f\x29\x15\xac\x0b\xdb\x4b\x5e\x6b\xd8\xb0\x3d\xa5\x4b\xa4\x8a\xc5\xf1\x3d\x53\xdc\x86\x2e\x2e\x64\x5d\xa3\x31\xa8\x60\x8b\x66\x23\x6b\x1d\xc2\xab\xad\xcb\xa0\xed\x5a\x76\xcc\x11\x15\xbc\xc2\xf6\xe8\x6c\x9e\xab\x63\x6a\x98\x1d\x36\xbc\xda\x84\x3a\x93\xa7\x50\x6c\x60\x9b\x2f\xe1\xcb\x04\x9e\x03\x3d\xe5\xd7\x49\x5d\x8f\x41\x8e\xc6\x7e\x7e\xcb\x79\x80\x01\xc1\x76\x0a\x0b\xde\xec\x84\xdb\x7b\xed\x33\xe0\x57\x45\xb7\x6f\x42\x6e\xc3\x74\x6b\xc3\x89\x17\x76\x05\xda\x3a\xde\x39\x29\x90\xcb\x51\xcf\x0e\x6b\x15\xb2\xfa\x38\x88\x74\x06\x92\x4a\x9b\xf4\xed\x0c\x43\x35\x1e\x9d\xe7\x42\x9a\x7d\xfe\x6f\xdc\x64\xe3\x4f\xcd\xb3\x09\xb5\x59\x32\x1d\x33\xf1\x2f\x7e\x0a\xc7\x5f\x36\x02\xae\x3b\xde\x78\xcf\xf1\xef\x1d\x52\x47\x30\x59\x81\xa0\xf6\x4f\xed\x3c\x05\xb5\x9c\x26\xe6\x8a\xb5\x96\x92\x51\xc7\x67\x20\xa9\x1c\xf7\xef\x23\x60\xa0\xd4\x9e\x3a\x50\xa2\xea\xf7\xf5\xf9\x01\x61\x5c\x09\x40\x36\x50\xa2\x8d\xfd\x6d\x48\x85\x4f\xa3\x6c\x72\x9f\xf3\x4f\xb1\xed\x6b\xec\xbd\x08\x51\x46\x7f\x39\x4e\x2f\xc7\x45\x07\xa5\xbc\xd1\x52\x6f\x31\x9a\xf5\xa0\x43\xbc\xd2\xf3\xda\x24\xac\x35\x28\x32\x78\xdf\x1a\x5e\x3c\x3b\xcb\x83\x0b\x51\xc6\x2c\xfc\x51\x8e\xe8\x46\x01\xfa\x3b\xdf\x32\x17\x2a\x09\x96\xf0\x2a\x98\x64\xa5\xa9\x69\xd2\xa8\x4e\x9b\x83\x54\x66\x73\x5c\xb8\xd6\x47\x4a\xdc\x53\x80\xb7\x75\x8d\x51\x08\x95\x54\x0a\x2b\x33\x84\xdf\x27\xb8\x57\x9d\x25\xed\x51\x8a\xda\x65\x24\x8e\x91\x41\x6c\xac\xb2\x53\xf8\x7c\x27\x35\x9d\x79\xcd\x29\xba\x77\x4b\x59\x04\xec\xa9\xac\x59\xa7\x98\x30\x98\xd4\x67\xfd\x12\x46\x0e\x50\x09\xf9\x01\x46\x81\xd2\x0a\x37\xa1\xaf\xa8\x47\x86\x0b\x60\xe2\xe8\x83\xbd\x03\x3b\x16\x92\x21\xa8\xb0\x91\xb1\x2a\x44\xf2\x69\xe9\x40\x80\xfa\x20\xa4\x0f\xc1\x4a\xeb\x2c\x86\x60\xad\x5d\x39\xa0\xa3\x5f\x7e\x6d\xa0\x2f\x09\x18\x42\x4b\x43\xa7\xf3\xe0\x2d\x84\x65\x5c\xa7\x04\x07\x29\x06\xc9\xa0\x29\x27\x4f\x34\xd3\x1a\xf5\xf3\x7d\xe2\x69\x45\x10\x3c\xb1\xb3\x7c\x65\xdf\xd4\xfd\xe2\xa7\x99\x45\xf3\xd7\xf9\xca\xc1\xff\x1a\x2a\xcf\x2f\xe6\x2e\x13\x25\xeb\x91\x26\xfd\x4c\x47\xf9\x4c\x0a\x92\x1a\x75\x2b\xcf\xcf\xa0\xe4\x49\xff\x39\xd7\x09\x13\xf4\x1b\x91\x66\xc8\xcc\x1f\x98\xef\xdf\x61\x02\xd8\x4a\x2a\x13\x9d\xad\xbe\x7b\xdf\x86\xc2\x56\xd2\x62\x67\x57\x8c\x98\x5d\xae\xe3\x60\x47\x1f\xc3\xa5\x20\x6e\x46\x12\x73\xa0\x56\xcb\xbe\x90\xe9\xb5\x66\xc3\x95\x36\xbe\x9d\xbb\x5c\xa5\xf5\x79\x28\x37\x30\x2e\xef\x53\x2e\x26\x8a\x77\x6c\x19\x4e\x88\x47\x1d\xc3\x76\x53\x6b\x85\x74\x8d\x23\x74\x80\x36\x52\x8d\x25\xfa\x08\x9d\xa0\x86\x98\xaa\x1b\x56\xa2\xf7\x8c\xda\x23\xe9\xfe\xd7\x87\xd8\x2f\x37\xd9\x24\xf7\x0a\x28\x9a\x1e\x11\xfa\x3d\x3b\xd2\x0d\xa6\xc4\x49\xa5\xb3\xe6\xaa\xdc\x25\x78\xba\x1c\x9e\xda\xcb\x89\xb2\x38\x6f\xb2\x1e\x46\xb8\x2e\xd7\xaa\x0b\x33\xbd\xe5\xf2\x88\xdc\x74\xd6\x80\x45\xb4\xa2\x91\x0a\xad\x80\x7d\x4d\xb9\x5c\xf7\x8e\x2b\xd6\xb8\x93\x9a\x9b\x59\xa3\xe4\xf6\x0a\x5e\x3c\xeb\xe1\x97\x27\xf2\xc6\x46\x77\x39\xe1\xad\xbc\x0a\xde\x4e\x60\xed\xd7\x1b\x4e\x29\xf7\x21\x86\xcf\xa7\xd1\xb7\x9f\x4e\x79\x1e\xd3\xe1\xf7\x60\x59\x9f\x55\xf9\x15\xaa\xe4\x9d\xf4\xc2\x11\x5c\xab\x68\xe0\x1f\x92\xea\xd7\xbe\xc7\x69\x27\x39\x5d\x31\x74\x37\x0a\xad\x0c\x50\x23\x14\x1c\xe8\x2e\x45\x65\xf8\xde\xa7\x1d\xdd\x95\x03\xde\x22\x39\x07\x22\x6b\x57\x75\x2d\xc5\x94\x78\xdb\xb0\xfd\xa8\x17\x86\x4a\xfe\xe4\x17\x69\x68\xb1\x31\x0b\xdf\xc6\x1b\x92\x75\xbd\x25\x77\xf7\xf4\x18\x08\xf9\x4c\xee\x9c\x29\xf4\xf7\x61\x9c\x35\xc4\xed\xce\x1c\x9d\xff\x78\x71\x8a\xb4\x4f\xa6\x58\x69\x4c\x88\x41\x9d\x86\xeb\x7f\x7d\x75\xc6\x65\x0f\x42\xdd\x63\x19\x00\xe4\xa8\x9d\x59\xba\x78\x5c\xd9\xe2\x57\x97\x2c\x3e\xa3\x5c\xf1\xc8\x52\xc5\x79\x65\x8a\xc1\x39\x7a\x5f\xfb\xc5\x33\xd1\x98\xa9\x74\xce\xe7\xb5\xd0\x7d\xf9\xac\x77\xef\x55\x7e\x7a\x39\x51\xdc\xfc\x0f\xe8\xd8\xcb\x9a\x75\xfc\x45\xe9\x46\xaa\x2d\xdd\xe5\x98\x0c\xa4\xe0\x7a\x90\x28\x2a\x7a\x5d\x6e\x07\x0f\x71\xc8\x84\x1f\x56\x26\x47\xe9\xdb\xf2\x5c\x47\xa2\xf0\xd7\x29\x11\x22\x36\x9d\x6a\x52\x3c\xd5\xdb\x94\xf8\x73\x85\xc6\xf7\x22\xd3\xb9\x1e\xc5\xf1\xf7\xe3\x56\x3e\x1f\x6e\xf8\x56\xf4\xb0\x4c\x08\xb2\x63\xfd\x63\xa4\xbb\xf2\x3e\x37\xab\xb5\xce\xee\x72\x73\xb3\x7d\xf8\xb4\x95\x7b\x5f\xda\xe5\x82\xaa\x57\x41\xbb\xc7\x8a\x73\x22\x88\x3a\xde\x70\xb5\xd6\x67\x8b\xdb\x15\xaa\xa9\xc2\x2f\x39\x1c\x61\x3b\x67\x55\x3c\xce\xb2\xca\x11\xe4\xe7\x5a\x63\xbb\x9d\xe8\xd4\xf7\x7b\x0b\x8c\xfe\x04\xbe\x77\xdd\x27\x46\x86\x4a\x88\x3d\xff\x80\x76\xa2\x4c\x4e\x7b\xe2\x21\x53\x70\x1d\x31\x1e\x15\x74\x4e\xe7\x00\xfa\xe2\xd8\x4e\x61\x8c\xbf\x63\xa5\xac\xc9\x4f\x99\x2e\x3e\x87\x6b\x86\x67\x47\x9e\xff\x3a\x22\x9e\x5b\x4e\x0a\xef\x36\x7c\x6e\xc4\x37\xae\x2a\xfd\xfa\xe0\xf9\xc1\xcd\x0d\x43\x67\x77\x0a\xf1\x09\x8a\xd3\xe1\xb2\x3f\x2c\xd9\x24\x3d\xfa\xa7\x6a\x4b\xa7\xe2\xe4\xcf\x24\xda\xab\x2f\x18\x27\x9f\x45\xac\xfe\xfe\x85\x23\x15\x11\xc8\xd5\x9c\x62\x44\x4c\x1d\xa7\x3e\x20\xee\x29\x33\x20\x4a\x31\x52\x9e\xa2\x42\x6c\x27\x85\xd1\x1d\xc7\xef\x98\x60\x6b\xaf\xcd\x47\x1d\xa5\xd4\x3b\x57\xbb\x96\x52\xaa\x38\x6c\x25\x55\x7a\xc2\x43\x16\xe1\x02\x09\x1b\x5d\xf0\x79\xee\x73\x6b\xc2\x46\xfe\x16\x8c\xd5\x9f\x3e\x03\xd0\x8f\xfd\x9d\x06\x79\x10\x61\x02\xfd\xff\x81\x36\xd4\x11\xe2\x83\x56\xd4\x6a\xf4\xee\x43\xdc\x58\xdf\xfe\x90\x5c\x7d\x72\xc5\x3c\x23\xfd\x44\xda\x26\x17\xa4\x58\xfa\xf7\x4e\x12\x30\xc3\x4a\x4a\xf6\xce\x84\xab\xa9\x64\x28\xfc\xb6\xdc\xb2\xde\xe9\x2a\x5e\x15\xca\x9a\xb1\x1e\x73\x38\x6e\x22\xe5\x32\x48\xaf\xc4\x0a\x1a\xab\x70\x67\x0a\x77\xb0\x0a\x27\x95\xbd\xf3\xe1\x4e\x2a\x6f\x0e\x9b\x6c\x28\x9c\x4f\x4b\xcf\xa9\x76\x6c\xeb\x43\xd8\x6d\xf5\xb7\x71\x44\xe8\xd9\x48\x65\xc8\x17\x41\x5d\x18\x42\x81\x1c\xbd\x89\xb2\xe7\xb1\x4f\x5b\x27\x12\x95\x89\xda\xa3\x1a\xb8\x87\x48\x27\x82\x33\x55\x25\xf5\xeb\xdf\xbe\xa1\x42\xe9\x8f\x8e\x1a\x3f\x9f\x9e\xe6\x36\xf8\x30\x4d\xaf\xe0\xab\x8f\x59\xd7\xeb\xa8\x47\x84\xd2\xed\xe3\x5c\x88\x42\x6d\x63\x76\x97\x2c\x81\x7f\xfe\xd3\x7f\x91\x34\x30\xd8\xdf\xec\x5f\x2f\xbe\xf6\x0b\xbc\x9c\x4d\x55\xdf\xf2\xeb\x0a\x8c\xba\xbf\x8a\xda\xfd\xc9\xb0\x64\x33\xfe\x6b\x58\x5b\x0d\xdd\x84\xa7\xf9\xaa\xc8\x56\x3d\x43\x95\xbb\x9c\xb9\xd1\xbd\x70\x6c\x49\x11\x02\x73\x2f\xea\xc8\x66\xc0\x59\x4c\x1c\xa5\x20\x6e\x24\x5e\x60\x95\x89\xcd\x64\xdb\x94\xb1\xa4\xaa\x1d\xbc\xbf\x77\x18\xd3\x77\x21\xfa\x49\x58\x34\x46\x02\x3a\x3a\xeb\xc7\xfe\x2a\xf6\x19\x2c\xd9\x6f\xf3\x6a\xac\xce\x17\xa7\xf8\xf4\xc1\xe6\xe7\x3e\xa8\x99\xe8\x7f\x7e\x74\x97\xf1\x39\x2d\xc3\x5d\xc7\xfb\xa2\xcf\x7c\xdc\x6a\x58\x73\x97\x24\x52\xc7\xe4\x74\xc0\xce\xa2\x0a\x4a\xf8\x22\x36\x33\x8c\xd0\x75\x3d\x86\x7b\x16\x1b\xb3\xf4\x15\x7c\xfd\xd1\x21\x11\x1b\x18\x46\x49\x03\x6b\xc4\xb2\xaf\x6e\x7a\x13\x47\x5d\x1a\x7a\xd3\xdf\x05\x09\x6d\xf2\x59\x80\x95\x6a\xd1\xff\x37\x78\x27\x0d\x5e\x6f\xf1\x0a\x9d\x6e\xd1\x91\x7d\x16\xdc\x8c\x22\xb1\x4e\x10\xac\xf8\xf5\x89\x8c\xd5\x7f\x46\x0a\x62\x24\x3c\x50\x8e\x1e\x47\x1a\x14\xae\x63\x8b\xe2\x68\x7e\x32\x21\xdc\xaf\x0a\x63\xb3\xfa\x7e\x7f\xe9\x6a\x94\x61\xf6\xf7\xd2\x04\xf6\xb6\xdb\x07\x6a\xbd\x28\x8f\x43\x7c\xd9\xd6\xdf\xf6\x07\x4d\x9b\x0b\xe2\xfa\xe3\x68\x07\x3f\xdb\x31\xed\xc0\x13\xf2\xeb\xf7\x77\xec\x13\x90\xae\x39\xd4\xb5\xd0\xae\xd0\x35\x35\xae\x3a\x7a\xcf\xc2\xc5\x0b\xd2\x3f\xb5\x47\x6f\x45\x64\x20\xfd\x6d\x92\x55\xa7\xc4\xec\xc5\xb3\x1e\xe6\x7c\x3a\x6f\x5b\x78\xa7\x67\x7c\x86\xf1\xbd\x1e\xda\x2b\xd9\xa3\x57\xe1\xd1\x8a\x27\xe3\xc3\x2f\xd8\xc1\xd1\x57\x5f\x9e\xa5\x9b\xc7\x73\xb2\x7f\x01\x28\x65\xa2\x33\x52\xb8\xa3\xbd\x14\x5c\x84\x8b\x33\xdd\xe1\x0f\xde\xdf\x8d\xda\x99\xb8\x21\xdc\xc4\x38\xe2\xf0\x36\x46\x72\xdb\xb5\xaf\xfc\x27\x4f\x09\x0d\xdf\x0f\xf9\x82\xbe\xf1\x69\x75\x97\x49\x81\xbf\x8c\x31\xbb\xc7\x63\xe1\xe8\xc7\x85\xb2\xfe\x56\x69\xee\x92\x4d\x5d\xef\x48\x5b\x77\xe9\xad\x96\x44\x78\x7d\x04\x31\x6e\xa3\xff\x69\xbc\xb7\xb9\x6f\xe5\x9d\xce\x43\x91\xa8\xb0\x51\x91\xc3\xb9\x14\xcb\x13\xc2\xd7\x06\xc9\xeb\x79\x23\x3b\xf8\xcc\xe9\x1e\xb0\x84\xeb\xf6\x63\x02\x98\x52\xec\x38\x6c\xd4\x8c\xee\xd5\xed\x9b\xf0\x30\x8d\x8a\x99\x94\x9e\x2b\x4e\x71\xc1\x99\xfe\xff\x89\x16\xc9\x78\xd2\xf7\x78\xd4\x53\x7b\x74\xce\xb6\xb5\xa0\xb7\xc6\xbb\xa0\xa3\x4d\x82\x42\x56\x3f\xa3\x17\xf9\x08\xa1\xf0\xcc\x9f\x9f\x14\x2f\xe8\xb9\x63\x6c\x03\x9e\xbe\xb1\xdd\xbf\xec\xca\xb8\x08\x6d\x07\x7c\xf2\x9a\xc4\xff\x55\x34\xc3\x9b\x87\x4d\x82\xcb\x41\x16\x63\x1e\xa2\xf1\x57\x0f\x42\xa0\x6e\xe1\x01\x22\x79\xe0\x02\xd8\xea\x52\x8b\xa6\x5f\x42\xf0\x76\x32\xd0\xc9\x13\x2d\x2e\xba\x29\xaa\xad\x78\x22\xf0\x94\x37\x4f\xb3\xab\x06\xe5\xe7\x28\xe0\xb5\x0b\x52\x2a\x26\x28\xa1\xee\x4c\x9b\x7b\x6f\x91\x7a\x31\xb8\x7f\x9e\xd1\x35\x18\xb8\x17\xee\xe2\x4b\xb8\x31\x12\xaa\x25\x68\x99\x03\xfe\x33\x4f\x22\x17\xff\x34\xe1\x46\x1e\xe8\x89\x19\x4a\xdd\x73\x13\x9f\x3e\x6d\xf9\x3d\x9e\xd7\x36\xfb\x70\x68\x77\x7e\x5b\xec\xc3\x0c\x71\x56\xe3\xe3\xf8\x1a\xdb\x29\x75\x48\x43\xa0\xdb\x0d\x15\x22\xaf\xcb\x0a\x71\xc0\x13\x5f\x4a\xe5\x3f\x29\xe5\x69\x8b\xde\x5a\xd6\x34\x6c\x54\x87\xe9\x95\x8e\xa4\x04\x9e\xf6\x27\xd5\x0b\xff\xa6\x67\xbd\xe5\x82\x4e\xde\x61\x33\xb8\xa9\xf1\x38\x0d\x5d\x49\x11\xea\xdf\x45\x56\x71\xc5\xd8\xe2\x33\x21\x81\x28\x96\x4a\x1f\xc7\x4d\x9f\xdf\xa2\x7f\x08\x36\x79\x49\xf3\x5e\xd0\x4b\x0f\xcc\x8c\xe2\x5b\xfc\x85\xeb\xc1\xc3\x16\x64\x95\x1e\xf3\x1a\x68\x74\xc0\x4f\x64\x8a\x5d\xc0\x52\x48\x4d\x50\x8f\xaa\x7b\xfa\x95\x5e\x1c\x48\xdf\x80\x26\xcd\x63\x29\x4f\x72\xc3\x63\x21\xa6\x94\x17\xe8\x23\xc8\x1e\x82\x35\x37\x5f\x67\x8f\xfd\x06\xfc\x62\xbd\xdc\x07\x52\xe9\x9c\x14\xf5\xf1\x31\xd0\x66\x8b\xef\x00\xc2\x35\x3c\xf7\x4f\x0d\x3e\xcf\x84\x6a\x6a\x6e\xff\x5e\x9f\x9d\xea\x76\x5b\x98\xf9\xe9\xe2\xd3\xc5\xff\x06\x00\x00\xff\xff\xaf\x13\xa1\x05\xb1\x5c\x00\x00"
+var _nftstorefrontCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3c\x5d\x73\x1b\xb9\x91\xef\xfc\x15\x2d\x3d\x24\xa4\x8f\x1e\xa7\xae\x52\x79\x60\x59\x76\xb4\x52\x7c\xa5\xca\xad\xe3\xf2\x69\xb3\x0f\xbb\xfb\x00\xce\xf4\x90\x28\x0d\x01\x06\xc0\x88\xcb\x38\xfa\xef\x57\x8d\xaf\x01\xe6\x83\xa2\xbd\xde\xca\xdd\xe5\x4e\xba\xac\x3c\x03\x74\x37\x1a\xfd\x8d\x1e\xf0\xdd\x5e\x2a\x03\x97\xef\x5a\xb1\xe1\xeb\x06\xef\xe5\x03\x8a\xcb\x59\x78\xfc\x5e\x8a\x89\x37\xdf\xb4\x4a\xa0\xba\x9c\xcd\x5e\xbd\x7a\x05\xef\xbf\x59\xc1\xfd\x96\x6b\x28\xa5\x30\x8a\x95\x06\xb8\x06\x21\xa1\x91\x62\x83\x0a\x74\xbb\x27\x70\x58\x15\xf0\xfe\xdd\x3d\xfc\x97\x91\x0a\x6b\x25\x85\x81\xbf\xfe\x3b\x8d\x54\x58\xca\xdd\x0e\x45\x85\x15\x81\xa3\xff\xa7\x81\xdd\xb8\x02\xe2\xf3\x6b\xd8\xa0\x40\xc5\x1a\xd8\xb7\x6a\x2f\x35\x82\x66\x0d\x06\x14\x1d\x01\xb5\x54\xf0\xae\x91\x07\xe8\x2f\x41\x17\x16\x8e\xfd\x9f\x3f\xb1\x72\x0b\xac\x2c\x65\x2b\x0c\x98\x2d\x33\x70\x60\xc2\x68\x30\x12\x1a\xae\x0d\x11\xa1\x2d\x24\x8b\x83\x0b\x6d\x58\xd3\x68\x60\xc9\x12\x96\x16\x10\x13\x95\x9d\xa1\x81\x8b\x8a\x3f\xf2\xaa\x65\x8d\x25\x4c\xc3\x81\x9b\x2d\x17\x0e\x7a\x37\x0d\x98\x86\xff\xe4\xda\x70\xb1\xf1\x04\xdd\x6f\x51\x21\x71\x43\x0a\x4c\x07\xee\x51\x05\x12\x97\xc0\x0d\x6c\x99\xa8\x1a\xd4\x1e\xba\xac\x81\x35\x0d\x11\x0a\xe6\xb8\x47\x6d\x41\x11\xc5\x16\x9f\x9f\x57\x44\xee\xd9\x05\x7b\xbc\x50\x32\x01\x5b\xf6\x88\x16\xa3\x54\xb0\x93\x0a\xe1\xb2\x6c\xcd\xa5\x06\x59\x83\xd9\x7a\xde\xee\x15\x2f\xd1\x2e\x80\xe0\xc0\x46\xa2\xe5\x50\x3a\x8b\x55\x95\x42\xad\x51\x17\x70\xd3\x1a\x6d\x41\xaf\x11\x5a\x8d\x15\x0d\xdd\xb3\xa3\x65\x0f\x61\xad\xd1\x53\x29\x15\x48\xb3\x45\x45\x7b\xa6\x79\x85\x8a\x19\x2e\xc3\xf6\x58\x42\x69\x55\x3b\x76\x84\x35\xda\xd9\x58\x01\x17\x19\xb1\x81\x83\x4b\x4b\xec\x23\x6b\x78\xc5\xcd\x91\x88\x47\x56\x6e\x2d\x96\x74\xb1\xc8\x34\x6f\x2c\xb8\x72\x8b\xe5\x03\x56\x89\x28\x7c\x68\x55\xb9\x65\x1a\x95\x23\xfe\xc0\x4c\xb9\xb5\x5b\x1f\x00\xe0\x23\x92\x68\xd0\x4e\xdb\xd9\x16\x65\xe0\x3b\x30\x61\x45\x17\xee\x6e\x69\xbd\x1a\x11\xb8\xe5\xe0\x11\x0e\x5c\x6f\xe9\xd9\xba\x3d\xd2\x83\xb8\x12\x83\x3b\x87\xfe\x5b\xa6\x1e\xd0\xec\x1b\x56\xa2\x03\xef\xb8\xc2\x36\x1b\x85\x1b\x66\xe4\x33\x14\x65\xf2\x07\xdc\xe0\xce\xee\x1e\x17\x06\x15\x6a\x63\x71\xcc\x58\x59\xa2\xd6\x73\xd6\x34\x8b\x4e\x45\x32\x15\x83\x4f\xb3\x19\x00\x40\x3a\x12\x85\xe1\xa6\xc1\x1d\x0a\x03\x37\x0a\x99\x41\x8f\xfa\xe4\xc8\x8f\xb8\x93\x8f\x71\xa4\x1d\x4a\x24\x76\xa8\xee\x04\x37\x9c\x35\xfc\xef\x58\xc5\xb7\xd7\xa9\xc8\x2b\xd4\xb2\x55\x25\xc2\x96\x69\x58\x23\x0a\x28\x2d\xf6\xaa\x88\xe3\xff\x44\x6b\xa7\xa5\xe8\x76\x17\xf6\x4c\xc8\x03\xe0\xcf\x7b\x2c\x4d\xd8\xac\x5a\xc9\x1d\x18\x32\x4c\x1d\xf4\x0e\xc6\x7b\x69\xd0\x6b\x3d\x42\x25\x41\x48\x03\x7a\x8f\x25\xaf\x8f\xc0\x44\x90\xe9\x15\xbd\x2d\x99\xa0\xb7\xb4\x3b\x7a\x2b\xdb\xa6\xa2\xc1\x1d\x24\xc7\x9c\x2a\x12\xae\x03\x38\xab\x5e\x4c\x80\x3c\x08\x52\x63\x07\x71\x69\xb7\xcb\x0b\x36\xf1\xaa\x63\x03\xab\x0d\x29\x04\x81\xe3\x52\x90\xb8\x1f\xd8\x51\x27\x14\x58\xd1\xeb\x10\x7b\x2e\x5f\x3f\x32\xde\xb0\x75\x83\x61\xe1\x3d\xfd\xab\xd0\xa0\xda\x71\x41\xcb\x8d\xca\x1a\x81\x78\x5d\x77\x34\xfa\x7f\x24\xbb\x31\x2f\x8a\x82\x1b\x0d\x8d\x2c\x2d\x55\x0b\x60\xc6\x8e\x31\x7c\x87\x20\xeb\x08\x27\x48\x37\x69\xcb\xba\x35\x20\x45\x73\x74\x63\x99\x81\xbd\xc2\x92\x6b\x84\x9d\xb4\x42\xc2\x45\xfe\xd8\x28\x26\x34\x2b\x09\x7e\xb7\xba\x3b\x6f\x84\xb0\x69\x90\x34\xfe\x11\x75\x9f\xb8\xc3\x96\x37\x18\xf5\x8a\x30\x73\xed\x0c\xc1\x12\x12\xc2\x98\xf5\x48\x92\x90\xe2\x2e\x22\x18\x8a\x31\x71\x6f\x5c\x52\xe7\x3a\x3e\xfd\xe8\x77\xf9\xee\x76\x05\xdf\xdd\x09\xf3\x87\xdf\x2f\x66\x11\x57\x37\xf9\x16\xb5\x51\xf2\x38\x25\xe4\x51\xb6\xab\x30\xee\x79\xe9\xd6\x46\xee\x61\xaf\x24\x29\x73\x62\x93\xbe\x86\x98\x9f\xcf\x94\xb8\xae\x73\x59\xd2\x17\xd2\xf8\xe2\x3a\x6e\x5a\x5f\xd1\xad\x86\xb0\xaa\x72\xee\x83\x8d\x19\x87\x6e\x81\xf7\x5b\x84\x6b\xb7\x0a\xda\xfa\x16\x35\x58\x5f\xca\x94\xf7\x09\x70\xd8\xa2\xdd\x7a\xbf\x16\xae\x01\x77\xdc\x18\xac\x96\xb0\x6e\x4d\x04\x44\x23\xb4\x61\x06\x83\x16\x78\xff\x69\xa5\xee\x08\x0a\x6b\x54\x44\x8f\x57\xdd\x72\xcb\xc4\x06\x2b\x90\xad\x21\xff\xe5\x27\x45\x68\xb9\x79\x3d\x48\xf5\x50\x37\xf2\xb0\x04\x2d\xad\x07\x62\x0a\xeb\xb6\x01\x23\xbd\x3b\xb1\x34\xb6\x76\x5b\xcf\x11\xd2\x3e\x53\xe7\x16\x2f\xfd\x76\xbb\xe2\x99\xb2\x0a\xdc\x59\xc6\x31\x9e\xef\xc3\x6d\xeb\x86\x88\xda\xdc\x1f\xf7\xb8\x02\xfa\xdf\xec\xf1\xd8\xe8\xda\xfc\x95\xb5\xcd\xe8\x0c\x1b\x3e\xac\xe0\xbb\x77\xfc\xe7\x3f\xfc\xde\x3e\x1d\xca\xc6\x8d\xdc\xed\x1b\x34\x89\xae\xdc\x6f\x71\x28\x1d\x64\x5f\x9b\x47\x8a\x23\xef\x9c\x02\x21\xb7\xbe\xd2\xbe\xdc\x7b\x0f\x5e\x2d\x29\x3e\x50\xd6\x0b\x39\x41\x1a\x6a\xd8\x69\xa6\x46\x6a\xe6\x67\x30\x0c\x46\x18\x7f\x72\x58\xa4\x73\x05\xdf\x48\xd9\x7c\x1e\xc7\xfb\xfc\xeb\x24\x8c\xfe\x62\x1b\xfc\xc0\xcc\x36\xbe\xb5\x4c\xf4\x66\x9b\x9c\x09\x51\xc8\x36\xde\x1a\x8c\x6a\x55\x70\x6f\x6b\x3f\x31\xf0\x2c\xe5\x55\x83\x66\x1c\xef\x0a\x52\x22\x46\x68\xfc\xd0\xae\x1b\x5e\x0e\x48\xdc\xdb\xc7\x1d\xa5\x14\x76\x65\xd4\x35\x5c\x3c\x3c\x47\x47\x07\x7b\x05\x09\x9e\x84\x55\xac\xc1\x9b\x44\xdb\xaf\x41\x1b\xd5\x96\x14\x6e\xec\x15\x6a\x0a\x76\xc4\x06\x18\x90\xa3\xda\x73\x0c\x19\xc1\xae\xd5\x86\x14\x96\x06\x00\x83\x12\x95\x61\x5c\x00\xdb\xc9\x56\x74\xc0\xbc\xc1\xd8\xb3\xa3\x75\x6f\x56\x99\x19\x18\x4a\x38\xc8\xf3\x68\xd9\x9c\x10\x3e\x4f\x87\x27\x10\x3e\xc5\xad\x0f\x0c\x52\x58\x22\x7f\x44\x65\xc3\xbf\x04\x4f\x91\x8d\x1c\x35\xf4\xc4\xfb\xc4\xcc\x93\xbd\xa9\xb9\xa8\x2c\xb5\x56\x65\xdd\x0c\x1b\x24\x45\x46\x24\x96\x82\x00\x33\xed\x3d\xac\x78\x70\xaa\xe5\x65\x25\xe0\xaa\x91\x82\x65\xeb\xd2\xb9\x86\x03\x3b\x06\x0b\xb9\x63\x82\xef\xdb\x86\x2c\x7a\x0e\x51\xcb\x8c\x90\x48\x1c\x01\x64\x50\xb6\x26\x66\x19\x47\xd9\xba\x2d\xd8\xa0\xa7\xd2\x73\x8b\x14\xdb\x6c\x51\x64\x70\x4b\x4a\x86\x02\xb7\x8a\xb5\x54\x4a\x1e\xe6\x8b\x8b\xc2\xc6\x36\x45\x40\x43\x91\x95\x29\xb2\x89\x2e\x7b\x75\x51\x53\x45\x29\x06\xd6\x35\x2f\x49\x0a\x9a\x23\x69\x0e\x03\x5d\x2a\xbe\x4f\x66\xf5\x25\x31\x60\x5d\xc1\x0d\xdb\xb3\x35\x6f\xb8\x39\xbe\xfe\xcd\xa7\x2c\xf7\x2c\x3e\xfa\x41\x4f\x6f\x66\x3d\xf4\xe8\x25\xaa\x2f\x49\xd9\x7c\xbf\xbb\xbc\x69\x88\xce\x3d\xe3\xd6\x3d\x9a\x44\x42\xa6\xe9\x73\xe0\xa3\x1d\xce\xd0\xf3\x18\xe7\xa8\xf4\x79\xfc\x9b\xde\xcf\x3f\x73\x81\xcb\x1e\xc6\x45\x22\xd7\xf4\xab\xb1\xa9\x8b\x00\x13\xae\xe2\x0a\x86\x83\x3c\x63\xae\x3c\xc0\x38\xe0\xc9\xfe\xf5\x34\x1b\x78\x93\x5b\x34\x8c\x37\x7a\xa8\xe9\x94\xfa\x30\x2e\xc8\xa7\xb0\x30\xf8\xb7\x1a\x2a\x66\xd8\xb3\xda\x99\xc3\x1e\x51\xd2\xce\x14\x05\x95\x8a\x09\xaa\x35\x01\xf4\x9a\x32\xd8\x29\xa5\xf5\x2a\xe8\x75\xcb\xc7\xfb\x21\x43\xa0\x7d\x66\x50\xf1\xba\x46\x95\x87\x63\x43\xc5\x22\x38\x5c\xc3\x5f\xfe\x5c\xc0\x5d\x4d\xfa\x89\x64\x3a\x76\xec\x01\xa9\x7e\x60\x47\x50\x3c\x6c\xe0\x45\xc9\xc4\x8b\x98\x84\xe4\x80\x2c\x35\x9d\x33\x50\xf8\xc8\x35\x37\x58\x8d\x0b\xd8\x23\x53\x89\xfb\xeb\xb9\xab\x00\xf2\xfb\x2d\x5a\x6f\x6d\x41\x0f\x7c\x7b\x74\x8b\x64\x62\x62\x76\x35\x86\xa8\xe7\x40\x33\x1c\xa4\x48\xf7\xc7\x7d\x8c\xe0\xfa\xd5\x9f\xc2\x66\xec\xc4\x6e\x4e\x78\x89\x02\xa2\x64\x6a\x5d\xa4\x38\x99\x5b\x1e\x20\xbb\xbb\x8d\xa8\xde\xdd\x67\xc5\x1e\xaa\x0b\x9c\x84\x3a\xc1\xa6\xfe\x12\x32\xfa\x7d\xd6\xe4\xdc\x8c\x8e\xce\x69\xc7\x2a\xcc\x44\xab\x8f\x8e\x0a\x39\x1f\xdc\xac\x5e\xa0\x36\x65\x87\x32\xe7\x67\x4d\x8d\x35\xf0\xe8\x73\x07\x8e\x55\x4e\xd9\x33\xb8\x07\x41\x60\x87\x92\xeb\x08\x93\x5c\x00\x02\x55\xd0\x34\x05\x02\xb2\x8e\x76\x70\x8d\xe6\x40\x51\x5e\xf4\xce\xfa\x34\x42\x2a\x43\xad\xe0\x07\xef\x53\x7f\xca\xcd\xad\x46\x73\x2f\x43\xc5\xa7\xca\x5e\xdd\x29\x45\xea\xa2\xf9\xba\x39\x82\x46\x93\x4b\x2b\xd3\x9d\xf4\x15\xa3\xa6\xd2\xd3\x12\x8a\x2c\x0b\xa8\x5b\xd1\xc3\x37\x1f\x35\x85\x11\x2e\x5c\x81\x51\x2d\xc6\x11\x4f\x9f\x6f\xab\xa1\x0b\x5c\x4f\x04\x96\x83\xe0\x32\x7f\x35\x2d\x32\xc3\x71\x3d\x6e\xf7\x06\x9c\x32\x0c\xa3\xbc\x48\x4d\x09\x5c\x65\x00\x4e\x33\xae\x66\x8d\xc6\xe1\x10\xcf\x00\xb8\x0a\xac\x18\x1d\x62\x71\xd9\xff\x0e\x5f\x8f\x31\x03\xae\x46\x79\x94\x4d\x0e\xe1\xaf\x95\xeb\xb2\x35\x3a\x7b\xcb\xb4\x46\x65\xe6\x81\x83\x45\x83\x62\x63\xb6\xf0\x06\x7e\xb7\x84\x1d\x6a\xcd\x36\xb8\x82\xcb\xe0\x44\xac\xb2\xbb\x8a\x92\x81\x06\x99\xa6\x12\x4b\x17\x29\x50\xd4\x14\xb5\xe3\x72\x31\xbe\x06\x42\xe3\xe9\xa6\x3f\x67\x7d\x62\x6f\x58\x53\xda\x80\xcd\x12\x6c\xa4\xa1\x42\x3b\xe9\x6e\xa8\xa5\x8d\xac\x82\x6c\x72\xd4\x71\xb8\x82\xdf\x15\xbf\xeb\x83\xfd\x80\xaa\x96\x6a\x17\x84\xd7\xa7\xbc\x52\x40\x19\x42\x09\x8e\xbe\x2e\x56\x46\x0a\x92\xe2\xb3\x45\x4e\x0b\x74\x21\x40\xa2\xfb\xf4\x4b\x51\x31\xbd\xe4\xa2\xb7\xd0\x5c\xb4\x3c\x2d\xdf\x92\x23\xd4\xad\x42\x5f\x56\x03\x17\x26\x4e\x84\x51\xe1\xe7\xd5\x2b\xf8\x1e\x5d\xe8\x15\xca\xbf\x5c\x03\xdb\x50\x2e\x10\xcb\x0b\x23\xf1\x7e\xfa\x53\xb6\xa6\x18\x04\xa7\x83\x51\xf4\xfb\xf6\x2d\xec\x99\xe0\xe5\xfc\xf2\xc6\xc7\x01\x8e\xc6\x30\xfb\x72\x31\x46\xe0\x75\x55\x85\x1d\x8a\x56\x5c\xf6\x37\x72\x30\x31\xdd\xba\xee\xef\x7f\xa3\x7d\xf6\xa1\x57\x36\xe5\x69\x4a\x82\xdd\xbc\x37\xb4\xfd\xa9\xf4\xde\x27\x61\x50\x27\xc1\x42\x8a\x97\x7f\x47\x25\xdd\xfe\x5e\x2e\x66\x19\xd4\x5c\x6b\x82\x40\x54\x89\x44\x8c\x4b\xf7\x60\x19\xcf\xc7\x8a\x2e\x55\x8c\x4f\xaf\x85\xab\x9c\xd7\xac\x24\x4c\xf2\x91\x57\x44\x38\xa3\x52\x2a\x95\x6b\x7c\xaa\xda\x8d\xb1\x71\x99\x87\x35\x1d\x43\xc6\xb0\xae\x9b\x98\xe1\x4f\x8c\x20\xd9\x78\x27\x1b\xef\xdf\xdd\x67\x4f\xad\xa7\xb4\x12\xe8\xb8\x1e\x1d\x32\xdb\xa1\xcd\xba\x7c\x9e\xf6\xfe\xdd\x3d\x68\xc3\x44\xc5\x54\xd5\x81\x4a\x24\x8d\x50\xf0\x2e\x68\x21\x39\x5e\x53\x7a\xbb\xb4\xf9\x25\xfe\xcc\xa8\xfc\x41\x07\x18\x3c\x29\x53\x52\x0a\x0b\x8f\x9c\x01\x13\xee\x80\xc2\xfb\xc4\x62\xd4\x0b\xa5\x8b\x27\x0f\x98\x90\xb1\x82\xdf\x7c\x1a\x0b\xcb\x9e\xde\x76\x42\x40\x14\x06\xbb\x9e\x3d\x0c\x4e\x34\x2d\xf9\x52\x11\xef\xe8\xeb\x66\x2e\xe5\xce\x68\x72\x27\x93\x7b\xaa\xa3\xd3\x80\x35\x0a\xa4\xd4\x8e\x29\xee\xcf\x5b\x14\x9a\x56\x09\xdd\x4d\x0f\x5a\xb3\x6e\x8f\xa8\xce\x5b\x5f\x20\x76\xee\xad\xf1\x0a\xfe\xd8\xcb\x8c\xac\x7f\x78\x5a\xd0\x8b\xd1\xd5\xe7\x8b\xdf\xa0\x49\xb3\x98\x53\xe8\x1f\x39\x1e\x6c\x94\xd1\xcd\x99\x2f\x56\x41\xbe\x02\x94\x29\xf9\x8f\xff\xbe\xee\x84\xd4\x86\x7e\xac\x69\xe4\x81\x18\x64\x65\x84\x0e\xb0\xd0\x9a\x35\x2b\x23\x2c\x14\x40\x28\x48\x65\xb0\xe1\x8f\x28\xf2\x70\x70\x19\x01\x53\xa2\x1e\xeb\x16\x54\xbb\xc6\xca\x9f\x2f\x32\x17\x1b\x06\xe0\xfb\x86\x77\x71\x9e\xa6\x28\x8c\x35\x83\x78\x8f\x20\x4e\xab\x97\x5f\x54\x5c\xbd\xd3\xee\x25\xb8\xd3\xea\x82\xfe\x43\xc5\xd2\x4c\xdd\x7c\xb5\xdd\x11\xe1\xeb\xc2\xc1\xa4\x27\xa1\x1f\xd7\xfd\x3a\xa2\x9f\x7e\x57\xa7\xc2\x68\x15\xc6\x5a\xec\x5e\x4d\xf2\x80\x40\xe7\x79\x8a\x8e\xfe\xe8\xf4\xb7\x0c\x45\x46\x5b\xac\xce\x20\xc6\xa3\x60\x06\x1a\xf7\x4c\x31\x13\x2b\xd7\x1d\x48\xbb\x0f\x11\x7e\x40\xaf\x47\x45\xc4\x4d\x0e\x55\xc9\xae\x80\x1f\x07\x9f\x2c\x71\x52\xbc\x40\x16\xb6\x6d\x79\x35\x15\xd9\x4d\xcf\xaa\x9c\x00\x16\x69\x10\x97\x43\x89\x8b\x70\x05\xd1\xfe\xc4\xf8\x3a\x9f\xe5\x23\x39\xaa\x39\x2a\x52\xfe\xde\x2c\xff\xba\xe0\x15\x15\xf6\x6a\x8e\x6a\x30\x7d\x9a\xd6\x3c\x08\x5c\xcc\xfa\x4c\xcd\xa3\xfb\x75\xab\xc4\x0d\x6b\x9a\x35\x2b\x1f\x06\xb1\xfd\xd7\x17\x10\x0f\xf5\x2f\x66\x8b\xea\x40\x67\x67\xd3\x13\x79\x87\x60\xbe\x18\x40\xb8\xf7\x85\x82\x35\x96\xac\x75\x70\xbc\x84\x67\xf4\x72\x91\x54\x1b\x0a\x95\x9e\xee\xf6\xa2\x17\x3a\x4b\x54\xe9\xe0\xb2\x41\x26\xda\xfd\x7c\x01\x03\xe4\xae\x32\xe1\x8e\x50\x5c\x8e\x65\x71\xb7\xa5\x91\x6a\x19\xca\x0d\x60\xb6\x92\x24\xbd\x15\xa5\xef\x0d\x08\x10\xe8\x87\xd7\x70\x91\x6d\x5b\x64\x66\x6f\x0f\xe8\x97\x14\xfb\x44\x7d\x3f\xfd\x19\x51\x84\x09\xf9\x3f\xad\x07\x67\x8a\x7f\xf8\x89\xd4\xaf\x60\x7c\x55\xe3\xd3\xa2\x1e\x8c\x89\xff\xe4\x94\x01\x7d\xc3\xbc\x87\x7e\x16\x13\xa1\x5f\x2f\x27\xa5\x18\x4f\x73\x62\x2a\xcc\x29\xb2\xeb\x0a\x84\x4b\xa0\x7f\x3b\x89\xfc\x79\x01\x1e\x5b\x28\x6d\x90\xf5\xef\xeb\x16\x51\xe5\xea\x06\x7e\xf0\xb8\x2b\x0b\xa8\xaf\xbb\x14\xe2\x48\xad\x30\xf2\x40\x3a\x96\xd7\xd2\x8c\xb4\x85\x99\x4a\xb1\x43\x0c\x7a\xe8\x81\xfd\x87\xf3\x5d\x77\xb7\x2e\xc1\xa1\x83\xee\x52\x36\x0d\x26\x47\xd1\xdd\x2a\xb9\x1e\x60\xd3\x3e\x6f\x18\x41\xf5\x82\x89\xe3\x0b\x42\x66\xcf\xfc\xa8\x90\xdd\xd5\xd3\xc2\xf1\x9f\xf5\x33\x1b\xfe\x18\x3c\x71\xc0\xa5\x5b\xea\x4c\x4a\xb1\xd9\x40\x33\xe2\x21\xa7\xca\x1a\xdb\x1c\xe0\xd2\x11\x47\x78\x65\x17\x6b\x4b\x7d\x36\xc3\xe1\xbe\x5a\x4c\xea\xcd\x43\xc0\x98\x21\xa2\xb8\x31\xd4\x03\xcb\x86\xf1\x9d\x2e\xfa\x3b\xd2\x59\x3b\x5f\xb8\xfa\x60\xe3\x62\x54\xdd\x36\x67\x35\x61\xd6\x9a\xed\x7c\x10\xe3\x7c\xef\xd9\xb2\x18\x0b\xff\x02\xc4\xe5\xa0\x5d\xab\xb8\x89\xbb\xe1\x9c\x79\xbf\x74\x1e\x23\xcb\x7f\xad\x58\xb9\x67\xe2\x68\x67\x14\xd6\xc1\x91\x8d\x6e\x52\x72\x1e\xd2\xa1\x20\x75\x2b\xd2\xc8\x91\x4c\xc7\xdd\xed\x62\x2c\xcb\x23\x04\x17\x57\x20\x78\x93\x26\x78\xd9\x39\x77\xe1\x75\xd5\xe3\x7a\xff\xee\x7e\x05\x37\xa1\x61\xc6\xaf\xcc\xca\xb1\xad\x63\x3b\x85\x89\xbc\x15\xa9\xdf\xb9\xb8\x1c\xa5\x21\x7b\x46\xbf\x0a\xeb\x8b\x82\xeb\x3b\x6a\xce\x13\x25\x8e\x2f\x88\x6c\xe1\x62\x68\x0c\x3f\x63\x11\x64\xe2\x4c\x5a\x58\x7e\x77\xef\xf3\x43\xac\x60\x7d\x4c\x3a\x68\x5e\xff\x38\xb7\x34\x6d\xd0\x1a\xe6\xf9\x22\x89\x41\x16\x6f\xa0\xa2\x83\x2d\xf2\xff\x3b\xdb\xd0\x65\x02\xdc\x7c\xf5\xf0\xfa\xc7\xe9\x95\x64\x00\x2f\x2e\xb3\x65\x9d\xcd\xb4\xb7\x05\xaf\xe0\xea\x0a\x46\xb1\xdc\xdd\xfe\x62\x66\xdd\xdd\x82\x67\x04\xaf\x16\x30\x7f\x96\x6d\x23\x7c\xb1\x20\x26\xe8\x5b\x0c\xc4\xe5\x04\x1b\x5c\x86\x07\x44\x0e\x05\x48\x53\x3a\xb5\x98\xf2\x6f\x1d\xfa\xec\xf1\x7f\xd8\xf2\x30\xf6\xbd\x5a\xd9\x2a\x7b\x46\x93\xb5\x92\x78\x2e\x11\x7a\xe6\x8f\x94\x32\x6b\xe0\x2c\x16\x7b\x94\xbc\xd2\x54\x22\xa1\xb1\xb6\xc7\xd1\x57\x1c\x1e\x99\xe2\x94\xba\x50\x46\x56\xc1\x06\x0d\x35\x8a\xed\xd0\x6c\x65\xa5\x43\x7a\xb5\x73\x15\xb4\x7d\xc3\x8e\x39\xa1\x82\x97\xd8\x50\x2b\xa2\xd9\xfa\x73\x4c\x0d\xf3\xc3\x96\x97\xdb\xd0\x57\xe6\x39\x14\xfc\x8b\x5e\x14\xf0\xac\xad\x3a\x2b\xf1\x84\x4f\x63\x3b\x91\x86\x1e\xb3\x61\x78\xf1\xaf\x5c\x07\xe8\x31\x6c\xaf\xd2\x6c\x35\xfc\x5f\x16\xba\x05\x9c\x56\x9d\x6d\x05\x7c\x35\x98\x40\xbf\x13\x7a\x1b\xa6\x93\x0f\xb7\xb5\xc6\xfd\x08\x6f\x9d\xec\x9c\x54\xc8\x62\xd0\xb3\xc3\x1a\x85\xac\x3a\xf6\x32\x9d\x9e\xa6\xd2\xaf\x67\xd6\xc0\x8c\x87\x15\x8e\x95\xd9\x17\xff\xc4\x45\xd6\x7e\xd7\xbc\x98\xd8\x36\x67\xa6\x63\x25\xfe\xf5\x8f\x61\xfb\xc7\x9d\x00\x45\x11\x44\x03\x09\x9a\xc2\xbf\xb5\x48\x07\x90\xb6\x7f\x3b\x9a\xfd\x53\x2b\x4f\x41\x15\xd3\xcc\x5c\xb3\x86\x89\x12\xa3\x8d\xcf\x40\x52\xa1\xf4\x9f\xc8\x40\x4f\x23\x3c\xd2\x9a\x3a\x97\xe8\xcf\xe7\x7b\x8c\xb1\x05\x5f\x32\xae\x63\xbc\xa1\x77\x7d\x2e\x3c\xcd\xfa\xd9\x66\x57\xf3\x4f\xa9\xed\xce\xd8\xc3\x62\x2a\x57\xd1\x2f\x66\x93\xca\xd6\x3f\xca\x1b\xa0\x7a\x87\xd1\xad\x07\x1b\xe2\x8d\x9e\xb7\x26\x01\x97\x2a\x06\x11\x9c\xa8\x0d\xbc\x7e\x79\x56\x04\x17\xb2\x8c\x79\xf8\x63\x3c\xa3\xcb\xfd\x20\x75\x25\xfa\x96\xb9\x70\x92\x40\x8c\x57\xc1\x25\x2b\x6d\x9b\x26\x8d\x6a\xb5\x39\x48\x65\xb6\x47\xdb\x59\x7f\x74\xe7\xc0\x36\xc1\x23\xa9\xa6\x67\x50\x4a\xa5\xb0\x34\x7d\xf8\x5d\x81\x9b\x5a\x80\xd7\x78\x94\x74\xa6\x43\xf9\xdf\x31\x0a\x08\xe5\x2a\x7b\x85\xaf\xf6\x52\xdb\x3d\xaf\x38\x25\x5b\xbe\xcb\x92\x08\x20\x59\xd8\xb0\x56\x31\x61\x10\xab\x3e\x0a\x23\x7b\xa4\x84\xfa\x00\x23\x1e\xc1\x1a\xb7\xa1\xaf\xa8\x23\x86\x5a\x78\xc4\xd1\x17\x2a\x0f\xec\x98\xf3\x3e\xd4\xdc\x6a\x19\x4f\x85\x88\x04\xcb\x07\x8b\xa1\x4b\x42\xba\x54\x6f\x0c\x4f\x1e\x35\xf9\xe2\xe7\x01\x1d\xff\xc2\xb9\x91\x0b\x1e\x23\x1c\x6e\x2c\x59\x1a\x5a\x9d\x27\x6f\x21\x2d\xe3\x3a\x65\x38\x9d\xf9\x15\x67\x05\x79\xa2\x9e\xb6\xa8\x5f\x1e\x13\x4f\x1b\x02\xaf\x56\x17\x67\xc5\xca\xbe\xa9\xfb\xf5\x8f\x73\x51\x4f\x98\xc9\xb3\x63\x65\xbf\xa8\x81\xf1\xfc\x6a\xe1\x32\x91\x98\x44\xcb\x09\xfc\xbb\xdb\x5f\x8d\x83\xd6\x8c\x3a\xcc\x8b\x33\x38\x39\xc2\xab\xce\x10\x67\x14\xf7\x43\xe7\x10\xbf\x0e\x58\x93\xfd\xf3\xd5\x2b\xf8\xc8\x7c\xff\x0e\x95\xff\xd7\x52\x99\x18\x6c\x75\xdd\xfb\x74\x6c\xc4\xc4\x11\x62\x67\x57\xcc\x98\x5d\x4d\xfd\x40\xe6\xe5\x18\x3e\x0a\xe2\x66\xd9\x47\x72\xa0\x15\x79\xbd\xa2\x83\x4c\x6f\x35\x6b\xae\xb4\xf1\xed\xdc\x01\xf6\x98\x12\xfb\x81\x11\xbd\x2f\xb9\x98\xa8\xde\xde\xfe\xad\x31\x61\x9e\xed\x18\xa6\x45\x6d\x14\x62\xd5\x9d\x39\x50\x60\xdd\xc7\x41\x8b\x6b\x85\x6d\x88\xa1\x93\xf0\x9c\x06\x3a\x0a\x57\xa8\xed\xf7\x5f\xa1\x15\x8e\x72\xf8\x89\x26\xb9\xb7\xd4\x70\xc0\x9b\x81\x0f\xf9\xc0\x8e\xf6\x0b\xa6\x24\x48\xb5\x7b\xcd\xd5\x78\x97\xe0\xe9\xe3\xf0\xb0\xf9\x27\x8e\xc5\x79\x9d\xf5\x30\xc2\xd5\xf8\x59\xf5\xc8\x4c\xef\xb9\x3c\x21\x37\x2d\x39\xb0\x48\x56\x74\x52\xa1\x15\xb0\x3b\x53\x5e\x8c\x41\x8a\x04\x14\x15\xee\xa5\xe6\x66\x4e\x45\xb9\x15\xbc\x7e\xd9\xc1\x1f\x9f\xc8\x6b\x98\xf7\x19\x4f\xfa\x2a\x78\x33\x41\xb5\xc7\xd7\x9f\x12\x49\x18\x9d\xf3\x34\xeb\x3d\x80\xa7\x53\x91\xc7\x94\x3d\x19\xa0\xf5\x55\x95\x5f\x60\x4a\xde\x4b\xaf\x1c\x9e\x53\x71\x1d\xfa\x39\xad\xbe\xf6\x3d\x4e\x7b\xc9\xa9\xac\x45\xfa\xeb\x9a\x68\x39\x1d\xc1\xd1\xc7\x47\xd4\x41\x5c\x1a\xfe\xe8\xcb\x8e\xee\x93\x03\xde\xa0\x0d\x0e\x44\xd6\xae\x6a\xb3\x1b\x57\x73\xa4\x4e\x95\x3e\x2e\x7b\xe4\x6f\x83\x66\x0d\x0d\xd6\xc6\x25\xac\x26\x16\xeb\x3a\x4f\xee\xbe\xd3\x63\x20\xe4\x4b\xb9\x27\x93\x13\xbf\x87\x71\xde\x10\x77\x7b\x73\x74\xf1\xe3\xec\x14\x6b\x2f\xa6\x44\x69\x31\x7b\xe6\x9c\x86\xeb\x5f\xff\x74\x86\x56\xd2\x9d\x7b\x14\x01\xc0\x6c\xf6\x05\x47\x17\x9f\x77\x6c\xf1\x8b\x8f\x2c\x22\x6f\x7a\xb3\x3a\x9e\x0d\xa6\x78\x7f\xbc\x82\x31\x2f\x3d\x3a\xfc\x8c\x63\x8a\xc5\x6c\xac\xc0\xf0\xfa\xa5\xa8\xcd\x6c\x44\x2d\xbf\xbc\x85\xee\xeb\x57\xbd\xbb\xa8\xf2\xe9\xcd\x72\x36\xca\xa9\x21\x67\xfe\xc7\x75\xec\x65\xcd\x3a\xfe\x43\x69\x6a\xf6\xb2\xdf\x72\x64\x23\xd3\x9d\x84\xab\x5e\xa1\x68\x28\xd0\x91\x09\xfe\x8f\xe5\xd8\x08\x22\x70\x22\x0e\x1b\x67\xc7\xd8\xd3\xf1\xb9\xe4\x2b\x57\xf1\xaf\x91\x31\x19\x97\x52\x9e\x9d\x12\xd0\x8c\x5d\x49\x3c\x97\x07\x1a\xd3\x39\x20\x5c\x8d\x0b\xe3\x00\xcb\x8d\x4f\x37\x7c\x2b\x7a\x40\x13\x92\xec\x78\xfe\x51\xcc\x4e\xf6\xb9\x71\xf3\x19\x5d\x6e\x6e\xb6\x4f\x9f\x76\xf2\xd1\x1f\xed\x72\x61\x4f\xaf\x82\x75\x8f\x27\xce\x89\x22\xea\x30\xcb\x7e\xc2\xb1\xc3\xdd\x1a\x55\x9c\xd0\x8b\xb2\x28\x54\x89\xcb\xb9\x3a\xc1\xab\x18\xb8\x9c\xe5\x95\x23\xc8\x2f\xf5\xc6\xb4\x9c\x18\xd4\x77\x6b\x0b\x82\x7e\x01\xdf\x09\x2a\xe1\x52\xf0\xeb\x4f\x42\xde\xbf\xbb\x87\x40\x76\x62\x4c\x4e\x47\xe2\xa1\x52\x70\x15\x99\x30\x38\xd0\xc9\xcc\x65\x1e\x31\x85\x42\xa8\xdd\x62\xfa\xfc\x36\xe4\xdf\x9e\x2f\xe1\xb4\x2b\xee\x32\x65\xc2\xde\xe7\x37\xe7\x67\x9e\xe1\xa0\xe8\xeb\x33\xf1\xdc\xe3\xa4\x70\x6f\xc3\x80\x99\x67\xae\x60\x78\xaa\x94\x70\xf5\x0b\x93\xe7\x67\x17\xd7\x4f\x9d\xdd\x2e\xc4\x2b\x28\x4e\xa7\xcb\x7e\xb3\x64\x9d\xf4\xe8\x9f\x3a\x5b\x3a\x95\x27\x7f\x21\xd3\xb2\x53\xa5\x04\xfe\xdd\xed\xaf\xc4\xac\xee\xfb\x0b\xc7\x2a\xcb\xa0\x0b\x9b\x33\xc7\x8c\x98\x22\xd1\x90\x10\x77\x9c\xe9\x31\x65\x34\x53\x9e\xe2\x42\x6c\x27\x0d\x21\x45\x47\xfe\xb7\x4c\xb0\x0d\xaa\xf1\x8e\x52\xda\x48\x56\xb9\x96\x52\xdb\x79\xb8\x93\x74\xf4\x1f\x96\x14\x6f\x0b\x49\xbf\x7e\xec\x3a\xe9\x08\x0e\x5d\x92\x42\x69\x29\x35\xa4\x86\x0a\x40\x37\xf6\xb7\x9a\x4e\xd3\xc2\x84\xe9\x3e\xb9\x8e\xa2\x01\xe1\x89\x77\x27\x9c\xe5\xe0\xde\x87\xf0\xe6\xba\x6b\x7f\xe8\x80\xf8\xc3\x3c\xfa\xaa\xd9\x4e\xb4\xcb\xe4\x82\xe4\x25\x2e\xb2\x18\x0d\xba\x3c\x9d\xd9\x3d\x13\xee\x4c\x25\x23\x21\x97\xba\xff\xeb\x61\x59\x7c\xbf\x18\x84\x61\xb4\x05\x59\x33\xd6\xe7\x6c\x8e\x9b\x68\x4b\xa2\xd6\xae\x78\x25\x58\x02\x2b\x71\x6f\x46\xbe\xc1\x1a\xd9\xa9\xec\x9e\x0f\xb7\x53\x79\x73\xd8\x48\x42\x12\xee\x06\x98\xd4\x9e\x53\xed\xd8\x14\x43\xd0\xb2\xa2\xca\x92\x6c\x39\x1f\x90\xea\x90\x3f\x04\x75\x69\x08\xbd\x70\x77\xa2\x3c\xf2\xd8\xa7\xdd\x7d\x19\xd8\x53\xb5\xcf\x6a\xe0\xee\x13\x0d\x9f\xfa\x1c\x1a\x9c\x92\x7a\xfc\x77\xb7\xf6\xa0\xf4\x07\xc7\x8d\x9f\x4e\x4f\x73\x0b\x7c\x9e\xa7\x54\x66\xf2\xa3\x7c\xa3\x4c\xbf\x47\xc4\x96\xdb\x3f\x8d\x15\x23\x28\x67\x77\xc5\x12\xf8\xc7\x3f\xfc\x83\xa4\x81\x81\x0c\x3a\x49\xfc\xeb\x3f\x7a\x04\x6f\xe6\x53\xa7\x6f\xde\x5a\x47\xcf\x4c\xdd\x5f\xa3\xd6\xbd\xe7\x95\x9f\x66\xc3\xbf\x52\x76\x10\x03\x43\x37\xe1\x34\x0f\x66\x53\x62\xf5\x5c\x97\x33\x9d\x48\x44\xe5\xd8\x59\x0b\x0e\xcc\xca\x19\x79\x97\x5c\xb2\x98\x38\xd2\x67\x37\x74\x0a\x21\x0c\x52\x63\x54\x6c\x26\xdb\x45\x3c\x74\x51\x91\xaa\x9c\xb2\xfd\xad\xc5\x58\xbe\xf3\xee\x25\x15\xd1\x98\x09\xf8\xef\x9f\xfd\x5d\x13\xfe\x53\xec\x33\x44\xb2\x5b\xe6\x6a\x68\xce\x97\xa7\xe4\xf4\xd9\xe6\xe7\x2e\xa9\x99\xe8\x7f\xfe\xec\x2e\xe3\x0e\xe2\x70\xff\x42\xf7\x12\x55\x2c\x66\x23\xc1\x6f\x68\x35\xac\xb8\xb5\xce\x4c\x1d\x93\xdd\x01\x9a\x65\x4f\x50\xc2\x83\xc0\x20\x3d\x20\x97\x3c\xbd\xfb\x96\xd4\xcb\x92\xa6\x73\x7a\x47\x44\x6c\x60\x78\xca\x11\x3b\x27\x96\x3d\xba\xe9\x5c\x9c\xed\xd2\xd0\xdb\xee\x5b\x90\xd0\x26\x9f\x25\x58\x61\x1b\xff\xdf\xe1\x3d\xeb\xf0\x3a\x8f\xd7\xb3\x62\x94\xfd\xf8\x5d\xa3\x52\xb3\x0f\x33\x46\x99\x75\x82\x61\xa3\x8f\x97\x63\xb3\xff\x37\x95\x20\x06\xca\x33\x91\x3d\x7a\xfe\x75\x1a\x08\x57\x81\xa7\xc3\xf9\xc9\x84\xf0\x7d\x55\x18\x9b\x9d\xef\xc7\xe3\xf8\xd9\x6c\xe2\xbb\x34\x81\x9d\xef\xf6\x89\x5a\xa7\xca\xc5\x00\xa9\x6c\x2a\xbf\xad\xb4\xd1\xa4\xb3\x85\x9f\xad\x7f\x18\xac\xe0\x27\x1a\xe3\x9f\xf6\xf1\x77\xdf\xd8\x27\x20\x6d\xfe\xeb\x5b\x68\xd7\x48\xbe\x8f\xfa\x87\xec\xdd\x19\x2e\x5f\x90\xfe\xaa\x3d\x7b\x57\x44\x06\xd2\x7f\x4d\x42\x7d\xff\xf3\xd7\x2f\x3b\x98\x8b\xe9\xba\xed\xc8\x3d\x3d\xc3\x3d\x8c\xf7\xf5\xd8\xb5\x5a\x7f\xf4\x36\x5c\x5a\x71\x31\xdc\xfc\x01\x0f\x56\xc3\x47\x5f\x5f\xa4\xeb\xcf\x97\x64\x7f\x03\x90\x27\x2e\xff\x30\x6f\xba\x84\x3b\x58\xcb\x48\x88\x30\x3b\x33\x1c\xfe\xe8\xe3\xdd\x68\x9d\xad\xa7\x0d\x5f\x62\x1c\xb1\xff\x35\x46\xf2\xb5\x6b\xb4\x8d\xe9\x55\x42\xfd\xfb\x43\x66\x3d\xa3\xfe\x0b\x62\xe3\xd3\xe6\x2e\xd3\x02\xff\x31\xc6\xfc\x01\x8f\x23\x5b\xbf\x98\x4d\x7f\x55\x9a\x87\x64\xc9\x9f\x19\x95\x69\xeb\xae\xbd\xab\xc5\xe3\x48\x32\x88\x61\x1b\xfd\x8f\xc3\xb5\x2d\x7c\x2b\xef\x74\x1d\xca\xaa\x0a\x1b\x1c\x72\xb8\x6b\x99\x8a\x13\xca\xe7\x71\xa5\x99\x79\xbf\x73\xd3\x03\xbd\xbb\xcd\x7b\x22\x3f\xfa\x6e\x3f\x3a\xf6\x55\x8a\x1d\x43\x19\xa1\x1f\x3d\xc0\xdd\x6d\xb8\x98\x46\xc5\x4a\x4a\xf7\x89\x40\x0a\xb3\x2f\x05\x67\xc6\xff\xf0\x69\x4c\xfc\xf3\x9d\x7e\xc0\xa3\x9e\x5a\xa3\x0b\xb6\xc9\x83\xde\x19\xdc\x8d\x2f\x12\x14\xb2\xea\xa5\xbd\x91\xcf\x66\x16\x7e\xb1\x61\x52\xfc\x40\x8f\x3a\x51\xa2\xea\xdd\xdd\xfa\xc6\x76\x7f\xb3\x2b\xe3\x22\xb4\x1d\xf0\xc9\xcf\x24\x4e\x33\xe1\xeb\x65\x33\xbc\x7e\xde\x25\xb8\x1a\x64\x6f\x62\xc2\xe3\xdf\x3c\x0b\x81\xe9\x21\x21\x19\xb4\x27\xc0\x46\xe3\x34\x0a\xc1\x9b\x7c\xfc\xd4\x2e\xfa\xec\x66\xd4\x6c\xc5\x1d\x81\x17\xbc\x7e\x91\x7d\x6a\x10\x4d\x56\xb6\x09\x70\xed\x92\x14\xfa\xbc\x9d\x0a\xea\xce\xb5\xb9\x6b\x18\xe9\xeb\x05\x02\x61\x85\xc1\x35\x18\x18\x9d\x5e\x77\xd7\x65\x42\x95\x04\x2d\x73\xc0\x7f\xe6\x49\xe6\xe2\xaf\x26\xdc\xca\x03\x1d\x15\xd3\x97\xda\x0d\x41\x0e\x57\x9f\x36\xfc\x01\x9f\x97\x8d\xf3\x52\xbb\xf3\xdb\x62\x4f\x6d\xa7\x13\x88\xb3\x1a\x1f\x93\x3f\x3d\x75\xa7\xcc\xa1\x1d\x02\xed\xbe\x6f\x10\x79\x35\x6e\x10\x33\x0a\x9e\x66\x5f\xcb\xe4\x5f\x8c\xd5\x69\x47\xa3\xb5\x28\x37\x94\xdc\xd3\x7d\x23\xe9\x27\x1d\x7e\x46\x28\x28\xc7\xb1\x4b\x7f\xa7\x67\xb5\xe3\x24\x57\xc1\xa9\x5d\x2e\x7e\x81\x85\xa6\x53\x7d\x7f\xfe\x9d\x3e\x8f\x7f\x53\x15\x18\x06\xad\x27\x19\x53\x88\x4b\x9f\x7a\x4c\xa4\x2f\x9c\xd1\x5f\x04\x9b\xdc\xa4\xf9\x40\xd7\x68\xfa\x34\x3b\xcf\x6f\xf1\x67\xba\xbf\x39\x83\x61\xbd\xd2\xe7\xdc\x06\x1a\x03\xf0\x74\xb5\xc9\x9a\xbb\x82\x6a\x07\x35\xbe\xb0\x3d\xaa\xf6\x2d\xb1\x3e\x2d\x4b\xf9\x5b\x07\xe8\x36\x55\xab\x37\x94\xd6\x4d\xd7\x05\xba\x0c\xb2\x83\x40\xe5\xa6\x3f\x26\x00\x3f\xcd\x7a\x66\xea\xf5\x4b\x9f\x48\xa5\x73\x52\xd2\x87\xdb\x60\x17\x3b\x7a\x0f\x20\x5c\xc1\x2b\x7f\xd5\xe0\xab\x4c\xa9\xa6\xe6\x76\xf7\xf5\xd1\x54\xb7\xda\x91\x99\x4f\xb3\xa7\xd9\x7f\x0f\x00\xaf\x13\xa1\x05\xb1\x5c\x00\x00"
 
 func nftstorefrontCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -97,7 +99,7 @@ func nftstorefrontCdc() (*asset, error) {
 	return a, nil
 }
 
-var _nftstorefrontv2Cdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xdb\x92\x1b\xb9\x91\xe8\xbb\xbe\x02\xad\x07\x0d\xe9\xa5\xa8\xd9\x8d\x13\xe7\xa1\xad\xd6\x4c\xbb\x25\xed\x76\xec\x5a\x56\xcc\x68\xec\x07\x59\x11\x0d\x56\x81\x24\xa2\x8b\x40\x19\x40\x35\xc5\xd5\x76\xc4\x7e\xc4\x7e\xe1\x7e\xc9\x09\x24\xee\x97\x2a\xb2\x25\x8d\x3d\xf6\x19\x3e\xcc\xb4\x48\x5c\x13\x79\xcf\x44\x82\xee\x7a\x2e\x14\x7a\xfc\x7a\x60\x1b\xba\xea\xc8\x3b\x7e\x4b\xd8\xe3\x47\xee\xeb\x37\x9c\x8d\xfc\xf2\xbb\x41\x30\x22\x1e\x3f\x7a\xf4\xec\xd9\x33\xf4\xe6\xf5\xbb\x1f\x15\x17\x64\x2d\x38\x53\x7f\xfc\x17\xfd\x1d\x7c\x7f\x89\x36\x84\x11\x81\x3b\xd4\x0f\xa2\xe7\x92\x20\x89\x3b\x82\xe4\xd0\xc3\x20\x0d\x67\x4a\xe0\x46\xa1\x35\x17\x7a\x0c\x89\xd4\x16\x2b\x44\x77\x7d\x47\x76\x84\x29\xa4\xb6\x04\xbd\xee\xf8\x1e\xe5\xeb\x40\x52\x61\xd6\x62\xd1\x2e\x61\x1e\xf8\xcf\x2b\xdc\x6c\x11\x6e\x1a\x3e\x40\x4f\xac\xd0\x1e\x33\x25\x91\xe2\xa8\xa3\x52\x99\x09\xf4\x4c\xb0\x06\xca\xa4\xc2\x5d\x27\x11\x46\x61\xe9\x0b\x18\x08\xb3\x16\x7a\x48\x44\x59\x4b\xef\x68\x3b\xe0\x0e\x3a\x49\xb4\xa7\x6a\x4b\x99\x19\x3d\x74\x43\x58\xa2\xff\xa0\x52\x51\xb6\x91\x66\x41\xef\xb6\x44\x10\x44\x25\xe2\x8c\xc4\x0d\x7b\x22\xdc\x12\x17\x88\x2a\xb4\xc5\xac\xd5\xe3\x9a\xd1\xf9\x1a\xe1\xae\xd3\x0b\x45\xea\xd0\x13\x09\x43\xe9\x15\xc3\x7c\xb6\xdf\xd2\x43\x17\x36\x6c\xe7\x45\x0d\x66\x68\x8b\xef\x08\xcc\xc8\x05\xda\x71\x41\xd0\xe3\x66\x50\xf2\xb1\x1e\x57\x43\x12\xf6\xdd\x0b\xda\x10\x18\x10\xc6\xd8\x70\x02\x10\x8a\x7b\xe1\xb6\x15\x44\x4a\x22\x97\xe8\x6a\x50\x12\x86\x5e\x11\x34\x48\xd2\xea\xa6\x3d\x3e\x00\x78\xf4\xac\x6b\x62\x57\xc9\x05\xe2\x6a\x4b\x84\x3e\x53\x49\x5b\x22\xb0\xa2\x9c\xc9\x25\xaa\xaf\x94\xb2\xa6\x1b\x5a\x82\x30\x6a\xf8\x6e\x47\xa5\xa4\x9c\x21\xbc\x0b\x47\x47\x25\xea\x31\x85\xf9\xf6\x5b\x4e\xee\x88\x40\x6b\xdc\xd0\x8e\x2a\xac\xec\x94\x7a\x4b\xfd\x20\x9a\x2d\x96\x64\xa9\x21\x8e\x24\xe9\x3a\xbd\x04\xcc\x10\xee\x24\x47\xcd\x96\x6b\x9c\xd3\x6b\x16\xfc\x8e\xea\xf9\x18\xe2\xbd\x5e\x19\xee\x0c\x52\xf0\x35\xda\x61\x71\x4b\x54\xdf\xe1\x86\x98\xd5\x0a\xd2\x10\x7a\x07\x03\xf5\x78\xa5\xe7\xa4\x1a\x16\xd7\xfa\xdc\xa9\x06\x87\x24\x0b\x98\xbd\x5c\xfb\x6e\x90\x4a\xc3\x4a\x09\xcc\xe4\x9a\x08\x01\x20\x33\x10\xd2\x10\x36\x07\x11\x8f\x8b\x00\x9d\x08\xac\x26\x3b\x5a\x8d\x07\x3b\x7c\xd0\xe3\xe9\x5f\x49\xab\xdb\xc6\x07\xe5\x70\xce\xac\xe6\x0e\x77\xb4\xa5\xea\xa0\x67\x21\xb8\xd9\xc2\x40\x31\xd0\x09\x96\xb4\x83\xe1\x9a\x2d\x69\x6e\x49\x4c\x3c\x6f\x2d\x20\x85\x39\xee\x3d\x56\xcd\x16\x50\xcf\x0d\x40\xee\x88\x26\x26\x4d\x1b\xd0\x1b\xa6\x74\x98\xaa\xbf\x86\x61\xae\x5f\x6a\x68\x4b\x42\x10\x85\xad\x1e\xd0\x9e\xca\xad\xfe\x6e\x35\x1c\xfc\x3e\xf5\x4e\x14\xd9\x99\xe9\x7f\x1f\xc0\x6f\x86\x37\x78\x84\x37\x1b\x41\x36\x58\xf1\x23\x2b\x4a\x28\x16\x86\x05\x3a\xa2\x4c\x11\x41\x1c\x48\x71\xd3\x10\x29\x67\xb8\xeb\xe6\x81\xe9\x64\x4c\x0b\x7d\x7a\xf4\x08\x21\x84\xe2\xb6\x84\x29\xaa\x2c\x2f\xba\x12\x04\x2b\x62\x27\x9f\x6c\xf9\x03\xd9\xf1\x3b\xdf\x12\x9a\xea\x45\x86\xc9\xae\x19\x55\x14\x77\xf4\x3f\x49\xeb\x7f\xbd\x8c\xd9\x84\x20\x92\x0f\xa2\x21\x68\x8b\x25\x5a\x11\xc2\x50\x03\xb3\xb7\x4b\xdf\xfe\x95\xde\x3d\x50\xdb\xb0\x73\xa7\xc6\xf8\x1e\x91\x8f\x3d\x69\x94\x3b\xae\xb5\xe0\x3b\x83\xb5\x61\xf4\x30\xc6\x1b\xae\x88\xe5\x94\x04\xb5\x1c\x31\xae\x90\xec\x49\x43\xd7\x07\x4d\x29\x96\x0f\x9c\xeb\x5f\x1b\xcc\xf4\xaf\x1a\xd0\x72\xcb\x87\xae\xd5\x8d\xc3\x48\x06\x38\xad\x5f\xb8\x74\xc3\x01\x4b\xd2\x54\xb7\x67\xfa\x50\xcd\x88\x0b\x18\xc7\xa2\xb6\x86\x55\x00\x03\x5e\x2b\x4d\x78\x7a\x38\x4d\x56\x54\x1f\xfc\x41\x46\x2b\x00\xe4\x0b\x13\x5b\x28\x5f\xde\x61\xda\xe1\x55\x47\xdc\xc6\x33\x9e\xd5\x12\x45\xc4\x8e\x32\x02\x38\x68\x97\xe1\x07\xb1\x64\x69\xd6\x68\xff\x11\x9d\xc6\x6c\xb9\x5c\x52\x25\x51\xc7\x1b\x58\xd5\x1c\x61\x23\x9a\x14\xdd\x69\x9a\xf6\xe3\x38\xfc\xd6\xd8\xb9\x1a\x14\xe2\xac\x3b\x98\xb6\x58\xa1\x5e\x90\x86\x4a\xbd\x5f\x40\x12\x27\x45\xdc\xd7\xc0\x31\x70\xa3\xc7\x0f\xbb\xbb\xb6\x8c\xdb\xf0\x35\x0d\x29\x99\x2f\x6e\xbf\xa5\x1d\x49\x66\xa6\xd2\xb0\x82\x05\x8a\x16\x66\x58\x2a\x07\x5e\xb3\xf3\x13\x94\x68\x0c\x58\x55\xc5\xd4\x99\xf4\xdf\xfe\x60\x4f\xf9\xfa\xe5\x39\xfa\xe9\x9a\xa9\xff\xfb\x7f\xe6\x8f\x46\x4f\x24\xc2\x70\xb7\xc2\x1c\xab\x01\x1d\x70\xdb\x9a\xb3\xc2\x35\x4a\x08\x30\xd1\x8c\xfe\xd2\x1c\xa0\xde\xe7\x40\x24\x02\x61\x8b\x85\x65\x81\x68\xbf\x25\x86\xa7\x9a\xdd\x50\x89\xc8\x8e\x2a\x45\xda\x85\x3e\x95\xe4\xb4\xa4\x16\x27\xee\xc8\xad\x80\x95\x86\x71\x09\xb2\x26\x42\xaf\x47\xe3\x69\xb3\xc5\x6c\x43\x10\x1f\x94\x16\x6e\xb6\x43\xa0\xa3\x8c\x93\xec\xb9\xb8\x5d\x77\x7c\xbf\x40\x92\x03\xbb\xc5\x82\xac\x87\x4e\x0f\x66\x78\x27\xac\x70\x90\x1a\x16\xa7\x9c\x47\x0e\xd2\x19\xb4\xd3\x9f\x70\x26\x97\x8e\x58\xed\x1f\x0b\xdf\xc6\x42\xbd\x3c\xb4\xd0\x84\xad\xd5\xbb\x43\x4f\xce\x91\xfe\x6f\xf2\xf5\x4f\x3f\x45\xed\x51\xfc\x4b\x6d\x1c\xad\x61\xbc\xc5\x07\x8d\xe0\x7f\xc4\x43\x57\x1d\x14\xda\x68\x25\xe4\x1c\xfd\xf4\x9a\x7e\x8c\xbb\x37\x83\x54\x7c\xa7\x07\xfe\x51\x09\xca\x36\xdf\x45\x3f\x79\x51\x7b\x09\x92\xb6\xd2\xd9\xb7\xf8\xc1\xca\x6f\x79\x8e\xde\x5b\x68\x7c\x88\x86\x22\x1f\x7b\x2a\x0e\x6e\xf1\xf0\x75\x89\xbe\x57\x5c\x2b\xa1\x2a\xe2\x4c\xef\x22\x12\xf3\x08\xac\xd1\xb3\xbb\x23\xed\x12\x5d\x2b\xf8\x96\x50\x90\x5e\xf0\xa3\x53\x4e\xda\x05\x12\x20\x12\x5a\x2d\xb9\x5b\x22\x95\xe0\x87\x88\x97\x4f\x9f\xba\x5f\xc9\xec\x84\x13\x45\x15\xcc\x98\x6c\xe6\xd7\x78\x8e\x7e\xc7\x79\xf7\x60\x94\xf8\xfb\xc2\x08\x4f\x1d\x27\xa3\xc3\x4f\x4c\xab\xa1\xae\x7f\xc4\xcb\xac\xc0\x6f\x83\xb6\xa8\x8f\x5f\x4b\x28\x73\xf6\x5a\x79\x6d\x07\x61\x49\xdc\xe8\xde\x96\xcf\xbc\x79\xfd\xee\xd8\xd9\xa7\xd3\xce\x44\xbe\xfe\x85\x9f\xff\x47\xdc\x91\xab\xc1\xef\x7e\x5e\xd3\x37\xf4\x5f\x78\x43\xde\x62\xb5\x4d\xd1\xd9\x0a\x34\x2d\x8c\xa4\x69\x63\x4d\x8d\xaa\x32\x62\x05\xff\xca\x76\x74\x18\x1c\xaf\xbe\x23\xaa\x3e\xef\x39\x8a\x17\x51\x59\xe3\xdb\x61\xd5\xd1\xa6\x58\x62\x0f\x5f\x87\x95\x6a\x05\x30\x59\x5d\x47\xd9\xed\xb1\x75\x84\xb1\xcf\x51\x34\x4f\xb4\x0c\x03\xc4\xe8\x78\xa5\x12\x43\xa3\xf7\xde\x0b\x22\x35\xac\xd9\x06\x61\x7d\xd6\xb4\xa7\xc4\x19\x29\x4e\xdb\xd7\x0d\xb4\x29\x43\x84\xc2\xd4\xd9\x02\xb9\x76\xd1\x1b\x2a\x30\xbc\x1f\x23\x05\x66\x2c\x95\x48\xf2\x6e\x82\x15\xd8\x75\xd8\x05\xa2\x4f\x1e\x6d\x1d\x80\x3c\xfa\x19\x3b\xd1\xcf\xb3\x4c\x5a\x56\x35\x3d\x05\xc6\x9e\xd7\xf3\xb4\x78\x5a\x53\xd6\xc2\x28\x40\xac\xa6\x07\xa8\x8f\x1e\x10\x91\x60\x01\x7d\x4d\x5a\xdd\x83\xdd\x6a\xe6\xe6\x71\xc5\xcd\xb5\x26\x5a\x6d\xa7\xd6\x74\xda\xe3\x83\x57\xfb\x30\xa3\xfd\xd0\x69\x34\x4a\x47\x94\x3c\x59\x88\x5f\x9c\xc1\xcb\x66\x50\xde\x66\x3d\xf0\xc1\x1c\xc1\x86\xd8\x55\x5a\x68\x61\xd3\x97\x25\xe3\x36\xda\xb4\x76\xd0\x5a\xae\xb8\x10\x7c\x3f\x9b\x9f\x2d\x41\xeb\x5b\xba\x69\x34\x31\x64\xb0\x7b\x67\x8c\x3e\xd0\x27\x5b\x6d\x7e\x91\xf5\x9a\x36\x1a\x0b\xba\x83\xde\x19\x46\xb2\x11\xb4\x8f\x7a\xe5\x98\x18\xc8\xf7\xca\x59\x7e\x87\xe7\x4f\x3e\x25\x1e\x8d\xa5\x23\xf6\xfb\x17\x8f\x8a\x43\xb6\xd6\x65\x86\x49\xa9\x47\xc4\x40\x9c\x76\x9d\x5e\xa7\xb3\x9d\x55\x84\x21\xe3\xeb\xc3\x09\x03\x4d\xa7\xa7\x5e\x03\x14\xf1\xf7\xfe\x6f\xfd\xfb\xec\x81\x1b\x5c\x64\x33\xce\x23\xbc\x06\x81\x40\xba\xf5\xd2\x23\xf6\x85\xdf\x41\xd9\xc8\x02\xe6\x22\xa6\x39\xfd\xb9\x7f\x64\xfe\x5b\xc8\xf5\x97\x44\x61\xda\xc9\x92\xd2\xb5\x59\x88\x29\x33\x74\x6e\x1b\x7f\x23\x51\x8b\x15\x3e\x4a\x9d\xe9\xd8\x15\x22\x8d\xf8\x95\x25\x29\x6f\xbc\x03\x0b\xd0\x3f\x6b\xeb\x7e\x8c\x68\x2d\x09\x5a\xda\xb2\x96\x90\xb3\x9d\x8c\xce\xdc\xd2\xf5\x9a\x88\x54\x7b\x2f\x09\x4b\x8f\x43\x25\xfa\xc3\xbf\x2f\xb5\x6d\xb1\x07\x15\x59\xa0\x1d\xbe\x25\x88\x2a\xd3\x42\x5b\x0a\x0a\xfd\xa6\xc1\xec\x37\x7e\x8a\x74\x20\x43\x6a\x5e\x18\x08\x72\x47\x25\xf5\xe2\x20\x87\xd1\x1d\x16\x91\x32\x12\x74\x84\x64\xc8\x3f\x6d\x09\xe8\x4d\x30\x74\xa1\x65\x79\x25\x45\xb3\x18\x6f\x77\xd6\x26\xca\xd4\x99\xe2\x20\xb4\xe2\xe1\xc5\x70\xe6\x53\x5c\x82\x37\xc3\x5a\x4a\x2b\xa2\x57\x60\x9c\x15\xe3\x84\x93\x28\x49\xc5\x64\x4e\xf1\x42\xd7\x2f\x23\xd1\xaf\x2d\xb6\x66\x0b\x0c\x05\xec\x43\x90\x1f\xca\xb9\x81\x9c\x77\x62\x6a\xca\x58\x01\x2b\x26\x1d\x18\xfd\xcb\x40\x10\x6d\xb5\xd0\x5a\xd3\x60\xd0\xfa\xdd\x01\x8f\xd0\x2c\x53\x9b\x95\x93\x7b\x9b\x98\x26\x06\x64\x85\x0f\x59\x16\x25\xbd\x88\xdc\xe1\x96\x24\x08\x9e\x4f\x37\xae\x28\x8e\x71\xc3\x44\x04\x03\xc3\xb3\x30\x34\x2e\x0c\x4a\xda\x74\x65\x47\xe6\x8e\x15\xd0\x92\xff\xbb\x31\x8d\xb8\x6b\xa9\x46\x79\xce\x34\x04\x1c\x37\x5e\x11\xb5\x37\x26\x81\xd5\x11\xe4\xf4\x84\x57\x83\xd2\x06\x8a\x95\xec\x1f\x92\x29\x2f\xbb\x8e\xef\x23\x92\x6e\x71\xdf\x23\x45\xf0\x4e\xc6\xce\x4c\xa3\x1c\x6b\x26\x44\xd9\x46\x3a\x51\xdc\x1a\xea\x19\xa8\xdc\x92\xd6\xfe\x98\x91\xaf\x46\x02\xa0\xde\x2d\xe9\x7a\xb0\x40\x8d\xc8\xed\x14\x11\xce\x81\x22\x08\xc8\x66\x2b\x44\xa8\xf0\xaa\xf8\x38\xf1\x15\xca\x7a\x32\xed\x55\xe4\x2c\xf5\xde\x1a\x65\xec\xe3\x0e\xd3\x1d\x69\xd1\xea\x50\x73\xf6\x7a\xfd\x79\x1c\x9e\x63\xb6\x40\xb2\x80\x57\xa0\xe8\xeb\x23\xeb\x22\x27\x5e\x6d\xb8\xcc\x24\x48\x46\xb9\x16\x42\x2f\x50\xd2\x55\x77\x00\xc2\x4d\x58\x16\x96\x81\x05\x2d\xab\xf2\xd2\xce\xe5\xe8\x7c\x8e\xd6\x03\xd3\xe3\xbc\xe3\xce\x05\xdb\xce\xaa\xf2\x30\x30\xc1\x0b\xa4\xc4\x40\x22\x59\x97\x2c\xf0\xa7\xbe\xf5\x50\xb3\x18\x12\x31\x03\x83\x0f\xc1\x2f\x16\x90\x25\x71\x19\x46\xa8\x77\xd9\xf7\x32\x95\x4b\xbf\xc7\x9e\x6b\x29\x8e\x18\x35\x8e\x8d\x8e\x60\x91\xe8\x4e\x63\x3b\xbd\xb2\x68\x32\x2b\xf0\x65\x5e\xd9\xb6\x6b\x84\x2e\x3c\x7e\x8d\xed\xfc\xfa\x04\x55\x05\xcd\x92\x29\x46\xac\x5c\x34\x65\xe9\xa2\x71\x6b\x17\x9d\x68\xf1\xba\x76\x19\x0f\xc8\x1a\x54\x84\x66\xda\x62\xc2\x3a\x46\x27\x59\xc8\xa8\x6e\xff\xea\xcf\xdc\xf9\xc3\xdd\xa7\x17\x24\x43\x4b\x03\x5f\xf4\x47\xdc\x51\x8d\x72\xc6\xe5\x06\xa3\x15\xcd\xcc\xd7\xe8\x85\x9d\x65\xb6\xd1\x68\x20\x34\x82\xfd\xae\xe3\xcd\xed\x6c\xbe\x54\x74\x47\xa4\xc2\xbb\x7e\x7e\x5e\xf4\xd6\x9f\xc7\x99\x93\x6d\x99\x2a\x5d\x4b\x7d\xb8\xe7\x20\x1e\x36\xf4\x8e\x30\x37\xa3\x1f\x16\xfd\x79\x66\xbe\x9a\x7b\x99\x61\xc5\xc5\x7a\x50\x83\x20\x67\x8f\x8f\xee\xad\x23\x6c\xa3\xb6\x49\x34\xad\x19\x54\xd1\xcd\x1d\xec\xd2\xb6\x7f\x81\xbe\x3d\x3f\x71\xf9\x4e\x27\x84\x15\x1a\xd7\xb9\x42\x1d\xc1\x52\x41\xdc\xc7\x89\x1a\x6d\x04\x79\x31\x93\xae\xfb\xfe\x51\x49\x42\x31\x22\xa1\x8b\x04\xaf\xa6\xd9\xcc\x1a\x77\x92\x94\x4d\x2c\xd1\xa0\x0b\x47\x3e\xd5\x26\x9a\x78\x4c\x13\xfd\x57\xb5\x89\x6b\x50\xfb\xb9\x46\x46\x7a\xf1\x95\xaf\x1f\xc6\x34\x42\xa3\x8c\x3c\x74\xe3\xec\xab\xb2\x93\x45\xab\x8b\x1a\xa2\xfb\x75\x43\xd4\xf4\xc2\x23\x42\x7a\x24\x5a\x14\xe2\xae\x01\xd3\xd7\x44\x11\xb8\xc2\x9d\x8d\xcb\xda\x78\x0d\x20\x96\x4c\xba\x81\x1a\xed\xf4\x94\x63\x4b\x7d\xf6\x0c\xbd\x25\x62\xcd\xc5\xce\xd9\x6f\xd6\xf1\xcc\x59\x12\x7c\x34\x81\x98\xc6\x2f\x27\x8a\x10\xc3\x4a\x34\xa2\x19\x6d\x2b\x12\x01\xfa\xb3\xe6\x02\x7e\xa4\x2c\xdb\x75\x95\x43\xfc\x5e\xdb\x17\x72\x10\xc4\xc6\x71\x90\xb1\xbe\x47\xac\xd3\xa8\xe3\x9f\x88\xd1\x56\x5d\xc4\x91\x4a\x84\x37\x98\xb2\xe0\xe2\xaf\xb8\x51\xe2\x4f\x33\xa8\x65\x61\xf3\x57\xf9\xcb\x77\xdf\xa1\x1e\x33\xda\xcc\x4e\xa3\xd4\x2b\x6b\x83\x99\x8d\xb8\x29\x1e\x97\x63\x6b\x6d\xae\x6d\xdd\x99\x7a\xdd\x95\xe7\x47\x5f\x65\x23\xee\xb0\xc3\xdf\xff\x04\x5b\xc2\xe5\x91\xdf\x27\xff\xc2\x52\x12\xa1\x66\x13\x83\xbe\x40\xdf\x2e\xbf\x5d\x14\x0d\x76\x44\x4a\xbc\x21\xa7\x32\xac\x77\x91\x21\x1b\x98\x16\xe3\xec\xe9\x7f\x12\xc1\xcd\xce\x32\xce\x3a\x2f\xc8\x01\xa6\xb1\x81\x71\x8b\x89\x6d\x84\x8a\x23\xbc\x21\x07\xcd\x71\xdb\xdf\xb8\xfe\x82\xe9\xcf\x4c\x94\x78\x8d\x1b\x62\x75\x6a\x63\xfd\x0f\x12\xa2\x35\xd6\xf5\x18\xda\x80\x9d\x6d\xc7\x1a\xf7\x09\x78\x33\x3d\x74\x4c\xe6\xcf\xdc\x03\x06\x83\xde\xbc\x7e\x57\xda\x1c\x80\xfa\xe6\x24\xbd\x69\x83\x77\x04\xbc\x68\x56\xd9\xd7\x46\x9d\x4b\x85\x09\x43\x45\x28\x0e\x2e\x9c\x60\x00\x6a\x02\x5a\x49\xc2\xd4\x02\x48\x98\x7c\xc4\xbb\xbe\x83\x60\x3d\x55\xc1\xde\xd6\xb4\x84\xee\x28\x46\x98\x99\x60\x7c\x17\x6f\x1a\xd5\x35\x5a\xd8\xbc\x56\xf1\xa2\x65\x9c\xa3\x27\x9f\x6a\x66\xf6\xfd\x77\xa9\xe6\xe6\x84\x4e\xf2\xa5\xd3\x87\xe3\xe0\xe6\x02\xad\x86\x83\xf3\xa9\xab\xd4\xaa\xf3\x70\xeb\xf1\xc1\x40\x67\x45\x18\x59\xd3\x86\x62\x41\x6d\x6e\x81\x20\x6a\x10\x4c\x46\xac\xc3\x52\xe2\x6a\x38\xc4\x3c\x68\x6a\x7f\x6e\xb1\x29\x75\x59\xd9\x7c\x8e\xbe\xcf\xdc\x5e\x20\xa6\xee\xa3\x90\x0b\xca\x43\x13\x46\x8e\x9f\xe8\x3b\x8b\x74\xbc\xb9\x9e\xac\x0a\xde\x14\xba\x1b\xa2\x62\xb7\x97\xfb\xfa\x35\x51\xcd\xd6\x19\xb4\xd6\x75\x65\x75\x9b\xe2\xbc\x13\x53\x8f\x92\x3d\x00\x22\x8c\xab\x4f\x3a\x73\xb0\xe5\x2b\x00\x93\x96\xb4\x57\x65\x94\x6e\x74\x51\xd8\x74\x89\x13\x74\x64\x9a\x3b\xa3\xa5\x50\x30\x2b\x1d\x17\xce\xcc\x95\x6b\x40\x6e\x77\xf2\x37\x8c\x76\x37\xe0\x1d\x8e\xbb\x52\x89\x86\x5e\xe3\xc2\x46\xe0\x95\xb6\x43\x31\x3b\x70\x36\x62\x71\xc6\x00\x18\xdf\x96\x86\xc9\xfb\x93\x8e\xf4\x43\x46\x0d\x5b\x2c\x2d\x30\x7f\x47\x1a\xbe\x23\xff\xba\xe5\xd2\x45\x22\x3d\xa2\x93\xae\x93\x5a\x0c\x7a\xfa\x24\xad\xa3\x70\xeb\xa9\xd7\x3c\xc3\xba\x0a\xda\x00\xb6\xc3\x24\x70\x40\xe3\xb3\xe0\xa1\x0a\xed\x08\x66\xc1\xb8\x5d\xc1\x6a\x24\xda\xe8\xf5\x68\xd8\x03\x9f\xe0\x83\xaa\xd3\x8d\x1e\xfe\xe5\xab\xb7\x3f\xbc\xba\xba\x7c\xf7\xea\xe5\xb9\x8d\x56\xe8\x89\x4c\xdc\xde\x20\x1b\x95\x1a\x94\x8d\xb2\xa7\x20\xc9\x0e\x33\x45\x1b\xdc\x81\x73\xfd\x8e\x08\xbd\xb1\xff\xfd\xef\xff\x49\x96\xa9\xed\xdf\x9b\x64\x22\xaf\x10\x58\x18\x48\xa5\x99\xa7\x83\xc4\x8c\x2e\xc9\x12\xbd\xf9\xc3\x3b\xb3\x76\xd2\xce\x81\x1b\xb8\xed\xe6\x9d\x0d\x8b\x4c\xc6\x37\x23\x5c\xff\xe8\x07\x58\x1a\x5e\x43\x0d\xaa\xf2\xbe\xe7\x92\x9a\x54\x82\xbd\xf3\xf0\xfa\x7d\x31\xcd\xb5\xe9\xae\xef\x20\x8f\xec\x5d\xf4\x53\xca\xa7\x25\xba\x25\xbd\x42\x58\x3e\xa5\xe0\xe8\xc1\x77\x9c\xb6\x68\x25\x08\xbe\x85\x84\xa7\x8f\xce\x65\xcc\x14\xd9\xd8\x0c\x3b\x1b\x18\xe9\x04\xc1\xed\x41\xa3\x74\x4f\x98\xd4\xba\x9c\x8d\x07\x65\xce\x7c\x70\x5d\x70\xb6\x44\x3f\x49\x82\x6e\xa8\x04\xdc\xb2\xd8\x36\x9b\xdf\x40\x5a\x24\xc1\xed\xc2\x7a\x29\x53\x88\x07\x40\x85\x5c\x93\x94\xce\x0d\x70\x4a\xe0\x52\x15\x0e\x05\x92\x34\x8e\x50\xd6\x08\x09\x68\x9a\x02\xbf\x6e\x06\xb7\x78\x17\xc9\x4f\x3f\xa4\xeb\xa7\xeb\x38\x07\xcd\x9e\x36\xe3\xa8\xe3\x6c\x43\x44\x4c\x38\x21\xed\xe6\x1b\x89\x1a\xde\x75\xa4\x3c\x2f\x83\x13\x59\xea\x4d\x0c\x82\xe0\xa8\xf7\x56\xd5\x7c\x91\x00\x27\x11\xcd\xa9\xb7\x1e\x60\xe5\x3d\x65\x29\xba\x35\x5c\x08\xd2\xa8\xee\xf0\x54\xa3\x96\x16\x6b\xc0\x1d\xc1\x38\xd4\xe7\x7e\x33\x0a\xbf\x9b\x45\x46\x36\xfa\x94\xb5\xe0\xf7\xb4\x66\x49\xd4\x11\xe2\x88\x17\xd3\x9f\x54\x8e\x42\xf1\x01\x55\x74\xb0\xa0\x7d\x65\xb1\x42\x60\xf6\x5a\x48\x1b\x47\x35\xf8\x05\x81\xbf\x40\xc4\x97\x45\x21\x30\x6c\x2d\xfc\x84\x9d\x2e\xfc\xc0\x1a\xbc\x3e\x16\x2a\x78\x43\x48\x6b\xe5\x1a\x36\x8e\x57\x37\x78\xdf\xd1\xe0\xb5\x95\xe4\x0e\xf2\x94\x73\xef\x2d\x24\x48\xe6\x9b\xf7\x2b\xb7\x9b\x3a\x4f\x35\xbc\x05\x32\xc9\xd1\x4b\xfd\x3f\x70\x73\x96\x11\x21\x09\x49\xce\x68\xa6\x55\xe5\x20\x24\x16\xa0\x3a\x37\x90\xf1\xf1\x71\x9e\x4b\x65\xbd\xf8\xfc\x30\xb4\x3e\x6c\x9c\x96\xb6\xf1\xb4\x20\xbe\x8c\x04\x81\x01\xb9\x51\xa6\xe2\xf0\x92\xe2\x90\xe6\xdc\x0a\xbc\x0f\x31\x0b\xaa\xb6\xf0\x0f\x03\xfa\xeb\x97\xc6\x3e\xa4\x2a\x26\x8e\x6a\xd8\x34\x9d\x4d\x5a\x9b\xaf\x32\xd5\x6f\x30\x3b\xfc\x46\x4f\x06\x59\x53\x07\x3e\x44\x21\x26\x97\x40\x05\xbc\x64\x43\xef\x72\x32\x97\x43\xb3\x45\x38\x9e\x0d\x74\x75\x3f\x0f\xe4\x97\x75\x90\x49\x68\x4c\x49\xb3\xf0\x96\x98\xfc\x2e\x67\x9d\x52\x1b\x1c\x19\x24\xfc\x6d\xd8\x40\x4a\x2f\xf8\xe0\x43\x64\xe0\xb5\x96\xe3\xb2\x0f\xf8\x3c\x25\x5d\x6b\x52\x64\x07\x49\x24\xba\xa9\xe8\xc3\x6f\x8d\x8c\x16\x8b\x32\x22\x75\xe5\x61\x6b\x30\xeb\xfe\x26\xe4\xfb\x25\x73\xdd\x68\x73\xec\x06\xf5\x58\xe0\x1d\x51\x26\xc9\x9c\xf4\xaa\x3e\x61\x18\xf5\xfe\x06\xc4\x91\x24\xd6\xe1\x0b\x92\x85\x99\xfc\xe8\xee\x70\x0e\x31\xf3\x5c\x43\xeb\xb1\x34\xa7\xc8\xb0\xd6\xf3\x89\x40\x37\x61\xc0\x1b\xb3\xd7\x99\xe1\x2b\x96\x5b\x79\x03\x25\x3e\x1f\xdd\x8c\x4a\x39\x18\x3f\xb4\x61\xb5\x72\x9e\x25\x17\xb8\x1c\x00\x29\xe9\x86\xed\x6c\x32\x9f\x49\xf2\x5b\x91\x06\xeb\x73\xba\x99\xd8\xde\x0d\x6a\x38\x5b\x73\x61\xc2\x26\x2b\xae\xb6\xe8\xc6\x41\xfb\xa6\x98\xe9\x26\x87\xf6\xcd\x12\x5d\x76\x74\xc3\xbc\xbd\xb1\xe7\xce\x5c\x00\x3b\x0c\xf6\xb0\x77\x38\x8a\x83\xa0\xb6\xd9\x82\xc6\xc2\xaa\x48\xa5\x71\xf9\xbd\xd5\x1c\xef\xd8\x99\xa5\x79\xe9\xe3\xfe\x76\x1b\xc5\x73\x3b\x0e\x6c\x26\x31\x35\xf0\xa0\xb6\xb3\x62\xbe\x3f\x59\xb2\x9c\xd7\x2c\xb8\x87\x60\x6c\x96\xcd\x70\x39\x9d\x80\x9f\xe9\xf7\x06\x36\x82\x20\xdc\x6b\x4d\x96\xb4\x28\x8d\x64\x71\xa7\xf3\xc3\xa1\xc4\x89\xfc\x41\xb7\x3f\x02\xa0\x78\xf2\x18\x42\x9f\xa7\xba\x5f\x69\x82\x81\x40\x96\x15\x01\x2b\x2d\x4b\xad\xda\x44\x65\x1c\xf4\xcf\xf2\x00\xdd\x08\xaf\x76\x9a\x37\xe5\xb9\x7f\x26\x54\x4c\x13\xeb\x0c\xed\x6d\xc2\x99\x43\x1e\xd8\x4a\x9b\xc4\xa0\xb2\x0c\x00\xca\x9a\xa0\x8b\xcc\xe6\x90\x17\x2b\xa3\x54\x59\x2f\x37\xbd\x13\xb8\xc1\x92\x14\x16\x83\x09\x79\xf1\x0d\x6d\x2c\xaa\xcb\x85\xcb\x02\x88\xf3\xcc\x45\x9c\x07\x3f\x33\x2a\x77\xf4\x73\xd3\x11\xcc\x86\x7e\x36\x3f\x12\x32\xd2\x20\xd4\x70\x5d\xe1\xe6\xb6\x08\x8d\x85\x24\xe9\x38\x6b\x20\xa4\xe1\x85\x14\xcc\xbd\x96\x3b\x1b\xcd\x86\x28\x5c\xd8\x69\x3c\x6c\xb7\x44\x90\x65\x3e\xea\x1f\xb4\x61\xb5\xa7\x92\x4c\x74\xa4\x91\x33\x60\x5e\x8c\xe0\x14\x36\xc7\xa9\xf6\xc4\x9d\x79\xaa\x33\xb2\x09\x98\xe5\x63\x72\x51\x85\x20\x2a\x26\x37\xd9\x1d\x96\x11\xc1\x79\xc1\xdc\x43\xa3\xb8\x08\x87\xa5\xb6\x5c\x06\x63\x24\xf3\xf1\xd2\x35\x3a\x03\x77\x9b\xd5\x2c\xa2\xd0\x40\xe9\xe5\xd5\x98\x34\x91\xb1\x1a\x7f\x2a\xd9\xab\x30\xcd\x30\xd0\xb6\x74\x48\xa2\xd1\x5c\xd6\x64\x6d\x71\x50\xa3\x3e\x48\x94\x1a\x52\xdf\x55\xbd\x9b\x8f\x10\x26\x9d\xec\xb7\xa3\x5d\x4c\xe4\x30\xef\xa2\xbf\x1d\xed\x52\xeb\x30\xd6\xbc\x1e\x63\x4c\x01\x52\x69\x32\x31\x98\xc9\x7e\x28\x47\xd0\xdf\xd7\xbb\x85\xe0\x63\xd2\xcb\x7d\x3d\xd2\xa9\x08\x49\xa6\x9d\xb3\x9f\x8f\x0d\x12\xf2\x7a\x19\xed\xea\x8d\x5d\x78\x33\x99\x67\x24\x4a\x39\x1f\xf1\xa8\x67\xfe\xb4\xba\xcb\xd6\x98\x9a\x56\xbf\x85\xe0\x79\x43\x8a\xdc\x1b\x9b\x2a\x40\x5a\x7f\x11\x71\xf9\x57\x70\xd5\x7a\xc5\xd6\xda\x76\x8c\x76\x5f\xd1\x95\x3b\xca\x91\xad\xf3\x49\xc4\x7a\x5f\x94\x39\x7f\xc7\x6f\x4d\x6a\xbc\x5b\x13\x12\xd8\x26\x81\x61\x66\x82\x31\x5a\x9b\x5a\xe4\xa3\xbb\x3c\x17\xde\x84\xbb\x5c\xbd\xe0\x3b\xaa\x15\x6c\x3d\x8c\xb1\x18\x0f\x16\x7c\xcf\x06\x16\xf2\x4d\x7c\xea\xb5\xfb\xd0\x35\x68\x01\x6e\xa9\x3f\x90\x35\xba\xf0\xf1\xc9\x52\x73\xf2\x31\xa4\x0a\x0b\xb4\x43\x09\x18\x22\x1a\x70\x19\xa0\x58\xd2\x77\x6d\x20\x3b\x98\x20\xeb\x25\x95\xd7\x4c\xab\xcf\x0d\x29\xfa\x6a\x82\x9e\xa3\x27\x4f\x4c\xbb\x16\x5d\x5c\x54\xd8\xc7\xc8\xe8\xfa\x63\xe1\x2e\xc8\xba\xda\xe4\xbe\xf8\xf6\x7e\x22\xde\x14\x0e\x71\x8c\x68\x46\x9c\xd0\xff\x4a\x54\xee\x80\x3e\x39\xd8\x70\x92\x23\x3a\x83\x80\xf7\x6e\x04\x48\x4d\xac\xf8\x57\xa7\xf5\x84\xe6\x3b\x01\xd9\xba\x52\x3d\x06\xe8\xff\x5f\xfc\xdd\xa3\xee\x6d\xc8\xb5\x03\x2b\x86\x97\x8e\xe3\x8a\x57\xc5\xff\x3e\xe6\x9e\xad\x3b\xc1\xb5\x56\x5c\xf3\x7f\x4f\x3a\xd2\x0d\x13\x45\xb3\xe0\xf7\x9e\xf6\x1c\x7f\x99\x63\x37\xc3\xa9\xaf\xc8\x9d\xed\x49\x9c\xce\x97\xcf\x2e\x12\x76\x86\xc6\x78\x5e\x9a\x24\x73\xff\xab\x6b\xfa\xb3\x5d\xd3\x9f\xe5\x69\xfe\x05\x61\xcc\xc5\x89\x18\x33\x95\xbd\xf9\x57\x8b\x84\x47\x72\xc4\x71\x1d\x97\x72\x0b\xea\x53\x88\x95\x93\x8f\x4a\xe0\x63\xd1\x72\x33\x9f\xa9\xba\xd0\x01\x17\x1d\x7a\xd4\x0e\x7d\x47\x1b\xac\xfc\xa2\xa5\xf7\x30\x50\x45\x76\x36\xc9\xbe\x92\x30\xfb\x77\x18\x72\x3f\x2d\x5d\x72\xc4\x9e\xbe\xb0\xb9\x76\x0f\x4b\x7d\xf4\x03\xa4\x79\x38\x5a\xc5\x76\x2e\xa1\xd4\x09\x52\xa6\x38\xba\xcb\x62\x63\x7a\x66\xcd\x8a\x7c\x60\x82\x66\xb4\x4a\x9b\x34\xd5\x57\xd0\xd9\x44\x18\xae\x5f\xa2\x3f\x9b\x05\xc4\x2a\x9d\xa5\x2f\x1f\x39\x05\xc8\x5b\x84\x84\x44\x66\x2c\x7d\x6a\xe4\xf3\x3f\xcf\xdc\x9e\x36\x04\x96\x3b\x9b\x2f\x43\xfe\xf3\xdc\x70\x55\x65\xcd\xb4\xbf\x0c\x04\x38\x22\x78\x72\x9f\xff\xf9\xf8\xde\xe3\xa1\x96\xe3\xe0\x5c\xe1\x4e\xc3\xb2\xd0\xc9\x83\xa9\xfd\xb7\x04\xa1\x83\xd5\x1d\xdc\xf1\x6b\x39\x31\x20\xb1\x37\xa0\x32\xd0\x98\x6c\x40\xbe\x46\x35\xe8\xe8\xdf\x6a\x70\xa8\x18\xdb\x3f\x47\xee\xef\x69\x70\x39\x4b\xa8\x83\x4a\xe3\x12\xa8\x51\x03\x2c\xdb\x54\x81\x30\xd2\x1f\x7d\xf6\x7a\xfc\xdd\x1f\x0d\x5e\xf6\x8d\x4d\x8c\x0b\xc1\x0c\xd2\x9a\x72\x13\x79\xf6\x6e\xfc\xaf\xdc\xf0\x0d\x69\x94\xf1\xa9\x57\x24\xb9\x49\x92\x4c\x0d\xdd\xf4\xf0\xf2\xfb\x06\xe3\xf3\xd2\xf5\xb4\x8b\xc6\x24\x12\xd6\xf3\x3e\xaf\xd7\x99\xa5\x63\x2f\xcf\x52\xb0\xd3\x17\xe8\xdd\x56\xf0\xbd\xb1\xf4\xcb\xd4\xcd\xf4\x5e\xc7\x0f\xe1\x36\x60\x85\x91\x57\x4f\xe9\x68\x46\x67\x8c\x3e\xb5\x65\x36\x58\x1f\xdc\x8a\xe8\xc5\xd6\x33\x3b\xff\x48\x04\x5d\x1f\xd2\x9a\x3e\x87\x38\x46\xb5\xe6\x82\xa0\xde\xa4\xe2\x3a\x61\x0d\x16\x22\xc4\x3e\x20\x16\x59\xba\xad\xcc\x5d\x3d\xec\x5c\x24\xf1\xd0\xbd\xe0\xed\x00\x85\x72\xec\x85\x0b\x22\x04\x17\x89\xe3\x04\x43\xd0\xcb\x14\x97\xb0\xe9\xa9\x6b\x4c\xbb\x21\xf7\x71\xa3\x89\x34\x51\x54\xf5\xb2\x2d\x61\xbd\xb3\x79\xdd\xd1\x76\x34\x73\x34\x13\x57\xc1\x54\xab\xc3\x3e\x06\x27\x65\x00\xd0\x92\x62\xcb\x53\x71\xf8\x5a\x37\x41\x1d\x5d\xd7\xdd\x22\x77\x58\x20\x2a\xaf\x4a\xfc\xfa\x37\x2c\xe1\x26\x80\x4d\x42\x2f\xf3\xe2\x8f\x8e\x70\x39\xa8\x2d\x17\x74\x2c\xaf\xde\x7d\x20\xaf\x1a\xf7\x3e\xaf\xba\xbe\x8b\xb3\x09\xb7\xce\xb3\x67\xe8\x0a\x42\xdc\xff\x7c\x8e\x7e\x34\x01\x74\xcf\x7a\x7c\xd4\x72\xb4\x37\x5d\xeb\xe9\x83\xe8\xd4\x12\xac\x82\x08\xe1\xf7\xf1\x85\xc0\x70\x27\x00\x33\xd1\x86\x27\x77\xf4\x2f\xe5\x8e\x0c\x9d\x19\x28\xa5\x57\xc5\xb7\xbc\x6b\x65\x88\x1b\xd6\xfc\x01\x13\x00\x70\x43\xd5\xb7\xef\x7e\x7d\xf2\x04\x1a\x5b\xb2\x38\x02\x8b\x51\x78\x24\xa8\x71\x14\x1a\xfa\x03\x81\xe6\xc9\x56\xa5\xf7\x6e\xfa\x97\xfa\xb7\x53\xec\x01\x9d\x72\xbc\x75\x4e\x81\x1e\xc8\x2d\x32\xd9\x7e\x86\x2e\x6d\x1a\x4a\x95\x73\x80\x2e\xe7\x95\x1a\x73\x89\xc6\xe2\x89\xfe\xa9\x72\xcf\x07\x55\x19\xc9\x67\x03\x20\x9c\xe7\xcf\xbc\xfd\x54\xa0\x72\x85\x70\xc0\xa4\x2c\x34\x1e\x20\x75\xf2\xfe\x4b\x94\x48\x25\xf2\x5b\xa7\x71\x3f\xf5\x6a\xaf\xcb\xe5\x99\xe1\x53\x82\x3b\xe5\x94\xb6\x56\x82\xdd\x54\x95\xf4\xbe\xec\x0e\x47\x04\xd5\x9f\x98\xbf\x80\x1a\x2e\xa3\x54\xfc\xb2\x55\x99\x54\xd1\x06\x7c\xb3\x65\x4b\x20\x17\x74\xb6\x16\x7c\x77\xae\xc1\x53\xc0\x6c\x2c\xce\x84\x0c\xb7\x03\x77\x72\x9a\xa2\xee\x3c\x07\x3c\x09\xcf\x67\x97\x66\x6c\xbe\x07\x7a\xfe\xf4\x04\x87\xc7\x59\x38\x2d\xf7\x47\x3d\x02\x59\xc4\xa0\xdf\xd8\x1a\x47\xde\x73\x8d\x18\x17\xde\x4d\x22\x21\x5f\x43\x89\x41\xaa\x3d\x17\x6a\x7b\x58\x98\x72\x5a\x70\x3b\x24\xad\xec\x69\x5d\x45\xf9\xf8\xe1\xde\xc4\x6a\xd0\xea\xd7\x81\x1b\x77\x45\xdf\x1d\xbc\x95\x43\x15\xb8\x7d\x9f\xf5\x5c\x82\xe1\xd2\x52\x97\x4c\x43\x0e\xb0\x00\xf0\x75\x0e\x58\x60\xa6\x48\xe4\x4a\xb6\x53\x28\x9e\x2d\xc5\x79\x54\x31\x28\x09\x2b\xb2\x75\xe5\x47\xc2\x62\x28\x83\xa0\x92\x21\xbd\x3d\x3e\x54\xe2\xfd\x44\x18\x85\x2f\x54\xab\x03\x38\xc0\x40\x21\x31\x26\xa4\xa5\xd5\xe6\x29\xc3\x5d\xac\xd5\x03\x02\xfc\xd2\xca\x8b\xe1\xa6\x89\x82\x65\x49\x34\xc8\x34\xa1\xcd\xa5\xaa\xa5\xbe\x39\x94\x04\x09\xd0\x04\x9f\x63\x6b\x95\xea\x02\xa7\x05\xc1\x1f\xaa\x0a\xaa\xb8\x2a\xc3\xeb\x77\x41\x35\x5c\x1d\x22\x6f\xa7\x36\xd0\x93\x05\xc5\xc6\xf8\x8b\xc0\xf2\x77\xd8\x93\x0f\x24\x99\xa5\x19\xcb\x2e\xd0\x94\x5b\xfb\x76\x33\xc9\x98\xc5\xcd\xa5\x53\x61\x36\x16\x8e\xfb\x72\x50\x81\x79\x6f\xa6\x98\x9f\x00\xb2\x0a\x50\x82\x83\x20\x73\x5f\xd6\x01\x75\xf4\xf6\x56\xe0\x56\x85\xbb\xcf\x7b\xfb\x0c\xd1\xc4\x11\x73\xdb\xf9\x12\x1c\x7d\xc8\xd7\x34\x7c\xad\x37\xff\x7b\xcc\xf0\x86\x44\xc5\x84\x42\x3c\x1d\x58\xe1\x8e\x5b\xa9\x56\xf1\x2f\xd2\x75\xf0\x01\xd8\x1a\x07\xb8\xef\x09\x43\x72\x80\xa9\xd6\x43\xd7\x1d\x4a\xb6\x29\xfd\xe4\x26\x6d\xcd\xf8\x8d\x37\x44\x5d\x9a\x9a\x80\xb3\xe0\x1a\x38\x73\xea\xdf\x7c\x99\xe4\xe1\x19\xc6\xfa\xfc\xc9\xa7\xfc\x20\xf3\x3a\x54\xf7\x2f\xea\x0a\xc5\xb1\x7e\xbe\x36\x56\x72\x1e\x5f\x2e\xf4\xe4\x28\xe0\x33\x31\x07\x59\xc6\x0e\xe4\xae\xf6\xac\xbb\x50\x9c\x02\x0f\x9c\x4f\x59\xd3\xeb\x97\xb2\xbe\xf1\xcf\x4b\xb1\x79\x48\xbe\x4c\xe7\x56\x10\xa5\x1a\x55\x6c\xc9\x1c\x3b\xff\x83\xa8\x6f\xc0\x65\x0d\x38\xb8\xe3\x77\xb8\xcb\x6e\x48\xf9\xca\x56\x21\xff\xc8\x23\x7d\x59\x4e\x26\x78\xba\x53\x1f\x8b\xee\xe1\x97\xa8\x87\x2c\xa1\x5c\x71\x25\x57\xa0\xee\x12\xc2\x2a\x29\x56\x7e\xfc\x5c\xf9\xc8\x37\xfd\x43\xec\x4b\x58\x71\xa1\x7c\x90\x21\x54\x28\xd5\x64\xa6\x85\xa1\x57\x92\x42\xa0\x10\xe2\x87\x7b\xdd\xfa\xe0\x8a\x45\x53\x55\x08\xb5\x3d\x14\x4d\x0b\xd7\x62\x5d\x08\x82\x0a\xa9\xac\xb2\x5e\xbf\x18\xec\xf3\xaa\x75\x43\x3f\xbd\xcd\x14\x57\x5e\x02\xfb\x32\x8c\x11\x33\x5c\x20\x7b\x93\x60\x23\x88\xa9\xdb\x67\xf5\xba\x35\x17\xa5\xd0\x3d\xa0\x01\x6a\xf0\xc1\x4d\xec\x74\x0d\xda\xc8\x17\x44\x42\x5d\xf0\x90\x81\x34\x1a\x3f\xf8\x0e\x95\x71\xa1\x67\xcf\xd0\x5b\x7c\xc8\x75\xce\x5a\x13\x82\x9b\x6d\x14\xbe\x39\xd8\x6a\x2c\xd5\x8a\x60\xcb\x47\x05\x5a\xc5\x97\xb4\x63\xd7\xed\xc8\x65\x6d\x9f\xc3\x12\xbc\x6d\xb5\x1b\xd4\x75\x53\x17\x02\x6f\x66\x25\x57\xc3\xb4\x61\x10\x2e\x31\xd7\x35\x79\x3f\x63\xae\x4c\x87\xf1\xeb\x1d\xe9\x1a\xcd\xf2\xb3\xb1\x81\xb9\x29\x03\xbd\xec\x52\x2f\x3a\xe6\x3e\x95\xec\x18\x44\x3a\x59\x0b\xf9\x20\x97\x1d\x39\x5a\xd3\x31\x01\x31\x1e\x2d\xf0\x38\x05\xb3\x3c\x37\xe7\x24\x4d\xa5\xd8\xb4\x71\x8e\x7d\x99\x92\xf2\x86\x5b\xfa\x75\x51\x06\x6f\x26\x1c\x53\x24\x2e\x6d\x2d\x9d\x9e\x53\xa8\x8e\x6f\x8a\xe1\x07\x2b\x63\x0f\x55\x7e\x1b\x48\x94\x80\x0b\x1d\x2e\x00\xbd\x30\xf9\x1b\x71\x69\x3c\x53\xbe\x10\x72\xde\xb6\xf8\x8e\xe4\x53\xc1\xe5\x74\x30\xae\x24\xea\xc8\x5a\x2d\xec\x55\x03\x77\xe9\x3a\x98\x03\xa6\x40\x17\x46\x8c\x3f\xe5\xbd\x61\xe7\xb6\xe6\xb6\xcd\x9b\xde\xf5\xea\x60\x22\x29\x8f\xa6\x60\x7b\x36\x86\xc9\x25\x1c\xb2\x7c\x66\x2a\x7f\xfe\x2c\x66\x93\x81\xe5\xf2\x83\x33\x36\x02\xa5\x9d\x26\x4a\xa9\xa2\x0a\x7f\xd3\x64\x38\x1d\x36\x38\xbb\x40\xdf\x2e\xbf\xad\xd1\xe4\xa9\x4e\x7f\xaf\x88\x4d\xe1\xfe\x89\x79\xc9\x0f\xcb\x49\xfe\xe2\x7c\xe4\xcf\xc8\x45\x7e\xa0\x92\xf4\xc0\x1c\xe4\x07\xe8\x53\x5f\x2d\xf7\xf8\x33\xf2\x8e\x1f\x9c\x73\xfc\xc5\xf9\xc6\x35\xc4\x2f\xbf\x2b\xfb\x9d\x9a\x76\x9c\x91\xbf\xf5\xf3\x3c\x7f\xca\xd6\x71\x31\x4b\xff\xa7\xbb\x21\x02\x69\x3c\x83\xe2\x3b\xec\x92\xc9\xac\xdd\x77\x85\x5b\x30\x94\xc4\xc0\xa0\x3a\x7d\x79\xf5\x24\x78\x0d\x46\xef\xa0\x5c\xe1\x5e\x0d\x02\xa2\x4c\x92\xe1\x5e\x6e\x39\x68\x1a\xb7\x24\x7a\xf8\x83\x12\xf0\xaf\x2b\xcf\x37\x20\x93\x84\xee\x46\xd2\x0f\xcd\xfd\x12\x47\x2e\x2f\xdd\xbc\x29\x25\x8e\xd6\xb5\x76\x39\x3c\x25\x39\x4e\x95\xb9\x46\x79\xf4\x7d\x94\x26\xb3\xb2\x91\x79\xc7\x11\xb2\xf4\x24\x69\xaa\x6f\xe5\xbd\x4a\x8f\xc2\x64\x81\xb1\x4a\xf7\x92\x56\x93\xba\x63\x95\x1e\x79\xfb\x3a\xb1\xd6\xd7\x7b\x24\xdf\xa1\x32\x70\x5c\x32\xb1\x3a\x5a\x49\xc5\x45\xc9\xb2\xbc\x5f\x9d\x96\xc7\x4a\x99\x15\xbd\x27\x09\x7a\x52\x8a\x55\x74\x9f\xb4\x34\x5a\x3e\x57\x46\xcd\xf3\x87\xd7\xcd\x2d\x8b\xd1\x7d\xfd\xbb\x7a\xd1\xc5\xc1\x17\x23\xe8\xfb\x0b\xac\x7d\xf7\xa5\xb7\xf2\x7e\x69\x95\xf4\xe2\x66\x49\xc5\x23\xfb\x16\xd5\x9a\x8b\x1d\x4e\xb2\x38\x51\x96\xb3\x81\x2e\xb2\xc4\xf6\xaa\xe7\xd1\x00\xfc\xb8\x56\x70\x4c\x11\x78\x98\xec\x3f\x59\xdc\x9b\x33\x77\x7f\x4d\x29\x56\x20\xe3\x27\x75\xa8\x70\x64\x0f\x11\xff\xc7\x25\xbe\x3b\xc5\xe3\xc2\x3a\x39\xc8\xc8\x09\x5b\xa9\x53\x5d\x25\x6d\x53\xfc\xae\xfc\xbe\xec\x3e\x92\xcc\x70\x31\x42\x27\x65\xc1\x39\x1b\x37\xb0\x85\x49\xc2\xc5\x1c\x93\xf2\xe5\x6b\x49\x15\xf6\x43\x5a\x80\x4d\x5b\x0e\x27\x97\x5f\x33\xbd\x6d\x1c\xc4\xba\x6c\x21\x95\x03\xae\xe6\xfb\x14\x76\x77\x3b\x32\xe2\x97\xd2\xbf\xf5\xa3\x0d\xc0\x1d\xd9\xad\x88\x18\xbb\xa4\x18\x27\xfb\x9e\x96\xe9\x7b\x92\x69\xec\x87\xfc\x7c\x93\x38\xa9\x13\x17\x76\xe7\xa8\x78\xe1\xb1\xc6\xad\x35\x62\xf4\x51\xce\xcb\xd9\xe3\x12\xeb\xa2\xb4\x23\x3d\x00\xdc\x31\x97\xa1\x82\xb4\x0f\x34\x99\x57\x96\xe2\x2a\x4f\x4d\x87\x85\xcd\x77\x3c\x72\xd6\xe6\xa0\x71\x9c\x52\x47\x77\xe4\xb7\xbe\x84\x38\xbc\x27\x26\xba\xc3\xd3\x35\xa6\x1d\x84\xda\x5a\x28\x56\xe9\xb2\xd2\xec\x0b\x4e\xf5\x08\x65\xb8\x1f\x75\x36\x9d\x53\x5d\xc0\xfd\xb8\xb7\xdb\xc0\xdd\x17\xad\x18\x0d\x77\xf8\xd0\x08\x80\xcf\x41\x2f\xca\x4a\x72\x60\x7c\x7c\x7a\xdc\xe7\x67\x89\x95\x1d\x41\xa4\xb3\x22\x84\x66\x88\xd2\xbf\x74\x38\x1d\x36\xb3\xa9\x03\x7c\x1d\x95\xcd\xae\xc5\xcd\x4e\x89\x97\x8d\x85\xcb\x7c\x45\x3e\x64\x95\xa3\xb0\x4f\x1b\x72\xf0\xbf\x24\x45\xf9\xe0\x22\x5f\x6b\xaa\xf2\x41\x42\xfa\x8e\xdf\xe9\x7f\x78\xaf\xb8\x7d\x8e\x11\x17\x35\xef\x5d\x95\x05\xd6\xda\xab\x96\x9a\xc5\x58\x13\x29\xb4\xfd\x46\x9a\x24\x4c\xd7\x05\xfe\x7f\xa4\x96\x5f\xb1\xf4\xac\xb8\x4b\x53\x3c\x13\xe7\xb7\x16\x0a\xa0\x44\xef\x01\x98\x74\x53\xc5\x6d\x47\xd8\x28\x65\x50\xf3\x2f\x3c\x29\x19\x0d\x93\x5b\x57\xc9\xb3\x74\x26\x5b\x3e\x59\xc2\x2f\x58\xc5\xfc\x3b\xd3\x22\x7f\x56\x1d\xb1\x5e\x6c\x3c\xa9\x06\xf0\x10\x64\xb2\x41\x52\xcc\x0e\x86\x07\xf8\x4b\x23\xa6\x2e\x4c\xe5\x25\x85\x0a\x6a\x25\xef\x18\x1a\xd4\x4a\xcb\x13\x8c\xda\xeb\xf3\x71\x82\x9f\x2a\xc2\xa9\x35\x03\xa8\x7c\xef\xcb\xa9\x33\x57\xd5\x32\x26\x7b\xe3\xad\xb5\x72\x09\x5c\xa4\xf0\xea\xe3\x1d\xf5\xd5\x39\x65\xc4\x04\x12\xee\xf0\xa0\xb2\x9d\xf9\xa2\x23\x4a\x1f\xbb\x59\x19\x85\x3a\xe7\xe7\xe8\xbd\x81\xc6\x87\x6a\x37\x77\x71\xb5\x12\x25\x4d\xc9\x26\x23\x95\x38\x98\x69\xa1\x7d\x64\x26\xbf\x40\x03\xca\xe3\xa7\x77\x8e\x9e\x7c\x4a\x2a\x5a\x15\x97\xbc\x21\xef\xa6\x76\x65\x4a\x0e\x9d\xb2\xf1\x16\xf4\x5f\xff\x65\xbf\x38\x4b\x05\xa3\xfe\xeb\xf9\xf7\x76\x82\x17\xb3\xb1\xa4\xff\xb4\xc4\x2e\x86\xd2\x58\x23\x32\xf2\x6c\x2c\x8d\xfe\x7e\x14\xf4\x36\x54\xfa\xca\xdc\x05\x70\x78\x03\xde\xf9\x6b\xd6\x92\x8f\x01\xde\x8a\x27\x5f\xcc\xf3\x21\xd3\x6a\x25\x13\x21\xd8\x91\xfe\x05\x12\xbd\xb2\x35\x83\x4e\xc4\x88\x53\x10\xcd\xae\xca\xa7\xfc\xfb\xfd\x3e\x70\x99\xd1\x58\xf1\x65\xbc\x63\xe3\x54\x39\x41\xe0\x01\xf5\x52\x70\x54\xc9\xc0\xd0\x76\x20\x6c\x11\xf6\x45\x83\x52\x66\x60\x6e\x3e\x43\x5e\x97\x26\x5f\xdc\x28\x5f\xb2\x6c\x17\xf3\x02\x2e\x5a\x33\xde\x5f\x06\xe2\x63\xa9\xce\xc2\x8e\xb8\x8a\xb7\xe9\xa4\xb7\x9a\x0e\xe1\x0d\xac\x13\xb8\x48\xc4\x94\xcf\x4b\x9d\x61\x31\xc5\x5b\xe0\x6e\xa8\xbb\x3d\xe2\x1c\xa5\xc6\x81\xfa\xf9\xae\xd5\x53\xfc\xa4\x49\x56\xc4\xbc\x7c\x85\xaa\xa5\x26\x28\x66\x1e\xd1\x70\xfe\x64\xdd\x0b\xd2\xce\x72\x07\xf3\x54\x69\xaa\x3b\xec\x33\x1e\xe4\x39\xfa\xfe\x93\x59\x88\xbf\xbb\x7f\x9f\xcc\xfd\x32\xcc\xab\x38\xba\x25\xa4\x47\x7a\x98\xdb\xa8\x58\x00\xd2\x6b\x30\x1a\xef\xce\x9e\x5b\xb5\x10\x46\xa9\xb5\xa2\xa7\x2f\x0c\x39\xe9\x3f\xde\x77\xb9\x93\xfc\xfa\x65\x41\x54\xe5\x2e\x48\xab\x67\x3c\x47\x9f\x8c\x62\x70\x8e\xec\x7e\x50\xa0\xcc\xfb\xfc\x62\x30\xa8\x78\xa9\xd7\x3d\x28\x80\x50\x7a\x5a\x6e\x43\xb9\x69\x57\x05\x31\xb1\xd1\x63\x91\xfd\xab\x3a\xf8\x8f\xa4\x0e\x06\x7d\x30\x13\xb2\xb9\xcd\xde\x41\x86\x12\x61\x21\xdb\x33\x4f\xfe\xa3\xac\x25\xa4\x35\x85\xe5\x9c\xc3\x20\x7e\xdc\xaa\xb0\xcf\x83\xe1\x6b\x92\xe0\x1e\xe0\x4d\x41\x93\x06\x7a\x5c\xb8\x2a\x46\xc9\x73\x74\xe5\x1e\x94\x0e\xc2\xde\x3b\x45\x92\xdc\xbf\xcc\xbf\x61\x4a\x7a\xea\x61\x43\x35\x74\x67\xf6\x56\x72\xd7\xd8\x5a\x99\x2d\x25\x5b\x8c\x1c\x10\x0f\xf6\x39\x3c\x68\x4b\xc5\x4e\x5a\x22\xb5\xee\x91\xf9\x2a\xcc\x22\x2a\x5e\x9f\x6b\x53\x60\x01\xb8\x1e\x51\x56\x3f\x46\x58\xac\xa8\x12\x9a\x37\x9a\x22\x13\xf6\x61\x1e\x97\x84\x06\xaf\x70\x44\x45\x53\xa1\xbe\xa6\xaa\x3d\x34\x66\x67\xb1\xa9\x54\xf6\xdd\x46\xa5\xa5\x30\xe9\xd6\x25\xf2\x69\x80\x6a\xd6\x6f\x30\x44\xc3\xb1\x48\xaa\xd3\x4d\xbc\xfb\xe0\xa9\x33\x70\xab\x8c\x08\x8d\x33\xa3\xea\xd7\x13\xf1\xf1\xe3\xce\xef\x7a\x50\xff\x6f\xeb\xf9\x1e\xe3\x4d\xf5\xef\x8f\x7a\xce\xc7\x73\x17\xfe\x26\x6e\x73\xf7\xd7\x18\x7a\x04\x9d\x04\x5d\x78\xc1\x3d\x85\x4f\xee\xf5\x07\xd7\x36\xb9\xeb\x5c\x3e\x09\x81\x6c\x86\x91\x7d\x88\x83\x91\x60\x62\x3a\x5a\xf4\x3a\x46\xe9\xb1\xe4\x9d\xd3\x97\x35\x1a\x03\x6c\x9d\xee\xf2\xbe\xd8\xc0\x07\xdd\x26\x7f\x60\xcc\xce\x1f\x1e\x74\x8c\x86\x34\xaf\x3c\x98\xe2\xb4\xe6\xae\x29\x3c\x53\x8e\xf6\xc4\x5e\x73\xe3\x68\x8b\x59\xdb\x11\x78\x5c\x2b\x19\x32\xaa\x31\x39\x7b\xfe\x34\x8c\x59\xc9\xad\xb2\x3b\xbf\x29\xd6\x7b\xe3\x1c\x7d\xa0\x52\x91\xd6\xab\x65\x95\xeb\xc3\xb8\x6d\x73\x73\x15\xd8\x95\x57\xa6\xce\x2b\x0a\xd6\x22\xa5\xac\x5a\x90\xbf\xf8\xaa\xdc\xc0\xab\x8f\xa6\xd0\x97\x8a\x9e\x84\x85\x12\xc4\x90\x1f\x99\x54\xfe\x0c\x5e\x74\xcd\x04\x29\x6b\xba\x41\xda\x47\x8e\x7d\xa5\xcb\xc2\xf9\xad\xed\xd5\x91\x28\x8b\x29\x0a\xc4\xfb\xf4\xc2\xd9\xcc\xe6\xbf\xda\x1c\x52\x5b\xf6\x69\x5e\x66\x8a\xe2\xd1\x62\x4b\xf1\xf3\xe4\xf5\x1c\x2a\x78\x9c\xd5\xf4\xf7\xbd\x46\xa3\x3e\x15\x7b\x1c\xe6\x37\x53\x90\x78\x3a\x74\x81\xde\x7f\x28\x5a\x47\x97\x4f\x8b\x49\xeb\x79\x8d\x7e\xec\x25\x24\xd9\xb7\xb3\xe8\xee\xe4\x29\x37\xca\xc6\x61\x83\x2e\xc2\xe0\x99\x6d\x9f\x2a\x50\x51\x7e\x57\xe5\x7d\x7c\xf7\xa9\xbc\x93\x5f\xc9\xe8\x2f\xb9\xda\x29\xc8\xfa\x77\x27\x8b\x6c\xde\x46\xcc\x4a\xff\x7a\x72\xa2\xfa\x4c\xff\x38\x1e\x7c\x49\x7c\xd6\x26\x53\x15\x07\x16\x79\x87\x12\x03\xac\xc2\xde\x92\xdf\xff\x8d\x74\x7d\x14\x89\x4c\x9c\x15\x8a\xeb\xee\x95\x2b\x30\x7c\x6d\x35\x2f\xb6\x56\xc6\x1b\xb9\xc3\x7d\x6a\x94\x4e\x3b\x94\x4e\x60\xba\xc6\x00\x19\x73\x14\x56\x7c\x32\x39\x39\xfb\x52\xaf\xc1\x9a\x5d\xba\xd8\xf0\xbf\x93\x43\x3a\x5f\x2d\x67\x33\xef\x6c\xcc\xdb\xd9\x2d\x31\x0a\xdc\x75\x24\x0d\x3e\xd9\x55\x56\x44\xe7\x7d\x76\x37\x61\x2c\xa5\xba\xb2\xdc\xf7\xc9\x2c\x1f\xce\x8a\xe5\x8f\x56\x5a\x3c\x36\x50\xbe\x95\x97\x8b\xda\xd2\x2b\xdc\x6e\x2a\x21\xfc\xc8\xa4\xf0\xef\x97\x1f\xce\x1c\x5b\xad\x08\xc8\x72\xba\x51\x17\x68\x25\xa4\xf0\x25\x78\x6e\x63\x0b\x47\x50\x1d\x84\xf3\x83\x91\xbd\xbe\xba\xaf\x8d\xef\xe0\x61\xf3\xb7\xc8\x7c\x4a\xa3\x7b\x7b\x2f\x9c\xcb\xa4\x5a\xe8\xfc\xf0\xac\x25\x1f\x9d\x0b\xed\x84\x23\x85\xab\x2b\xd0\x69\xc6\xd7\x35\xdd\x27\xb2\x37\x6d\xb9\x83\xb8\x32\x8f\xb6\xe7\x3f\x66\xc6\xed\xa9\x53\x1b\xe8\xce\xb0\x3a\x4f\x16\x9f\x07\x6a\x51\xac\xa9\x4f\x87\xa1\x2c\x18\x83\x9f\xca\xd4\x5e\xb0\x25\xb8\x0f\x24\x2f\xc3\x1d\x3d\x70\x98\xa6\x29\xb8\xca\xd8\xf9\xe3\xfb\xd7\x2a\x79\x29\x9b\x7c\x24\xcd\xa0\xc2\x35\xc7\x32\x1a\xeb\x3d\x77\xe6\x5d\xff\xaa\xb3\xec\xcb\xe3\x5b\x19\x42\x65\x96\x6e\x62\x22\x38\xa8\x03\x03\x39\x81\x90\x4f\xf2\x36\x24\xeb\xf4\xc9\x01\x96\x32\xcb\x7a\x50\x15\x24\xf3\x79\x37\xca\x97\x28\x4a\x12\x21\x82\xc6\x74\x56\x71\xa5\x74\x49\x2a\xda\x88\x21\x56\xe2\xe8\x69\xe4\x9d\x0e\x5e\xcb\xa3\x75\x94\x5f\xb6\x3c\xdd\xc2\xc8\x18\x42\x78\x71\x0f\x54\x4a\x5c\x96\xe0\xaf\x18\x0e\xa9\x01\xd6\x39\xeb\x2b\x90\x53\x82\xcb\x49\x7c\x30\x23\x23\x53\x6a\x0f\xfc\x39\x02\x1f\x5c\x26\x47\x91\xb3\x7d\xfd\x32\x7a\x14\xa1\x48\xf8\x99\x42\xf6\x13\x43\x95\x13\x45\x5d\x3d\x42\xdf\x92\xc3\x54\xd1\xdc\x32\x7c\x75\x74\xaf\x0e\x63\xf5\xf6\xec\xd6\x8d\x24\xb9\xb1\x87\x7f\x63\x4a\x53\xc2\x01\xdf\x3c\xac\x32\xf0\xe7\x47\xd3\xca\xd2\x92\x15\x46\x9b\xa1\xe6\x87\x28\xf8\x79\x42\x6b\xc7\x9b\x5d\xaf\xd1\x2a\x94\x99\xb9\x76\x3f\x2a\x8d\x5e\xca\xba\x2c\x1a\x9b\xf9\x6c\x42\x65\x8e\x8f\x2e\x3b\xe7\xb1\x80\x62\xd2\xe8\xd2\xbd\xe9\xe4\x82\x73\x2e\x2d\xc1\x56\x27\x0c\x62\xa1\x74\x3a\x4c\x95\x25\xff\x8c\x68\x66\x1e\xbf\x1e\xaf\xce\x38\xe5\xda\x31\xd9\x80\x27\x16\x85\x2b\x1f\x6a\x28\x16\xec\x39\xb7\x6d\x00\x31\x6e\x60\xc3\x84\xa9\x93\xf8\x78\xa5\x9c\x0b\xec\xe2\x68\xa8\xbf\x32\x54\xea\x45\x4b\xaa\x53\x2a\x31\x9c\x5a\xb3\xf0\x73\xb6\xfd\x50\x89\x55\x7f\xe3\x43\xeb\x1b\xa3\x99\x00\xe8\xcb\xc4\xf4\xd9\x3f\xa2\x08\xfc\x32\x59\x56\xc9\x5c\x79\x10\xa3\xf7\x72\xcc\x5b\x12\x5f\x87\xf9\xff\x2c\x89\x35\x19\xb7\x88\xe2\xd7\x11\xc7\x3d\x22\x6d\x9c\x37\x28\x71\xef\x94\xae\xce\x3f\x68\x5d\xd7\x32\xe2\x08\x34\x34\x06\x4d\x54\x2d\x40\x22\xdc\xa8\x01\xee\x80\x59\x17\xee\x6f\x11\x77\xd7\x3e\xf3\xb1\xe1\x86\xbc\xcd\xf9\xdc\x5a\xf7\x77\x4b\x82\x29\x07\x59\xbf\x52\x95\x6f\x24\xf8\xed\x78\xc3\x7a\x16\x2a\x0a\x54\x58\xa9\xa6\x10\x6a\x2d\xa3\xa8\x73\xdd\xf8\x29\xe8\x0b\xc5\x35\x1b\x64\x6c\xb7\xd0\xd4\x60\x71\x9f\x71\xb9\x85\xc6\xaa\x30\x47\x12\xb5\x2e\xdb\xb2\xd4\xa0\xa4\xc9\x95\x65\x5c\xe0\x50\x36\xed\x42\xd9\x78\x6d\xc5\x10\x81\x8d\x4d\x7c\x47\x44\x9a\x58\x2c\xe0\x49\x1e\xbe\x36\x5b\x21\x0f\x13\x79\x9f\x91\xae\x74\x92\xe4\xf3\xe3\xa0\xe7\x17\x6e\x84\x53\x8b\x9f\x96\x0c\x3f\x5b\xe5\x39\x7a\x0d\x8f\x05\xc2\xa0\x50\xc7\x68\x45\x50\x67\x2b\xf5\x31\xc4\x05\x22\x7f\x19\x70\x17\x1e\x45\x47\x30\x7f\x45\xb4\x5d\x33\x35\x73\xab\x7b\x1a\x16\x3d\x47\xcf\x3d\x01\xc6\x6a\xed\xb2\x23\x6c\xa3\xb6\x5f\x71\x23\x6f\xdd\x29\xfa\x44\x11\x38\x4d\x2a\x11\x1f\x20\x2d\x69\xc5\x07\xd6\xe6\x45\x73\xee\x0b\xde\xe1\x48\xc3\x6f\x61\x4c\xbc\xc8\x94\xbd\xc4\xbb\x4b\xba\x98\x67\x01\x69\x7e\x84\xd5\xb2\xac\xef\x1c\x13\xc0\xa8\xe7\x52\x52\xf7\x24\x1f\x44\xd3\xf9\xce\x0d\xb3\xc3\x87\x50\x42\x4f\xd5\x5e\x85\x8e\xc6\x74\xc2\xd9\x3d\xb4\x49\x43\xb0\x7a\x8d\x69\x57\xa9\x21\xb2\x40\xaf\xc0\x98\xa7\x9c\xc1\xf5\x10\xa8\x59\xc7\xc8\x47\xc7\x37\x78\xe5\x2d\x1b\xf7\xa9\x79\x04\x63\xe1\x7e\xf1\x30\x25\x48\x03\xf9\x3d\xcc\xfa\x61\xe2\x3d\x96\x6a\xac\xd1\x16\x37\x7e\x7e\x71\x4a\x75\xe3\x89\x02\x14\xb0\xde\xe3\xe5\x5b\xe2\x95\x56\xc7\x3a\xf6\x6c\x0b\xec\xc5\x22\x9f\xf9\xff\x3f\xa1\x7f\x1e\xf3\x1c\xa2\xda\xbb\x47\x3f\xe2\x8e\x5c\x2b\x9b\x56\x87\x72\x59\x8f\xb4\x66\xff\x14\xdc\x35\x60\x84\x59\x81\xee\x3a\x65\x15\xa1\x22\x31\xb6\xb6\x75\xca\xac\x78\x71\x2e\x1e\x3a\xfa\xc0\xe8\xb4\xe1\xf7\xf5\x52\x5d\xad\xb8\x78\x72\xcc\x38\x38\x22\x4d\xaa\x6e\x33\x16\x12\xb3\xff\x64\x9f\x36\x0d\x50\x81\x0b\xda\x5e\x32\xc3\x13\x50\xc0\x8d\x92\x91\x40\x59\xf0\xcf\x20\x41\x09\x7e\xf3\xfe\x8c\xe2\xce\x59\x96\xfb\xd4\xa6\x3d\xb0\xc7\xf3\x57\x7f\x11\xa6\x54\x9c\x61\xb3\xa6\xac\x4d\x4d\x08\xda\x56\x4d\x88\x5f\x6d\x83\xbf\x8a\x6d\x50\x4b\x10\x2e\xfd\x02\x91\x5b\xc0\x99\x81\xe6\xa1\x9d\xae\xd6\x25\xba\xf2\xd3\x75\xf6\x65\x9e\xac\x3d\x38\x68\x34\xa2\xb4\xd5\x97\xc7\x7c\x21\x76\xde\xa5\x24\xe4\x32\xc7\x30\x3b\xec\xb8\x20\xe3\x8f\xfb\x7c\x0f\xcf\xeb\x56\x72\x56\x4c\x8a\x55\x1c\x4b\xf0\x0e\x3b\xf3\x10\xae\xa9\x44\xb7\x21\xce\x45\xdb\x5a\x7e\x57\xdb\xc7\xf8\x2b\x18\x0f\x4c\xbc\xfe\x45\xd0\x69\xb2\xd8\x9f\x93\x68\xa3\x97\x5e\x3e\xc7\xf7\x51\x0c\xdb\xe6\xb4\xeb\xaa\xda\x55\xc9\x77\xec\x52\xe0\xd9\x09\x95\x5d\xc6\x6f\x00\x1e\x07\xe7\x84\x1b\x65\x04\x9c\xb1\x1b\x85\xca\xd2\x15\xf7\x59\x35\x2f\x23\x10\xe5\xaf\xf4\xfc\xc2\xf6\x0b\x65\x92\x53\x82\x9b\xdc\xf1\x57\x13\x0b\xbe\x52\x66\x64\xd2\xdb\xf7\x01\xfc\xbd\x46\x63\x27\xd2\x1d\x16\x07\x13\x28\x8b\x22\xea\xf9\x60\x75\x0f\x87\x7d\x65\x3c\xb7\xd1\xa3\x55\xdd\x20\xac\xd5\x34\x73\x61\xf7\x7f\xff\xfb\x7f\x9c\x5f\xc1\xce\x9b\x4f\x13\xa1\x88\x2d\xf0\x97\x2d\x2c\x7e\x4e\xed\xfd\x07\x13\xcb\x0b\x3b\x84\x52\xbc\x44\xdb\xc0\x70\x66\xa4\x45\x43\x5f\x46\x52\xab\x45\x25\xdd\x13\x26\x53\x6e\x9c\xfc\x76\xad\x13\x7c\x6d\x55\xe2\x9d\x16\x08\x7a\xc3\xf7\x71\x8d\xd1\x04\x55\xcc\x85\x51\x44\x98\x3a\xe1\x84\x5e\xdd\x11\x71\x30\xae\x18\x5f\x3d\xb2\xc7\x6a\x8b\x66\x49\xf4\x6e\x31\xea\xce\xf6\xbf\xd8\xcb\xca\x5a\x1b\xce\x27\xf1\x2f\x36\x5b\xb4\xb1\x0b\xfd\x6d\x55\x58\xc0\x8b\xd1\xba\x35\xe8\xe7\xb0\x16\x93\x8d\x68\x2b\x0a\x55\x0a\x35\x76\x04\x03\x6a\x62\xd4\x62\xb6\xe9\x42\xde\x38\x9c\xbd\x01\x04\x5e\x2b\xeb\xe4\xf0\x07\xf9\xd4\x93\x28\xe7\x7d\x25\x93\x70\x44\xd3\x29\x78\x45\xa6\xfa\x9c\x5a\x5a\x07\x85\x1c\xaa\x23\xd5\xac\x4e\x91\x0e\x13\xec\xe1\x67\x28\x12\x6a\x5f\x69\x4b\x81\xf6\x79\x15\x42\x4f\xb3\x29\xcb\x92\xa0\xf1\xbf\x1e\xa8\xfa\x71\xe6\xaa\xc8\x55\xd5\x28\x53\xf5\x26\x57\x4d\x12\xae\xaa\xd9\xec\xa7\xfb\xfa\xef\x16\xed\x2e\x74\x83\xca\x39\xd8\xab\x50\xb6\x40\x9f\x90\xe8\x96\x41\x8d\x5b\xac\x0a\xf8\x9b\x9a\x09\xc9\x18\x10\xf7\x0d\x22\xe8\xda\xdf\xb4\x6f\x67\x13\x05\xdf\x86\x81\xb6\x13\x37\xdd\x4d\xd2\x7b\xe5\xd2\x1b\x3c\xe6\x63\xaa\x24\x00\x79\x45\x17\xc6\xe0\xf6\x4f\xd3\x1d\xac\x02\x48\x57\xee\x95\xe0\xda\x8d\xb3\x70\xc3\x27\x8c\x30\x9b\x9f\xa3\xef\xa3\x01\x03\xb4\x7d\x7d\x31\x9b\x8c\x1f\xf7\x89\x97\x5e\x9e\x13\x6c\x36\x34\xd7\x7f\xe1\x0d\x79\xab\x79\xc8\x05\x7a\x26\xcd\x3f\x9f\x65\x12\x7d\xac\x77\x28\x68\xac\x3b\x9b\xfd\x56\xfb\xde\x3f\xba\x7f\xf4\xff\x02\x00\x00\xff\xff\xac\x4d\xe9\x20\xa3\xb2\x00\x00"
+var _nftstorefrontv2Cdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x7d\xdb\x92\x1b\xc9\x71\xe8\x3b\xbe\x22\x87\x0f\xbb\x80\x0c\x82\xb2\xe3\xc4\x79\x80\x88\x5d\x8d\x66\x48\x7b\xc2\x12\xb5\x41\xcd\x4a\x0f\x5c\x46\x4c\xa1\xbb\x00\x54\xb0\xd1\xdd\xea\xaa\x9e\x21\x4c\x4f\x84\x3f\xc2\x5f\xe8\x2f\x39\x91\x55\x59\xd7\xae\x06\x30\xe4\xf2\x78\x65\xcb\x43\x6b\x67\xba\xeb\x92\x95\x95\xf7\xcc\xaa\x16\xfb\xb6\xe9\x14\x3c\x7b\xdd\xd7\x5b\xb1\xae\xf8\x6d\xf3\x81\xd7\xcf\x26\xf6\xf1\x9b\xa6\x1e\x79\xf3\xbb\xbe\xab\x79\xf7\x6c\x32\x79\xf1\xe2\x05\xbc\x79\x7d\xfb\x27\xd5\x74\x7c\xd3\x35\xb5\xfa\xf3\x3f\xe1\x33\xfc\x7f\xb8\x84\x2d\xaf\x79\xc7\x2a\x68\xfb\xae\x6d\x24\x07\xc9\x2a\x0e\xb2\x6f\xf5\xf0\x45\x53\xab\x8e\x15\x0a\x36\x4d\x87\x63\x48\x50\x3b\xa6\x40\xec\xdb\x8a\xef\x79\xad\x40\xed\x38\xbc\xae\x9a\x07\x48\xe1\x00\xa9\x58\x5d\xb2\xae\x5c\xe8\x79\xf4\xff\xbc\x62\xc5\x0e\x58\x51\x34\xbd\xee\xc9\x14\x3c\xb0\x5a\x49\x50\x0d\x54\x42\x2a\x33\x01\xce\xa4\x61\x10\xb5\x54\xac\xaa\x24\x30\xf0\xa0\xcf\xf5\x40\xac\x2e\x75\x0f\x09\xa2\x2e\xc5\xbd\x28\x7b\x56\x69\xc0\x25\x3c\x08\xb5\x13\xb5\x19\xdd\x77\x03\x26\xe1\xf7\x42\x2a\x51\x6f\xa5\x01\xe8\x76\xc7\x3b\x0e\x42\x42\x53\xf3\xb0\x61\xcb\x3b\x0b\xe2\x1c\x84\x82\x1d\xab\xcb\x8a\x4b\x1a\xbd\xd9\x00\xab\x2a\x04\x14\xd4\xa1\xe5\x52\x0f\x85\x10\xeb\xf9\xa8\xdf\xc2\x61\x57\x2f\x98\xe6\x85\x82\xd5\xb0\x63\xf7\x5c\xcf\xd8\x74\xb0\x6f\x3a\x0e\xcf\x8a\x5e\xc9\x67\xd0\x6c\x34\x26\x71\x12\x68\x3b\x51\x70\xbd\x00\x1c\x07\xb6\x0d\xd7\x18\x0a\x7b\xb1\xb2\xec\xb8\x94\x5c\x2e\xe0\xaa\x57\x52\x0f\xbd\xe6\xd0\x4b\x5e\x62\xd3\x96\x1d\x34\x7a\x70\xd6\x0d\x27\x28\x9b\x0e\x1a\xb5\xe3\x1d\x14\x4d\x2d\x45\xc9\x3b\xa6\x44\x53\xcb\x05\xe4\x21\x15\x75\x51\xf5\x25\x07\x06\x45\xb3\xdf\x0b\x29\x45\x53\x03\xdb\xfb\xad\x13\x12\x5a\x26\xf4\x7c\x0f\xbb\x86\xdf\xf3\x0e\x36\xac\x10\x95\x50\x4c\xd1\x94\xb8\xa4\xb6\xef\x8a\x1d\x93\x7c\x01\xb7\xb8\x40\x5e\x55\x08\x02\xab\x81\x55\xb2\x81\x62\xd7\x20\xcd\x21\xcc\x5d\x73\x2f\x70\xbe\x1a\x9a\x16\x21\x63\x95\x5e\x03\xa2\x66\xcf\xba\x0f\x5c\xb5\x15\x2b\xb8\x81\xb6\xe3\x05\x17\x38\x63\xc1\x5a\xb6\xc6\x39\x05\xe2\xe2\x06\xf7\x5d\x20\x3a\x24\x9f\x6b\x84\x0e\x61\xdf\xf7\x52\xc1\x9a\x83\xea\x58\x2d\x37\xbc\xeb\x34\xca\xf4\xa8\x1a\xc3\x66\x23\xc2\x71\x41\x93\x13\xd7\xd0\x24\x5b\x8b\x74\xb0\x67\x07\x1c\x0f\xdf\xf2\x12\xdb\x86\x1b\x45\x18\x95\x06\x9a\x7b\x56\x89\x52\xa8\x03\xae\x89\xb3\x62\x87\x83\x45\x48\xe7\x4c\x8a\x4a\x0f\x57\xec\x78\xf1\x81\x87\xcc\xf3\x03\x21\xb2\xc3\xf5\xd5\xf0\xc0\x54\xb1\xd3\x6c\x69\x07\xe0\xf7\x1c\x99\x09\x79\x43\xf7\xd6\x53\x5a\x4a\x05\x56\x97\x7a\xac\x9b\x6b\xdc\x31\xc9\x39\x08\xbd\xd4\x03\x3c\x08\xb9\xc3\x67\xeb\xfe\xe0\xd6\x89\x2b\x51\x7c\x6f\xa6\xff\x83\x47\xbf\x19\xde\xd0\x11\xdb\x6e\x3b\xbe\x65\xaa\x39\x01\x51\xc4\xb1\x7a\x58\x89\x08\x10\xb5\xe2\x1d\xb7\x28\x65\x45\xc1\xa5\x9c\xb2\xaa\x9a\x79\xa1\x93\x08\x2d\xf8\x34\x99\x00\x00\x84\x6d\x79\xad\x84\x22\x59\x74\xd5\x71\xa6\x38\x4d\x7e\xb4\xe5\x5b\xbe\x6f\xee\x5d\x4b\xdd\x14\x81\xf4\x93\xdd\xd4\x42\x09\x56\x89\x7f\xe3\xa5\x7b\x7b\x19\x8a\x89\x8e\xcb\xa6\xef\x0a\x0e\x3b\x26\x61\xcd\x79\x0d\x85\x9e\xbd\x5c\xb8\xf6\xaf\x70\xf5\xb8\x18\xd9\xef\x39\xe1\xa8\x6e\x1e\x80\x7f\x6c\x79\xa1\xec\x76\x6d\xba\x66\x6f\xa8\xd6\x8f\xee\xc7\x78\xd3\x28\x4e\x92\x92\x43\xd9\x40\xdd\x28\x90\x2d\x2f\xc4\xe6\x80\x9c\x42\x72\x60\x89\x6f\x0b\x56\xe3\x5b\xdc\x1f\xb9\x6b\xfa\xaa\xc4\xc6\x7e\x24\x83\x9c\xd2\x01\x2e\xed\x70\x5a\x24\xb1\x1a\x9a\x87\x1a\x45\x9f\x19\x71\xae\x37\x8c\x48\x1b\x71\xe5\xd1\xc0\x36\x0a\x19\x0f\x87\x43\x91\x20\x90\x14\x0f\x32\x80\x40\x13\x9f\x9f\x98\xb0\x7c\x79\xcf\x44\xc5\xd6\x15\xb7\x0b\x4f\x64\x56\xc9\x15\xef\xf6\xa2\xc6\xe5\x3a\x01\xe7\x06\x21\xf9\x68\x60\xa4\x3f\x82\xdd\x98\x2e\x16\x0b\xa1\x24\x54\x4d\xa1\xa1\x9a\x01\x53\xba\x8d\x12\x7b\xe4\x69\x37\x8e\xa5\x6f\xe4\x97\x75\xaf\xa0\xa9\xab\x83\x69\xcb\x14\xb4\x1d\x2f\x84\xe4\xb0\x6f\x34\x91\x88\x3a\x7e\xac\x25\x06\x2b\x70\x7c\xbf\xba\x1b\x12\xdc\x46\xae\x21\xa6\x64\x0a\xdc\xc3\x4e\x54\xdc\x71\x16\xce\x2c\xa4\x11\x05\x73\x08\x00\x43\xb5\x8a\xda\x08\x5b\xee\xdd\x04\x43\x32\x46\xec\xe5\x29\x75\x2a\xdd\xd3\xb7\xb4\xcb\x37\xd7\x4b\xf8\xf1\xa6\x56\xff\xf7\xff\xcc\x26\xa3\x3b\xe2\x5e\x5c\x3a\x08\x53\xaa\xd6\xe4\xc0\xca\x52\x0b\x4b\x60\x39\x4e\xf0\x38\x41\x41\x7f\x69\xe8\x08\xd7\xd9\x73\x09\x5a\xd9\xb2\x8e\x44\x20\x3c\xec\x38\x22\x97\x68\x01\xd7\xcd\xf7\x42\x29\x5e\xce\x61\xdd\x2b\x37\x10\xb6\x90\xa8\x4e\xec\x96\x93\x82\xd5\x28\x3e\x40\xc7\x37\xbc\x43\x78\x90\x4e\x8b\x1d\xab\xb7\x1c\x9a\x5e\xa1\x72\xa3\x0e\x6e\xa4\x54\x92\x3c\x34\xdd\x87\x4d\xd5\x3c\xcc\x41\x36\x5a\xdc\xb2\x8e\x6f\xfa\x0a\x54\x43\xb2\x53\x43\xd8\x4b\xdc\xad\x73\xf6\x23\x45\xe9\x54\xcf\x8c\xff\xfc\x9e\x10\x4a\x96\x16\x37\x73\xd7\x86\xb0\x3e\xdc\x34\xdf\xa4\xde\xa8\xdb\x43\xcb\x97\x80\xff\x1b\x3d\xfe\xf1\xc7\xa0\x3d\x84\x6f\x72\xe3\xa0\x85\xf1\x03\x3b\x20\x81\xff\x99\xf5\x55\x76\x50\xdd\x06\x8d\x90\x25\xfc\xf8\x5a\x7c\x0c\xbb\x17\xbd\x54\xcd\x1e\x07\xfe\x93\xea\x44\xbd\xfd\x3e\x78\xe5\x54\xed\xa5\xd6\xb4\x99\xce\xae\xc5\x5b\xd2\xdf\x72\x09\xef\x08\x1b\xef\x83\xa1\xf8\xc7\x56\x74\x07\x0b\xbc\x7e\x3c\x24\xdf\xab\x06\x8d\x50\x15\x48\xa6\xdb\x1d\x1f\x12\x30\x92\x67\x75\xcf\xcb\x05\xdc\xa0\xac\x93\xc0\x85\xd6\x5e\xfa\xa5\x35\x4e\xca\x39\x74\x5a\x25\x94\xd0\x74\x50\x72\xa9\xba\xe6\xc0\xcb\x33\x77\xdd\x41\x32\x3d\x63\x47\x21\x43\x19\x47\x9b\x39\x18\x97\xf0\xbb\xa6\xa9\x9e\x4c\x12\xe1\x8b\x5f\x3e\x45\x38\xee\x38\x9b\x1c\x7e\xac\xd1\x0c\xb5\xfd\xdd\xe3\x4b\xab\xf0\x4b\x6f\x2d\xe2\xf6\xa3\xca\x33\x7b\x8f\xc6\x6b\xd9\x77\xc4\xe2\xda\xc0\xb7\x72\xe6\xcd\xeb\xdb\x53\x7b\x1f\x4f\x3b\xed\x52\xf8\xe7\x6e\xfe\x3f\xb1\x8a\x5f\xf5\x6e\xf5\x01\xe8\x5e\x24\xe1\x6f\x6c\xcb\x7f\x60\x6a\xe7\xde\x6a\x72\x26\x85\x86\x76\x25\xd2\x0b\xdb\x92\x39\x90\x15\xc1\x56\xf1\xaf\xa9\xa3\xa5\xe0\x10\xfa\x8a\xab\xfc\xbc\x4b\x08\xfe\xc8\xc1\xf8\x43\xbf\xae\x44\x31\x00\xb1\xd5\x8f\x3d\xa4\x68\x92\x46\xd0\x55\xa2\xfe\x70\x0a\x0e\x3f\xf6\x12\x82\x79\x02\x30\x0c\x12\xdd\xdf\x97\x20\x55\xd7\x17\xa8\x7e\xda\x8e\x4b\xc4\x75\xbd\x05\x86\x7b\x2d\x5a\xc1\xad\x93\x62\xad\x7d\x6c\x80\xae\x0c\xef\x14\x13\xd6\x17\x70\x83\xd1\xae\xb7\x46\x2e\x1a\xd9\xcf\x40\x69\x37\x56\x48\x90\x4d\x75\x44\x14\x10\x1c\xb4\xcb\xf0\xc9\x91\xad\x45\x90\x23\x3f\xc4\x4c\x30\xcf\x22\x6a\x99\xb5\xf4\x90\x3e\x02\x3b\x0f\xd5\xd3\x46\xd4\xa5\x86\x56\x8b\x6f\xd3\x43\x9b\x8f\x0e\x11\x81\x62\xc1\x81\x99\x24\xdb\xa3\xfe\x80\xc2\xcd\xd1\x8a\x9d\x6b\xc3\xd1\x91\x10\xe4\x3a\x3d\xb0\x83\xf5\x68\xf6\xac\x16\x6d\x5f\x21\x19\xc5\x23\xca\x26\x02\xc4\x01\x87\x03\x32\x28\x7a\xe5\x7c\xd6\x43\xd3\x9b\x2d\xd8\x72\x82\x92\xb0\x85\xe6\x84\xda\xf1\x3a\x1a\xb7\x40\xd7\xda\x62\x6b\xb1\x6e\xba\xae\x79\x98\xce\x2e\x16\xda\xea\x5b\xd8\x69\xd0\xe6\x54\x8b\xa8\xe3\x2d\x42\x4e\xf6\x64\x89\xee\x17\xdf\x6c\x44\x81\x54\x50\x1d\x90\x73\x18\xc8\xa2\x13\x6d\xd0\x2b\xa5\x44\x3b\xeb\x12\xae\xac\xe7\x77\x78\xf9\xcd\xa7\x28\xa2\xb1\xb0\xcc\xfe\xf8\xdd\x24\x99\x9e\x5b\xef\x32\xa1\xa4\x38\x22\x62\x30\x2e\xaa\x0a\xb1\x6b\x7d\x67\x15\x50\xc8\x38\x7c\x2c\x12\xa0\xf1\xf4\xc2\x59\x80\x5d\xf8\xdc\xfd\x8e\xef\xa7\x4f\x5c\xe0\x3c\x99\x71\x16\xd0\x35\xfe\x93\xbc\xda\x2c\xec\x98\xb0\x72\x2b\x18\x36\x22\xc4\xac\x68\x40\xd7\xe0\x51\xff\xf6\x38\x19\xe8\xf5\x6b\xae\x98\xa8\xe4\x90\xd3\xd1\x2d\x64\xa2\x46\x79\xcd\x6c\xe3\x6f\x25\x94\x4c\xb1\x93\xdc\x19\x8f\x9d\x61\x52\x2f\x8a\x2c\x4b\x39\xe7\x1d\xcd\x53\x94\xbd\xe8\x13\xd7\x8b\xa8\xa7\x67\x5a\x62\x41\xe2\x2d\xf2\x84\xac\xef\x84\xfb\xcc\xa0\x14\x9b\x0d\xef\x62\xeb\x7d\xc8\x58\x38\x8e\x90\xf0\xc7\x7f\x5d\xc0\xcd\x06\xf9\x53\x47\x56\xf6\xec\x03\xc7\x68\x94\x6e\x81\x9e\x82\x82\x5f\x15\xac\xfe\x95\x73\xcf\xe2\x81\x34\x34\x5e\x19\x74\xfc\x5e\x48\xa1\x78\x99\x27\xb0\x7b\xd6\x05\xc6\x88\xb7\x11\xa2\x21\xff\xb2\xe3\xda\x6e\xd2\x43\x0f\xac\x2c\x67\xa4\xa0\x88\x71\x7e\x67\x6e\xa2\xc4\x9c\x89\xe6\x40\x46\xba\x3d\xb4\xd6\x7a\x1f\xc4\x14\x17\x6f\x5e\xdf\xba\xe0\xd3\x9a\x23\x04\x08\xc9\xd8\xba\x90\x71\x22\x23\x69\x30\x99\x35\xbc\xe0\xe6\xda\xcd\xf9\xfa\x16\x1e\x76\xa2\xd8\x69\x7f\x5d\xfb\x87\x5a\x7f\x90\x3f\xc8\x5d\x74\xe2\xd8\x94\xa1\x01\x36\x98\xb4\xaf\xc5\x5f\x7b\x0e\xa2\x44\xa5\xb5\x11\xde\xa1\x75\xab\xd3\x32\x02\x45\xa6\xe4\x55\x75\x74\x6d\x47\xa6\x09\x11\x19\x61\x91\xbc\x5a\x23\xa2\xa4\x53\x91\x7b\x56\xf2\x88\xc0\xd3\xe9\xc6\x0d\xc5\x31\x69\x18\xa9\x60\x2d\xf0\x08\x87\x26\x84\x21\x78\x19\x43\x76\x62\xee\xd0\x00\x4d\xa6\x14\xd2\x8d\x89\x8a\x88\x03\x46\x85\xd1\xae\x44\x0c\x58\x69\xbc\xe6\xea\x01\x2d\x3f\x67\x23\xc8\xe3\x13\x62\x68\x75\x09\xef\x48\xb3\xbf\x8f\xa6\xbc\xac\x30\xe8\xed\x59\xba\x64\x6d\x0b\x8a\xb3\xbd\x0c\x83\x99\xc6\x5d\x42\x01\x86\x41\x40\xab\x8a\x4b\xc3\x3d\xbd\x90\x3b\x5e\xd2\xcb\x68\x70\x8d\xb7\x07\xcd\xbd\x3b\x5e\xb5\xb8\xa0\x3d\x0e\xbb\x11\x15\xc6\x5e\x28\x80\xd2\x71\xad\x9b\x49\x89\x88\xce\x99\xe2\xf9\x55\xdd\x33\xdf\xc2\x19\xeb\xd1\xb4\x57\xce\x1a\x07\x66\x1d\x59\x1c\x1d\xfd\xe3\x8a\x89\x3d\x2f\x61\x7d\xc8\x05\x7b\x9d\xfd\x3c\x8e\xcf\x31\x5f\x20\x02\xe0\x95\x36\xf4\x71\xcb\x48\xc0\x8c\x0e\x97\xb8\x04\xd1\x28\x37\x5d\x87\x32\x53\x8a\x75\x75\xd0\x8c\x1b\x89\x2c\x26\xbd\x08\x5a\x64\xf5\x25\xcd\x65\xf9\x7c\x06\x9b\xbe\xc6\x71\x6e\x1b\x1b\x82\x2d\xa7\x59\x7d\xe8\xc6\x85\x15\xa8\xae\xf7\x6c\xf1\x18\x2b\xec\x1f\xdb\xd2\x61\x8d\x28\x24\x10\x06\x86\x1e\x7c\x5c\xcc\x13\x8b\xdd\x78\x1d\x32\x0c\x48\xef\xb2\x6d\x03\x4a\xc6\x19\xfe\xc0\x9c\xd4\x52\x0d\xd4\xc2\x04\x36\x2a\xce\xba\xc8\x76\x1a\x5b\xe9\x15\x91\xc9\x74\x40\x2f\xb3\xcc\xb2\x6d\x23\x58\x39\xfa\x1a\x5b\xf9\xcd\x19\xa6\x0a\x78\x2f\x9a\xbc\x56\x2f\x68\xe6\xe9\xab\x50\xd0\x0e\x5e\x8e\xbd\x3a\xc7\xe3\xb5\xed\x12\x19\x90\x34\xc8\x28\xcd\xb8\xc5\x00\x81\xc9\xeb\x11\xae\x88\x5b\x65\xfc\x5f\xfc\x37\xb3\xf1\x70\xfb\xd3\x76\x3c\x21\x4b\xfc\xf7\xe2\x05\xfc\x19\x83\x8d\x18\x46\x43\x3e\x35\xa3\x0d\x9a\x99\xc7\xf0\x1d\xcd\x32\xdd\x22\x19\x74\x68\xae\xfc\xae\x6a\x8a\x0f\xd3\xd9\x42\x89\x3d\x97\x8a\xed\xdb\xd9\x72\xd0\x1b\xff\x3d\x4b\x82\x6c\x8b\xd8\xe8\x5a\xe0\xe6\x2e\xb5\x7a\xd8\x8a\x7b\x5e\x13\x20\xe0\x86\x85\x9f\xa6\xe6\xd1\xcc\xe9\x0c\x52\x17\x9b\x5e\xf5\x1d\xbf\x78\x76\x72\x6d\x15\xaf\xb7\x6a\x67\xb5\x1e\xee\x1f\xba\x23\x83\x6e\x76\x63\x17\xd4\xfe\x3b\xf8\xf5\xf2\x4c\xf0\xe9\xa1\x81\xd0\x84\xce\x15\x54\x9c\x49\x8c\x25\x7b\xc3\x1f\x9d\x20\xa7\x66\x62\xb8\x1f\x27\x43\x16\x0a\xad\x2f\x58\x45\x74\x75\x5c\xcc\x6c\x58\x25\xf9\xb0\x09\x31\x0d\xac\x2c\xfb\x64\x9b\x20\xf3\x98\x26\xf8\x5b\xb6\x89\x6d\x90\x7b\x9d\x63\x23\x58\x65\xb9\x6b\xd8\xf9\x98\xd0\xf0\x8d\x12\xf6\x80\xd5\x80\x63\x86\x9d\x88\xac\x56\x39\x42\x77\x70\xe3\xee\x13\xac\xf8\x6b\xbc\x25\xa8\x0a\x59\x55\x68\xd7\x57\x93\x95\x6a\x14\x26\xc8\xd1\xfe\xb0\xf9\x1a\x4d\x58\x32\xea\x86\x0a\xd6\xd9\x29\xa7\x40\x7d\xf1\x02\x7e\xe0\xdd\xa6\xe9\xf6\xd6\x7f\xa3\xc0\x73\x53\x47\xc9\x47\x93\x88\x29\x1c\x38\x41\x86\x58\x43\x82\x84\x66\x3c\xab\x40\x05\xe0\x3f\x0c\x36\xe0\x4b\x51\x27\xab\xce\x4a\x88\x3f\xa0\x7f\x21\xfb\x8e\x53\x1e\x07\x8c\xf7\x3d\xe2\x9d\xda\x1f\x74\x0b\xb8\xf1\x68\x6d\xc6\x51\x48\x60\x5b\x0c\xb1\xb8\x10\x7f\x26\x8c\x12\xfe\x14\xbd\x5a\x0c\x7c\xfe\x41\x2b\xfc\xf7\xfd\xf7\xd0\xb2\x5a\x14\xd3\xf3\x38\xf5\x8a\x7c\x30\xb3\x10\x3b\xc5\xb3\xe1\xd8\x68\xcd\x95\xa5\xdd\x53\x67\xbb\x36\xe9\xd6\x0f\x3a\x86\x9b\xed\x7f\xff\x07\xa4\x0c\x72\x7b\xa3\x2e\x8f\xd1\x5f\x4c\x4a\xde\xa9\xe9\x91\x41\xbf\x83\x5f\x2f\x7e\x1d\x6b\x02\xfc\xd9\x73\x29\xd9\x96\x9f\x2b\xb0\x6e\x77\x3c\x23\xb4\xea\xa6\x7e\xfe\x6f\xbc\xc3\xb4\xbb\x28\x52\xc9\x3a\x9b\x44\x7f\xda\x20\x20\x25\xc6\x89\x12\x4b\xcd\x3c\x19\xcc\x38\x6a\x1b\xa0\xe6\xb4\xef\x6f\x42\x7f\xee\xe9\x65\x6d\xb2\xc4\x1b\x4c\xfc\x1b\x9b\x1a\x97\xc1\xd0\x38\xc2\x6c\x0d\x85\x1e\x7d\x1b\xed\x67\xd3\x58\xe3\x31\x01\xe7\xa6\xfb\x8e\xd1\xfc\x81\x12\x45\xa3\xc5\x10\xe5\x9b\xd7\xb7\xd1\x53\x1d\x73\xd2\xa4\x6f\x76\xd2\xba\x87\x92\xed\xb9\x8e\xa2\x91\xb1\xff\xe6\xf5\xad\x2b\x85\xf1\x43\x05\x24\x8e\x53\x08\xef\x00\x22\x03\xad\x31\x5c\x39\xd7\xa9\x74\xfe\x91\x61\x72\x01\x93\xf5\x42\x79\x7f\x1b\x43\x92\x70\x2f\x18\xb0\xda\x24\xe3\xc9\xbc\x5d\x64\xcd\xaa\x70\xf1\x68\xcc\x06\x60\x2c\xe1\x9b\x4f\x39\x37\xfb\xf1\x7b\x4f\x04\x08\xa1\x55\x3a\xd1\x43\x6b\x0f\x87\xc9\x4d\xcc\xe0\x1d\x6c\x4c\x5d\xc5\x5e\x9d\xc3\x5b\xcb\x0e\x06\x3b\x6b\x5e\x73\x0c\xd5\xb1\x4e\x50\x6d\x41\xc7\x55\xdf\xd5\xd2\x77\xb7\x9c\xb8\xee\x0f\xbc\x3b\x6f\x7d\x16\xd8\x98\xbb\x48\x37\x2f\xe1\xb7\x49\xd8\x4b\xab\xa9\xc7\x39\x8c\x98\x66\x6f\xad\x1e\x3f\x33\x76\x16\x64\x2e\x66\x38\x59\x16\xbd\x31\x76\xb7\x5c\x11\xd7\x46\x8f\x5f\x63\x68\x96\x1c\x85\x92\x42\x57\xcd\x26\xc4\xf6\x22\x8b\x83\x7b\xc1\x1f\xb4\x2d\xef\xc7\x9d\xce\x9c\xed\x62\x67\x8a\xa6\xda\x72\xa5\x5d\x5a\x5e\x7a\x2f\xd0\x2e\x69\x1c\x28\x66\xba\x84\x05\x3a\x32\xae\x9d\x69\xba\x00\x93\x4e\x0a\x27\xee\xca\x8d\x26\x6e\xbb\xf3\x77\xb5\xa8\xee\x70\x91\x75\xd8\x55\x48\xe8\x5b\xa4\x85\x6d\xc7\xd6\xe8\x87\xb2\xfa\xd0\xd4\xfc\x34\x02\xc6\x97\x85\x38\x79\x77\xd6\x96\xbe\x4f\xb8\x61\xc7\x24\x21\xf3\x77\xbc\x68\xf6\xfc\x9f\x77\x8d\xb4\x99\x48\xdb\xe6\x96\x63\xed\xda\x03\x85\xcd\xa8\xa8\x86\x38\x9c\x22\xf5\x28\x33\x28\x54\x50\x7a\xb4\x1d\x8e\x22\x47\x5b\x7c\x84\x1e\xa1\x60\xcf\x59\xed\x9d\xdb\xb5\x86\x46\xc2\x16\xe1\xc1\x50\x9c\x96\x13\x4d\xaf\xf2\x7c\x83\xc3\x5f\xbf\xfa\xe1\xed\xab\xab\xcb\xdb\x57\xd7\x4b\xca\x56\xe0\x44\x98\xa7\xef\x29\x0e\x27\x24\xd2\x92\xae\x7b\xd0\xa1\x50\xbe\x67\xb5\x12\x18\xac\xc7\xe0\xfa\x3d\xef\x30\x08\xf1\x5f\xff\xf1\x9f\x11\x98\xe8\xff\xde\x45\x13\x39\x83\x80\x70\x20\x15\x0a\x4f\x8b\x89\xa9\x58\xf0\x05\xbc\xf9\xe3\xad\x81\x9d\x97\x33\x2d\x0d\xec\x72\xd3\xce\x46\x44\x46\xe3\x9b\x11\x6e\xfe\xe4\x06\xc0\x8a\x33\x13\x4c\x45\x52\x6d\xda\xb6\xc1\x18\x28\xae\xe9\xc1\x46\x78\xdd\xba\x6a\x94\xda\x58\xd5\xa8\xeb\xc8\x6e\x83\x57\xd1\x1c\x42\xc2\x07\xde\x2a\x60\xf2\xb9\xd0\x81\x1e\x76\xdf\x88\x12\xd6\x1d\x67\x1f\x10\xff\xfc\x23\x6d\x04\x6a\x96\x2d\x55\xd8\x51\x62\xa4\xea\x38\x2b\x0f\x48\xd2\x2d\xaf\x25\x9a\x96\x94\x0f\x8a\x67\xa8\x91\x32\xb1\xc4\x04\x7e\x94\x1c\xee\x84\xd4\xb4\x45\xd4\x36\x9d\xdd\xe9\xb2\x48\xce\xca\x39\x45\x29\x63\x8c\x7b\xb3\xcb\x92\x84\x88\xb9\x97\x90\x33\x44\xae\x50\x7e\x53\x74\x91\xc6\x09\xce\x1a\x61\x01\xe4\x29\x1d\xd7\x8d\x66\x8d\x57\x11\xbd\x7a\x1b\xc3\x2f\x36\x61\x0d\x1a\xed\x76\xdd\x40\xd5\xd4\x5b\xde\x39\x72\xb1\xca\x56\x97\xdd\x7c\x2b\xa1\x68\xaa\x8a\x0f\xf7\xcb\xd0\x44\x8c\x8e\x08\x05\x3e\x50\x6f\x75\x46\x39\x9b\x47\xc8\x89\x54\x73\x34\xb8\x21\x60\x17\x29\x8b\xc9\xad\x68\xba\x8e\x17\xaa\x3a\x3c\x47\xd2\xc2\x1c\xb4\x2e\x5f\x44\xaf\x48\xeb\xf5\xbb\x51\xfc\xdd\x79\xf5\x81\x4b\x30\xbb\x8c\x81\x76\xc7\x6b\xc4\xa2\x96\x11\xe5\x89\x9d\x4a\x49\x28\xdc\xa0\x8c\x0d\xe6\xfe\xbe\xf4\x86\x12\x51\x70\xd5\x3c\xa0\x92\xd6\xc8\x30\x71\x41\x2d\x5f\x70\x3d\xcc\x15\x58\x62\x89\x2c\x79\xf8\x91\x38\x9d\xbb\x81\x11\xbd\x2e\x17\xda\x35\x05\xe7\x25\xe9\x35\x66\x02\xaf\x14\x74\x94\x6d\x25\x7c\xd4\x56\x62\x50\x8f\x55\x83\xe8\x2d\x8e\x38\x6e\xe2\xd1\xa2\x9c\xf2\x33\x16\xe6\x1c\x4c\x71\xf4\x02\xff\xa3\xc3\x9c\xb1\xc9\x77\x8b\xb4\x85\xe2\x80\xc3\x14\x4d\x65\xaf\x24\xe6\x80\x7f\x23\x13\x57\xfc\xe3\x2c\xd5\xca\x08\x7c\xba\x19\x68\x0f\x9b\x18\x28\x35\x3e\xae\x88\x2f\x03\x45\x60\x50\x8e\x64\x1b\xa7\x97\xb0\xe4\x56\xa8\x5d\xd9\xb1\x07\x47\x9c\x58\xf7\xac\xff\x30\xa8\xbf\xb9\x36\x9e\xaa\x50\x21\x73\x64\x4c\xb1\x74\x36\x49\x3e\x5f\x66\xaa\x5f\xb1\xfa\xf0\x2b\x9c\x4c\x57\x4d\x61\x6e\xd7\xa7\x98\x6c\x01\x95\x96\x3f\x5b\x71\x9f\xb2\xb9\xec\xb1\xf4\x3b\x5c\x1b\x4a\x4f\x3f\x0f\xd2\x04\xab\x74\x25\xa1\x71\x25\x0d\xe0\xa5\x5e\xac\xce\x7e\x69\xef\x54\x50\x72\xa4\x97\xfa\x77\x23\x06\xa2\x89\xd0\xf4\xb6\x29\x32\x1d\xb5\x96\xe3\xba\x0f\xb7\x79\x23\x78\x55\xea\x62\x6e\xf4\x2a\x24\xdc\x65\xec\xe1\x1f\x8c\x8e\xee\xe6\xc3\x8c\xd4\x95\xc3\xad\xa1\xac\xc7\x3b\x5f\xef\x17\xcd\x75\x87\xee\xd8\x1d\xb4\xac\x63\x7b\x2c\x75\xd4\xe4\xd1\xaa\xfc\x84\x7e\xd4\xc7\x3b\x94\x2b\x5c\x72\xca\x35\x68\x87\xa8\xc6\x37\xa8\x7f\x97\xe8\x8f\x55\xa9\x85\xd6\x32\x69\x76\xb1\x66\x68\xe7\xf3\x0e\xee\xfc\x80\x77\x66\xad\x53\x23\x57\x48\x5a\x39\x07\x25\xdc\x1f\x6c\x26\xa4\xec\x4d\xfe\x12\x53\x51\xbc\x93\xb3\x58\x3a\x31\x5b\x03\x20\xa5\xd8\xd6\x7b\x2a\xe6\xd3\xfa\x03\xd6\xbc\x60\xb8\x4f\x77\x47\x96\x77\x87\xe9\x34\x8c\x82\x68\x6d\xba\x6e\xd4\x0e\xee\x2c\xb6\x63\xf3\x01\x67\x0a\x96\x61\xb0\x7d\xb7\x80\xcb\x4a\x6c\x6b\xe7\x6f\x3c\x34\xd6\x5d\xd0\x7e\x98\x5e\xea\x83\xa5\x51\xe6\x15\x35\x55\x0b\xa2\x14\xb2\x3a\x3b\x9a\x6d\x5c\x7f\xef\x50\xe2\x9d\xda\x33\x4f\xe7\x68\x4f\xa4\x12\xc1\xc7\xdb\x29\x8b\x67\x57\xec\xc5\x4c\xe4\x6a\xb0\x5e\xed\xa6\x83\xf9\xfe\x42\x6c\x39\xcb\x79\x70\x4f\xa1\xd8\xa4\x9a\xe1\xf2\x78\x01\x7e\x62\xdf\x6b\x56\xc3\xc2\x4e\xd6\xa2\x25\xcb\x4b\xef\x45\xe1\xae\xa9\xc6\xda\xfc\x7a\x53\x82\x71\x02\xdb\xfe\x04\x82\xc2\xc9\x43\x0c\x7d\x9e\xe9\x7e\x85\x0c\xa3\x13\x59\xa4\x02\xd6\x68\xee\x92\xd9\x24\xdc\x29\x11\x24\xe3\xa4\x0e\xd0\x8e\xf0\x6a\x2f\x94\x6b\xe7\x6a\xff\x4c\x29\x71\x60\xbe\xe0\x20\x0f\x54\x70\x66\x89\x47\x2f\xa5\x8c\x72\x50\x31\x3f\x49\x51\x17\xde\x16\x99\xce\x74\x5d\xac\x0c\x4a\x65\x9d\xde\xb4\xfd\xf5\x61\x87\x18\xc0\x1b\xb2\xda\xab\x66\x2b\x0a\x22\x75\x39\xb7\x55\x00\x41\xf5\xc1\xa2\x0b\xeb\xe0\xa7\xc6\xe4\x0e\x5e\x17\x15\x67\x75\xdf\x4e\x67\x47\x76\x08\x2d\x0c\x44\x21\xe2\x75\xcd\x30\x51\x90\xe4\x20\x7c\x91\xb4\xc5\x4a\x5c\x86\x67\x17\x32\xc7\xd0\x23\x1e\x28\xe8\xf0\xec\x01\xe2\xa8\x70\xb8\xc5\xf2\xe1\x45\x3a\xea\x1f\xd5\x8e\x77\x0f\x58\x99\x3d\xde\x51\x04\xc1\x80\xd9\x60\x04\x6b\xb0\x59\x49\x85\x95\x57\x66\xcf\x23\x78\x45\x7d\x04\x67\xe9\x98\x4d\x97\xc5\x20\x0c\x26\x37\xd5\x1d\x24\x88\xb4\x7e\xd7\xf4\xd6\x17\xaa\xe9\xfc\x66\xa9\x5d\x23\xbd\x33\x12\xc8\x12\xfc\x27\x36\x70\x81\xe6\xc5\x82\x2c\x8b\x20\x35\x10\xef\x01\xfe\x20\x25\x0d\xa8\x76\x18\x7f\x1c\xa9\x5e\xd5\xd3\xf4\xbd\x08\x4a\xc1\xc2\x1f\x9f\xb1\x18\x74\xb2\xb0\x85\x49\x8d\xfc\x20\x0e\xfa\x25\xe4\x57\x95\xef\x46\x29\x8e\xa4\x13\x3d\x1d\xed\x82\x29\x8f\x61\x17\x7c\x3a\xda\x25\xd7\x61\xac\x79\x2e\x0b\x92\xf4\xce\x35\x39\x32\x18\x46\x53\x73\x23\xe0\xf3\x7c\x37\x9b\x58\x49\x7a\xd9\xc7\x23\x9d\x06\x29\xc9\xb8\x73\xf2\xfa\xd4\x20\x56\x10\x2f\x31\x0b\x9d\x6f\x6c\xd3\x9b\xd1\x3c\x23\x59\xca\xd9\x48\x44\xfd\x71\x72\x46\xc8\xd6\xb8\x9a\x64\xdf\xea\x52\xac\x82\x0f\x6a\x6f\xa8\x54\x80\x97\xee\x20\xe2\xe2\xff\x43\xa8\xd6\x19\xb6\xe4\xdb\xd5\xa2\xca\x9b\xad\x69\xa8\xf3\xac\x50\xee\xa8\x44\xa6\xe0\x53\x70\x8e\xee\xe0\x01\xef\xf8\x7d\xf3\x01\xe5\xb2\x87\x09\x3a\x46\x45\x60\xac\x36\xc9\x18\x0c\x7b\xc4\xdb\xea\x4b\x4e\xcb\xa6\xf0\x67\xb9\xda\xae\xd9\x0b\x34\xb0\xb1\x1a\x01\xd1\xc5\xea\x03\xa1\xef\x45\x5f\xfb\x7a\x13\x57\x7a\x6d\x7f\xc4\x46\x5b\x01\x16\xd4\xb7\x7c\x83\x89\x3b\xa4\x95\xac\xe5\xe4\x72\x48\xc9\xa2\x83\xa1\x3a\x3d\x44\x30\x20\xf5\x41\x2c\x46\x44\xa8\x79\x3e\xd5\x67\xf6\xff\xc4\x06\xa9\x68\x21\xe4\x0d\x9e\x53\xad\x0b\x3e\xe8\x8b\x0c\x3d\x83\x6f\xbe\x31\xed\x4a\x58\xad\x20\x6d\x72\x73\x3d\x32\x3a\xfe\x23\xbc\x77\x7c\x93\x6d\xf2\x38\x39\xfe\xe4\x71\x92\x19\xac\x16\xd5\x18\xd3\x8c\x04\xa1\xff\x99\xab\x34\x00\x9d\xa3\xe0\x2c\x85\x9e\x15\x88\x86\x4f\x39\x40\x43\x4c\x1d\x81\x78\x3c\xba\xfb\xbf\x3c\x68\x8d\x41\xeb\x23\x98\xcd\x1b\xd5\x63\x88\x1e\x09\x56\xfd\x8f\x8b\x77\x8f\x86\xb7\x75\xad\x9d\xf6\x62\x9a\x61\xe0\x38\x86\x5f\x1b\x95\xee\xfd\x58\x78\x36\x1f\x04\x47\xab\x38\x17\xff\x8e\x26\x48\xc7\x30\x42\x14\xa6\xb6\xe3\xa9\xc8\xf1\x09\xea\x1b\x0d\x4c\x9a\xb8\x21\x7c\xfa\x5a\xd2\x99\x76\xe2\x7c\xb9\x7c\xb1\x8a\xc4\xd9\xa8\xcc\x8b\x8b\x64\x12\xd2\x8e\xd1\xf4\xf7\xd0\xf4\xd1\xd0\xf4\x09\xd2\x49\x49\xee\x17\x47\x31\xab\x33\x29\xe6\x58\xf5\xa6\xdd\x8d\xaf\x9e\x09\x0f\xf4\x88\x95\x3a\xb6\xe4\xb6\xe9\xa2\x5c\x39\xff\xa8\x3a\x7b\x5e\x69\x34\x5b\x6e\xe6\x33\xb7\x2e\xa0\x5f\xaf\xf3\xa9\x65\xdf\x56\xa2\x60\xca\x01\x2d\x5d\x84\x01\x0f\xea\x53\x91\xbd\x23\xc0\xfc\xfe\xff\x8d\xa4\xdc\xcf\x2b\x97\x8c\xc8\xc6\x2d\x1c\x6d\x37\xcd\x75\x4f\x2b\x7d\x74\xae\x6b\x5c\x87\x83\x26\xb6\x0d\x09\xc5\x41\x90\xb8\x10\x27\x40\xe1\xa8\x9d\x99\xf3\x22\x67\x9f\x0d\x25\x15\x4d\xb5\x19\x72\xd6\x29\x07\x3c\x28\xf1\x93\x61\xad\xd0\xa4\x23\xfe\x72\x99\x53\x8d\x79\x22\x48\x5d\xc8\xcc\xa4\x2b\x8d\x7c\xf9\xd3\xd4\xae\x69\xcb\xb5\x8f\x3e\x9d\x2d\x7c\xfd\xf3\x0c\xc5\x14\x0a\x3e\xa4\xc1\x8e\xff\xb5\xe7\x28\x7b\x4c\x34\xfa\xe5\x4f\xa7\xd7\x1e\x0e\xb5\x18\x47\xe7\x9a\x55\x0c\x43\x6d\xab\xd5\x88\x4b\xfd\xdf\x8a\x42\x82\x12\xee\x71\x47\xa1\xc4\xd3\x74\x75\xe3\x4e\x40\x25\xa8\xd1\x25\x58\x68\x96\xe7\xb0\x83\xef\x72\x78\x88\x5a\x7e\xbd\xda\xdf\xf3\xf0\x72\x11\x71\x07\xde\x06\x80\x00\xe5\xb8\x41\x83\xad\xcf\x03\x92\xf6\x87\xcf\x86\xc7\x06\xaa\x34\x7a\xeb\x6f\xa9\x30\xce\x27\x33\x78\x69\xae\xc4\x78\x76\x44\x5d\x4c\x46\xcb\x28\xc3\x5d\xcf\x68\x72\x53\x24\xb9\x98\x0c\xd6\xe6\x36\x2f\x3d\x6f\x10\x35\x8d\xfe\x10\x9b\xb8\xeb\xa0\x6a\x56\x17\x12\x66\x44\x9d\x31\xb6\x7d\x73\x9f\x5a\xd5\x3c\x28\xaa\x39\xdc\xee\xb0\x78\x52\x7b\xfa\x31\xb0\xf8\x13\x9f\xeb\xb0\xd2\x18\x56\x39\x41\xfe\x79\x15\x9d\x21\xf9\xe4\xc0\x2c\x18\x6e\xdc\x9a\xa3\x56\xcf\x57\x76\xfe\x99\x77\x78\x57\x4a\x74\xa7\xcf\x21\xcc\x51\x6d\xf0\x88\x6d\x6b\x4a\x71\xad\xb2\xd6\x69\x57\xdc\x3d\x53\x93\x3b\x9f\x24\xe3\xe2\xc0\xd2\xa4\x2f\x75\x88\x24\x1c\xba\xed\x9a\xb2\x47\x8f\x92\xd1\x81\x0b\xde\x75\x4d\x17\x05\x4e\xf0\x4a\xa5\x7a\x63\x2e\x97\xa0\x3a\xdb\x0d\x13\x55\x9f\xc6\xb8\x8f\x95\x89\xe6\xa3\x6c\x0b\x0d\xef\x34\x48\xd2\x3d\xa9\x72\x34\x40\xf7\xad\x8f\x0e\x95\x79\x12\x89\xd1\x29\x6a\x8d\xd0\x67\x27\x22\x76\x21\xbd\xe6\x5d\x50\xcb\xd7\x43\x72\xb5\x35\xd6\x42\x5e\x0d\xe9\xeb\x5f\x98\xd4\x27\x01\xa8\x08\x7d\x58\x17\x7f\x72\x84\xcb\x5e\xed\x9a\x4e\x8c\xd5\xd5\xdb\xff\x43\xf3\xa8\x60\x2d\x88\xa3\x8e\xf4\xc5\x08\xfc\x44\x3c\x57\xb8\x4d\xf0\x8f\x4b\xf8\x93\x49\xa0\x3b\xd1\xe3\xb2\x96\xa3\xbd\xc5\x06\xa9\xcd\xab\x4e\xb4\x4c\x32\x84\xe0\xdf\x8f\x03\x82\x3f\xe7\x20\x33\xb2\x86\x8f\xae\xe8\x9f\x86\x2b\xd2\x64\x41\xb1\x16\x7f\x8e\x1d\x23\xad\x98\x49\x95\x3e\x6f\xe8\xe9\x69\x31\x19\x99\x27\x44\x80\x1d\x2a\xbf\x7c\xfb\xf6\x9b\x6f\x34\xb6\x88\x2d\x4e\xe0\x62\x14\x1f\x11\x69\x9c\xc4\x06\xfe\xd3\x89\xe6\xa3\xad\x1e\x27\x63\xaf\x1e\x27\xe7\x3f\x3d\x26\x1e\xce\xda\xde\xbc\xa4\x78\xaa\xb4\x48\x74\xfb\x05\x5e\x2b\xa8\xcb\x50\xb2\x92\x43\xdb\x72\xce\xa8\xd1\xb4\xcf\x88\x4e\xf0\x55\xe6\x9c\x4f\x5e\x90\x7c\x36\x02\xfc\x7e\x7e\xe5\xe5\xfb\x35\x93\x51\xcb\x3c\x25\x25\xa9\x71\x8f\xa9\xb3\xd7\xff\x78\x42\x23\x93\x69\x0c\x2f\x9f\x3b\xb3\xd7\xd6\xf2\x4c\xed\xf1\xfe\xa3\x96\xc3\x70\x4a\xba\x2b\x81\x16\x95\x65\x3d\xeb\xaf\x7f\xb1\xc6\xff\xb1\x76\x07\x50\xfd\x61\x14\x3f\xa1\xc5\x5e\x97\xd5\x49\x19\x6b\xc0\x35\x5b\x94\x5c\xd7\x82\x4e\xb1\x42\x6a\x89\xe8\x19\xe0\x6c\x36\x19\x47\xb4\x0d\x27\xc7\x25\xea\x36\x72\xd0\x44\xe9\xf9\xc0\x05\xb7\xe8\xab\x37\x0a\x5e\x3e\x3f\x23\xe0\x71\xe1\x77\xcb\xfe\x92\xcf\x40\xc6\xc0\xe2\xb5\x04\x74\xc7\x91\xc5\x10\xd2\x5e\xe7\xc2\x24\x52\xd7\x6b\xa8\xae\x97\xea\xa1\xe9\xd4\xee\xa0\x2f\x27\x3c\x98\x23\x6d\xf1\xcd\x9e\x14\x2a\x4a\xc7\xf7\xe7\x26\xf0\x0e\xb5\x35\x3f\x34\x26\x5c\xd1\x56\x07\xe7\xe5\x60\xd9\x42\xdb\xf1\x17\x2d\x56\x22\x17\x4d\x5d\x0a\x5b\x4c\xc3\x0f\x1a\x00\x74\x06\xb6\x3d\xeb\x58\xad\x38\x2f\xd3\x29\x54\x93\x80\x62\x23\xaa\x0c\x71\x04\x6b\xbe\xb3\xd7\x8f\x78\x60\xf0\xa6\x8f\xfa\x40\xac\xf7\xc0\x12\x3d\x62\xef\xfc\xd4\x06\x9f\xbf\xad\x4e\xe3\x41\xcf\xe0\x0b\x63\x7c\x59\x5a\x6e\x9e\x58\x66\x50\x69\xd2\x03\x37\xf8\xb3\xe7\xa0\x4c\x58\xce\x8d\x23\x94\x06\x4b\x42\x2f\xe3\x82\x36\x5b\xaa\x16\xc7\xe6\x20\x4a\x12\x1c\x93\x73\xf5\x26\x70\xa3\x73\x19\xa6\xbc\x90\x7f\x8a\x74\x43\x53\x50\x85\xb7\x32\xbc\xbe\xf5\xa6\xe1\xfa\x10\x44\x3b\xe1\xe5\x4f\xd3\x7a\x33\xe2\xd7\x7f\xe7\xfd\xd8\x3d\x73\xec\x83\xe3\x8a\x3a\x72\x97\x08\xf8\x81\xb7\x5f\x6f\x06\x0e\xfe\x77\x89\xac\x9c\x9d\x8d\xb3\xb1\x74\xdc\x97\xa3\x4a\xbb\xf7\x66\x8a\xd9\x19\x28\xcb\x20\xc5\x07\x08\x22\xd0\x66\x23\x88\x1a\xe0\x60\x5c\x5a\x0d\xc2\x7d\x2e\xda\x87\xd4\x59\x43\x98\x31\xa7\xce\x97\x3a\x43\xe5\xef\x34\x7c\x8d\xf2\xfa\x0f\xac\x66\x58\x8e\xed\xe8\xdb\xe7\xd3\xb5\x5a\xc3\x0a\x99\xfc\x84\x12\xed\x37\x8b\x30\x7b\xc7\x01\x6b\x5b\x4c\x94\xf7\x7a\xaa\x4d\x5f\x55\x09\xeb\xa2\xd8\x94\x6e\x72\x53\xfa\xf7\x56\x27\x71\x31\x55\x66\xee\x04\x9c\xfa\xd0\xc0\x85\x35\xff\x66\x0b\xa7\x10\xb0\x0e\xcf\x68\xa6\x97\xdf\x7c\x4a\x37\xd2\xff\x61\x4b\xe2\x86\x44\x83\x3f\xa7\xfa\xb9\xbb\xb1\xc2\x9f\xd9\x97\x2b\x3d\x39\x8a\xf8\x44\xcd\x21\xa2\x1c\xca\x69\x70\x09\xab\x1c\xf2\x50\x6a\x5c\x27\x4d\x6f\xae\x65\x7e\xe1\xc4\x7a\xcb\x33\xa5\x0b\x75\xc9\x6b\xab\x7c\x73\x22\x0f\xd7\x05\x4b\x8d\x06\x0d\x87\xa4\xfd\x7b\xae\xbe\xd5\x21\x6b\x4d\x83\xfb\xe6\x9e\x55\x96\xe7\x68\x44\x77\xb3\x95\xaf\x3f\x72\x44\x1f\x16\x7d\xa4\x91\xee\x38\xe0\x82\x3d\x1c\x88\x38\xe4\x10\xcb\x43\xdf\x22\x87\x75\x5b\x10\x46\x83\x85\xd5\x52\x6e\xfc\x78\x4f\x1f\x07\x8b\x7e\x1b\xc6\x12\xd6\x4d\xa7\x5c\x92\xc1\xdf\x50\x8a\x6c\x86\x15\x16\xce\x48\xf2\x89\x42\x9d\x3f\x7c\x40\x0b\xe0\x60\x2f\x8b\x16\x6a\x9e\x4e\xf2\x80\x12\x8a\x54\x1f\x1e\x8b\x25\xc3\x66\x23\x3a\xa9\xc8\x58\xb7\x63\xc7\xcc\xea\xea\xaa\xb1\xa1\x9b\x9e\x2a\xc5\x95\xd3\xc0\x64\xa2\xac\xc9\xef\xd5\xd5\xc5\x73\xa0\x93\x04\xdb\x8e\xf3\xd2\x1b\x6c\xb8\x61\xe9\x1c\xb8\xb8\x5e\xdf\xc1\x87\xe7\x6d\x93\xc2\x38\x74\xf2\x3b\x2e\xf5\xbd\xe0\xd6\x30\x5d\xc2\x68\xfe\xe0\x7b\x18\xe6\x85\x30\xa9\xc3\x0e\xa9\xcd\x99\x6b\x82\x37\x39\x07\xe9\x9b\x03\xdd\xc6\x92\xbd\x11\x6c\x31\x39\x7a\x48\xdb\x32\xca\x91\xc3\xda\x94\x3e\x73\x88\x5d\xe5\x4f\x50\x67\x7a\x92\x7c\x20\x48\xae\xfa\xe3\x8e\x81\x3f\xc4\x3c\xcb\x8d\xe4\x76\x76\x60\x4c\xfb\xf1\xf3\x1d\xc5\x06\xa6\xe9\xde\xa0\x2e\xae\x45\x75\x2c\x58\x31\xec\xe2\x40\xc8\xf6\x19\x3a\x47\x8f\xc0\x2b\x99\x4b\xf9\xb8\xea\xc8\xd1\x3b\x1d\x23\x14\xb3\xd1\x0b\x1e\x8f\xe1\xec\xf1\x18\x53\x8f\x59\x2a\x83\x45\x9b\xe0\xd8\x97\x19\x29\x6f\x1a\xe2\x5f\xda\x29\x87\x47\x79\xca\x90\xb8\xa4\xbb\x74\xda\x46\x60\xd1\x1b\x8a\x98\xe0\xc6\x3e\xbc\x03\x1a\x0b\xc1\x0b\x5d\x28\x81\xa5\xc4\x2e\x01\xad\x3d\x8c\x3a\xba\x1a\x4f\x27\x6c\xcc\x61\x0e\x8c\x00\xa4\x53\xe9\xc3\xe9\xda\xb9\x92\x50\xf1\x8d\x9a\xd3\x51\x03\x7b\xe8\xda\xbb\x03\xe6\x82\x2e\x06\x75\xf3\xbc\xc1\xab\x92\x98\xbb\x73\x9b\xea\xa6\xf7\xad\x3a\x98\x4c\xca\xe4\x18\x6e\x2f\xc6\x28\x79\x36\x19\xa9\x9e\x23\x81\x8d\xda\xe3\xab\x57\x31\xe3\x4a\x7c\x7d\x70\x22\x46\x50\xdc\x79\x21\xf5\x36\xbd\x8a\xf4\x7b\xc8\xc8\x37\x64\xc3\x48\xe6\x0c\xd2\x06\x17\x2b\xcc\x1b\xe4\x78\xf2\xdc\xa0\xbf\x33\xc4\x8e\xd1\xfe\x99\x75\xc9\x19\x85\x79\xa4\x26\xd9\x2b\xfb\x41\x07\x27\x63\x5d\x93\x9c\x4d\xe2\x36\x34\xe9\xe5\x37\x7a\xf2\x85\x46\xd2\x13\x6b\x90\x9f\x60\x4f\xe5\xd2\xa2\x4b\x38\x99\x39\x9d\x4f\x7e\x86\xba\xe3\x27\xd7\x1c\x7f\x71\xbd\xf1\x90\x18\x97\x19\x02\x9d\x4f\x3e\xb7\xec\x78\x36\xc9\x55\x88\xbc\x7c\x5e\x6f\xd4\x24\x43\xd2\xf6\x84\x08\xf2\x2d\xeb\x55\xb3\x67\xb6\x98\x8c\xfc\xbe\x2b\x56\x6a\x47\xa9\xeb\x6b\xcc\xaa\x66\x8e\x9e\x38\xaf\x6a\xfc\x0c\xca\x15\x6b\xf1\x4a\x24\xcc\x32\xc9\x9a\xb5\x72\xd7\xe8\x73\x3a\x1f\x78\xf0\xe1\x0f\x3c\xd8\x26\xf1\x7a\x22\x2b\x37\xd0\x80\xc1\x29\xf3\x55\x1c\xe6\x7c\x89\x65\x97\x6b\x3b\xef\x74\x72\x82\x0b\xcd\x7d\x51\xb0\x1a\x63\xc7\x3c\x2b\xc6\xbd\x2c\xfa\x7d\xdb\x94\x54\x02\x7e\xfc\x5d\xd3\x54\xb0\x3a\x8b\x2d\x1d\x4b\x9a\xdb\xb7\x60\x95\xe5\xcd\x20\xa2\x70\xf4\x82\x31\x58\x9d\xc1\xab\xc4\xa7\xa3\x3d\xd2\xf6\x79\x66\xcd\xc3\x9b\x6b\x3b\x0a\x7c\xc0\xbc\xe6\xaa\x3d\x58\x9d\xc5\xc5\x83\x2b\xcb\x60\x75\x0e\x2f\x0f\xf9\x38\x3f\x6b\xda\x6e\x3e\x39\xc5\xcc\x4e\x8b\x65\x6c\x1f\xcb\xc4\x79\x74\x27\xdc\x3c\x7b\xfa\xbd\xb9\xc3\xcb\xe8\x7e\xfe\xb3\x7a\x3e\xee\xf8\xf8\xdd\x08\xf9\x0e\xc5\x73\x42\x9a\x47\x68\xf0\x1c\x72\x1b\x8e\x7f\xf2\xee\xbb\x2f\x3d\x95\x37\x26\x24\xc6\x00\x1f\x90\xe5\x18\xe1\xc4\xe4\x77\x8c\x5c\xdc\xab\xd4\xc6\x89\x6e\x3c\xa2\x6f\x51\x61\x7d\x00\x8b\xaa\x38\xd3\x9a\x0d\x58\x59\x09\x6e\x8b\x7a\xa2\xa6\xb4\x31\x88\xea\xa5\xfd\x65\x9e\x6b\x81\x67\x90\x96\xf6\x97\x6c\x0b\x7a\x9f\x7b\x9b\xdf\xdf\xdc\xd3\x7c\x5f\xb3\xe7\xf6\xb7\x4c\x9b\x68\xa3\xc6\xe5\x75\xbc\x65\xf6\xb7\x4c\x9b\xc1\xbe\xa5\x3b\x39\xec\x63\x77\xf1\xb4\xb2\x8e\x36\x32\x08\xc2\xc6\xd1\x84\xf1\x5c\x0c\xac\xf2\x2c\x3f\xec\x9e\x67\x07\x58\x41\xfe\xc5\x00\xcc\x2b\xca\x1b\xd0\xc5\x24\x16\x4e\x5b\xf2\x25\xed\x0a\x16\x69\xc7\xf8\x02\x36\xa1\x9e\x70\xfd\x9a\xe9\x4d\x79\x10\x0a\xd9\x62\x99\x70\xad\x8f\xe6\x5b\x0f\xcb\x9d\x8e\x0c\xe4\xa5\xb4\xbd\xd0\xb2\x81\x3d\xdf\xaf\x79\xe7\x3a\x24\xb1\x98\xb0\xd8\x17\x56\x47\x90\xed\x62\x17\x67\xb9\xc6\x6e\xc8\xcf\x77\x89\xa3\x7b\xe2\xfc\xea\x2c\x17\xcf\x1d\xd5\x58\x58\xe1\x2a\x57\xf3\x72\xf1\x6c\x48\x75\x41\xd9\x11\x0e\xa0\xcf\x98\xbb\x0f\x89\xf9\x8a\x70\xfa\xca\x92\x3f\x9b\x52\xf2\xa2\x62\x1d\xd5\x3b\x9e\xd8\x6b\x5d\x42\x86\xf6\x9d\x35\x7e\xf4\x55\x9a\xbf\xa1\x4d\xd4\x17\x75\x70\xd6\x55\x87\xe7\x58\x5c\xa4\x53\x6d\x25\xb6\xb6\xf6\xa1\xfd\x82\xd3\x70\xb7\x30\x43\xe9\xcf\x47\x5d\x1c\xaf\xa9\x9e\x3c\x3d\xc5\x8b\xa8\x5e\xfa\x4b\x2b\x46\xd3\x1d\x2e\x35\xa2\xd1\x67\xb1\x47\x70\x95\x41\x61\xfd\xb3\xf3\x48\xe6\xab\xe5\xca\x4e\x10\xd2\xc5\x20\x85\x66\x98\xd2\x7d\xe9\xf0\x78\xda\x8c\x4a\x07\x9a\x8d\xbb\xe2\x9a\xca\x61\x09\x21\x76\x43\xcf\xc9\x97\xa5\x11\x9e\xc1\x8d\x7c\xd6\x38\xf2\xeb\xa4\x94\x83\xbb\x6b\x25\xba\x94\x0f\x97\xc0\xca\x12\xa7\x47\x52\xd6\x67\xa3\xf1\x0f\x5a\xb9\xfb\x1c\x63\xf8\x41\x10\x7f\x11\x8c\xbe\xcb\xa2\xa4\xa3\x96\x78\x00\x9b\x5c\x24\xdf\xf6\x5b\x69\x8a\x30\x9f\x72\x97\xdf\x00\xf4\x40\xbb\xe3\xac\xc5\xe0\x33\x71\xf6\xcd\xa5\xbf\x00\xc5\x0f\x42\x1f\x46\xc3\x0b\x94\x75\x47\xcd\xb3\xa2\xc6\x80\x9d\x5b\xe6\x22\x6b\x40\x12\x9c\xd1\x67\xe9\x4c\xb5\x7c\x04\x42\x4c\xa8\xbf\x28\x13\xf3\x6f\xcc\x8a\xfc\xaa\x36\xa2\x7b\xe2\x1e\x21\xc9\x44\xb7\x01\x3c\x85\x98\x28\x49\x8a\x69\x0c\xc4\x92\x15\xcb\x73\xba\x17\x26\xf3\x25\x85\x0c\x69\x45\xdf\x31\x34\xa4\x15\x5f\x4f\x30\xea\xaf\xcf\xc6\x19\xfe\xd8\x25\x9c\x68\x19\xe0\xba\x9c\x16\x41\x66\x30\xfa\x21\x64\x7b\x13\xad\x25\xbd\x84\x2f\xf0\x30\x89\xd4\x17\x56\xda\x26\x6e\xfc\x44\x3a\x2c\x3e\x8f\xd5\x07\x37\x77\x66\x0f\x28\x6d\xb9\x3d\x9c\x84\xa9\x4e\x3c\x49\x69\xf6\xf4\x7d\xb6\x1b\xf5\xc8\x65\x49\x63\xb6\x49\x58\xc5\x22\x27\xc0\xf6\x89\x99\x1c\x80\x06\x95\xa7\x77\x0f\x93\x59\xd4\x8a\x52\xd6\xdf\x07\x6b\xc7\x7f\xba\xee\xe6\x53\x2e\xa3\x80\x71\x77\x93\x6f\x81\x7f\xff\x77\x7a\x70\x11\x2b\x46\xfc\xed\xe5\x6f\x69\x82\xef\xa6\x63\x45\xff\xa4\xf1\x28\x53\x8d\x21\xf8\xfa\xf9\x88\x8e\x4c\x8a\x14\x1e\x27\xc3\xdf\x52\xd4\x53\xaa\x54\x7f\x14\x80\x97\x34\x8e\xd4\xd1\xf9\x9b\xba\xe4\x1f\x2d\x32\xe6\xa0\x9a\xe8\xc1\x2c\x1d\x32\xbe\xad\xe4\x48\x0a\x76\xa4\xff\x80\x88\x5e\xa1\x41\x22\xea\xed\x99\x14\x71\x0e\xa1\x11\x54\xae\xe4\xdf\xad\xf7\x89\x60\x06\x63\x85\x87\xf1\x4e\x8d\x93\x95\x04\xa7\xae\x82\xc3\x1a\x2f\x27\xd0\xf6\xda\x4e\x00\xe6\x2e\x0d\xb2\x33\x1b\x61\x60\xae\xeb\x44\xc9\xa7\xa5\x09\x5e\x09\x60\xaf\x2c\xdb\xbb\x79\xf0\xeb\xb9\x5d\x69\x04\xe4\x5f\x7b\xee\x72\xa9\x64\xd2\x84\x52\xc5\xf9\x74\x54\x4d\x4c\x5f\x84\xa4\x53\x98\x67\x48\x11\xbf\x4c\x58\x0e\x6d\x86\xf9\x31\xd9\xa2\xcf\x86\xd2\x30\x2e\x40\x6b\xbe\xce\xf0\xf9\xa1\x55\xef\x4b\x0f\xb7\x08\x56\x99\xaa\x88\xd9\x64\x70\x99\x59\x29\xb4\x92\x67\xdd\x21\xd8\x00\xc0\x5e\xba\xec\xcc\x3e\xb0\x38\x90\x8b\x14\x5c\xcf\x29\x98\x53\x22\x92\x91\x78\xa4\xce\x00\xe2\xce\xee\x7b\xb6\x45\x2d\x78\xed\xe7\x55\x0d\x7c\xe0\xbc\xc5\x5a\x84\xe2\x43\x70\x59\x00\x20\x0c\x68\xe7\xe9\x12\x77\xfd\xa5\x6f\x7a\x13\x69\x38\x18\x5a\xad\xf0\xfc\x3b\x7c\x7a\x73\x8d\xbf\xbc\xab\x92\x35\xc0\xcd\xf5\xfb\xd3\xab\xe0\xe5\x9b\xd7\xb7\x72\x09\x9f\x8c\x61\xb0\x04\x5a\x0f\x78\xce\x7c\x7c\x9c\x44\x80\x18\x13\x2f\x7a\x74\xe5\x0d\x40\x7d\xf5\xb4\xdc\xf9\xeb\xa6\xe9\x4e\x8b\xd8\x47\xb7\x04\xf8\x77\x73\xf0\x7f\x9a\x39\xe8\xed\xc1\x44\xef\x46\x7f\xbc\x78\x81\x25\x17\xdf\x4a\xe0\xb5\xaf\xf6\x4c\x8b\xff\x44\x5d\x62\xb5\xcb\x0e\xef\x89\xb1\x01\x83\xf0\xe3\x56\xd1\x88\xe8\x9f\x7b\xc7\xd7\x14\xc1\x3d\x21\x9a\x72\xdc\x41\xf7\x7f\x2c\x22\x92\x5c\xc2\x95\xfd\xa0\xb4\x2d\x4b\x63\x3e\x28\x12\xd5\xfe\x25\xf1\x0d\x2d\x9a\xb5\x64\x73\x11\x2c\xe7\xf6\x66\x6a\xd7\xea\x8d\x32\x4b\x8a\x96\x18\x04\x20\x9e\x1c\x73\x78\xd2\x92\x06\x2b\x29\xb9\xc4\x73\x88\x49\xac\xc2\x00\x91\x89\xfa\xe0\x4d\x30\x9c\x95\x5a\xea\x71\x45\xf6\x31\xb0\x6e\x2d\x54\x87\xb2\xd1\x5c\xaa\x4c\x1f\xe6\xb1\x45\x68\x18\xee\x0d\x2f\x4d\xd5\xf7\x6b\x66\x3f\x34\x46\xb3\x50\x29\x15\x7d\xb7\x51\x81\x50\x18\xc0\x1c\x12\x1f\x92\x0a\x8a\x7e\x43\x21\x6f\x79\xa2\x3e\x6c\x13\x2b\x50\xb1\x0c\x5e\x63\x08\xb2\x82\x88\x44\xc0\x90\xd0\x96\xf9\xc7\xf3\x5c\xef\x73\x83\xdf\xf9\xa4\xfe\x7f\x6f\xe4\x7b\x4c\x36\xe5\x9f\x67\xe6\x70\x94\xe8\xb2\xe3\xf9\x65\x7a\xb1\x65\x7f\xcb\xb4\x19\xc8\xae\x54\x9a\x0d\xfb\x1c\x0d\x9b\xdb\xdf\xc6\xc8\xc3\xdb\x24\xb0\xb2\xcf\x8e\xd2\x93\xfd\xfa\x83\x6d\x1b\x9d\x75\x76\xa9\xc7\xc9\xc8\x87\x38\x6a\xee\x5d\x4c\xcb\x8b\xce\xc6\x58\x0c\xe6\x6c\x2a\xeb\x1f\xe0\x69\x0e\x8d\x5b\xea\x2d\xdf\x0d\x16\xf0\x1e\xdb\xd0\xd3\x74\x7e\xff\x41\xc7\x60\x48\x1d\x73\xa5\xcb\x69\xcd\x59\x53\xfc\xc8\x81\xc2\x4a\x1f\x2c\x5d\x42\xcb\x6a\xc7\xea\x12\xd3\x44\x2a\xa9\xcc\x09\xee\x98\x9c\xbe\x7c\xee\xc7\x9c\x4d\xc6\x56\x7e\x37\x80\xf7\xce\x46\x3e\xd1\xa6\xc1\xd3\xa6\xd4\x22\x89\xb3\xeb\x55\xb3\xb2\x4c\xdd\x55\xf4\x4c\x6e\x9c\x31\xb5\xcc\x18\x58\xce\x5b\xd1\xff\x99\xc3\x00\x82\xe5\xf0\xd1\x70\x01\xaf\xf0\x12\x8a\x42\x45\x9f\x84\x45\x5f\xcd\xd4\x47\x06\x5c\x12\x9c\xd2\xd1\xba\x40\xd4\x45\xd5\x4b\xfa\xc8\xb1\xbb\xe9\x32\x5e\xdc\x8b\x17\x88\xf6\x11\x5e\xa3\x4b\x81\x9a\x36\x3e\x70\x36\xa5\xfa\x57\xaa\x21\xa5\x6b\x9f\x92\x5b\x20\xd1\xcc\xa5\x37\x57\xc7\x3f\x4f\x9e\xaf\xa1\xaa\xb8\x1b\xd9\xf5\x1a\xcd\xfa\x24\xa6\x82\x9b\xdf\x60\x8b\x87\xd3\xc1\x0a\xde\xbd\x9f\x24\x8d\xc3\xc3\xa7\x83\x49\x87\x63\xe3\x0f\xed\x04\x97\x0b\x5d\x64\x5f\x4e\x83\xb3\x93\x43\x3d\xfa\x38\x49\x1e\x1c\xc1\x0d\xac\xfc\xe0\x51\xb7\x23\xf5\x5d\x99\xef\xe3\x0f\xdd\x1f\x42\xc1\x12\x32\x15\xfd\x43\xa9\x76\x0e\xb1\x0e\x7b\xfd\xc2\x75\x11\xd5\x6d\xd0\x42\x4e\x96\x5a\xfd\xbc\x7a\xa2\x18\xee\xf5\xf2\x08\x1d\x3c\x51\xd1\xe4\x8a\xa9\x06\x1b\x36\xc9\xd0\x12\x3a\x87\x19\xf1\x16\xbd\xff\x17\x5e\xb5\x41\x26\x32\x0a\x56\x60\xe8\xb2\x2c\x7d\xe1\xbe\x9d\x15\x9a\x0d\x59\x5e\x98\xf7\xd2\xd1\xc8\x3d\x6b\xbd\x94\x70\xf7\xcb\x67\x1d\x4c\x0c\xb7\x9c\x21\x74\x8d\x03\x92\x84\x85\xe6\x30\x1e\x93\x49\x45\x85\xbb\xea\xd5\x7b\xb3\x0b\x9b\x1b\xfe\x57\x7e\x88\xe7\x1b\xf4\xb6\xea\x21\xe8\x6c\xdc\xdb\xe9\x07\x7e\x58\x42\xd4\x79\x0e\x9f\x08\xca\x8c\xea\x7c\x9c\x4d\xce\x2a\xa9\xce\x80\xfb\x2e\x9a\xe5\xfd\xc5\x00\xfc\xeb\x1c\xd8\x19\xd0\x07\x03\xa5\x4b\xb9\x9e\xe7\x40\x9f\x3d\xad\x20\xfc\xc4\xa4\x1a\x88\xeb\xf7\x17\x56\xac\x0e\xe6\xcb\x4d\x37\xc9\xcb\xda\x84\xc8\x4d\x04\xff\x4b\xe8\x9c\x72\x0b\x27\x48\x5d\x2b\xe7\x27\x13\x7b\x1e\xba\x9f\x9b\xde\x75\x84\xcd\x9d\x22\xb3\xe0\xbb\x6f\xef\xf9\x7d\x39\x6a\x16\x52\x3f\x1d\x1c\x86\xd5\xd9\x5b\xaa\xcf\xb8\xe8\x4e\xd3\x66\x93\x51\x27\xe1\x89\x2e\xba\xee\x80\x68\x99\x0e\x8a\x96\xfc\x63\xe2\xdc\x9e\x3b\xb5\xc1\xee\x94\x29\x37\xad\x86\x63\x36\x19\x52\x4d\x86\x66\x72\xa4\x42\x68\xf4\x71\x2a\x2d\x13\xed\x15\xdc\x07\x9e\x5e\xc3\x1d\x7c\xe0\xd0\xbb\xf1\x18\x77\xa5\x40\xe7\xe0\xe3\xfb\x37\x2a\xfa\x52\x36\xff\xc8\x8b\x5e\xf9\x63\x8e\x83\xc8\xaa\x8f\xdc\xe9\xd0\x71\x34\x58\x4a\x7a\x5f\x90\xdf\x82\x4f\x63\xe4\x30\x70\x11\x2c\xd6\xb5\x2c\x1c\x8c\xf8\x99\xd1\x86\x08\x4e\x57\x1c\x40\x9c\x49\x93\x04\x31\x85\xe1\xb4\xbe\xee\x46\xb9\x2b\x8a\xa2\x42\x08\x6f\x31\x5d\x64\x42\x29\x55\x54\x8a\x36\xe2\x88\xe5\xbe\xe9\x7f\x0e\x7b\xc7\x83\x67\x2a\x0d\x1c\xe7\x0f\x5b\x9e\xef\x61\x24\x02\xc1\x7f\x71\x4f\x9b\x94\x6c\x70\x68\x20\xe7\x38\xc4\x0e\x18\x4d\x32\x1b\x13\xbd\x51\x7e\x30\x7b\x17\x24\x9e\x4e\xeb\x3a\x76\xb0\x95\x1c\xbf\x1f\x86\xa3\x29\x21\x81\x67\xdc\x45\x9d\x04\xc4\xc2\x31\x53\x62\x1f\x64\x99\xf2\xa9\x4a\xf8\x94\x33\xa1\x62\x82\xfe\xc0\x0f\xf2\xc8\x1a\x87\xe9\xab\x93\x6b\xa5\xa1\xf5\xf2\x68\xe9\xc6\x68\xba\xa3\xcd\xbf\xd3\x61\x71\xfc\xeb\xe6\xfa\x6e\xf1\xa4\x75\x7e\x7e\x36\x0d\x3e\x65\x6f\xc2\x8a\x05\x6d\x42\x9a\xef\x83\xe4\xe7\x19\xad\xad\x6c\xb6\xbd\xe2\x19\x83\x1d\x78\xf7\x7e\x44\xbd\x27\x1c\x89\x18\x5c\x3d\x65\xe6\x8b\x49\x66\x36\x3f\xd8\xd8\x3e\x8f\x25\x14\x73\x45\x0a\x3e\x39\x47\x02\xca\xde\x4e\xe8\xd5\xc2\x30\xe8\x30\xb6\xb5\x9f\x99\xcd\x84\x4f\xe7\xde\xce\x68\x41\xc9\x18\x79\x74\x7b\xd4\x99\x97\xc2\x05\xe2\x7a\x0c\x60\x27\xb9\xa9\x81\xce\x71\x6b\x31\x8c\xa7\x25\xce\x91\xe3\x17\xcf\xf2\xab\x38\x99\xea\xcf\x0c\x15\x47\xd1\xfc\xe6\xac\xcc\x25\x4d\x5f\x71\xd9\xe7\xac\x34\xd4\x58\xf9\x6f\x7c\xc0\x81\xab\x8b\x67\xe7\xf1\xc9\x53\xd5\xf4\xc5\xd8\x40\x7f\xcb\x2a\xf0\xcb\x74\x59\xba\x86\xa7\x0a\x7a\xa7\xc7\x9c\x27\xf1\xf3\x08\x7f\x92\xfb\x19\xf0\x8e\x0a\x7e\x87\xb4\x73\x74\x41\x90\xbf\x0e\x24\xee\x09\x6d\x43\xbf\xb8\x79\x29\xdd\x14\x0d\x8c\x5f\x9e\xc1\x4f\xfd\x90\x20\x0e\x50\x43\xf7\x31\x1b\xbd\xe8\xe6\xc6\xe3\xa1\xac\x50\xbd\x3e\xa9\x4a\x21\xdc\xdf\x40\x63\x8f\x7d\xa6\x63\xe3\x73\x4e\x35\x9f\x3b\x0a\x7f\x97\xdc\xcd\x62\xca\xbb\x65\x62\xe5\x08\xb7\x6f\x37\xd7\x3e\x2e\x60\x59\x34\xef\x59\x23\x87\x08\xf2\x8c\x82\xce\x79\xe7\x67\xc0\x5f\x41\xe8\xed\xe6\xda\xb1\x26\xfa\x2d\x22\x76\x58\x4e\xeb\xad\xa1\x14\x18\x6a\xd4\xbc\x6e\x4b\x4a\x83\xa2\x26\x57\x24\xb8\xd0\x93\xa1\xeb\x44\xed\xe4\x78\x03\x8b\x50\x1c\x3f\xcf\x89\xe1\x1f\xbc\x42\x2a\x2a\x2c\xee\xf4\x27\x79\x9a\x8d\xf1\xe4\xb8\x3c\x8f\xa0\x3f\xbf\x5c\xe9\x2c\xcd\xe7\xc6\x81\x97\x2b\x3b\x02\x7c\xb6\xc0\x4f\xa0\x5c\xc2\x6b\xf4\xfa\xf4\x7a\xcd\x3d\x46\x6b\x0e\x15\xdd\xd4\x87\x35\x39\xc0\xff\xda\xb3\xca\xa6\x62\x54\x03\x1a\x94\x8c\x6a\xbb\xa9\xd5\xd4\x42\xf7\xdc\x03\x3d\x83\x97\x8e\x01\x69\x52\x64\xbc\xd9\xa2\xe2\xf5\x56\xed\x7e\xc6\x85\x50\x26\xd2\x19\x2c\xb4\x9b\x42\x42\xd3\xeb\xb2\xa4\x75\xd3\xd7\xa5\x3c\xaa\x85\x50\x76\x58\xd6\x70\x4b\x18\x53\x2f\x32\x16\x2f\xe1\xea\xa2\x2e\xe6\xb3\x80\x22\xdd\xc2\xe1\x4e\xdb\x8b\xaa\x50\x08\x30\x68\x1b\x29\x05\xa5\x0f\xb4\x3c\x96\xcd\xde\x0e\xb3\x67\x07\x7f\x85\x5e\x10\x28\x59\xe4\xc6\xb4\xca\x59\x0b\x70\xbc\xf3\xc4\x27\xab\xf1\x80\x40\xe6\x0e\x91\x39\xbc\xd2\xce\x3c\x46\x00\x90\xbd\xf5\x77\x00\x6b\xfe\xd1\xca\x8d\xa6\x1b\x9f\x70\xec\xde\x0a\xea\x00\xab\xa7\x19\x41\x88\xe4\x77\x7a\xd6\xf7\x39\x59\x16\xcb\xc0\xd8\x4a\xa2\xcb\x8d\x5f\xae\xce\xb9\xdd\x78\x64\x6c\x67\x1b\x10\xd5\x9d\x09\x69\x76\xac\xc7\xc9\xe9\x27\x96\xf8\xcc\x7f\xff\x01\xfe\x71\x92\x6f\xff\x08\xb1\x5c\x34\xe8\xc4\xda\x9a\x1b\xc5\xf7\xd1\x2b\xa7\xeb\x01\x2d\xfb\xe7\x3a\x5c\xa3\x9d\x30\x52\xe8\xb6\x93\xbb\x1c\x67\xa0\xc6\x36\x74\x4f\x19\xa9\x17\x1b\xe2\x11\xa3\x1f\x18\xcd\x8a\x4a\xe7\xf8\x9d\xdc\xfa\xb3\x4b\x5d\x49\x5d\x7c\x73\xca\x39\x38\xa1\x4d\xb2\x61\x33\x87\x81\x39\xfc\x85\x3e\x6d\xea\xb1\x82\xd8\xf0\x9a\x19\xa5\x24\xd2\x5a\x50\xb1\xf3\xc2\x1a\x0b\x36\x86\x8a\x3d\x28\x95\x82\xcc\x44\xc1\xb2\x34\xa6\x96\x22\x2e\x8e\xc0\x1e\xa1\xc1\xa7\x28\x94\xaf\xed\x4a\x85\x15\x36\x1b\xbc\xac\x8f\xa6\xa0\xeb\x01\xcb\xac\x0b\x71\x4c\x2a\xff\xdd\x37\xf8\xd9\x7c\x83\x5c\x81\x70\xd4\x40\x9f\x84\x09\xc2\x02\xd4\x81\x3e\xb4\x53\xe5\xba\xd8\x71\x4c\x88\xce\x7c\x89\x3e\x69\x8f\x4a\x40\x47\x2d\xcb\xec\x97\xc7\x6c\x94\x13\x2b\xe1\xa2\xa1\x6d\xe5\x18\xab\x0f\xfb\xa6\xe3\x79\x5e\x41\x5e\xfb\xad\xfe\xbc\xee\x10\x59\xe8\xb1\x92\x9c\xab\xd2\x80\x9d\xf9\x10\xae\xb9\x89\x6e\xcb\x6d\x88\xb6\x24\x79\x97\x5b\xc7\x22\xe5\xcf\xcf\x2d\xbc\xfe\x45\xf0\x69\x04\xec\xd7\x64\xda\xb7\x7c\xf3\x54\xb5\x7f\x94\x85\xcb\x94\x77\xed\xad\x76\x59\xf6\x1d\x3b\x14\x78\x71\xe2\x0a\x89\xe3\x27\x00\x4f\xa3\xf3\x48\x18\x45\x94\x27\xc3\x28\xc2\x7f\x28\xc4\xc1\x97\x98\xae\xe7\x2d\xd3\x4d\xb3\x59\xa4\x5f\xe9\xf9\x85\xad\x17\xbb\xb3\x98\xe1\x8e\xae\xf8\x67\x53\x0b\xee\xa6\x4c\xa7\xd2\xa5\xfd\x3e\x80\x3b\xd7\x88\x92\xa8\xed\xc4\x1e\xab\x39\xd1\x33\x08\x8a\xcb\x17\xe9\x60\xf9\x08\x07\x7d\x65\x3c\xf5\xd1\x03\xa8\xee\xf0\xf2\x27\x46\x07\x76\xff\xeb\x3f\xfe\xd3\x7e\xe7\x89\xe6\x4d\xa7\x09\x48\x84\x2e\xf8\x4b\x00\x0b\x3f\xa7\xf6\xee\xbd\x8e\xd4\x04\x2b\xc4\x34\x45\x8d\x9f\xd6\x37\xc2\x8b\x97\xd0\xb7\x8b\x01\x82\xcb\x64\x21\xd2\x72\xf2\xa9\x30\x4e\x19\xeb\x40\xa7\xf8\xca\xac\xc6\x1b\xd1\x74\xc9\x8a\xdf\x34\x0f\x36\x4e\xad\x76\x89\x6c\x36\x07\x46\xf1\x6a\xb6\x33\x76\xe8\xd5\x3d\x1e\x31\x69\xe8\xae\x64\x73\x7b\x64\xcb\xd4\x0e\xa6\x51\xf6\x6e\x0e\x63\x61\x52\xf7\x86\x0e\x2b\xa3\x35\x9c\x4e\x62\xed\x69\x4b\x36\x04\xe8\x6f\x20\xc7\x40\xf0\x40\xdf\xce\xd4\xf6\xb9\x86\x45\x3b\x7d\x0d\xdd\x28\x94\xb9\xa8\xb1\xe2\x4c\x93\x26\x83\x92\xd5\xdb\xca\xd7\x8d\xeb\xbd\x37\x88\x60\x1b\x45\x41\x0e\xb7\x91\xcf\x69\x7a\xa8\x9a\xa6\x5d\x9c\x6d\xe9\x64\x0b\xa1\x02\xd3\xa7\x1c\xb7\x79\xb2\x5d\x07\xa4\x30\x68\x35\xa0\x87\x0c\x89\x1c\x11\x0f\x5f\xe1\x92\x50\xfa\x4a\xdb\xe2\x67\xb8\x21\xf4\x3c\x9f\x32\xe5\x81\xc7\x2f\x31\xfd\x9a\xda\xde\x22\x17\x3e\x77\xbf\xe3\x59\x76\x18\xdc\x1f\x19\x49\x55\x8c\xcc\x7f\x7a\xcc\xbf\x27\xb2\x5b\xc1\xa7\xc7\x49\x66\x1f\xe8\x28\x14\x5d\xd0\xd7\x49\xf8\x50\x37\x0f\xf6\x58\x44\x8c\x7f\x9d\x64\x89\xb9\x49\xe7\x7d\xbd\x0a\xba\x71\x27\xed\xcb\xa9\xef\x18\xe2\xcf\x55\x59\x87\xe8\x08\x90\xe2\x8f\x83\xfb\x51\xdd\x0b\xfd\x31\x1f\xfd\xd6\xb0\x97\x6f\x62\x4e\xff\x14\xd5\x81\x0c\x40\x3c\x8c\x32\x7e\xe2\xcc\x9f\xf0\xf1\x23\x60\x42\xf7\xb7\xc1\x80\x9f\x26\x89\x33\xfb\xf2\x39\x15\xe3\x87\x7d\x42\xd0\x87\xfb\xa4\x17\xeb\x9b\xe3\x6f\x6c\xcb\x7f\x40\x19\xb2\x82\x17\x88\x20\xb6\xe5\x2f\x12\x8d\x3e\xd6\xdb\x5f\x68\x0c\x2b\x78\x61\xd6\x9b\xed\xfb\x38\x79\x9c\xfc\xbf\x01\x00\xac\x4d\xe9\x20\xa3\xb2\x00\x00"
 
 func nftstorefrontv2CdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -117,7 +119,7 @@ func nftstorefrontv2Cdc() (*asset, error) {
 	return a, nil
 }
 
-var _utilityExamplenftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x3b\x6b\x73\x1b\x47\x8e\xdf\xf5\x2b\x10\x7e\xc8\x91\x39\x9a\xb4\xf3\xda\x5d\x96\x19\x27\x91\xac\x1c\xaf\x6c\xad\x4b\xa2\x9d\xab\x72\xa9\xbc\xcd\x19\x50\xec\x68\xa6\x7b\xd2\xdd\x43\x9a\xe5\xd2\x7f\xbf\x02\x7a\xde\x0f\x92\xb2\x2f\x57\x77\xfa\x90\xd0\x33\x00\x1a\x40\xa3\xd1\x78\xcd\xf4\x9b\xb3\x6f\xce\xbe\x01\x58\x6e\xa4\x05\x69\x41\x28\xc0\x8f\x22\x4e\x22\x04\x49\xff\x8d\x51\x39\xe1\xa4\x56\xa0\xd7\x20\xe0\x32\xd2\x3b\xb8\xd2\xea\xc9\x65\xaa\xee\xe4\x2a\x42\x58\xea\x7b\x54\x44\x21\xb5\x52\xdd\x81\xdb\x20\xbc\xfb\x16\xac\x13\x2a\x14\x26\x9c\xd0\x9b\x85\x23\xca\x4a\x3b\x48\x84\x71\x44\x88\xa0\xf4\x7a\x2d\x03\x29\xa2\x02\x16\x56\xa9\x03\xe9\x40\x58\x9b\xc6\x18\x82\xd3\xb0\x42\xc2\xb7\x32\x96\x91\x30\xf4\x60\xa3\x77\x10\x0b\xb5\x87\xab\xcb\xa5\x85\x9d\x4e\xa3\xb0\xe4\x93\xc9\x06\xda\x20\xac\x53\x15\x10\xd3\x22\x92\x6e\x3f\xa9\x48\x18\x68\xe5\x8c\x08\x1c\x84\x1a\x3d\x4b\x25\x36\x91\xb5\x3a\xd9\x48\xeb\x64\x20\x1c\x86\x10\x44\xc2\x5a\xb9\xa6\x7f\x49\xcd\x42\xda\xbd\x75\x18\xc3\x5a\x1b\x90\xce\x32\x17\x13\x92\x2f\xc4\xb5\x54\x68\x41\x10\xb3\xa4\xbc\xab\xcb\x25\xec\xa4\xdb\x40\x2c\x95\x8c\x45\x04\x31\x3a\x11\x0a\x27\x98\x9b\xe9\xd9\x99\x8c\x13\x6d\x1c\x0c\xae\xb4\xca\x75\xc9\xaa\x1c\x14\x6f\xde\x49\xdc\x5d\xa3\xd5\xd1\x16\x4d\xf9\xf4\x75\x46\x87\xde\xda\xc1\xd9\x99\x08\x02\xb4\x76\x28\xa2\x68\x54\x4a\xf7\xd2\x6f\xe1\xd5\xe5\x72\x06\xcd\x05\xe0\xd3\xd9\x19\x00\x40\x15\x11\xb7\xa4\x80\xd7\x52\x39\x0c\x87\x0a\x77\x8b\x8b\x19\xbc\x5d\x28\xf7\xe3\xf7\x23\x0f\x3c\x9d\x4e\xe1\x26\xdf\xa7\x37\xc2\x6d\x6c\x8b\x46\x84\x0e\xce\x75\x14\x21\x6b\xfe\xc6\x69\x23\xee\x90\x40\x67\x50\xf9\xc7\x11\xb4\x37\xe9\x2a\x92\x81\xc7\x2a\x7f\x97\x3c\xd0\xbf\x60\xb7\x41\x83\xbc\xd9\x31\x71\x6c\xc0\x6e\xd8\x10\x56\x08\xd6\x69\x83\x61\x01\xbe\xdc\x60\x69\x5e\x09\xb1\xcd\x5b\xe7\xed\x24\x5f\x13\x84\xc9\x11\x41\xaa\xe6\x4b\x83\x56\xa7\x26\x40\x70\xfb\x04\x3b\xb9\x67\xb5\x99\x5e\x81\x0b\x66\x7e\x47\x08\x36\x5a\x5b\xcf\xba\x12\xb1\xb7\x12\x12\x66\xcc\xb6\xef\xc8\x42\x69\x19\x08\x84\x82\x8d\xd8\x22\xdb\x24\x43\x2a\xbd\x2b\x08\xad\x30\x10\x69\x46\x86\xd7\x5e\x8b\x00\x4b\x8b\x36\xf8\x67\x2a\x0d\xd2\x51\xa2\x13\xc3\x64\xc0\x26\x18\x90\x25\x7b\x6a\x44\x36\xd6\xa6\x2d\x4f\x21\x6d\xa7\xe9\x4c\x88\xdf\xcc\x7c\xba\x34\x21\xc3\xdc\x6c\x4a\x18\x62\xf8\xd2\xe8\x98\xb9\xbd\x90\x36\x89\xc4\xbe\x38\x0c\xb0\x95\xb8\xeb\x25\x47\xac\x92\x2e\x8d\x54\x77\xbd\x40\x21\xda\xc0\xc8\x84\xf6\xea\x28\xac\xdb\xa4\xf1\x4a\x09\x19\x15\x90\x75\x36\x33\xd3\xb8\xd6\x7b\x11\x39\x89\xf6\x30\x9f\x16\xa3\xb5\xa7\x6b\x72\x84\x19\xbc\xaf\x9d\xcf\x89\x27\xb5\xbf\xad\x2f\xf4\x1b\x2a\x34\x32\x80\x50\x7a\x2f\x65\xf6\xec\x14\x8d\x20\x9f\x42\x1c\xb0\x5d\x08\xdb\xbf\x62\xce\xd8\x0c\x3e\x79\x49\x66\xf0\x8b\xda\xdf\x38\x93\x06\xee\xa1\x5c\x4c\x2a\xe9\x86\xc5\xbf\xe8\xaf\xaa\xd3\x71\xed\x4d\x87\x22\xeb\x00\x2d\xed\xd5\x5f\x1f\x57\x42\x1d\xfe\xa0\x08\x25\xe8\x08\x3e\xd5\xd0\x48\x07\x13\x19\xc2\xdc\xff\x4a\x53\x19\xb6\xdf\xb3\x91\xcf\x59\xd8\xf6\xcb\x8a\xa0\x30\xaf\x8a\xdd\x06\x2d\x44\x86\x79\x29\x7e\x1b\xac\x10\x1d\xe6\xa5\x1a\xda\x60\x85\x35\xcd\x0b\xe1\x0b\xa0\x87\xba\x85\x04\x06\x85\xc3\x97\x71\xe2\xf6\xa5\x73\xcc\x9e\xfa\x4b\x9a\x5e\x55\x1c\x67\x0d\x5b\xa8\x10\x0c\xba\xd4\x28\x9b\x79\x01\x76\x6a\x22\x8a\xc8\x59\xd2\xbf\x04\x5f\x96\x7b\x76\x34\x7a\xa7\xf8\x22\xab\x91\xf8\xf9\x53\xeb\xf0\x97\x8b\x3d\x74\x9e\xb0\x75\xaa\xba\xf9\x1e\x8e\x66\x47\xe8\x35\xf6\xd8\xf3\x0e\xcf\x9f\x94\xf7\xd8\xa4\x9b\xb2\x5a\xbb\xe5\x3e\xc1\x19\xd0\x7f\x9f\xff\x5c\x81\xbf\xba\x5c\xfe\x34\x1c\x8d\xba\x14\x5c\x65\x9a\x0e\x36\x73\x7e\x87\x8e\xad\x95\x98\x7d\x4f\xd4\x6e\xbb\x99\x7a\x5f\x7b\x48\x7f\xbc\x74\xdd\xe2\x33\x3f\xf7\xd3\x70\x34\x3e\x05\xbc\x70\x38\xa7\x22\xbc\x0c\x25\x89\x7f\x3a\xfc\x47\x87\x46\x89\xe8\xed\xf5\xab\x53\x51\xae\x2e\x97\xa5\x9e\x2f\x84\x13\x9f\x87\xf8\x38\x45\xdc\xa0\x91\x22\x3a\x15\x7a\xc9\x0e\xf3\x64\x1d\xbc\x7b\xfd\xab\x91\xe1\x1d\x86\xf9\xf3\x9f\x86\xa3\x1a\xe2\xed\x31\x63\x21\x3b\x31\x3e\x22\x23\x9a\xc3\x0f\x6c\x3e\xde\xf8\x46\x15\xf7\xf5\xa2\xe9\xb3\x76\xd2\x05\x1b\x6f\x6b\x9f\x5a\xbc\x06\xc2\xe2\x61\x23\x9a\xb5\x70\xa0\x34\xc8\x4e\xa4\x61\x27\x06\x14\x17\x40\xe1\x25\xdb\xaa\xcb\xff\x6a\xf7\x41\xd3\x71\xf6\xa3\x55\x6e\x89\x3a\x67\xff\xb1\x5c\xbe\xb9\x94\x11\xf6\xb3\x46\x7f\xa9\x89\x66\x0d\xdf\xdb\x0b\x3f\xea\x7c\xd3\x7e\xda\xa7\xe0\xca\x29\xea\xd6\xb0\x8f\x20\x29\x94\xa2\xc8\x0a\x62\xf1\x11\x54\x1a\xaf\xd0\xd0\x75\xcd\x19\x08\x7b\x52\x72\xa2\xab\x2c\x18\x0d\x61\xed\x83\x9d\x4a\xb2\xd1\x47\xdb\x7a\xbf\x4c\x64\xd1\xb3\x02\x6b\x89\x51\x08\x5b\x11\xa5\xbc\xa8\x45\xf6\xde\xaa\x47\x09\x14\x09\x64\x98\x0b\xb5\xd6\x30\x87\x4e\x01\x87\x7e\xcf\x07\x99\x77\xe4\xe8\x22\x7b\x35\x18\x67\x12\xcd\xf2\x8b\x75\x4c\xfc\xcc\x68\xc9\x6e\xf5\x56\xd6\x7c\x25\xad\x6b\x5d\xf6\x19\xe1\x5b\x98\xc3\xfb\x0a\x6f\xb7\xa7\x9b\x70\xbe\x2d\xfd\x86\x52\x59\xff\x0b\x4d\xa0\x70\x38\x8f\x38\x62\x1e\xa7\x9f\xbb\x4c\x91\x5f\xc8\x59\xf5\x4e\x78\x04\x73\x05\xda\x11\xfe\xba\xc3\x94\xc7\xb3\x59\xbf\x59\x1e\xc1\x68\x05\x71\x38\xd8\x38\x97\xd8\xd9\x74\x9a\x95\x1e\x9e\xa8\xb5\x9b\x68\xb5\x8e\xf4\x6e\xa2\xcd\xdd\x74\x30\x09\xb4\x0a\x84\x1b\x66\xaa\x9d\x38\xed\x43\xc6\xe1\x68\x74\x3a\xab\x5d\x37\xda\x41\x86\x2b\xd1\x44\xe6\xf5\xcf\xb3\x13\xcd\xde\x3f\xcf\x95\x0e\x06\x20\x63\xf6\xfa\x15\x90\xe3\x3c\x7d\xae\x44\xa7\x5d\x17\xff\xeb\x42\x15\x6c\x9d\x2e\x57\x71\xb1\xf7\xba\x65\xfc\x18\x44\x69\x98\xfb\xdc\xa5\xe4\x9c\x36\x84\xb5\xd6\xe4\x2f\xed\x46\xef\x40\xbb\x0d\x1a\x48\x2d\x5a\xf2\xd6\x9e\x64\xbf\x47\xf3\xf4\x42\x0f\x46\xbe\x6b\x50\x92\x1e\x8c\x61\xb0\xd6\x7a\xd0\xed\xc3\x38\xb1\x64\x34\x62\xbe\xe5\x83\x29\xc7\x5b\x6a\x4f\x77\x48\xff\x98\xd5\x93\x81\x71\xb1\xf6\x95\x88\x29\x79\xaa\xb3\x32\x3a\xeb\x53\x41\x45\x74\x69\x41\x40\xaa\xe4\x47\x70\x32\x46\xeb\x44\x9c\x8c\x61\x87\x79\x5d\x24\x16\xe6\x9e\xf2\x00\xae\x47\x09\x08\xfd\x8e\x90\xde\xe9\x0a\x4a\x22\xe1\xd6\xda\xc4\x16\xee\x95\xde\x71\x85\x2d\x57\xa1\x74\x93\x5e\x91\xcb\xe5\x99\xd1\x96\xdc\xfc\x34\xbf\x79\x6a\xba\xe4\xdb\xad\xa1\x85\x9a\xba\x6f\xbf\x1a\x57\x99\x9c\xc1\xe0\x42\x38\xc2\x34\xc2\x48\xb7\x3f\x70\x39\x95\xfb\x30\x11\xa1\xd7\xe0\xb0\xc1\x68\xbf\x42\xc9\x78\x58\x93\x4c\xc5\x6b\x8b\x8c\x81\xf2\x23\xbf\x72\xaf\x32\xd6\xda\xef\xf0\x35\x83\xb5\x74\xe1\x1f\x0f\x6d\xa0\x0d\xce\xe0\xd9\xd3\xc9\xd3\xec\x96\x7d\xf6\x94\x7f\xd7\x42\xad\xc1\xb9\x8e\x63\xad\x06\xfd\xd7\x6f\xbe\xda\x61\x9d\x93\xc5\xf6\x29\x9b\xad\xb9\xa1\x64\x25\xa3\x52\xc3\x75\x81\x4e\x57\x76\x8e\xd7\xa3\xe5\xcc\x07\x95\x98\xa7\xdf\x33\x5d\xd1\x7b\xaf\x7b\x58\xe4\x45\x5d\x5f\x92\x96\xd6\xc7\xde\x77\x72\x8b\xbe\xc0\x92\x18\xfd\x07\x06\xce\x87\x68\x3a\x02\xbd\x45\xe3\x4d\x7f\x83\xb0\xf2\x0b\x71\x9c\x24\x2d\x18\x4c\x0c\x5a\xe4\xe0\x4e\x50\xf2\xdd\xb7\xea\xcb\xeb\xf3\xbf\x7d\xfb\x0c\x76\x1b\x54\x05\x0d\xa7\xe1\xe5\xbb\xd7\xa0\x95\x2f\x9d\x6f\xa5\xf0\xeb\x73\x6d\x13\xa4\x5a\x1b\x61\x39\x67\x48\x4d\xbe\xf0\xa4\xd7\x42\x7f\xc3\xbc\xc6\xed\x3d\xf6\x93\x08\xb7\x18\xe5\x25\xbd\x10\xec\x3e\x5e\xe9\xc8\xef\x79\xbf\xaf\xcb\xb1\x5f\x31\xf2\xfc\xd8\x8d\x70\x30\x5c\xaf\x5f\x17\x64\x42\x07\xc1\x0f\x5e\x1b\xc7\x13\xb4\xea\xdf\x08\x84\xfd\xaa\x19\x53\xb4\x28\xbc\xe8\xc5\x7f\xf1\x02\x12\xa1\x64\x30\x1c\x9c\xb3\x93\xf4\x95\x53\x16\xbf\xa9\xdf\x36\xd9\x9e\xc3\x39\x9d\xc2\xb9\x8e\x93\xbc\xc4\xeb\xf4\x3d\xaa\x8c\xc6\xdb\xeb\x05\xac\x84\xc5\x90\x4c\x41\xf0\x4f\x7e\x46\xdb\x56\xc0\xc2\xe2\x62\x0c\x89\x96\x99\xdd\x6a\x10\xf0\x9f\x37\xff\xbc\x82\xb5\x8c\x70\xc2\x0d\x8c\xbe\x65\x77\x79\x01\x5c\x30\x30\xec\x75\xfa\x6f\x5b\x84\x34\x89\xb4\x08\xc9\x6e\x55\xc8\x65\xee\x8d\xb6\x4c\xdb\xea\x18\x7d\x19\xfd\x89\xaf\x79\x53\xba\x42\x3c\x51\x96\x56\xa9\x46\x07\x19\xdd\xde\x83\xf6\xe6\xf2\x66\x0c\x37\xdf\x8d\xe9\x5e\x11\x4e\xc0\xdb\xeb\x57\xac\x3e\x21\x55\xde\x0e\x62\x11\x42\x69\x30\x70\xd1\x7e\x0c\xe8\x82\xfe\x5b\x85\xf4\x42\x6a\x99\xc3\xb1\x98\xd0\x2b\x37\x77\x69\xd3\x41\x2f\xc9\xd4\xc8\x77\x9c\x4f\xcd\xa1\x1d\x3e\xe6\x81\xe5\x60\xf2\x87\x65\x9f\xfb\x88\x00\xb6\x65\x15\xc7\x92\xee\xda\xc1\x3b\x92\x7d\xfb\xa3\xdc\xc4\xf1\x4f\xfb\xb1\x52\x23\x9b\x69\xf7\xdb\xeb\xc5\xe1\x23\x9c\xa9\x7c\x96\xff\x18\xd3\xbe\x72\x3f\x8b\x36\x30\xdf\x10\x83\x36\x8d\x9c\x25\x63\x11\xe0\x95\x86\xaa\x68\x0c\xe6\x50\x64\x66\xec\x7d\x0e\x7b\x81\xca\x9d\x74\x78\x37\xfa\x28\xf4\x65\xfe\xb5\xc7\x0f\x5d\xe5\xbb\x6a\x2a\xed\x01\x1e\xf2\x0e\x0d\x5c\x90\xa3\xe7\xce\xdf\x0c\xfe\xa9\xa2\x3d\xb7\x66\xb8\x61\xb4\x12\xc1\xfd\x4e\x98\x10\x02\x1d\x27\xc2\xc9\x95\xf4\x8d\x45\xe8\x6b\x9f\x94\x6d\x99\xd2\xbb\x36\xbb\x5c\xf0\xe9\xa1\xdd\x89\x2b\x28\x94\xd0\x1d\x7d\x98\xf2\xe5\xf8\xe0\x02\xb5\x92\x6e\xbd\xdb\x40\x97\x5b\xa0\x15\x45\x7e\xde\xd9\xdc\xa3\xaa\x97\x80\xb3\xeb\x4f\xd4\x5b\x60\x59\x14\xa9\xe0\x5f\xbe\xd3\xf3\x2f\x58\x5c\xf8\xb2\x45\x77\x7d\x55\x18\x0a\xa0\x30\xbc\xba\x5c\xda\x19\xfc\xfc\xc9\x63\xcd\xa0\x5d\x0f\xbe\xba\x5c\x3e\x34\x7a\x17\x30\xec\x2c\xff\x17\x04\xe1\xf9\x13\xd2\x62\xb9\xa1\x35\x01\xee\xd0\xdd\xa4\x49\xa2\x8d\x63\x68\xba\x72\x6c\x51\x17\x17\x10\x49\xeb\x72\x4d\x38\x7e\x97\xd5\xc5\xf9\xce\x0f\x50\x52\x4c\x40\xb2\x24\xae\xd5\x89\x69\xd5\x8e\x5b\x0b\x0d\x47\x33\xf8\xe4\xef\xba\x5f\xb5\x8e\x9a\x25\x6e\xf2\x4c\x36\xc7\x61\x84\x06\xf8\xbc\x2a\x18\x4b\x5e\x83\x7e\xdf\x93\x9a\xdd\xc2\x1c\x9c\x69\x1c\xc0\xcc\xf6\xeb\x14\xfa\xb4\x76\x9d\x29\x68\xb7\x41\xce\xa0\xb4\xe1\xbb\x91\x3c\x39\xc5\x4f\xca\x5b\x01\x19\x06\xab\x06\x43\x58\xed\x1b\x4d\xd3\x1a\xbd\x5f\xaa\xdd\xd4\xa2\x5e\xe6\x91\xb9\x11\xc9\xf4\xb2\x54\xe5\x8f\xd4\xba\x32\x4a\x4c\x91\x68\x87\xb8\x16\x69\xe4\x0e\x6f\x81\xb4\xcd\x1d\x18\xba\x22\xd0\x18\x79\xa5\x76\x17\xf4\x79\xf9\xf9\xbc\x2f\xd7\xed\x53\x13\x1d\x83\xd0\x88\x1d\x18\x8c\xf5\xd6\x37\x65\xc8\x92\xd6\x79\xaf\xb3\xda\x60\x56\x21\x78\xa0\x66\x37\xa6\x29\x54\xeb\x50\xfc\x9e\x2d\xe3\x8b\xcf\xf9\xa2\xc3\xfc\x47\xa5\x51\xdf\xd9\x63\xa1\x33\xd5\x61\x79\x3e\xda\x78\xfe\xa4\x71\x9c\x26\x5e\x96\xe1\x3d\xee\x67\x50\x2e\xd1\xf6\xb6\x5d\xc1\x53\xa1\x90\x4c\x11\xec\x27\xb2\x58\x7b\x2b\x29\x08\x21\x47\xd1\xd6\x4e\xf3\xd2\x2d\x9a\x3f\xcc\x64\x9f\xfa\x43\x4c\xb4\x25\x6d\x8a\x7b\x1e\xbb\xa0\x15\x39\xc8\x09\xc3\x9a\x96\x8b\x65\x6c\xc5\xff\xb5\x9a\x65\x8c\x45\xf0\x8b\x8b\x1c\x53\x52\xc0\x64\xc4\xbe\xb7\x11\x90\x71\x30\x64\x36\x7b\x95\xdf\xf4\x5f\x35\xed\xfb\x1f\x14\xc7\x36\xec\xae\x85\xc2\x0d\x4f\x06\x9f\xc8\xb0\xae\x2f\x12\x21\xf4\x41\xa4\xc2\x5d\x46\x33\x13\xa2\xe2\xf2\x77\x1b\x19\x6c\x0a\x63\xe5\x01\x9c\x88\x82\x51\x6c\xad\xa5\xa3\x70\xd9\x6d\x1f\xef\x73\x0e\x6e\x0b\xee\xeb\xbc\x84\x68\x9d\xd1\xfb\x82\x44\x8b\xd3\x6c\x08\x27\x64\x07\xc2\xa3\x18\xe8\x03\xd2\x24\x35\x14\x37\x5b\xd0\x2a\xda\x37\xb1\x2e\x34\x5b\x18\x8b\xa9\x29\xb6\x35\xe5\xa8\x4b\xaa\x22\xb4\x96\x1e\x36\x47\x1d\x9a\x54\x0c\x0a\xab\x59\x35\x3b\xa1\xd8\x42\x30\x96\x2e\xef\xb7\xbf\x4d\x42\x9e\xf9\xf1\xa3\x30\x45\x7c\xdc\x24\x22\x55\x7d\xfd\x96\xf6\x44\xea\x36\x2c\xfb\x35\xae\x61\x0e\xc3\xaf\x1b\x2a\x24\xe5\x51\x16\x99\xba\x4d\xfb\xb4\x7b\x26\x46\xf0\x75\xb7\x31\xbd\x18\x7d\xd5\xe0\xa7\xba\xda\x24\x65\xec\xa5\x11\xca\xae\xd1\x5c\x08\x87\x43\x7a\x30\xa3\xfb\xe9\x3c\x35\x06\x95\xfb\x35\xd2\xc1\xfd\x70\x34\x29\xea\x44\xf5\xb3\x5d\xb1\x42\xd2\x4d\xa9\x96\x61\x75\xa1\x5e\x9f\x78\x87\x6e\x71\x51\xb9\x62\x95\x3f\x42\xf9\xc4\x17\xbd\xe3\x0b\x80\x32\x91\xd6\xa4\xcd\xd1\x2b\x76\x71\xe1\x9b\xb3\xde\xdf\xf5\xb4\x67\x1b\x0e\xed\x1e\xf7\xbd\x17\xdd\x6f\x98\x4d\x5b\x88\x58\xa7\xca\x15\x3d\x9d\xbe\x51\xa0\xa3\x0c\xbe\x42\x75\xe7\x36\xc4\xe3\x42\xb9\x93\xd8\x8b\x18\xe3\x58\xdb\xb1\x58\x63\xa5\x8d\xd1\xbb\xab\xcb\xe5\xf0\x43\x65\xd6\x66\x34\xeb\xb5\x97\x6e\x26\xfa\x6c\xb2\xd7\xea\xfa\x34\xf8\x2b\xf3\xc3\x6a\x62\x1e\xb3\xfc\xd9\x14\x43\x56\xd9\x51\xcc\x6a\x29\x8b\x8b\x53\xc4\xab\x8e\xbd\x0d\x1b\x52\x56\xdf\x4d\xf2\x1f\x2d\x31\xe5\xda\x8f\x0f\xad\x1d\xcc\xe1\x91\xb2\x76\xf4\x67\xf3\xcc\x61\xed\x3c\x62\x37\x13\x8f\x4d\x3d\x6a\x8a\x7c\xf4\xb8\x47\x7e\xa4\xac\x88\x2b\x93\x69\x70\xc2\xfc\x47\x0d\xf0\xe7\x8c\xb5\x5f\xca\x35\x82\x13\xd6\xf8\xff\x34\xf5\x01\xd5\x14\xef\x73\x34\xdd\x6d\xcb\x85\x3e\xbe\x70\xde\xe6\x34\x55\xd6\x04\x7e\x8c\x5e\x0b\x9d\x66\x84\xa1\xba\x3f\x4d\xdd\x5c\x66\x53\xb3\x9e\xdf\xc2\x8b\x47\x11\x8b\x93\x97\x15\x80\xeb\x0a\xe5\xdc\xac\x4f\x00\x04\x25\xa9\xd0\x98\x0a\xce\x08\x9f\xb5\xcc\xad\x72\x31\xf8\xac\xac\xa8\x37\x70\xe8\x55\x21\xbd\xe5\x22\x86\x8f\x1b\x7c\x5b\x7c\x27\xa3\x08\x56\x08\xa9\xe5\x95\x0b\xe2\xf9\x5f\x88\x5b\x8c\x74\x82\xc6\xd2\x46\x70\x4f\xc3\xc7\x3e\x89\x30\x22\x46\x87\x3c\x48\x9c\x08\x6b\xf3\x8d\xaa\x8e\x74\x8c\x20\x46\xb7\xd1\xe1\xa4\xc6\x7c\x9f\xc7\xaf\x16\x4a\x6d\x47\xef\xec\x45\xd7\x30\x51\xe7\x20\xd1\x67\x4d\xe0\x7c\xee\xf4\xcd\xa3\x8b\xaf\xb7\xc7\x4c\x85\x15\x48\x71\x78\x6d\x62\x32\x3b\x3b\x95\x51\x88\x49\xdb\x26\x78\x5b\xf2\x41\x9a\x8d\x2f\xfe\xe7\xae\x27\x44\x2b\x4d\x66\x05\x93\xb6\x19\x41\x59\x3a\x2f\x8a\xf4\xb9\x11\x19\xfc\x33\x45\xeb\x9a\xc8\x9d\x87\xee\xb4\x46\xe8\x8b\x66\xdb\xb3\x6f\xe4\xa7\x32\xee\xc3\xc2\xd4\xdd\xdc\x97\xb5\xa7\x7d\x01\xbf\x0a\xd6\xea\x02\xb5\x08\x75\xd7\xff\x6c\x75\x60\x99\x2f\xc9\xce\xe9\xed\xee\x62\x63\x52\x99\xd3\x6e\xe0\x96\x63\xdb\x87\x50\xab\xe5\x2d\x56\xc6\xd7\x15\x2f\x5e\xbe\xec\x1c\xee\x2a\xa9\xbc\x92\xea\xde\x97\x33\x3e\x8f\x4a\xa7\xb7\xcd\x6d\x7b\x06\xc3\x75\xfa\xf8\x6b\xac\xfa\xf7\x57\x5c\x69\xd5\xbf\x87\xf6\xe3\xf6\x93\x8c\x89\xba\xd5\x7c\x86\x49\x1e\x98\x2f\xf0\x23\xc9\xa1\x6c\x1b\xe3\x6b\x7a\xda\x6d\x80\x6b\x19\xe1\xe3\x87\xc4\x78\x40\xac\x68\x0e\x08\x6b\xd1\xd9\xc9\x0e\x57\x56\x3a\x7c\x42\x24\xed\x24\xd0\xf1\xf4\x87\xf5\x8f\xdf\xfe\xe3\xfb\xe0\x69\xf0\x37\xf1\xf7\x20\x0c\x7f\xfc\xfe\xbb\xd5\xb3\xe0\xef\xdf\x3e\x6d\xbc\x10\x3f\xfc\x10\xac\x9e\x05\xff\xf8\xee\xc7\x0f\x97\x91\xde\x7d\xf8\x5d\x9b\x30\x16\xe6\x7e\x62\xb7\x77\xdd\x2d\x85\x1e\x4b\x62\xe9\xb3\x6e\xb5\x8c\xc5\x1d\x4e\xed\xf6\xee\xdf\x3f\xc6\x51\x9b\x4a\xef\x0e\x1d\x57\x7e\xb7\x5a\xb2\x86\x2f\x39\xcf\x7c\xc4\xab\xc4\x1c\x74\xf3\x5b\x6f\x39\x67\x69\x79\x11\xf3\x48\xeb\xaf\x57\x51\xfb\x20\xc8\x69\xd8\x60\x94\x70\xae\x9d\xdd\xb2\x3e\x19\x56\xf8\xd1\x65\x9f\x06\x5d\x2e\x27\x3d\x2b\x62\x39\xf0\xd3\xdc\xf5\x47\xcc\x02\x0d\x7a\xf4\x6f\xff\x4c\x85\xc1\x05\x69\x7e\xe6\x37\xa3\x1b\x6e\x25\x94\x42\x73\x1c\xce\xea\x40\x8a\xc8\xce\x0e\x1c\xee\x81\xdb\x49\xe7\xd0\x0c\x4e\x12\x27\x03\x66\xe3\x24\x61\x3e\xac\x28\x15\x0f\x36\x42\xf6\x35\x3a\x1e\x8e\x58\xce\x17\xb6\xca\xff\xf2\x36\x79\xd6\x0f\xef\x5a\xf8\x2f\x68\x91\x37\x7a\xaf\x8d\x16\xee\xdb\xeb\xc5\x04\x16\x95\x46\xe7\xb8\x06\x55\x86\x2d\xd2\x42\xa4\xfd\xd7\x5f\x5a\x71\x19\x88\x5b\xa2\xdc\x38\x6d\x5b\xca\x74\x9a\x7f\xb4\x96\x37\x4a\xff\xc7\x3a\xa2\x5f\xd4\x73\xac\x0f\x7c\x5e\x5d\x2e\x7b\xce\x64\xde\x65\x1c\xfc\xd7\xeb\x37\xaf\x7a\x60\x1e\xdb\x53\x2c\xfa\x89\x3c\x50\x32\x9d\x82\x45\xe7\xaa\xad\x44\x61\xe9\x15\x3d\xb6\x95\xae\x82\x0f\xee\x85\x7f\x94\x1a\x59\x1d\x85\xed\x5d\x2b\xeb\x24\x1e\xeb\x15\x17\xc6\x50\x7c\x84\xc7\xfd\xc5\x6e\xff\x7e\xe0\xd4\x3d\x34\x63\xf8\x3c\xa5\xaf\x44\xc6\xd7\x45\xb3\x8c\x2b\x5d\x0a\x44\x18\x4b\x05\xda\xb0\x31\xb9\x0d\x77\xdf\xb3\x0f\x1a\x7d\xd7\x9e\xf2\x41\xff\xad\x63\x4e\x43\xac\xbc\xb7\x8d\xa5\x72\x5c\xd4\x2d\xd2\xc5\xae\x08\xb6\xfa\xcd\x96\xff\x16\xad\xfa\x8d\xd6\x34\x1b\x15\xa3\x53\x4a\xff\xa7\x20\x3d\x23\x99\x0f\x84\xd1\x3f\x2b\xb5\x99\xc3\x19\x2d\xf1\x4f\xfa\xc4\x8f\xdd\x1d\x19\x8a\xa7\xb3\xf5\xfe\xef\x7c\x79\x54\x80\x53\x30\x57\x8f\xad\xaa\xba\x82\x22\x94\x39\xf0\x69\x52\xbb\x33\xc7\x31\x79\xa5\xbe\x0a\xf3\x76\xc5\xb5\x86\xd0\x9c\x7a\x63\x98\xc1\x2d\xcc\x6b\x64\x26\x1b\x94\x77\x1b\x77\x10\xd3\xcf\xcb\x35\x11\x8b\xea\x6e\xab\xfe\xce\xfe\x2f\x91\x18\x70\x8a\x55\x78\xbd\x5a\x4e\x9d\x4f\xff\x61\xbc\xc2\x30\xa4\xfd\xf6\x53\x61\x20\x15\x8f\xa4\xf0\xf4\x56\x0f\x57\x3c\x58\x06\x73\x18\xac\x84\x19\xb4\x56\xcf\x6a\x10\x85\x01\xd6\xde\x6f\x05\x05\x12\x3b\xda\x92\xb2\x5c\xd1\xb2\xa2\xd2\x92\xba\xe7\x27\x6a\xb6\x74\xf0\x83\x85\x8a\x51\x15\x3f\xdb\x50\x15\xdb\x2a\x7e\xb6\xa1\x4a\x83\x29\xc6\x3a\x6b\x30\x8d\x16\x17\xf7\x1d\xea\x5f\xdb\x7a\xc9\x27\x32\xec\xeb\x86\x79\x80\xee\xc2\x16\x7f\xe6\x37\xaa\x9f\x7a\xb8\xc9\x86\xc5\x48\x4f\xd9\xc7\xaf\x65\x86\xda\x9b\xee\xc1\x1c\xa6\x59\x66\x98\x7b\xd3\x5a\x20\xda\x47\xa2\xcc\xfa\x88\x82\xbf\xbb\x4f\x20\xd0\xfa\x76\xb6\x7b\x7d\x0f\x56\x13\xef\x3c\xb7\xa5\xf3\x8e\x6f\x75\x79\x18\x4e\x6c\xf3\x6f\x60\x33\x82\x05\x7a\x3d\x89\x3e\x54\x1d\x2b\x18\x15\x41\xa0\x53\xe5\x26\x19\xa9\x09\x51\x1f\x3e\x7f\x12\x54\x06\x31\x9c\x3e\x94\x47\x8f\x6a\xdc\x17\x27\x21\x8b\x72\x02\x91\x08\x3f\x54\xd2\xf1\x81\x72\x0f\xdf\xe7\x22\xc9\x67\x9a\x72\xee\x0a\x32\x12\x6d\xc1\xaa\xb4\x36\xed\xcf\x8c\x0f\x71\xdc\xa9\x81\xda\x1a\xcc\xbe\xdd\x0c\x6b\x5c\x8d\x41\xb8\x03\x65\x81\x51\xf7\x3e\x66\x57\xd7\x63\xf6\x30\xfb\xfc\xbb\xe6\x2e\x3c\x99\x13\xb7\xcf\x13\xa8\x6c\x5d\xcb\x1e\xf3\x22\xe9\xc3\xd9\x7f\x07\x00\x00\xff\xff\x28\xfe\x05\xd4\x2a\x41\x00\x00"
+var _utilityExamplenftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xd4\x7b\x6d\x73\xdb\x38\x92\xf0\x77\xfd\x8a\x1e\x7d\x98\x47\x9a\x47\x96\x92\x79\xdb\x5d\x55\x34\x99\x19\x3b\x9e\xd3\x55\xe2\x4d\xd9\x4a\xe6\xaa\x52\xae\x2c\x44\x42\x16\xc6\x24\xc0\x05\x40\x2b\xaa\x94\xff\xfb\x55\xe3\x85\x04\x49\x50\x92\x33\xb7\x5b\x77\xb6\x2b\x91\x88\xee\x46\x77\xa3\xd1\x68\x74\x37\x67\xdf\x0c\xbe\x19\x7c\x03\xb0\xda\x32\x05\x4c\x01\xe1\x40\x3f\x91\xbc\xc8\x28\x30\xfc\x37\xa7\x5c\x13\xcd\x04\x07\xb1\x01\x02\x97\x99\xd8\xc1\x95\xe0\x67\x97\x25\xbf\x63\xeb\x8c\xc2\x4a\xdc\x53\x8e\x14\x4a\xc5\xf8\x1d\xe8\x2d\x85\xf7\xdf\x82\xd2\x84\xa7\x44\xa6\x53\x1c\x59\x6a\xa4\xcc\x85\x86\x82\x48\x8d\x84\x10\x4a\x6c\x36\x2c\x61\x24\xab\x60\x61\x5d\x6a\x60\x1a\x88\x52\x65\x4e\x53\xd0\x02\xd6\x14\xf1\x15\xcb\x59\x46\x24\x3e\xd8\x8a\x1d\xe4\x84\xef\xe1\xea\x72\xa5\x60\x27\xca\x2c\xad\xf9\x34\x93\x27\x42\x52\xd8\x94\x3c\x41\xa6\x49\xc6\xf4\x7e\x1a\x48\x98\x08\xae\x25\x49\x34\xa4\x82\x5a\x96\x6a\x6c\x24\xab\x44\xb1\x65\x4a\xb3\x84\x68\x9a\x42\x92\x11\xa5\xd8\x06\xbf\x31\x61\x84\x54\x7b\xa5\x69\x0e\x1b\x21\x81\x69\x65\xb8\x98\xc2\x52\x43\x4a\x37\x8c\x53\x05\x04\x94\x21\x88\x23\xb0\x63\x7a\x0b\x39\xe3\x2c\x27\x19\xe4\x54\x93\x94\x68\x62\xb8\x99\x0d\x06\x2c\x2f\x84\xd4\x30\xbc\x12\xdc\xeb\xd2\xa8\x72\x58\x8d\xbc\x67\x74\x77\x4d\x95\xc8\x1e\xa8\xac\x9f\xbe\x71\x74\x70\x54\x0d\x07\x03\x92\x24\x54\xa9\x11\xc9\xb2\x71\x2d\xdd\x2b\xbb\x84\x57\x97\xab\x39\xb4\x27\x80\xcf\x83\x01\x00\x40\x88\x48\x1f\x50\x01\x6f\x18\xd7\x34\x1d\x71\xba\x5b\x5e\xcc\xe1\xdd\x92\xeb\x1f\xbf\x1f\x5b\xe0\xd9\x6c\x06\x37\x7e\x9d\xde\x12\xbd\x55\x1d\x1a\x19\xd5\x70\x2e\xb2\x8c\x1a\xcd\xdf\x68\x21\xc9\x1d\x45\xd0\x39\x04\x5f\x8e\xa0\xbd\x2d\xd7\x19\x4b\x2c\x56\xfd\xb9\xe6\x01\xbf\xc1\x6e\x4b\x25\x35\x8b\x9d\x23\xc7\x12\xd4\xd6\x18\xc2\x9a\x82\xd2\x42\xd2\xb4\x02\x5f\x6d\x69\x6d\x5e\x05\xb2\x6d\x96\x0e\x51\x93\x6a\x4e\x20\xd2\x23\x02\xe3\xed\x41\x49\x95\x28\x65\x42\x41\xef\x0b\x1a\xe5\xde\xa8\x4d\xf6\x0a\x5c\x31\xf3\x3b\x85\x64\x2b\x84\xb2\xac\x73\x92\x5b\x2b\x41\x61\x26\xc6\xf6\x35\xee\x41\x9c\x06\x12\xc2\x61\x4b\x1e\xa8\xb1\x49\x03\xc9\xc5\xae\x22\xb4\xa6\x09\x29\x1d\x19\x33\xf7\x86\x24\xb4\xb6\x68\x49\xff\x59\x32\x49\x71\x2b\xe1\x8e\x31\x64\x40\x15\x34\x41\x4b\xb6\xd4\x90\x6c\x2e\x64\x57\x9e\x4a\xda\xa8\xe9\x4c\x91\x5f\x67\x3e\x31\x4d\xb0\xd4\x9b\x4d\x0d\x83\xab\x76\x29\x45\x6e\xb8\xbd\x60\xaa\xc8\xc8\xbe\xda\x0c\xf0\xc0\xe8\xae\x97\x1c\xb2\x8a\xc6\x23\x19\xbf\xeb\x05\x4a\xa9\x4a\x24\x2b\xd0\xe6\x8e\xc2\xea\x6d\x99\xaf\x39\x61\x59\x05\x59\x81\x1a\x36\x9d\x69\x5c\x8b\x3d\xc9\x34\xa3\xea\x30\x9f\x8a\x66\x1b\xcb\xa8\xf4\x08\x73\xf8\xd0\xd8\x9f\x53\x4b\x6a\x7f\xdb\xd4\xc7\x6f\x94\x53\xc9\x12\x48\x99\xb1\x31\x22\xf7\xc6\x29\x4a\x82\x3e\x05\xf5\x84\x7a\xde\x12\xd5\x3f\xa3\x67\x6c\x0e\x9f\xad\x24\x73\xf8\x85\xef\x6f\xb4\x2c\x13\xfd\x58\x4f\xc6\x38\xd3\xa3\xea\x1b\xfe\x85\x3a\x9d\x34\x46\x22\x8a\x6c\x02\x74\xb4\xd7\x1c\x3e\xae\x84\x26\xfc\x41\x11\x6a\xd0\x31\x7c\x6e\xa0\xa1\xd6\xa7\x2c\x85\x85\xfd\x54\x96\x2c\xed\x8e\xa3\x94\xb0\x30\xb6\xde\x1d\x0c\x04\x85\x05\x04\xdf\xba\xa0\x95\xc8\xb0\xa8\xc5\xef\x82\x55\xa2\xc3\xa2\x56\x43\x17\xcc\x4b\x0c\x8b\x4a\xf8\x0a\x28\x58\x34\xb4\x90\x44\x52\xa2\xe9\xab\xbc\xd0\xfb\xda\x39\xba\xa7\xf6\x90\xc6\xa1\xc0\x71\x36\xb0\x09\x4f\x41\x52\x5d\x4a\xae\x9c\x17\x40\xa3\x4a\x48\x96\x51\x09\x4a\x80\xde\x12\xf4\x36\x74\x6f\x1c\x8d\xd8\x71\x74\x44\x35\xc3\xc8\xc0\xcf\x9f\x3b\x9b\xbf\x9e\xec\xb1\x6d\x98\x66\x87\x6d\x4a\x1e\xe7\x7b\x34\x9e\x1f\xa1\xd7\x5a\x63\xcb\x3b\xbc\x38\xab\xcf\xb1\x69\x9c\x32\xdf\xe8\xd5\xbe\xa0\x73\xc0\x7f\x5f\xfc\x1c\xc0\x5f\x5d\xae\x7e\x1a\x8d\xc7\x31\x05\x87\x4c\xe3\xc6\xc6\x70\x01\xee\xa8\x36\xd6\x8a\xcc\x7e\x40\x6a\xb7\x71\xa6\x3e\x34\x1e\xe2\x1f\x02\xbf\x68\x5a\xbc\xf3\x73\x3f\x8d\xc6\x93\x53\xc0\x2b\x87\x73\x2a\xc2\xab\x94\xa1\xde\x4e\x87\xff\xa4\xa9\xe4\x24\x7b\x77\xfd\xfa\x54\x94\xab\xcb\x55\xad\xe7\x0b\xa2\xc9\x97\x21\x3e\x4d\x11\x37\x54\x32\x92\x9d\x0a\xbd\x32\x0e\xf3\x54\xe8\x57\xef\xdf\xfc\x2a\x59\x7a\x47\x53\xff\xfc\xa7\x51\x6d\x1e\xf8\x7b\x7b\xcc\x58\xd0\x4e\xf0\x84\xcc\x1e\x28\xd2\x1c\x7d\x34\xe7\x97\x35\xbe\x71\xe0\xbe\x5e\xb6\x4c\x47\xed\x98\x4e\xb6\x06\xb8\x35\x82\x7f\x09\x51\xf4\xb0\x11\xcd\x3b\x38\x81\x41\x46\x91\x46\x83\x08\x42\x70\x00\x54\x5e\x72\x32\xe8\x81\x0b\x1d\xa3\x83\x0f\x9e\xf4\xa3\x55\x6e\x72\xde\xe2\xec\x3f\x56\xab\xb7\x97\x2c\xa3\xfd\xac\xe1\x6f\x29\x33\x37\x5b\xdc\xdf\x86\x3f\xe3\x41\xe4\x21\x8c\x4f\x56\x70\xb0\x8b\xe2\x1a\xb6\x11\x24\x86\x52\x18\x59\x41\x4e\x3e\x01\x2f\xf3\x35\x95\x78\x5c\xa3\xcb\xb4\x9e\x14\x9d\xe8\xda\x05\xa3\x29\x6c\x6c\xb0\x13\x5c\x36\xfa\x68\x1b\x4f\x4c\x0d\x59\x6a\x59\x81\x0d\xa3\x59\x0a\x0f\x24\x2b\xcd\xa4\x0a\x83\x16\x01\xbc\x47\x09\x18\x7b\x38\xcc\x25\xdf\x08\x58\x40\x54\xc0\x11\x9e\x83\x73\x18\x3a\xef\x88\xce\x1e\xdc\xd0\x70\xe2\x24\x72\x4a\x67\xe9\x04\xf9\x99\xe3\x94\xe3\x63\x73\xbe\x66\x4a\x77\x0e\x7b\x47\xf8\x16\x16\xf0\x21\xe0\xed\x76\xd0\xa2\x13\xfa\xd4\x28\x05\xd5\x6f\x28\xc1\xfc\x7f\xd2\x04\x2a\x87\xf3\x84\x2d\x66\x71\xfa\xb9\x73\x8a\xfc\x93\x9c\x85\x67\xc2\x13\x98\xab\xd0\x8e\xf0\x17\x0f\x53\x9e\xce\x66\xf3\x64\x79\x02\xa3\x01\xe2\x68\xb8\xd5\xba\x50\xf3\xd9\xcc\xa5\x1e\xce\xf8\x46\x4f\x05\xdf\x64\x62\x37\x15\xf2\x6e\x36\x9c\x26\x82\x27\x44\x8f\x9c\x6a\xa7\x5a\xd8\x08\x76\x34\x0e\x4e\xf8\x63\xac\xc6\x4e\xb4\x83\x0c\x07\xd1\x84\xf3\xfa\xe7\x6e\x47\x23\xc1\x91\xbf\x2b\x1d\x0c\x40\x26\xc6\xeb\x07\x20\xc7\x79\xfa\x52\x89\x4e\x3b\x2e\xfe\xed\x42\x55\x6c\x9d\x2e\x57\x75\xb0\xc7\x25\x99\xcd\x80\x7e\x4a\xb2\x32\xf5\x3e\x77\xc5\xcc\x9d\x36\x85\x8d\x10\xe8\x2f\x15\xa6\x89\x84\xde\x52\x09\xa5\xa2\x0a\xbd\xb5\x25\x19\x25\x67\x3c\x9a\xa5\x97\x5a\x30\xf4\x5d\xc3\x9a\xf4\x70\x02\xc3\x8d\x10\xc3\xb8\x0f\x43\x74\x6d\xd0\x90\xf9\x8e\x0f\xc6\x3b\xde\x4a\x58\xba\x23\xfc\x32\x6f\x5e\x06\x26\xd5\xdc\x57\x24\xc7\x1b\xa4\xff\x6a\x51\x5c\xfe\xa5\xfd\x3b\x9b\x85\xa2\x63\xde\x0e\x4a\xce\x3e\x81\x66\x39\x55\x9a\xe4\xc5\x04\x76\xd4\xe7\x45\x72\x22\xef\xf1\x1e\x60\xf2\x51\x04\x52\xbb\x22\xb8\x70\x78\x19\x28\x32\xa2\x37\x42\xe6\x0a\xee\xb9\xd8\x01\xaa\xce\xab\x90\xe9\x69\xaf\xc8\xf5\xf4\x86\xd1\x8e\xdc\xe6\xa9\x3f\x79\x6a\xe0\xe1\xc4\x9e\x6e\x2d\x2d\x34\xd4\x7d\xfb\xd5\x24\x64\x72\x0e\xc3\x0b\xa2\xe9\x70\x02\x92\x48\xa6\xf7\x07\x0e\xa7\x7a\x1d\xa6\x24\xb5\x8b\x39\xaa\x09\x9b\xef\xfd\x0a\x45\xe3\x31\x9a\x34\x54\x6c\xf6\x0e\x2f\xe4\x78\x3f\xb2\x33\x47\x31\x71\xfd\x37\xc2\xae\xf0\xb5\x01\xeb\xe8\xc2\x3e\x1e\x29\xcc\x4d\xce\xe1\xf9\xb3\xe9\x33\x77\xca\x3e\x7f\x66\x3e\x37\x42\xad\xe1\xb9\xc8\x73\xc1\x87\xe3\xa3\xb3\x1d\xd6\x39\x5a\x6c\x9f\xb2\x71\xec\xb6\xa5\x64\xce\xb2\x5a\xc3\x4d\x81\x4e\x57\xb6\xc7\x1b\x0f\x0e\xf9\xa0\x1a\xf3\x64\x97\x10\x8d\xde\x7b\xdd\xc3\xd2\x27\x75\x6d\x4a\x9a\x29\xe3\x85\xe1\x8e\x3d\x50\x9b\x60\x29\xa4\xf8\x83\x26\xda\x86\x68\x22\x03\xf1\x40\xa5\x35\xfd\x2d\x85\xb5\x9d\xc8\xc4\x49\x4c\x81\xa4\x85\xa4\x8a\xa2\x15\x01\xc1\xcb\x77\xdf\xac\xaf\xae\xcf\xff\xf2\xed\x73\xd8\x6d\x29\xaf\x68\x68\x01\xaf\xde\xbf\x01\xc1\x6d\xea\xfc\x81\x11\x33\x45\x61\x72\x9b\xc0\xf8\x46\x12\x65\xee\x0c\xa5\xf4\x13\x4f\x7b\x2d\xf4\x37\xea\x73\xdc\xd6\x63\x9f\x65\xf4\x81\x66\x3e\xa5\x97\x82\xda\xe7\x6b\x91\xd9\x35\xef\xf7\x75\x1e\xfb\xb5\x41\x5e\x1c\x3b\x11\xa2\x74\xfc\x6f\xf3\xb8\x40\x13\x3a\x08\x7e\xf0\xd8\x38\x7e\x41\x0b\x7f\xc6\x40\xd4\x57\x70\x8c\xc2\xcb\x5e\xfc\x97\x2f\xa1\x20\x9c\x25\xa3\xe1\xb9\x49\x1e\xdb\xcc\xa9\x11\xbf\xad\xdf\x2e\xd9\x9e\xcd\x39\x9b\xc1\xb9\xc8\x0b\x9f\xe2\xd5\x98\x36\x71\x34\xde\x5d\x2f\x61\x4d\x14\x4d\xd1\x14\x88\xf9\x68\x9e\xe1\xb2\x55\xb0\xb0\xbc\x98\x40\x21\x98\xb3\x5b\x01\x04\xfe\xf3\xe6\xef\x57\xb0\x61\x19\x9d\x9a\x12\x4d\xdf\xb4\x3b\x9f\x00\x27\x06\x18\xf6\xa2\xfc\x7f\x0f\x14\xca\x22\x13\x24\x45\xbb\xe5\xa9\x49\x73\x6f\x85\x32\xb4\x95\xc8\xa9\x4d\xa3\x9f\xd9\x9c\x37\x5e\x57\x90\x27\xbc\xa5\x05\xd9\xe8\xc4\xd1\xed\x9b\x77\xf9\xf6\xf2\x66\x02\x37\xdf\x4d\x80\x00\x2a\x06\xde\x5d\xbf\x36\xea\x23\x8c\xfb\x72\x90\x11\x21\x65\x92\x26\x3a\xdb\x4f\x80\xea\xa4\xff\x54\x41\xbd\xa0\x5a\x16\x70\x2c\x26\x34\x0a\x3b\xf3\x2e\x6d\x36\xec\x25\x59\x4a\xf6\x1e\x37\x84\xcf\x18\x36\xc2\x47\x1f\x58\x0e\xa7\x7f\x28\xe3\x73\x0f\xb9\xad\x63\xc6\xd6\xbf\x55\x70\x87\xce\x9b\x1b\xef\xc8\xed\xdb\x6e\xe5\x36\x8e\x7d\xda\x8f\x55\x4a\xd6\xbe\x76\xbf\xbb\x5e\x1e\xde\xc2\x4e\xe5\x73\xff\x61\x82\xeb\x6a\xea\x59\xb8\x80\xee\x21\x48\xaa\xca\x4c\x2b\x34\x16\x82\x4c\x25\x44\x53\x5e\x15\x06\x3d\x14\x5a\xb3\xf1\x3e\x07\x67\x0c\xcf\xa4\xc3\xab\xe1\x31\xda\x3f\xf1\x91\xd6\xea\x3d\x0e\x22\x6b\x18\x5e\xa5\x1f\x07\x41\xae\x67\x36\x83\x0b\x74\xf4\x28\x58\x3a\x87\xbf\xf3\x6c\x0f\x66\x83\x60\xc1\x68\x4d\x92\xfb\x1d\x96\xbe\x12\x91\x17\x44\xb3\x35\xb3\x85\xc5\x76\x82\xc8\xbb\xc3\xa0\x2c\x53\x7b\xd7\x3a\x26\xb6\x95\x2d\xf8\xfc\x38\xe8\xa7\x50\x43\x47\xea\x30\xf5\xe0\xe4\xe0\x04\x95\xa8\x98\xd2\x6d\x56\x1b\xae\x2e\x57\xb8\x8e\x18\xf9\x59\x67\x73\x4f\x79\x7d\x66\x20\xbc\x3b\xfe\x48\xcd\x93\xa9\x4d\x99\xb8\x88\x70\xf8\x87\xad\xf4\xfc\x03\x96\x17\x36\x6d\x51\x21\x87\xf2\x3c\x10\x09\x62\xc7\x69\x7a\x75\xb9\x52\x98\x06\xb6\x58\x73\xe8\xe6\x83\xaf\x2e\x57\x8f\xad\xda\x05\x8c\xa2\xe9\xff\x8a\x20\xbc\x38\x43\x2d\xfa\xc1\x00\x1b\x05\xb8\xa3\xfa\xa6\x2c\xb0\x6c\x6a\xa6\xc7\x23\x47\x39\x3b\x50\x40\x20\x63\x4a\x7b\x4d\xa0\x64\x2e\x9b\x63\xfc\x9e\xa4\x09\x65\x18\x13\xa0\x2c\x85\x56\x71\xd9\x82\xdc\x71\x67\x22\xcc\x23\x7f\xc6\x4f\x73\xf8\x55\x88\xec\xb1\x25\x07\x3a\x3b\xe5\x71\x10\x4c\xb5\xc0\x17\xa1\x60\xf8\xdb\x84\xfe\xd0\x73\x35\xc3\xbc\x8b\x96\x25\x8d\xd9\x7e\x93\x42\x9f\xd6\xae\x9d\x82\x76\x5b\x6a\x6e\x50\x42\x9a\x3a\x39\x7a\x72\x8c\x9f\xb8\x29\x84\x62\x72\xca\xaa\x86\xa6\xb0\xde\xb7\x8a\xa6\x0d\x7a\xbf\x84\xd5\xd4\x2a\x5f\x66\x91\x4d\x7d\xd3\xd0\x73\x57\x95\x3f\x4a\xa5\x3d\xbb\x28\x06\xd2\x4e\xe9\x86\x94\x99\x3e\xbc\x04\x4c\xb5\x57\x60\xa4\xab\x40\x63\x6c\xd7\x00\x3e\xc7\xb4\x82\x60\xb0\x58\xf4\xdd\x75\xfb\xd4\x84\xdb\x20\x95\x64\x07\x92\xe6\x02\xc3\x4a\x62\xaa\x28\x3e\xfd\xd7\xa8\x21\xa3\x5b\xb4\x40\xed\x6a\x4c\x5b\xa8\xce\xa6\xf8\xdd\x4d\x63\x93\xcf\x7e\xd2\x91\xff\x10\x14\xea\xa3\x35\x16\xdc\x53\x11\xcb\x33\xbb\x1d\x37\x4f\x73\x3b\x4d\xad\x2c\xa3\x7b\xba\x9f\x57\x73\x2d\x2f\xc6\x83\x53\x82\x27\x0f\xef\x15\x81\xdf\x7d\xac\xfd\xc0\x30\x08\x59\x5e\xc4\xb4\xd3\x3e\x74\xdd\xb2\xbc\x38\x33\x4c\xf6\xa9\x3f\xa5\x85\x50\xa8\x4d\x72\x8f\xba\x37\xaa\x47\x35\x93\x34\x6d\x68\xb9\x9a\x46\x05\xfe\xaf\x41\xa9\xc2\x42\xf8\xe5\x85\xc7\x64\x18\x30\x49\xb2\x6f\xaf\x50\x55\x08\x70\x1c\x8c\x0c\x9b\xbd\xca\x1f\x1f\xd2\xbe\xfd\x40\xd4\x57\xd0\xb2\xbb\x0e\x8a\x29\x78\x1a\x70\xcc\x2c\x36\x86\x51\x84\xd4\x06\x91\x9c\xee\x1c\x71\x27\x44\xe0\xf2\x77\x5b\x96\x6c\x2b\x63\x45\x68\x91\x61\x30\xda\xf4\x14\xc8\x9e\xc8\xd2\x55\xdc\x3e\x3e\x78\x0e\x6e\x2b\xee\x9b\xbc\xa4\x54\x69\x29\xf6\x15\x89\x0e\xa7\xae\x09\x27\x35\x0e\x04\x4f\x56\x4d\x6d\x40\x5a\x94\x12\xe3\x66\x05\x82\x67\xcd\x7b\x36\x1e\xcc\xc2\xb8\x20\x23\xa6\xc0\xd8\x56\x56\x71\x11\x94\x3c\xa3\x4a\xe1\xc3\x76\xab\x43\x9b\x8a\xa4\x44\x09\xa3\x9a\x1d\xc1\x66\x21\x01\x34\x47\x4b\xb1\xf5\xf6\x77\x45\x8a\x27\xbf\x6b\x85\xa9\xe2\xe3\x36\x11\xc6\x9b\xf3\x37\xc6\x51\x7b\xa4\xd4\x5b\x23\xfb\x35\xdd\xc0\x02\x46\x5f\xb7\x54\x88\xca\xc3\x5b\x64\xa9\xb7\xdd\xdd\x6e\x99\x18\xc3\xd7\x71\x63\x7a\x39\xfe\xaa\xc5\x4f\x38\xdb\xb4\x34\xd8\x2b\x49\xb8\xda\x50\x89\x39\x93\x11\x3e\x98\x63\x6d\xf3\xbc\x94\x92\x72\xfd\x6b\x26\x92\xfb\xd1\x78\x5a\xe5\x89\x9a\x7b\x3b\xb0\x42\xd4\x4d\xad\x96\x51\x38\xd1\xb8\x6f\x53\xde\x51\xbd\xbc\x08\x8e\x58\x6e\xb7\x90\xef\xf8\xc2\x31\x73\x00\xe0\x4d\xa4\xd3\x69\x13\xdd\x68\xe1\x11\xbb\xbc\x30\x87\xea\x07\xeb\xef\x7a\xca\xb3\x4d\x6d\x4f\xef\xe9\xbe\xf7\xa0\xfb\x8d\xba\x6e\x0b\x92\x8b\x92\xfb\x50\x40\xf5\xb6\x02\x1d\x65\xf0\x35\xe5\x77\x7a\x8b\x3c\x2e\xb9\x3e\x89\xbd\xcc\x60\xc4\x18\x8c\xce\xb1\x16\x52\x8a\xdd\xd5\xe5\x6a\xf4\x31\xe8\xb5\x19\xcf\x7b\xed\x25\xce\x44\x9f\x4d\xf6\x5a\x5d\x8c\x41\x74\xc2\xbf\x1a\x7e\x8c\x9a\x0c\x8f\xee\xfe\x2c\xab\x26\x2b\xb7\x15\x5d\x2e\x65\x79\x71\x8a\x78\x61\xdb\xdb\xa8\x25\x65\x38\x36\xf5\x1f\x3a\x62\xb2\x8d\xf1\x99\x7c\xa3\x61\x01\x4f\x94\xb5\x45\x2a\xd0\x1a\x92\x23\xaa\x97\x89\x06\xda\xe3\x20\x46\xa0\x71\xf5\xf8\x73\xed\x1e\x7e\x4b\x29\x92\x07\x9d\x69\xa7\xf4\x7f\x34\x00\x7f\x76\xac\xfd\x52\xcf\x91\x9c\x30\xc7\xff\xa5\xae\x8f\x40\xdb\x5f\xa6\xe9\xb8\x2d\x57\xfa\xf8\x93\xfd\x36\xa7\xa9\xb2\x21\xf0\x53\xf4\x5a\xe9\xd4\x11\x0e\x04\x1b\x8d\xdb\xba\xb9\x74\x5d\xb3\xf6\x1e\xe4\x05\x22\x59\x86\xbc\x57\x69\x05\xc0\x4d\xa8\xea\xbe\x59\x7b\x01\x20\x78\x49\x85\x56\x57\xb0\x23\x3c\xe8\x98\x5b\x70\x30\x60\xd8\xad\xea\x7c\x03\xce\x14\x92\x46\xbf\xa2\x6c\xee\xcb\xe6\x35\x61\xc7\xb2\x0c\x33\x5d\x25\x26\xd3\xd6\xfb\x8a\xb8\xff\x49\x31\xbb\x22\x0a\x2a\x15\x2e\x84\xa9\x69\xd8\xd8\xa7\x20\x92\xe4\x14\xfb\x45\xb5\x80\x82\x28\xe5\x17\xca\xb9\x2d\x94\x6b\x34\xc6\xd6\xac\xad\x48\xa7\x9e\xee\xa0\xd7\x5d\xe1\xa9\xea\xa2\x00\x44\x55\x91\xda\xd9\xcb\x58\x33\x91\x53\x42\xb3\x91\x28\x92\x0f\x8d\xd5\x06\x27\x4f\x43\xaa\x6a\x6f\x47\xf1\x0e\x27\x5f\x6f\x8f\x99\x8a\x51\xa0\x02\x52\x35\xb6\x39\x35\x09\xd9\x6c\x85\x68\xaa\x15\x97\xed\x67\xb3\x2c\xbe\x91\x66\x6b\x93\xff\xde\xf5\xa4\x54\x31\xe9\xac\x60\xda\x35\x23\xa8\x53\xe7\x55\x92\xde\x1b\x11\x76\xc1\x52\xa5\xdb\xc8\xd1\x4d\x17\x4b\x7b\x47\x16\xb3\x5d\xf6\xec\x6b\xf9\x09\xda\x7d\x10\x2c\x18\x39\x54\xe1\x88\x2d\x77\xb7\xc0\x81\x07\x5b\xed\xa2\xb1\x2f\xab\x53\x05\xea\x10\x8a\xe7\xff\x54\xd8\xb0\x6c\x0e\xc9\x1a\x2b\xe8\x5f\x9e\x0c\x22\xb8\x50\x04\x7d\xda\x2d\xdc\xba\x6d\xfb\x10\x6a\x0d\xef\xbc\xf8\xd7\x81\x17\xaf\x07\x3b\xa6\xeb\x7f\x2d\x95\xd7\x8c\xdf\xd3\xb4\x5e\x91\xa7\x52\x89\x7a\x5b\x6f\xdb\x73\x18\x6d\xca\xa7\x1f\x63\xe1\xcf\xbf\xe2\x48\x0b\x7f\x1e\xbb\x8f\xc7\x83\x1e\x26\x9a\x56\xf3\x05\x26\x59\x39\x93\xb8\x55\xe6\x34\x65\x5d\x63\x7c\x83\x4f\xe3\x06\x88\x85\x8a\xa7\x37\x89\x99\x06\xb1\xaa\x38\x40\x94\xa2\x5a\x4d\x77\x74\xad\x98\xa6\x67\x48\x52\x4d\x13\x91\xcf\x7e\xd8\xfc\xf8\xed\xdf\xbe\x4f\x9e\x25\x7f\x21\x7f\x4d\xd2\xf4\xc7\xef\xbf\x5b\x3f\x4f\xfe\xfa\xed\xb3\xd6\x00\xf9\xe1\x87\x64\xfd\x3c\xf9\xdb\x77\x3f\x7e\xc4\xfa\xdc\xc7\xdf\x85\x4c\xb1\x5c\x3e\x55\x0f\x77\xf1\x92\x42\x8f\x25\x19\xe9\x5d\xb5\x9a\xe5\xe4\x8e\xce\xd4\xc3\xdd\xff\xff\x94\x67\xc3\xd3\x57\xe8\xb8\xf2\xe3\x6a\x71\x05\x5f\x7c\xd9\xc1\xd9\x4d\x70\xcc\x0f\xe3\xfc\x36\x4b\xce\xee\x5a\xee\x71\xf0\x72\x6e\x8e\x57\xd2\x78\x21\x08\xdf\x26\xa0\x59\x81\x17\x60\x7f\xca\xe2\x67\x09\x9c\x7e\xd2\xee\xd5\xa0\xcb\xd5\xb4\x67\x46\x5a\x37\xfc\xcc\xbf\xbc\x17\x68\xd8\xa3\x7f\xf5\xcf\x92\x48\xba\x44\xcd\xcf\xed\x62\xc4\xe1\xd6\x84\x73\x2a\x8f\xc3\x29\x81\xef\x26\x61\x52\x36\x3a\x8c\x7f\x43\xbd\x63\x5a\x53\x39\x3c\x49\x1c\x07\x6c\x8c\x13\x1b\x9b\x3e\xae\xf1\x2a\x9e\x6c\x09\xeb\x2b\x74\x3c\x1e\xb1\x9c\xbe\x5d\x1b\x3d\xca\xe7\x83\x7f\x7b\x99\xdc\xd5\xc3\x63\x13\xff\x0b\x4a\xe4\xad\xda\x6b\xab\x84\xfb\xee\x7a\x39\x85\x65\x50\xe8\x9c\x34\xa0\xea\xb0\x85\x29\xc8\x44\x82\x29\x0f\x2c\xd4\x62\x1a\x08\xd0\x27\x99\xc2\x69\xd7\x52\xf0\x65\x1c\xfb\xd2\x9a\x2f\x94\xfe\x8f\x55\x44\xa3\x3e\xa1\xbb\xb4\x07\x3d\x42\x7d\x8a\xf4\xec\x49\x5f\x65\x1c\xfe\xd7\x9b\xb7\xaf\x7b\x60\x9e\x5a\x53\x74\xb5\x40\xd7\x50\x32\x9b\x81\xa2\x5a\x87\xa5\x44\xa2\x70\x08\x1f\x5b\x23\x43\x73\xe3\x2e\xb8\x27\xf6\x51\x29\x59\xd8\x0a\x3b\x38\x52\x49\x3c\xe2\x33\x66\x95\x31\xf8\x65\xb6\xf5\xc5\x28\xd9\xf1\xa0\xff\xc9\xe3\xa0\xb5\x3a\xfe\x4a\x1f\x44\xc6\xd7\x2e\x6e\x74\x99\x2e\x0e\x24\xcd\x19\x07\x81\x2f\x5e\xe0\xd5\x02\x35\xe1\x5f\x68\xb4\x55\x7b\xbc\x0f\xda\x77\x1d\x3d\x0d\x82\x2f\x56\x6a\x61\x5a\xbc\x4c\x52\xb7\xba\x2e\xc6\x22\x58\x1f\xa9\xe2\x46\xb4\xef\xa2\x85\xef\x68\xcd\x5c\xab\x18\x5e\x5a\xf1\x7f\x05\xc4\x93\xf4\x0d\x61\xf8\x75\x79\x71\x62\x06\x81\xf1\x3b\xb3\x6d\xe8\xa7\x78\x45\x06\x6f\x46\x6e\xbe\xff\x3d\x6f\x1e\x55\xe0\x18\xcc\xd5\xbb\xa2\xfd\x3e\x1b\x00\x1c\x7f\xbb\xaa\x5b\x99\x43\x94\x24\xc8\xaf\xc2\xa2\x9b\x71\x6d\x20\xb4\xbb\xde\x0c\xd6\x10\x4b\x77\x21\x99\xe9\x96\xb2\xbb\xad\x3e\x88\x89\x6d\x6d\x5d\xc4\x2a\xbb\xdb\x14\x6d\x36\xb3\xfe\xaf\x60\x34\xc1\xf7\x6f\x2b\x6a\xcd\x3b\xb5\xef\xfe\xa3\xf9\x9a\xa6\x29\xae\xb7\xed\x0a\xc3\xa2\x36\xb6\xa4\x98\xee\xad\x1e\xae\x4c\x63\x19\x2c\x60\xb8\x26\x72\xd8\x99\xdd\xe5\x20\x2a\x03\x6c\x8c\x63\xa5\x98\x53\xcc\x6e\x06\xe9\x8a\x8e\x15\xd5\x96\x14\xef\x9f\x68\xd8\x52\xf0\xa5\x0b\x19\x18\x55\xf5\xb1\x0b\x15\xd8\x56\xf5\xb1\x0b\xe5\x15\x30\xaf\x3e\x4d\x06\x07\x3a\x13\x68\xce\xda\x6f\xdb\x5a\xc9\xa7\x2c\xed\xab\x86\x59\x80\x78\x62\xcb\xbc\xe6\x37\x6e\xee\x7a\xb8\x71\xcd\x62\xa8\x27\xf7\xf2\x6b\x35\xdc\x7f\xdd\x83\x05\xcc\xdc\xcd\xd0\x7b\xd3\x46\x20\xda\x47\xa2\xbe\xf5\x21\x05\x7b\x76\x9f\x40\xa0\xf3\xee\x6c\x7c\x7e\x0b\xd6\x10\xef\xdc\xdb\x52\x4d\xba\xf6\x84\x98\x90\x53\x58\x0d\xb2\xd9\x38\x47\x70\x10\xbf\x44\x1f\xca\x8e\x55\x8c\x92\x24\xc1\x22\xc1\xd4\x91\x9a\x22\xf5\xd1\x8b\xb3\x9a\xca\x04\xb4\x38\x74\x8f\x0e\xd6\x35\xdc\x09\x2e\xca\x49\x48\x41\x6c\x53\x49\x95\x6f\xac\x49\xf7\xf0\x7d\x4e\x0a\x58\x34\xb9\xab\xc8\x30\xaa\x2a\x56\x99\x52\x65\xff\xfd\xfa\x10\xc7\x51\x0d\x34\xe6\x30\xec\xab\xed\xa8\xc1\xd5\x04\x88\xef\x72\x8e\x19\xc8\x38\xbe\x8e\xee\xe8\x7a\xca\x1a\xba\xd7\xbf\x1b\xee\xc2\x92\x39\x71\xf9\x2c\x81\x60\xe9\x3a\xf6\x38\x1e\x00\x00\x3c\x0e\x1e\x07\xff\x3d\x00\x28\xfe\x05\xd4\x2a\x41\x00\x00"
 
 func utilityExamplenftCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -137,7 +139,7 @@ func utilityExamplenftCdc() (*asset, error) {
 	return a, nil
 }
 
-var _utilityExampletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x6d\x6f\xdb\x38\x12\xfe\x9e\x5f\x31\x9b\x03\xee\x6c\x34\x71\xd2\xdd\xb6\xb7\x6b\x24\xcd\xa6\xdd\x06\x77\xc0\x16\x28\xda\x6c\xf7\x43\x51\x34\xb4\x34\xb6\x78\xa1\x48\x81\xa4\xec\x78\x83\xfe\xf7\xc3\xf0\x45\x16\x65\x29\x71\xd2\x1e\x70\xfe\xd0\xe8\x85\x33\x1c\xce\x3c\x9c\x99\x87\x2a\x2f\x2b\xa5\x2d\xec\x5f\xd4\x72\xc1\x67\x02\x2f\xd5\x35\xca\xfd\xbd\xf8\xf8\x2d\x5a\x96\x33\xcb\x3e\x72\x5c\x99\xcd\xe3\x64\x74\x67\xcc\x1e\xcb\x32\x34\x66\xc4\x84\x18\x43\xa6\xa4\xd5\x2c\xb3\xf0\xe6\x86\x95\x55\x10\x98\x42\x22\x0f\xb7\x7b\x7b\x00\x00\x47\x47\x47\x70\x59\x20\xe0\x12\xa5\x05\x5b\x30\x0b\xdc\x00\x96\xdc\x5a\xcc\x61\x55\xa0\x04\x89\x2b\xb0\x24\x63\x80\x69\x84\x92\x4b\x8b\xb9\x13\x6e\x4f\xea\x15\x38\xdd\xe6\xad\x1b\x32\x62\xa5\xaa\xa5\x9d\xc2\x1f\x17\xfc\xe6\xc5\xb3\x03\xb0\xeb\x0a\xa7\xf0\xc1\x6a\x2e\x17\xe3\xd6\xf4\xca\x32\x01\xa6\xae\x2a\xb1\x06\x35\x4f\xac\x36\xc0\x25\xe0\x0d\x37\x16\x65\x86\x5b\x93\x2e\x99\x06\x4b\xe2\x1f\x9c\x74\x9c\x6a\xa3\xfb\x83\x55\x9a\x2d\x10\x98\xcc\xe1\x5d\x3d\x13\x3c\x83\x77\xcc\x16\x66\x4b\x93\x40\x0b\x1f\x59\x2d\x6c\x90\xa0\x51\x53\x68\xdd\x0c\x4b\x78\xbd\x5e\x60\x73\xdd\x3b\xfe\x3d\x66\xc8\x97\xa8\x1f\x20\x72\x9e\x97\x5c\x0e\x1a\xb5\xed\x11\x8e\x2b\x98\xd7\x12\x16\x68\x5f\x07\x1c\x38\x8c\x8c\x34\x1a\x55\xeb\x0c\x2f\x5d\x14\xe8\xdf\xb3\xf1\x14\x3e\xd1\xc5\x67\xb8\x75\x8a\xe8\xa7\xd1\xd6\x5a\xc2\xa7\xe6\x01\xfd\x68\xd0\xc9\x30\xfe\x26\x17\x97\xf4\xf7\xe5\x68\x7c\xf0\x40\xb1\xdf\xb8\xa9\x04\x5b\x3f\x42\xd2\xb9\xfe\x37\x66\xd9\x83\x65\x2f\x37\x80\x79\x39\x1a\x37\xa2\x9f\xdd\xd5\xd7\x6d\x97\x92\x37\xc9\x79\x62\x89\x6d\x8f\xf6\x39\xf4\xc0\xf9\x7f\xf3\x60\x3c\x85\x73\xb9\xfe\x60\x75\x9d\xd9\xb3\x96\x93\xcd\x8a\xdb\xac\x68\x06\xb7\xde\xd0\x2f\x63\x06\x77\x77\xf9\x34\x91\x6d\x85\xf0\x5e\xe1\xd1\x96\x24\xfd\xe6\x36\x04\x65\x0a\x06\xc5\x7c\x72\xff\xd2\x25\x17\xdd\x85\xef\x1a\xf5\x31\x30\xf3\xc3\xdd\x96\x86\xc1\x67\x07\x03\xd6\x36\x40\xf8\xdf\xd9\xdb\xc6\xda\x0e\x16\x37\xc3\xcf\xb6\x4c\x1e\x3f\x26\xd0\x1b\x77\x6d\xc7\x9a\x52\x44\x89\x39\x67\x70\x0a\xa9\xdc\x5b\x7a\xda\x1f\x62\xe7\x38\x2e\x70\xda\x11\xf9\xd7\xe5\xe5\xbb\x0b\x2e\x70\x58\xaa\xd6\x62\x0a\xfb\x85\xb5\x95\x99\x1e\x1d\x31\x63\xd0\x9a\xc9\x0a\x67\x86\x5b\x3c\x24\x95\x66\x92\xa9\xf2\xe8\xf9\xfc\xc5\x8f\xbf\x3c\xcb\x8e\xb3\x7f\xb2\x9f\xb3\x3c\x7f\xf1\xec\xa7\xd9\xd3\xec\xe7\x1f\x8f\x3b\x2f\xd8\xf3\xe7\xd9\xec\x69\xf6\xcb\x4f\x2f\xbe\x5c\x08\xb5\xfa\xf2\xa7\xd2\x79\xc9\xf4\xf5\xc4\x2c\x17\xfb\xbd\x36\x8c\xfb\x51\xe0\x3c\xe0\xa3\xb9\xcf\x4b\xb6\xc0\x23\xb3\x5c\x3c\xb9\x29\xc5\xb6\x96\xf1\xb0\x0b\x4d\xbf\x0f\xcd\xe8\x93\x7b\xfd\x79\x5b\x74\x97\x9d\x16\xa2\xd7\xef\x53\xc9\x4a\xb2\x39\x94\xbc\x46\x91\xaf\xa3\xfb\xfd\x8b\x35\xeb\x72\xa6\x28\x0c\x6f\x2e\x2e\x07\x86\xe4\x68\x32\xcd\x2b\xcb\x95\x9c\xc2\xfe\x65\xc1\x0d\x65\x31\xaf\xda\x95\x72\x2a\xf2\xb5\xc1\x1c\x98\x01\x46\x15\xd6\xcf\x6f\x15\x14\x28\x2a\x58\xab\x1a\x72\x5c\xa2\x50\xee\x5a\x83\xc4\x1b\x0b\x17\x97\xf0\x37\x25\x29\x52\x93\x81\x79\xf1\xc6\xa2\x96\x4c\xfc\xf1\xfe\xf7\x2e\xb6\xde\x6c\x5e\x8d\x1a\x00\x85\x79\x0f\xe7\x76\xa2\xe4\x9c\x14\x2b\xbd\xd8\x1f\x08\xb2\x50\x0b\x65\xa6\x21\x54\x03\xae\x51\x19\x67\xc2\x4c\x3b\x09\xb5\xfd\xdb\xb7\x2b\xea\x6d\xf4\xfe\x4e\x06\x86\xc1\x0e\xd4\x64\xdf\x97\x99\x50\xd9\x75\x56\x30\x2e\xf7\xb7\xe1\x00\xae\x80\x74\x9f\x3c\x6a\xcf\xb7\x53\xce\x23\x33\x7c\xd4\xd0\x8f\x3c\xd3\x6e\x29\x8e\xc2\x5d\x0c\x88\x53\xe8\x14\xf4\xfb\x59\xc7\x3e\xc6\x4b\x57\xae\x85\x49\x84\x63\xa7\x33\xb4\x5f\xbd\xad\xc3\xf2\x3b\x4c\xfe\x3b\x97\xd7\x98\xb7\x72\xf8\xdf\xdb\x9d\xe3\xc4\x69\xd8\x6a\x0e\xba\x16\x7c\x93\x92\x4c\x23\xb3\xf8\xa6\xac\xec\xda\x0d\xbc\xa8\x65\xe6\xf7\xdc\x68\x5e\xcb\xd1\x78\x0a\xbf\xde\x26\x31\xf2\xfa\xbe\xde\x01\xcf\x10\xd9\x93\xc3\xc4\x8c\xee\x44\xa3\x25\xfd\xdb\xb2\xfa\xd7\x5e\xab\x07\x10\xba\xfd\xf8\x11\x10\x4d\xbb\xa8\xc7\x40\xb4\xa5\xa1\x1f\xa2\x49\x67\x9f\x2c\xb0\xf5\xe6\x8e\xb5\x7c\xed\x36\xb5\x92\x8b\x76\x93\x47\x04\xc1\xb9\x2a\xde\x35\x4f\xdf\xb0\xac\xa0\xfc\xa8\xdd\x36\x41\x97\x23\xb9\x34\x96\xc9\x0c\x89\xa2\x28\x29\xd6\x60\x0b\xf4\xe2\xc4\x51\x6c\x81\x5c\xc7\x4d\x95\x30\xab\x79\x00\x85\x09\xc3\x82\x0c\x31\x92\x85\x5a\xa2\x96\x98\xc3\xcc\x6b\xab\xb4\x67\x2a\x95\x32\x96\x58\x5c\xce\x9d\x60\xa3\x8e\x77\xfc\xe9\xf9\x99\x2d\x70\xed\x98\x59\xc6\x84\xc0\x7c\x92\xcc\x9e\x15\x98\x5d\x1b\x28\x58\x55\xa1\x04\x66\x41\xd7\xd2\xf2\x12\x9d\x28\x2e\x51\x03\x6b\x2c\xa4\xa2\xd0\xd1\xd1\xe8\x7a\x1f\x3a\x28\x1a\x21\xfd\xfa\x67\x18\x36\x40\x1e\x57\x46\xc4\x93\x0a\x85\x9a\x37\xb7\x8e\x87\x3a\x5a\x49\x66\x36\xea\xc8\xdc\x1c\xe7\x5c\x3a\xe1\x03\x30\x8a\xde\x6b\x24\x13\xa4\x82\x15\x5b\xc3\x5c\x91\x6d\x25\x13\x3c\xe3\xaa\x36\x3e\x1c\x56\x85\x39\xbd\x17\x37\xae\x51\x75\x98\x96\x4b\x60\x5c\x4f\xe0\x1c\x4c\x85\x54\x0d\xc0\xb1\x51\x0d\xb1\x07\x04\x89\x98\x1b\xd2\x34\xdb\xd8\x60\x95\xe3\xb5\x8d\xba\x0d\xe7\x4d\x5d\xd1\xe6\x05\x8d\x42\x67\x4a\x87\x5f\xfb\x3d\x18\x59\x76\x3b\x22\x0e\xbb\x30\x63\x22\x82\xc9\x52\x79\x5e\x36\x38\xec\x4e\x43\x1c\x37\x8c\x4e\xf9\xad\x57\x0a\x5c\x72\xcb\x99\xe0\x7f\xa1\x73\x7a\x54\x4c\xa1\x8e\x06\x3a\x97\x51\x80\x29\xf2\x8d\x2c\x09\x8e\x3a\x9a\xc7\x9d\xd4\xe4\x3a\xea\xa8\xf2\x34\x2a\x6f\xed\xb0\x64\x79\xaf\x1d\x78\x3c\x2a\x59\x4f\xc7\x31\xab\x1d\xda\x97\x9c\x39\x53\xaf\x5e\xd1\xbd\x9e\xd0\xe3\xd1\xf8\x8a\x72\x72\xa1\xf2\xae\x13\x22\x8a\x3c\x11\xa3\xb1\x34\xcd\x8c\x65\xd7\xa3\xae\xb5\x7c\x9e\x1a\xfc\x12\x8e\x27\xc7\x3d\xc9\x76\x28\x97\xc0\xe9\xf0\xab\xc3\x44\x75\xa2\xf2\xeb\x5d\x2e\x3b\x9e\x1c\xf7\xb9\x6b\x88\xb3\x7b\xae\xde\x47\xcc\x5b\x79\x2c\x31\xf2\x1e\xa2\x2f\xb9\x18\xdf\x67\x40\x8b\xe1\x3a\xba\xf4\xc5\x99\x74\x37\x85\x1d\x32\xe7\xc1\xf4\x8b\xae\x7a\x2d\x24\x44\x2d\xd0\x92\xff\x95\xb6\x98\x7f\x8c\x35\xcf\x80\x72\xad\x2d\x13\x62\x1d\x6c\x30\xc0\x40\x70\xe3\x72\x80\xdb\x4a\xee\xb0\xc9\xc4\xcc\xc3\x4d\xd3\x35\xb8\x85\x57\x21\x73\xdc\x15\x89\x9e\x79\x29\x2e\xb7\xde\xea\x57\x4a\x89\x6e\x1d\x27\x22\x61\xa2\x94\x13\xe8\x0c\x3f\x85\xdb\x0e\x56\x92\xd1\x9f\x1c\x74\x16\xe8\x26\x1b\x8d\x3f\xc3\x29\x58\x5d\x63\x9f\xcb\x53\xc1\x9d\x01\xc6\xcd\xf6\xaa\x46\xb6\x7d\x5c\x41\x86\xf6\x47\x39\x1a\xd7\xeb\x97\x4f\xd6\xa1\xf5\xec\x0c\xe6\x4c\x98\xc1\x04\x71\x6e\xae\x0d\xed\x52\xda\xfd\xfe\x74\xd0\x95\x93\x19\xc2\x8a\xdb\x22\xd7\x6c\x25\x61\xae\x55\x79\x6f\x4e\xdc\x2c\xe8\x7c\xc9\xb8\x60\x2e\xed\xfe\x19\x74\x74\x0e\x1e\xef\x5c\x55\xb0\xe2\xe4\xb4\x7f\x7b\x77\xec\x8f\x56\xb6\x1f\x26\x03\x62\x07\x18\x80\xc7\xae\x7d\xef\x10\x66\xf1\x64\x8b\xe9\x45\x5d\xa2\xb4\x89\x20\x95\xfd\xa8\x3d\xc0\x36\x08\x05\x7f\x84\x32\x33\x19\x9c\xfa\xdf\x36\x94\x46\xda\x0b\xae\x7e\x61\x59\x29\xcd\xf4\x3a\x74\x1c\xf1\x70\xd7\xf1\x3e\x62\x7a\x4a\xe4\x89\x06\x5b\x60\x3c\xe8\xf5\x06\x68\x84\x19\x72\xb9\x00\xab\x99\x34\x73\xd4\x1a\xf3\x09\x4d\x14\x37\x1d\x49\x48\x5c\xb5\xba\x30\xd2\x13\xbb\x82\x30\xad\x4a\x7a\x03\xa7\xd9\x77\x19\x54\xf5\x79\x83\x80\x1c\x2b\x65\x78\x3c\x5a\x8e\xba\x50\x18\x5c\x51\x67\xd0\xbf\xf0\x00\x8a\xb4\xf4\x46\x1c\xf8\xc4\xb6\x1a\x44\x45\x4f\xd3\x7c\x77\xf9\x4b\x6e\x0f\x43\x80\xfa\x50\x75\x72\xd8\xee\x52\x36\x45\xd6\x4b\x0c\x66\xbb\xe0\x82\x87\xa1\x2b\xb8\x59\xcd\xfe\x83\x59\x17\x62\x0e\x56\x2c\xcf\x4d\xa2\x86\x5b\xd3\xb4\x09\x21\x3a\x49\x3b\x82\xa0\x56\x12\xb5\xd9\x01\x71\xdc\x00\x13\x42\xad\x3c\xa2\x72\x34\x56\x2b\xdf\xcb\x1a\x9a\xde\x9b\x36\xc3\x8c\xd5\x06\x37\x20\x4e\xf7\x14\x99\xdc\x02\x2b\xc1\x12\x75\xb4\x24\x34\x61\xae\x73\x72\xb2\xff\xd8\xd8\x5e\xb0\x74\x5d\x33\x44\x49\x38\x33\x75\x89\xb9\x5b\xba\xeb\x29\xe7\xca\xf5\xc6\x01\x64\xce\xc2\xd8\xe1\x0e\xc0\xa9\x29\x8a\x21\x20\x23\xda\x83\x43\x64\xae\xdb\x84\x50\x15\xf0\x25\xe8\xe4\xd0\x6f\x5e\x66\x7e\xe8\xc3\xda\xce\x48\x7b\xe2\xf5\xf5\xf6\x1e\xc9\x9b\x4e\xbb\x01\xfe\x24\xc8\x85\x24\xcd\xa5\x1d\xdc\x75\xe9\xe5\x8e\x00\x4c\xd3\x8d\x8f\x35\xed\x36\x60\x6d\x3c\xfd\x85\x5a\x6d\xa5\xba\x98\x40\xf8\x26\x3f\x30\x21\x28\xd5\x84\x3c\x41\x0d\xbc\xeb\xf8\xcb\xda\xf8\x7c\xe1\x6b\x42\xe4\x2a\x5b\x1a\x1d\x51\x73\x9a\xbc\xee\x26\xff\x74\xc9\x19\x3d\x50\x3a\xf7\x64\xc2\x81\xd7\xbf\x4f\x35\x66\x99\x4b\xbe\x9e\x25\x30\xdf\xc5\xc6\x1e\x22\xc2\xc2\x34\xdd\xbb\xef\x70\xa9\x06\xee\x86\xab\x2d\x3e\xbf\x53\x36\xba\x27\xb9\x1c\x4f\x8e\xbb\xdc\xb7\x45\x74\x3d\x0b\x1a\xe4\x75\x31\x7f\xf8\xcc\xe2\x96\xc3\xf2\x92\xc8\x94\xf7\x84\xe7\x7d\xb4\x37\x23\x57\x7a\x18\x47\x0a\x24\xec\x36\xf1\x32\xa9\xf1\x9f\xf9\x76\x44\x1c\x09\x98\xd6\xc4\x07\x2e\xb9\x51\xfc\xca\x88\x23\xdb\xfa\x9a\x78\x30\x88\xbb\xb6\x44\x17\x79\x3b\x45\x70\x63\xfa\x63\xea\xca\x63\x08\xc8\x93\xbe\x7a\x83\x25\x1f\xf8\xe8\xea\xff\xc6\x8f\xae\x69\x67\x39\xe1\x39\x4a\xcb\xe7\x1c\xf5\xf8\xdb\xca\x57\x07\x64\xbd\x89\xa4\x0d\xb7\x6f\x4a\x20\xdf\x37\x79\x7c\xdf\xc4\xf1\x1d\x92\x46\xdf\xfe\xe9\x4d\x16\x9d\xc3\xbf\x7b\x11\xd7\x44\x15\xee\x49\x1c\x21\x92\xee\x60\xa0\x5d\xd6\x1c\x7a\x52\x98\x3e\x3d\x3e\xa6\x52\x93\x0e\xe9\x7e\x40\x87\xd3\x3b\x4e\x96\x7b\x44\x37\x9f\xc2\x49\x72\xe8\x54\x38\x15\xdc\xfe\xa4\x3e\x20\x1b\x07\xa6\xe2\xdd\xcf\xeb\x43\x26\xbb\x71\x90\x1c\xbc\xbc\xf6\xce\xdc\x1c\xe9\x39\xe8\x76\x73\x90\x0b\x77\xf8\xdf\x13\x84\x36\xb6\x44\x02\x2e\x97\xc9\x61\xa1\x57\xd9\x5c\x26\x3d\x44\x7f\xd0\xba\x31\x19\xf7\xd9\xc6\xc0\x3b\x02\x32\x56\xb1\x19\x17\xdc\xae\xe3\x96\x71\xb0\xcf\xdb\xd4\x00\x6f\x2a\x65\xb0\x9d\x8a\xfd\x29\x4d\x00\x6e\x3c\x9f\xf1\x27\x97\x68\xcf\x1d\x8f\x0e\x0c\x34\xbe\xb3\x85\x56\xf5\xc2\x7b\xe1\x2a\x7a\xfc\x0a\x5c\xf2\x9f\xb3\xac\xbd\xd8\xd8\xa0\xc1\x55\x58\xd3\x55\xaf\x92\x57\xf1\x65\x9f\x8e\xc4\x61\xed\x70\xbd\x66\x55\xec\xa2\xc2\xae\x9c\x34\x2e\xe0\x68\x26\xc1\xf7\x13\x6e\x4c\x3d\x70\xe6\xdf\x8b\xe8\x71\x8a\x9e\x5e\xdd\xce\xe5\xa6\x18\x75\xec\x39\x00\x66\xa7\xbd\x60\x1f\x27\xcb\x88\x07\x15\xff\x0f\x4b\x68\xd9\xd2\x32\x7f\x7b\xcb\x8d\xf7\xfa\x95\x46\x1b\x09\xf5\xa3\x93\x43\x07\xe9\x03\xb0\xea\xae\x2f\x4e\x2d\x5d\xe4\x0e\xdf\x85\x6c\xb6\x81\x6f\x24\x46\x03\xab\xe8\x4c\xe8\x84\xfd\x84\xbd\xbb\x3d\xa6\xbd\xaf\x7b\xff\x0d\x00\x00\xff\xff\x01\xc2\xd9\x61\x93\x25\x00\x00"
+var _utilityExampletokenCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x5a\x7b\x6f\x1b\x37\x12\xff\x5f\x9f\x62\xaa\x03\xee\x56\x88\x2d\x3b\x6d\x92\x6b\x05\x3b\xae\x93\xc6\xb8\x02\x0d\x10\x24\x6a\xfa\x47\x60\xc4\xd4\xee\x48\xe2\x99\x4b\x0a\x24\x57\xb2\x6a\xf8\xbb\x1f\x86\x4b\xee\x4b\x5c\x5b\x76\x73\x40\x21\xc1\xd1\xee\xce\x8b\x33\x3f\xce\x83\x1b\x9e\xaf\x94\xb6\x30\xbc\x28\xe4\x82\xcf\x04\x4e\xd5\x35\xca\xe1\x20\xdc\x7e\x8f\x96\x65\xcc\xb2\xcf\x1c\x37\x66\x38\x88\x52\x77\x68\x06\x2c\x4d\xd1\x98\x84\x09\x31\x82\x54\x49\xab\x59\x6a\xe1\xdd\x0d\xcb\x57\x9e\x61\x02\x2d\x7e\xb8\x1d\x0c\x00\x00\x8e\x8e\x8e\x60\xba\x44\xc0\x35\x4a\x0b\x76\xc9\x2c\x70\x03\x98\x73\x6b\x31\x83\xcd\x12\x25\x48\xdc\x80\x25\x0b\x0d\x30\x8d\x90\x73\x69\x31\x73\xcc\x4d\xa5\xa5\x00\x27\xdb\xbc\x77\x24\x09\xcb\x55\x21\xed\x04\x7e\xbf\xe0\x37\xaf\x5e\x1c\x80\xdd\xae\x70\x02\x9f\xac\xe6\x72\x31\x6a\xa8\x57\x96\x09\x30\xc5\x6a\x25\xb6\xa0\xe6\x2d\xab\x0d\x70\x09\x78\xc3\x8d\x45\x99\xe2\x8e\xd2\x35\xd3\x60\x89\xfd\x93\xe3\x0e\xaa\x6a\xd9\x9f\xac\xd2\x6c\x81\xc0\x64\x06\x1f\x8a\x99\xe0\x29\x7c\x60\x76\x69\x76\x24\x09\xb4\xf0\x99\x15\xc2\x7a\x0e\xa2\x9a\x04\x76\xba\xe8\xe7\x28\xe5\x12\xcd\xc4\xeb\xe8\xa5\xff\x88\x29\xf2\x35\xea\x47\xb0\x9c\x67\x39\x97\xbd\x46\xed\xb0\xac\x39\x6e\x60\x5e\x48\x58\xa0\x7d\xeb\x71\xe0\x30\x92\x68\x34\xaa\xd0\x29\x4e\x5d\x14\xe8\xef\xd9\x68\x02\x5f\xe8\xc7\x25\xdc\x3a\x41\xf4\xd5\x68\x0b\x2d\xe1\x4b\x75\x83\xbe\x44\x74\xd2\x8f\xbf\xf1\xc5\x94\x74\xbc\x4e\x46\x07\x8f\x64\xfb\x85\x9b\x95\x60\xdb\x27\x70\xba\x60\xfd\xc2\x2c\x7b\x34\xef\xb4\x06\xcc\xeb\x64\x54\xb1\x5e\xba\x5f\x77\xbb\x2e\x25\x6f\x92\xf3\xc4\x1a\x9b\x1e\x8d\x39\xf4\xc0\xf9\xbf\xbe\x31\x9a\xc0\xb9\xdc\x7e\xb2\xba\x48\xed\x59\xc3\xc9\x66\xc3\x6d\xba\xac\x88\x1b\x4f\xe8\x9b\x32\x83\xfb\x78\xa0\x74\xf9\xa4\xc5\xdb\x08\xe1\x83\xcc\xc9\x0e\x27\x7d\xe7\xd6\x07\x65\x02\x06\xc5\x7c\xfc\xf0\xd2\x25\x17\xdd\x85\xef\x1b\xf5\x11\x30\xf3\x1d\xec\x43\x7c\x76\x30\x88\x18\x0b\x73\x5b\x01\xe1\xff\x67\x6f\x13\x6b\x7b\x58\x5c\x91\x9f\xed\x98\x3c\x7a\x4a\xa0\x6b\x77\xed\xc6\x9a\xf2\x56\x8e\x19\x67\x70\x0a\x6d\xbe\xf7\x74\x37\x1e\x62\xfa\xcc\xb9\xc0\x49\x87\xe5\x3f\xd3\xe9\x87\x0b\x2e\xb0\x9f\xab\xd0\x62\x02\xc3\xa5\xb5\x2b\x33\x39\x3a\x62\xc6\xa0\x35\xe3\x0d\xce\x0c\xb7\x78\x48\x22\xcd\x38\x55\xf9\xd1\xcb\xf9\xab\xef\x7f\x7a\x91\x1e\xa7\xff\x66\x3f\xa6\x59\xf6\xea\xc5\x0f\xb3\xe7\xe9\x8f\xdf\x1f\x77\x1e\xb0\x97\x2f\xd3\xd9\xf3\xf4\xa7\x1f\x5e\x7d\xbd\x10\x6a\xf3\xf5\x0f\xa5\xb3\x9c\xe9\xeb\xb1\x59\x2f\x86\x51\x1b\x46\x07\xd1\xdb\xce\x03\x14\xc7\x09\x0c\x79\xce\x16\x78\x64\xd6\x8b\x67\x37\xb9\x18\x3e\x10\x81\x96\x0b\x4d\xdc\x87\x26\xf9\xe2\x1e\x5f\x8e\x9e\xb4\xd3\x7c\xf4\xe2\x3e\x95\x2c\xc7\x09\x0c\x7d\xc9\xab\x04\x81\xc3\xc1\x30\xbe\x58\xb3\xcd\x67\x8a\xc2\xf0\xee\x62\xda\x43\x92\xa1\x49\x35\x5f\x59\xae\xe4\x04\x86\xd3\x25\x37\x54\x13\x4a\xd1\xae\x94\x53\x91\x2f\x0c\x66\xc0\x0c\x30\xaa\xb0\xa5\x7e\xab\x60\x89\x62\x05\x5b\x55\x40\x86\x6b\x14\xca\xfd\xd6\x20\xf1\xc6\xc2\xc5\x14\xfe\xa1\x24\x45\x6a\xdc\xa3\x17\x6f\x2c\x6a\xc9\xc4\xef\x1f\x7f\xeb\x62\xeb\x5d\xfd\x28\xa9\x00\xe4\xf5\x1e\xce\xed\x58\xc9\x39\x09\x56\x7a\x31\xec\x09\xb2\x50\x0b\x65\x26\x3e\x54\x71\x12\xa3\x52\xce\x84\x99\xc0\x6d\xf4\x31\x7d\x87\x76\x43\xbd\x8d\x1e\xee\x65\xa0\x27\x76\xa0\x26\xfb\xbe\xce\x84\x4a\xaf\xd3\x25\xe3\x72\xb8\x0b\x07\xfa\xdc\xed\xdc\x7d\xd2\x9e\x6f\xa6\x9c\x27\x66\xf8\x20\x21\x8e\x3c\xd3\x6c\x29\x8e\xfc\x55\x08\x88\x33\xc9\x99\x10\xf7\xb3\x0e\x7d\x8c\x6b\x48\x8e\x56\xae\x9d\x69\x31\x87\x4e\x27\xce\x9f\x7b\x5b\xfb\xf9\xf7\x50\xfe\x1b\x97\xd7\x98\x35\x72\xf8\x3f\x9b\x9d\xe3\xd8\x49\xd8\x69\x0e\xba\x16\xfc\x25\x21\xa9\x46\x66\xf1\x5d\xbe\xb2\x5b\xa7\xed\xa2\x90\x69\xb9\xe7\x92\x79\x21\x93\xd1\x04\x7e\xbe\x6d\xc5\xa8\x94\x77\x77\x0f\x3c\x7d\x64\x4f\x0e\x5b\x66\x74\x15\x25\x6b\xfa\xdb\xb0\xfa\xe7\xa8\xd5\x3d\x08\xdd\xbd\xfd\x04\x88\xb6\xbb\xa8\xa7\x40\xb4\x21\x21\x0e\xd1\x56\x67\xdf\x5a\x60\xe3\xc9\x3d\x6b\xb9\x1b\x74\x8c\x91\x5c\x34\x9b\x3c\x1a\x10\x5c\x40\xc2\x55\x75\xf7\x1d\x4b\x97\x50\x18\xd4\x40\x1b\x03\x5d\x8e\xe4\xd2\x58\x26\x53\xa4\x11\x45\x49\xb1\x05\xbb\xc4\x72\x00\xa0\x19\xc5\x2e\x91\xeb\xb0\xa9\x2a\x39\x34\x59\xcd\x3d\x28\x8c\x27\xf3\x3c\x34\x91\x2c\xd4\x1a\xb5\xc4\x0c\x66\xa5\xb4\x95\x2e\x27\x95\x95\x32\x96\xa6\xb8\x8c\x13\x9a\x4c\x25\x8e\x77\xfc\x59\xce\x67\x76\x89\x5b\x37\x99\xa5\x4c\x08\xcc\xc6\x2d\xed\xe9\x12\xd3\x6b\x03\x4b\xb6\x5a\xa1\x04\x66\x41\x17\xd2\xf2\x1c\x1d\x2b\xae\x51\x03\xab\x2c\xa4\xa2\xd0\x91\x51\xc9\xfa\xe8\x3b\x28\xa2\x90\xe5\xfa\x67\x08\x25\x2e\xb3\xb0\x32\x1a\x3c\xa9\x50\xa8\x79\x75\x49\x2d\x58\x39\x56\x92\x99\x95\x38\x32\x37\xc3\x39\xa7\xc5\x73\x79\x00\x46\x11\x87\x46\x32\x41\x2a\xd8\xb0\x2d\xcc\x15\xd9\x96\x33\xc1\x53\xae\x0a\x57\xae\x68\xd8\xf3\x3a\x4b\x2f\xd6\xae\x51\x85\x57\xcb\x25\x30\xae\xc7\x70\x0e\x66\x85\x54\x0d\xc0\x4d\xa3\x1a\x42\x0f\x08\x12\x31\x33\x24\x69\x56\xdb\x60\x95\x9b\x6b\x2b\x71\xf5\xcc\xdb\x76\x45\x73\x2e\xa8\x04\x3a\x53\x3a\xf3\x75\xb9\x07\xc3\x94\x1d\xc4\x12\x1e\x1c\x76\x61\xc6\x44\x00\x93\xa5\xf2\xbc\xae\x70\xd8\x55\x43\x33\xae\xa7\x6e\xcf\xb7\xf4\x71\xa0\xe0\x96\x33\xc1\xff\x44\x72\x61\x25\x98\xd9\xda\x40\xe7\x32\x0a\x30\x45\xbe\xe2\x25\xc6\xa4\x23\x79\xd4\x49\x4d\xae\xa3\x0e\x22\x4f\x83\xf0\x8a\xe4\xae\xbd\xbc\xb7\x0e\x3c\x0e\x5a\xc0\x22\x1d\xc7\xac\x70\x68\x5f\x73\xe6\x4c\xbd\x7a\x43\xd7\x7a\x4c\xb7\x93\xd1\x15\xe4\x68\x97\x2a\xeb\x3a\x21\xa0\xa8\x1c\xc4\x88\x96\xd4\xcc\x58\x7a\x9d\x74\xad\xe5\xf3\xb6\xc1\xaf\xe1\x78\x7c\xdc\xa1\xa1\x6f\x5f\x2e\x81\xd3\xfe\x47\x87\x2d\xd1\x83\xfe\x92\xdf\xb2\xe0\x94\x2c\xa8\x1e\xdf\x0d\xba\x8b\xdb\x99\xd9\x5d\x6a\x4c\x62\x83\x79\x23\x8f\xb5\x8c\x7c\x60\xd0\x97\x5c\x34\x33\x62\xd4\x80\xc6\x84\x4b\xfa\x93\xaf\xce\xa4\xfb\x47\xd8\x3e\x73\x1e\x3d\x7e\x91\xaa\xa8\x85\xb4\x61\x16\x68\x29\x34\x4a\x5b\xcc\x3e\x87\x9a\x67\x40\xb9\xd6\x96\x09\xb1\xf5\x36\x18\x60\x20\xb8\x71\x39\xc0\x6d\x25\x77\xd8\x64\x42\xe6\xe1\xa6\xea\x1a\xdc\xc2\x57\xd6\x44\x1d\xd1\x8c\x44\x44\x2f\xc5\xe5\x96\x7e\x4d\xe0\x8d\x52\xa2\x5b\xc7\x69\x90\x30\x81\x8b\xc8\x4c\x87\xfc\x14\x6e\x3b\x58\x69\x51\x7f\x71\xd0\x59\xa0\x5b\x64\x32\xba\x84\x53\xb0\xba\xc0\x98\xcb\xdb\x6a\x62\xde\x8b\x2e\x8b\x9b\xdd\x55\x25\xb6\x6a\x23\x46\xa5\xa1\xf1\x28\x07\xe3\xa2\x7e\xf9\x42\x42\x2e\xe1\xec\x0c\xe6\x4c\x98\xde\x04\x71\x6e\xae\x0d\x70\x4a\x79\x08\xe5\xe9\xa0\x2b\x27\x33\x84\x0d\xb7\xcb\x4c\xb3\x8d\x84\xb9\x56\xf9\x83\x39\xb1\x5e\xd0\xf9\x9a\x71\xc1\x66\x04\xbf\x3f\xbc\x8c\xce\xc1\xe3\xbd\xab\xf2\x56\x9c\x9c\xc6\xb7\x77\xc7\xfe\x60\x65\xf3\x66\x8b\x20\x74\x80\x1e\x78\xec\xba\xec\x1d\xbc\x96\x72\xd8\x62\x7a\x51\xe4\x28\x6d\x8b\x91\xca\x7e\x90\xee\x61\xeb\x99\xbc\x3f\x7c\x99\x19\x37\xb9\x5a\x12\x7e\xb5\xbe\x34\xd2\x5e\x70\xf5\x0b\xe9\x3c\x99\xe9\xad\xef\x38\xc2\xe1\xae\x9b\xfb\x68\xd2\x53\xa2\x4e\xb6\xb4\xd9\x28\x2a\xfe\xa0\xd7\xd1\x52\x91\x9e\x21\x97\x0b\xb0\x9a\x49\x33\x47\xad\x31\x1b\xc3\xaf\xd6\x3b\x8f\xec\x44\x3a\x1e\x6e\x74\x61\x24\x27\x74\x05\x5e\xad\x6a\xf5\x06\x4e\x72\xd9\x65\x50\xd5\xe7\x15\x02\x32\x5c\x29\x3a\x46\x68\xdb\x84\xc2\xe0\x86\x3a\x83\xf8\xc2\x3d\x28\xda\xa5\x37\xe0\xa0\xac\x18\x9b\x5e\x54\x44\x9a\x66\xb8\xbd\x2f\x97\xb7\x2e\x0f\x7d\x54\x63\xa8\x3a\x39\x6c\x76\x29\x75\x91\x2d\x39\x7a\xb3\x9d\x77\x41\xf3\xde\xc3\xe8\xf2\x6e\x56\xb3\xff\x62\xda\x85\x98\xeb\x26\x59\x96\xd5\x19\x82\x7c\xca\xad\xa9\xda\x04\xab\x5a\x5d\x83\xef\xdc\xd4\x46\xa2\x36\x7b\x20\x8e\x1b\x60\x42\xa8\x4d\x89\xa8\x0c\x8d\xd5\xaa\xec\x65\x0d\xbd\x0e\x70\x02\x60\x86\x29\x2b\x0c\xd6\x20\x6e\x49\xd9\x90\xc9\x0d\xb0\x2e\x95\xc8\x50\x07\x4b\x7c\x13\x06\xd3\xc0\xfb\xaf\xda\xf6\x25\x6b\xaf\x6b\x86\x28\xa9\xe9\x34\x45\x4e\x27\x1b\x32\x23\x8d\x1a\xe7\x4a\x63\x0d\x32\x67\x61\xe8\x70\x3d\x67\x17\x4e\x55\x51\xf4\x01\x49\x68\x0f\xf6\x0d\x73\xdd\x26\x84\xaa\x80\xcb\x5c\x70\x72\x58\x26\x33\x66\xbe\x8b\x61\x6d\x6f\xa4\x3d\x2b\xe5\x85\xeb\x16\x5f\xeb\x49\xa7\xdd\xf0\x27\x41\xb4\xe0\x4e\x2e\xed\xe0\xae\x3b\x5e\x36\x1f\xde\x03\xc0\x76\xba\x71\xde\x70\x79\x1c\x58\x13\x4f\x7f\xa2\x56\x2d\x21\x84\xc9\x90\x40\x78\x9d\x1f\x98\x10\x5c\x2e\x42\x9e\xa0\x06\xde\x75\xfc\x79\x41\xb3\x10\x13\xa2\xac\x09\x61\x56\xd9\x91\x48\xa3\x57\x09\xb1\x52\x36\x66\x3d\xc3\x19\xdd\x50\x9a\x20\x66\x55\x09\x5e\xe2\xe2\xba\x2d\x31\x4d\x69\xa7\xfa\x29\x81\xca\x0b\xfd\xf4\x3d\x44\x80\x85\xa9\xba\x77\x87\x52\xd7\x72\xec\x87\xab\x9d\x79\x7e\xaf\x6c\xf4\x40\x72\x39\x1e\x1f\x37\x33\x4b\x23\xce\xb4\x39\xca\x29\xa8\x77\xae\x0b\xf9\xc3\x05\xb6\x5c\x0e\xa3\x37\x4f\xc1\x13\xe5\xdc\x47\x7b\x33\xcc\x4a\x8f\x9b\x91\xfc\x10\x56\x2f\x88\x6c\x22\x31\x6e\x2b\xb5\xf6\xf1\x3d\x88\x23\x06\xd3\x50\x7c\xe0\x92\x1b\xc5\x2f\x0f\x38\xb2\x8d\xb7\x89\x07\x3b\x28\x69\x14\xae\xbc\x0f\x79\x4d\xa6\xde\x08\xd6\xa6\x3f\xa5\xae\xf4\x4e\x19\xf7\x0c\x20\xcf\x62\xf5\x86\x5e\xd4\xc6\x5f\xba\x96\xff\x86\x97\xae\xed\xce\x72\xcc\x33\x94\x96\xcf\x39\xea\xd1\x63\x10\xb6\x5b\xbe\x3a\x20\x8b\x26\x92\xe0\xc6\xbf\x9c\x40\xbe\x6d\xf2\x08\xd2\xbe\x4d\xe2\xf8\x06\x49\x23\xb6\x7f\xa2\xc9\xa2\x73\xf8\xf7\x60\xee\xa8\xa2\x0a\xf1\xb0\x56\x89\xc3\x47\xd2\x1d\x0c\x34\xcb\x9a\x43\x4f\x1b\xa6\xcf\x8f\x8f\xa9\xd4\xb4\x49\xba\x2f\xd0\xe1\xf4\x9e\x93\xe5\x08\x6b\xfd\x2a\x1c\x4e\xfb\x4f\x85\xdb\x8c\xe1\xa0\xf9\x41\xde\x40\xd8\x66\xef\xbe\x5e\xef\x33\xd9\xd1\x41\xb3\x6c\xc2\xdb\xd2\x99\x55\x57\x53\xd6\xbe\x6e\x0e\x72\xe1\xf6\xff\x7b\x82\xca\x14\x5b\x23\x70\x07\x2e\xaf\xa6\x21\x72\x10\xed\x21\xe2\x41\xeb\xc6\x64\x14\xb3\x8d\x41\xe9\x08\x48\xd9\x8a\xcd\xb8\xe0\x76\x1b\xb6\x0c\x69\xaf\xa0\xee\x92\x2b\xde\xac\x94\x69\x0c\x93\x7e\x22\xb8\xf2\xc0\x0d\xe7\x33\xae\xda\x2e\xd0\x9e\xbb\x39\xda\x4f\xa0\xe1\x99\x5d\x6a\x55\x2c\x4a\x2f\x5c\x05\x8f\x5f\x01\xa5\x26\x3d\x67\x8d\xde\xc5\x6f\x3e\x47\xe7\xd7\x74\x15\x15\xf2\x26\x3c\x8c\xc9\xa8\x7e\x52\xd3\xd5\x0c\xd7\x5b\xb6\x0a\x5d\x94\xdf\x95\xe3\xca\x05\x1c\xcd\xd8\xfb\x7e\xcc\x8d\x29\x7a\xce\xfc\xa3\x88\xae\x73\x5f\xbf\x6c\xe7\x72\xb3\x4c\x3a\xf6\x1c\x00\xb3\x93\x28\xd8\x6b\xa1\xb4\x0c\x9f\x2f\xf4\xdf\x61\x09\x0d\x5b\x1a\xe6\xef\x6e\xb9\xd1\x20\x2e\x34\xd8\x68\xd8\x1a\x93\x93\x43\x97\xb8\x0e\xc0\xaa\xfb\xde\x38\x35\x64\x91\x3b\xca\x2e\xa4\xde\x06\x65\x23\x91\x8c\xf6\x52\xe8\x98\x4b\x85\xd1\xdd\x3e\x1a\x00\x00\xdc\x0d\xee\x06\xff\x1b\x00\x01\xc2\xd9\x61\x93\x25\x00\x00"
 
 func utilityExampletokenCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -157,7 +159,7 @@ func utilityExampletokenCdc() (*asset, error) {
 	return a, nil
 }
 
-var _utilityNftcatalogCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\xdd\x6f\x1b\x37\xf2\xef\xfa\x2b\x26\x7e\xf8\xfd\xa4\x9e\x2a\xb5\x87\xa2\xc0\x09\x55\x53\x37\xb1\x01\xa3\x4d\xce\x48\xec\xf6\x21\x08\x0a\x7a\x97\xb2\x08\xaf\xc8\x3d\x92\x6b\xd5\x30\xfc\xbf\x1f\xb8\x5c\x2e\xc9\xe5\xec\x87\x83\x7e\xdc\x43\xfc\x90\x48\xbb\x33\xc3\x99\xe1\x7c\x93\x62\x87\x52\x48\x0d\x27\x6f\xa8\x26\x39\xd1\xe4\x17\x46\x8f\xea\x64\x36\x5b\xaf\xe1\xed\xf9\xd5\x2b\xa2\x49\x21\x6e\x67\xeb\xb5\x79\x70\x0a\xb7\x94\x53\x49\x0a\x28\x2b\x59\x0a\x45\x0d\x08\x48\x7a\xcb\x94\x96\x0f\xb0\x13\x12\xce\x0b\x71\x84\xb7\x82\x9f\x57\xfc\x96\xdd\x14\xf4\x4a\xdc\x51\xae\x56\x0d\x81\x33\x92\xed\x21\xb3\x34\x81\x72\x83\xa4\xb4\x90\x54\x81\x59\x1a\xc8\x8d\xa8\x34\xe8\xbd\xa5\xcb\x78\x56\x54\x39\xe3\x66\x75\x60\x5a\x41\x26\x8a\x82\x66\x9a\x09\x0e\x2c\xa7\x5c\xb3\x1d\xa3\x72\x09\x7c\xa7\x41\x3f\x94\x74\x59\xd3\x22\xb7\x14\x08\xcf\xa1\xac\x6e\x0a\x96\x41\x49\xf4\x5e\x2d\x81\xea\xcc\xf1\x70\x25\xe0\x40\xee\x0c\x10\x90\x3c\x67\x35\x39\x2d\xea\x55\x1d\x67\x0f\xa2\x82\x8c\x70\x28\xa5\xa8\xa5\x24\xbc\x66\xa8\x26\x2b\xc5\x3d\xcb\x69\xcd\xcf\xa1\x51\xd9\xaa\xd6\x0d\x87\xd3\xfc\xc0\x78\x8d\x48\x4a\x03\x47\x81\x34\x24\x48\x01\xc7\x3d\xcb\xf6\x70\x14\x55\x91\x9b\x75\x5b\x29\xe3\xa5\x67\x33\x92\x65\x54\xa9\x39\x29\x8a\x05\x64\x82\x6b\x49\x32\x1d\xec\x04\x3c\xce\x00\x00\x8c\x2e\x8d\xfa\x4e\xf3\x9c\xe6\xee\xc9\xa9\x65\x33\x50\xd3\x9e\x28\xb8\xa1\xb4\x96\x94\xe6\xdd\xb5\x0c\x5a\xb8\x1c\xbd\xa7\x5c\x07\x74\xe7\x35\x84\xf9\xf3\x24\x2f\x5a\xc5\xc3\x06\xde\x6b\xc9\xf8\xed\x32\x00\xb3\xfc\xbe\x25\x07\x3a\xf0\xfa\x34\xcf\x25\x55\x0a\x36\xd0\x7c\xf2\x20\x7c\xa7\xaf\x1e\x4a\x83\x6c\xfe\xf3\xcf\x9b\x9d\xbd\x24\x7a\x6f\xe8\xb6\x5f\x3c\x84\xdd\x6f\x0b\x70\xd9\x7e\xee\xbe\xff\x99\xf1\x3b\x9a\xa3\x4b\xe4\x4c\x95\x05\x79\xe8\xe1\x3d\xa7\x2a\x93\xac\x34\x2a\x48\x5f\xd2\xdf\x35\x95\x9c\x14\xd7\xef\x7e\x6e\x51\xeb\x97\x8b\x59\xb4\x5b\xd7\x65\x4e\x74\xb2\x5f\xaf\x90\xfd\xaa\x2c\x24\x30\x3e\x75\xc7\x1a\xda\x9f\xf7\xec\x0f\xde\xb3\x77\xf4\x20\xee\x27\xed\x99\xb4\x90\xb0\x93\xe2\x30\x75\xd7\x1a\xea\xf3\xe1\xcd\x72\x2a\xb6\xe2\x7b\x06\x2f\x9b\xe0\x82\x84\x02\xe0\xf4\xe8\x83\x8f\x8e\x62\x1e\x16\xf2\x5a\x29\x0e\x24\xa7\x3d\x1c\xa7\xcb\xcd\xdd\x0a\x17\xaf\x61\x03\xd7\x17\x5c\x7f\xfb\xcd\x72\xc4\xf2\xe0\x40\x95\x22\xb7\xd4\x3f\x50\x9a\xe8\x4a\xf9\xef\x4d\xd8\x95\xde\xd8\x7a\x44\xee\xfa\x93\x17\xb8\xeb\x48\x53\x24\x72\x1e\xf4\xf7\xca\xd4\xb5\x37\x44\xa6\xc8\xd0\x1a\x2f\x9b\x22\xa0\x33\x36\x44\xc0\x86\x99\x90\x40\x41\x3d\xfa\x1b\xc2\xc9\x2d\x95\xef\x7b\x3c\x7a\x12\xf2\x25\xea\xec\x11\xaa\xa2\xc5\xce\xe2\x36\x66\xb9\x81\x47\xab\x40\xd8\x04\x39\x70\xe5\x3f\xba\xaa\xe5\xc9\xe8\xea\x11\xdf\xa5\x2f\xbf\x07\x07\x06\x4f\x83\xcb\x19\xef\x7a\x4d\x34\x09\x97\xf5\x9f\x7e\x14\xa2\x78\xaa\x17\x3a\x6d\xea\x06\x52\xc0\x3d\xa3\x47\xe3\x4b\xb7\xc2\x6e\xc7\x63\x1d\x1e\xea\x60\x15\xb3\xf0\x18\x84\x8c\xc8\x84\x0c\x59\x78\x82\xa7\x61\x4d\x38\x65\x9a\x08\xfc\x68\xf7\x2c\xd2\x89\x7b\xdf\x28\xe2\x32\xdc\xe2\x40\xfa\x74\x8d\x7b\x22\x41\x0b\x4d\x8a\x70\x05\xbb\x40\x6b\xa0\xe9\x32\xcd\x9e\x3a\x80\x6b\x65\xab\x0b\x52\xe9\xbd\x11\x2d\x23\x9a\xb6\x86\xab\xea\x98\x92\x14\x3a\x89\xc1\x72\xcd\x74\x41\x0f\xa1\xd9\x9e\xd6\xfa\xfa\xf7\x91\x53\x99\x22\x48\xaa\x44\x25\x33\xda\xcf\x5e\x53\x2c\xb9\xbf\x44\xee\xac\x92\x92\x72\x1d\x39\x89\x73\xd9\x97\x33\x0c\xb7\x5e\x78\x57\x71\xb8\xa5\xfa\x15\x82\x3d\x5f\xb4\xf8\x9d\xc5\xcd\x9f\xa4\xba\x92\x1c\x0c\x03\x2b\x6c\xed\x08\xe1\x09\x65\x00\xd1\x8c\x65\x48\xf5\x30\xe4\x2b\xe5\x96\xb3\x05\xc2\x5a\x2f\x4f\xb0\x0d\x8a\xed\x21\x06\x19\x67\x1a\xe6\xcf\xa5\xcd\x59\xd1\x21\xda\x90\xee\xdf\xee\xf7\x9c\x94\x6a\x2f\x74\xb0\x52\x08\x57\x6f\xac\x35\x07\x07\x89\x87\x91\x36\x76\xf4\x92\x51\x7b\x53\xae\x5f\x2b\xea\x09\x19\x6f\x9d\xa1\x08\xcd\x26\x5c\x12\xa9\x19\x29\x1c\xc6\xfc\x37\x50\xcd\xc7\x9f\xe8\x83\xcf\x07\xfe\x71\x63\x75\x29\x5f\x5d\x55\x5a\x35\xc6\x92\x7d\x08\x88\x7f\x84\x6d\x4c\x74\x86\xec\x14\xc2\xf1\xfb\xae\x94\x86\x67\x5c\x72\x94\xa5\x04\xd6\xf0\xd1\x7d\x36\xc6\x4b\x1d\x47\x9d\x63\xc5\x32\x1a\x9f\x1a\xdc\xbf\x0e\x53\x91\x97\xc5\xa4\x30\x2e\x8c\xdd\x26\x66\xdb\x2b\xd8\x8e\x14\x8a\x8e\x6e\x0b\x6c\xe1\xf1\x69\x96\xd8\x34\xb6\x01\x99\xa4\x44\xd3\xb3\x43\xa9\x1f\x42\x81\x7f\x40\xac\xbc\x11\xec\xbb\x2f\x1b\x24\xf0\x08\xe1\x0a\x4d\xc0\x6e\xd3\x8d\xc9\x68\xee\xc5\x3b\x5a\x4a\xaa\x28\xd7\x0a\x18\xdf\x09\x79\x20\x75\x42\xb2\x8d\x37\x49\xfa\x47\xe7\x73\x2d\x5d\xa1\xe9\xc6\xfc\x0b\xaa\x32\xdb\x61\x02\xba\xd2\xb2\xca\xb4\xcd\x7e\x6d\xa6\x51\x9a\xf0\x9c\xc8\x1c\xf2\xca\x44\x7e\x87\xcf\x38\xb9\x61\x05\xd3\x0f\x26\x1b\xd4\x9d\xbf\xd1\x41\xbd\x96\x4a\xb4\xd3\x10\x4e\x64\x81\x47\xdc\x84\x4c\xb6\x0c\x7a\x0e\x88\x4b\x94\x3e\x0c\xdf\x83\x40\x54\x97\x0c\xc3\xfb\x9e\xc4\xd6\xe4\x1d\x73\x8a\x0d\xa4\x8f\xa7\x65\x04\xd6\xc3\x08\x06\x34\xd0\x11\xe1\x86\x1c\x70\xb0\x0d\xf9\x49\x41\x03\x2e\xb6\x01\x4b\x7d\x80\x01\x27\xdb\x84\x39\xdc\x01\xa2\x7a\xc2\x19\x0c\x62\x9f\xc1\x44\xc8\x1a\xe6\x90\x81\xc4\xd4\x7a\x32\x43\x5d\x4e\x61\xed\xee\x28\x74\xd2\xfd\xf6\x62\x44\x9d\xda\x00\xdd\xd0\xa0\x37\x3d\xfe\x3a\x82\x69\x7b\x5f\x5f\xde\xd5\x93\xbb\x55\x4c\xca\xc2\xc4\xd6\x09\x73\xbc\xe7\x1f\xe8\xf5\x63\xb1\x96\x1d\xfe\x01\x11\x60\x99\x72\x0a\x53\x58\xc5\x93\x5e\xc8\xef\x36\xda\xc3\x7e\x60\x27\xc5\xb6\x2b\x57\x8a\xe2\x26\x18\x5b\x27\x27\x46\x35\x92\x78\xdb\x51\xc1\x20\x42\x23\xfe\x36\x55\xc9\x04\x0f\x71\x05\x13\xe2\x21\x24\x6a\xf0\xbb\x13\x87\xf5\x1a\x2e\xea\x09\x2a\xfd\x24\x5f\x72\xeb\x0e\xfa\xd2\x40\x4f\xdc\x8b\xe5\xa6\xa6\x68\x2e\x1f\xc0\xaa\x1b\xec\x71\xf2\xb6\xf1\x1e\x87\x4b\x1b\xf2\x7e\x39\xeb\x6c\x9b\x5f\xb1\xda\x5b\xae\xcf\xd9\xef\xae\x37\x72\x2e\x35\x36\xbf\x19\x94\x79\x99\x08\xb7\xec\x4a\x81\xce\x0f\x70\x4f\x41\xf8\xd8\xa2\x3b\x95\x22\xb7\x5c\x6e\x5b\x86\x31\x20\xcb\xeb\xd6\x71\x8d\xa5\x9b\x9a\xf9\x6d\x23\x05\x92\x3b\x9c\x30\xdb\x56\x2e\x44\x94\x40\xeb\xdb\xa0\xdf\xfa\xb1\x10\xd9\xdd\x7c\xb1\xd2\xec\x40\x95\x26\x87\xb2\xc7\x89\xbe\x68\x9f\xbf\x3e\xbb\x7c\x77\xf6\xea\xf4\xea\xec\x75\xfb\xe8\x62\x07\xe2\x46\x13\xc6\x4d\x15\x43\x8a\x02\xa8\xed\x3c\x55\x32\xbd\x03\xa6\x80\x2a\xe3\x70\x8c\x14\x4b\x28\x0b\x4a\x82\x02\xb0\x52\xb4\x06\xf6\x55\xeb\x4f\xf4\x41\xd5\x27\x05\x3b\x21\xcf\x48\xb6\xf7\x8f\x8d\x52\xf7\x22\x37\x95\x97\xd2\x94\xe4\x2b\x4b\xe5\x8b\x75\xe2\x8c\x48\x31\x3c\x5f\xc0\xf4\x2a\xb8\xf6\x83\xa0\x18\x37\xea\x24\x59\x26\x2a\xae\x5d\x2d\xb0\xba\x11\x52\x8a\xe3\x77\xff\x17\x8c\x55\x5c\x39\xf9\xfd\xdc\x28\x61\x03\xeb\x06\x76\xdd\x29\xc8\x17\xde\xf6\x77\x7e\x9d\x17\x75\x33\xd7\x31\xca\x2e\x27\xcd\xc7\x17\x71\xe7\xe8\xa9\x20\x35\x77\x7f\x1b\xed\x70\xb0\x96\x21\x6e\x2a\x81\x16\x8a\x8e\x35\xe4\x41\xec\x8c\x2d\xaa\x97\xc4\x10\x7a\x6f\xd1\x8f\x6c\xae\xb1\x19\x53\xf4\x7f\xb0\xfb\xfb\x31\xad\xf9\xc3\x25\x56\x77\xf4\x41\x0d\xb5\x14\x89\xe5\xcd\x7f\x6b\xcb\xec\x4d\x0d\x31\xb7\x0b\x2d\xd2\xae\x2e\x5a\xa8\x21\x64\x28\x38\xfc\xc5\x33\xc4\xb2\x03\x88\xc1\xd0\xb8\x40\x6d\xf9\xe5\xb0\x02\x3e\x60\x24\x3f\x4e\x62\xac\x45\x54\xe7\x42\x9a\x4c\x3f\x6f\x32\xfe\x45\x32\x26\x59\xa4\xc3\xbf\x11\xbe\xdc\xf8\xf0\x43\x42\x73\x1a\x73\x31\x95\x8e\xc7\x27\x73\xc8\x49\xac\x74\xca\x8a\xcb\xf8\x40\x33\xe8\xf4\xf0\x02\xe2\x87\x92\x48\x72\x40\xd3\xc7\x06\xae\xf6\x14\x2a\xce\xfe\x53\x51\xe0\xa6\x30\x23\x4a\x31\x7e\xeb\x8e\x19\x99\xaa\x0f\x66\x3d\x6a\x87\xa6\xcb\x30\x96\x4e\x5b\xb9\xef\x84\x6c\x8f\x46\x43\xf6\xf6\x44\xc3\x91\x15\x05\xdc\x50\xdb\x38\xa2\x67\x63\x21\xf9\x66\x20\x7f\xda\x66\xd7\xce\x89\x87\x38\x72\x2a\x55\x07\xcd\xa5\xa3\x0d\xfc\xba\x17\x26\xf4\x1f\xc8\x9d\x6b\x74\xed\xbb\x7a\xf0\x3b\x37\xdf\x49\x53\x60\x72\x4a\x73\x65\xa8\xdf\x50\xb8\xa7\xd2\x68\x27\x5f\xa0\x8e\xd9\x50\x7f\x7b\x7e\xe5\xe4\xfd\xc3\x0b\x07\xac\x4e\x70\xf3\xdc\x4e\x86\x70\xa0\xcd\xb0\xf4\x1d\xdd\xd9\x3c\x7b\x6a\x33\xc5\xdc\xbd\x5f\xac\x32\x52\xda\xbe\x9d\x51\x85\x24\x8e\xce\xd4\xf5\xfb\xb8\xfd\x0d\x12\x4c\xef\x89\x40\xd0\xbe\xbe\x7c\x09\x25\xe1\x2c\x9b\x9f\x5c\x3a\x51\x5a\x05\x2b\xaa\xa1\x2a\x81\xc0\xc1\x12\x38\x59\x04\x93\x00\xa5\xa8\xf4\x4c\x7b\xa1\x56\xbd\xa3\xda\x17\xb0\xc5\x4b\xa3\xe0\x3c\xe7\xe4\xec\xf7\x92\x66\x9a\xe6\xbe\xd6\xb6\xf7\x16\x72\x41\x15\x70\xa1\xe1\x40\x74\xb6\x6f\x1e\x3a\xeb\x75\x5c\x84\x0c\x22\xc3\x7c\xd8\x22\x05\x77\x9f\x49\xf4\x31\xda\x1a\xc8\x01\x31\x8b\xe6\x93\x3f\x8f\x3a\xb9\x78\xfb\xdb\xbb\xb3\x5f\x2e\xce\x7e\x3d\x59\x06\xd6\xde\xee\x75\x9c\x08\x3a\x07\x03\x5b\xf4\xe9\x3f\xe0\x6b\x34\x7d\xb4\x10\x1f\x10\xac\x8f\xa6\x2a\xed\x74\x38\x2d\x15\x7a\x60\xe3\xa7\x8d\x08\xd1\xde\x63\xba\x91\x4d\xee\x30\xb2\x4a\xb4\xd6\x05\xb0\xcf\x43\x05\x76\x21\x52\x85\x86\x51\x3a\x66\xbb\x13\xa4\x7f\x65\x7a\x9f\x4b\x72\x0c\xfb\x3b\xf4\x4c\xb9\x1b\xb6\x8c\x62\x6c\x38\xbd\x78\x0d\x62\xe7\xb1\x1f\x44\x05\x47\xc2\xb5\xf1\xa1\x63\x43\x1d\x0d\x50\xee\xe5\xdb\xf3\xab\xd6\x1c\xb1\xd3\xc2\x20\x92\x94\xb2\x5b\x15\xe1\x36\xe0\xc9\x7c\x74\xa5\xa2\xb1\x46\x7e\x4f\x0a\x96\xb7\x9b\x0d\x17\xaf\x4f\x3a\x25\x54\x1c\xae\x6a\xa7\x19\x5d\xe1\x05\x1a\xe8\xda\x76\x23\xd8\x9f\xd9\xe7\x90\x98\x84\xc4\x56\x49\x7f\x59\x6c\xac\x37\xd4\x1e\x6b\x77\xa3\x61\x64\x7e\xfe\xcb\x73\xea\xd0\xd6\x44\x6c\x49\x35\x78\x7a\x3a\x58\x55\xa1\x1e\x3b\x71\x71\xab\x60\xd4\x99\x30\x4e\x46\x4a\x4d\xd4\xea\x3f\x81\x2b\xdb\x79\xc0\x06\x3e\x58\x66\x46\x5a\x8f\x76\xd9\x67\xf6\x20\xc1\x72\x69\x2f\xd2\xa8\x61\xb8\x17\xf1\x2b\x3f\xa7\x29\xf1\x07\x2c\xbd\xae\x59\x1f\xb6\x4c\x39\x41\x6e\x4f\x5f\x9a\xc3\x97\x01\x8a\x18\x3b\x36\x82\x58\x96\x48\x9e\x4f\x6f\x91\x96\x41\xa9\x3c\x7c\x48\x38\x1c\x8a\xf1\xae\xc9\xf8\x7b\x13\x8b\x4d\xe2\x30\x25\x7b\x5d\xcc\xef\x89\x02\x52\x48\x4a\xf2\x87\xfe\x1b\x84\x61\xa8\x8e\x77\xcd\x4b\x68\x3a\x91\x41\x29\xd1\xc7\xa1\xd0\xee\x53\x37\x5e\x8c\xc8\xe5\x67\x58\x71\x5d\x81\xdd\x6f\x84\xfe\xfb\x72\x68\x0c\xec\x20\x46\xd3\xf4\xf6\x56\x68\xf8\x1c\xc7\xf0\x83\xf6\x04\x29\xb9\x65\x07\xfe\xa6\x5d\x00\xde\x3c\x89\xc1\xa2\x8b\x77\x01\xe5\x70\x60\x1d\x9e\x09\xf5\x9d\x43\xf5\x23\x97\xd3\x8f\xa7\x86\x49\x78\xd0\x98\x50\x7c\xb1\x0f\xa3\x61\x01\x56\x3c\x51\x6e\x74\xeb\x6f\x00\x33\x80\x8b\x09\xc4\x37\x03\x07\x28\x04\x80\xab\x4a\xfa\x7b\x0c\xa3\xfe\x6f\x2f\xa5\x4d\x0f\x01\xf0\x27\xc7\x80\xb4\x1e\x43\xaf\x5a\x63\x95\xd9\x7a\xdd\x5c\x47\x33\x2b\xdf\x33\x51\xa9\xf6\x3e\xb6\xcd\xfb\x03\x39\xfe\x8f\x0c\x0d\x01\x3f\x24\xcf\xdb\xfb\xb3\x43\xbc\xfc\x6f\xc6\xa8\xe0\x10\x31\x78\xef\x1c\x1d\x8b\x65\xc9\xcd\x5f\xf8\x1c\xcd\xda\xbf\xcf\xd1\xec\x4f\x8f\x66\x91\x4f\x4f\x9a\xf9\xfe\xf5\xe1\x2a\x72\xaf\xe6\xfe\x6c\xe3\x62\x7f\x46\x5c\x1a\x17\xe2\xc5\x02\x1f\xb9\x5b\x46\xe6\x77\xf4\x01\x5f\x66\x81\x45\x80\x91\x5b\xe4\x78\x1b\xd7\xba\x5f\xa0\x8e\xee\x3d\xa2\xab\x3d\x53\x6d\xb9\x0e\xcc\x36\x75\xa5\xa4\x3b\x2a\x25\xcd\x97\xf5\x39\xd7\x91\x28\xa8\x14\xcd\xdb\x06\x6f\x27\x8a\x42\x1c\x4d\xda\x62\x4a\x55\x74\xe3\x88\xed\xb5\x2e\xd5\x66\xbd\xbe\x65\x7a\x5f\xdd\xac\x32\x71\x58\x0b\xbe\x2b\xc4\x71\x9d\x91\x9c\xf2\x8c\xae\x6b\x78\xb5\xfe\xe7\xb7\xdf\xfc\xcb\x21\x5d\xec\x80\xb4\x11\xe6\xff\x95\x0d\xe6\x35\x23\x50\x08\x6e\x2a\x74\x49\x95\x28\xee\xc9\x4d\x41\xa1\xfe\xf5\x4d\x4d\xaa\xe6\x2c\x93\x44\xed\xdb\x4b\xff\xeb\xb5\x9d\x4a\xb7\xe2\x64\x84\xc3\x0d\xb5\xbc\x6b\xe1\xd2\x18\xd3\xc9\xb0\x65\xf5\x0c\xd3\xbf\xe6\x8a\xec\x28\xba\x13\xc9\x75\x7e\xec\xcc\xe1\x31\xcc\x64\x76\x5f\xa3\xa9\x35\x92\xc7\x3e\xc1\x74\x62\xda\x48\x82\x64\x3b\x98\x4f\x3c\xda\x78\x51\xf7\x82\xab\x82\xf2\x5b\xbd\x37\xed\xc4\xd7\x43\x17\x17\x1d\xa1\x88\xdb\x84\xe8\x62\xf8\xdc\x6d\x32\x67\xe3\x2a\xf1\x21\x62\x24\xca\x45\x35\x1b\x32\x9d\xf0\x3f\x15\x70\xcf\xde\x60\xb3\x7b\x87\x39\xda\xe7\x46\x03\xb3\x6d\x42\x74\x68\x58\x8a\xfe\x90\xc1\x7f\xe9\x9d\x92\x76\xd7\x18\x9b\xff\x24\xf0\xc9\xdc\x34\x81\x48\x07\xa7\x09\x48\x3c\x39\x9d\x9a\x77\xa6\x8e\x2b\xf1\x99\x82\xb7\x93\xce\x90\x69\x40\xcb\xe8\xaf\x29\x86\x07\x54\x2e\x8e\x75\xa7\x00\xa3\x09\xe7\xd9\x6d\x00\xdb\xe1\x4e\xd2\x2d\xb5\x56\x2c\xcd\xab\xe9\x09\xbe\x6e\xd0\xd3\x63\xc8\xce\x30\x76\xd2\x3a\xf1\xe1\x7f\x33\xa4\x7c\x3e\x95\xc1\x79\x46\x78\x06\xf2\x29\x83\x8d\xf8\xfe\x80\x93\xbf\xb7\x8c\xd7\xb2\xea\xbf\x14\x3c\x4d\xf9\xdb\x76\x95\xe1\xd8\x37\xba\x1f\xc1\xc5\xe3\xbf\x93\xf7\x51\xf3\x7f\x5e\xc9\x35\xcd\x03\xec\xfd\xc4\xe4\x9c\x93\xde\xbf\xf1\x57\x9b\x26\xd4\x66\x09\x7a\x7a\x6f\xa0\xcf\xf0\xc3\xc5\x86\x8d\x1f\xa7\x1b\x87\xa2\xe1\x9c\xc5\x76\x7d\x44\x7c\x32\xfe\xea\xb9\xb9\x78\x44\x80\x4f\xc9\xcc\x63\x3a\xa9\xd3\x1b\x26\x07\x56\xc0\x37\x63\xd8\xa4\x31\x0e\xc0\x92\x1b\xfd\x35\x57\xfd\xbf\x65\x83\xad\xbf\xea\xc4\x77\xdd\x11\x79\xf8\x53\xa7\x3e\x62\x97\xe1\xad\xe9\xb5\x6d\x06\x07\x48\xc5\xb4\x92\x43\xd6\xaf\xd0\x6c\x15\xbb\x36\xa6\x68\x0b\x31\x9c\xea\x3c\x95\xa7\xd9\xec\x69\x06\xff\x0d\x00\x00\xff\xff\xdb\x38\xcb\xe6\xff\x3f\x00\x00"
+var _utilityNftcatalogCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x3b\x6d\x6f\xe3\x36\xd2\xdf\xf5\x2b\x66\xf3\xe1\x79\xec\x5e\x6a\xb7\x87\xa2\xc0\x19\x55\xb7\xe9\x6e\x02\x04\x6d\x73\x8b\x6c\xd2\x7e\x58\x2c\x16\x8c\x44\xdb\x44\x64\x52\x47\x52\x71\x83\xc0\xff\xfd\x40\x91\x14\x5f\x44\x49\x4e\xd0\x37\xe0\x8a\x14\xa8\x2c\xcd\x3b\x67\x86\x33\x43\x2e\xd9\xd5\x8c\x4b\x38\xf9\x09\x4b\x54\x22\x89\x7e\x26\x78\x2f\x4e\xb2\x6c\xb9\x84\xab\x8b\x9b\x37\x48\xa2\x8a\x6d\xb2\xe5\x52\xbd\x38\x83\x0d\xa6\x98\xa3\x0a\xea\x86\xd7\x4c\x60\x05\x02\x1c\x6f\x88\x90\xfc\x11\xd6\x8c\xc3\x45\xc5\xf6\x70\xc5\xe8\x45\x43\x37\xe4\xae\xc2\x37\xec\x1e\x53\xb1\x30\x04\xce\x51\xb1\x85\x42\xd3\x04\x4c\x15\x92\x90\x8c\x63\x01\x8a\x35\xa0\x3b\xd6\x48\x90\x5b\x4d\x97\xd0\xa2\x6a\x4a\x42\x15\x77\x20\x52\x40\xc1\xaa\x0a\x17\x92\x30\x0a\xa4\xc4\x54\x92\x35\xc1\xfc\x14\xe8\x5a\x82\x7c\xac\xf1\x69\x4b\x0b\x6d\x30\x20\x5a\x42\xdd\xdc\x55\xa4\x80\x1a\xc9\xad\x38\x05\x2c\x0b\x2b\xc3\x0d\x83\x1d\xba\x57\x40\x80\xca\x92\xb4\xe4\x24\x6b\xb9\x5a\xc9\x1e\x59\x03\x05\xa2\x50\x73\xd6\x6a\x89\x68\x2b\x50\x4b\x96\xb3\x07\x52\xe2\x56\x9e\x9d\x31\x99\xa2\x0c\x67\x14\xce\xca\x1d\xa1\x2d\x22\xaa\x6b\xce\x1e\x30\x20\x43\x02\x55\xb0\xdf\x92\x62\x0b\x7b\xd6\x54\xa5\xe2\xdb\x69\x19\xb2\xce\x32\x54\x14\x58\x88\x19\xaa\xaa\x39\x14\x8c\x4a\x8e\x0a\xe9\xad\x04\x3c\x65\x00\x00\xca\x96\xca\x7c\x67\x65\x89\x4b\xfb\xe6\x4c\x8b\xe9\x99\x69\x8b\x04\xdc\x61\x4c\x15\x47\x5c\xc6\xbc\x14\x9a\xcf\x0e\x3f\x60\x2a\x3d\xba\xb3\x96\xb0\xfa\xcf\x91\xbc\xec\x0c\x0f\x2b\x78\x2f\x39\xa1\x9b\x53\x0f\x4c\xcb\x7b\x85\x76\x78\xe4\xf3\x59\x59\x72\x2c\x04\xac\xc0\x3c\x39\x10\xba\x96\x37\x8f\xb5\x42\x56\xff\x73\xef\xcd\xca\xbe\x43\x72\xab\xe8\x76\x3f\x1c\x84\x5e\x6f\x0d\xf0\xae\x7b\x8e\xbf\xff\x48\xe8\x3d\x2e\x93\x2c\x4a\x22\xea\x0a\x3d\x0e\xc8\x5e\x62\x51\x70\x52\x2b\x13\xf4\x3f\xe2\x5f\x25\xe6\x14\x55\xb7\xd7\x3f\x76\xa8\x2d\xe6\x3c\x0b\x56\xeb\xb6\x2e\x91\xec\xad\xd7\x9b\xc4\x7a\x35\x1a\x12\x08\x3d\x76\xc5\x0c\xed\xbf\xd7\xec\x37\x5e\xb3\x6b\xbc\x63\x0f\x47\xad\x19\xd7\x90\xb0\xe6\x6c\x77\xec\xaa\x19\xea\xb3\xf1\xc5\xb2\x26\xd6\xea\x3b\x01\xdf\x99\xe4\x92\x48\x05\x40\xf1\xde\x25\x1f\x19\xe4\xbc\x54\xca\xeb\xb4\xd8\xa1\x12\x0f\x48\xdc\x67\x37\xb3\x1c\x2e\xdf\xc2\x0a\x6e\x2f\xa9\xfc\xfa\xab\xd3\x09\xcf\x83\x1d\x16\x02\x6d\xb0\x7b\x21\x24\x92\x8d\x70\xbf\x4d\xda\xe5\xce\xd9\x06\x54\x8e\xe3\xc9\x29\x1c\x07\xd2\x31\x1a\xd9\x08\xfa\x73\x75\x8a\xfd\x2d\xa1\x53\xe0\x68\x26\xca\x8e\x51\xd0\x3a\x5b\x42\xc1\x79\xd6\x23\x50\x61\x87\xfe\x13\xa2\x68\x83\xf9\xfb\x81\x88\x3e\x0a\xf9\x5d\x32\xd8\x03\x54\x81\xab\xb5\xc6\x35\x6e\xb9\x82\x27\x6d\x61\x58\x79\x7b\xe0\xc2\x3d\xda\xaa\xe5\xa0\x7c\xe3\x29\xbd\x4a\x9f\x7f\x0b\x16\x0c\x0e\xa3\xec\x54\x74\xbd\x45\x12\xf9\x6c\xdd\xd3\xf7\x8c\x55\x87\x96\xd1\x99\xa9\x1b\x50\x05\x0f\x04\xef\x55\x2c\x6d\x98\x8e\xfb\x27\x25\x67\x1b\xa6\x10\x8a\xf0\xe4\xa5\x0c\xef\x8b\x26\x0b\x07\x38\x8c\x5b\xc2\x1a\x53\x65\xe0\x27\x1d\x68\x81\x4d\xec\x77\x63\x08\xfb\xb3\x5d\x62\x4f\xfb\x3e\x8f\x07\xc4\x41\x32\x89\x2a\x8b\x22\x3a\xa7\xe8\x1c\xb4\xcf\xc6\xac\xa9\x05\xb8\x15\xba\xba\x40\x8d\xdc\x2a\xd5\x0a\x24\x71\xe7\xb8\xa2\xcd\x29\x71\xf1\xe1\x4b\xd2\xfa\x9b\xc2\x93\x15\xde\xf9\x6e\x7b\xd6\xae\xe5\xbf\xf7\x14\xf3\x3e\x02\xc7\x82\x35\xbc\xc0\xc3\xe2\x99\x62\xc9\xfe\xf5\xf4\x2e\x1a\xce\x31\x95\x16\xab\xcd\x29\x36\x64\x5f\x67\x29\xdc\x56\xd2\x75\x43\x61\x83\xe5\x9b\x04\xf6\x6c\xde\xe1\x47\xcc\xd5\x7f\x1c\xcb\x86\x53\x50\x86\x5f\xa4\x78\x07\x08\x87\x2c\xf8\x69\x04\x48\x58\x46\x0b\x24\x06\x04\x72\x95\x72\x27\xd9\x3c\x21\xda\xa0\x4c\x90\x7b\xc5\xf6\x98\x80\x84\x12\x09\xb3\xe7\xd2\xa6\xa4\x0a\xe0\x75\x80\x1e\xb2\x91\xe5\x7e\x4f\x51\x2d\xb6\x4c\x7a\x9c\x7c\x38\xe5\xd0\xc6\xc9\x2c\xa4\x1f\xc5\xce\x59\x6c\x58\x1c\x06\xc9\x88\xad\x2a\xd7\x6f\x05\x76\x84\x54\xb4\x66\x49\x04\xb3\x08\xef\x10\x97\x04\x55\x16\x63\xf6\x09\x84\x79\xfc\x01\x3f\xda\x25\x38\x05\xf7\xda\x78\x5d\x5f\xae\xd8\x94\xda\x8c\xa1\x66\x1f\x3c\xe2\x1f\x21\x0f\x89\x66\x89\x95\x4a\x48\xfc\x3e\xd6\x72\xf6\x69\x48\xf3\xa4\x48\x3d\x58\xc8\xfb\xf8\x53\xb2\xb4\x79\xd4\x06\x56\xa8\xe3\x6c\x3e\xb1\x7e\x91\x50\x41\x94\x85\xa4\x52\x52\x28\xbf\x9d\x1d\xad\xd8\x1a\x55\x02\x4f\x2e\x0b\xe4\xf0\x74\xc8\x7a\x3e\x9d\x5a\x80\x82\x63\x24\xf1\xf9\xae\x96\x8f\x16\x5b\x29\xfc\x5d\x47\xea\x29\x8b\x14\xfb\xe6\x73\x83\x04\x0e\xc1\xe7\x60\x12\x76\xb7\xdd\xa8\x1d\xcd\x7e\xb8\xc6\x35\xc7\x02\x53\x29\x80\xd0\x35\xe3\x3b\xa4\xd2\x88\x69\xbc\x4d\x9b\xeb\x36\xd1\x2e\xe6\x3a\xba\x4c\xe2\x15\x5c\x31\x09\xa2\x51\xcb\xa1\x12\xba\x90\xbc\x29\xa4\xde\xfd\xec\x92\xa8\xf2\x87\x96\x88\x97\x50\x36\x18\x24\xb3\xf8\x84\xa2\x3b\x52\x11\xf9\xa8\x76\x03\x55\xb9\x60\x95\xbb\x5a\x29\x45\x2f\xe0\x0d\xe1\x9e\x2e\xf0\x94\x76\x21\xb5\x91\x7b\x3d\x07\x84\x25\xca\x10\x86\xeb\x41\x20\xa8\x4b\xc6\xe1\x5d\x4f\xa2\x6b\xf2\xc8\x9d\xba\x5f\x51\x1f\x14\xca\xe4\x9a\x91\xb0\x19\x0a\x04\x49\x01\x8d\x74\x44\x69\x47\xf6\x24\xc8\x7d\x79\xfa\xa0\x9e\x14\xb9\x27\xd2\x10\xa0\x27\x49\x0e\xf1\xab\x74\x00\x2c\x97\x89\x18\x4e\xf8\xa7\x37\x11\xd2\x8e\x39\xe6\x20\x21\x35\x78\x1a\x5c\xbe\x64\xbb\x3b\x09\x6d\x4a\x76\x57\xbc\x0f\x62\x04\x9d\xda\x08\x5d\xdf\xa1\x57\x03\xf1\x3a\x81\xa9\xe7\x15\xae\xbc\x6b\x27\x77\x8b\x90\x94\x86\x09\xbd\x13\x66\x49\x23\x9c\x8e\xf4\xfa\x61\x03\xea\x77\x43\x4a\x7e\x93\x95\x83\x77\x01\x8c\x96\x02\x8e\x11\x35\xe9\xbf\x81\xbc\x79\x27\xa7\xfa\x39\x0c\x6c\xb5\xc8\x21\x7a\xd3\x47\x31\xda\x41\x6e\xf5\x4c\x51\xed\x04\x55\x1a\xe7\x91\x09\x46\x11\x8c\xfa\x01\x8e\x59\x98\xe9\x08\xb1\x05\x53\x22\x42\xbc\xe9\x62\x54\x5c\x1b\xd8\xcb\x76\x82\x8a\x5f\x14\x4b\x96\xef\x68\x2c\x59\x75\x82\x86\x66\x22\xa6\xec\xd4\x34\xb9\x97\x8f\x60\xb5\x0d\xf6\x34\x79\x3d\x4c\x98\x86\xeb\x37\xe4\x83\xa0\x7a\xb7\x2d\x6f\x48\x1b\x2d\xb7\x17\xe4\xd7\xaf\xbf\x0a\x43\x6a\x6a\x7e\x33\xaa\x73\x37\x3d\xf0\x10\x22\x2d\x92\xf3\x83\x74\xa4\x24\xe4\xc8\x21\xf5\xba\x8f\xdc\x49\x99\x77\x02\xa7\x80\xb4\xac\xb9\x95\xba\x0f\x62\x84\xcf\xcd\x5a\xf4\x01\x3a\x65\xf2\x4e\xaf\x3e\x90\x6f\xf5\xdc\xeb\xb7\xbe\xaf\x58\x71\x3f\x9b\x2f\x24\xd9\x61\x21\xd1\xae\x1e\x08\xa2\xcf\xba\xf7\x6f\xcf\xdf\x5d\x9f\xbf\x39\xbb\x39\x7f\xdb\xbd\xba\x5c\x03\xbb\x93\x88\x50\x55\xc5\xa0\xaa\x02\xac\x3b\x4f\xd1\x9b\xde\x01\x11\x80\x85\x2a\x99\x08\xaa\x4e\xa1\xae\x30\xf2\x0a\xc0\x46\xe0\x36\xf0\x5c\xd5\xfa\x03\x7e\x14\xed\x01\xc4\x9a\x71\x75\xdc\xe1\x5e\x2b\xa3\x6e\x59\xa9\x2a\x2f\x21\x31\x2a\x17\x9a\xca\x67\xcb\xec\x88\x62\x78\x36\x0f\x66\x11\x7d\x2f\x3a\x78\xee\xa0\xfc\xdb\x76\x02\xaa\x18\x57\xe6\x44\x45\xc1\x1a\x2a\x6d\x2d\xb0\xb8\x63\x9c\xb3\xfd\x37\xff\xe7\x28\x2d\x6c\x39\xf9\xed\x4c\x19\x61\x05\x4b\x03\xbb\x34\x00\xf6\xfb\xbc\x63\x44\xd6\x8e\xcf\xab\xb6\x99\x8b\x9c\x32\x96\xc4\x3c\xbe\x0a\x80\x3c\x2a\x89\x9a\x3b\x24\xe8\x17\xf8\x16\xc7\x59\x29\x2a\x88\xed\xdf\x01\x70\x25\xf0\x08\x25\xaf\x84\x0f\x11\xb3\x51\x12\x63\xe8\x81\x33\x4e\x2c\xae\xf2\x19\x55\xf4\x7f\xd0\xeb\xfb\xd1\x63\x93\x60\xb1\xb8\xc7\x8f\x62\x88\xba\xf2\x9a\x9e\xe7\xcd\x3e\x75\x65\xf6\x4a\x3d\xc1\x4c\x33\x9a\xf7\xbb\xba\x80\x91\x21\xa4\x28\x58\xfc\xf9\x10\xe3\x84\x5a\x6d\x67\x3b\x9e\x1a\xe7\x49\x5f\x7e\x3d\x6e\x80\x0f\x29\x92\x1f\x8f\x12\xac\x43\x14\x17\x8c\xab\x9d\x7e\x66\x76\x7c\x47\xc8\x17\xcd\x05\x5c\x3b\xfc\x9b\x90\xcb\x8e\x0f\x3f\xf4\x68\x1e\x27\x5c\x48\x25\x8a\xf8\x48\x94\xc3\x71\xa2\xf8\x7c\xbb\x49\x73\x77\xa0\xe9\xec\x38\x50\x40\x7c\x57\x23\x8e\x76\xc9\xed\x63\x05\x37\x5b\x0c\x0d\x25\xff\x69\x30\x50\x75\x00\x83\x84\x20\x74\x63\x8f\x19\x89\x50\xc5\x94\x87\x1a\xd1\xb4\x3b\x8c\xa6\x63\x57\xbe\x3d\x43\xb6\x47\xa3\x0e\x17\xe4\x16\x49\xd8\x93\xaa\x82\x3b\xd5\x71\x32\x9e\x3e\x1b\xf3\xc9\x9b\x81\xfc\x99\x7d\x8c\x54\x04\xa6\xa6\x8a\x22\x42\xb3\xdb\xd1\x0a\x7e\xd9\x32\x95\xfa\x77\xe8\xde\x36\xba\xfa\x5b\x3b\xf8\x9d\xa9\xdf\xc8\x14\x98\x14\xe3\x52\x28\xea\x77\x18\x1e\x30\x57\xd6\x29\xe7\xbd\x95\x56\x8b\x6c\xa8\x5f\x5d\xdc\x58\x7d\x7f\xf3\xc2\x21\x55\x27\xd8\x79\xae\xe7\x32\x7e\x05\x64\x86\xa5\xd7\x78\xad\xf7\xd9\x33\xbd\x53\x98\x63\x02\xcc\xe7\x8b\x02\xd5\xba\x6f\x27\x58\x24\x36\x8e\x68\xea\xfa\xad\x3b\x83\x54\x7f\x0e\x6e\x11\x01\x26\xba\xed\x39\xbc\x7e\x0d\x35\xa2\xa4\x98\x9d\x18\x67\xe5\xce\xc0\x02\x4b\x68\x6a\x40\xb0\xd3\x22\x9f\x98\x63\x0b\xf5\x1f\x12\x02\x73\x27\xb4\x61\x71\x8d\xd7\x8b\xc1\x51\xed\x2b\xc8\xd3\xa5\x51\x67\xd8\x15\x9c\x9c\xff\x5a\xe3\x42\xe2\xd2\x98\x0b\x55\xe6\xde\x42\xc9\xb0\x00\xca\x24\xec\x90\x2c\xb6\xe6\xa5\xf5\x5e\x2b\x85\x2f\x60\x62\x98\x0f\xb9\x67\x1d\xfb\x72\xc8\x25\x86\x04\xed\x1c\x64\x97\x70\x0b\xf3\xe4\xce\xa3\x4e\x2e\xaf\x3e\x5d\x9f\xff\x7c\x79\xfe\xcb\x89\x73\x96\x55\xf7\xe4\xf6\xcd\x36\xe1\x46\x07\x03\x79\xf2\xed\x3f\xe0\xcb\x10\x2b\xd2\x52\x7c\x48\x60\x7d\x84\x3c\xb6\x86\x33\x15\xde\x91\xe9\xd3\xc6\x04\xd1\xd3\xa4\x91\x60\x35\xb5\xc8\x91\x20\x8b\x9e\xd5\x62\x00\xfd\xde\x37\x60\x0c\xd1\x37\xa8\x9f\xa5\x43\xb1\xa3\x24\xfd\x0b\x91\xdb\x92\xa3\xbd\x7f\x7b\x24\x79\xa6\x1c\xa7\x2d\x75\xdc\xa3\xd3\xe9\xe5\x5b\x60\x6b\x87\xad\xee\xb3\xec\x11\x95\x2a\x49\xed\x0d\xf5\x64\x82\xb2\x1f\xaf\x2e\x6e\xac\x74\xc9\xd3\x42\x2f\x93\xd4\x3c\xae\x8a\xd2\x3e\xe0\xc8\x7c\xb4\xa5\xa2\xf2\x46\xfa\x80\x2a\x52\x76\x8b\x0d\x97\x6f\x4f\xb2\x7e\xf5\xe5\xd2\x15\xaa\x20\x9f\xe6\xf0\x2a\x81\xe9\xb5\x1b\xde\xfa\x64\x49\xc8\xff\xed\x94\xd8\x19\xe9\x0f\xcb\x8d\xad\xcb\xe8\x63\xed\xc8\x80\xa1\xfb\xb9\x1f\xcf\xa9\x43\x2d\x2d\x31\x9b\x4f\x9e\x9e\x3e\x25\xe3\x35\xf6\xb5\x17\x30\xd7\x06\x4e\x06\x53\x4a\x92\x89\x52\x33\xe9\xf5\x2f\x90\x4a\x77\x1e\xb0\x82\x0f\xda\x2a\x1f\x8f\x64\xfb\xcc\x1e\xc4\x63\xd7\xef\x45\x8c\x19\xc6\x7b\x11\xc7\xf9\x39\x4d\x89\x3b\x60\xe9\x5b\xd8\x84\x83\xea\xbb\xbe\x1b\xfc\xda\x37\xc7\x37\x9f\x9b\xc3\x97\x11\x8a\x29\x71\x74\x51\xa5\x45\x42\x65\x79\x7c\x8b\xe4\xf6\xf8\x54\x0d\x78\x7c\x2a\x4e\x77\x4d\x90\x77\xb9\x58\x6d\x1c\xaa\x64\x6f\x8b\x79\x75\xd9\x04\x55\x1c\xa3\xf2\x71\xf8\x06\xa1\x9f\xaa\xbb\xc7\x96\xa7\xd3\x50\xb5\x33\xa3\x5a\x26\x5f\xfb\x4a\xdb\xa7\x38\x5f\x4c\xe8\xd5\xe1\x39\xb4\xb6\xae\xf0\xea\x89\xee\xc3\xc8\x7d\xb9\xd4\xeb\xf0\x4c\x26\x9a\xa6\x5b\xb6\xdd\x20\x5a\xbd\x4f\x63\x98\x0a\x3d\x85\x64\x3e\x85\x78\xa6\xaf\xf4\xc0\xcd\x9b\x10\xcc\x8c\x6d\x54\x61\x1d\x50\xb6\x8a\xa8\xee\xd2\x3f\x13\x0a\xb1\xdd\xa1\xcf\x30\xb2\x83\x49\xe1\x7a\x87\x42\x53\x24\x1c\x68\x48\x28\xbc\xd8\x97\xa2\xa1\x01\x16\xb4\x67\xdc\xe0\xd6\xdf\x08\xa6\x07\x17\x12\x08\x6f\x06\x8e\x50\xf0\x00\x17\x0d\x77\xf7\x18\x26\xe3\xbf\x69\x6f\x9a\x1d\x9f\x02\xe0\x77\xce\x01\xfd\x7a\xcc\xc1\x79\xb7\x3f\x52\x95\xd9\x72\x09\x7a\xdf\x86\x9a\xe3\x07\xc2\x1a\xd1\xdd\xc7\xd6\xfb\xfe\xc8\x1e\xff\x5b\xa6\x06\xcb\x65\xb9\x54\x77\x9d\xed\xb5\xbf\x51\x59\xfe\x9a\x39\xca\x3b\x44\xf4\xbe\xdb\x23\xaa\x54\x2e\xb3\xf7\x16\xff\xce\x66\x7f\x67\xb3\x3f\x23\x9b\x05\x31\x7d\xd4\xcc\xf7\x8f\x4f\x57\xdd\xa3\x0a\x2f\x73\x7f\xd6\x84\xd8\xef\x91\x97\xa6\x95\x78\x35\xcf\x52\x1a\x1b\x41\x66\xf7\xf8\x31\xcd\x66\x9e\xca\x00\xf6\x62\xef\x33\xc4\xf5\x8e\xf4\x3d\x73\x04\x6b\xbd\x5c\xc2\xcd\x96\x88\xae\x5c\x57\xe3\x50\x35\xf0\xaa\x39\x5e\x63\xce\x71\x79\xda\x9e\x73\xed\x91\x80\x46\xdd\xff\xb4\x0d\xde\x9a\x55\x15\xdb\xab\xa9\x29\x11\xa2\xc1\x2b\x4b\x6c\x2b\x65\x2d\x56\xcb\xe5\x86\xc8\x6d\x73\xb7\x28\xd8\x6e\xc9\xe8\xba\x62\xfb\x65\x81\x4a\x4c\x0b\xbc\x6c\xe1\xc5\xf2\x9f\x5f\x7f\xf5\x2f\x8b\x74\xb9\x06\xd4\x65\x98\xff\x17\x3a\x99\xb7\x82\x40\xc5\xa8\xaa\xd0\xd5\x1d\xa5\xea\x01\xdd\x55\x58\x4d\x84\x0d\xa9\x56\xb2\x82\x23\xb1\xed\x2e\xfd\x2f\x97\x20\x03\x75\xd4\x3f\xd4\xb9\xc3\x5a\x76\xc9\x8c\x57\x00\x91\xbd\x61\xcb\xe2\x19\xae\x7f\x4b\x05\x5a\xe3\xe4\x4a\x58\xff\xef\x4c\xdf\xff\xe4\x87\x46\x7b\xd6\xaf\xc8\xfb\xa2\xa4\xf6\xb1\x17\xb8\x4e\x48\x3b\xb1\x41\x92\x35\xcc\x52\xe7\x09\x89\xa3\x8d\x57\x6d\x2f\xb8\xa8\x30\xdd\xa8\x4b\x43\x39\x7c\xe9\x6b\x11\x4b\x69\x09\x05\xd2\xf6\x88\xce\xc7\xcf\xdd\x8e\x96\x6c\xda\x24\xa3\xc7\x75\x83\x35\x5b\x62\x3a\x61\xdb\x79\x3b\x14\x44\xd5\x4f\xa9\xd9\xbd\xc5\xf4\x6d\xe4\xab\x93\x6c\xec\x21\xef\x11\x8d\xd2\xc0\xf4\x3f\x64\x70\x3f\x06\xa7\xa4\x31\x8f\x45\x0a\xce\x9b\xff\xf4\xe0\x7b\x73\xd3\x1e\x44\x7f\x70\xda\x03\xb1\x9f\x9e\xb7\xef\x24\x56\x24\x39\xae\x4c\xda\xda\x73\xc6\xc0\x52\x71\xb2\x9d\xfe\xd7\x14\x3e\x6e\x5f\x7c\x9b\xc7\xe2\x29\xc0\xe4\x86\xf3\xec\x36\x80\xac\x03\x4d\xbb\x20\x89\x4b\xad\x05\xe9\xef\xab\x8e\x8a\xdd\x30\xa5\x41\xef\x1f\x43\x42\xfe\x7c\x3e\xe1\xe1\xbf\x19\x52\x3e\x9f\xca\x40\x71\xa0\xe7\x19\x9d\x97\xbe\x70\xb0\xe1\x12\x83\xfa\xb3\xfa\x0f\xb1\x04\xc9\x9b\xd4\x9d\xb1\x67\xa8\x03\x79\xc7\x65\x3c\xf7\x4d\xae\xc7\xd3\xe1\x2f\x21\xfb\xa4\xfb\x3f\xaf\xe4\x3a\x2e\x02\xf4\xfd\x44\xcf\x62\xca\x5a\xaa\x31\xb5\x10\x91\xbf\xa6\x8d\x12\x9f\x1e\xe0\x87\xfe\xbd\x81\x21\xc7\xf7\x99\x8d\x3b\x7f\x9a\x6e\x98\x8a\xc6\xf7\x2c\xb2\x1e\x22\xe2\x36\xe3\x2f\x22\xf7\x49\x09\xed\xf1\x5c\xc1\x84\x02\xf3\x71\xef\x7c\x91\x4d\x20\x1f\xd0\xc3\xf1\xca\xe2\x31\x6c\xec\x8f\xbe\xbb\xf5\x6e\xf4\xb7\x52\x45\x53\x5a\xef\x1e\x36\xe4\xee\xaa\x13\x5d\xcb\xf4\x54\x77\x94\x98\x3b\xb6\x51\xb4\x74\x33\x38\x42\x2a\xa4\x15\x1e\x06\x42\x0e\x5f\x64\x29\x73\x86\xa1\x9d\x32\xb4\x86\x48\x82\xf8\xe4\x0d\x95\x43\x96\x1d\x32\xf8\xef\x00\xdb\x38\xcb\xe6\xff\x3f\x00\x00"
 
 func utilityNftcatalogCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -177,7 +179,7 @@ func utilityNftcatalogCdc() (*asset, error) {
 	return a, nil
 }
 
-var _utilityNftcatalogadminCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\xcd\x6e\xe3\x36\x10\xbe\xfb\x29\x66\x7d\x28\x2c\xc0\x6b\x5f\x8a\x1e\x84\xb8\x6d\xba\x71\x01\x17\xd8\x34\xc8\x26\xdb\x63\xc1\x50\x63\x9b\x5d\x89\x14\x48\xda\x59\x23\xf0\xbb\x17\xa4\xfe\x28\x89\x92\x95\xbf\xa2\x01\xa2\x93\x48\xce\xcc\x37\xf3\x71\x38\xa4\x28\x96\xa4\x42\x6a\x18\x5f\xfe\x7e\xf3\x89\x68\x12\x8b\xcd\x78\x34\x9a\xcf\xa1\x6a\x9f\x47\x09\xe3\xa3\xf9\xdc\xf4\x9e\x73\x20\xa6\x09\x54\x70\x2d\x09\xd5\xa0\xb7\x44\x43\x84\x6b\xc6\x51\x01\xe1\x00\x90\x4b\x48\x54\x62\x27\x29\x02\xe1\x91\x51\x25\x90\x4a\xf1\xfd\x50\xf5\x6b\x01\x12\x29\xb2\x3d\x02\x01\x4a\x52\x72\xc7\x62\xa6\x0f\x99\xc5\x18\xb5\x82\x83\xd8\x41\x42\xbe\x21\xd0\x2d\xe1\x1b\x54\x46\x45\x6f\xd1\xf8\x06\xb9\x73\xd6\x32\x8f\x20\x21\x9c\x6c\xd0\x40\xa4\x42\x91\x58\x8d\x46\x84\x52\x54\x6a\x42\xe2\x38\xa8\xbc\x6d\x44\x05\x0f\xa3\x91\xf5\xd8\x91\x45\xae\x99\x8e\x31\x41\xae\x0b\x90\x73\xaa\x99\xe0\x2a\x93\x35\x2c\x44\xd1\x55\x0e\x74\x4e\x29\xa6\x1a\xa3\x62\x68\x99\x30\xad\x31\x82\xfb\x2d\xf2\x2c\x64\x2b\x66\x3c\x27\x51\x04\x04\x38\xde\x03\xcd\xcc\x02\xd3\x98\xc0\x96\x28\xb8\x43\x23\x9d\xa6\x52\xec\x31\x82\xbb\x83\x21\xd2\xb2\xd8\xf6\x6e\x6f\xfc\xf2\x38\x30\xb1\xa2\xe6\xc9\x30\x51\x86\x46\x4c\xa2\x52\xd3\x72\x88\x8a\x38\x46\x1b\xcc\x2a\x32\x71\xae\x19\x4a\x08\xe1\x8b\x96\x8c\x6f\x5c\xb1\x8c\xae\x4b\x92\x60\xcf\x70\x6e\x1e\x3c\x40\x11\x53\x69\x4c\x0e\x35\x03\x76\x30\x28\x49\xbc\x4d\x23\xa2\xf1\x09\x3c\xee\xac\xa2\xcd\x99\x67\xd2\xe8\x77\xe1\xcd\x31\x59\x04\x70\x8d\xff\x20\x1d\x9e\x8b\x42\x9e\xa2\x52\xe6\x06\x67\x1d\xfc\x35\x81\xdf\x16\x73\x6e\x3c\x31\x9a\x45\x95\x30\x7e\x25\xd9\xde\xe4\x04\xd1\xdb\x10\x9c\x46\xb7\xc6\x17\x2d\x24\xd9\xe4\x1a\x4e\xa3\x17\x44\x7c\x3f\x5c\xed\xee\x62\x46\x73\xa0\xf2\xfd\x84\x52\x3f\x98\x2d\x4d\x45\xba\x17\x8d\x5a\xc1\xcd\xcb\xa4\x5b\x40\x9b\x80\xa5\x78\xad\x42\x3a\x52\xf5\xa2\x18\xc0\x7a\x67\x56\x59\x94\x77\x2f\xb9\x96\x87\x89\x6f\x92\xcb\x49\x84\x04\x35\x89\x88\x26\x10\x3a\x05\x79\x56\xbd\x7e\xce\xc7\x03\x78\x28\xc1\xcd\xe3\x08\x0f\x03\xf4\xf5\xd6\xe0\x8b\xd7\xa0\xc4\x39\x0e\x8a\x37\x5b\x38\x27\x3d\x28\xf3\x0e\x5e\x24\xe8\x81\xa8\xaf\x18\xb7\xc4\x44\xec\x1f\x11\x77\x4f\x30\xc3\x4d\xf9\xba\x9f\xef\xf8\x2d\x57\x64\x8d\xfd\xee\x4f\x81\xaf\xf5\xcd\x21\xc5\x76\x16\x3f\x2a\xb2\x7e\x2c\xff\x6c\x79\x90\x5b\x5d\x8f\x65\x21\xdf\x1b\xf3\xa1\xa2\x7c\x4f\x8a\x9d\x61\x75\x01\x21\xdc\xae\xb8\xfe\xe9\xc7\x66\x78\xa9\xc4\x46\x4f\x23\xe8\x0d\xea\x86\xd9\x6c\x4e\x6b\xb6\xab\x46\x00\x1f\x16\xc0\x59\x0c\x21\x8c\x57\x7c\x4f\x62\x16\x95\xdb\x09\xac\x2e\xc6\x2f\x09\xf5\x61\xa6\x34\xd1\x3b\x05\x8b\x05\x8c\x57\x97\x7f\x5f\x2f\xbf\xae\x96\x7f\x8d\x7d\xd0\x75\xdc\x63\xad\x65\x2a\x31\xf5\xe0\xc2\xe2\x59\xce\xb5\x30\x38\xde\xfb\x2c\xd4\x61\xaa\xd7\x72\x16\xbb\xb2\xcb\x63\x6b\x76\xb2\x40\x78\xb5\x8a\x71\x23\xa9\x94\xd9\x48\x3a\x05\xed\xf0\x14\x32\xe2\x43\x18\x9f\x5f\x5d\x5d\xff\xf9\x75\x79\x31\x9e\x3a\x67\x03\xaf\x6e\x31\x1c\x0c\xaa\x82\xfe\x1c\xae\x1a\xd3\xf2\xfd\x73\x15\x5c\x07\xc3\xc1\xa8\x06\xc9\xd6\xfe\x79\xed\xad\x55\xcf\x4a\x53\x6f\xa1\x33\x59\x6b\x16\x4a\xef\xea\x1b\xb6\x1d\x76\xc4\x7d\x3a\x1b\xba\x14\xdb\xdb\x48\xf1\x60\xc2\x4e\x7c\xa6\xd4\xab\x4b\x91\x12\x5d\x48\x85\xc4\xd4\xab\xdf\x31\x19\x8f\x8a\xb7\xc3\x70\xed\x14\x7a\x8a\x87\x99\x2b\xdf\x6f\xb1\x3a\xb8\x0e\x36\xda\x3a\xe1\xba\x4f\xfd\xb4\x3b\xc0\x66\x41\xc1\x45\xa6\x38\xe3\x24\xc1\x96\xe5\xfa\xc4\x1e\x01\x63\x75\x62\x1f\x18\x7c\x46\x79\x5a\x32\x3e\x39\x17\x4f\x7d\xeb\xb9\xcf\x7b\x3a\xbe\x8d\x74\x7c\xf4\x19\xd0\x7c\xa6\xbe\x1f\x7e\xde\x0f\x3f\xff\xf1\xe1\xe7\x7a\xf9\xc7\xf2\xd3\xcd\xff\xfd\xf0\xe3\x22\xda\x92\xd9\x7d\xbd\x53\x2d\x8c\xa7\x17\xca\x57\x29\x92\x2f\x5b\x20\x5f\xa3\x38\xbe\x7c\x61\x7c\xd6\xa7\xf0\x9b\x29\x83\xc7\x41\x5f\xdb\xa7\x56\x84\x97\x2b\xc6\x99\x86\x49\x00\x0f\x79\xdf\xb1\x7e\x9b\x66\x2f\xde\xca\x9e\xd6\x5f\x8c\x2d\x31\x95\x91\x83\xd2\x42\x62\x21\x56\xff\xa1\x21\xdc\x9f\x26\x22\x56\xdd\x77\x6e\x8c\x6b\x94\x6b\x42\x11\x56\x15\xb6\x43\xba\xab\x54\xde\xbb\x15\x40\x13\x07\x33\x84\xaa\xff\x8c\xec\xf4\xb6\x95\x0a\x3f\x58\x80\x9f\x83\x4e\xdb\x5b\xa2\x1c\xdb\x01\x84\xf0\x9b\x10\xb1\xcb\x50\xf7\xb5\x61\xe6\x77\xd8\x11\x45\x13\x52\x61\xbc\x0e\x60\x4f\x24\x3c\x25\x82\x5f\x5a\x59\xff\x92\xf4\x0c\x5a\x03\x95\xe5\x19\xdd\x22\xfd\x66\xd9\x2a\x73\x3a\xbb\x48\xad\x00\xdb\xfb\xbb\x21\x60\xe6\xb8\xb7\x28\xd7\x45\xa6\x9b\x11\x48\x62\x89\x24\x3a\x80\x42\xdd\xb7\x36\x5a\xc6\x1c\xf7\x7a\x4a\x85\x25\x6d\xcf\xf0\xde\x32\x67\x97\x72\x6d\xf2\x87\xce\x46\x83\x1e\x89\x7a\x27\x79\xd3\xa9\xc1\x7e\xf8\x93\x70\x10\x46\x5e\x5d\xba\x56\xfc\xa4\x39\xb5\x6d\xde\xea\xda\xc5\x5b\xd7\x0a\x30\xee\x52\x89\x44\x63\x95\xf4\xd6\xe3\x5f\xbd\x8b\x20\x77\xfa\xec\x63\xae\x04\xae\x96\x8b\x51\x96\xa7\x51\xcd\x53\xdf\x9f\x04\x58\xc0\x3c\xb5\xad\x39\x5f\x6b\xf7\x4f\x67\x55\xc4\x3c\x06\x9c\x1f\x09\xc6\x82\xca\x9a\xfd\x26\xbc\xb6\xca\xdf\x25\xd6\x91\xac\xd9\x34\xe3\x51\x1c\x86\x5f\x4d\x9f\x39\x28\x66\x15\x15\xa0\x49\xe0\xc4\xb9\xc5\xb1\x08\x84\x52\xb1\xe3\x7a\x96\x5b\x9d\x29\xb2\xc7\xc9\xd9\x47\xab\x3f\x05\x2d\x42\xbf\x23\xc5\x14\x1c\xff\x0d\x00\x00\xff\xff\xd5\x0e\x7f\xa2\x21\x1f\x00\x00"
+var _utilityNftcatalogadminCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xec\x59\x5f\x6f\xdb\x36\x10\x7f\xd7\xa7\xb8\xfa\x61\xb0\x00\xd7\x7e\x19\xf6\x20\x34\xeb\xb2\xc6\x03\x3c\x60\x9d\x91\xfe\xd9\xe3\x70\x91\xce\x36\x57\x89\x12\x48\xda\xa9\x11\xf8\xbb\x0f\x94\x45\x89\x92\x49\x59\x4e\x53\xa0\x01\x12\xe7\x41\x22\xef\xee\x77\x77\x3c\xfe\x74\x12\x59\x56\xe4\x42\xc1\xe8\xfd\x1f\x1f\xdf\xa1\xc2\x34\x5f\x8f\x82\x60\x36\x83\xe6\xfe\x3a\xc9\x18\x0f\x66\x33\x3d\x7a\xcd\x01\xf5\x2d\xc4\x39\x57\x02\x63\x05\x6a\x83\x0a\x12\x5a\x31\x4e\x12\x90\x03\x40\x25\x21\x48\xe6\x5b\x11\x13\x20\x4f\xb4\x2a\x42\x21\xf2\xaf\xfb\x66\x5c\xe5\x20\x28\x26\xb6\x23\x40\x88\xb1\xc0\x3b\x96\x32\xb5\x3f\x5a\x4c\x49\x49\xd8\xe7\x5b\xc8\xf0\x0b\x41\xbc\x41\xbe\x26\x09\x2a\x07\xb5\x21\xed\x1b\x54\xce\x95\x96\x79\x02\x19\x72\x5c\x93\x86\x28\x72\x89\xa9\x0c\x02\x8c\x63\x92\x72\x8c\x69\x1a\x36\xde\x76\xa2\x82\x87\x20\x28\x3d\xb6\x64\x89\x2b\xa6\x52\xca\x88\x2b\x03\x72\x1d\x2b\x96\x73\x79\x94\xd5\x59\x48\x92\x65\x05\x74\x1d\xc7\x54\x28\x4a\xcc\xd4\x3c\x63\x4a\x51\x02\xf7\x1b\xe2\x80\xb5\x3f\xda\x73\x4c\x12\x40\xe0\x74\x0f\xf1\xd1\x2c\x30\x45\x19\x6c\x50\xc2\x1d\x69\xe9\xa2\x10\xf9\x8e\x12\xb8\xdb\xeb\x44\x96\x59\x3c\xf5\x6e\xa7\xfd\x72\x38\x30\x2e\x45\xf5\xff\x11\x93\x44\xa4\xfd\x14\x24\xe5\xa4\x9e\x8a\xf3\x34\xa5\x32\x98\x45\xa2\xe3\x5c\x31\x12\x10\xc1\x07\x25\x18\x5f\xdb\x62\xc7\xc5\x7d\x8f\x19\xf5\x4c\x57\xe6\xc1\x01\x94\x30\x59\xa4\xb8\x6f\x19\x28\x27\xc3\x3a\x89\x9f\x8a\x04\x15\x3d\x22\x8f\xdb\x52\x11\xf0\xdb\xd3\xe8\x76\xe1\xd9\x65\xd2\x04\x70\x4b\xff\x51\x3c\xbc\x16\x73\x71\x2e\x95\xa2\x32\x38\xf5\xe4\xaf\x0b\xfc\xbc\x32\x67\xc7\x93\x92\xde\x54\x19\xe3\x4b\xc1\x76\xba\x2c\x51\x6d\x22\xb0\x6e\xfc\x1a\x1f\x54\x2e\x70\x5d\x69\x58\x37\xbd\x20\xf9\xd7\xfd\x72\x7b\x97\xb2\xb8\x02\xaa\xaf\xcf\x28\xf5\x83\x95\xd4\x64\xca\xdd\xdc\xb4\x08\xb7\xa2\x49\x9b\x40\xbb\x80\xb5\x78\x8b\x21\x2d\xa9\x36\x29\x86\xb0\xda\xea\x5d\x96\x54\xc3\x73\xae\xc4\x7e\xec\x5a\xe4\x7a\x11\x21\x23\x85\x09\x2a\x84\xc8\x22\xe4\x69\x73\xf9\x57\x35\x1f\xc2\x43\x0d\xae\xff\x2d\xe1\x61\x80\xae\xd1\x16\xbc\xb9\x0c\x6b\x9c\xc3\xa0\x78\x8f\x1b\xe7\xac\x07\x75\xdd\x41\x0b\xd5\x8a\xe3\xa2\xa0\x07\xa2\x7e\xc7\xb8\x05\x65\xf9\xee\x82\xb8\x7b\x82\x19\x6e\xca\x35\xfc\xed\x8e\x7f\xe2\x12\x57\xd4\xef\xfe\x04\xf8\x4a\x7d\xdc\x17\xd4\xcc\x3d\x2a\xb2\x7e\x2c\xd7\xb0\x13\xf9\x64\xe8\xd2\x2c\x54\xcf\xc6\x6a\xca\xd0\xf7\xd8\x3c\x19\x16\x37\x10\xc1\xa7\x05\x57\xbf\xfc\xdc\x0d\xaf\x10\xd4\x19\xe9\x04\xbd\x26\xd5\x31\x5b\x46\xde\xb6\xdd\xdc\x84\xf0\xea\x0a\x38\x4b\x21\x82\xd1\x82\xef\x30\x65\x09\x18\x45\x58\xdc\x8c\x9e\x12\xea\xd5\x54\x2a\x54\x5b\x09\x57\x57\x30\x5a\xbc\xff\xf7\x76\xfe\x79\x31\xff\x67\xe4\x82\x6e\xe3\x1e\x5a\x77\x9a\xbe\x63\x07\x2e\x5c\x7d\x93\x73\x27\x18\x9c\xee\xdf\x9d\x85\x69\x2e\x8d\x90\xb7\xba\x1c\xb6\xa6\x67\x09\xc2\x15\xe8\xd4\xcc\x6b\x49\x29\x75\xbf\xed\x15\x2c\xa7\x27\x70\x4c\x7c\x04\xa3\xeb\xe5\xf2\xf6\xef\xcf\xf3\x9b\xd1\xa4\x0a\x9e\x84\x47\xd7\x4c\x87\x81\x67\xf9\x5b\x2c\x68\x74\x7d\xf9\x35\x70\x98\x1a\x7e\x85\xc8\x97\xe1\x30\x68\x41\xb2\x95\x7b\x5d\x7b\xb9\xca\xa9\x31\xb0\x12\x9c\xab\x12\xea\xaa\xd5\x1b\xa5\x77\xf7\x0d\x7b\x1c\x7a\xe2\x3e\x5f\x0d\x3e\x45\x23\xd2\x5e\x2b\xfd\xa3\x8c\x9d\x79\x4d\xb1\x7f\x4d\x49\xf8\x90\x8c\xc4\x24\xe8\xa8\x56\xdd\xe0\x69\x00\x70\x61\xbc\x1e\xc3\xad\x2e\xf4\x5c\x1e\xa6\xb6\x7c\xbf\xc5\xa6\x71\x1d\x6c\xf4\xa4\xc3\xb5\x7f\xed\x6e\x77\x80\x4d\x93\x82\x9b\xa3\xe2\x94\x63\x46\x27\x96\xdb\x0b\x7b\x00\x4a\xe5\x99\xe7\xc0\xe0\x1e\xc5\xe7\x62\x7f\x31\x3e\xba\x16\xcf\xbd\xeb\xbd\x94\xe3\xf3\x2b\xc7\x0b\xbb\x9f\xe3\xeb\x6c\xef\x83\xe3\xa5\xf9\x79\x69\x7e\x9e\xbe\xf9\xb9\x9d\xff\x39\x7f\xf7\xf1\x47\x6f\x7e\x4e\x28\xd3\xff\x79\xc7\xfc\x35\xd1\x5c\x4e\x94\x9e\x15\xf3\x19\x72\x2e\x5a\xf0\x7d\x09\xb2\xc3\x74\x4f\x42\x8e\x4f\x4f\x8c\xe1\xc5\x34\x68\xbd\x9e\xba\x4b\xe8\x07\xa4\xc1\x43\xe0\x01\x1d\x10\x8e\x05\xe9\xca\x15\xe3\x4c\xc1\x38\x84\x87\x6a\xec\x10\xb4\x3e\xa0\x2d\xf5\xb1\x45\x3d\x72\x72\x8a\xa1\x4f\x2b\x62\xe4\x20\x55\x2e\xc8\x88\xb5\x0f\x34\x72\xfb\xd0\x24\x4f\xa5\xff\x9b\x1b\xe3\x8a\xc4\x0a\x63\x82\x45\x83\x0d\x0f\xdd\x65\x2d\x95\xea\xef\x6e\x06\x68\x6c\x61\x46\xd0\x8c\xbf\xc1\xad\xda\x9c\x94\xc2\x4f\x25\xc0\xaf\xa1\xd7\xf6\x06\xa5\x65\x3b\x84\x08\x7e\xcf\xf3\xd4\xce\x90\x33\x04\xcb\xef\xc8\x13\x45\x17\x52\x52\xba\x0a\x61\x87\x02\x1e\x13\xc1\xdb\xc0\x1b\xc2\x13\xa4\x67\xd0\x1e\x68\x2c\x4f\xe3\x0d\xc5\x5f\xc6\xa1\x5d\xd3\xa5\x25\x0b\xb0\x5d\xd8\xfa\xa7\x13\x30\xb5\xdc\xab\x5e\xf9\xf4\x7b\x73\xa9\x5b\x96\x20\x60\x2a\x08\x93\x3d\x48\x52\x7d\x7b\xe3\xc4\x98\x95\xd5\x5a\xf0\xe0\x4e\xda\x8e\xd1\x7d\xd9\x2b\x95\x6d\x86\xd1\x2a\xc3\x19\x98\xb0\xb7\x9d\xf4\x08\x52\x5b\xc1\xbb\x4e\x0d\xf6\xc3\x5d\x84\x83\x30\x2a\x76\x71\x41\xe9\x1d\x3f\xee\xd2\x5b\x57\xbd\xab\x6d\xae\x7c\x3b\x40\xa7\x2d\x16\x84\x8a\x9a\xa2\x2f\x33\xf7\x9b\x73\x13\x54\x4e\xbf\x79\x5d\x29\x59\x64\x33\x0e\x6d\x8c\x9a\x9e\x6a\xd5\xd2\x53\xd7\x49\x02\x5c\xc1\xac\x28\xcf\x12\x66\x7c\x65\xb8\xb8\x11\xf4\x19\xb0\x0e\x12\xb4\x05\xcd\x64\xb8\xa6\x7e\x13\x4e\x5b\xf5\x71\x89\x36\x53\x1c\x4f\x4f\xba\x66\x1c\x8a\xc3\xf0\x9b\xe5\xd3\x5f\xe2\x8e\x8c\x0a\xd0\x4d\xe0\xd8\xfa\x8a\x53\xe6\x09\xe3\x38\xdf\x72\x35\xad\xac\x4e\x25\xee\x68\xfc\xe6\x75\xa9\x3f\x01\x95\x47\x6e\x47\xc2\x00\x00\xe0\x10\x1c\xfe\x1f\x00\xd5\x0e\x7f\xa2\x21\x1f\x00\x00"
 
 func utilityNftcatalogadminCdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -197,7 +199,47 @@ func utilityNftcatalogadminCdc() (*asset, error) {
 	return a, nil
 }
 
-var _utilityTestMaliciousstorefrontv1Cdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\x5d\x6b\xe3\x3c\x16\xbe\xcf\xaf\x38\xcd\x45\x71\xa0\x4d\x58\x58\xf6\xc2\x24\xed\xbc\xb4\x14\xca\xee\x5b\xca\x4c\xa6\x7b\x51\xca\xa0\xca\xc7\x89\xa8\x2c\x19\x49\x4e\x26\x94\xfc\xf7\x45\xb2\xfc\x2d\xa7\xdd\xdd\x97\x99\x5e\x34\xb6\xa4\x73\xf4\x9c\x0f\x9d\xf3\xc8\x2c\xcb\xa5\x32\x30\x7d\xb8\x5b\x7f\x33\x52\x61\xaa\xa4\x30\xd3\x49\x3d\x2c\xc5\x5d\x21\x36\xec\x95\xe3\x5a\xbe\xa1\x68\x66\x3e\x33\xfc\x27\x1a\x92\x10\x43\x9e\x18\xee\xf5\x74\x32\x59\x2c\x16\xb0\xde\x12\xf1\xa6\xc1\x48\xf8\xa3\xd0\x86\x09\xf8\x27\x67\x02\xe1\x12\xb6\xc6\xe4\x3a\x5e\x2c\xcc\x9e\x19\x83\x6a\x4e\x65\xb6\x20\x6e\xc9\x8f\x94\xcb\xbd\x39\x38\xf1\x54\x2a\x48\x98\xa6\x72\x87\x8a\x89\x0d\x10\x91\x80\x42\xbb\xb7\x7d\x33\x5b\x84\x5d\xc1\x05\x2a\xf2\xca\x38\x33\x07\x30\x5b\x62\xc0\x6c\x99\x06\x2a\x85\x51\x84\x1a\x30\xa8\x8d\xb6\xca\x3c\x1e\xa6\x81\x69\x20\x6e\xbc\xb5\xca\x0a\xb2\x2c\xe7\x98\xa1\x30\x76\x3e\x23\x9c\x51\x26\x0b\x0d\xba\xf6\x94\x53\x61\x24\x18\x75\x00\x22\x40\x23\xe7\xf6\xf7\xe1\x6e\x0d\x7b\x66\xb6\x40\x20\x61\x69\x8a\x0a\x85\x81\xfb\x5b\x60\xc2\x41\xcc\x39\xa1\xe8\x44\x65\xda\x59\xc2\x99\xb5\x77\xd3\x02\x87\x0a\x5b\xe8\x98\x53\xdd\x04\xea\xe9\x6f\x3f\xec\xf8\x9c\x26\xd4\x5b\x6a\x6d\xf3\xf6\x12\x8d\x93\x09\xa1\x14\xb5\x8e\x08\xe7\xb3\xc6\xb6\x3f\x2b\x4b\xda\x9a\xe0\x7d\x02\x00\xd0\x16\xe0\x68\xa0\x59\x62\x9f\xc8\x06\x1f\x89\xd9\xc6\xd0\x7a\xf9\x40\xec\xb1\x78\xe5\x8c\x96\x52\xcd\xf3\x64\x20\xa5\x50\xcb\x42\x51\x6c\x89\xc6\x5d\x6b\xe7\x7d\xa5\x1e\x72\x4b\x93\x46\x9e\x96\x00\x9a\x18\xdd\x90\x3c\x86\x1b\x92\xfb\x94\x58\x92\xc2\x6c\xa3\xae\xe2\x1b\x85\xc4\xe0\xbf\x4a\xef\x5f\xf4\x76\xfd\x8a\x99\xdc\x55\x93\x33\x38\x1f\xc3\x74\x35\x0e\xc6\xc7\x55\xc7\xf0\xe5\xfd\xfb\xbd\x30\xff\xf8\x7b\x0c\x5e\xe1\x71\x32\xe9\xcb\x39\x77\xec\x18\xee\x21\x2d\x04\x6c\xd0\xf8\xa5\xf7\xb7\x3a\x9a\xc5\xf0\x5c\x6a\x78\x69\x59\x6f\xff\x14\x9a\x42\xb9\x14\x4c\xe7\x1d\xe3\xe7\xaf\x52\x29\xb9\x8f\x66\x67\xf3\x9e\xae\x5a\xfe\xf8\x01\x86\x52\x83\x17\x8d\xbc\x35\x5f\x7d\xc0\xee\x6f\x63\x28\x21\xcd\x62\x38\x7f\xef\xba\xc7\xcb\x94\xf1\x3a\x5e\x87\x31\x9f\x3b\xd0\x95\x93\x9e\x07\xfa\x5f\x3e\x02\x6a\x31\x52\x8e\x44\x14\xf9\x09\x74\xc1\xcd\x4f\xa8\x3e\x91\x23\x7e\xcb\xf6\x50\xd4\x51\x2e\x52\xf3\xa8\xe4\x8e\x25\xa8\x9a\xd4\x0b\xa4\x61\xaf\xbe\xce\xff\xcd\xcc\x36\x51\x64\x3f\xb3\x9e\xec\x4f\xde\x48\xce\x91\x1a\x26\xc5\xf1\xea\xa2\xbf\xdd\xfa\x90\x63\x0c\xf6\xff\x60\xaa\xf1\x41\x77\xaa\xae\x68\x0f\xa9\xb9\x4f\xc2\x6b\x34\xe1\xf8\x48\x0e\xb6\x08\x3e\x91\x82\x8f\x6e\x63\xd7\xdd\x14\x46\xc7\xf0\xdc\x3b\x20\xe5\xc4\x4b\x7f\x6b\xf5\x86\xc6\x15\x42\xdd\x76\xd0\x73\xcb\x43\xe7\xef\x5d\xf3\xbf\x22\x45\xb6\x43\x75\xbc\x7a\xb9\xee\x6a\xa3\x85\x36\x32\xb3\x66\x7e\x33\xb6\x2d\xf4\xa7\x65\x96\x31\xad\x99\x14\x7f\x64\xb2\xb0\x75\xe5\xfb\x1d\xfb\xd9\xb7\x14\x7f\xe6\x4c\x1d\x2a\x2f\xd4\x53\xb3\x6a\xa4\x97\x3e\xdd\x22\x03\xab\x93\x27\x6f\x20\xe9\xb3\xf4\x3e\xb1\x82\x8d\xb3\x4e\x64\xd4\x89\xac\x0a\x0e\x5f\x84\xa4\xcb\xe8\xf9\x87\xe0\x0a\xeb\x44\xf7\x33\x9c\x0d\xa7\x42\x68\x34\x2c\x5b\xa6\x47\xf5\xd4\x59\x32\x9b\x0c\x3c\x54\x27\xa7\x77\x15\x2c\x2f\xfd\x81\x83\x51\xff\xf4\x8a\xfe\x30\x22\x43\x60\x81\x72\x51\xc7\x26\xec\xa0\x24\xee\x1d\x9c\xe1\xb2\xdc\x47\x63\x24\x34\xa7\x4c\x4f\x50\x1b\x25\x0f\xd0\x29\x89\x73\x26\x34\x2a\x13\xbd\xe1\xa1\x0d\x0f\x96\x97\x7d\x2f\xf5\xb4\xf9\x02\x5b\x8b\x84\xaa\x1d\x13\xcc\x44\xbf\xa1\x5d\xf6\xeb\xf1\x30\x5a\x9d\xb3\x71\x43\xf2\xe1\xf2\xca\x41\x36\x39\xde\x8f\x2d\xeb\x5a\x36\x06\x49\x86\xc7\xd7\x67\x18\x9d\x76\xf5\xbf\xd1\x8b\x13\xfc\xa0\x56\x67\x49\xa3\xe3\x9d\x09\x64\x05\xdd\x42\x46\x0c\xdd\x3a\x6a\xc8\x12\xcb\x09\xed\x53\x95\xf6\xaf\x68\xff\xb3\x2c\x47\xa5\xa5\x20\x06\x93\x0f\x79\xc6\xb0\xf7\x05\xb6\xd6\xbd\xfd\x44\x6a\x60\x8f\x40\x14\xfa\xac\x71\xdb\x0a\x6d\x90\xd4\x6b\xa4\xc0\x92\x67\x12\x28\x34\x2a\xab\xc9\x92\x79\xa6\x3d\xca\xbc\x50\x74\x4b\x34\x26\xf3\x71\x8c\xa2\xdd\x6b\x06\x3d\xb7\xe2\xa8\xe5\xda\xe6\x1c\xfd\x7f\x8d\xb3\x3a\x82\x17\x70\xa2\xa7\x7a\x8e\x72\x35\x4e\x31\xca\x72\xfe\x70\xb7\x8e\x4a\xa6\xd3\xd7\xf5\x70\xb7\x1e\xa3\x38\x27\x69\xd9\x87\xf4\xaa\x9d\xeb\xcd\x78\x2d\xe9\x10\x7d\x9a\xcc\x6d\xd0\xdc\xa2\x21\x8c\x3b\x36\x19\xcc\x7f\x3f\xff\x4b\x4d\x69\xc3\x0a\xd9\xb2\x58\xd4\xe9\x05\x7b\xc6\x79\x05\xc7\xe6\xe5\x74\xaf\xa4\xd8\x4c\x6d\x6e\x8d\x06\xaf\x12\xee\x76\x8d\xbc\xec\x5c\x96\x98\x77\x63\xe9\x7a\xd9\xb1\xcd\x02\xbe\x84\x03\x1e\xa0\x05\x89\x77\x9f\xe7\x04\x41\xcb\x1c\x44\xed\xaa\xba\xc7\x30\x7f\x25\x9c\x08\x8a\xb0\x5a\x55\x1a\xe6\xae\xb9\x2a\x46\xf1\x02\x32\xd4\x9a\x6c\x30\x86\x29\x13\x54\x2a\x85\xd4\x54\xe8\x81\x38\x5a\x33\x3d\xa9\x7d\x83\xae\x35\x47\xb3\x81\xfe\x7e\xf3\x3e\xb9\x95\xb1\x86\x83\x39\xe4\x38\x0d\x34\xed\xb4\x54\x73\x4b\x0c\x81\x55\x25\x33\xb7\x55\x97\xef\xf0\x89\xe1\x3e\xb2\x3b\x2c\xc7\xbf\x12\xcc\xef\xd6\xb5\x86\xab\x68\x36\x3b\x03\xa2\xcf\xe0\x73\xeb\x3b\x68\x58\xea\x00\xed\xec\x24\xac\xc2\x57\xde\x39\xa1\xd4\x3a\xce\x65\x33\xd9\xa0\xcf\xde\x01\xf1\x2c\x73\xe1\x2a\x4a\x95\xcc\xe2\xb6\x8d\x95\xa0\xbd\xd1\xf6\xdb\x99\xfd\x73\xbb\xcf\x13\xcc\xa5\x66\xc6\x8b\x2f\x2f\x2b\xbf\x74\xc3\x75\x04\xe4\x1a\x03\x4a\x3e\x07\x5d\x93\x1d\x46\xcb\x4b\xaf\xfa\x02\x8c\x1c\x47\xda\xdd\x77\x18\x45\xdb\x0a\x96\x97\x65\xf2\x56\x25\xb8\x75\xc6\xf7\xbe\xd4\x46\xd5\x43\x7d\xae\x5d\x6d\x9f\x85\x8a\xc6\xf2\xb2\x73\x38\xfb\xf4\xa3\xdb\xd9\x7f\x3d\x17\xe9\x52\xb8\xd1\x36\x3a\xbc\x59\x8d\xdc\x9a\x7e\x4f\xe3\xaa\x76\xef\xa7\x62\xae\x42\x79\x55\x47\x96\x6e\x91\xbe\xd9\x5e\x30\x65\x62\x47\x38\x4b\xea\x29\xa0\x35\xfa\xe9\x69\xb2\x1d\x50\xd2\xba\x1c\x51\x92\x4f\x3f\x4a\xba\x8a\xef\xf4\x38\xdf\x7f\xd3\x5b\x86\x6d\x05\xae\xaf\x21\x27\x82\xd1\x68\x9a\x12\xc6\x31\x01\x23\x7d\x1b\xb7\x7d\xb0\x43\xab\x2a\xf1\x5e\x19\xed\x16\x74\xbf\xa6\x5b\xd3\xff\x2a\x1a\xdb\x00\x6f\x76\x6a\xc6\x86\x62\x2e\x01\x61\x55\x26\xe2\x70\xba\x8e\xe2\xaa\x0e\xe8\x24\xd8\x21\x86\x47\xbc\xe1\x15\xad\x73\x0d\x67\x2b\x10\x8c\xb7\xfb\x03\x95\x05\x4f\x40\x48\x53\x39\xd5\x5d\x1d\xc3\x8d\xa8\xea\x39\x6e\x89\xd5\xd5\xa8\x6e\xab\xcc\x0a\x6d\x9c\xc6\x56\x7f\xd7\x24\x73\x54\x95\x94\xa4\x55\x2a\xb6\x61\x82\xf0\x40\xc4\x46\x99\x7f\xf3\xbd\xa6\x39\xf6\xbf\xe5\xd6\x13\xc3\x97\xe6\xb5\x75\x2c\x9b\x42\xe9\x6f\xb9\xe3\x38\x3b\xaf\xb3\xb6\xc5\xae\x98\xb6\x8f\xbf\xf3\x71\xf0\x1b\x2e\xac\x60\xe1\x9b\xc2\xa2\xf7\x51\xb9\xee\x38\x63\x6a\x9a\xef\xb8\x56\x4b\xee\xde\xba\x4a\x3c\xa8\xe3\x7f\x02\x00\x00\xff\xff\x26\xf3\x3b\x67\x60\x18\x00\x00"
+var _utilityTestHybridcustodyCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x56\x5d\x6b\xe4\x36\x14\x7d\xf7\xaf\x38\xec\xc3\x62\xc3\xac\xe7\xa5\xf4\xc1\x24\xcd\x2e\x09\xa5\xf3\x50\x58\xd8\xf4\xa9\x94\xa2\xb1\xaf\x63\xb1\x8a\x34\x48\xd7\x1b\xcc\xe0\xff\x5e\x24\x6b\x6c\x79\x26\x4d\x76\xfb\x49\x1c\xb0\xec\x7b\x8f\xce\xb9\xe7\xea\x7a\xb6\xdb\x2d\xee\x3b\xe9\x20\x1d\x04\x98\x1c\xa3\x36\x9a\xad\xa8\x19\x8e\x85\x6e\xa4\x7e\x80\xd4\x68\x8d\x05\x77\x84\x9f\x86\xbd\x95\xcd\x6d\xef\xd8\x34\xc3\x1c\xba\xc9\x3c\xce\x53\x27\xeb\xce\x03\x69\xc3\x68\xe8\xa0\xcc\x40\x0d\xd8\x84\x44\x7a\xec\x95\x60\x63\x4b\x1f\xea\xff\xb1\x63\x18\xad\x06\x74\xc2\x85\x88\x83\xb0\xec\x60\xda\x67\xf6\x91\x9a\xc9\xb6\xa2\xa6\xf0\xae\x0b\xef\x50\x4f\x2f\x03\x16\x5b\xa1\x9d\xa8\x59\x1a\x1d\x21\xa4\x83\xa5\x83\x71\x92\x8d\x1d\xd0\x3b\xaa\x20\x70\x10\x96\x34\x43\xd4\xb5\xe9\x35\x63\x6f\xac\x35\x4f\x5e\xb8\x92\xfa\x33\x35\x01\xaa\xee\xa4\x6a\xe6\x90\xd6\x9a\x47\x48\x76\xf8\x59\x68\xf1\x40\x76\x03\xa1\x1b\x3c\x10\x4f\x9c\x6b\x71\x10\x7b\xa9\x24\x4b\x8a\x0f\x7c\x76\xc0\x39\x21\x48\xe7\x7a\x6a\xb0\x8f\xd5\x32\x4a\x91\xc5\xee\xae\xc4\x7d\x9a\x3f\xa0\x95\x8a\xc9\xba\x80\xdf\x8a\x9a\x8d\x95\xe4\x02\x92\x69\xcf\xca\x21\x2c\x41\x51\xcb\x30\x3d\x97\x59\x26\xea\x9a\x9c\xcb\x85\x52\xc5\x62\xde\x3a\xe3\x98\x01\x40\x1a\x48\x9a\x25\x2b\x7a\xf4\xf5\x98\xb4\xbd\x18\x72\x1b\x74\x5d\x84\x28\x3a\x65\xdb\x4f\x6c\xac\x78\xa0\x8f\x82\xbb\x0a\xc9\xe2\x32\xc9\x92\x33\xbd\xad\x29\xb1\xf5\xc3\x54\xac\x8f\x56\x7e\x11\x4c\x91\x6e\x92\x17\xb6\x2f\xf0\x45\xd2\x13\xda\x5e\x7b\x03\x6e\xe7\x6a\xee\xee\x7e\x34\xf6\x7e\x38\x50\xce\xc3\x81\x2a\xf8\xdb\x8d\xef\xd8\x0b\x2e\x45\x85\x5f\x76\x9a\xbf\xff\xee\xe6\x6b\x36\x98\xbd\xc9\x17\xe7\x76\x77\x27\x88\x0d\x96\xdd\x8a\x0a\x4b\xf4\x84\x3d\xbe\xa0\x3b\x6c\x16\x25\x57\xaf\x6a\x77\xa4\xda\xa9\xd2\xb1\xa5\xd2\xcd\xae\x44\xcf\x5d\x3e\xaf\x25\xb9\x02\x6f\x23\xe2\x0f\xd9\xbf\x5e\xc6\x84\xad\xbf\x12\x92\xb8\x86\x27\x5e\xc6\x65\x39\x9d\xb5\xbc\xc0\xcd\x0d\x0e\x42\xcb\x3a\x7f\x73\xbb\x3a\x69\x71\x6e\x4c\x4c\xe5\x5e\xd1\x9b\x62\x85\xed\x27\x50\x72\x82\xa4\x3e\x65\x96\xe9\x21\x2c\xdd\x44\xb3\x5c\x49\x73\xf9\x2c\x23\xde\x14\x67\xcc\xfd\x25\xdb\x64\x83\x48\xd8\x17\xa1\x94\xee\x53\xbf\xf7\x45\xc9\x4d\x5b\x05\xdb\x9f\x4b\xf7\x7f\x96\xb8\xb7\x3a\x85\x59\x4e\xf8\xee\xee\x22\x65\xcc\xfe\x7c\x15\xa1\xb4\x54\xf3\xe3\xf1\xab\x0c\xfd\xab\x6d\xfb\x1f\x7a\x29\xdb\x00\xbf\xf0\xc3\xf5\x37\xb8\x99\xef\x87\x85\xb6\x57\x96\xea\xfc\x1f\x8c\xfd\x07\x6d\x95\x5a\x72\xfe\xad\xc7\xfc\x9c\x73\xea\xd5\x52\xd8\x39\x64\x7c\x75\x3e\xc5\x89\x8e\xe3\x79\xbb\x2d\xa3\x28\x7c\x25\x2d\xe9\x0a\xef\x8f\x1f\x9a\xc6\x92\x73\xd5\x6a\xae\x8d\xd9\x79\xf2\x84\x5a\x84\xc1\x23\x9a\x53\x5c\xfe\x3b\xfe\xae\xde\x99\x0f\xae\xde\xa1\xb6\x24\x78\x3d\x62\x97\x82\xc6\x9b\x75\x33\x36\xe4\xd8\x9a\x61\x6a\xf1\x93\xae\x52\x6a\x47\x96\xf3\xcf\x34\xcc\x69\xa5\x98\x84\x6e\x70\xf5\x2e\xc4\x2d\x38\x2f\xab\x9d\x3a\x6e\x66\xd3\x34\xb6\x42\x2c\x5a\x51\x61\xb2\x36\xe0\xe1\xed\x71\xfd\x35\x18\xcf\x4f\x65\x6c\x9f\xb7\x2b\xb2\xbf\x7a\x62\xbf\x3d\x47\x26\xf4\xd3\xb3\xfd\x71\xca\xf5\x35\x3b\x8e\xaf\x37\x87\xd7\x31\xd5\x36\x76\x47\x5e\x54\x78\x7f\xd9\x29\x91\xe0\xe2\xc4\x1c\x9e\x22\x5f\xf0\x0a\x9c\x62\x68\xf2\xa5\xc1\x35\xb6\x71\x06\x6c\xbb\xf4\x17\x4d\x0c\xcd\x00\x60\xcc\xc6\xec\x8f\x01\x00\x08\x0f\x6e\xdf\xc8\x0a\x00\x00"
+
+func utilityTestHybridcustodyCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_utilityTestHybridcustodyCdc,
+		"utility/test/HybridCustody.cdc",
+	)
+}
+
+func utilityTestHybridcustodyCdc() (*asset, error) {
+	bytes, err := utilityTestHybridcustodyCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "utility/test/HybridCustody.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7e, 0x0, 0x8b, 0x3a, 0xcf, 0x85, 0x86, 0x94, 0x43, 0xf9, 0x1a, 0x23, 0x96, 0xf4, 0xcb, 0x27, 0x2d, 0x83, 0x80, 0xe0, 0x9, 0x81, 0xce, 0x89, 0x88, 0xf5, 0xb0, 0x8d, 0x68, 0x25, 0xda, 0x71}}
+	return a, nil
+}
+
+var _utilityTestMaliciouscollectionCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x56\x41\x6b\xeb\x46\x10\xbe\xeb\x57\x0c\x39\xbc\x4a\x90\xd8\x97\xd2\x83\x70\x9a\xb4\x75\x0d\x86\x3e\x5f\xe2\xb6\x87\x10\xc2\x46\x1a\xcb\x43\x56\xbb\x62\x77\x64\x23\x8c\xff\x7b\x99\x75\x22\xe9\x29\x72\x9e\x7b\x78\x10\x88\x64\xcd\x7c\xdf\x37\xdf\x8c\x66\x45\x65\x65\x1d\xc3\xd5\xca\x9a\x45\x6d\x0a\x7a\xd1\xb8\xb6\xaf\x68\xae\xa2\x68\x3a\x9d\xc2\x7a\x4b\x1e\xc8\x83\x02\x46\xcf\x90\x59\xc3\x4e\x65\x0c\xbc\x55\x0c\x54\x56\x1a\x4b\x34\x2c\xcf\x4b\xa5\x29\x23\x5b\x7b\xc8\xac\xd6\x98\x31\x59\x73\x0d\x5b\xab\x73\x32\x45\xc0\xca\x6c\x6d\x18\xdd\x06\x89\x61\xb5\x58\x7b\x28\xc9\x30\xe6\xb0\x27\xde\x82\x32\x0d\x2c\xe7\x13\x78\x60\xeb\x30\x07\x32\x50\x69\x95\x21\xd8\x0d\xf0\x16\x7b\x98\xa0\x02\x98\x26\xcf\x64\x0a\xd8\x2b\x0f\x99\x43\x25\x40\x1b\x67\xcb\x6b\x20\x86\x52\xbd\xa2\x0f\x79\x6f\x61\x3f\x79\xa8\x9c\xdd\x51\x8e\x2e\x48\x02\x65\x44\x02\xd8\x4d\x00\x53\xc6\xf2\x16\x1d\x70\x53\xa1\x94\x66\xda\x5c\xcc\xc1\x1a\xbc\x86\xda\x48\x6a\xef\xd7\xe5\x7c\x22\xa9\x6f\x26\xa1\xc3\x9e\x4b\x64\x40\xd3\xcb\xb4\xb0\x53\xb9\x9d\x7a\xb5\x41\x6e\x9e\xe5\x7a\x52\x58\xc1\xe7\xe0\xa6\x28\x24\x0f\x99\xf2\x18\x45\x2a\xcb\xd0\xfb\x58\x69\x9d\x74\x2e\x7f\x7d\xf7\xf4\x8f\xae\xfc\x43\x14\x01\x00\xf4\xe3\x1d\x7a\x5b\xbb\x0c\xa5\xa4\x14\x86\x9d\x9c\x48\xa1\x87\x90\x34\x4c\xd4\xc8\x40\x79\x0a\x7f\x2f\x0d\xff\xf2\x73\x34\x1a\xb3\x23\xdc\xc3\xa6\x36\x50\x20\xff\x43\xb8\xf7\x71\x92\xc2\xe3\xba\xa9\xf0\xa9\x87\x2a\x7f\x0e\xb9\x76\x06\x1e\x9f\xda\x5f\x8f\xe3\x90\x82\x26\x9a\xf5\x0e\x05\x31\x7e\x0e\x24\x29\x08\x68\x92\xc2\x6f\xa6\x79\x60\x57\x67\x7c\x37\x4e\x60\x48\x5f\xc2\x70\x9a\x8a\x3f\xcb\x8a\x9b\xce\x3e\x11\x7f\x7f\xf8\x60\x51\x17\x70\x1c\xe7\x9c\xdd\x8c\xf4\x62\x72\x86\x62\x4c\x1d\x19\xe2\xb8\xf3\x3a\x19\xd0\x78\xd4\x9b\x09\xe5\x70\x0b\x94\xb7\x0f\x8e\x51\x0f\x64\xb4\xe1\x1d\xeb\x48\xdf\xbb\x87\x70\x18\x75\x69\xa7\x1c\xd8\xbd\xc1\x7c\xb5\x58\x7b\xf1\xe5\xa4\x2d\x85\x8f\x06\xad\x16\xeb\xe3\x31\x1a\x47\xe9\x0d\xc8\x43\x5d\xc9\x3a\x09\x88\xd2\xcd\x30\x2c\x07\xb9\x4a\xe1\x77\x6b\xf5\x19\x77\x43\xc4\xec\x7e\xb5\x58\xff\x2a\x09\xec\x6a\x3c\xb6\x71\xdf\xa3\x25\x3f\x64\x8d\xe5\x3d\x6e\xc7\x49\x78\xc7\x69\x25\x0c\x6e\x6f\xa1\xcf\xfe\x09\xed\x07\x53\xfe\x25\xde\xe6\x4e\xed\x4f\xf3\xb6\x7f\xbb\x8b\xdf\x2f\x96\xf3\xb6\xdb\xa3\x43\x27\x9e\x8e\x0b\x9b\xdd\xc4\x61\x20\xda\xe6\x4c\x1c\x96\x76\x87\xf1\x2b\x36\x69\x4b\xb4\x9c\x27\x70\x77\x07\x95\x32\x94\xc5\x57\x5f\xc9\x7b\x32\x85\xec\x80\xab\xe4\xb3\x32\xda\x17\x24\xc7\xca\x7a\xe2\x98\x45\xcd\x59\x85\xc3\x49\xcd\xd1\xb3\xb3\x0d\x0c\x04\x92\xf1\xe8\xf8\x24\x30\x00\x4e\x28\xbf\x86\xd9\x4d\xb8\x4e\x2e\x6e\x66\x81\xbc\x9c\x87\xa9\x79\x3c\x59\x77\x66\xc9\x0c\xd8\x5f\xb1\xf1\xff\x87\xe3\x2f\x34\x05\x6f\x85\x66\x69\xf8\x22\x06\x1d\x32\x2e\xe6\x78\xb1\xce\xd9\xfd\x6a\xb1\x8e\x9f\x7b\x1b\x36\x49\xe1\xcb\xb8\xcb\x67\x56\xdd\x97\x6f\x55\x3c\x52\xfe\x04\xca\x9f\x05\xf9\x9e\xbc\x1f\xb0\x16\x4f\x70\xd0\x87\x1a\x53\x11\xd6\xdf\xe8\xd2\x6b\x8b\x83\xd9\x0d\x1c\xfa\xaf\x7d\x0f\x42\x4e\x59\xf9\x5a\x90\xa3\x4c\xfe\x7b\x50\xc3\xcf\x89\xf0\x5a\x84\x73\xbb\xa0\x1d\x1a\xf9\x98\xf8\xb0\x37\xa5\xfe\x37\x98\xfe\x2e\x4e\xe1\xfe\xdb\x33\x72\x58\xdd\x7b\x3c\xe5\xc9\xb9\x95\xfc\xb9\xb5\xdd\xed\x27\x2c\xfd\x9c\x08\x00\xe0\x18\x1d\xa3\xff\x06\x00\x5b\x41\xee\x9b\xa0\x09\x00\x00"
+
+func utilityTestMaliciouscollectionCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_utilityTestMaliciouscollectionCdc,
+		"utility/test/MaliciousCollection.cdc",
+	)
+}
+
+func utilityTestMaliciouscollectionCdc() (*asset, error) {
+	bytes, err := utilityTestMaliciouscollectionCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "utility/test/MaliciousCollection.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb0, 0x64, 0x17, 0x23, 0x41, 0xf8, 0xa4, 0x73, 0xb4, 0x4, 0x2d, 0x3a, 0x11, 0xd7, 0xfa, 0xf0, 0xec, 0x7e, 0xbf, 0x13, 0xb1, 0xc7, 0xa4, 0xed, 0x8a, 0xcb, 0xab, 0x1a, 0x2c, 0xd9, 0xdd, 0x1a}}
+	return a, nil
+}
+
+var _utilityTestMaliciousstorefrontv1Cdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x58\xdd\x6b\xe3\x3a\x16\x7f\xf7\x5f\x71\x26\x0f\x43\x0c\xad\xc3\x85\x65\x1f\x4c\xd2\xb9\x97\x96\x42\xd8\xbd\xa5\xcc\xe4\x76\x1f\x4a\xb9\xa8\xf6\x71\x22\x2a\x4b\x46\x92\x93\x1b\x4a\xfe\xf7\x45\xb2\xfc\x21\x5b\x49\x67\x19\xee\x0e\x13\xa6\xb2\xce\x87\x7e\xe7\x43\xe7\x1c\x9b\x96\x95\x90\x1a\x66\x0f\xf7\x9b\x6f\x5a\x48\x2c\xa4\xe0\x7a\x16\x75\xdb\x82\xdf\xd7\x7c\x4b\x5f\x19\x6e\xc4\x1b\xf2\x9e\xf2\x3d\xdb\xbf\xa3\x26\x39\xd1\xe4\x89\xe2\x41\xcd\xa2\x68\xb1\x58\xc0\x66\x47\xf8\x9b\x02\x2d\xe0\xb7\x5a\x69\xca\xe1\x5f\x8c\x72\x84\x6b\xd8\x69\x5d\xa9\x74\xb1\xd0\x07\xaa\x35\xca\x24\x13\xe5\x82\x58\x96\x3f\x0b\x26\x0e\xfa\x68\xc5\x0b\x21\x21\xa7\x2a\x13\x7b\x94\x94\x6f\x81\xf0\x1c\x24\x1a\x48\xe6\x49\xef\x10\xf6\x35\xe3\x28\xc9\x2b\x65\x54\x1f\x41\xef\x88\x06\xbd\xa3\x0a\x32\xc1\xb5\x24\x99\x06\x8d\x4a\x2b\xa3\xcc\xe1\xa1\x0a\xa8\x02\x62\xf7\x07\x5c\x46\x90\x96\x15\xc3\x12\xb9\x36\xf4\x92\x30\x9a\x51\x51\x2b\x50\x9d\xa7\xac\x0a\x2d\x40\xcb\x23\x10\x0e\x0a\x19\x33\x7f\x1f\xee\x37\x70\xa0\x7a\x07\x04\x72\x5a\x14\x28\x91\x6b\x58\xdf\x01\xe5\x16\x62\xc5\x48\x86\x56\x54\x14\x1e\x0b\xa3\xc6\xde\xed\x00\x1c\x4a\x1c\xa0\xa3\x1c\xbc\x40\x3d\xfd\xf2\xa7\xd9\x4f\xb2\x3c\x73\x96\x1a\xdb\x9c\xbd\x44\x61\x14\x91\x2c\x43\xa5\xe6\x84\xb1\xb8\xb7\xed\xf7\xd6\x92\xa1\x26\x78\x8f\x00\x00\x86\x02\x0c\x35\xf4\x2c\x66\x45\xb6\xf8\x48\xf4\x2e\x85\xc1\xc3\x07\x62\x8f\xf5\x2b\xa3\x99\x61\x4c\xa1\x5f\x47\x13\x29\x89\x4a\xd4\x32\xc3\x81\x68\xea\x5b\x9b\xf4\xcb\x46\x91\x83\x3c\xd0\xa4\x90\x15\x0d\xee\x3e\x46\xb7\xa4\x4a\xe1\x96\x54\x2e\x25\x96\xa4\xd6\xbb\xb9\xaf\xf8\x56\x22\xd1\xf8\xef\xc6\xfb\x57\xa3\x53\xbf\x62\x29\xf6\x2d\x31\x86\xcf\x3e\xb5\x5f\xde\x9c\x07\xe3\xe2\xaa\x52\xf8\xf5\xfd\x8f\x35\xd7\xff\xfc\x47\x0a\x4e\xe1\x29\x8a\xc6\x72\xd6\x1d\x7b\x8a\x07\x28\x6a\x0e\x5b\xd4\x8e\x75\x7d\xa7\xe6\x71\x0a\xcf\x8d\x86\x97\x81\xf5\xe6\x27\x51\xd7\xd2\xa6\x60\x91\x78\xc6\x27\xaf\x42\x4a\x71\x98\xc7\x9f\x92\x91\xae\x4e\xfe\xf4\x01\x86\x46\x83\x13\x9d\x3b\x6b\xbe\xba\x80\xad\xef\x52\x68\x20\xc5\x29\x7c\x7e\xf7\xdd\xe3\x64\x9a\x78\x9d\xbe\x84\x31\x7f\xb6\xa0\x5b\x27\x3d\x4f\xf4\xbf\x7c\x04\xd4\xf8\x29\x63\x48\x78\x5d\x5d\x40\x17\x3c\xfc\x82\xea\x0b\x39\xe2\x8e\x1c\x6e\xcd\x3b\x71\xf3\xe3\x85\x7e\x94\x62\x4f\x73\x94\x7d\xea\x05\xd2\x70\x54\x5f\x93\xff\x50\xbd\xcb\x25\x39\xc4\xc6\x93\x63\xe2\xad\x60\x0c\x33\x4d\x05\x3f\xdd\x5c\x8d\x8f\xdb\x1c\x2b\x4c\xc1\xfc\x3f\x21\xf5\x3e\xf0\x49\x5d\x45\x7b\x28\xf4\x3a\x0f\xf3\x28\xc2\xf0\x91\x1c\x4d\x11\x7c\x22\x35\x3b\x7b\x8c\xe1\xbb\xad\xb5\x4a\xe1\xd9\xf7\xdb\xb7\x86\xf0\xe2\xb3\x97\x44\xbe\xa1\xb6\x85\x50\x0d\x1d\xf4\x3c\xf0\xd0\xe7\x77\xdf\xfc\xaf\x98\x21\xdd\xa3\x3c\xdd\xbc\x7c\xf1\xb5\x65\xb5\xd2\xa2\x34\x66\x7e\xd3\xa6\x2d\x8c\xc9\xa2\x2c\xa9\x52\x54\xf0\xdf\x4a\x51\x73\x9d\xc2\x1f\xf7\xf4\xaf\xb1\xa5\xf8\x57\x45\xe5\xb1\xf5\x42\x47\x8a\xdb\x9d\x51\xfa\xf8\x45\x06\x56\x17\x6f\xde\x44\xd2\x65\xe9\x3a\x87\xd5\x40\x4b\x72\x21\xa3\x2e\x64\x55\x70\xfb\x2a\x24\xdd\x44\xcf\x2d\x82\x1c\xc6\x89\xf6\xcf\x94\x1a\x4e\x85\xd0\x6e\x58\xb6\x49\x8f\x76\xe5\xb1\xc4\xd1\xc4\x43\x5d\x72\x3a\x57\xc1\xf2\xda\x5d\x38\x38\xeb\x1f\xcf\xfb\x69\x20\x22\x53\x60\x81\x72\xe1\xb6\xd6\xf9\x94\xdb\x78\x26\x4f\x47\x17\x67\xca\x56\xb9\x68\x9c\x09\xcd\x25\xd3\x73\x54\x5a\x8a\x63\x83\xdd\x21\x51\x09\xe5\x0a\xa5\x9e\xbf\xe1\x71\x08\x0f\x96\xd7\x63\x2f\xc5\x51\xa8\xc0\x76\x22\xa1\x6a\x47\x39\xd5\xf3\x91\xe7\x26\x75\xea\x6f\x68\x97\xf1\xe8\x42\x4d\xa3\xe5\xdd\x8d\x5b\x52\x4d\xd9\x9d\x61\xca\x24\xc7\xfb\xa9\xa3\x9f\xa2\x81\x8d\xc1\x21\xc3\xe1\x1b\x4f\x18\x6e\xfb\x47\xc6\x8b\x0b\xf3\x41\xa7\xce\x0c\x8d\x66\x46\xa3\x39\x94\x75\xb6\x83\x92\xe8\x6c\x67\x47\x43\x9a\x83\x28\xec\xca\x99\x06\xaf\x68\xfe\xa7\x65\x85\x52\x09\x4e\x34\xe6\xe7\x51\x05\x92\xd9\x55\xb3\xe9\xd1\x6a\x74\x1e\x2f\x34\x1c\x10\x88\x44\x97\x35\xf6\x58\xae\x34\x92\x8e\x47\x70\x6c\xe6\x4c\x02\xb5\x42\x69\x8c\x30\xc3\x3c\x55\x0e\x65\x55\xcb\x6c\x47\x14\xe6\xc9\x79\x8c\xee\x0a\x8d\x71\x39\xbe\x76\x46\x6d\x78\xfb\x7b\xf4\x63\x8d\xb3\xbd\x82\x57\x70\xa1\xa7\xba\x19\xe5\x66\x82\xa9\x1b\x31\x9a\x72\xfe\x70\xbf\x31\x63\x58\xe0\x98\x87\xfb\xcd\xb9\x11\xe7\x52\x73\x70\xab\x0b\xe3\xd5\x30\xd7\xfb\xfd\x4e\xd2\x22\x0a\x5d\xed\xe0\x30\xb7\x45\x7d\x87\x9a\x50\x66\xa7\xc9\x60\xfe\x3b\xfa\xff\xd5\x94\x21\xac\x90\x2d\x8b\x45\x97\x5e\x70\xa0\x8c\xb5\x70\x4c\x16\xcf\x0e\x52\xf0\xed\xcc\x54\xdb\xa0\xed\x66\x58\x6b\x85\xfd\xae\x51\x35\xa3\x8d\x19\xcc\xfd\x58\xda\x61\xa7\xaf\x28\xb1\xe1\x08\x06\x7c\xe4\x24\x93\xb6\xb9\x73\x9f\x9b\x09\x82\x96\x99\x1f\x51\xb6\xaa\x3b\x0c\xc9\x2b\x61\x84\x67\x08\xab\x55\xab\x21\x31\xcd\xf2\x51\xd2\x0c\xaf\xa0\x44\xa5\xc8\x16\x53\x98\x51\x9e\x09\x29\x31\xd3\x2d\x7a\x20\x76\xac\x99\x5d\xd4\xbe\x45\xdb\xf4\xe7\xf1\x44\xff\xb8\x79\x5f\x3c\x4a\x1b\xc3\x41\x1f\x2b\x9c\x8d\x7a\x8d\xb1\xbc\x68\x66\x80\x3b\xa2\x09\xac\x5a\x99\xc4\x54\x5d\xb6\x47\xf3\x19\x60\x6e\x30\x2c\xcf\x7f\x25\x48\xee\x37\x9d\x86\x9b\x79\x1c\x7f\x02\xa2\x3e\xc1\xf7\xf1\x7b\x68\x68\x61\x2b\xc8\xde\x10\x61\x15\x7e\xe5\x4d\x48\x96\x19\xc7\xd9\x6c\x26\x5b\x74\xd9\x3b\x19\x3c\x2d\xa2\xd3\xcd\xbc\x90\xa2\x4c\x87\x36\xb6\x82\xe6\x8d\x76\xdc\xce\xcc\x3f\x7b\x7a\x92\x63\x25\x14\xd5\x4e\x7c\x79\xdd\xfa\xc5\x0f\xd7\x09\x90\x29\x0c\x28\xf9\x3e\xe8\x8a\xec\x71\xbe\xbc\x76\xaa\xaf\x40\x8b\xf3\x48\xfd\x73\xa7\x51\x34\xad\x60\x79\xdd\x24\x6f\x5b\x82\x9d\x6f\xcc\x5b\xe4\xc1\x95\xda\x79\xbb\xe8\xee\xb5\xad\xed\x71\xa8\x68\x2c\xaf\xbd\xcb\x39\x38\xd4\x8e\x1f\xd1\x85\x29\x6e\x52\xfa\xff\x86\x59\xc4\x1f\xe1\x02\x65\x2b\xf4\x6a\xe4\x75\x32\x9f\xf4\x73\x1a\x57\x7b\xfa\x38\x15\x2b\x19\xca\xab\x2e\xb2\xd9\x0e\xb3\x37\xd3\x0b\x66\x94\xef\x09\xa3\x79\x47\x82\xac\x43\x3f\x9b\xc8\xfb\x2d\x60\xaa\xa4\xa7\x1b\x35\xb3\x8f\x92\xce\x39\x7d\x3c\xf3\xfd\x2f\xbd\x65\xb2\x15\xc3\x97\x2f\x50\x11\x4e\xb3\xf9\xac\x20\x94\x61\x6e\x3e\x3c\x36\x1a\xcd\x87\x15\x6f\xac\x6a\xc5\x67\xf1\x85\x82\xee\x78\xfc\x9a\xee\xf1\xff\xc0\x18\xdb\x03\x87\xd5\xd4\x98\xa9\x98\x4d\x40\x58\x99\x9b\xb5\xce\xa7\xe4\x2e\x8a\xab\x2e\xa0\x51\xb0\x43\x38\xe2\xc4\xd5\x66\xae\x18\xdc\x6b\xf8\xb4\x02\x4e\xd9\xb0\x3f\x64\xa2\x66\x39\x70\xa1\x5b\xa7\x1a\x28\x77\xe1\x46\xd4\xf6\x1c\xcb\x62\x74\xf5\xaa\x87\x2a\xcb\x5a\x69\xab\x71\xd0\xdf\x15\x29\xed\xa8\x4a\x9a\xa1\x55\x48\xba\xa5\x9c\xb0\x40\xc4\xce\x4e\xfe\xfd\xf7\x9a\xfe\xda\xff\x94\xb7\x9e\x14\x7e\xed\x1f\x07\xd7\xb2\x2f\x94\xee\x2d\xf7\x3c\x4e\xef\x31\x1e\x5a\x6c\x8b\xe9\xf0\xfa\x5b\x1f\x07\xbf\xe1\xc2\x0a\x16\xae\x29\x2c\x3c\xb4\x4f\xbf\x74\x1d\xe7\x9c\x9a\xfe\x3b\xae\xd1\x52\xd9\x27\x5f\x49\x04\x00\x70\x8a\x4e\xff\x1d\x00\x26\xf3\x3b\x67\x60\x18\x00\x00"
 
 func utilityTestMaliciousstorefrontv1CdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -217,7 +259,7 @@ func utilityTestMaliciousstorefrontv1Cdc() (*asset, error) {
 	return a, nil
 }
 
-var _utilityTestMaliciousstorefrontv2Cdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x5f\x6f\xdb\x38\x12\x7f\xf7\xa7\x98\xfa\xa1\xb0\x01\xc7\x06\x0e\x87\x7b\x30\xe2\xb4\xdd\x64\x73\x08\xee\x36\x08\xba\xde\xde\x43\x51\x2c\x18\x6a\x64\x11\xa1\x48\x81\xa4\xec\x1a\x81\xbf\xfb\x81\x14\x25\x51\x7f\xe3\x6c\xef\x9a\x3c\x24\x12\xc9\xf9\x3f\x9c\xf9\x8d\xc2\xd2\x4c\x2a\x03\xd3\xfb\xdb\xed\xef\x46\x2a\x8c\x95\x14\xe6\xcb\xdf\xa6\x93\x6a\x43\x8a\xdb\x5c\xec\xd8\x23\xc7\xad\x7c\x42\x51\xef\x9c\xb3\xfc\x1b\x1a\x12\x11\x43\xbe\x30\x3c\xe8\xe9\x64\xb2\x5a\xad\x60\x9b\x10\xf1\xa4\xc1\x48\xf8\x94\x6b\xc3\x04\xfc\x8b\x33\x81\x70\x01\x89\x31\x99\x5e\xaf\x56\xe6\xc0\x8c\x41\xb5\xa4\x32\x5d\x11\x77\xe4\xcf\x98\xcb\x83\x39\x3a\xf2\x58\x2a\x88\x98\xa6\x72\x8f\x8a\x89\x1d\x10\x11\x81\x42\x2b\xdb\xbe\x99\x04\x61\x9f\x73\x81\x8a\x3c\x32\xce\xcc\x11\x4c\x42\x0c\x98\x84\x69\xa0\x52\x18\x45\xa8\x01\x83\xda\x68\xcb\xcc\xeb\xc3\x34\x30\x0d\xc4\xad\x07\xa7\x2c\x21\x4b\x33\x8e\x29\x0a\x63\xf7\x53\xc2\x19\x65\x32\xd7\xa0\x2b\x5f\x39\x16\x46\x82\x51\x47\x20\x02\x34\x72\x6e\xff\xde\xdf\x6e\xe1\xc0\x4c\x02\x04\x22\x16\xc7\xa8\x50\x18\xb8\xbb\x01\x26\x9c\x8a\x19\x27\x14\x1d\xa9\x8c\x1b\x47\x38\xb3\xf6\xee\x02\xe5\x50\x61\xa0\x1d\x73\xac\xc3\x50\xfd\x69\xd7\x97\x34\xa2\xde\x52\x6b\x9b\xb7\x97\x68\x9c\x4c\x08\xa5\xa8\xf5\x8c\x70\x3e\xaf\x6d\xfb\xad\xb4\x24\xe4\x04\xcf\x13\x00\x80\x90\x80\xa3\x81\xfa\x88\x7d\x22\x3b\x7c\x20\x26\x59\x43\xf0\xf2\x02\xd9\x43\xfe\xc8\x19\x2d\xa8\xea\xe7\x49\x87\x4a\xa1\x96\xb9\xa2\x18\x90\xae\xdb\xd6\x2e\xdb\x6c\xbd\xd2\x01\x2f\x8d\x3c\x2e\x54\xa8\xa3\x74\x4d\xb2\x35\x5c\x93\xcc\x27\xc5\x25\xc9\x4d\x32\x6b\xb3\xbe\x56\x48\x0c\xfe\xbb\x88\xc0\xa2\x23\xf9\x33\xa6\x72\x5f\x6e\xcf\xe1\xfd\xb0\x66\x57\xc3\x2a\xf9\xf8\xea\x35\x7c\x7c\xfe\xe3\x4e\x98\x7f\xfc\x7d\x0d\x9e\xe5\x69\x32\x69\xd3\x39\xb7\xec\x19\x1e\x20\xce\x05\xec\xd0\xf8\xa3\x77\x37\x7a\x36\x5f\xc3\xd7\x82\xc3\xb7\xc0\x07\xf6\x47\xa1\xc9\x95\x4b\xc5\x78\xd9\x70\xc1\xf2\x51\x2a\x25\x0f\xb3\xf9\xbb\x65\x8b\x57\x45\x7f\xea\xd7\xc1\x8b\xbf\xc9\x33\xce\x68\xed\x24\x4b\x2b\x62\xb3\x3d\x66\xb8\x06\xfb\x7b\x01\x22\x36\x77\x37\x6b\x28\x34\x5b\x94\xf6\xd6\x4b\x3f\xac\xf6\xa8\x0e\xfe\xa1\x52\xc3\xfd\x69\x68\x51\x3d\xbe\x68\x73\xe5\xf7\x42\xbc\x17\x37\xf3\x0c\x3e\xfb\x64\x6d\x58\xf6\xfe\xb9\x9d\x14\x9e\xaa\xc8\xd5\xd3\x87\x7e\x93\xdf\x3b\x9b\xcb\xd4\xf8\xda\x91\xf0\xed\x9c\xf0\x50\x8e\x44\xe4\xd9\xaf\xdf\x33\xa6\x30\xf2\x72\xf5\x2c\x56\x32\xbd\x13\x11\x7e\xaf\x63\x62\x64\x63\x61\xfe\x17\xe2\xf0\xb2\xb0\xea\x31\x90\xe7\x1f\xc6\x3c\x5f\x56\xa8\x86\x4d\x23\x3e\xef\xd5\xfd\xec\xc8\xee\xd0\xfc\xfa\xbd\xe0\x7d\x66\x46\xff\x70\xfa\x8e\xc9\xeb\xcd\xde\xb3\xee\xa6\x77\xd4\x43\xae\x68\x42\x74\x10\x91\x57\x7a\xee\xac\xa8\x9f\x25\xa5\xb3\xf4\x1a\x3b\xfe\x99\x48\x6d\xfe\xaf\x36\xbc\x28\xe1\x55\xfa\x8f\x76\x12\x6f\x59\xb8\x34\x6b\xa8\x2d\x62\xf3\xa0\xe4\x9e\x45\xa8\xea\x16\xd5\xd3\xae\x5a\x48\x6c\xf9\x1f\x66\x92\x48\x91\xc3\xdc\xd6\x9d\xf6\xe6\xb5\xe4\x1c\xa9\x61\x52\x9c\xae\x16\x6d\x71\x41\x7a\xb7\xb7\x82\xe2\xdd\xd8\xaa\xb0\xcf\x7d\x6c\xee\xa2\xfe\x33\x9a\x70\x7c\x20\x47\x0b\x97\xbe\x90\x9c\x0f\x8a\xb1\xe7\xae\x73\xa3\xd7\xf0\xb5\xd3\x44\x8b\xad\x6f\x6d\xe1\xea\x09\x8d\x03\x4d\x3a\x74\xd1\xd7\xc0\x47\xef\x9f\x9b\x0e\xf8\x8c\x14\xd9\x1e\xd5\xe9\xea\xdb\x87\x26\x37\x9a\x6b\x23\x53\x6b\xe8\xef\xc6\x42\xc8\xf6\xb6\x4c\x53\xa6\x35\x93\xe2\x53\x2a\x73\x8b\x41\xfe\xb8\x65\xdf\xdb\xb6\xa2\x2d\x7d\xc7\xd2\x0f\xd5\xd6\xbc\x5c\x69\xa5\x66\x13\x8e\xc0\x66\x34\x49\x3b\x94\x65\xd3\x8a\x2c\x61\x45\xb3\x1c\xc9\xa9\x91\xbc\xea\x5d\x5e\xf4\x51\x37\xab\x52\xdf\x89\xba\xc7\x76\x76\xfb\x93\xa1\x6f\xb5\x9f\xb6\x48\x90\xf2\xa9\x7b\x66\x28\x27\xfa\xd7\xbb\xf4\x75\x16\x94\x4f\x3d\x67\x3a\xa9\xd0\x5e\xe9\xd2\x94\x89\x51\xfc\x6d\x6c\xcf\x27\x9d\xc8\x56\xd7\xca\x87\x18\x2e\x2f\x7c\xa9\x80\xc1\xb8\xb6\x60\x6d\x37\x93\xba\x4a\x0d\x17\xb8\xbb\xa8\x3f\xb0\xd1\xba\x75\xe5\xbb\xc7\x32\x9f\x45\x03\x29\x35\x66\x7a\x84\xda\x28\x79\x84\x06\xf0\x59\x32\xa1\x51\x99\xd9\x13\x1e\x43\xf5\xe0\xf2\xa2\xed\xa5\x16\x37\x5f\xf7\x2b\x92\xbe\x4a\xcd\x04\x33\xb3\x37\x1b\x08\xda\x9d\xaa\x1b\xb3\xc6\xcd\xbe\x26\x59\xf7\x78\xe9\x26\x9b\x22\xcf\xa7\xc0\xc6\xc0\xd2\xde\x71\xca\x6b\xd8\x9d\xa5\x1a\xe0\xf4\xaf\x0d\x52\xa3\x53\x50\xc5\xd0\x8e\xc8\x6e\xca\x8e\x20\xcd\x69\x02\x29\x31\x34\x71\x83\x30\x8b\xec\x04\x6c\x9f\xca\x0b\xf0\x88\xf6\x37\x4b\x33\x54\x5a\x0a\x62\x30\x7a\x71\x9a\xea\x22\x83\x1e\xd1\xba\x25\x4f\xc4\x06\x0e\x08\x44\xa1\xcf\x1f\x27\x56\x68\x83\xa4\x3a\x23\x05\x16\x53\x35\x81\x5c\xa3\xb2\x9c\xc4\x93\xe3\x55\x68\x99\x95\x48\x68\x39\xac\xa3\x08\xfb\xe5\x08\xde\xb5\x67\xeb\x1b\xf5\x63\xcd\xbf\xbc\x8c\x0b\x18\xc1\x05\x7e\x26\xb9\x1a\x46\x63\x45\x43\xba\xbf\xdd\xce\x8a\xd9\xa6\xcd\xeb\xfe\x76\x3b\x34\xd2\x8c\x62\xb0\x17\x07\xaa\x30\xdf\x03\x04\x56\x52\x3a\x8d\x5e\x03\xf2\x6f\xd0\x10\xc6\xdd\xcc\x3c\x70\x07\xfc\x89\x9f\x6a\x4c\xa8\xd8\x6b\xac\xf9\xc4\xb9\x3c\x60\x74\x5d\xb5\xa2\x12\xea\x14\x1f\x05\xce\xc4\x44\x3f\xdb\xd4\x31\xad\xcf\xb6\x3e\x21\xda\x8b\xff\x05\xa9\x4c\xd1\xc1\x78\x8c\xac\xdd\xbf\x48\xc9\x7f\xaa\x4d\x83\xba\x9c\x6d\x0d\xd3\xe1\x18\xf2\x26\x46\xb4\x55\xe8\xd3\x7d\xb5\xaa\x0a\x1d\x1c\x18\xe7\xa5\x4a\xb6\x42\x4e\x0f\x4a\x8a\xdd\xd4\x56\xb9\xc1\x32\x52\x12\x37\x91\x4c\x56\xa0\xc0\x35\x7c\x6c\x25\xa9\xc3\x85\xa7\x05\x0c\x80\xf2\xcf\x48\x59\xc6\x1c\xe5\x59\x99\x1e\xa0\xfb\xb9\x15\xd6\x5b\xc5\x7a\xd0\x7a\xe4\x2b\x82\x87\xea\xbd\x97\xd5\x59\xab\x1d\x68\xf1\xe6\x2c\x1f\x09\x27\x82\x22\x6c\x36\x25\x87\xa5\xc3\xbc\x8a\x51\x5c\x40\x8a\x5a\x93\x1d\xae\x61\xca\x04\x95\x4a\x21\x35\xa5\x23\x80\x38\x40\x39\x1d\xe5\xbe\x43\x87\x98\x67\xf3\x0e\xff\x36\xa6\x1e\x15\x65\xac\xe1\x60\x8e\x19\x4e\x7b\x30\x69\x5c\xb0\xb9\x21\x86\xc0\xa6\xa4\x59\x5a\x38\xc1\xf7\xf8\x85\xe1\x61\x66\x25\x5c\x0e\x7f\xe8\x5f\xde\x6e\x2b\x0e\x57\xb3\xf9\xfc\x1d\x10\xfd\x0e\xce\x3b\xdf\xd0\x86\xc5\x4e\xa1\xbd\xdd\x84\x4d\xff\x57\xeb\x25\xa1\xd4\x3a\xce\x5d\x0e\xb2\x43\x7f\x19\x3a\x19\x51\xa4\xd5\x95\xfb\x46\xb5\x0e\x6d\x2c\x09\x1f\x88\x49\xda\x38\xcd\xfe\x38\xe9\xcb\x08\x33\xa9\x99\xf1\xe4\x97\x17\xa5\x5f\x9a\xe1\x3a\x01\x72\x8d\x3d\x4c\xce\x53\x5d\x93\x3d\xce\x2e\x2f\x3c\xeb\x05\x18\x39\xac\x69\x53\x6e\x37\x8a\x16\xdf\x5c\x5e\x14\xc9\x5b\xe2\x8a\xa0\x64\x1c\x3c\x7e\x98\x95\x0f\x55\x99\x70\x80\x65\xde\x57\x83\x2e\x2f\x1a\xf7\xbc\x8d\xae\x9b\x90\xf5\xad\xa0\x76\x73\x4e\x19\x44\x88\xdd\x0f\x1f\x03\x1f\x35\xde\x06\x93\x95\xd2\xdb\x09\x99\xa9\xbe\xec\xaa\xe2\x4b\x13\xa4\x4f\xb6\x91\x4c\x99\xd8\x13\xce\xa2\x6a\x0b\x68\xa5\xfd\x74\x7c\xa2\xec\x61\x12\x7c\xb9\xa0\x24\x9b\xbe\x94\x7a\x25\x94\x6f\x8d\x34\xaf\x69\x58\xdd\x5e\x05\x1f\x3e\x40\x46\x04\xa3\xb3\x69\x4c\x18\xc7\x08\x8c\xf4\x08\xd5\x82\xa2\xc6\xc4\x50\x92\xb7\x8a\x69\xb3\xac\xfb\x33\xcd\xca\xfe\xbf\x9a\xd2\x6a\xc5\x6b\x49\xf5\x5a\x97\xcc\x25\x20\x6c\x8a\x44\xec\x6e\x57\x51\xdc\x54\x01\x9d\xf4\xf6\x89\xee\x45\xaf\x21\x73\x70\xbb\xe1\xdd\x06\x04\xe3\x61\x97\xa0\x32\xe7\x11\x08\x69\x4a\xa7\xba\xef\x3a\xfd\xed\xa8\xec\x3c\xee\x88\xe5\x55\xb3\x0e\x59\xa6\xb9\x36\x8e\x63\x00\x18\x34\x49\xdd\x14\x46\x8a\x79\x4c\x2a\xb6\x63\x82\xf0\x9e\x88\x0d\x0e\xb6\xf5\xe7\xd4\xfa\xda\xbf\xe1\x68\xbf\x86\x8f\xf5\x6b\x70\x39\xeb\xa2\xe9\x3f\xe8\x0c\x6b\xdb\x78\x9d\x87\x76\xbb\xc2\x1a\x16\x01\xe7\xe9\xde\x7f\xc9\xc2\x06\x56\xbe\x41\xac\x5a\xfa\x56\xdd\x67\x88\x4d\xfd\x6f\x59\xcb\x25\x73\x6f\x6d\x26\x5e\xad\xd3\x7f\x03\x00\x00\xff\xff\x5c\x3e\x0f\x91\x33\x20\x00\x00"
+var _utilityTestMaliciousstorefrontv2Cdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xc4\x59\x5f\x6f\xdb\x38\x12\x7f\xf7\xa7\x98\xfa\x21\xb0\x00\xc7\x06\x0e\x87\x7b\x30\xe2\x74\xbb\xc9\xe6\x60\xdc\x6d\x10\xb4\xd9\xde\x43\x51\x14\x8c\x34\xb2\x89\x50\xa4\x40\x52\x76\x8d\xc0\xdf\xfd\x40\x8a\xfa\x4b\x4a\x49\x2e\xb8\x14\x31\x62\x89\xe4\xcc\xfc\xe6\x0f\x87\x33\x34\xcd\x72\x21\x35\x4c\x6f\x6f\xee\xbf\x68\x21\x31\x95\x82\xeb\xaf\x7f\x9b\x4e\xea\x09\xc1\x6f\x0a\xbe\xa5\x0f\x0c\xef\xc5\x23\xf2\x66\xe6\x25\xc3\x7f\xa2\x26\x09\xd1\xe4\x2b\xc5\x83\x9a\x4e\x26\xcb\xe5\x12\xee\x77\x84\x3f\x2a\xd0\x02\x3e\x15\x4a\x53\x0e\xff\x62\x94\x23\x9c\xc3\x4e\xeb\x5c\xad\x96\x4b\x7d\xa0\x5a\xa3\x5c\xc4\x22\x5b\x12\xbb\xe4\x47\xca\xc4\x41\x1f\x2d\x79\x2a\x24\x24\x54\xc5\x62\x8f\x92\xf2\x2d\x10\x9e\x80\x44\x03\xc9\xbc\xe9\x1d\xc2\xbe\x60\x1c\x25\x79\xa0\x8c\xea\x23\xe8\x1d\xd1\xa0\x77\x54\x41\x2c\xb8\x96\x24\xd6\xa0\x51\x69\x65\x98\x39\x3c\x54\x01\x55\x40\xec\x78\x6b\x95\x21\xa4\x59\xce\x30\x43\xae\xcd\x7c\x46\x18\x8d\xa9\x28\x14\xa8\xda\x56\x96\x85\x16\xa0\xe5\x11\x08\x07\x85\x8c\x99\xef\xdb\x9b\x7b\x38\x50\xbd\x03\x02\x09\x4d\x53\x94\xc8\x35\x6c\xae\x81\x72\x0b\x31\x67\x24\x46\x4b\x2a\xd2\xce\x12\x46\x8d\xbe\xdb\x16\x38\x94\xd8\x42\x47\x39\xf4\x5c\xf5\xc3\x8c\x2f\xe2\x24\x76\x9a\x1a\xdd\x9c\xbe\x44\xe1\x64\x42\xe2\x18\x95\x9a\x11\xc6\xa2\x46\xb7\x3f\x2b\x4d\xda\x9c\xe0\x69\x02\x00\xd0\x26\x60\xa8\xa1\x59\x62\x9e\xc8\x16\xef\x88\xde\xad\xa0\xf5\xf2\x0c\xd9\x5d\xf1\xc0\x68\x6c\x16\xae\xa0\x79\x9e\x78\x54\x12\x95\x28\x64\x8c\x2d\xd2\x55\x5f\xdb\x45\xf3\x52\xb2\x72\xa0\x5b\xbc\x14\xb2\xb4\x44\xde\x78\xe9\x8a\xe4\x2b\xb8\x22\xb9\x0b\x8a\x0b\x52\xe8\xdd\xac\xcf\xfa\x4a\x22\xd1\xf8\xef\xd2\x03\x73\x4f\xf2\x67\xcc\xc4\xbe\x9a\x8e\xe0\xac\x3f\xdf\xbc\x5c\x0e\x43\x72\xfe\x55\x2b\xf8\xed\xe9\xaf\x0d\xd7\xff\xf8\xfb\x0a\x1c\xcb\xd3\x64\xd2\xa7\xb3\x66\xd9\x53\x3c\x40\x5a\x70\xd8\xa2\x76\x4b\x37\xd7\x6a\x16\xad\xe0\x5b\xc9\xe1\x7b\xcb\x06\xe6\x23\x51\x17\xd2\x86\x62\xba\xe8\x98\x60\xf1\x20\xa4\x14\x87\x59\xf4\x61\xd1\xe3\x55\xd3\x9f\xc2\x18\x9c\xf8\xeb\x22\x67\x34\x6e\x8c\x64\x68\x79\xaa\xef\x8f\x39\xae\xc0\xfc\x9f\x03\x4f\xf5\xe6\x7a\x05\x25\xb2\x79\xa5\x6f\x33\xf4\x66\xd8\xa3\x18\xdc\x43\x0d\xc3\x7e\x75\x50\xd4\x8f\xcf\xea\x5c\xdb\xbd\x14\xef\xc4\xcd\x1c\x83\xcf\x2e\x58\x3b\x9a\x9d\x3d\xf5\x83\xc2\x51\x95\xb1\x7a\xfa\x18\x56\xf9\xcc\xba\xca\x31\x56\xdf\x3c\x09\xdf\x9f\x83\x6a\xdc\x13\x33\x24\xbc\xc8\xff\xf8\x99\x53\x89\x89\x93\xab\x66\xa9\x14\xd9\x86\x27\xf8\xb3\x82\x39\x07\x2d\x3a\x03\xd1\xff\xe0\x87\xe7\x85\xd5\x8f\x2d\x79\xee\x61\xcc\xf2\x55\x86\xea\xe8\x34\x62\xf3\x20\xf6\x11\xfe\xde\x8e\xfa\xe3\x67\xc9\x3b\x14\x4d\x81\x88\x7e\x73\xf8\x8e\xc9\x0b\x46\x6f\xf4\x0a\xe7\xdf\x15\x32\xde\x11\xd5\xf2\xc8\x2b\x2d\xf7\x22\xaf\xbf\x48\x8a\x37\xf4\x1a\x3d\xfe\xb9\x13\x4a\x8f\x71\x7f\xb3\x0e\xcf\x4a\x78\x15\xfe\xd1\x93\xc4\x69\xd6\x1e\x9a\xd5\x0c\xcc\x87\xa7\xfa\x4e\x8a\x3d\x4d\x50\x36\x47\x54\xe0\xb8\xea\x55\x62\x8b\xff\x50\xbd\x4b\x24\x39\x44\x70\xf6\xe4\x4d\x5e\x09\xc6\x30\xd6\x54\xf0\xd3\xe5\xbc\x2f\xae\x15\xde\xfd\xa9\xc6\xba\x5d\xaa\xba\xf6\xb9\x4d\xf5\x26\x09\xaf\x51\x84\xe1\x1d\x39\x9a\x72\xe9\x2b\x29\xd8\xa0\x18\xb3\xee\xaa\xd0\x6a\x05\xdf\xfa\x96\xfb\x52\x4e\x7d\xef\x12\x64\x44\x3e\xa2\xb6\x45\x93\x6a\x9b\xe8\x5b\xcb\x46\x67\x4f\x5d\x03\x7c\xc6\x18\xe9\x1e\xe5\xe9\xf2\xfb\xc7\x2e\xb7\xb8\x50\x5a\x64\x46\xd1\x2f\xda\x94\x90\xfd\x69\x91\x65\x54\x29\x2a\xf8\xa7\x4c\x14\x5c\xaf\xe0\xaf\x1b\xfa\xb3\xaf\x2b\x9a\xd4\x77\xac\xec\x50\x4f\x45\xd5\x48\x2f\x34\xbb\xe5\x08\xac\x47\x83\xd4\xa3\x74\xc1\xb8\x49\x60\xdd\xe2\xb2\x18\x89\xa9\x91\xb8\x0a\x0e\xcf\x43\xd4\xdd\xac\x14\x5a\xd1\x9c\xb1\xde\x6c\x38\x18\x42\xa3\x61\xda\x32\x40\xaa\x27\x7f\xcd\x50\x4c\x84\xc7\x7d\xfa\x26\x0a\xaa\xa7\xc0\x1a\x2f\x14\xfa\xc1\xe1\xd3\x54\x81\x51\x7e\x77\xa6\xa3\x89\xe7\xd9\x7a\x5b\x39\x17\xc3\xc5\xb9\x4b\x15\x30\xe8\xd7\x4e\xd4\xac\x02\x91\xe4\x83\xf2\xb2\x59\x9d\xe0\x36\x89\xbf\xda\x78\x34\x59\xf5\xb6\xbc\xbf\x2c\x77\x51\x34\x10\x52\x63\xaa\x27\xa8\xb4\x14\xc7\x12\xbb\x43\xa2\x16\x94\x2b\x94\x7a\xf6\x88\xc7\x36\x3c\xb8\x38\xef\x5b\x29\x9a\x84\xf2\x7e\x4d\x12\xca\xd4\x94\x53\x3d\xeb\x59\xce\xcb\xb0\xff\xb7\x86\xa0\x7f\x52\xf9\x3e\xeb\xec\xec\x2b\x92\xfb\xcb\x9d\x7a\xca\x84\xc8\xd3\xa9\x9e\x3f\x4d\x5a\x9a\x06\xdb\x29\x87\xd0\xef\xa5\xdc\xc4\x5b\x1a\xa9\xd1\x2e\xa8\x66\x68\x5a\x64\xd3\x91\xd2\x04\xb2\x22\xde\x41\x46\x74\xbc\xb3\x8d\x30\x4d\x40\xa4\xf6\xc9\xa9\x07\x0f\x68\xfe\xd3\x2c\x47\xa9\x04\x27\x1a\x93\x61\x5c\x81\xb0\x76\xf9\xd8\x17\xad\x7a\xf2\x78\xaa\xe1\x80\x40\x24\xba\xf8\xb1\x62\xb9\xd2\x48\xea\x35\x82\x63\xd9\x55\x13\x28\x14\x4a\xa3\x84\xb9\xba\xa0\xca\xa1\xcc\xab\x4a\x68\x31\x8c\xd1\x6d\xa6\x3e\x2e\xaf\xde\x35\x6b\x9b\x1d\xf5\xb6\xc3\xbf\xda\x8c\x73\x18\xa9\x0b\x5c\x4f\x72\xe9\x61\xaa\xab\xb1\xf2\x40\xba\xbd\xb9\x37\xcd\x66\x40\xcc\xed\xcd\xfd\x50\x4b\x33\x76\xbc\xb9\x27\x17\x7d\xa1\xe2\xab\x1d\xef\xcd\x78\x4d\x69\x11\x85\x36\xf9\x50\x91\x7f\x8d\x9a\x50\x66\x7b\xe6\x81\x3d\xe0\x56\xbc\xab\x32\x6d\x60\xaf\xd1\xe6\x13\x63\xe2\x80\xc9\x55\x7d\x14\x55\xa5\x8e\x55\xf0\xa5\x35\xd1\x7b\xab\x3a\x86\xfa\xc5\xda\xef\x88\x72\xe2\x7f\xc7\x58\x64\x68\xcb\x78\x4c\x8c\xde\xbf\x0b\xc1\xde\x55\xa7\x41\x2c\x2f\xd6\x86\xaa\x76\x1b\xf2\x4b\x94\xe8\x43\x08\x61\x5f\x2e\xeb\x44\x07\x07\xca\x58\x05\xc9\xe4\xd3\xe9\x41\x0a\xbe\x9d\x9a\x0a\x20\xa8\xab\x69\x7d\x2a\xe2\x6e\x25\x93\x97\x8d\x82\xb9\x08\xeb\x06\xa9\x6d\x1d\x4e\x73\x18\x28\xca\x3f\x63\x4c\x73\x8a\x5c\x77\x92\xe4\x70\xa4\xb7\xaa\xfb\xc8\x08\x0b\x66\xb1\x9e\xcd\x4d\x2e\x4e\x5c\x46\x70\xa5\x7a\x70\xb3\x9a\x0f\x51\xb6\x68\x71\xea\x2c\x1e\x08\x23\x3c\x46\x58\xaf\x2b\x0e\x0b\x53\xc3\xde\x49\x1a\xe3\x1c\x32\x54\x8a\x6c\x71\x05\x53\xca\x63\x21\x25\xc6\xba\x32\x04\x10\x5b\x62\x4e\x47\xb9\x6f\xd1\xd6\xe2\xb3\xc8\xe3\xdf\xaf\xa9\x47\x45\x69\xa3\x38\xe8\x63\x8e\xd3\x5e\x29\x65\x34\x4f\xcb\xd2\xfc\x9a\x68\x02\xeb\x8a\x66\x61\xca\x09\xb6\x47\x73\x93\x3f\x33\x18\x2e\x86\x2f\xfa\x17\x37\xf7\x35\x87\xcb\x59\x14\x7d\x00\xa2\x3e\xc0\xcb\xd6\x77\xd0\xd0\xd4\xb6\x3f\x7b\x33\x09\xeb\xf0\xad\xf5\x82\xc4\xb1\x31\x9c\xdd\x1c\x64\x8b\x6e\x33\x78\xb9\xcf\x22\x3a\x5d\xce\xcc\xc5\xd4\xaa\xad\x63\x45\x68\x2e\xa5\xfb\x75\x9a\xf9\xb3\xd2\x17\x09\xe6\x42\x51\xed\xc8\x2f\xce\x2b\xbb\x74\xdd\x75\x02\x64\x0a\x03\x4c\x5e\x06\x5d\x91\x3d\xce\x2e\xce\x1d\x6b\x73\x73\x36\x8c\xb4\x2b\xd7\xf7\xa2\xa9\x6f\x2e\xce\xcb\xe0\xad\xea\x0a\x67\x1b\x73\x0f\x72\x70\xf5\xc3\xac\x7a\xa8\xd3\x84\x2d\x58\xa2\x50\x0e\xba\x38\xef\xec\xf3\x96\x50\x5b\x5d\x4f\x46\x9a\x94\xf7\x2b\xb5\xe7\x93\x67\x1a\x9f\xd0\xcd\x45\xa7\x48\xeb\x4e\xfd\x9a\x9a\xac\x92\xde\x0f\xc8\x5c\x86\xa2\xab\xf6\x6f\xbc\xc3\xf8\xd1\x1c\x24\x53\xca\xf7\x84\xd1\xa4\x9e\x82\xb8\x46\x3f\xf5\xe8\xbb\xe7\x8a\xcf\xa4\x99\x37\x6c\xa6\xcf\x85\x9e\x33\x7a\xbf\xa5\x79\xcd\x81\xe5\x0d\x45\xf0\xf1\x23\xe4\x84\xd3\x78\x36\x4d\x09\x65\x98\x98\x5f\x10\x4b\x8e\xa6\x28\xea\x74\x0c\x15\xf9\x34\x1a\x49\xeb\x6e\x4d\x37\xb3\x77\xd6\xbf\xa1\x4b\x6b\x80\xc3\xda\x57\xc6\x27\xb3\x01\x08\x6b\xb3\xbf\x36\x89\x3f\x5d\x7b\x71\x5d\x3b\x74\x12\x3c\x27\xdc\xa4\x67\x6a\x53\x32\xb7\x76\x37\x7c\x58\x03\xa7\xac\x7d\x4a\xc4\xa2\x60\x09\x70\xa1\x2b\xa3\x1a\x28\xd7\xe1\xe3\xa8\x3a\x79\xec\x12\xc3\xab\x61\xdd\x66\x99\x15\x4a\x5b\x8e\xad\x82\x41\x91\xcc\x76\x61\xa4\xec\xc7\x84\xa4\x5b\xca\x09\x0b\x78\x6c\xb0\xb1\x6d\xae\x53\x9b\x6d\xff\x0b\x5b\xfb\x15\xfc\xd6\xbc\xb6\x36\x67\x93\x34\xdd\x85\xce\x30\xda\xce\x6b\xd4\xd6\xdb\x26\xd6\x76\x12\xb0\x96\x0e\xfe\x24\x0b\x6b\x58\xba\x03\x62\xd9\xc3\x5b\x9f\x3e\x43\x6c\x9a\x9f\x65\x0d\x97\xdc\xbe\xf5\x99\x4c\x00\x00\x4e\x93\xd3\x7f\x07\x00\x5c\x3e\x0f\x91\x33\x20\x00\x00"
 
 func utilityTestMaliciousstorefrontv2CdcBytes() ([]byte, error) {
 	return bindataRead(
@@ -334,6 +376,8 @@ var _bindata = map[string]func() (*asset, error){
 	"utility/ExampleToken.cdc":               utilityExampletokenCdc,
 	"utility/NFTCatalog.cdc":                 utilityNftcatalogCdc,
 	"utility/NFTCatalogAdmin.cdc":            utilityNftcatalogadminCdc,
+	"utility/test/HybridCustody.cdc":         utilityTestHybridcustodyCdc,
+	"utility/test/MaliciousCollection.cdc":   utilityTestMaliciouscollectionCdc,
 	"utility/test/MaliciousStorefrontV1.cdc": utilityTestMaliciousstorefrontv1Cdc,
 	"utility/test/MaliciousStorefrontV2.cdc": utilityTestMaliciousstorefrontv2Cdc,
 }
@@ -390,6 +434,8 @@ var _bintree = &bintree{nil, map[string]*bintree{
 		"NFTCatalog.cdc": {utilityNftcatalogCdc, map[string]*bintree{}},
 		"NFTCatalogAdmin.cdc": {utilityNftcatalogadminCdc, map[string]*bintree{}},
 		"test": {nil, map[string]*bintree{
+			"HybridCustody.cdc": {utilityTestHybridcustodyCdc, map[string]*bintree{}},
+			"MaliciousCollection.cdc": {utilityTestMaliciouscollectionCdc, map[string]*bintree{}},
 			"MaliciousStorefrontV1.cdc": {utilityTestMaliciousstorefrontv1Cdc, map[string]*bintree{}},
 			"MaliciousStorefrontV2.cdc": {utilityTestMaliciousstorefrontv2Cdc, map[string]*bintree{}},
 		}},
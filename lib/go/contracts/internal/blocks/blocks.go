@@ -0,0 +1,40 @@
+// Package blocks tracks the last block added to the consumers of blocks of
+// NFTStorefrontV2 events: analytics.Aggregator, customid.View,
+// payouts.Collector and unpaid.Monitor.
+package blocks
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+)
+
+// Cursor is the last block added to a consumer, which embeds it for its
+// Height and Time methods.
+type Cursor struct {
+	height uint64
+	time   time.Time
+}
+
+// Height returns the height of the last block added.
+func (c *Cursor) Height() uint64 {
+	return c.height
+}
+
+// Time returns the timestamp of the last block added.
+func (c *Cursor) Time() time.Time {
+	return c.time
+}
+
+// Advance moves the cursor to the given block, or returns an error if it is
+// below the last block added: blocks must be added in order of height. A
+// block may be added again, for example after it failed.
+func Advance(c *Cursor, block flowclient.BlockEvents) error {
+	if block.Height < c.height {
+		return fmt.Errorf("block %d added after block %d", block.Height, c.height)
+	}
+	c.height = block.Height
+	c.time = block.BlockTimestamp
+	return nil
+}
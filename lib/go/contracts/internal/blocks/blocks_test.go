@@ -0,0 +1,25 @@
+package blocks_test
+
+import (
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/blocks"
+)
+
+func TestAdvance(t *testing.T) {
+	at := time.Unix(1_700_000_000, 0)
+
+	var cursor blocks.Cursor
+	require.NoError(t, blocks.Advance(&cursor, flowclient.BlockEvents{Height: 2, BlockTimestamp: at}))
+	assert.Equal(t, uint64(2), cursor.Height())
+	assert.Equal(t, at, cursor.Time())
+
+	require.NoError(t, blocks.Advance(&cursor, flowclient.BlockEvents{Height: 2, BlockTimestamp: at}))
+	assert.EqualError(t, blocks.Advance(&cursor, flowclient.BlockEvents{Height: 1}), "block 1 added after block 2")
+	assert.Equal(t, uint64(2), cursor.Height())
+}
@@ -0,0 +1,292 @@
+// Package cadenceconv converts between Cadence values and the Go types used
+// by the generated contract bindings.
+package cadenceconv
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+)
+
+// Decoder converts a Cadence value to a Go value.
+type Decoder[T any] func(cadence.Value) (T, error)
+
+// Encoder converts a Go value to a Cadence value.
+type Encoder[T any] func(T) cadence.Value
+
+// ParseUFix64 parses a decimal number such as 10, 10.5 or 0.00000001 as a
+// UFix64. Unlike cadence.NewUFix64, it accepts integers.
+func ParseUFix64(s string) (cadence.UFix64, error) {
+	return cadence.NewUFix64(decimal(s))
+}
+
+// ParseFix64 parses a decimal number such as -10, 10.5 or 0.25 as a Fix64.
+// Unlike cadence.NewFix64, it accepts integers.
+func ParseFix64(s string) (cadence.Fix64, error) {
+	return cadence.NewFix64(decimal(s))
+}
+
+func decimal(s string) string {
+	s = strings.TrimSpace(s)
+	if !strings.Contains(s, ".") {
+		s += ".0"
+	}
+	return s
+}
+
+// TypeID returns the ID of the given type, or an empty string if it is nil.
+func TypeID(t cadence.Type) string {
+	if t == nil {
+		return ""
+	}
+	return t.ID()
+}
+
+// Location returns the location of a contract deployed to the given address.
+func Location(address cadence.Address, contract string) common.Location {
+	return common.NewAddressLocation(nil, common.Address(address), contract)
+}
+
+// Fields returns the fields of the given composite value, checking that it
+// has the given qualified type identifier.
+func Fields(value cadence.Value, qualifiedIdentifier string) (map[string]cadence.Value, error) {
+	composite, ok := value.(cadence.Composite)
+	if !ok {
+		return nil, fmt.Errorf("expected %s, got %T", qualifiedIdentifier, value)
+	}
+
+	compositeType, ok := composite.Type().(cadence.CompositeType)
+	if !ok {
+		return nil, fmt.Errorf("expected %s, got value without type", qualifiedIdentifier)
+	}
+	if compositeType.CompositeTypeQualifiedIdentifier() != qualifiedIdentifier {
+		return nil, fmt.Errorf("expected %s, got %s", qualifiedIdentifier, compositeType.ID())
+	}
+
+	return cadence.FieldsMappedByName(composite), nil
+}
+
+// Field decodes the named field.
+func Field[T any](fields map[string]cadence.Value, name string, decode Decoder[T]) (T, error) {
+	value, ok := fields[name]
+	if !ok {
+		var zero T
+		return zero, fmt.Errorf("missing field %s", name)
+	}
+
+	result, err := decode(value)
+	if err != nil {
+		return result, fmt.Errorf("field %s: %w", name, err)
+	}
+	return result, nil
+}
+
+func as[T cadence.Value](value cadence.Value) (T, error) {
+	result, ok := value.(T)
+	if !ok {
+		return result, fmt.Errorf("expected %T, got %T", result, value)
+	}
+	return result, nil
+}
+
+func UInt8(value cadence.Value) (uint8, error) {
+	v, err := as[cadence.UInt8](value)
+	return uint8(v), err
+}
+
+func UInt16(value cadence.Value) (uint16, error) {
+	v, err := as[cadence.UInt16](value)
+	return uint16(v), err
+}
+
+func UInt32(value cadence.Value) (uint32, error) {
+	v, err := as[cadence.UInt32](value)
+	return uint32(v), err
+}
+
+func UInt64(value cadence.Value) (uint64, error) {
+	v, err := as[cadence.UInt64](value)
+	return uint64(v), err
+}
+
+func Int8(value cadence.Value) (int8, error) {
+	v, err := as[cadence.Int8](value)
+	return int8(v), err
+}
+
+func Int16(value cadence.Value) (int16, error) {
+	v, err := as[cadence.Int16](value)
+	return int16(v), err
+}
+
+func Int32(value cadence.Value) (int32, error) {
+	v, err := as[cadence.Int32](value)
+	return int32(v), err
+}
+
+func Int64(value cadence.Value) (int64, error) {
+	v, err := as[cadence.Int64](value)
+	return int64(v), err
+}
+
+func UFix64(value cadence.Value) (cadence.UFix64, error) {
+	return as[cadence.UFix64](value)
+}
+
+func Fix64(value cadence.Value) (cadence.Fix64, error) {
+	return as[cadence.Fix64](value)
+}
+
+func Bool(value cadence.Value) (bool, error) {
+	v, err := as[cadence.Bool](value)
+	return bool(v), err
+}
+
+func String(value cadence.Value) (string, error) {
+	v, err := as[cadence.String](value)
+	return string(v), err
+}
+
+func Address(value cadence.Value) (cadence.Address, error) {
+	return as[cadence.Address](value)
+}
+
+func Path(value cadence.Value) (cadence.Path, error) {
+	return as[cadence.Path](value)
+}
+
+func Capability(value cadence.Value) (cadence.Capability, error) {
+	return as[cadence.Capability](value)
+}
+
+// Type decodes a type value to its static type.
+func Type(value cadence.Value) (cadence.Type, error) {
+	v, err := as[cadence.TypeValue](value)
+	return v.StaticType, err
+}
+
+// Value returns the value unchanged.
+func Value(value cadence.Value) (cadence.Value, error) {
+	return value, nil
+}
+
+// OptionalOf decodes optional values, returning nil for nil.
+func OptionalOf[T any](decode Decoder[T]) Decoder[*T] {
+	return func(value cadence.Value) (*T, error) {
+		optional, err := as[cadence.Optional](value)
+		if err != nil {
+			return nil, err
+		}
+		if optional.Value == nil {
+			return nil, nil
+		}
+
+		result, err := decode(optional.Value)
+		if err != nil {
+			return nil, err
+		}
+		return &result, nil
+	}
+}
+
+// ArrayOf decodes arrays.
+func ArrayOf[T any](decode Decoder[T]) Decoder[[]T] {
+	return func(value cadence.Value) ([]T, error) {
+		array, err := as[cadence.Array](value)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make([]T, 0, len(array.Values))
+		for i, element := range array.Values {
+			decoded, err := decode(element)
+			if err != nil {
+				return nil, fmt.Errorf("element %d: %w", i, err)
+			}
+			result = append(result, decoded)
+		}
+		return result, nil
+	}
+}
+
+// DictionaryOf decodes dictionaries.
+func DictionaryOf[K comparable, V any](decodeKey Decoder[K], decodeValue Decoder[V]) Decoder[map[K]V] {
+	return func(value cadence.Value) (map[K]V, error) {
+		dictionary, err := as[cadence.Dictionary](value)
+		if err != nil {
+			return nil, err
+		}
+
+		result := make(map[K]V, len(dictionary.Pairs))
+		for _, pair := range dictionary.Pairs {
+			key, err := decodeKey(pair.Key)
+			if err != nil {
+				return nil, fmt.Errorf("key %s: %w", pair.Key, err)
+			}
+			decoded, err := decodeValue(pair.Value)
+			if err != nil {
+				return nil, fmt.Errorf("value of key %s: %w", pair.Key, err)
+			}
+			result[key] = decoded
+		}
+		return result, nil
+	}
+}
+
+func EncodeUInt8(v uint8) cadence.Value   { return cadence.NewUInt8(v) }
+func EncodeUInt16(v uint16) cadence.Value { return cadence.NewUInt16(v) }
+func EncodeUInt32(v uint32) cadence.Value { return cadence.NewUInt32(v) }
+func EncodeUInt64(v uint64) cadence.Value { return cadence.NewUInt64(v) }
+func EncodeInt8(v int8) cadence.Value     { return cadence.NewInt8(v) }
+func EncodeInt16(v int16) cadence.Value   { return cadence.NewInt16(v) }
+func EncodeInt32(v int32) cadence.Value   { return cadence.NewInt32(v) }
+func EncodeInt64(v int64) cadence.Value   { return cadence.NewInt64(v) }
+func EncodeBool(v bool) cadence.Value     { return cadence.NewBool(v) }
+func EncodeString(v string) cadence.Value { return cadence.String(v) }
+
+func EncodeUFix64(v cadence.UFix64) cadence.Value         { return v }
+func EncodeFix64(v cadence.Fix64) cadence.Value           { return v }
+func EncodeAddress(v cadence.Address) cadence.Value       { return v }
+func EncodePath(v cadence.Path) cadence.Value             { return v }
+func EncodeCapability(v cadence.Capability) cadence.Value { return v }
+func EncodeType(v cadence.Type) cadence.Value             { return cadence.NewTypeValue(v) }
+func EncodeValue(v cadence.Value) cadence.Value           { return v }
+
+// EncodeOptionalOf encodes optional values, encoding nil as the Cadence nil.
+func EncodeOptionalOf[T any](encode Encoder[T]) Encoder[*T] {
+	return func(v *T) cadence.Value {
+		if v == nil {
+			return cadence.NewOptional(nil)
+		}
+		return cadence.NewOptional(encode(*v))
+	}
+}
+
+// EncodeArrayOf encodes arrays with the given element type.
+func EncodeArrayOf[T any](elementType cadence.Type, encode Encoder[T]) Encoder[[]T] {
+	return func(v []T) cadence.Value {
+		values := make([]cadence.Value, 0, len(v))
+		for _, element := range v {
+			values = append(values, encode(element))
+		}
+		return cadence.NewArray(values).WithType(cadence.NewVariableSizedArrayType(elementType))
+	}
+}
+
+// EncodeDictionaryOf encodes dictionaries with the given key and value types.
+func EncodeDictionaryOf[K comparable, V any](keyType, valueType cadence.Type, encodeKey Encoder[K], encodeValue Encoder[V]) Encoder[map[K]V] {
+	return func(v map[K]V) cadence.Value {
+		pairs := make([]cadence.KeyValuePair, 0, len(v))
+		for key, value := range v {
+			pairs = append(pairs, cadence.KeyValuePair{Key: encodeKey(key), Value: encodeValue(value)})
+		}
+		// Sort the pairs so that encoding is deterministic.
+		sort.Slice(pairs, func(i, j int) bool {
+			return pairs[i].Key.String() < pairs[j].Key.String()
+		})
+		return cadence.NewDictionary(pairs).WithType(cadence.NewDictionaryType(keyType, valueType))
+	}
+}
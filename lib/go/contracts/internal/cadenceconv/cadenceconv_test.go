@@ -0,0 +1,51 @@
+package cadenceconv_test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+)
+
+func TestParseUFix64(t *testing.T) {
+	for s, want := range map[string]cadence.UFix64{
+		"10":          10_00000000,
+		"10.5":        10_50000000,
+		" 0.00000001": 1,
+		"0":           0,
+	} {
+		value, err := cadenceconv.ParseUFix64(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, value, s)
+	}
+
+	for _, s := range []string{"", "-1", "ten", "1.123456789"} {
+		_, err := cadenceconv.ParseUFix64(s)
+		assert.Error(t, err, s)
+	}
+}
+
+func TestParseFix64(t *testing.T) {
+	for s, want := range map[string]cadence.Fix64{
+		"-10":   -10_00000000,
+		"12.5":  12_50000000,
+		"-0.25": -25000000,
+	} {
+		value, err := cadenceconv.ParseFix64(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, want, value, s)
+	}
+
+	_, err := cadenceconv.ParseFix64("ten")
+	assert.Error(t, err)
+}
+
+func TestTypeID(t *testing.T) {
+	address := cadence.BytesToAddress([]byte{0xf8, 0xd6, 0xe0, 0x58, 0x6b, 0x0a, 0x20, 0xc7})
+	nft := cadence.NewResourceType(cadenceconv.Location(address, "ExampleNFT"), "ExampleNFT.NFT", nil, nil)
+	assert.Equal(t, "A.f8d6e0586b0a20c7.ExampleNFT.NFT", cadenceconv.TypeID(nft))
+	assert.Equal(t, "", cadenceconv.TypeID(nil))
+}
@@ -0,0 +1,222 @@
+// Package cdc extracts the declarations of Cadence contracts that the
+// analysis packages of this module work with.
+package cdc
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+	"github.com/onflow/cadence/parser"
+)
+
+// Contract is a parsed contract and all composite and interface
+// declarations nested in it.
+type Contract struct {
+	Name         string
+	Declarations []*Declaration
+	Events       []*Event
+}
+
+// Declaration is a composite or interface declaration.
+type Declaration struct {
+	// QualifiedName is the name prefixed with the names of the enclosing
+	// declarations, for example NFTStorefrontV2.ListingDetails.
+	QualifiedName string
+	Kind          common.CompositeKind
+	Interface     bool
+	Access        string
+	Conformances  []string
+	Fields        []Field
+}
+
+// KindDescription returns a human readable description of the declaration kind,
+// for example "resource interface".
+func (d *Declaration) KindDescription() string {
+	if d.Interface {
+		return d.Kind.DeclarationKind(true).Name()
+	}
+	return d.Kind.DeclarationKind(false).Name()
+}
+
+// Field is a field of a declaration or a parameter of an event.
+type Field struct {
+	Name       string
+	Type       string
+	Annotation *ast.TypeAnnotation
+	Access     string
+	Variable   bool
+	Optional   bool
+}
+
+// Event is an event declaration.
+type Event struct {
+	QualifiedName string
+	Parameters    []Field
+}
+
+// Parse parses the given program and returns the contract it declares.
+func Parse(code []byte) (*Contract, error) {
+	program, err := parser.ParseProgram(nil, code, parser.Config{})
+	if err != nil {
+		return nil, err
+	}
+
+	var contracts []*ast.CompositeDeclaration
+	for _, declaration := range program.CompositeDeclarations() {
+		if declaration.CompositeKind == common.CompositeKindContract {
+			contracts = append(contracts, declaration)
+		}
+	}
+	if len(contracts) != 1 {
+		return nil, fmt.Errorf("expected exactly one contract declaration, found %d", len(contracts))
+	}
+
+	contract := &Contract{Name: contracts[0].Identifier.Identifier}
+	contract.addComposite("", contracts[0])
+
+	return contract, nil
+}
+
+// Declaration returns the declaration with the given qualified name.
+func (c *Contract) Declaration(qualifiedName string) *Declaration {
+	for _, d := range c.Declarations {
+		if d.QualifiedName == qualifiedName {
+			return d
+		}
+	}
+	return nil
+}
+
+// Event returns the event with the given qualified name.
+func (c *Contract) Event(qualifiedName string) *Event {
+	for _, e := range c.Events {
+		if e.QualifiedName == qualifiedName {
+			return e
+		}
+	}
+	return nil
+}
+
+func (c *Contract) addComposite(prefix string, declaration *ast.CompositeDeclaration) {
+	name := qualify(prefix, declaration.Identifier.Identifier)
+
+	if declaration.CompositeKind == common.CompositeKindEvent {
+		c.Events = append(c.Events, &Event{
+			QualifiedName: name,
+			Parameters:    eventParameters(declaration),
+		})
+		return
+	}
+
+	c.Declarations = append(c.Declarations, &Declaration{
+		QualifiedName: name,
+		Kind:          declaration.CompositeKind,
+		Access:        declaration.Access.Keyword(),
+		Conformances:  typeNames(declaration.Conformances),
+		Fields:        fields(declaration.Members),
+	})
+	c.addMembers(name, declaration.Members)
+}
+
+func (c *Contract) addInterface(prefix string, declaration *ast.InterfaceDeclaration) {
+	name := qualify(prefix, declaration.Identifier.Identifier)
+
+	c.Declarations = append(c.Declarations, &Declaration{
+		QualifiedName: name,
+		Kind:          declaration.CompositeKind,
+		Interface:     true,
+		Access:        declaration.Access.Keyword(),
+		Conformances:  typeNames(declaration.Conformances),
+		Fields:        fields(declaration.Members),
+	})
+	c.addMembers(name, declaration.Members)
+}
+
+func (c *Contract) addMembers(prefix string, members *ast.Members) {
+	for _, nested := range members.Interfaces() {
+		c.addInterface(prefix, nested)
+	}
+	for _, nested := range members.Composites() {
+		c.addComposite(prefix, nested)
+	}
+}
+
+func fields(members *ast.Members) []Field {
+	var result []Field
+	for _, field := range members.Fields() {
+		result = append(result, Field{
+			Name:       field.Identifier.Identifier,
+			Type:       field.TypeAnnotation.String(),
+			Annotation: field.TypeAnnotation,
+			Access:     field.Access.Keyword(),
+			Variable:   field.VariableKind == ast.VariableKindVariable,
+			Optional:   isOptional(field.TypeAnnotation),
+		})
+	}
+	return result
+}
+
+func eventParameters(declaration *ast.CompositeDeclaration) []Field {
+	initializers := declaration.Members.Initializers()
+	if len(initializers) == 0 {
+		return nil
+	}
+
+	var result []Field
+	for _, parameter := range initializers[0].FunctionDeclaration.ParameterList.Parameters {
+		result = append(result, Field{
+			Name:       parameter.Identifier.Identifier,
+			Type:       parameter.TypeAnnotation.String(),
+			Annotation: parameter.TypeAnnotation,
+			Optional:   isOptional(parameter.TypeAnnotation),
+		})
+	}
+	return result
+}
+
+func isOptional(annotation *ast.TypeAnnotation) bool {
+	_, ok := annotation.Type.(*ast.OptionalType)
+	return ok
+}
+
+func typeNames(types []*ast.NominalType) []string {
+	names := make([]string, 0, len(types))
+	for _, t := range types {
+		names = append(names, t.String())
+	}
+	return names
+}
+
+func qualify(prefix, name string) string {
+	if prefix == "" {
+		return name
+	}
+	return prefix + "." + name
+}
+
+var importDeclaration = regexp.MustCompile(`(?m)^\s*import\s+(?:"(\w+)"|([\w\s,]+?)\s+from\b)`)
+
+// Imports returns the names of the contracts imported by the given program,
+// in order of appearance. Both string imports and imports from an address
+// or path are recognised.
+func Imports(code []byte) []string {
+	var names []string
+	seen := make(map[string]bool)
+	add := func(name string) {
+		if name != "" && !seen[name] {
+			seen[name] = true
+			names = append(names, name)
+		}
+	}
+
+	for _, match := range importDeclaration.FindAllSubmatch(code, -1) {
+		add(string(match[1]))
+		for _, name := range strings.Split(string(match[2]), ",") {
+			add(strings.TrimSpace(name))
+		}
+	}
+	return names
+}
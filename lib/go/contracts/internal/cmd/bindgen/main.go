@@ -0,0 +1,370 @@
+// Command bindgen generates Go bindings for the public structs and events of
+// the embedded contracts.
+//
+// Usage:
+//
+//	go run ./internal/cmd/bindgen -o bindings NFTStorefrontV2.cdc NFTStorefront.cdc
+//
+// Each contract is written to <o>/<lowercased contract name>/<lowercased
+// contract name>.go.
+package main
+
+import (
+	"bytes"
+	"flag"
+	"fmt"
+	"go/format"
+	"os"
+	"path/filepath"
+	"strings"
+	"text/template"
+
+	"github.com/onflow/cadence/ast"
+	"github.com/onflow/cadence/common"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/assets"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cdc"
+)
+
+func main() {
+	output := flag.String("o", "bindings", "directory to write the bindings to")
+	flag.Parse()
+
+	if flag.NArg() == 0 {
+		fail("no contracts given")
+	}
+
+	for _, name := range flag.Args() {
+		code, err := assets.Asset(name)
+		if err != nil {
+			fail("%s", err)
+		}
+
+		contract, err := cdc.Parse(code)
+		if err != nil {
+			fail("%s: %s", name, err)
+		}
+
+		source, err := generate(name, contract)
+		if err != nil {
+			fail("%s: %s", name, err)
+		}
+
+		pkg := strings.ToLower(contract.Name)
+		dir := filepath.Join(*output, pkg)
+		if err := os.MkdirAll(dir, 0o755); err != nil {
+			fail("%s", err)
+		}
+		if err := os.WriteFile(filepath.Join(dir, pkg+".go"), source, 0o644); err != nil {
+			fail("%s", err)
+		}
+	}
+}
+
+func fail(format string, args ...interface{}) {
+	fmt.Fprintf(os.Stderr, "bindgen: "+format+"\n", args...)
+	os.Exit(2)
+}
+
+// binding is a struct or event for which Go code is generated.
+type binding struct {
+	Name                string
+	QualifiedIdentifier string
+	Event               bool
+	Fields              []field
+}
+
+type field struct {
+	Name        string
+	GoName      string
+	GoType      string
+	Decode      string
+	Encode      string
+	CadenceType string
+}
+
+// mapping describes how a Cadence type is represented in Go. Encode and
+// CadenceType are expressions that may refer to the variable address.
+type mapping struct {
+	GoType      string
+	Decode      string
+	Encode      string
+	CadenceType string
+}
+
+func generate(filename string, contract *cdc.Contract) ([]byte, error) {
+	structs := make(map[string]string)
+	for _, d := range contract.Declarations {
+		if isPublicStruct(d) {
+			structs[d.QualifiedName] = goTypeName(contract.Name, d.QualifiedName)
+		}
+	}
+
+	var bindings []binding
+	for _, d := range contract.Declarations {
+		if !isPublicStruct(d) {
+			continue
+		}
+		bindings = append(bindings, newBinding(contract.Name, d.QualifiedName, false, d.Fields, structs))
+	}
+	for _, e := range contract.Events {
+		bindings = append(bindings, newBinding(contract.Name, e.QualifiedName, true, e.Parameters, structs))
+	}
+
+	var buf bytes.Buffer
+	err := bindingsTemplate.Execute(&buf, struct {
+		Filename string
+		Package  string
+		Contract string
+		Bindings []binding
+	}{
+		Filename: filename,
+		Package:  strings.ToLower(contract.Name),
+		Contract: contract.Name,
+		Bindings: bindings,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	source, err := format.Source(buf.Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("formatting generated code: %w", err)
+	}
+	return source, nil
+}
+
+func isPublicStruct(d *cdc.Declaration) bool {
+	return d.Kind == common.CompositeKindStructure && !d.Interface && d.Access == "access(all)"
+}
+
+func newBinding(contract, qualifiedName string, event bool, fields []cdc.Field, structs map[string]string) binding {
+	b := binding{
+		Name:                goTypeName(contract, qualifiedName),
+		QualifiedIdentifier: qualifiedName,
+		Event:               event,
+	}
+	for _, f := range fields {
+		m := mapType(contract, f.Annotation.Type, structs)
+		b.Fields = append(b.Fields, field{
+			Name:        f.Name,
+			GoName:      goFieldName(f.Name),
+			GoType:      m.GoType,
+			Decode:      m.Decode,
+			Encode:      m.Encode,
+			CadenceType: m.CadenceType,
+		})
+	}
+	return b
+}
+
+// goTypeName returns the Go name of a declaration: the qualified name
+// without the contract name, for example Listing.ResourceDestroyed becomes
+// ListingResourceDestroyed.
+func goTypeName(contract, qualifiedName string) string {
+	return strings.ReplaceAll(strings.TrimPrefix(qualifiedName, contract+"."), ".", "")
+}
+
+// initialisms are the lowercase prefixes of Cadence field names that are
+// written in upper case in Go.
+var initialisms = []string{"nft", "ft", "uuid", "id"}
+
+func goFieldName(name string) string {
+	var b strings.Builder
+	for len(name) > 0 {
+		word := nextWord(name)
+		name = name[len(word):]
+
+		upper := false
+		for _, initialism := range initialisms {
+			if strings.EqualFold(word, initialism) {
+				upper = true
+				break
+			}
+		}
+		if upper {
+			b.WriteString(strings.ToUpper(word))
+		} else {
+			b.WriteString(strings.ToUpper(word[:1]) + word[1:])
+		}
+	}
+	return b.String()
+}
+
+// nextWord returns the first camel case word of name. A run of upper case
+// letters is a single word.
+func nextWord(name string) string {
+	i := 1
+	if isUpper(name[0]) {
+		for i < len(name) && isUpper(name[i]) {
+			i++
+		}
+		if i > 1 {
+			return name[:i]
+		}
+	}
+	for i < len(name) && !isUpper(name[i]) {
+		i++
+	}
+	return name[:i]
+}
+
+func isUpper(c byte) bool {
+	return c >= 'A' && c <= 'Z'
+}
+
+var primitives = map[string]mapping{
+	"UInt8":   {"uint8", "cadenceconv.UInt8", "cadenceconv.EncodeUInt8", "cadence.UInt8Type"},
+	"UInt16":  {"uint16", "cadenceconv.UInt16", "cadenceconv.EncodeUInt16", "cadence.UInt16Type"},
+	"UInt32":  {"uint32", "cadenceconv.UInt32", "cadenceconv.EncodeUInt32", "cadence.UInt32Type"},
+	"UInt64":  {"uint64", "cadenceconv.UInt64", "cadenceconv.EncodeUInt64", "cadence.UInt64Type"},
+	"Int8":    {"int8", "cadenceconv.Int8", "cadenceconv.EncodeInt8", "cadence.Int8Type"},
+	"Int16":   {"int16", "cadenceconv.Int16", "cadenceconv.EncodeInt16", "cadence.Int16Type"},
+	"Int32":   {"int32", "cadenceconv.Int32", "cadenceconv.EncodeInt32", "cadence.Int32Type"},
+	"Int64":   {"int64", "cadenceconv.Int64", "cadenceconv.EncodeInt64", "cadence.Int64Type"},
+	"UFix64":  {"cadence.UFix64", "cadenceconv.UFix64", "cadenceconv.EncodeUFix64", "cadence.UFix64Type"},
+	"Fix64":   {"cadence.Fix64", "cadenceconv.Fix64", "cadenceconv.EncodeFix64", "cadence.Fix64Type"},
+	"Bool":    {"bool", "cadenceconv.Bool", "cadenceconv.EncodeBool", "cadence.BoolType"},
+	"String":  {"string", "cadenceconv.String", "cadenceconv.EncodeString", "cadence.StringType"},
+	"Address": {"cadence.Address", "cadenceconv.Address", "cadenceconv.EncodeAddress", "cadence.AddressType"},
+	"Type":    {"cadence.Type", "cadenceconv.Type", "cadenceconv.EncodeType", "cadence.MetaType"},
+
+	"StoragePath": {"cadence.Path", "cadenceconv.Path", "cadenceconv.EncodePath", "cadence.StoragePathType"},
+	"PublicPath":  {"cadence.Path", "cadenceconv.Path", "cadenceconv.EncodePath", "cadence.PublicPathType"},
+	"PrivatePath": {"cadence.Path", "cadenceconv.Path", "cadenceconv.EncodePath", "cadence.PrivatePathType"},
+	"Path":        {"cadence.Path", "cadenceconv.Path", "cadenceconv.EncodePath", "cadence.PathType"},
+}
+
+var anyStruct = mapping{"cadence.Value", "cadenceconv.Value", "cadenceconv.EncodeValue", "cadence.AnyStructType"}
+
+func mapType(contract string, t ast.Type, structs map[string]string) mapping {
+	switch t := t.(type) {
+	case *ast.NominalType:
+		name := t.String()
+		if m, ok := primitives[name]; ok {
+			return m
+		}
+		for _, qualifiedName := range []string{name, contract + "." + name} {
+			if goName, ok := structs[qualifiedName]; ok {
+				return mapping{
+					GoType:      goName,
+					Decode:      "Decode" + goName,
+					Encode:      fmt.Sprintf("func(v %s) cadence.Value { return v.Encode(address) }", goName),
+					CadenceType: goName + "Type(address)",
+				}
+			}
+		}
+		return anyStruct
+
+	case *ast.InstantiationType:
+		if t.Type.String() == "Capability" {
+			return mapping{"cadence.Capability", "cadenceconv.Capability", "cadenceconv.EncodeCapability", "cadence.NewCapabilityType(nil)"}
+		}
+		return anyStruct
+
+	case *ast.OptionalType:
+		m := mapType(contract, t.Type, structs)
+		return mapping{
+			GoType:      "*" + m.GoType,
+			Decode:      fmt.Sprintf("cadenceconv.OptionalOf(%s)", m.Decode),
+			Encode:      fmt.Sprintf("cadenceconv.EncodeOptionalOf(%s)", m.Encode),
+			CadenceType: fmt.Sprintf("cadence.NewOptionalType(%s)", m.CadenceType),
+		}
+
+	case *ast.VariableSizedType:
+		m := mapType(contract, t.Type, structs)
+		return mapping{
+			GoType:      "[]" + m.GoType,
+			Decode:      fmt.Sprintf("cadenceconv.ArrayOf(%s)", m.Decode),
+			Encode:      fmt.Sprintf("cadenceconv.EncodeArrayOf(%s, %s)", m.CadenceType, m.Encode),
+			CadenceType: fmt.Sprintf("cadence.NewVariableSizedArrayType(%s)", m.CadenceType),
+		}
+
+	case *ast.DictionaryType:
+		k := mapType(contract, t.KeyType, structs)
+		v := mapType(contract, t.ValueType, structs)
+		return mapping{
+			GoType:      fmt.Sprintf("map[%s]%s", k.GoType, v.GoType),
+			Decode:      fmt.Sprintf("cadenceconv.DictionaryOf(%s, %s)", k.Decode, v.Decode),
+			Encode:      fmt.Sprintf("cadenceconv.EncodeDictionaryOf(%s, %s, %s, %s)", k.CadenceType, v.CadenceType, k.Encode, v.Encode),
+			CadenceType: fmt.Sprintf("cadence.NewDictionaryType(%s, %s)", k.CadenceType, v.CadenceType),
+		}
+	}
+
+	return anyStruct
+}
+
+var bindingsTemplate = template.Must(template.New("bindings").Parse(`// Code generated by bindgen from {{ .Filename }}. DO NOT EDIT.
+
+// Package {{ .Package }} contains Go types for the structs and events of the
+// {{ .Contract }} contract.
+package {{ .Package }}
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+)
+
+// ContractName is the name of the contract.
+const ContractName = "{{ .Contract }}"
+{{ range .Bindings }}{{ $b := . }}
+// {{ .Name }} is the {{ .QualifiedIdentifier }} {{ if .Event }}event{{ else }}struct{{ end }}.
+type {{ .Name }} struct {
+{{- range .Fields }}
+	{{ .GoName }} {{ .GoType }}
+{{- end }}
+}
+
+// {{ .Name }}QualifiedIdentifier is the qualified identifier of {{ .Name }}.
+const {{ .Name }}QualifiedIdentifier = "{{ .QualifiedIdentifier }}"
+{{ if .Event }}
+// {{ .Name }}EventType returns the type ID of {{ .Name }} events emitted by
+// the contract deployed to the given address.
+func {{ .Name }}EventType(address cadence.Address) string {
+	return string(cadenceconv.Location(address, ContractName).TypeID(nil, {{ .Name }}QualifiedIdentifier))
+}
+{{ end }}
+// {{ .Name }}Type returns the Cadence type of {{ .Name }} for the contract
+// deployed to the given address.
+func {{ .Name }}Type(address cadence.Address) *cadence.{{ if .Event }}EventType{{ else }}StructType{{ end }} {
+	return cadence.New{{ if .Event }}EventType{{ else }}StructType{{ end }}(
+		cadenceconv.Location(address, ContractName),
+		{{ .Name }}QualifiedIdentifier,
+		[]cadence.Field{
+		{{- range .Fields }}
+			{Identifier: "{{ .Name }}", Type: {{ .CadenceType }}},
+		{{- end }}
+		},
+		nil,
+	)
+}
+
+// Decode{{ .Name }} decodes a {{ .QualifiedIdentifier }} value.
+func Decode{{ .Name }}(value cadence.Value) ({{ .Name }}, error) {
+	var result {{ .Name }}
+
+	{{ if .Fields }}fields{{ else }}_{{ end }}, err := cadenceconv.Fields(value, {{ .Name }}QualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+{{ range .Fields }}
+	result.{{ .GoName }}, err = cadenceconv.Field(fields, "{{ .Name }}", {{ .Decode }})
+	if err != nil {
+		return result, fmt.Errorf("{{ $b.QualifiedIdentifier }}: %w", err)
+	}
+{{ end }}
+	return result, nil
+}
+
+// Encode encodes v as a value of the contract deployed to the given address.
+func (v {{ .Name }}) Encode(address cadence.Address) cadence.{{ if .Event }}Event{{ else }}Struct{{ end }} {
+	return cadence.New{{ if .Event }}Event{{ else }}Struct{{ end }}([]cadence.Value{
+	{{- range .Fields }}
+		{{ .Encode }}(v.{{ .GoName }}),
+	{{- end }}
+	}).WithType({{ .Name }}Type(address))
+}
+{{ end }}`))
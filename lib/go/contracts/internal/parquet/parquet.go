@@ -0,0 +1,153 @@
+// Package parquet writes flat Parquet files with
+// github.com/xitongsys/parquet-go, from the columns and rows of the
+// exporters: a single row group of uncompressed, PLAIN-encoded pages.
+package parquet
+
+import (
+	"fmt"
+	"io"
+	"strings"
+	"time"
+
+	format "github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/writer"
+)
+
+// Kind is the type of a column and of its Go values.
+type Kind int
+
+const (
+	// String columns hold string values, as UTF-8 byte arrays.
+	String Kind = iota
+	// Uint64 columns hold uint64 values, as unsigned 64-bit integers.
+	Uint64
+	// Decimal columns hold uint64 values in units of 10^-Scale, as
+	// DECIMAL(20, Scale) fixed-length byte arrays.
+	Decimal
+	// Timestamp columns hold time.Time values, as milliseconds since the
+	// Unix epoch.
+	Timestamp
+	// Boolean columns hold bool values.
+	Boolean
+)
+
+// Column is a column of the schema.
+type Column struct {
+	Name string
+	Kind Kind
+	// Scale is the number of fractional digits of Decimal columns.
+	Scale int
+	// Optional columns also hold nil values.
+	Optional bool
+}
+
+const (
+	// decimalLength holds any uint64 as a big-endian two's complement
+	// number.
+	decimalLength = 9
+	// decimalPrecision is the number of digits of the largest uint64.
+	decimalPrecision = 20
+)
+
+// tag returns the parquet-go schema tag of the column.
+func (c Column) tag() string {
+	fields := []string{"name=" + c.Name}
+	switch c.Kind {
+	case String:
+		fields = append(fields, "type=BYTE_ARRAY", "convertedtype=UTF8")
+	case Uint64:
+		fields = append(fields, "type=INT64", "convertedtype=UINT_64")
+	case Decimal:
+		fields = append(fields,
+			"type=FIXED_LEN_BYTE_ARRAY",
+			"convertedtype=DECIMAL",
+			fmt.Sprintf("length=%d", decimalLength),
+			fmt.Sprintf("scale=%d", c.Scale),
+			fmt.Sprintf("precision=%d", decimalPrecision),
+		)
+	case Timestamp:
+		fields = append(fields, "type=INT64", "convertedtype=TIMESTAMP_MILLIS")
+	case Boolean:
+		fields = append(fields, "type=BOOLEAN")
+	}
+	if c.Optional {
+		fields = append(fields, "repetitiontype=OPTIONAL")
+	} else {
+		fields = append(fields, "repetitiontype=REQUIRED")
+	}
+	return strings.Join(fields, ", ")
+}
+
+// value returns the parquet-go value of a Go value of the column.
+func (c Column) value(value interface{}) (interface{}, error) {
+	if value == nil {
+		if !c.Optional {
+			return nil, fmt.Errorf("missing value")
+		}
+		return nil, nil
+	}
+
+	switch v := value.(type) {
+	case string:
+		if c.Kind == String {
+			return v, nil
+		}
+	case uint64:
+		switch c.Kind {
+		case Uint64:
+			return int64(v), nil
+		case Decimal:
+			b := make([]byte, decimalLength)
+			for i := decimalLength - 1; i > 0; i-- {
+				b[i] = byte(v)
+				v >>= 8
+			}
+			return string(b), nil
+		}
+	case time.Time:
+		if c.Kind == Timestamp {
+			return v.UnixMilli(), nil
+		}
+	case bool:
+		if c.Kind == Boolean {
+			return v, nil
+		}
+	}
+	return nil, fmt.Errorf("unexpected value of type %T", value)
+}
+
+// Write writes a Parquet file with the given columns and rows. Each row
+// holds one value per column, of the Go type of its Kind.
+func Write(w io.Writer, columns []Column, rows [][]interface{}) error {
+	values := make([][]interface{}, len(rows))
+	for r, row := range rows {
+		values[r] = make([]interface{}, len(columns))
+		for i, column := range columns {
+			if len(row) <= i {
+				return fmt.Errorf("column %s: row %d has %d values", column.Name, r, len(row))
+			}
+			value, err := column.value(row[i])
+			if err != nil {
+				return fmt.Errorf("column %s: row %d: %w", column.Name, r, err)
+			}
+			values[r][i] = value
+		}
+	}
+
+	tags := make([]string, len(columns))
+	for i, column := range columns {
+		tags[i] = column.tag()
+	}
+	pw, err := writer.NewCSVWriterFromWriter(tags, w, 1)
+	if err != nil {
+		return err
+	}
+	pw.CompressionType = format.CompressionCodec_UNCOMPRESSED
+
+	for _, row := range values {
+		if err := pw.Write(row); err != nil {
+			return err
+		}
+	}
+	return pw.WriteStop()
+}
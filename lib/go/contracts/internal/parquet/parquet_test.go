@@ -0,0 +1,106 @@
+package parquet_test
+
+import (
+	"bytes"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	format "github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/parquet"
+)
+
+var columns = []parquet.Column{
+	{Name: "name", Kind: parquet.String},
+	{Name: "count", Kind: parquet.Uint64, Optional: true},
+	{Name: "amount", Kind: parquet.Decimal, Scale: 8},
+	{Name: "at", Kind: parquet.Timestamp},
+	{Name: "ok", Kind: parquet.Boolean},
+}
+
+// read reads a file written by parquet.Write with an independent Parquet
+// implementation.
+func read(t *testing.T, rows [][]interface{}) *reader.ParquetReader {
+	t.Helper()
+
+	var b bytes.Buffer
+	require.NoError(t, parquet.Write(&b, columns, rows))
+
+	f, err := buffer.NewBufferFile(b.Bytes())
+	require.NoError(t, err)
+	r, err := reader.NewParquetColumnReader(f, 1)
+	require.NoError(t, err)
+	return r
+}
+
+func TestRoundTrip(t *testing.T) {
+	t.Parallel()
+
+	at := time.UnixMilli(1_700_000_000_123)
+	r := read(t, [][]interface{}{
+		{"first", uint64(1), uint64(1_50000000), at, true},
+		{"", nil, ^uint64(0), at.Add(time.Second), false},
+		{"third", uint64(1) << 63, uint64(1), at.Add(2 * time.Second), true},
+	})
+	require.EqualValues(t, 3, r.GetNumRows())
+
+	schema := r.Footer.Schema
+	require.Len(t, schema, len(columns)+1)
+	assert.Equal(t, format.ConvertedType_UTF8, schema[1].GetConvertedType())
+	assert.Equal(t, format.FieldRepetitionType_OPTIONAL, schema[2].GetRepetitionType())
+	assert.Equal(t, format.ConvertedType_UINT_64, schema[2].GetConvertedType())
+	assert.Equal(t, format.ConvertedType_DECIMAL, schema[3].GetConvertedType())
+	assert.EqualValues(t, 8, schema[3].GetScale())
+	assert.EqualValues(t, 20, schema[3].GetPrecision())
+	assert.Equal(t, format.ConvertedType_TIMESTAMP_MILLIS, schema[4].GetConvertedType())
+	assert.Equal(t, format.Type_BOOLEAN, schema[5].GetType())
+
+	column := func(name string) []interface{} {
+		for i, c := range columns {
+			if c.Name == name {
+				values, _, _, err := r.ReadColumnByIndex(int64(i), 3)
+				require.NoError(t, err, name)
+				return values
+			}
+		}
+		t.Fatalf("no column %s", name)
+		return nil
+	}
+
+	assert.Equal(t, []interface{}{"first", "", "third"}, column("name"))
+	// UINT_64 values are read as their two's complement int64.
+	assert.Equal(t, []interface{}{int64(1), nil, int64(-1 << 63)}, column("count"))
+
+	// Decimals are read as their big-endian two's complement bytes.
+	var amounts []string
+	for _, value := range column("amount") {
+		b := []byte(value.(string))
+		require.Len(t, b, 9)
+		require.Zero(t, b[0]&0x80)
+		amounts = append(amounts, new(big.Int).SetBytes(b).String())
+	}
+	assert.Equal(t, []string{"150000000", "18446744073709551615", "1"}, amounts)
+
+	assert.Equal(t, []interface{}{at.UnixMilli(), at.UnixMilli() + 1000, at.UnixMilli() + 2000}, column("at"))
+	assert.Equal(t, []interface{}{true, false, true}, column("ok"))
+}
+
+func TestEmpty(t *testing.T) {
+	t.Parallel()
+
+	r := read(t, nil)
+	assert.EqualValues(t, 0, r.GetNumRows())
+	assert.Len(t, r.Footer.Schema, len(columns)+1)
+}
+
+func TestInvalidRow(t *testing.T) {
+	t.Parallel()
+
+	err := parquet.Write(&bytes.Buffer{}, columns, [][]interface{}{{"short"}})
+	assert.EqualError(t, err, "column count: row 0 has 1 values")
+}
@@ -0,0 +1,61 @@
+// Package rlp implements the subset of the Recursive Length Prefix encoding
+// used by the canonical forms of Flow transactions: byte strings, unsigned
+// integers and lists.
+package rlp
+
+import "encoding/binary"
+
+// Bytes encodes a byte string.
+func Bytes(b []byte) []byte {
+	if len(b) == 1 && b[0] < 0x80 {
+		return []byte{b[0]}
+	}
+	return append(header(0x80, len(b)), b...)
+}
+
+// Uint encodes an unsigned integer as the big-endian byte string without
+// leading zeros.
+func Uint(v uint64) []byte {
+	return Bytes(bigEndian(v))
+}
+
+// List encodes a list of encoded items.
+func List(items ...[]byte) []byte {
+	size := 0
+	for _, item := range items {
+		size += len(item)
+	}
+
+	result := header(0xc0, size)
+	for _, item := range items {
+		result = append(result, item...)
+	}
+	return result
+}
+
+// BytesList encodes a list of byte strings.
+func BytesList(bs [][]byte) []byte {
+	items := make([][]byte, len(bs))
+	for i, b := range bs {
+		items[i] = Bytes(b)
+	}
+	return List(items...)
+}
+
+func header(offset byte, size int) []byte {
+	if size <= 55 {
+		return []byte{offset + byte(size)}
+	}
+	length := bigEndian(uint64(size))
+	return append([]byte{offset + 55 + byte(len(length))}, length...)
+}
+
+func bigEndian(v uint64) []byte {
+	var b [8]byte
+	binary.BigEndian.PutUint64(b[:], v)
+	i := 0
+	for i < len(b) && b[i] == 0 {
+		i++
+	}
+	return b[i:]
+}
@@ -0,0 +1,1315 @@
+// Code generated by go-bindata. DO NOT EDIT.
+// sources:
+// ../../../scripts/check_sale_cut_receivers.cdc (3.293kB)
+// ../../../scripts/example-nft/get_ids.cdc (463B)
+// ../../../scripts/example-token/get_balance.cdc (654B)
+// ../../../scripts/get_existing_listing_ids.cdc (1.139kB)
+// ../../../scripts/has_listing_become_ghosted.cdc (1.112kB)
+// ../../../scripts/inspect_listing.cdc (3.673kB)
+// ../../../scripts/is_ghost_listing.cdc (1.185kB)
+// ../../../scripts/read_all_unique_ghost_listings.cdc (2.229kB)
+// ../../../scripts/read_all_unique_ghost_listings_v2.cdc (1.812kB)
+// ../../../scripts/read_allowed_commission_receivers.cdc (668B)
+// ../../../scripts/read_duplicate_listing_ids.cdc (654B)
+// ../../../scripts/read_listing_details.cdc (588B)
+// ../../../scripts/read_listings_details.cdc (936B)
+// ../../../scripts/read_storefront_ids.cdc (396B)
+// ../../../transactions/buy_item.cdc (4.451kB)
+// ../../../transactions/cleanup_expired_listings.cdc (1.096kB)
+// ../../../transactions/cleanup_ghost_listing.cdc (978B)
+// ../../../transactions/cleanup_purchased_listings.cdc (870B)
+// ../../../transactions/example-nft/burn_nft.cdc (1.542kB)
+// ../../../transactions/example-nft/mint_nft.cdc (3.807kB)
+// ../../../transactions/example-nft/setup_account.cdc (1.431kB)
+// ../../../transactions/example-nft/transfer_nft.cdc (2.114kB)
+// ../../../transactions/example-token/mint_tokens.cdc (2.238kB)
+// ../../../transactions/example-token/setup_account.cdc (1.76kB)
+// ../../../transactions/flow-token/transfer_flow.cdc (1.739kB)
+// ../../../transactions/hybrid-custody/sell_item_in_child_from_parent.cdc (5.994kB)
+// ../../../transactions/hybrid-custody/setup/dev-setup/setup_nft_filter_and_factory_manager.cdc (4.923kB)
+// ../../../transactions/hybrid-custody/setup/linking/redeem_account.cdc (1.882kB)
+// ../../../transactions/hybrid-custody/setup/linking/setup_owned_account_and_publish_to_parent.cdc (3.111kB)
+// ../../../transactions/remove_item.cdc (879B)
+// ../../../transactions/remove_items.cdc (967B)
+// ../../../transactions/sell_item.cdc (7.765kB)
+// ../../../transactions/sell_item_and_replace_current_listing.cdc (8.543kB)
+// ../../../transactions/sell_item_with_marketplace_cut.cdc (7.902kB)
+// ../../../transactions/setup_account.cdc (1.033kB)
+// ../../../transactions-v1/buy_item.cdc (2.091kB)
+// ../../../transactions-v1/cleanup_item.cdc (695B)
+// ../../../transactions-v1/remove_item.cdc (689B)
+// ../../../transactions-v1/scripts-v1/read_listing_details.cdc (581B)
+// ../../../transactions-v1/scripts-v1/read_storefront_ids.cdc (396B)
+// ../../../transactions-v1/scripts-v1/verify_listed_nft_exists.cdc (634B)
+// ../../../transactions-v1/sell_item.cdc (3.68kB)
+// ../../../transactions-v1/setup_account.cdc (992B)
+// ../../../transactions-v1/utility/mint_example_nft.cdc (2.876kB)
+// ../../../transactions-v1/utility/setup_account_for_example_nft.cdc (1.326kB)
+
+package templates
+
+import (
+	"bytes"
+	"compress/gzip"
+	"crypto/sha256"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+)
+
+func bindataRead(data, name string) ([]byte, error) {
+	gz, err := gzip.NewReader(strings.NewReader(data))
+	if err != nil {
+		return nil, fmt.Errorf("read %q: %w", name, err)
+	}
+
+	var buf bytes.Buffer
+	_, err = io.Copy(&buf, gz)
+
+	if err != nil {
+		return nil, fmt.Errorf("read %q: %w", name, err)
+	}
+
+	clErr := gz.Close()
+	if clErr != nil {
+		return nil, clErr
+	}
+
+	return buf.Bytes(), nil
+}
+
+type asset struct {
+	bytes  []byte
+	info   os.FileInfo
+	digest [sha256.Size]byte
+}
+
+type bindataFileInfo struct {
+	name    string
+	size    int64
+	mode    os.FileMode
+	modTime time.Time
+}
+
+func (fi bindataFileInfo) Name() string {
+	return fi.name
+}
+func (fi bindataFileInfo) Size() int64 {
+	return fi.size
+}
+func (fi bindataFileInfo) Mode() os.FileMode {
+	return fi.mode
+}
+func (fi bindataFileInfo) ModTime() time.Time {
+	return fi.modTime
+}
+func (fi bindataFileInfo) IsDir() bool {
+	return false
+}
+func (fi bindataFileInfo) Sys() interface{} {
+	return nil
+}
+
+var _scriptsCheck_sale_cut_receiversCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x56\xdf\x6b\xe4\x36\x10\x7e\xf7\x5f\x31\xdd\x87\x62\xc3\xe2\x40\x29\x7d\x58\xce\x4d\x73\x09\x07\x81\x52\x8e\x34\xbd\x3e\x84\x3c\x68\xe5\xf1\x5a\xac\x56\x32\x92\x9c\x34\xe4\xf6\x7f\x2f\x92\x25\xf9\xe7\xc6\xc7\xe6\xc1\x91\xe7\xc7\x37\x9f\x66\xbe\x31\x3b\x35\x52\x19\xd8\x7c\x69\xc5\x81\xed\x39\x3e\xca\x23\x8a\x4d\x12\x8e\xff\xfa\xf2\xf8\xb7\x91\x0a\x2b\x25\x85\xf9\xf6\xcb\x26\x49\xae\xae\xae\xe0\xdf\x1a\x4d\x8d\x0a\x4c\x8d\xa0\x90\x22\x7b\x41\xa5\x81\x08\x90\x0d\x0a\xe0\x4c\x1b\x26\x0e\xd0\x90\x37\x0d\x52\x40\xd3\x2a\x5a\x13\x8d\x40\x89\x80\x3d\xc2\x5e\x2a\x25\x5f\xb1\xcc\x6d\xac\x84\x50\x8a\x5a\xa7\x84\xf3\x0c\xb4\x51\x2d\x35\xf0\x67\x17\xe0\x21\x46\x7e\x4f\x00\x00\x86\x96\x1c\x4d\xc8\xf3\x80\x5a\xb6\x8a\xe2\xfd\xdd\x0e\xfe\xb9\x17\xe6\xb7\x5f\x17\xad\x4b\x34\x84\x71\xbd\x83\x49\x49\xb9\xcf\x76\xd7\xbd\x77\xbe\x97\x6a\x04\x4a\x1a\xb2\x67\x9c\x99\x37\x90\x15\x20\xa1\x35\x68\xc2\x11\x68\x6b\xa6\xd5\x6d\x81\x09\x4b\x50\x0c\x28\x55\x89\xca\xba\x79\x24\xb9\xf5\xbc\x6d\x8d\xce\x17\xf1\xfa\xb7\x91\x84\x1d\x3c\x7d\x96\x92\x3f\xc7\x78\x8f\x35\x02\x29\x4b\x85\x5a\xa3\xb6\x71\x2d\xd2\x08\x90\xa1\x06\xc2\xb9\x45\x02\x46\x86\x0a\x2c\x20\xa0\xf2\x74\x62\x5a\x33\x29\xb6\x20\x55\x8c\x27\x18\x07\x56\x01\x11\x6f\xd6\x9a\x35\x0c\x85\x01\x16\xa3\x2c\xa3\xec\x63\x0d\x81\xde\x74\xb0\x9e\xaf\x67\x6c\x3a\xca\x3a\xac\x7a\x82\x76\xd6\x1d\x3f\x98\xef\x1b\xe1\xac\x0c\xec\x5c\x27\x2e\x25\x13\xcc\xa4\xee\xc9\xfe\x5d\x6c\x94\x6d\x34\xf9\xc1\xee\xe8\x1d\x2e\x5d\x4f\x6f\xb1\x42\xcd\x87\x86\x93\x9a\xac\x55\x06\xef\xd1\x43\x23\xaf\xf2\x59\x55\x50\xcc\x2b\x1d\xbb\xf8\x2a\xa1\x08\x3d\x38\x7e\x3d\xad\x09\x8a\x59\x99\x63\x87\x05\xe4\x50\x2c\xd5\xb3\xea\xe6\x0a\x5e\xf6\x75\xaf\x5c\x80\x73\x72\xee\xf4\xe7\xb1\x66\x1a\x34\x55\xac\x31\x40\x6b\xa4\x47\x6d\x1b\xaa\x1f\xc5\x30\xaf\x56\x93\x4a\xf7\x2a\x8c\x42\x1f\xbf\x37\x72\x21\x65\x15\xc8\x73\xb3\x44\x40\xc7\x2e\xd8\xc2\x6b\xcd\x68\x1d\x1a\xb4\x15\x9c\x89\x23\x96\x40\x2a\xe3\xf5\x21\x7a\xbe\xa2\x42\x17\x8e\x2a\x24\x06\xcb\x3c\x68\x99\x05\x48\x0c\x10\x85\xe0\xea\x13\x87\x6d\xd4\xc5\x12\xa4\x02\xfc\xaf\x61\xca\x06\x55\x08\xfa\xc8\x9a\x26\xe8\xa3\x8d\xf6\x47\x43\x14\x39\x59\x89\x90\xad\x30\xe0\x7b\x28\xcc\x7c\x38\xae\x25\x2f\xad\xee\xda\xb3\x1e\x3d\x28\xdf\x0c\xf9\x30\xd4\xac\x51\x34\x84\x67\xb8\xbf\x8b\x72\x12\x0b\x33\xb2\x23\x3a\x1f\xe9\x75\xd5\x0a\x38\x11\x26\x52\x0f\x61\x17\xa0\x6d\xe7\x9d\x68\x9b\xbf\x93\xe7\xe7\x6c\x07\x4f\x9e\x97\x78\xcb\xcf\xbe\xbd\xed\x90\xf7\xe0\x1f\xb0\x82\x02\x0e\x68\x6e\xba\x04\x21\x51\x96\x0f\xa5\x23\xef\x34\xf7\xd3\xcf\xef\xd3\xe9\xed\xff\xf9\xda\xee\x39\xa3\xe7\xdf\x7b\x55\xb0\xbf\x35\xfb\xaf\xc4\xd4\xd1\x21\x83\xeb\x6b\x68\x88\x60\x34\xdd\xdc\xca\x96\x97\x20\xa4\xf1\x7a\x05\x8d\x8b\x3f\xe4\xbd\x52\xf2\x14\x04\x7a\x93\x25\xb1\x3a\x21\x5f\xa1\xf0\x02\x94\x1e\xd0\xdc\xb6\x4a\xa1\x30\x9f\xb9\xa4\xc7\x34\xcb\x0d\x3b\xa1\x36\xe4\xd4\x64\xd1\x43\xa1\x6e\xb9\x59\x24\xad\x80\xa7\x6e\x23\x54\x52\xcd\x39\xb7\x2b\x68\x7e\x11\x03\x21\x19\xac\x51\x28\x06\xe0\x1f\xb0\xf2\xa4\xfa\x94\xe9\x2c\xcc\x6e\x1e\x39\x8b\x71\x59\x9c\x26\x28\x0a\xb7\x59\xfa\x9c\xf6\x47\xa5\x30\x4c\xb4\x18\x0f\xcf\xf1\x69\xb0\xab\x7b\x41\xfb\x29\x3f\xa0\xf1\x1a\x9c\x8e\xd2\x78\xd3\xbc\x1f\xa6\xef\xdf\x83\x7f\xee\x86\xea\x0d\x3e\x15\x8e\xf4\x55\x08\xf1\xf1\xa3\xfd\xdb\x53\x1e\x68\xf7\x96\x96\xec\x90\xd8\x1f\x0d\xa9\x5e\x5a\x1a\x39\x69\x1a\x14\x65\xea\xcf\xf3\x20\x49\xb9\x9b\xb5\x34\xcb\x96\xc0\xbd\x10\xb5\xb6\x5b\xc0\x71\xbe\xe6\x31\x5e\x32\x13\x1f\x7b\x83\x68\xe2\x27\xc4\xf8\x26\x6e\xba\xd3\xdb\x79\xcc\x74\xb8\xa6\x02\x95\xf1\x2b\x65\x80\x71\x4c\x63\xb0\x7c\x19\x42\x9a\x9b\x58\xb6\x03\x47\x96\xee\x80\x6e\x9c\xd2\xfe\x62\xca\x40\x71\xa4\xd6\xbf\xc9\x92\x89\x47\x97\x7b\x66\x3e\xbb\x89\x71\xb7\x5e\xd8\xdf\x50\xf4\x08\xd6\x6c\xc3\xea\x7b\x89\x7b\x6e\x72\xe1\xdd\xf8\x07\x64\x53\x0d\x18\x0b\xda\x6c\x26\x17\xc6\xb4\xff\xf2\x18\x7d\xf9\xf8\x87\xed\x87\x1d\xbb\x9b\x9d\x6c\xd7\xea\xdb\x2d\x1d\xae\x7a\xf9\xe6\xbc\xf4\x26\xba\xfb\x9b\xe9\x6e\x44\xa1\x69\x95\x00\x85\xba\xe5\x26\x39\x27\xff\x0f\x00\x45\x03\x6c\x9a\xdd\x0c\x00\x00"
+
+func scriptsCheck_sale_cut_receiversCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsCheck_sale_cut_receiversCdc,
+		"scripts/check_sale_cut_receivers.cdc",
+	)
+}
+
+func scriptsCheck_sale_cut_receiversCdc() (*asset, error) {
+	bytes, err := scriptsCheck_sale_cut_receiversCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/check_sale_cut_receivers.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf2, 0x78, 0x32, 0xae, 0xa1, 0x6e, 0xe6, 0x1e, 0x8a, 0x80, 0x1a, 0x15, 0x4, 0x2b, 0xd, 0xcf, 0x91, 0xe9, 0x8d, 0x70, 0x79, 0xbe, 0xe, 0xd5, 0xc0, 0x21, 0x25, 0x37, 0x52, 0x48, 0xe1, 0xa3}}
+	return a, nil
+}
+
+var _scriptsExampleNftGet_idsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x6c\x8f\x41\x6b\xeb\x30\x10\x84\xef\xfa\x15\x83\x0f\xef\xd9\xf0\x70\x2e\x8f\x1e\x42\xd3\x10\x92\x06\x72\x09\x21\x4d\x4f\xa5\x07\x59\x5e\x27\xa2\xf2\x4a\x48\x6b\xda\x12\xf2\xdf\x4b\x9b\xda\x09\xa5\xe8\xb2\x62\x67\xbf\x99\x19\x8d\x46\x78\x30\xd1\x06\x81\x78\xec\x49\xb0\x5e\xee\xb0\x5a\x24\x58\x86\x66\x68\x63\x7c\xc7\xf2\x37\xc1\x78\xe7\xc8\x88\xf5\xac\x94\x6d\x83\x8f\x82\x6c\xed\x79\xd9\xf1\xde\x56\x8e\x76\xfe\x85\x38\x1b\x36\xf7\x6f\xba\x0d\x8e\xd6\xcb\x5d\xa6\x94\x36\x86\x52\xca\xb5\x73\x05\x9a\x8e\xd1\x6a\xcb\xb9\xae\xeb\x48\x29\x8d\x31\x3b\x0f\xff\xae\x1c\x36\x5d\xe5\xac\xd9\x68\x39\x8c\x71\x99\x8b\x31\x9e\x1e\x57\x2c\x37\xff\x9f\x71\x54\x00\xe0\x48\xfa\x84\x98\x7c\xa6\x9f\x9d\x3f\x3d\xbc\x50\x83\xec\x02\xdf\x52\x83\x49\x7f\x56\x1a\x1d\x74\x65\x9d\x15\x4b\xa9\xac\x7c\x8c\xfe\xf5\xf6\xcf\xf1\x67\xb1\x72\x3e\x9c\x9f\xee\xf2\x2f\x68\xff\x7e\x4b\x3d\x08\x0a\x4c\xa7\x08\x9a\xad\xc9\xb3\xb9\xef\x5c\x0d\xf6\x82\xb3\x0d\x06\xeb\x77\x34\xd1\xb7\x57\x24\x68\x41\x0a\x64\x6c\x63\xa9\x46\xd0\x72\xc8\xbe\xab\x44\x92\x2e\xf2\x95\x74\x4b\x4d\xb9\x27\x59\x2d\x52\x5e\xa8\xd3\xc7\x00\x21\x70\x19\x24\xcf\x01\x00\x00"
+
+func scriptsExampleNftGet_idsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsExampleNftGet_idsCdc,
+		"scripts/example-nft/get_ids.cdc",
+	)
+}
+
+func scriptsExampleNftGet_idsCdc() (*asset, error) {
+	bytes, err := scriptsExampleNftGet_idsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/example-nft/get_ids.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x66, 0x3f, 0x90, 0xd, 0xd0, 0xec, 0x2, 0xdc, 0x1c, 0x2, 0x4, 0xe8, 0x22, 0x1b, 0xce, 0x29, 0xf8, 0xa4, 0xee, 0x10, 0x77, 0xc9, 0x37, 0xff, 0x37, 0x4f, 0xd9, 0x39, 0x8f, 0x4e, 0x22, 0xdb}}
+	return a, nil
+}
+
+var _scriptsExampleTokenGet_balanceCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x4f\x6b\xdc\x30\x10\xc5\xef\xfa\x14\xaf\x3e\xb4\x16\x14\xef\xa5\xf4\xb0\xa4\x59\xd2\xb4\x7b\x2b\xf4\xb0\xdd\xfb\xac\x3c\xce\x8a\xca\x92\x91\x46\xbb\x29\x21\xdf\xbd\xc8\xff\x68\xa0\x2d\x01\x61\xec\xf1\x1b\xcd\xef\xcd\xcc\x66\x83\xc3\xd9\x26\x24\x13\xed\x20\x88\x4c\x6d\x82\x9c\x19\x27\x72\xe4\x0d\xa3\xb3\xec\x5a\xb5\xd9\x20\x74\x20\x0f\x32\x26\x64\x2f\xef\x12\xbe\x3e\x52\x3f\x38\x3e\x84\x9f\xec\xf1\x79\x52\x2b\x65\xfb\x21\x44\x41\xb5\xcf\xfe\xc1\x9e\xe6\xdf\xd5\x1a\xfe\x33\xa9\xfa\xbb\xf8\x1b\x0b\xb5\x24\x74\xb4\x7c\x4d\x95\x52\x64\x0c\xa7\x54\x93\x73\x1a\x5d\xf6\xe8\xc9\xfa\x9a\xda\x36\x72\x4a\x5b\xdc\x4d\x2f\x7a\x8b\x1f\x7b\xfb\xf8\xf1\x03\x9e\x14\x00\x38\x16\x5c\x28\x3b\xf9\x42\x42\xf8\xf4\x02\xb6\x89\x9c\x82\xbb\xf0\x7d\xf0\x12\xc9\x48\x29\x54\x97\x58\x8e\x86\x0f\xbf\x06\xde\xc2\x5b\xf7\x1e\x17\xcb\xd7\xe9\xb3\x3c\x6f\xfe\x0d\xd9\xec\x0f\xc7\xa5\xd6\x6d\xad\x35\x28\xbd\xc1\xeb\xe4\xbb\x91\xb6\x9c\xdd\x0e\x03\x79\x6b\xea\xea\x3e\x64\xd7\xc2\x07\xc1\xc3\xe2\x02\xa5\x21\x23\x10\xba\x10\xc7\xf9\x98\x99\xbe\xd2\x6a\xbc\x23\xb2\xe4\xe8\x4b\xca\xdd\x34\xa2\xa5\x47\xba\x31\x34\xd0\xc9\x3a\x2b\x96\x53\x73\x0a\x31\x86\xeb\xcd\xdb\xa7\x17\x80\xcd\x3c\xc0\xe7\xdb\x7a\x25\x2a\x67\xed\x61\xd3\xcf\x16\xbe\x93\x9c\x57\x89\xde\x35\xf3\x9e\xfc\xd7\xc7\x54\x73\x59\x12\x44\xee\x38\x72\x59\x2e\x09\xa3\x97\x23\x65\x27\x95\x56\xcf\xbf\x07\x00\x89\xb0\xa4\x5a\x8e\x02\x00\x00"
+
+func scriptsExampleTokenGet_balanceCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsExampleTokenGet_balanceCdc,
+		"scripts/example-token/get_balance.cdc",
+	)
+}
+
+func scriptsExampleTokenGet_balanceCdc() (*asset, error) {
+	bytes, err := scriptsExampleTokenGet_balanceCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/example-token/get_balance.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x55, 0x87, 0x10, 0xbf, 0x2e, 0x48, 0x2b, 0xc, 0x6f, 0xe9, 0x63, 0x5b, 0x75, 0x4f, 0x8a, 0x74, 0xf2, 0x8d, 0xe7, 0x63, 0xba, 0x42, 0x9d, 0xd3, 0x43, 0xa2, 0x42, 0x42, 0xde, 0x96, 0xc, 0x50}}
+	return a, nil
+}
+
+var _scriptsGet_existing_listing_idsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xdf\x6a\xdb\x3a\x18\xbf\xcf\x53\xfc\xc8\xc5\xc1\x86\x1e\xb7\xe7\x30\x76\x11\xb6\x75\x5d\xd3\x80\x61\x84\xd2\x7a\xbb\x19\x83\x28\xd2\x67\x5b\x4c\x96\x3c\xe9\x73\x93\x50\x0a\x7d\x88\x3d\xc3\x1e\xac\x4f\x32\x2c\x37\x49\xd7\x94\x0d\x14\x14\x59\xdf\xf7\xd3\xef\x8f\xa4\x9b\xd6\x79\xc6\x78\x3e\x2b\xae\xd9\x79\x2a\xbd\xb3\xfc\xf9\xff\xf1\x68\x74\x7c\x7c\x8c\x2b\xe2\xce\xdb\x00\x61\x0c\x8c\x0e\xac\x6d\x05\x4f\xc1\x75\x5e\x12\xf2\x69\x00\x7b\x21\xbf\x91\x82\xb6\x58\xf4\x05\xa4\xe6\xb3\x22\x2c\x50\x3a\x0f\xae\x09\x95\xbe\x21\x8b\xf9\xac\x00\x6f\x5a\x82\xb0\x0a\xf9\x34\x8b\xd8\x45\xad\x03\x3c\x09\x15\xa0\xb4\x27\xc9\x66\x83\xd2\xbb\x26\xf6\xfd\x06\xa6\xad\xa2\x35\x92\x1b\x2d\xb0\xa8\x88\x2f\xd6\x03\x93\x8f\xc3\x94\x4f\xc3\x22\x3d\xc2\xaa\xd6\xb2\x86\x0e\x11\x5b\xc5\x2d\xc9\x03\x60\xdf\xb4\x2f\x4e\xd2\x05\x1e\xee\x7f\x40\xa0\xaa\x5d\x60\x90\x65\xbf\x81\xa1\x92\xb1\xa4\x5a\x5b\x85\xe5\x26\x72\xf8\xd0\x55\xf8\x0f\xa5\x5e\x63\xe5\x3a\xa3\x10\x6a\xb7\x8a\xe8\x5d\x8b\x9a\x3c\x81\x7a\x6d\xa2\x64\x1a\xb4\x6e\x0d\xaa\x45\xc0\x92\xc8\xc2\x53\xe3\x6e\x48\x3d\x92\x08\x64\xca\xec\xb1\x26\x2c\xa2\x07\xfd\x0f\xef\x5b\xe1\x45\x83\xb0\x73\xff\x4c\x29\x4f\x21\x60\x3b\xbb\x32\xc2\x0b\x29\x5d\x67\x19\xb5\x33\xaa\x3f\xa6\xff\xb6\x6f\xda\xc5\x92\x3d\x05\xb5\x25\x17\x9b\x96\x72\x45\x96\x75\xa9\xc9\x63\xd6\x19\xb3\xf9\xf7\x7b\x27\x4c\xbf\x56\x43\x2c\x7a\xbf\xff\x78\x58\x9f\x58\x42\x59\x95\x61\x7c\x96\x9d\x9c\x3c\xdc\xff\xbc\x58\x8b\xa6\x35\x34\x9f\x15\xd9\x7c\x56\x8c\xd3\xe7\xe7\xe4\x53\x5c\xed\x6f\xc6\x13\x9c\x6c\x24\xa4\xa4\x10\x12\x61\x4c\x8a\xb2\xb3\x68\x84\xb6\xc9\x81\xde\xc9\x56\xf0\xd1\x21\xed\x09\xae\xd9\x6b\x5b\xc5\xad\x7c\x3a\xc1\xa7\xdc\xf2\xeb\x57\xe9\x04\x5f\x86\x7f\x5f\x71\x3b\x02\x00\x43\xbc\xed\xc6\x5b\x9c\xbb\xa6\x75\x41\x33\xf5\xeb\xe4\x00\x35\x8d\x2d\xfd\x38\x3d\x45\x2b\xac\x96\xc9\xf8\x3c\x46\x6d\x1d\x43\x3a\x1b\xd8\x77\x92\x07\x8f\x62\x88\x7b\xa3\x26\x18\x67\xd2\x59\x29\xf8\x05\xdc\x74\x14\x91\x7d\x7c\x3d\xa8\x88\xcf\x86\xec\x0e\x45\xa7\x99\x14\xad\x58\x6a\xa3\x59\x53\xc8\x96\xce\x7b\xb7\x7a\xf3\xcf\xed\xb3\xe7\x98\xed\x17\x97\xdd\xd2\x68\x79\xf7\x2e\xd9\x91\xef\xc7\xdf\xea\x2f\x05\xd7\xbb\x86\xf4\x34\x7b\xf1\x19\x6d\x95\x4c\xb6\x16\xee\xec\x8e\xd3\x9f\xed\x1a\xa8\xa3\x8d\xf4\x9e\x5e\xcc\x68\x9c\x50\xca\x53\x08\xe3\x74\x74\x37\xfa\x35\x00\x22\x96\x91\x04\x73\x04\x00\x00"
+
+func scriptsGet_existing_listing_idsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsGet_existing_listing_idsCdc,
+		"scripts/get_existing_listing_ids.cdc",
+	)
+}
+
+func scriptsGet_existing_listing_idsCdc() (*asset, error) {
+	bytes, err := scriptsGet_existing_listing_idsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/get_existing_listing_ids.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7f, 0x2, 0xaa, 0x4, 0x5b, 0xa0, 0xc3, 0x30, 0x2f, 0x68, 0x36, 0x7, 0xda, 0x72, 0x1a, 0xd2, 0xa8, 0x5c, 0x13, 0x7e, 0x92, 0x35, 0xdb, 0x6a, 0x71, 0xcf, 0x26, 0xbe, 0x4c, 0x20, 0x3c, 0x15}}
+	return a, nil
+}
+
+var _scriptsHas_listing_become_ghostedCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x93\xd1\x6a\xdb\x30\x14\x86\xef\xf3\x14\x3f\xb9\xd8\x6c\x28\x0e\x8c\xb1\x8b\xb0\xad\xa4\x4b\x5b\x02\xa3\x0b\x6d\xba\xdb\x5a\x91\x4f\x62\x81\x22\x05\x9d\xe3\x64\xa3\x14\xf6\x10\x7b\xc2\x3d\xc9\xb0\xac\xc4\x6b\xbb\x6e\x84\x80\x2d\xeb\xff\xce\x7f\xfe\x23\x99\xcd\xd6\x07\xc1\xf0\xea\x62\x71\x23\x3e\xd0\x2a\x78\x27\x5f\xdf\x0c\x07\x83\xd1\x68\x84\xe9\xf9\xfc\xfa\xfc\xd3\x64\x71\x3e\x1d\x63\x51\x1b\x06\xeb\x60\xb6\x02\xad\xac\x65\x94\xb5\xe2\xcf\x86\xc5\xb8\xf5\x19\x69\xbf\xa1\xcb\xda\xb3\x50\x95\xe5\xe5\x09\xf6\xb5\x67\x42\x20\x69\x82\xc3\x4e\xd9\x86\xd0\xea\x69\xa3\x9c\x98\x56\xff\x3d\x56\x30\x6e\x47\x41\xa8\xc2\xaf\x1f\x3f\x61\x24\x09\x18\xa5\x84\x86\x4a\xec\x6b\x72\x90\x9a\x70\x75\xb1\x88\x7a\x31\xd6\x62\x1b\x88\xc9\x09\xb2\xab\x2f\x0b\x28\xac\xdb\xb2\xb0\x9d\x93\x1c\xca\x55\x28\x57\xca\x32\x95\xb1\xc4\x53\x86\x5a\x76\xe2\xd9\xcd\x33\x6d\x81\x5b\x26\x94\x86\xef\x22\xf3\x2e\xad\x17\xba\xd2\x25\x8c\x63\x21\x55\xb5\xad\x19\x5d\x47\x74\xca\xc1\x70\xec\x3c\x65\x91\xe5\x65\xf4\xf0\x52\x2b\x09\xda\x5a\x89\x55\xa8\x2a\x5a\x58\xfb\x7f\x14\xb2\x50\x0b\xdf\xd7\x24\x35\x85\xd8\xc0\x36\xf8\x9d\xa9\xa8\x42\x99\x18\xb3\x69\x89\xc6\x55\xcf\x3e\xf3\x71\x94\x25\x54\x55\x05\x62\x8e\xf8\x5a\xf1\xd3\x9e\x8b\x81\xd2\x9a\x98\x33\x65\x6d\x8e\x55\xe3\xb0\x51\xc6\x65\x3d\x61\xd2\xe9\xc7\x48\x0f\x27\x07\xe5\x6c\x3a\xc6\xed\xcc\xc9\xbb\xb7\xf9\x18\x67\xde\x5b\xdc\x0f\x00\xc0\x92\xa0\x97\xcf\x9b\xa5\x35\xfa\x9a\x56\xf8\x80\x35\xc9\x44\x6b\xdf\x38\x79\xce\xcf\x0b\xad\xb6\x6a\x69\xac\x11\x43\x5c\x2c\x7d\x08\x7e\xff\xfe\xd5\xfd\x93\x83\x59\xf4\x2f\x1d\xf9\xe1\x63\x16\xab\x1e\x7e\xff\xdb\x3f\x57\x52\x1f\x05\x39\x4e\x4f\xb1\x55\xce\xe8\x6c\x78\x69\x76\xe4\xa0\x3a\x7f\xa8\x3c\x31\x9c\x97\x18\x59\x6f\x16\x81\xd8\x37\x41\xd3\x30\x1f\x44\xca\x68\x84\x49\xcc\xef\xd1\x68\xfb\x99\xac\x23\xf5\x0f\x40\x2a\x70\x4c\x2a\x49\xba\x80\xfe\x12\x5b\x4a\xe2\x70\xb6\x8e\xdb\x3b\x1b\xed\x0c\xd2\xd2\x6c\x9a\xf7\x41\xf4\x6d\xcd\x0f\x87\xe2\xb8\x2d\xf6\xe6\x5e\x0b\xe8\x9b\x61\xf9\xa7\xd7\x6e\x34\xc3\x0e\x9c\xee\x72\xe2\xb4\xd6\x5e\xbc\xff\x83\x87\xdf\x03\x00\x20\xbf\x9b\xbb\x58\x04\x00\x00"
+
+func scriptsHas_listing_become_ghostedCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsHas_listing_become_ghostedCdc,
+		"scripts/has_listing_become_ghosted.cdc",
+	)
+}
+
+func scriptsHas_listing_become_ghostedCdc() (*asset, error) {
+	bytes, err := scriptsHas_listing_become_ghostedCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/has_listing_become_ghosted.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5f, 0x33, 0x20, 0x5c, 0x60, 0x89, 0x3f, 0xfc, 0x4f, 0x68, 0xd9, 0x81, 0xc4, 0x54, 0x79, 0x80, 0x8f, 0x9d, 0xf8, 0x60, 0x21, 0x85, 0xfb, 0xcb, 0x12, 0x67, 0xa3, 0x7c, 0x79, 0x39, 0x6c, 0x8d}}
+	return a, nil
+}
+
+var _scriptsInspect_listingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x4d\x6f\xe3\x36\x10\xbd\xfb\x57\x4c\x72\xd8\xb5\x00\x57\x01\x8a\xa2\x07\x23\x8e\x77\xbb\x86\x01\x03\x6d\xb0\x68\xdd\xdc\x69\x69\x64\x11\xa5\x49\x81\xa4\x62\x18\x8b\xfc\xf7\x62\x28\x52\xa4\x64\xb9\x29\xfa\x11\x09\x89\xc4\x19\x0e\xdf\x1b\x72\xde\x28\xfc\xd4\x28\x6d\xe1\xfe\x59\xc9\x6d\x2b\x8f\xfc\x20\x70\xaf\xfe\x40\x79\x3f\x0b\x96\x5f\xd0\xb2\x92\x59\xf6\xc2\xf1\x6c\xe2\xf0\xf3\x76\xff\x9b\x55\x1a\x2b\xad\xa4\x7d\xf9\xfe\x7e\x36\x7b\x78\x78\x80\x7d\x8d\x60\x2f\x0d\x1a\x60\x60\x7a\x33\x30\x59\x82\x92\x08\xaa\x02\x6e\x0d\x08\x6e\x2c\x97\x47\x03\x1a\x8d\x12\xaf\x08\x56\x2d\x9c\x8f\xad\x11\x9e\xb7\x7b\x17\x8a\x9e\xbd\x23\x9c\x55\x2b\x4a\x30\x28\x44\x4e\xb6\x19\x2b\x0a\x34\x66\xce\x84\xc8\xc0\x58\xdd\x16\x16\x7e\xee\x5c\x77\xd2\x34\x58\x58\xae\x24\x7c\x9b\x01\x00\xa4\xa8\x68\x7d\x0a\x9b\x20\x6b\xda\x83\xe0\xa6\xc6\x12\x98\x85\x11\xa7\x3c\xbe\x7c\x25\xb7\xe2\x2b\xb3\xf5\xa2\x8f\xaa\x34\x48\x2e\x80\xbb\x98\x1a\x81\x1b\x90\x4a\x62\xee\x1c\x52\x84\x02\x6d\xb2\xe4\xfe\xd2\xe0\x12\xe8\xf7\xfa\x26\xc0\xc0\x5b\xa3\x6d\xb5\xc4\x12\x0e\x17\x38\x28\xad\xd5\xd9\xd3\x5c\x0c\x57\x4f\xc2\xf7\x31\x1d\x1c\x0b\xec\x2f\x58\x51\x90\x9a\x91\x1f\x98\xb6\xa8\x43\xba\xa7\x19\x78\xe3\x18\xfe\xd8\xad\x44\xcb\xb8\x30\xcb\xab\x65\x3d\xf2\x4d\x67\x9f\xe0\x4e\x07\x60\xb7\x09\x29\x78\xde\xee\xd3\x54\x7c\x34\xd0\x68\xf5\xca\x4b\xd4\x50\x2b\x51\x9a\x34\x03\xdc\x82\x54\x7d\x40\xa1\xe4\x31\x78\x01\x93\x04\x04\xce\xdc\xd6\x7d\x38\xa4\x75\xa6\x49\xca\xca\xbe\x47\x50\x56\x76\xb7\x59\xc2\xef\x3b\x69\x7f\xfc\x61\x3d\x73\x61\xb8\xe4\x76\xee\x9e\xe8\x9e\xdc\xeb\x45\x6f\xbe\x4e\x64\xb4\xfd\xdd\xec\xc5\x19\x43\xc4\x83\xf1\x14\x26\x0d\x65\xbe\x24\xe8\x36\x28\xaa\x7c\x08\x14\x56\x23\xe4\x43\xe7\x04\x36\xac\x52\x12\x43\x37\xcf\x00\x56\x81\xcb\xd0\xec\xe1\xc2\x2a\x00\xbf\x32\xef\x36\x9d\x71\xb7\x71\xa6\xb7\xd9\x5b\x90\x16\x6e\xc0\x14\x9a\x37\x16\x34\x92\x08\x19\x38\xd7\xcc\x8e\x2b\x5a\x55\xb4\xe9\xac\x28\x54\x7b\x5b\x79\x5c\xc4\x54\x7d\x8c\x02\x4b\xc1\x0a\xc1\x51\x5a\x03\x05\x93\x44\x00\x8b\xb4\x74\x8d\x0b\x17\x62\x40\xa3\x0c\x15\x29\xeb\xa2\x8d\x76\x8c\x56\x35\x70\xc0\x4a\x69\x04\xd3\xea\x8a\x15\xe4\x6c\x6b\x3c\x75\x1a\x46\x73\x06\x35\x4d\x02\xa2\xa4\xb8\x40\xc1\x84\x40\x12\x4c\x38\xa2\x6c\xb9\x4c\xe9\x99\xa5\x53\xd0\x46\x19\xfb\x5d\xa1\x64\xc9\x9d\xd4\xb1\x03\xa5\xa3\x97\x4d\x9f\xa5\x4a\x69\x60\xf2\x02\x8a\xf4\x29\x50\x77\x3a\x13\x11\xec\x07\x25\xd6\xe1\xa1\x82\xe9\x94\xc7\xb8\x0a\xeb\xe2\xd0\x91\xa4\x34\x32\xd9\xc5\xa3\x38\x54\x83\xbb\x0d\x65\x4e\xd2\xc2\x14\x35\x54\x98\x92\xe8\xb3\xda\xd5\x32\x37\xf4\x28\xfd\x12\x58\x42\xa5\xd5\x89\x86\x68\xe3\x05\xea\x8f\x06\x0a\x25\x44\xa7\xdd\x8b\x8e\x71\xe9\x02\x9e\x6b\xd4\xe8\x3c\xa3\x03\x50\x47\x4a\xd5\x12\x4b\xb7\x8a\x03\x65\xd8\xc5\x44\x86\x9f\x1a\xa6\xd9\x29\x49\xe1\xe7\xb2\xd4\x68\x0c\x84\xbf\x3e\x4a\x38\x31\xa4\x2e\x7e\xa7\x92\x49\xae\x55\xb5\xba\xc0\x3c\x0d\xea\x33\xf7\xab\xb7\xed\x36\x10\x1e\x13\x25\xf3\x4e\x60\x15\xf0\xae\x3b\xe5\x83\x06\x56\xb5\x12\x4e\x8c\xcb\xf9\x15\xc4\x65\xc0\xb8\xb8\x5e\x29\x94\x76\xb6\xbc\xd9\xfa\x86\x7d\x07\x56\x70\x44\xfb\xb9\x63\x79\xbd\x56\x96\x17\xac\x61\x07\x2e\xb8\xe5\x68\xf2\x6e\x9b\x1e\x3f\x7c\x1b\x0b\x51\x7c\xe9\x7a\xe2\xdb\x53\x14\x3e\xba\xde\xf3\xa7\x1e\xda\x4f\xc8\xdc\x13\xaf\x06\x30\x57\xee\xd0\x45\xa9\xea\x8e\xe2\x35\xcb\x84\x03\x09\xc9\x92\xa6\xf5\x89\x4a\x46\xbc\x0e\xf9\x37\x2f\x3b\xf1\x8d\x52\x29\xb9\xc8\xbc\xda\x4c\x64\xce\x0b\x56\x1c\xb8\xcb\x8f\xe8\xa2\xcc\x27\x08\xd0\x38\xdc\xad\x9c\x1e\x3f\x7e\xba\x9d\x8e\xa7\x79\xf6\x4f\x48\x0e\xdf\xff\x43\xbe\x3e\xd0\x88\xe8\x40\xa1\xe6\x13\xa7\xf0\x6a\xa8\x4f\x89\xb7\xfc\x8b\x0d\xfd\xdf\xb8\xfa\x69\xb1\x8f\xb9\x1d\xf5\xcd\xd5\x6f\xea\x2b\xd3\x74\x3c\x52\x9f\x5e\x1e\xe3\xbe\xcb\x6a\xe2\xc4\x52\xe1\x45\xad\xda\x90\x54\xad\x60\xf0\x1d\x9d\xfb\xde\xf3\x45\x49\xab\x59\x61\x69\x70\xab\xd5\x89\x78\xee\x4a\x94\x96\x57\x1c\xf5\xb0\xb2\x82\x02\x0d\x7d\x96\x21\x07\xa1\xb5\xe6\xbc\xb7\xc5\xaf\x01\xba\x5e\x39\x9e\xe3\xa7\xc2\xe3\x10\xcf\xf3\x76\xff\x65\x80\xf8\xc9\x73\xa4\x3b\x03\x66\xd6\xf0\xce\x84\xde\x9b\x57\x63\xf2\x77\xe3\x04\x5d\x27\xc9\xab\x53\x6b\x6b\xaf\x50\x8f\xac\xb5\xf5\xfc\x27\x97\xf1\x17\x26\x5a\xcc\xe0\x83\x37\x3d\x4d\xa9\x17\x0d\xb1\x23\x26\xc2\x35\xfa\x8f\x26\x8f\x70\xc7\x9a\x15\x7e\xa8\x25\x2d\x47\xe0\xef\x42\xe4\x81\x70\x85\x2b\xe6\xc8\x7f\x73\xc1\x2a\x99\xbf\x4e\x4e\x4c\xb2\x4b\xbb\x4d\x06\xeb\x35\xa5\xa4\x9f\xfd\x96\x9e\xd0\x9b\xf5\xd1\xbb\xbf\x53\x28\xbd\x9f\x3f\xb9\x34\xd8\x57\x6a\x22\x5e\xf1\x7c\x78\x74\xfd\x61\x8a\x96\x58\x53\x95\x5d\x4f\x4d\x0d\x65\x46\x66\x5e\xce\x00\x00\xb2\xd9\xdb\xec\xcf\x01\x00\x52\x88\xa6\xbc\x59\x0e\x00\x00"
+
+func scriptsInspect_listingCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsInspect_listingCdc,
+		"scripts/inspect_listing.cdc",
+	)
+}
+
+func scriptsInspect_listingCdc() (*asset, error) {
+	bytes, err := scriptsInspect_listingCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/inspect_listing.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x53, 0x72, 0xf5, 0x34, 0x31, 0xc6, 0xf1, 0x5f, 0xa1, 0x2b, 0xfc, 0xe9, 0x1e, 0xdf, 0xa8, 0x22, 0xd7, 0x9c, 0x91, 0x35, 0x90, 0xd0, 0x4e, 0x95, 0x2a, 0xbe, 0x66, 0xdc, 0xd4, 0x37, 0xc, 0xc}}
+	return a, nil
+}
+
+var _scriptsIs_ghost_listingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x53\xdd\x6a\xdb\x4a\x10\xbe\xf7\x53\x7c\xf8\xe2\xc4\x86\xa0\xc0\xa1\xf4\x22\xb4\x4d\x53\x4c\x82\xa1\x04\x93\xa6\xbd\x8d\xd6\xab\x91\x35\x74\xbd\x2b\x76\x46\x4a\x4b\x08\xf4\x21\xfa\x84\x7d\x92\xa2\x95\x64\x3b\x71\xa0\x18\x63\x6b\x66\xf6\xfb\xd3\x0e\x6f\xeb\x10\x15\xd3\x9b\xab\xbb\x2f\x1a\x22\x95\x31\x78\xfd\xf6\xff\x74\x32\x39\x3b\x3b\xc3\x2d\x69\x13\xbd\x20\xd7\xd8\x50\x0e\x2e\xa1\x15\xc1\xb1\x28\xfb\x0d\x1e\x58\xab\x54\xd8\x70\x4b\x1e\xf9\x50\x5f\x2e\x72\x34\xbe\xa0\x78\xd8\x93\x1d\xf8\x65\x51\x44\x12\xc9\x13\x01\x0b\x0c\x36\x55\x10\xdd\xa1\xfe\xf9\xf5\x1b\x9c\x51\x96\x4e\x27\x1c\xf7\xb3\x63\xbb\xb9\xba\x03\x0b\x7c\x80\x0b\x7e\x43\x11\x75\x24\x21\xaf\x60\x9f\x46\x85\x9c\xa3\x78\x22\xb0\xc1\x39\xb2\xca\xc1\x27\x0a\xe3\x8b\x67\xaa\xad\xf1\x3e\x28\xd6\x84\xba\x89\xb6\x32\x42\x45\xb6\x37\x5a\x1a\x27\x7b\xa7\x03\xa7\x28\x3b\x07\xd3\x1a\x76\x66\xed\x28\xeb\x70\xbb\x2f\xee\xaa\xae\x6b\x23\xd7\x8a\x46\x48\x90\xb3\x5c\x77\x6e\x3e\xf7\x64\xb3\x79\x7e\x8a\x87\x8a\x6d\x85\xca\x74\xca\x62\x24\xab\x10\xda\x1a\xaf\x6c\x25\xc3\x2a\x52\x99\x92\x62\x41\x68\xd3\x3f\x4a\xd0\x05\xd5\x91\xac\x51\x2a\x90\x57\x46\xee\x07\xf9\xf7\x6b\xb2\x61\x4b\xf7\x29\x33\x2a\x32\x5b\xd8\x44\x11\xe4\x59\x58\x65\xe3\x53\x02\x88\x83\x31\xe3\xc1\xbe\xa5\xd8\xe1\xb5\xc6\x35\x07\x26\x3e\xd6\x26\x9a\x2d\x8e\xde\x10\xc6\xdf\xd0\x87\x61\xac\x0d\x8d\x57\x54\xc1\x15\xdd\x0b\xe9\x6a\xfb\x43\x88\x24\xa1\x89\xb6\x07\x1e\x41\x07\xd5\xcb\x05\x6e\x87\x36\x96\x8b\x11\x70\x68\x42\x03\x6c\x45\xf6\x7b\x36\x31\xd6\x92\xc8\xcc\x38\x37\x47\xd9\x78\x6c\x0d\xfb\xd9\x91\xb0\xf3\x51\xd9\xe9\x08\xb1\x5c\x9c\xe3\xeb\xd2\xeb\xdb\x37\xf3\x73\x7c\x0a\xc1\xe1\x71\x02\x00\x8e\xf4\x40\xe2\xaa\x59\x3b\xb6\xb7\x54\xe2\x3d\x36\xa4\x97\xbd\x9f\x63\xfc\x79\x66\x4d\x6d\xd6\xec\x58\x99\x24\x5b\x87\x18\xc3\xc3\xbb\xff\x1e\x5f\xec\x48\xb6\x7f\xe8\x91\x9f\x3e\xcc\x12\xeb\xf8\xf9\xd7\xfc\xca\x68\xb5\x3b\x30\xc7\xc5\x05\x6a\xe3\xd9\xce\xa6\xd7\x69\x67\xc6\xbc\x8b\x40\xdd\xb5\x57\x54\xa6\x25\x98\xd7\x32\x9f\xce\x27\x3b\xbf\x43\x24\xbd\xcd\x57\xcc\x0f\x7e\xc6\x1b\xba\x1b\xef\x91\xba\x24\x87\xd2\x72\x31\xdf\xa9\xdb\x6b\x5b\xc5\xd0\x72\x41\xc5\x7e\x2a\x09\xf4\x27\x0a\xfa\xc1\xa2\x47\xab\x7f\x20\xd7\xf4\xf9\x8e\x6a\xfb\xbb\x39\x02\x75\xd2\x5e\x6e\xcf\xe4\x69\xf2\x77\x00\xa8\x22\xa0\xef\xa1\x04\x00\x00"
+
+func scriptsIs_ghost_listingCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsIs_ghost_listingCdc,
+		"scripts/is_ghost_listing.cdc",
+	)
+}
+
+func scriptsIs_ghost_listingCdc() (*asset, error) {
+	bytes, err := scriptsIs_ghost_listingCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/is_ghost_listing.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x24, 0xd8, 0xdf, 0xa, 0x77, 0x15, 0x6b, 0xd6, 0x27, 0x35, 0xa4, 0xcb, 0xce, 0xae, 0x7a, 0x3d, 0x6b, 0x24, 0x39, 0x9f, 0x7f, 0x3f, 0x72, 0x57, 0xcc, 0xfc, 0xf4, 0xd5, 0xe0, 0x93, 0xc5, 0xb4}}
+	return a, nil
+}
+
+var _scriptsRead_all_unique_ghost_listingsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x56\x4d\x6f\xe3\x36\x10\xbd\xfb\x57\x4c\x72\x68\x25\xc0\x90\x8b\x45\xd1\x83\xd1\xec\xd6\xad\xb3\x0b\x03\x45\x1a\xa4\x6e\x2f\x8b\x85\x45\x93\x23\x69\xb0\x34\xa9\xe5\x50\x36\x8c\x20\xff\xbd\xa0\xbe\xfd\xd5\x8d\x12\x20\x26\x67\xde\x9b\xf7\x66\x46\x30\xed\x4a\xeb\x3c\xdc\x3f\x7d\x5c\xff\xed\xad\xc3\xcc\x59\xe3\xff\x7d\x77\x3f\x99\xcc\x66\x33\x58\x3e\x3e\xbf\x3c\xfe\xb1\x58\x3f\x2e\xe7\xb0\x2e\x88\x81\xa5\xa3\xd2\xc3\xc1\xba\xaf\x0c\xd2\x3a\x87\xd2\xeb\x23\x6c\x2b\x0f\x0e\x35\x21\x83\x35\x90\x16\x82\xff\x24\xf6\x64\xf2\xdf\x51\xda\x1d\x7e\x2a\x2c\x7b\x54\x51\x9c\x4e\xe1\x50\x90\x2c\xa0\x10\x5c\x13\x90\xd9\xa3\xf3\xa8\xc0\xa1\xaf\x9c\x01\xc6\x9d\x30\x9e\x24\x43\xd4\x9c\x30\xa4\xde\x55\x98\xc2\xa1\x40\x03\x4f\x7f\xad\x21\x6f\xc0\xa6\x90\x66\x42\x73\x77\xd3\x9e\xc6\x09\xac\x0b\x6c\xcb\xac\x19\xa4\xdd\x95\x68\x58\x78\x64\x20\xe3\xd1\x19\xa1\xf5\x11\x0e\xe4\x0b\x48\xef\xd2\x69\x5d\xbb\x14\x5a\xa3\x63\xe0\xc2\x56\x5a\xc1\x8e\x72\x27\x3c\x82\xb7\x90\x3a\x14\x6a\x23\xb4\xde\x54\x86\xbe\x55\xb8\xa9\x89\x36\xba\x91\xc7\x9b\xfd\xbb\x44\x2a\x99\x4e\x6b\xae\x46\x5b\xc5\xc8\x90\x12\xd7\xaa\x5b\x1f\xa2\x38\x05\x61\x54\xd0\x0d\x52\xa3\x70\xe8\x06\xad\x49\x48\x0e\x7f\x27\x1e\x97\xce\xee\x49\x21\x83\x2f\x10\x84\x73\xe2\x08\x36\x83\x96\x18\x1c\xb2\xad\x9c\x44\x58\xa9\xd6\xd2\xdc\xfa\xce\x05\x58\x79\x10\x95\xb7\x3b\xe1\x29\x48\x3b\x02\x7f\xa5\xb2\x41\x52\x55\xa9\x49\x06\x75\x2d\x54\x4d\x2c\xf8\xf2\x82\xe1\x50\xbb\x91\xe3\x39\x9a\x42\x8d\x1e\xc1\x1a\x89\x35\x66\xe9\x68\x27\xdc\x11\xac\xc1\x41\xcb\x6f\xa5\x70\x62\x07\xdc\x4f\x15\x2c\x94\x72\xc8\x1c\x64\x84\xac\xd1\x4d\xaf\xe6\x50\x58\xc6\x46\xc6\x50\x46\x28\xe0\x5b\x85\x8e\x50\x35\xf0\x42\x4a\x64\x8e\x84\xd6\x31\x64\x95\x81\x9d\x20\x13\x0d\x70\x2d\xcf\xbc\x23\x8c\xe7\xf0\xf9\x9f\x95\xf1\xbf\xfc\xfc\x05\x5e\x27\x13\x00\x80\xbd\x70\x83\xe0\xb6\x47\x3c\x0a\x7b\x80\xcf\x5f\xfa\xc0\x7c\xd4\xc8\x8b\xa0\x3a\x4a\xa3\x1f\xc9\x79\xae\xb6\x9a\xe4\x0b\x66\xf0\x00\x39\xfa\x85\x94\xb6\x32\xfe\xb2\xc0\x38\x91\xa2\x14\x5b\xd2\xe4\x09\x39\xd9\x5a\xe7\xec\xe1\xd7\x1f\x5e\xcf\x76\x31\x19\x3e\x34\xc8\x6f\xef\xa3\x9a\xb5\x7b\xbe\x17\xff\x2c\x7c\xd1\x27\xc4\xf0\xe1\x03\x94\xc2\x90\x8c\xee\x3f\xd1\x1e\x0d\x88\xa6\x3e\x50\x16\x19\x8c\xf5\xf5\x90\x0e\xc5\xf6\xa3\x76\x1f\x37\xde\xcd\x66\xb0\xa8\x1b\x00\x42\xeb\xba\xff\x7d\xa7\x2a\xa3\xd0\xd5\x47\x79\x0d\x3d\x42\x69\x59\x7a\xbb\xc4\x5e\x90\x16\x5b\xdd\xb9\xbf\x52\x0c\x0f\xd7\x4c\x4c\x72\xec\xcc\x5f\x2d\x39\x8a\xbb\x1a\x56\x1e\xeb\x25\xb5\x7b\x74\x03\xda\x30\x35\x61\xdd\x32\x32\x0a\x6c\xe5\xdb\x15\x69\xef\x20\x13\x5a\x77\xc5\x9e\xcc\x1a\x84\x71\xc8\xad\x3b\x26\x35\x4b\x66\x1d\x90\x02\x32\x57\xab\x7d\xed\x3d\xed\xff\xa1\x0c\xee\x2e\xc6\x2a\x91\xd6\x78\x41\x86\x23\x52\xf1\xb5\xac\xce\x92\xb6\x88\x17\xcc\x6e\x38\xd1\x4c\x48\x8b\x1b\xf5\xe1\xcd\xee\xac\x96\x73\x20\x15\xdf\x9d\xc0\xce\x66\xf0\x64\x3d\xc2\xcd\x37\x72\xfb\xe2\xe5\xe0\x09\x23\x50\xb3\x9a\x4f\x1f\xd7\x40\x61\x18\x40\x5b\x93\x9f\xf8\x1b\x3c\x61\xa1\xf1\x9c\x86\x12\x4c\x80\xfc\x8f\x0c\xe2\xcc\x53\xca\x1a\xf0\x93\x8c\xe0\x54\x2f\x20\x4b\x6e\xd7\x37\xf8\xd5\xfd\x9c\xac\x63\x22\xca\x12\x8d\x0a\xd6\x5e\x04\x8e\x3c\x5d\xa2\x17\xa4\xc3\x84\x8d\x48\x73\xf4\xed\x79\x3b\x55\xe3\x27\x24\xab\xaa\xec\x78\x6e\xcf\xe6\xf2\xac\xdf\x61\x48\x2f\xd0\xba\xc7\x64\x7e\x7d\x2c\x71\x7e\x56\x57\xd2\x9e\x4f\xff\x2f\x71\xb5\xbc\x96\xb6\x5a\xde\x4e\x6a\xa3\x43\x22\xa9\xab\x51\x97\xba\x29\x1b\xcb\x4e\x34\x9a\xdc\x17\xf0\x1e\x7e\xba\xd2\x89\xf0\x7b\x39\xee\x4d\x47\x16\x5a\x47\x23\xa0\x4b\xa2\xb7\xc9\xf5\x4f\xcd\xf9\x5b\xf3\xae\x69\xbf\x16\x9c\xb4\x7c\xf2\xf6\xdf\x00\x08\xb7\xa1\x58\xb5\x08\x00\x00"
+
+func scriptsRead_all_unique_ghost_listingsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsRead_all_unique_ghost_listingsCdc,
+		"scripts/read_all_unique_ghost_listings.cdc",
+	)
+}
+
+func scriptsRead_all_unique_ghost_listingsCdc() (*asset, error) {
+	bytes, err := scriptsRead_all_unique_ghost_listingsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/read_all_unique_ghost_listings.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0xa3, 0x5c, 0x31, 0x7, 0x33, 0x1d, 0xc1, 0x4b, 0xd4, 0x4d, 0x41, 0xae, 0xde, 0x8d, 0x70, 0xe4, 0x6e, 0xec, 0xfc, 0x7f, 0xa3, 0x5e, 0xb8, 0x28, 0x20, 0x2c, 0x60, 0xcd, 0x64, 0x54, 0x58}}
+	return a, nil
+}
+
+var _scriptsRead_all_unique_ghost_listings_v2Cdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x55\xef\x6b\xe4\x36\x10\xfd\xbe\x7f\xc5\x5c\x3e\xb4\x36\x04\xa7\x94\xd2\x0f\xa1\xb9\x6b\x60\xb9\xc3\x50\x8e\x90\xa6\xfd\x72\x1c\xc9\x44\x1a\xdb\x03\xb2\xe4\x6a\xe4\x4d\x97\x90\xff\xbd\x48\xfe\x11\x67\xed\x50\xd6\xcb\xae\xe5\xf7\xde\xbc\x79\x23\xed\x72\xdb\x39\x1f\xe0\xec\xeb\xe7\xbb\x3f\x83\xf3\x54\x79\x67\xc3\xdf\x3f\x9f\xed\x76\x17\x17\x17\x70\x4b\xa1\xf7\x56\x20\x34\x04\x86\x25\xb0\xad\xc1\x93\xb8\xde\x2b\x82\x72\x2f\xe0\x2a\x40\x63\xa0\xb7\xfc\x4f\x4f\x50\x37\x4e\xc2\x04\x14\xe8\xad\x26\x9f\xb8\x35\x1f\xc8\x82\xcc\x05\x8a\xa4\x7e\xfd\x96\x00\x2c\xe0\x2c\xc1\x53\x43\x9e\x12\x2d\x09\x98\x63\xac\xfa\xf5\xf3\x5d\x7c\x6e\x1d\x18\x67\x6b\xf2\xd0\x79\x12\xb2\x01\xd8\x26\xa8\x90\x31\xe4\x7f\x14\x50\xce\x18\x52\x81\x9d\x1d\x8a\xec\xfb\xce\xb0\xc2\x30\x37\x20\x90\x85\xc6\x09\x81\x34\xe8\xa3\x74\xa2\x63\x4b\xa9\x46\x38\x76\x04\x68\x35\x94\x7b\x40\x01\xb4\x2e\x34\xe4\x27\x6e\x0e\xe8\x09\xe8\x5f\x65\x7a\x4d\xfa\x3c\x15\x10\xb6\x2a\xd9\x3d\xa6\x87\xca\x10\x5a\xd2\xd0\x77\x80\x7d\x70\x2d\x06\x56\x68\xcc\x31\xb6\x35\x58\xed\x3c\xb7\xe8\x8f\x93\x66\x6c\xcb\x53\xeb\x0e\xa4\x93\xe3\xf8\x86\xbb\x86\x05\x44\x79\xee\x02\xf4\x42\x02\x0f\x2c\x5f\x62\x58\x7f\x0c\xa4\x2c\x7f\x38\x87\xa7\x86\x55\x03\x0d\xc6\xa6\xbd\x27\x15\x40\xa8\x45\x1b\x58\x49\x01\x37\x9e\xaa\x94\x7e\x4c\xf5\x90\xbe\x51\x92\xd6\xd4\x79\x8a\x81\x68\x78\xf0\x84\xfa\x1e\x8d\xb9\x1f\x06\x78\x9f\xe6\x71\x3f\x1a\x93\x42\x69\xf5\xf0\xea\xe9\xf7\x0e\x3d\xb6\x8b\x29\x5e\x6b\xed\x49\x04\xa6\x4f\x57\xc5\x22\x80\x4a\xb9\xde\x06\x68\x9c\xd1\x73\xbe\x33\x69\xde\x3f\xc5\x0e\x95\x22\x91\x0c\x8d\xc9\xa1\xea\x2d\xb4\xc8\x36\x5b\xc9\x5f\x4e\xfa\xf9\x25\x7c\xfb\xab\xb4\xe1\xd7\x5f\xbe\xc3\xf3\x6e\x07\x00\x70\x40\x0f\x7a\x1a\xf0\x18\x8d\x2c\x60\x57\xf0\xed\xfb\x0c\xac\x17\xf9\xad\x40\x09\x65\x28\x2c\x9c\xde\xf4\x8f\x86\xd5\x2d\x55\x70\x05\x35\x85\xeb\xa1\xad\xb5\xc1\xbc\x50\xd8\xe1\x23\x1b\x0e\x4c\x52\x3c\x3a\xef\xdd\xd3\x6f\x3f\x3c\x9f\x1c\xa9\xe2\xf5\x66\x50\x7e\xf9\x98\xa5\xaa\xd3\xeb\xff\xf0\x37\x18\x9a\x99\x90\xc3\xa7\x4f\xd0\xa1\x65\x95\x9d\x7d\x49\xa7\x6b\x8a\x5d\x3b\x8a\xe7\x24\x40\x83\x07\x02\xdc\x8a\xfe\x2c\x7f\xed\x17\x0f\xc8\x06\x1f\xcd\x14\x5f\xa9\x05\xae\xb6\x52\x28\x6a\x9a\xd2\x2b\xf7\x92\x8d\x12\x95\xf3\xc0\x3a\x9e\xc2\x2d\xa1\xe7\xd9\x2f\x57\xf0\x61\x35\xa9\x42\x39\x1b\x90\xad\x64\xac\xf3\x05\x78\xf2\x36\x6e\xc4\x5b\xaa\xde\xb1\x34\x64\x3d\xca\x65\x33\x7c\xe8\xb2\xdc\x5f\x02\xeb\xfc\xc3\x1b\x59\xae\x16\xaa\xc5\xe9\xa1\x3a\xf1\x10\xaf\x37\xbb\xa6\xc0\xae\x23\xab\xa3\xdd\x15\x70\x61\x78\x4f\x01\xd9\xc4\x1c\x17\xb5\x6a\x0a\xe3\x7a\xb6\x4d\xd6\x7d\x37\xd5\x79\x7f\x02\xf3\xcf\xd9\x08\x8d\xa3\x58\xa9\xc5\xcb\x56\xe1\xee\xd8\xd1\xe5\x89\xa7\x62\x5c\x3f\x7f\x8f\x54\xee\xb7\x28\xe5\x7e\x9b\x30\x22\x23\x89\xf5\x0a\xb1\xee\x93\xab\x65\x9b\x85\x21\x5b\x87\x06\x3e\xc2\x4f\x1b\xc9\xc7\x6b\xbd\x65\x86\x09\x5c\x1b\x93\x2d\x84\xd6\x85\x5e\x76\xdb\x77\xc3\xfa\xcb\xb0\x79\x7d\xfa\x7b\x7b\x3b\xe2\xdd\xcb\xee\xbf\x01\x00\xc7\x11\x4e\x4b\x14\x07\x00\x00"
+
+func scriptsRead_all_unique_ghost_listings_v2CdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsRead_all_unique_ghost_listings_v2Cdc,
+		"scripts/read_all_unique_ghost_listings_v2.cdc",
+	)
+}
+
+func scriptsRead_all_unique_ghost_listings_v2Cdc() (*asset, error) {
+	bytes, err := scriptsRead_all_unique_ghost_listings_v2CdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/read_all_unique_ghost_listings_v2.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x47, 0xb6, 0xde, 0xdc, 0xcf, 0xd0, 0x1b, 0x9c, 0x9f, 0xaa, 0x61, 0xc4, 0x84, 0xfc, 0x6c, 0x1e, 0x77, 0xfe, 0xe1, 0xe2, 0x86, 0x43, 0xec, 0xc6, 0xfd, 0x96, 0x93, 0x70, 0x3d, 0xf2, 0xa6, 0x96}}
+	return a, nil
+}
+
+var _scriptsRead_allowed_commission_receiversCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x52\x4d\x6b\xdb\x40\x10\xbd\xeb\x57\x3c\x74\x08\x12\x14\x19\x4a\xe9\xc1\x24\x31\xc1\x21\x60\x28\x21\xb8\x6e\x2f\xa5\x87\xd5\x6a\x64\x0d\x5d\xed\x8a\x9d\x51\x4c\x30\xfe\xef\xc5\x52\xa4\xd4\x6d\x21\x08\x04\xd2\xcc\x9b\xf7\x31\xc3\x6d\x17\xa2\x22\x7d\x7c\xd8\x7d\xd5\x10\xa9\x8e\xc1\xeb\xf7\x8f\x69\x32\x15\x1e\x7a\xbf\xe7\xd2\xd1\x2e\xfc\x22\x9f\x26\xc9\x62\xb1\xc0\xae\x61\x81\xd8\xc8\x9d\x22\x92\xf6\xd1\x0b\xb4\x21\x38\x16\x45\xa8\x61\x9c\x0b\x07\xaa\x60\x43\xdb\xb2\x08\x07\x8f\x48\x96\xf8\x99\xa2\x40\xfa\xee\x3c\x99\x2a\x94\x2f\x03\x6a\xcf\xcf\xe4\x07\x2c\xfb\x3d\x36\x55\x71\xe6\x48\x8c\xb5\x24\x92\x19\xe7\x72\xd4\xbd\x47\x6b\xd8\x67\xc6\xda\xd0\x7b\x5d\xe2\xae\xaa\x22\x89\x7c\x98\x60\x5b\x92\xd0\x47\x4b\x9b\xfb\x25\xbe\x6d\xbc\x7e\xfe\x94\x2f\xf1\x63\x6d\x3a\x53\xb2\x63\x7d\xb9\xbe\x3a\x5e\x18\x29\xb6\xaf\x7a\x4e\xb7\x3f\x57\x38\x26\x00\xe0\x48\x21\x73\x06\x5b\xaa\x71\x83\x3d\xe9\xdd\xc8\x39\x71\xe7\x85\x9d\xa6\x32\x49\x51\x86\x18\xc3\xe1\xfa\xea\xf8\x57\x80\xc5\xdb\xc7\x53\x5f\x3a\xb6\xa7\xdb\x6c\x60\x99\x9e\xf7\xfa\x9f\x8c\x36\x33\x20\xc7\x6a\x85\xce\x78\xb6\x59\xba\x0e\xbd\xab\xe0\x83\x62\xe4\x46\x37\xcc\xff\x43\x3a\xea\x18\x5a\x98\x31\xa2\x34\x4f\x66\x77\x53\xc6\x37\x97\x3e\x5f\x4d\x7c\x19\xab\xd9\x7f\x22\xfd\xe7\x57\x3e\x4b\x7b\x13\xf6\x18\xc0\x4a\x2d\x0e\xac\x0d\xb4\x31\x8a\xcd\x7d\x3a\x36\x0e\xaf\xf1\x52\xa6\x8d\x15\xe7\x68\xc7\x3b\x59\xcf\x67\x32\x6d\x45\xb2\x3c\x39\x25\xbf\x07\x00\xa7\x4c\x94\x9c\x9c\x02\x00\x00"
+
+func scriptsRead_allowed_commission_receiversCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsRead_allowed_commission_receiversCdc,
+		"scripts/read_allowed_commission_receivers.cdc",
+	)
+}
+
+func scriptsRead_allowed_commission_receiversCdc() (*asset, error) {
+	bytes, err := scriptsRead_allowed_commission_receiversCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/read_allowed_commission_receivers.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb9, 0xec, 0x55, 0xcd, 0x77, 0x59, 0x71, 0x4f, 0xc2, 0x1d, 0x5c, 0x74, 0xd6, 0x15, 0x41, 0x26, 0x7d, 0xee, 0xa2, 0xf7, 0x5e, 0x82, 0x3f, 0xaa, 0xf5, 0xc8, 0x34, 0x23, 0x68, 0x36, 0xab, 0x7d}}
+	return a, nil
+}
+
+var _scriptsRead_duplicate_listing_idsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\x41\x8b\xd4\x40\x10\x85\xef\xf9\x15\x8f\x1c\x24\x81\x25\x03\x22\x1e\x82\x3a\x2c\x3b\x08\x03\x22\x0b\x3b\x7a\x11\x0f\x3d\x9d\x4a\xa6\xa0\xa7\xba\xa9\xae\x28\xc3\xb2\xff\x5d\x36\x21\x19\xd4\x85\xa5\x0f\xdd\xd5\x54\x7d\xd4\x7b\x8f\xcf\x29\xaa\xa1\xfc\xfa\xf9\xf0\x60\x51\xa9\xd7\x28\xf6\xfd\x6d\x59\x14\x9b\xcd\x06\x87\x13\x67\x64\xaf\x9c\x0c\x4a\x36\xaa\x64\x38\x81\x53\x75\x17\xc4\x1e\x2e\x04\xd8\x89\xd0\x8d\x29\xb0\x77\x46\x08\x9c\x8d\x65\xd8\xef\x32\xfa\xa8\x70\x18\xf8\x17\x09\xa4\xb7\xfd\xae\x79\x66\x16\xce\x7b\xca\xb9\x72\x21\xd4\xe8\x47\xc1\xd9\xb1\x54\xce\xfb\x38\x8a\xb5\xb8\xed\x3a\xa5\x9c\x6f\xe6\x89\x16\xdf\xf6\x62\xef\xdf\xdd\x5c\xb9\xd7\x2f\xe9\xed\x70\x49\xb4\xef\x48\x8c\x7b\x26\x6d\xf1\x60\xca\x32\xd4\x2d\x7e\xcc\x4d\x3f\xf1\x58\x00\x40\x20\x5b\xda\xf1\x11\x77\xf1\x9c\x62\x66\xa3\xe7\xba\xfa\x0f\x53\x63\xbb\x45\x72\xc2\xbe\x2a\xef\xe2\x18\x3a\x48\x34\xf8\x28\xd9\x74\xf4\x06\xbb\x24\x42\xaf\xf1\x0c\x5e\x67\x50\x36\x3e\x8a\x77\xf6\x02\xae\x2e\xa6\x1d\x66\xff\x30\x90\xdd\xce\x62\x17\xd1\x75\xe3\x5d\x72\x47\x0e\x6c\x4c\xb9\x39\x46\xd5\xf8\xfb\xc3\x9b\xc7\x7f\x22\x69\xae\xc5\xfd\x78\x0c\xec\x9f\x3e\x55\x13\x78\x39\xaf\xf5\xdf\x3b\x3b\xad\x03\xf5\xb6\x19\xc8\x76\x4b\x6e\x5f\xd6\xd8\x16\x01\xed\x62\xd8\x1a\xc5\x74\xfd\x95\xc4\xfa\xac\x57\xee\x4b\xd6\xcd\x8a\x90\xa6\xad\x91\xd7\xb5\x66\x13\x5d\xd7\x29\xe5\x5c\xd6\xc5\x53\xf1\x67\x00\x9a\x8d\x7f\x39\x8e\x02\x00\x00"
+
+func scriptsRead_duplicate_listing_idsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsRead_duplicate_listing_idsCdc,
+		"scripts/read_duplicate_listing_ids.cdc",
+	)
+}
+
+func scriptsRead_duplicate_listing_idsCdc() (*asset, error) {
+	bytes, err := scriptsRead_duplicate_listing_idsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/read_duplicate_listing_ids.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x36, 0x11, 0x3a, 0x82, 0x3d, 0x53, 0x40, 0x75, 0x35, 0xf9, 0x4a, 0xca, 0xdb, 0xf4, 0xde, 0x7e, 0x35, 0xcd, 0xb6, 0x9d, 0xa8, 0xd8, 0x2c, 0x67, 0x2e, 0x1, 0xf3, 0x77, 0x1e, 0xd, 0x3b, 0x8b}}
+	return a, nil
+}
+
+var _scriptsRead_listing_detailsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x92\xcd\x6a\xeb\x30\x10\x85\xf7\x7e\x8a\x83\x17\x17\x0b\x2e\x0e\x5c\x2e\x5d\x84\xa6\x21\x34\x14\x02\x25\x84\x34\xed\x5e\x91\xc7\xf1\x80\x22\x19\x69\x4c\x16\x21\xef\x5e\x1a\xff\xe4\xa7\x85\x22\x10\x48\x8c\xce\x77\xe6\x8c\x78\x5f\xfb\x20\x48\x97\x2f\x9b\x37\xf1\x81\xca\xe0\x9d\x7c\xfc\x4b\x93\x64\x34\x1a\x61\x53\x71\x44\x34\x81\x6b\x41\x20\x69\x82\x8b\x90\x8a\x50\x90\x68\xb6\x11\xa5\x0f\xd0\xb0\x1c\x85\xdd\x0e\x07\x96\x8a\x1d\x34\xe2\xa0\xf4\xa5\x92\x68\x63\x28\xc6\x4c\x5b\xab\x50\x36\x0e\x7b\xcd\x2e\xd3\xc6\xf8\xc6\xc9\x18\xb3\xa2\x08\x14\xe3\xdf\x5e\x66\x4d\xd1\x37\xc1\xd0\x62\x3e\xc6\xfb\xc2\xc9\xc3\x7f\x35\xc6\x9d\xbd\xfc\xb5\xad\x9d\x77\x3e\x8e\x09\x00\x58\x92\x2b\xf4\x9a\x4a\x4c\xb0\x23\x99\xb5\xa4\x9e\xa8\x72\xa3\x6b\xbd\x65\xcb\xc2\x14\xf3\xad\x0f\xc1\x1f\x1e\xff\x1c\xef\x11\x97\xc3\xaa\xd9\x5a\x36\xa7\xa7\xec\x4c\xe9\xd7\x6f\xf5\x2b\x2d\xd5\xf0\x40\x61\x3a\x45\xad\x1d\x9b\x2c\x7d\xf6\x8d\x2d\xe0\xbc\xa0\x65\xa3\x3e\xeb\x5f\x59\x47\x19\xfc\x1e\xba\x0d\x26\x55\x43\x73\x7d\xd0\x93\xdb\x36\xbb\x1e\xba\x4c\xb2\x1f\x72\xfc\x76\xa5\x06\x67\x17\x5f\x4b\x7f\x33\x49\x48\xa5\x05\x8b\x79\xc7\x3f\x6f\xed\x1f\xe8\xcb\xf2\x1d\x49\x37\x81\x4c\x25\xa7\xe4\x73\x00\x9f\x36\xa2\x2d\x4c\x02\x00\x00"
+
+func scriptsRead_listing_detailsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsRead_listing_detailsCdc,
+		"scripts/read_listing_details.cdc",
+	)
+}
+
+func scriptsRead_listing_detailsCdc() (*asset, error) {
+	bytes, err := scriptsRead_listing_detailsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/read_listing_details.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x5c, 0xc9, 0x74, 0xfc, 0x9a, 0x4e, 0x17, 0x94, 0x91, 0x5e, 0xee, 0xaa, 0xf8, 0x69, 0xc, 0xaa, 0xd1, 0x3e, 0xea, 0xe, 0xb5, 0x77, 0xf4, 0x52, 0x59, 0x6, 0xbe, 0xec, 0xf5, 0xf0, 0x90, 0x22}}
+	return a, nil
+}
+
+var _scriptsRead_listings_detailsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x93\x41\x8b\xdb\x30\x10\x85\xef\xfe\x15\x8f\x1c\x8a\x0d\xc5\x81\x52\x7a\x30\x4d\xb7\x4b\x43\x21\xd0\x96\x65\xbb\xed\x65\xd9\x83\x22\x8f\x93\x01\x45\x32\xd2\x98\x52\x42\xfe\x7b\xb1\x25\x7b\xdd\x75\xa0\x14\xcb\x46\x96\x66\xde\x37\xf3\x64\xf3\xa9\x75\x5e\xb0\xfa\xf6\xf9\xe1\xbb\x38\x4f\x8d\x77\x56\x7e\xbe\x59\x65\xd9\x7a\xbd\xc6\xc3\x91\x03\x82\xf6\xdc\x0a\x3c\x49\xe7\x6d\x80\x1c\x09\x35\x89\x62\x13\xe0\x1a\x18\x0e\xc2\xf6\x30\xcc\x15\xc2\xa4\x81\xfd\xef\x71\x0f\x9e\x82\xeb\xbc\x26\xec\xb6\xe5\xa0\xfb\x95\x43\x60\x7b\x78\x4e\x56\x9e\x60\xa8\x11\xb8\x4e\x86\x90\xfe\xc6\xc7\x56\x79\x75\x82\xd2\xda\x75\x56\x70\x5b\xd7\x9e\xc2\x40\xea\x8b\x18\x97\x8f\xce\xd4\xbd\x58\xbf\x36\xe3\x8f\xd0\x72\x2e\x95\x80\xf7\x69\x6f\xb7\x0d\x18\xe7\xd8\x6d\x27\xe9\xa9\x2e\x71\xf0\xa4\xea\x32\x53\x5a\x53\x08\xb9\x32\xa6\x40\xd3\x59\x9c\x14\xdb\x3c\x55\x50\x8d\x95\xbd\xbe\xa2\x5f\xe1\xf1\xc7\xce\xca\xbb\xb7\x4f\x45\x85\x73\x9c\x56\x78\x61\x77\xf9\x25\xe6\x6d\xa3\xaf\x17\x9c\x33\x00\x30\x24\xb3\x8e\xee\xa9\xc1\x06\x07\x92\xdb\x88\x1d\xf1\x45\xa9\x55\xab\xf6\x6c\x58\x98\x42\xb9\x77\xde\xbb\x5f\xef\x5f\x9d\x5f\x32\x9e\x5f\xee\xba\xbd\x61\x7d\xf9\x90\x0f\x94\xf1\xfa\x57\xfc\x9d\x92\xe3\x94\x50\xe0\xe6\x06\xad\xb2\xac\xf3\xd5\x27\xd7\x99\x1a\xd6\x09\x22\x1b\xed\xa0\x3f\x3f\x8c\xc6\xbb\x13\x54\x74\x69\x55\x64\x53\x77\xe9\x43\xfa\x0f\x67\x36\x38\x5f\x86\xf4\xc6\xf9\xa5\xdd\x60\x7b\xe5\x0c\x92\x9d\xfd\xe0\x66\xe0\xa6\x18\x6c\xfe\xf6\x37\x99\x97\x98\xf9\x42\xa9\x5a\x8a\x17\x33\xf1\x7e\xa4\x8e\x1e\x17\x81\x4f\xd8\x8c\xd9\xe5\x81\x24\x35\x94\x17\x53\x76\x6c\x2b\x3e\xe3\xcf\x86\x9a\x44\xb1\x09\xd9\x25\xfb\x33\x00\x56\x92\x14\x20\xa8\x03\x00\x00"
+
+func scriptsRead_listings_detailsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsRead_listings_detailsCdc,
+		"scripts/read_listings_details.cdc",
+	)
+}
+
+func scriptsRead_listings_detailsCdc() (*asset, error) {
+	bytes, err := scriptsRead_listings_detailsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/read_listings_details.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa7, 0xed, 0xe9, 0x2f, 0xf1, 0xe6, 0xd7, 0xd6, 0x5d, 0xb4, 0x64, 0x5d, 0xe3, 0x8c, 0x3b, 0x54, 0x10, 0xec, 0x2b, 0x90, 0x8a, 0x73, 0xcb, 0xb6, 0x16, 0xc8, 0x99, 0xb2, 0x31, 0xdc, 0x22, 0xe0}}
+	return a, nil
+}
+
+var _scriptsRead_storefront_idsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x8f\x41\x4b\xc3\x40\x10\x85\xef\xfb\x2b\x1e\x39\x48\x72\x49\x41\xc4\x43\x11\x4b\x51\x84\x82\x48\xc1\xea\x45\x3c\x4c\x37\xbb\xc9\xc2\x76\x37\xcc\xcc\x22\x52\xfa\xdf\x85\xd6\xa6\xe0\x45\xe6\x34\xcc\x7b\xdf\x9b\x17\x76\x63\x66\x45\xf5\xf2\xb4\x79\xd5\xcc\xce\x73\x4e\xfa\x7e\x5d\x19\x33\x9b\x61\x33\x04\x81\x58\x0e\xa3\x82\x9d\x16\x4e\x02\x4a\x20\x66\xfa\x46\xf6\xa0\x18\xa1\x83\x43\xf2\x8a\x52\x42\x27\xf0\x99\x21\x14\x1d\x74\xe0\x5c\xfa\x01\x84\x0b\xd6\x18\xb2\xd6\x89\xd4\x14\x63\x03\x5f\x12\x76\x14\x52\x4d\xd6\xe6\x92\x74\x8e\x65\xd7\xb1\x13\x69\xe6\xf8\x78\x5b\x25\xbd\xbd\xf9\xc4\xde\x00\xf8\xcd\x46\xef\x74\x79\xd2\x9e\x3d\x4d\x6b\x69\xa4\x6d\x88\x41\x83\x93\x76\x9b\x99\xf3\xd7\xdd\xd5\xfe\x4f\x9b\xf6\xb2\xac\xcb\x36\x06\x7b\xb8\xaf\x8f\xe0\xf3\xfc\xa7\x5f\x93\x0e\x93\xa1\x59\xb4\xbd\xd3\xe7\x20\x1a\x52\xbf\x7a\x94\xba\x99\x4e\x8b\x05\x46\x4a\xc1\xd6\xd5\x43\x2e\xb1\x43\xca\x8a\xd3\x53\x18\x8f\xc1\x90\x89\x0c\xcf\x79\x07\xea\x3a\x76\x22\x55\x63\x0e\xe6\x67\x00\xd0\x30\x8d\x6a\x8c\x01\x00\x00"
+
+func scriptsRead_storefront_idsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_scriptsRead_storefront_idsCdc,
+		"scripts/read_storefront_ids.cdc",
+	)
+}
+
+func scriptsRead_storefront_idsCdc() (*asset, error) {
+	bytes, err := scriptsRead_storefront_idsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "scripts/read_storefront_ids.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x8e, 0x86, 0xe7, 0xe, 0xd0, 0xbb, 0xb3, 0x27, 0x27, 0x76, 0x4c, 0x83, 0x4c, 0x66, 0x98, 0x85, 0x24, 0xe, 0xf5, 0x2a, 0xc1, 0x9e, 0xd3, 0x0, 0x34, 0x71, 0xe2, 0x79, 0x8, 0x4c, 0x6d, 0x65}}
+	return a, nil
+}
+
+var _transactionsBuy_itemCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x57\x5d\x6f\xdb\xc6\x12\x7d\xf7\xaf\x18\xeb\xc1\x21\x01\x87\x0a\x2e\x2e\xee\x83\xe0\x8f\xeb\xc8\x50\x61\xa0\x35\x8c\x44\x75\x5f\x02\x14\x2b\x72\x24\x6d\x43\xed\x12\xbb\x43\xa9\x8a\xe1\xff\x5e\xcc\x92\xdc\xe5\x4a\x54\xec\x97\x56\x6a\x90\x90\x33\xb3\x33\x67\xce\x9c\x59\xc9\x4d\xa5\x0d\xc1\x68\x56\xab\x95\x5c\x94\x38\xd7\xdf\x51\x8d\xce\x06\x1f\xff\x86\x24\x0a\x41\xe2\x59\xe2\xce\x06\x9b\x47\xad\x4e\x78\x3f\xce\xe6\x5f\x49\x1b\x5c\x1a\xad\xe8\xf9\x3f\xe1\xc5\x41\xa4\xb3\xf1\x78\x0c\x73\x23\x94\x15\x39\x49\xad\x60\x29\x72\x59\x4a\x12\x84\x16\x68\x8d\x50\xd5\x26\x5f\x0b\x8b\xa0\x97\x50\x4a\x4b\x58\xc0\xe3\x6c\x9e\x39\xbf\x07\x02\x12\xdf\x5b\x43\xeb\x8f\x03\x51\x14\x06\xad\xbd\x74\x0e\x52\xad\xc0\xa0\xd5\xb5\xc9\x11\x68\x2d\x08\x14\x62\x01\xa4\x61\x81\x2e\x4a\x77\x42\x01\x17\x20\x3a\xdf\xc6\x72\x27\xcb\xd2\x1d\x21\x76\x62\xef\x4e\xc9\xf5\x66\x23\xad\x95\x5a\xb9\x14\xf8\x7f\xf8\x5c\xef\xd1\x70\x7e\x6c\xd0\x1d\x99\x5c\xca\x0c\x33\xa8\x55\x81\xa6\xe4\x07\x8f\xb3\x79\x0a\x3b\x5d\x97\x05\x88\x9a\xd6\xda\xc8\x1f\xcd\xf9\x42\x15\x60\xe5\x4a\xb9\xf8\xd4\x83\x82\x5f\xc8\x65\x40\x60\x2d\xaa\x0a\x95\xab\x56\x39\xcf\xce\xb8\xc1\xa4\x0d\xee\x70\x00\xa9\x60\xc1\x69\x7d\xb0\x90\xeb\xb2\x44\x07\x6e\x93\x72\xef\x88\xa4\xcd\xf6\x4b\x8b\xcf\xc3\xfd\x04\x7e\x7f\x50\xf4\xbf\xff\x5e\x9e\x41\xef\xbf\x80\xed\x5d\x03\xcf\x04\xda\xbf\xc4\x76\x01\x9d\x2f\x98\xcb\x4a\xa2\x22\x6f\x79\x1b\x9b\xaa\x25\xcd\xf7\x15\x3e\x14\xa8\x48\x2e\x25\x9a\x09\x7c\x25\x23\xd5\x2a\x85\x97\x33\x67\x59\x22\x41\x25\xf6\x1b\x54\xf4\x2c\xea\x92\x26\xf0\xff\x97\x88\x6d\x99\x7b\xfc\xea\x8d\xd5\x92\xbe\x60\x8e\x72\xcb\xc1\x2e\x5e\x0e\xc9\x99\x75\x2f\x83\x47\xa8\xcb\x39\xc4\x9c\xcd\xc2\x3f\x9e\xea\x45\x29\xf3\xe0\xd7\xc2\x36\xe4\xf4\x6b\xf3\xaa\xef\xb1\x15\x66\x08\x99\xa9\xa8\x26\x30\x15\x95\x58\x30\xe1\xf7\x57\x17\x2f\x27\xd2\xbd\xb9\x6d\x00\xa9\x0c\x56\xc2\x60\x22\xf2\x9c\x26\x8e\x44\xc9\x67\x6d\x8c\xde\x3d\x8b\xb2\xc6\x14\x2e\xee\xf2\x5c\xd7\x8a\x3c\x82\xfc\x1d\x8f\xe1\x17\x24\xe6\x0c\x6c\xda\xd1\x83\x2d\x4f\x31\x2c\xb5\x71\x8f\x1f\x67\x73\x47\xb5\xd9\x1c\x68\x5f\x61\x4b\x7d\x61\x10\x6a\x1e\x0a\xc9\xc4\x94\x16\x7a\xb4\xf1\xc1\x19\x8b\x40\xaf\x7b\x8e\x7d\x0d\xd1\x84\x67\x3c\x7a\xe5\x16\xa7\x5a\x91\x11\x39\xf1\xc3\x99\xd1\x9b\xb8\xf7\x89\x8f\xc8\xdf\x6e\x5a\x0f\xf9\x71\x44\x99\x98\x51\x5c\x15\xbb\x4c\x80\xff\xbc\x8a\xd3\x78\x9c\xcd\xa7\x51\xa2\x37\x49\xea\xbd\x53\x10\xf6\x16\xde\x70\x88\xce\xba\xbd\x85\x4a\x28\x99\x27\xa3\xa9\x1b\x3a\xa5\x19\x08\x65\xc9\xd4\x39\xc1\x56\x94\xb2\x19\x49\x06\xd4\x81\xcb\xc9\xc1\xd2\xe8\x0d\x48\x9f\x3e\x7c\x4b\x8e\x4a\x4a\x47\x69\xe8\x9d\xc5\x72\x99\x0d\x33\x07\xae\x41\xc9\xd2\x5b\x8e\xc7\x70\x97\xe7\x68\x8f\xa4\xb0\x72\x3c\x0c\x02\xd8\xaa\x94\xc5\xb2\x44\xc3\x22\xe8\xd5\xa5\x27\x5e\x59\x9c\x41\x2f\xdc\x35\xac\x90\x5a\x9a\x25\xe1\x79\x3b\xe2\x69\x96\x77\x74\x96\x68\xb3\x85\x23\xe7\xd5\x3b\x06\xeb\x26\x26\x00\x7f\xde\xf2\x79\x12\xb4\x8e\x9c\xd2\xc1\xa6\xac\x90\x40\x40\x70\x6e\x7a\xc0\xb5\x56\x46\x6f\x65\x81\x85\x57\xfc\x6f\x03\x05\x9d\xf7\xdb\xc1\x52\xef\x4a\xea\x0b\xbd\x7f\xeb\x9a\xd5\x3e\x84\xeb\x43\xe4\x5a\x30\x5a\x75\x18\xd2\xdd\xa3\x47\xe9\x9b\x8c\x6b\x8a\x6b\x1d\x61\x27\x69\x0d\x0f\xf7\xf0\xed\x38\x7a\x1a\xca\x0e\x29\xf1\x8e\x10\x4d\x2b\x07\x6b\x1f\x85\x04\x78\xce\x2b\x23\x73\xec\x0a\x6b\x4f\xc8\x56\x48\xf7\x48\x42\x96\x36\x49\x33\x2b\x4a\x7c\x62\xab\x00\x19\x3b\x6e\x59\xa5\x79\x24\xdf\x76\xee\xa9\x3d\x3b\x0c\xc4\xf9\xb7\x35\xc6\x27\x9b\xc9\xf7\xc9\x4c\xa4\xd9\x71\x5a\xb3\xf9\x73\x97\xf2\xb1\xda\xbc\xcf\xef\x4d\x0a\x1c\x8a\xce\x3b\x34\x67\xa8\xc4\x48\x76\x62\x31\x71\xe6\x9d\x6e\xb8\x3b\x85\x93\x8d\xf6\x3e\xc4\x3d\x6f\xa9\xd1\x9a\x74\xdd\xf5\xe1\xb8\x77\x1b\x21\x95\x03\x03\xae\x81\xd7\x57\xc6\x74\x13\x2b\xec\x34\xc2\x2d\xb3\x08\x92\xec\x0f\x49\xeb\xc2\x88\x5d\x0a\x87\x8b\xd1\x05\x7a\xbd\x49\x98\xd3\x6d\xc7\x18\xab\x2e\x26\x2b\x43\x7a\x02\xb8\x39\xa7\x2c\x57\x0a\x0d\x14\x1a\xad\x83\x90\xdd\x18\x30\x70\x71\x41\x2f\xfe\xc2\x9c\x40\x34\x3b\xb3\x12\xb4\xee\x30\x3b\x3a\x64\x14\x9d\xd2\x7d\xb2\x5c\xab\x5c\x50\x32\xca\xa0\x77\xdc\xa6\xb6\x04\x52\x49\x92\xa2\x94\x3f\xf8\x26\x8a\xd2\xf8\xf9\x73\xb3\xeb\xd6\xac\x2b\x07\x96\xd2\x58\x3a\x1f\xa5\xa1\x0e\xa7\x27\xfd\xeb\x10\x5c\x7d\x0c\xb0\x66\xbb\x16\xad\x44\x6c\x38\xe0\xa4\xe9\x49\xaf\xa9\xce\xbf\x77\x43\xea\x1a\x71\x42\xb1\x4f\xde\x9d\x6e\x92\x78\xe1\x67\x95\x97\xe3\x53\xa0\x4f\x85\x62\x9c\x9b\x56\x33\xd0\xbc\x19\x43\x14\x30\x5d\x4a\x41\xa4\x5c\x8b\x3e\x58\x8f\x8f\xa0\xae\x13\xa7\x4f\xcf\x0e\x38\x3c\x43\xca\xd7\x07\x97\x76\x10\x1b\x8a\x99\x19\xde\xdd\x39\xe4\x7e\x2a\x52\x87\xc6\xe1\x3c\xb9\x84\x81\x45\x0d\xe7\x6e\x4d\xc3\xc5\xc5\xf1\x39\xe7\xd7\xf0\x29\xfb\x04\x2f\x3e\xc4\xf1\xe8\xf9\xde\xec\x79\xe4\x5a\x98\x0e\x2a\x0a\xd5\x74\x15\xfd\x39\x90\xc8\x54\x54\xf1\xee\x1e\xb0\x39\x3f\x58\xdf\x2b\xa4\x9f\xdc\x49\x8f\x37\x36\x7f\xc2\x9c\x74\x4d\x3d\xda\xd3\xfc\x8d\x99\x22\xac\x45\x43\xc9\x89\xbc\xb3\x7c\x8d\xf9\xf7\x24\xbd\x84\x0d\x5a\x2b\x56\x38\x81\xd1\xd4\x5b\x82\x37\x75\x03\xad\x3e\x10\xac\xc5\x16\x41\xb4\x70\xf1\x62\xec\xc1\x28\x28\x1a\xe6\x7e\x92\xfd\x7d\xe7\x07\x66\x38\x25\xb8\x3e\x05\xb2\x8f\xf0\x0a\x58\x5a\x8c\x69\xd1\x11\x6c\xa8\xf1\xef\xbf\xea\xb5\x91\x63\xf7\x6e\xd2\x7c\x00\x30\x1e\x97\x5c\x28\xa7\x73\x0b\x04\xdc\x54\xb4\x87\xdd\x1a\x55\x2f\x2d\x68\x24\x03\x24\xcb\xa1\x82\x1f\x68\x74\x0f\x8a\xe6\x27\xcc\x6b\xc3\x74\xfc\x1b\xf3\x9a\xfa\x87\x8f\xc7\xf0\xd4\xbf\x41\x3e\xce\xe6\xfe\x1d\x93\x51\x12\x6e\x58\xa9\xa2\x99\xea\xee\x9c\x31\x87\x5a\x71\x9b\xc0\xd5\xc7\x23\xb1\x8b\x57\xf0\x00\x4e\x93\x9f\x21\xe8\x9d\x43\x59\xe3\x31\xdc\x63\xa5\xad\x24\xff\x1b\x48\xaa\xb0\xe2\x0e\x7e\x36\x9f\x12\xd1\xac\x68\x62\x24\xc4\xd3\xc1\x99\x73\xbd\xe9\x19\x00\xc0\xeb\xd9\xeb\xd9\x3f\x03\x00\x38\xcb\xb9\x8e\x63\x11\x00\x00"
+
+func transactionsBuy_itemCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsBuy_itemCdc,
+		"transactions/buy_item.cdc",
+	)
+}
+
+func transactionsBuy_itemCdc() (*asset, error) {
+	bytes, err := transactionsBuy_itemCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/buy_item.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x0, 0xfb, 0x64, 0xe3, 0x97, 0xb3, 0x44, 0xe5, 0x78, 0x1, 0xb6, 0x79, 0x8, 0x8, 0xe8, 0xcb, 0x57, 0x6b, 0xec, 0x2f, 0x5, 0x7b, 0xa5, 0x80, 0x91, 0xc0, 0x8a, 0x8e, 0x8c, 0xa9, 0x8e, 0x17}}
+	return a, nil
+}
+
+var _transactionsCleanup_expired_listingsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x53\x51\x6b\xdb\x30\x10\x7e\xf7\xaf\xf8\x96\x87\x92\xc0\x70\x60\x8c\x3d\x84\x6d\xa5\xeb\x36\x28\x8c\x51\x58\xb7\xa7\x3d\xe4\x2c\x9d\x6d\x31\x45\x32\x27\xb9\x69\x28\xf9\xef\xc3\x8a\x12\x3b\x5d\xc7\x86\x30\x9c\x64\xdd\x7d\xf7\x7d\xf7\xc9\x6c\x3a\x2f\x11\xb3\xaf\x9f\xef\xbe\x45\x2f\x5c\x8b\x77\xf1\xc7\xab\x59\x51\x2c\x97\x4b\xdc\x09\xb9\x40\x2a\x1a\xef\x10\x3d\x6a\x52\xc6\x9a\x48\x91\x11\x5b\x86\xb2\x4c\xae\xef\xe0\xeb\xb4\xe5\x87\xce\x08\x6b\x58\x13\xa2\x71\x4d\x18\xce\x09\x8d\xb9\x67\x87\x70\xaa\x0d\xe1\xe0\x7b\x51\x0c\x52\xca\xf7\x2e\xa2\xf5\x56\xb3\x94\xb8\x6b\x4d\x48\xa8\x71\x82\x3a\x42\x86\x33\x4c\xe3\xd0\x51\x63\x1c\xa5\x5b\x1b\xaf\xd9\x62\xdb\xb2\x30\x82\x69\x1c\xcb\xb1\xa9\x69\xad\xad\xb1\x16\x9d\xf8\x7b\xa3\x13\x81\x04\xb6\xae\xc5\x6f\x6e\x9c\xe6\x87\x35\x2e\xb0\x8e\x3e\xc7\xbe\xc6\xfa\xc8\xe4\xe6\x63\x58\x83\x44\x68\x37\xa8\x20\xbc\xf1\xf7\xfc\x3c\xe5\xde\x69\x96\xf4\xeb\xc0\x5b\xc8\x35\x5c\x0e\x40\xc3\x87\xeb\x63\xf7\x01\x5d\x5f\x59\xa3\xec\x6e\x90\x81\x43\x30\x95\x65\x04\x0f\x45\x0e\xd5\x50\x98\x55\x1f\x59\xa3\xda\x81\xdc\xce\x3b\x2e\x8b\x62\xc2\x65\x7e\xea\x7a\x85\xef\x37\x2e\xbe\x79\xfd\x12\xd1\x3f\x39\x18\x45\xbf\xd2\x5a\x38\x84\x15\x72\xb0\xc0\x63\x01\x00\x96\xe3\x64\x34\x2b\x5c\x3c\x3e\xf1\x41\x39\x6e\x6e\x53\xc3\xfb\x22\x25\x76\xc2\x1d\x09\xcf\x49\xa9\xb8\xc2\xc5\xd5\x61\x94\xc7\xb2\xc3\x5a\x2e\x71\x95\x98\x25\x35\x46\x90\x4c\x7c\xb4\x41\x1e\x54\x60\x6b\x07\xe9\x3c\xba\x5e\x54\x4b\xe1\xa0\x70\x56\xb6\x3c\xd5\x0d\x6c\xeb\x72\x52\xee\x1d\x1a\x8e\x19\x7f\xfe\x07\xe3\x45\xa9\xa8\xa3\x6a\x70\xad\xe1\x50\x56\x5e\xc4\x6f\xdf\xfe\x07\xcd\xf7\xf3\x13\xe2\x71\xfd\x2b\xe7\x96\x62\x7b\x96\xb4\xc0\xe5\x25\x3a\x72\x46\xcd\x67\xd7\xbe\xb7\x1a\xce\xc7\xa1\x5d\x10\xc6\x64\x0c\xa3\x4c\x5c\xb3\x35\x35\xe8\x30\x25\xfc\x7c\x86\xd0\x8b\xd9\x22\x81\xe4\x41\x64\xa3\x9c\x0b\xff\x81\xf1\xcb\x38\x0d\x72\x1a\xc2\x6a\xa7\x2c\xff\x4d\xbf\x32\x3f\xa8\x4f\x87\xc7\xfb\x25\x1b\x79\xea\xaf\x53\x38\xb1\x58\x0e\x16\x05\x00\xec\x8b\x7d\xf1\x7b\x00\xe4\xd8\xa2\x23\x48\x04\x00\x00"
+
+func transactionsCleanup_expired_listingsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsCleanup_expired_listingsCdc,
+		"transactions/cleanup_expired_listings.cdc",
+	)
+}
+
+func transactionsCleanup_expired_listingsCdc() (*asset, error) {
+	bytes, err := transactionsCleanup_expired_listingsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/cleanup_expired_listings.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x46, 0x6, 0xb3, 0xed, 0xcf, 0x64, 0x7f, 0xa2, 0xa6, 0x10, 0x89, 0x55, 0x2d, 0x93, 0xa7, 0x95, 0x2f, 0x3e, 0xc8, 0x23, 0xc8, 0x23, 0x4f, 0x99, 0x50, 0xff, 0xa9, 0x67, 0xff, 0x5d, 0xc3, 0xcf}}
+	return a, nil
+}
+
+var _transactionsCleanup_ghost_listingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x6b\xdb\x40\x10\xbd\xeb\x57\xbc\xfa\x10\x24\x08\x12\x94\xd2\x83\x69\x9b\xa6\x2d\x2d\x86\xd2\x86\xf4\xe3\xd4\x43\xd6\xab\x91\xb5\xb0\xde\x5d\x66\x47\x76\xc1\xf8\xbf\x17\x7d\x59\x4a\x6c\x48\x25\x74\xd0\xee\xcc\x7b\x6f\xde\x3c\xb3\x0d\x9e\x05\x8b\x6f\x9f\x7f\xfe\x10\xcf\x54\xb1\x77\xf2\xfb\xe5\x22\x49\x8a\xa2\xc0\x07\xb2\x7e\x0f\x61\xe5\xa2\xd2\x62\xbc\x43\x4d\x36\x40\x3c\x98\xb6\x7e\x47\x90\x9a\xb0\xa9\x7d\x14\x58\x13\xc5\xb8\x0d\x2a\xcf\xfd\xa9\xd9\x91\x43\x3c\x61\xe6\x2d\x60\xfb\xe1\x7d\x50\xac\xb6\x63\xc3\x3d\x45\xdf\xb0\xa6\xd5\x27\xac\x4a\xf8\xaa\x6b\x1e\xc1\x78\xb8\x84\xd4\x4a\xb0\xf7\x8d\x2d\xb1\x21\x41\x49\x96\x84\x4a\x98\x0a\x46\x50\xf9\xc6\x95\x8f\x65\xe4\x73\xa6\x49\xc4\xf7\xbd\x23\xc6\x6d\x59\x32\xc5\x38\x92\x4d\xd7\x27\xbe\x6b\xf4\x95\x43\x45\x3f\xcb\xc3\x99\xe2\x87\x96\x25\x99\xd9\x93\x9e\x95\x2c\xf1\x6b\xe5\xe4\xf5\xab\xeb\x19\xcd\xc0\xbf\x1c\x85\x64\x38\x24\x09\x00\x58\x92\x59\xd9\x5d\xb3\xb6\x46\xdf\x53\xb5\xc4\xd5\xe1\xc9\x7e\xf2\xe9\xa7\x2f\x3b\x02\xe8\x41\x02\x53\x50\x4c\xa9\xd2\x5a\x96\xb8\xba\xd5\xda\x37\x4e\x5a\x0e\x0c\x4f\x51\xe0\x56\xeb\xd6\x81\x27\xe3\x87\x0e\x6a\x72\x7d\x34\x88\xac\x25\x6e\xb7\x1e\x1a\xd6\xb5\x8a\x34\x5f\x52\x7e\xc2\x8d\x64\xab\xfc\x82\x7e\xbc\x6d\x97\x36\x08\x49\xcf\x7c\xc8\x72\xad\x82\x5a\x1b\x6b\xc4\x50\xcc\xd7\x9e\xd9\xef\xdf\xfc\xc7\xc8\xef\xd2\x13\xf5\xf8\x3e\xd7\x73\xa7\xa4\x7e\xd4\x94\xe1\xe6\x06\x41\x39\xa3\xd3\xc5\xc7\x2e\x5f\xce\x4b\x2b\x17\x0a\x53\x33\x2a\xf6\xdb\x6e\xe8\xc0\x7e\x67\x4a\x2a\xa1\x86\x10\xfd\xb9\x30\xd0\x8b\x45\xd6\x91\x1c\xfb\x8d\xd0\x5f\xd2\x8d\x10\x0e\xcf\x3a\x95\x6b\x4b\xca\x35\xe1\x4b\x9b\xe5\xaf\x7d\x96\xe2\xa5\x50\x9d\x1d\x65\x09\x00\x1c\x93\x63\xf2\x6f\x00\xb2\x4b\x95\x1a\xd2\x03\x00\x00"
+
+func transactionsCleanup_ghost_listingCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsCleanup_ghost_listingCdc,
+		"transactions/cleanup_ghost_listing.cdc",
+	)
+}
+
+func transactionsCleanup_ghost_listingCdc() (*asset, error) {
+	bytes, err := transactionsCleanup_ghost_listingCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/cleanup_ghost_listing.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x15, 0x94, 0xe5, 0x82, 0x1, 0xa6, 0xa, 0x72, 0xb3, 0x15, 0xf3, 0x39, 0xbb, 0x29, 0x67, 0x46, 0x50, 0xba, 0x73, 0xd1, 0x31, 0x3b, 0x4, 0x21, 0xb1, 0x30, 0xd1, 0xe2, 0x82, 0x8c, 0x59, 0x33}}
+	return a, nil
+}
+
+var _transactionsCleanup_purchased_listingsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xcf\x8b\xd3\x40\x14\xbe\xe7\xaf\xf8\xec\x61\x49\x40\x12\x10\xf1\x50\xd4\xa5\xae\x08\x0b\x22\x45\x57\x4f\x5e\x5e\x66\x5e\xda\xc1\xd9\x99\x61\xe6\x65\xb5\x2c\xfd\xdf\x25\xd3\x69\xb3\xeb\x56\xb4\xbd\x64\x26\x79\xdf\xaf\xf7\x99\xdb\xe0\xa3\x60\xf1\xe9\xc3\xcd\x17\xf1\x91\x87\xe8\x9d\x7c\x7b\xb1\xa8\xaa\xae\xeb\x70\x13\xc9\x25\x52\x62\xbc\x83\x78\x0c\xa4\x8c\x35\x42\xc2\x90\x2d\x43\x59\x26\x37\x06\xf8\x21\x1f\xc3\x18\xd5\x96\x12\x6b\x58\x93\xc4\xb8\x4d\x9a\xde\x10\x36\xe6\x8e\x1d\xd2\x09\x1d\x91\x93\x1f\xa3\x62\x90\x52\x7e\x74\x82\xad\xb7\x9a\x63\x9b\x29\xaf\x0a\xa8\x49\x08\x63\x6f\x8d\xb2\xbb\xe9\x3b\x4e\xc9\xf4\x96\x91\x3c\x14\x39\xf4\x0c\xfe\xc5\x6a\x14\xd6\xe8\x77\x20\xb7\xf3\x8e\x33\x40\x25\xb3\xe6\x7a\x26\x5d\x69\x1d\x39\xa5\x25\xca\xc3\xf3\xa3\xc8\xcf\x45\xcc\xf5\xfb\x25\xbe\x5e\x3b\x79\xf5\xb2\xc1\x7d\x55\x01\x80\x65\x79\x20\x7b\x89\x8b\xfb\x3f\x52\x6a\xe7\xc3\x3a\x6b\xdd\x1f\x06\x43\xe4\x40\x91\x6b\x52\x4a\x96\xb8\x58\x1d\x6c\x4e\xb8\x28\xbf\xae\xc3\x2a\x9b\xca\xc9\xcd\x24\xc5\xf3\x1c\x51\xc9\x36\xb1\xb5\x1c\xa7\x1d\x1c\x53\xce\xd7\xc5\x43\x7b\xc2\x4d\x6c\x87\xf6\x01\xdc\x1b\x6c\x58\x0a\xff\xd3\x34\x9a\x56\x51\xa0\x7e\xda\xa9\xe1\xd4\xf6\x3e\x46\xff\xf3\xf5\x7f\xd8\x7c\x5b\x9f\x18\x8f\xff\x7f\xcd\xac\x49\xb6\x8f\x86\x1a\x5c\x5e\x22\x90\x33\xaa\x5e\x5c\xf9\xd1\x6a\x38\x2f\x93\x5c\x10\xe6\x61\x0c\xd1\xdf\x66\xaf\x21\xfa\x3b\xa3\x59\x83\x0e\xe2\xf1\xfd\x8c\xa1\x67\x8b\x26\x93\x94\x45\x94\x8e\x3c\x0e\xfe\x1d\xe3\x87\x71\x1a\xe4\x34\x22\xab\x9d\xb2\xfc\xb7\xfc\xda\x52\xf1\xf5\xb1\xda\x1f\x4b\xb3\xeb\x33\xed\x79\x72\xd5\x54\x00\xb0\xaf\xf6\xd5\xef\x01\x00\x8b\xa7\x68\x40\x66\x03\x00\x00"
+
+func transactionsCleanup_purchased_listingsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsCleanup_purchased_listingsCdc,
+		"transactions/cleanup_purchased_listings.cdc",
+	)
+}
+
+func transactionsCleanup_purchased_listingsCdc() (*asset, error) {
+	bytes, err := transactionsCleanup_purchased_listingsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/cleanup_purchased_listings.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xeb, 0xd3, 0x3d, 0xf4, 0xf9, 0xb3, 0xf9, 0xa8, 0xd2, 0xe1, 0xa5, 0xf4, 0xb5, 0x9a, 0x84, 0x40, 0x9e, 0x45, 0xdd, 0x12, 0xdf, 0xeb, 0xad, 0x92, 0x39, 0x22, 0x9a, 0xe7, 0xe7, 0xef, 0x10, 0x1d}}
+	return a, nil
+}
+
+var _transactionsExampleNftBurn_nftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x54\x5d\x6f\x9b\x4a\x10\x7d\xe7\x57\x9c\xf0\x90\x0b\xd2\x0d\x7e\xb9\xba\x0f\x96\x93\xa8\x89\x6b\xc9\x0f\xb5\xaa\x94\xa6\xcf\x6b\x76\x30\xdb\xe2\x5d\xb4\x3b\x84\xa4\x51\xfe\x7b\xb5\x7c\x18\x9c\xb8\xa9\x54\x2f\xb2\xcd\xc0\x9c\x39\x33\x67\x66\x66\xb3\x19\xd2\x42\x39\xb0\x15\xda\x89\x8c\x95\xd1\x68\x14\x17\xd2\x8a\xc6\x41\x68\x6c\x56\x29\x72\x6b\xf6\xe0\x82\xe0\xd4\x4e\x93\x75\xc8\x4c\x59\x52\xf7\xb2\xd0\x12\x92\x1c\x5b\xf3\xe4\xa0\x38\x08\xd4\xbe\x32\x96\x11\x6e\x8c\x5e\xd5\x7a\xa7\xb6\x25\xa5\xe6\x07\xe9\xf0\xf0\xe4\x13\xb1\x90\x82\xc5\xbd\xa2\xc6\x8d\xe6\x8f\x8f\x62\x5f\x95\xb4\x59\xa5\xa3\xed\xa6\xb6\x9a\x6c\x18\x04\x13\x7e\x91\x92\x73\x7c\x5d\x6b\xfe\xff\xbf\x18\xcf\x41\x00\x00\x3e\x8f\x3b\xca\xc9\x92\xce\x08\x5c\x08\x46\xa3\xca\x12\x5b\x42\xed\x48\x22\x37\xb6\x4d\xc0\x34\x9a\xec\x3f\xd3\x04\x5a\xf7\x92\x78\x62\xba\xa3\x7c\x0e\x51\x73\x11\xbd\xce\x21\xf9\xd6\x97\x26\xc6\xf9\x48\x37\xb9\x1d\xd1\x5a\xb8\xca\x52\x25\x2c\x45\x5d\xb9\x7a\xac\x1b\x63\xad\x69\xee\x45\x59\x53\x8c\xf3\x0f\x59\x66\x6a\xcd\x3e\x01\xf4\x9f\x63\x12\x4b\xc1\x02\x97\x98\x44\xb1\xe4\x4c\xf9\x40\xb7\x46\xb3\x15\x19\xfb\xea\x45\xde\x56\xdb\x8c\xd2\xa7\x8a\xe6\xd0\xaa\xfc\x17\x0f\x8a\x9a\xee\xd6\x7f\x2f\x8e\x8a\x9d\x6c\x56\xe9\x48\xd6\x87\xb8\x8a\xe2\x18\xc2\x9d\xe1\x0f\xef\x5d\x1f\x68\xfa\xeb\xfa\x1a\x95\xd0\x2a\x8b\xc2\x5b\x53\x97\x12\xda\x30\x7a\x7a\x78\xe3\xda\x32\x4a\x90\x16\x34\xc9\x06\x59\x9f\x06\x34\x91\x74\x60\x03\xe5\x1f\xed\x49\x73\xab\xd4\x5b\x98\x81\x61\x8b\x07\xa5\x61\xac\x24\xeb\x3d\xe9\x91\xb2\x9a\xbd\xee\xc7\x9d\x1c\xc6\x47\xac\x0f\x37\xb3\x19\xb6\xad\x1a\x10\xb0\x63\xd7\x98\xf7\x5a\xc4\x1f\x47\x65\x9e\x8c\xf6\x3b\xca\x71\xd9\xcf\x44\xe2\xd8\x58\xb1\xa3\xa4\x03\x5e\xfc\x5d\xfb\x5c\x45\x87\x58\xc3\xf1\xb3\x37\x7f\xd5\x17\x43\xb0\xcf\x82\x8b\x23\x87\x78\xa2\x4c\x7a\x98\x57\x48\x43\xae\xd5\xc8\xfb\x91\x9f\xea\x93\xd1\x61\xb6\xdf\x29\x63\x88\x4e\x81\x4a\x70\x81\xf0\x0d\xa1\xe1\x24\x99\xd1\x99\xe0\xe8\xf7\xd4\x12\x36\x5f\xd8\x2a\xbd\x8b\xe2\x38\x38\x81\x70\x04\x13\x26\x98\x30\xde\xd7\x8e\xa1\xb4\x62\x25\x4a\xf5\xd3\x2b\x4b\xca\x42\x74\x43\xd3\x2e\xa8\x4e\xec\x31\x38\x72\x65\x1d\x9f\x85\x71\xdc\x6d\x84\x97\xee\x67\x68\x8d\xe7\x60\xaa\xfe\xb0\xe1\x86\x4e\x1b\x37\xdc\x3b\xea\xfb\xf9\xd4\x39\x63\x71\x71\xa2\x11\x92\x01\x32\x1a\xfe\xac\x97\x73\x28\xd9\xb3\xf1\x57\xb7\xcb\x92\x6d\x6d\x75\xb4\xb8\xd0\x39\xc7\x53\xa2\x95\x71\x3c\x59\x06\x67\x27\x42\xec\x88\xd7\x4b\x17\xc5\xbe\xf2\x2c\x94\x76\x91\x92\xf1\x1c\x61\xda\x27\xd1\x97\x85\xe0\x2a\xca\x54\xae\x48\x62\xbd\x84\x2b\xda\x11\x2d\xc4\x03\x61\x4b\xa4\x21\xa9\x24\x26\x99\x84\x01\x00\xbc\x04\x2f\xc1\xaf\x01\x00\xa5\xc6\x6a\x02\x06\x06\x00\x00"
+
+func transactionsExampleNftBurn_nftCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsExampleNftBurn_nftCdc,
+		"transactions/example-nft/burn_nft.cdc",
+	)
+}
+
+func transactionsExampleNftBurn_nftCdc() (*asset, error) {
+	bytes, err := transactionsExampleNftBurn_nftCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/example-nft/burn_nft.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xe9, 0x4c, 0xaa, 0xa0, 0x6a, 0x7c, 0x91, 0x91, 0xf5, 0xb9, 0x81, 0x48, 0x3a, 0x1b, 0xcf, 0x60, 0xad, 0x1, 0x73, 0x37, 0x5d, 0xb8, 0xdd, 0x91, 0x3, 0x41, 0x48, 0x2b, 0x85, 0x8a, 0x95, 0xf0}}
+	return a, nil
+}
+
+var _transactionsExampleNftMint_nftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x57\x4f\x6f\xdb\xb8\x13\xbd\xeb\x53\x4c\x7d\x48\x25\xfc\x52\xf9\xb7\xc0\x62\x0f\x46\x9c\xa2\x4d\x37\xc0\x1e\x1a\x14\xa9\xb7\x97\x22\x07\x9a\x1a\x5b\xb3\xa5\x49\x2d\x39\x8a\xe3\x0d\xf2\xdd\x17\xa4\x24\x4a\x72\xe4\x64\x1b\x3b\xfe\x43\xce\x0c\x87\x6f\xe6\x3d\xd2\xf3\xf9\x1c\x56\x25\x39\x70\xd2\x52\xc5\x50\x3b\x74\xc0\x25\xc2\xcd\xf5\xea\x33\x69\x46\x0b\x16\x9d\xa9\xad\x44\x60\x03\x3b\xd2\x0c\x02\x34\xee\xbd\x41\xe2\xbd\xff\x60\xd8\xd5\x8e\x61\x8d\x60\x6b\x0d\x7b\xe2\x32\x04\x10\x52\x9a\x5a\x33\x70\x29\x18\x4a\xd1\x44\xdd\x8d\x43\x86\x00\x8e\x8d\xc5\x02\x48\xc3\xdc\x7f\x14\x5b\x9c\xc7\xc5\xbd\x81\xff\x87\x55\x89\x60\xcd\x41\x28\x3e\x80\xb0\xdb\x7a\x87\x9a\x1d\x90\x2e\x48\x12\xba\x98\x81\x50\xb4\xd5\x58\x24\x09\xed\x2a\x63\x19\x66\x37\x46\x5f\xd7\x7a\x4b\x6b\x85\x2b\xf3\x03\xf5\x2c\xce\xfc\xfe\x20\x76\x95\xc2\x9b\xeb\x55\x3f\xf6\x19\x59\x14\x82\xc5\x37\xc2\xbd\xeb\x87\x8f\x22\x24\x6c\x85\x76\x42\x32\x19\x9d\x26\x00\x00\x16\x25\x55\x84\x9a\x17\xf0\xa1\x28\x2c\x3a\x77\x1e\xc6\xb5\xd8\xe1\x02\xbe\xb2\x25\xbd\x6d\x46\x0a\x6c\x80\x26\xa3\xc7\x13\x5c\xd6\xbb\xb5\x16\xa4\xc6\xc3\xb2\x66\xb7\x80\xef\x7f\x5e\xd3\xc3\x6f\xbf\xde\x35\x63\x2d\x0e\x9f\xfa\x50\xde\xa4\xf1\x1a\x9b\x7c\x44\x8d\x1b\x92\x24\x2c\xa1\xb7\x69\x93\xbb\x4b\x32\x78\x4c\x82\xa1\xc7\x56\x19\x29\x14\xdc\x0b\x4b\x62\xad\x10\x36\xc6\x82\x2f\x04\xe9\xed\xb8\x66\x1b\xb4\xa8\x25\x06\x3f\x85\xdc\x4e\x2c\xe0\xac\x87\x32\xef\x2b\x17\xc3\xdf\x76\x8e\xbe\x81\x7c\x40\x8b\x12\xe9\x1e\xed\x5b\x07\xd2\x28\x85\x01\xc8\x18\x35\x62\x79\x15\xe7\x6e\x71\xb3\x80\xb3\xc7\xe3\x5a\xe6\xb7\x6d\xa0\xa7\x66\x2f\x95\xc5\x4a\x58\x4c\x9d\xef\x01\xbb\x00\x51\x73\x99\x7e\x34\xd6\x9a\xfd\x37\xa1\x6a\xcc\xe0\xec\x43\xd3\x94\x71\xfb\xdd\xa2\x7d\x1e\x9f\x04\x0b\x58\xc2\x60\x4b\xbe\xff\xd5\x3d\x5e\x19\xcd\x56\x48\xf6\xbd\x91\x76\x0d\xbc\x3a\x54\xb8\x00\x4d\xea\x1c\xee\x09\xf7\xcd\x57\xff\x7a\x31\x6a\x25\x0f\x4b\xbf\x1d\xbf\xc4\x65\x9a\x65\x20\xdc\x1b\x78\xc5\xee\x7d\x4c\xd3\x3f\xdf\xbf\x87\x4a\x68\x92\xe9\xec\xca\xd4\xaa\x00\x6d\x18\xda\xf4\xe0\x99\x6b\xc8\x28\x0f\xcc\xe9\x77\x03\xb2\xdd\x06\x68\xc4\xc2\xf9\x92\x90\xa7\x81\xe7\x53\xc7\xfb\xa3\x30\x5d\x86\x21\x9e\xa7\xa9\xb1\x05\x5a\xef\x89\x0f\x28\x6b\x46\x60\x2f\x20\x03\x52\xcc\xb2\x98\x75\xfc\x30\x9f\xc3\x3a\x94\x02\x04\xd8\xe3\x8e\x98\x10\x1b\xd2\xd0\xaa\x41\x0c\xe1\x50\x6d\xf2\xb6\x1b\x97\xd0\x54\x39\x6f\x8d\xf2\x26\xf8\xc5\x64\x2f\x5e\xa6\x1b\x6b\x76\x8b\x61\x51\x1b\x6d\xfb\xda\x38\x7f\x11\x5c\x66\x27\x80\xf6\xf0\x35\x4b\x41\x61\xd0\x05\xc8\xfd\x9a\x08\x42\x3f\x0f\x08\x66\xfd\x17\x4a\x06\xe1\x75\x0f\xa1\x12\x5c\xc2\x6c\x14\x39\x3e\x72\x69\xb4\x14\x9c\xbe\x94\x54\xce\xa6\xa1\x75\x9a\x65\xc9\x44\x8c\x3e\xca\x30\xcf\x20\x86\xa4\x89\x49\x28\xfa\xc7\x97\x07\xc9\x46\x3d\x6e\x05\x9a\x1c\xec\x8e\x20\xdf\x90\x75\xfc\x66\x96\x65\x3d\x37\xe6\x73\x68\x08\xd4\x11\xb7\x91\xb9\xb7\x0e\xaa\x7a\xad\x48\xfa\x76\x19\x90\xe7\x48\x24\x62\xd1\xa6\x39\x0d\x4b\xd8\x22\xb7\x94\x4c\xa3\x4d\x96\x4b\x51\x89\x35\x29\x62\x42\x17\x0b\xfb\x02\xfd\x2f\xd3\x31\x7f\xf3\x26\xb9\xd7\xca\x1a\x57\xec\x2b\x5b\x8a\x7b\x04\x01\xc7\x4b\x41\xb7\x94\x2f\xec\x2c\x79\xa9\x10\x27\x33\x79\xb5\x96\xb1\x94\xcd\x91\x49\x0e\x02\x0e\x0a\xc1\x6c\x5a\xcd\xf4\x92\x2c\xb4\x07\x3d\x1f\x70\x6c\x32\xc8\x78\x83\xff\xb5\x25\xfa\xec\x41\xe8\x22\x2a\xf5\xa0\x35\xfc\x22\xbd\xde\xc2\x63\xcc\xc2\x9f\x54\xb9\x42\xbd\xe5\x12\x96\xcb\xa9\x43\xaa\x9b\x3d\x3b\x3b\x61\x3c\x3a\xae\xda\xe9\x05\xcc\x3e\x58\x2b\x0e\xd0\x5a\xbb\x32\x28\xdf\x1a\x01\xff\xae\x85\x0a\xa7\x55\xeb\x0e\x16\x95\x60\x2c\xa0\x40\x16\xa4\xdc\x6c\x98\x6c\xa7\x55\x03\xe1\x9f\xcf\xe1\xca\xa2\x08\x02\xd6\xdf\x2e\x5a\xe7\x68\x75\x2f\x2c\x34\x30\x2d\xe1\xff\xa3\xd1\xc6\xa3\x39\x59\xc7\x32\x7e\x1b\x66\x0e\x77\xb0\x84\xef\x77\xd1\x67\x5f\x92\xc2\x97\xf6\x0a\x97\xed\x4a\x3d\xaa\xdd\x01\xb5\x8e\xe6\x07\x98\xc6\xeb\x7b\x70\xbd\x7b\xc9\xf3\xaa\xe3\xd5\x61\x4c\xbd\x81\xc9\x11\xf9\xb6\xc8\x17\x67\x8f\x27\x69\x37\x5a\xcc\x3f\xc7\x30\x6c\x91\x5b\x24\x3a\x9f\x2f\x91\x0e\xe9\xb8\x7f\x07\x9a\xe3\x9f\xb4\x81\x37\x93\x89\xe7\xb2\x44\xf9\x23\xcd\x06\x8d\xd7\xfd\x0d\x99\xdd\xd6\x72\x10\xe2\x94\x0c\xf7\x94\x19\x18\xbf\xae\xbb\x03\xa6\x3d\x53\x8f\x13\xd2\x21\x8d\xde\xd0\xb6\xf6\x77\x5d\xaf\x22\xaf\x06\xfe\x19\x2c\x7f\x2a\xe1\x70\x27\x38\x74\x4c\x72\xc8\x50\x57\xc7\x69\x7f\xdd\x13\xcb\x72\x6d\x84\x2d\x46\xe2\x17\x8e\xfa\x70\xac\xb1\xe9\xe4\x01\x84\x3e\x00\x1f\xaa\x20\x54\x5d\x14\x08\xbb\xef\x14\xa3\xfb\x7b\x1a\x97\x39\x32\x28\x17\x55\x85\xba\x78\xad\xa1\x5a\x04\x9e\x9b\xb5\x97\x70\x9f\x8d\x5d\x4c\xb7\xfc\xf9\xa4\x93\xac\x79\x11\xd4\xa8\xa5\xcf\xb4\xd5\xe8\xd6\x3e\xa1\x6c\x53\xdc\xf3\x8f\x2c\x39\xfd\xad\xd3\x94\xe6\xfd\x7f\xf0\x4b\x9c\x7d\x4a\x7a\x94\xe6\x73\xf8\x4c\xfd\xd5\x2c\xa8\x72\x81\x95\x71\xc4\x40\x3c\xb8\x51\xc7\x83\xb9\x97\xf0\x18\x24\x5e\xd6\x8b\x9b\xeb\x15\x5c\xbc\x1b\xde\xa4\xc2\xdb\xcd\xf5\x6a\x8c\x69\xf3\xc3\xc5\xbf\x9e\x27\x27\x81\x18\x7c\x19\x5b\x0d\x7e\xcb\xc4\x8f\xe7\xd3\x85\xef\xe0\x24\x74\xc9\x73\x9c\x5e\xb8\x3c\xe4\x2d\x0a\x29\xfb\x3e\x5b\xc0\xc5\xbb\xb8\xc3\x2c\x01\x00\x78\x4a\x92\xa7\xe4\xdf\x01\x00\xd4\xaa\x2e\x40\xdf\x0e\x00\x00"
+
+func transactionsExampleNftMint_nftCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsExampleNftMint_nftCdc,
+		"transactions/example-nft/mint_nft.cdc",
+	)
+}
+
+func transactionsExampleNftMint_nftCdc() (*asset, error) {
+	bytes, err := transactionsExampleNftMint_nftCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/example-nft/mint_nft.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xec, 0x2f, 0xf5, 0xa2, 0x24, 0x57, 0x30, 0x3, 0x38, 0xa0, 0xdf, 0xda, 0x4e, 0xd5, 0x95, 0xd6, 0xf4, 0xd4, 0xbe, 0xf7, 0xfb, 0xc9, 0xef, 0x61, 0x58, 0x29, 0x44, 0xe2, 0xce, 0x61, 0x3f, 0xe7}}
+	return a, nil
+}
+
+var _transactionsExampleNftSetup_accountCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x94\x51\x6f\xea\x38\x10\x85\xdf\xf3\x2b\x4e\x79\xa8\x82\x44\xe1\x1d\xd1\x76\x77\xb3\x45\xda\x87\x45\xd5\x6d\x6e\xdf\x07\x33\x34\xd6\x35\x76\x64\x4f\xa0\xa8\xea\x7f\xbf\xb2\x29\x49\xa0\xdc\x56\xb2\x10\xd8\x9e\x99\xef\xcc\x1c\x33\x99\x4c\x50\x56\x3a\x40\x3c\xd9\x40\x4a\xb4\xb3\xd0\x01\xbb\x8a\x04\x64\x41\x4a\xb9\xc6\x0a\x76\xae\x31\x2b\xf8\xc6\x66\x31\x42\x1c\x02\x0b\xb4\x04\x36\x6b\x34\x35\xc4\xc1\xb3\x62\xbd\x65\x2c\xe6\x65\xc8\x32\xbd\xa9\x9d\x17\x0c\x16\xce\xce\x1b\xfb\xa2\x97\x86\x4b\xf7\x8b\xed\xa0\x3d\x79\x78\xa5\x4d\x6d\x78\x31\x2f\xbb\xbd\xff\x59\x68\x45\x42\xcf\x9a\x77\x61\x90\x65\x7d\xa8\xb7\x2c\x03\x80\xda\x73\x4d\x9e\xf3\xa0\x5f\x2c\xfb\x29\xa8\x91\x2a\xff\xc7\x79\xef\x76\xcf\x64\x1a\x1e\xe1\xbf\x10\x1a\x7e\x12\xe7\xe9\x85\x0b\xaa\x69\xa9\x8d\x96\x7d\xe1\xac\x78\x67\x0c\xfb\x11\x1e\x9b\xa5\xd1\xa1\xea\x0e\x47\x78\xa2\x2d\x7f\xc4\xff\xb4\xf5\xf9\xf9\x10\xd7\x7f\x1f\x1a\x31\xc4\x5b\xc2\x88\xab\xfd\x62\x58\xa0\x62\xee\x44\xfa\x2f\x09\xe1\x16\x9d\xbe\xb1\xe7\xe0\xcc\x96\x13\x02\x29\x89\xea\xf2\xb8\xd7\x78\xc5\xe5\xbe\xe6\x29\xac\x36\x23\x6c\x35\xef\x0e\x3f\xe3\xe7\xec\xa4\x19\xe3\xc5\xbc\x2c\x4e\x4a\xdc\xe5\xc3\x21\x28\x5c\xe1\x9b\x7b\xf7\x2d\x66\x5c\xf7\xf7\xa8\xc9\x6a\x95\x0f\x8a\x34\x51\xeb\x04\x1f\x78\xf8\x14\x9a\x88\xc6\x28\x2b\xee\xa9\x81\xfa\x90\x01\xcb\xbc\x0a\x71\xf4\x3a\x1e\x6d\xd8\x0a\xa4\xba\x94\xe6\x48\x98\xf2\x41\x5b\x38\xbf\x62\x1f\x23\xf9\x95\x55\x23\x0c\x39\xb3\xe0\x60\x98\xb5\xd8\x93\x09\x7e\xb0\x34\xde\x82\xc9\x9b\x3d\xf4\x3a\x95\x39\x3a\x93\x8c\x67\x5a\xed\x51\x51\x00\xf5\xc6\xd0\xc6\xeb\x35\x0e\x66\x19\x87\x83\x29\xc6\xcb\x64\x97\xd9\x75\x6f\x44\x1d\xf1\x5d\xbe\xf6\x6e\x33\x3d\x1b\xe8\x31\xf6\x91\xa4\x1a\xe2\xea\x36\x4e\xac\x67\x85\xb8\x7c\x82\x6c\xb7\xde\x4f\x14\x14\x9e\x49\x18\x04\xcb\x3b\xf0\xa6\x96\xfd\x25\xd4\x53\x23\x61\x76\xd3\x77\x91\x4a\x29\x1e\x62\x6c\x47\x9b\xdb\xb5\xf4\x3c\xf3\x57\xef\xfe\x62\x5e\x46\x8f\x9c\x60\x04\xda\x32\xb4\xc4\xd6\xf7\x7a\xd8\xde\x38\xeb\x53\xbc\x9d\xcf\x6e\x3a\xa2\x11\xc4\x7d\xd9\x99\x93\x62\xea\xa8\x39\xbd\x27\x05\xd5\xbe\x27\xac\x9d\x4f\x43\xec\x52\x9d\x33\xb4\x97\x35\x87\x71\x73\x7c\x92\xf9\x59\xed\xb4\xad\x0e\xa5\x2f\x37\xb1\xa0\x1a\xb7\x17\x93\x1e\x55\xea\xf8\x7f\xf1\x47\x33\x7c\x25\xf6\x2b\xe4\xcf\xc0\x05\xd5\x23\x90\x4c\xf1\x8d\x86\xf7\xec\x3d\xfb\x3d\x00\x45\x8d\x56\xee\x97\x05\x00\x00"
+
+func transactionsExampleNftSetup_accountCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsExampleNftSetup_accountCdc,
+		"transactions/example-nft/setup_account.cdc",
+	)
+}
+
+func transactionsExampleNftSetup_accountCdc() (*asset, error) {
+	bytes, err := transactionsExampleNftSetup_accountCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/example-nft/setup_account.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6b, 0x68, 0xe6, 0xca, 0xfa, 0xd4, 0xcf, 0x11, 0x7, 0x35, 0x55, 0x7b, 0x91, 0xf9, 0x30, 0xbe, 0xec, 0xac, 0xf, 0x1d, 0xbe, 0x46, 0xb0, 0x5d, 0x1e, 0xab, 0x3f, 0xda, 0x5, 0xd3, 0xa5, 0x19}}
+	return a, nil
+}
+
+var _transactionsExampleNftTransfer_nftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x9c\x95\x4d\x6f\xdb\x3c\x0c\xc7\xef\xfe\x14\xff\xfa\xd0\xda\xc0\x53\xe7\xf2\x60\x87\x20\x5d\xd1\xad\x2b\xd0\x4b\x57\xb4\xd9\x76\x56\x1c\xda\xd6\xe6\x48\x86\x44\x27\xdd\x8a\x7e\xf7\x41\x7e\x91\x9d\x97\x36\x43\xe3\x1c\x94\x48\x22\xff\x24\x7f\xa4\x27\x93\x09\xe6\x85\xb4\x60\x23\x94\x15\x29\x4b\xad\x20\x2d\x32\x6d\xda\xbf\x32\x32\x46\xaa\x1c\x42\x2d\x71\x77\x33\x47\x66\xf4\x2a\x70\xb7\xb4\x22\x88\x34\xd5\xb5\x62\xb0\x86\x50\x9a\x0b\x32\x41\x20\x57\x95\x36\x8c\xf0\x4e\xab\x9b\x5a\xe5\x72\x51\xd2\x5c\xff\x22\x15\xfa\x9d\x2f\x4f\x62\x55\x95\x74\x77\x33\x0f\x83\x60\xe4\x37\x32\x94\xca\x4a\x92\xe2\x29\xae\x96\x4b\x43\xd6\xfe\x87\x8d\xe4\x62\x69\xc4\xe6\xf6\x7a\x8a\x6f\xb7\x8a\x3f\xfc\x1f\xe3\x39\x08\x00\xc0\xa9\x78\xa0\x8c\x0c\xa9\x94\x9c\x06\x2e\xc8\x9f\x27\x73\x66\x91\xea\xb2\xa4\xc6\x76\x73\xa1\x24\xf6\xfb\x0f\x94\x4d\x21\x6a\x2e\xa2\x5d\xa1\xc9\x8f\xee\x48\x8c\xd3\x41\x6a\xf2\x79\xb0\x75\xc0\xbb\xce\x1a\xef\x83\x43\xa7\x67\x49\x95\xb6\x92\x9b\x1d\x97\x3b\xd6\x5e\x46\xb7\xd5\xa8\x38\x7d\xde\x93\xf0\x40\x29\xc9\x35\x99\x97\x36\xd2\xca\x50\x25\x0c\x45\x56\xe6\x8a\x4c\xa7\xfb\x93\x36\x46\x6f\xbe\x8b\xb2\xa6\x18\xa7\x57\x6d\x29\x5c\x72\xd0\x7d\x26\x13\x2c\x9a\x33\x10\x30\xbb\x79\x6a\x4d\x9d\xd9\xa6\xa8\x3b\x79\x72\x5f\x4b\x65\x96\x8c\x92\x85\x8b\xee\x4a\x62\x59\x1b\x91\x53\xd2\xda\x9e\xbd\x2f\x87\x1f\x23\xef\xa9\x7f\x1c\x59\x53\x1c\x3c\xfd\xd8\xba\xbc\x17\x5c\xc4\x7b\xf7\x2e\x2f\x51\x09\x25\xd3\x28\x9c\xfb\xb8\xb0\xd4\x64\xa1\x34\xc3\xa9\x25\x08\x75\xd8\x30\xf4\xe2\x27\xa5\x0c\xd1\x16\xa9\x12\x5c\x20\x8c\xb1\xe7\xa3\x7f\x92\x54\xab\x54\x70\x74\x4c\x65\xc2\xfa\x91\x5d\xdf\x44\x71\x1c\xbc\x62\xcb\x1b\x1b\xeb\x5e\xd5\x96\x21\x95\x64\x29\x4a\xf9\x87\x9c\x2a\x69\x7c\x9f\xb9\x7a\x80\x5d\xbb\x8e\x40\xcb\xa4\xb1\x7c\x12\xc6\x81\x77\x34\x99\x20\xa7\x36\x22\xdf\x52\x16\x55\xbd\x28\x65\xea\x6d\xb5\x91\xfb\x3b\xae\x37\xfc\x61\x5c\x38\x03\x1d\x52\x43\x5b\xc6\xc1\x41\xb6\x3a\xc3\x7b\x88\x99\x0e\x62\xfb\x2a\x5f\x43\x17\xe0\x62\xf0\x9e\xa4\xa2\x12\x0b\x59\x4a\x96\x64\x93\x9c\x78\xf6\x56\x87\x1c\x20\xe9\x60\x75\xee\x1b\x95\xae\x38\x5b\xe7\xe3\x8e\xe3\xe8\x18\x58\x5e\xde\xc0\x56\x21\xd6\x04\x81\x5d\x6d\xe8\xb5\x39\xac\xc2\xe0\xad\xd2\x1f\x51\x7a\x14\x23\x8f\x10\xb8\x10\xec\x06\x77\x93\xbb\x92\xa0\x33\x97\x50\x92\xeb\x76\x78\xbb\x36\x4f\xc2\x23\x46\xb6\xc3\x7c\x07\x8a\xee\x25\xd1\x57\xdd\x73\xd9\x51\xd3\x8d\x32\x7a\xa2\xb4\x66\xc2\xf3\x16\x4b\xfd\xa0\xf1\xa3\xd2\x0d\x83\xe6\x87\xde\xa8\x43\xa3\xbc\x47\x56\x65\x8c\xd9\xf9\xde\xb4\xf2\xeb\xa8\x5f\xb8\xf7\xc7\xb0\xde\x26\xf9\x7a\x67\x4e\x4b\xb5\xdd\x3b\x67\xff\x42\x70\xbf\x8c\xd8\x31\x30\xc5\xec\x5c\x65\x1c\x8f\x43\xaf\xb4\xe5\xd1\x78\x3e\xd9\x13\x9d\x13\xdf\x5e\xdb\x28\x76\x05\x61\x21\x95\x1d\xa9\x8f\xa7\x08\xbf\x1a\x99\x4b\x25\xca\x36\x29\xb0\x85\xae\xcb\xe5\x40\x62\x2f\x5f\xa8\xdf\x2b\x6d\x28\x7c\x55\xe9\x31\x3f\x3d\x07\xb4\x1e\x7b\xd9\x38\xb7\xbd\x93\x30\x00\x80\x97\xe0\x25\xf8\x3b\x00\xa8\x12\x5d\x6c\x42\x08\x00\x00"
+
+func transactionsExampleNftTransfer_nftCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsExampleNftTransfer_nftCdc,
+		"transactions/example-nft/transfer_nft.cdc",
+	)
+}
+
+func transactionsExampleNftTransfer_nftCdc() (*asset, error) {
+	bytes, err := transactionsExampleNftTransfer_nftCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/example-nft/transfer_nft.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xd8, 0x4, 0xb8, 0xdf, 0xcd, 0x40, 0x18, 0x4d, 0xe4, 0xee, 0x77, 0x71, 0xee, 0x23, 0x9d, 0xe7, 0xf7, 0xc, 0xf7, 0xba, 0xa1, 0xc8, 0xa, 0xa6, 0x79, 0x76, 0xec, 0x25, 0xa0, 0x5a, 0x78, 0x70}}
+	return a, nil
+}
+
+var _transactionsExampleTokenMint_tokensCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x55\x4d\x4f\xe3\x48\x10\xbd\xfb\x57\xbc\xf1\x01\xd9\x5a\xc6\xb9\xac\xf6\x10\x11\x10\xc3\x2c\x37\xa4\x15\x64\xb9\x57\xec\x4a\xd2\x3b\x4e\xb7\xd5\x5d\x4e\x88\x10\xff\x7d\xd5\xdd\xb6\xb1\x21\x41\x83\x2c\x10\xd5\x55\xf5\xaa\x5e\x7d\xa9\x5d\x63\xac\x20\xbd\x6f\xf5\x46\xad\x6a\x5e\x9a\x5f\xac\xd3\xa4\x17\xff\xfd\x42\xbb\xe6\x93\x74\xa2\xfc\xc0\x42\x15\x09\x3d\x2b\x3e\xb8\x34\x49\x66\xb3\x19\x96\x5b\xe5\x20\x96\xb4\xa3\x52\x94\xd1\x50\x0e\x87\x2d\x09\x64\xcb\xd8\x29\x2d\x6c\x71\x5b\x96\xa6\xd5\x82\xd6\xb1\x83\x98\x20\x86\xe6\x03\xc4\x87\xe0\x3a\x3f\x7c\x44\x63\xcd\x5e\x55\x1c\x6c\x2d\x97\xaa\x51\xac\x05\x54\x55\x96\x9d\x03\xe9\x0a\xb4\x0b\x9e\x3a\x27\x97\x41\xe6\xb5\x47\x9e\xc8\x72\x0c\x68\xcd\xd6\x72\xe5\x01\xbd\xc6\xe0\x65\xed\x43\xf2\x21\x28\xbd\x49\x92\x51\xe8\xd9\x00\x39\xc7\x6d\xd4\xbe\xec\x00\xe7\xf8\xf7\x5e\xbd\xfc\xf5\x67\x8e\xd7\x24\x01\x00\x1f\xf2\x23\xaf\xd9\xb2\x2e\xb9\x87\xe8\x28\x44\x20\x0b\x0f\x31\xf9\x47\x76\xa6\xb5\x25\xc3\xac\xfe\xe3\x52\x82\x75\xcd\x12\x73\x8f\x3a\x73\x5c\x8c\xd9\x2f\xa2\xf4\x0b\xa0\xbe\x2a\x1d\xd2\x23\x97\xac\xf6\x6c\x61\xd6\x53\xea\xa6\x60\xbd\xda\x1c\x17\xaf\x93\xba\x16\xfd\xcb\xdb\x3b\xe6\x32\x90\x2a\x54\xc3\xb5\x4d\x53\x1f\x83\x6f\xef\xc5\x61\xc5\x6b\xe3\x39\xde\x32\x56\xad\xd5\x03\x48\x54\xfc\x11\x5e\x7b\xc2\xa2\xc3\xc6\x72\x43\x96\x33\xa7\x36\xda\xe3\x53\x2b\xdb\xec\x87\xb1\xd6\x1c\x9e\xa9\x6e\x39\xc7\x45\xd7\x23\x9e\x60\x74\x3f\x8e\xeb\x75\x31\x76\x8a\x05\x26\x3c\x89\x11\xaa\x9f\x82\x42\x32\x58\xcd\x66\x88\x9e\x41\xb0\x1f\x89\xa3\x6a\xa7\xf4\xb8\x12\x03\xce\xa8\x1c\x58\x20\x06\x5a\x38\x31\x96\x36\x5c\xac\x82\xc3\xab\x53\x55\xba\xce\xd6\xd6\xec\xe6\xd3\xc0\x6e\x3d\xcc\x53\x34\xfe\x87\x64\x9b\x0f\x58\xfe\xbb\xb9\x41\x43\x5a\x95\x59\x7a\x47\x5a\x1b\x09\xad\x3c\xc7\x53\xc0\x44\x65\xd8\xc1\x4b\x3d\x38\x8f\xfb\x6a\xd2\x56\x4a\xfb\x27\x65\x41\x91\xb8\x6f\x69\xfe\xce\x81\xef\xaf\x3d\xb5\xb5\xfc\x24\xa1\x8f\xac\x59\x76\xa6\xde\xf3\x9d\xd1\x62\xa9\x14\x3f\xcc\x99\xed\x9a\x74\x79\x6c\x78\x0e\xad\xea\x4b\xec\x15\x1f\xe2\xbf\xfe\xf7\xd5\xf9\x45\x50\xdc\x2f\x9f\x7b\xac\xeb\x2c\xcf\x41\xee\x1b\x7e\x4f\xfd\xe6\x1c\x2f\xa6\xad\xab\x40\x42\x17\x2c\x46\x46\x21\xb2\x02\xcb\x0f\xc4\xa4\x13\x5f\xfe\x2b\x4a\xa3\x4b\x92\x2c\x45\xd9\xe5\x0a\xcd\x5c\x85\x15\xa4\xbc\xdd\xce\x6f\x17\xd9\x4e\xbd\xf7\xc1\x06\x18\xf8\x6e\xb1\x15\x5b\x6f\xc3\x2f\x5c\xb6\xe2\x4b\x32\x5d\x79\x45\x9a\xc7\x02\x9f\xe8\xa8\x7e\xb2\xb0\xc0\x86\xa5\x6b\xf2\xf7\x45\x93\x17\x25\x35\xb4\x52\xb5\x12\xc5\x6e\xe8\xb3\x73\xe3\x79\x9d\x0d\x55\x2d\x6c\x27\xfc\xb2\xbf\x06\x1e\x57\xfd\x48\x0c\x01\x7d\x9a\x8d\x09\x26\x02\x21\x3e\xfd\xae\xbf\x70\x9e\xdf\x21\x9b\x42\xcc\x93\x58\xa5\x37\x59\x9e\xbf\x93\x4f\x82\x86\x64\x8b\x74\x90\x9d\x4e\x62\x6c\x7d\x16\x2b\x2d\xf0\x40\xbf\x18\xae\xb5\x8c\xa3\x69\xc3\xb6\x77\xac\x2b\xa5\x37\xbe\x46\xa4\x87\x45\x2f\xfe\x0a\x6d\xc9\x21\xfd\xc2\x1d\x9d\x4c\xdb\xb1\xa0\x6d\xfc\x39\x6a\xd8\xd6\x47\x74\xe7\xcc\x35\x5c\xaa\xb5\xe2\x2a\x24\x34\x94\xbd\xdb\x9a\x7d\x7b\xbc\x4e\x76\xd1\x9d\x65\x92\x78\x09\xfb\x23\xd5\xbf\xfa\x29\xf5\x72\xae\x22\xd9\x57\xdf\x3f\xad\xa2\xc2\xbf\x87\x82\xb8\xac\xbf\x44\xf1\xef\x68\xda\x67\x33\xfc\xe4\xc6\x38\x15\xa2\xdc\xf5\xf5\x0c\xdc\xf2\x9e\xed\x17\x6d\x59\x54\xd1\xb0\x5b\x62\x57\xdf\x47\xf1\x4c\x92\x6b\x8c\x93\xd1\x6a\x3e\xb7\x86\xb1\x58\x9c\x58\xdb\x7f\x0c\x57\x34\xfd\x74\x56\x76\xad\x13\xac\x18\x4a\x97\x96\xc9\x71\x85\xd5\xd1\xa7\xd1\x99\xa4\x09\x00\xbc\x25\x6f\xff\x0f\x00\xa1\x65\xfe\xdf\xbe\x08\x00\x00"
+
+func transactionsExampleTokenMint_tokensCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsExampleTokenMint_tokensCdc,
+		"transactions/example-token/mint_tokens.cdc",
+	)
+}
+
+func transactionsExampleTokenMint_tokensCdc() (*asset, error) {
+	bytes, err := transactionsExampleTokenMint_tokensCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/example-token/mint_tokens.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7f, 0xfd, 0xc, 0x3d, 0xaa, 0x43, 0xca, 0x86, 0xb, 0x1e, 0xc5, 0x5c, 0xcc, 0xf5, 0x61, 0xfb, 0xff, 0x54, 0x1d, 0xf8, 0x47, 0x3a, 0x76, 0x4, 0x2e, 0xaf, 0x65, 0xbd, 0xd2, 0x8a, 0x55, 0xb4}}
+	return a, nil
+}
+
+var _transactionsExampleTokenSetup_accountCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xcc\x54\x4d\x8f\xdb\x36\x10\xbd\xeb\x57\xbc\xe8\x90\x4a\x80\x23\xdf\x17\xbb\xd9\xb6\x6e\x02\xf4\x50\x20\xd8\x18\xbe\x8f\xa9\xb1\x45\x94\x26\x05\x72\x24\xaf\x11\xec\x7f\x2f\x48\x5b\xb2\xec\x6a\xd1\x1e\x03\x10\x06\x3c\x9a\x8f\xf7\xde\x3c\x72\xb9\xc4\xba\xd1\x01\xe2\xc9\x06\x52\xa2\x9d\x85\x0e\x20\x08\x1f\x5a\x43\xc2\xd8\x39\x0f\xba\xf9\x2e\x0e\x64\x8c\x3b\x66\xcb\x25\xc8\x9e\x9c\xe5\x14\xaa\x6b\x10\x36\xd4\x19\x81\xe7\xe0\x3a\xaf\x52\x5c\x1a\xd6\x1e\xa4\x94\xeb\xac\x20\x38\x48\x43\x12\x4b\xa5\xe1\x13\x14\x59\x74\x81\x21\x0d\x83\x5f\xe9\xd0\x1a\x5e\xbb\xbf\xd9\x66\x99\x3e\xb4\xce\x0b\xf2\xaf\x9d\xdd\xeb\xed\x25\x9c\x8f\xe1\x2f\x93\xe4\x6b\x74\xa3\xf9\xf8\xc2\xc1\x99\x9e\x7d\x3e\xdf\xe2\x2f\x16\xaa\x49\x28\xa6\x86\x3c\xcb\xa6\xcc\x8a\x12\x3f\xb2\x0c\x00\x5a\xcf\x2d\x79\x2e\x82\xde\x5b\xf6\x0f\xa0\x4e\x9a\xe2\x77\xe7\xbd\x3b\x6e\xc8\x74\xbc\xc0\x9f\x21\x74\xfc\x5d\x9c\xa7\x3d\xaf\xa8\xa5\xad\x36\x5a\x4e\x2b\x67\xc5\x3b\x63\xd8\x2f\xf0\xad\xdb\x1a\x1d\x9a\xeb\xc7\x05\xbe\x53\xcf\xa9\xbe\xc4\xc7\xdf\xce\x92\x8c\x23\xe3\x31\x2c\xe8\xa3\x84\x7f\x90\x10\x9e\x30\x65\x59\x45\x55\x4d\xcf\x69\x04\x29\x89\x04\x8a\x41\xe9\xf5\xa9\xe5\x07\x58\x6d\x16\xe8\x35\x1f\xcf\x7f\xe3\xef\xe3\xfb\xe4\xab\xaf\xeb\xcd\x30\xeb\x73\x51\x96\xa0\xf0\x01\xff\x2f\xfd\x79\x44\x1c\xcf\xf3\x33\x5a\xb2\x5a\x15\xf9\xca\x75\xa6\x86\x75\x67\x0b\x98\x9e\x31\x29\x4a\xc8\x2a\xac\x1b\xbe\xe1\x95\xdf\xf4\x8a\xa7\x52\xce\x2a\x92\x22\x87\xba\x70\x85\x65\xae\x43\xb4\x93\x8e\x75\x07\xb6\x92\x2c\x33\xed\x3e\x80\x4d\x63\xa0\x2d\x9c\xaf\xd9\xc7\x1a\x7e\x65\xd5\x49\x34\xd9\xad\xd3\xab\xbc\x2c\xaf\xda\x2f\x97\x78\x61\xe9\xbc\x05\x93\x37\x27\xe8\x5d\x1a\x31\x38\x97\x8c\x67\xaa\x4f\x08\xe2\x3c\xc7\x1b\x32\xe5\x70\xf6\xfd\xd8\x4a\xef\x70\x36\x4e\x15\xb3\x69\xcf\xd5\x36\x59\xe7\xf1\xe3\xb4\xa8\x4a\x45\x9f\x8b\x9d\x77\x87\x87\xeb\xda\x87\x9a\x6f\x24\x4d\x89\x0f\x4f\x71\xab\xf8\x31\xf6\x8e\xc7\x27\x9c\x63\xe8\x6d\xc6\x40\x78\xfc\x74\x83\xb0\x52\x9e\x49\xf8\xcb\xa1\x95\x53\x9a\x5b\xa4\xb4\x89\x51\x7e\x9d\xc3\x76\x27\xd0\x2a\x35\x01\xc1\xf2\x71\x46\x00\x90\xad\xd1\x76\x02\x2d\x71\x01\x17\x22\x63\x83\x3b\x4d\x02\xf5\x5c\x3c\x7e\x4a\x38\x16\x10\xf7\x9e\x06\xf3\x08\xda\x78\xbb\x14\xd4\x78\xbb\x2e\x8f\xcd\x05\x49\x7c\x65\xc0\xaf\xad\x0b\x1c\x26\x61\x6d\x85\xfd\x8e\x14\x87\x7f\x4b\xb6\xa2\x16\x4f\xc3\xe2\xc6\xbe\x9a\xc3\x88\x58\xc7\x3b\x3f\xbf\xc4\xb1\x5d\x3c\xb3\x3c\xc6\x8c\xf2\x5e\x90\x9b\x59\x89\x57\x68\x8a\x01\xd2\x02\x24\x53\x65\x0e\x17\x9b\xff\xb7\x34\xab\x79\x69\x7e\x09\x78\x61\xc5\xba\x67\x8f\x5d\x67\xd3\xcb\x47\x31\x6b\xec\x15\x4d\xe4\x2f\x29\x3f\x97\x28\x13\x54\xf7\xba\x0c\x9f\xce\xba\x00\xc0\x5b\xf6\x96\xfd\x33\x00\x2a\x2e\x65\xf3\xe0\x06\x00\x00"
+
+func transactionsExampleTokenSetup_accountCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsExampleTokenSetup_accountCdc,
+		"transactions/example-token/setup_account.cdc",
+	)
+}
+
+func transactionsExampleTokenSetup_accountCdc() (*asset, error) {
+	bytes, err := transactionsExampleTokenSetup_accountCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/example-token/setup_account.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x39, 0xbf, 0xe7, 0x76, 0xe8, 0x73, 0x2d, 0xac, 0x68, 0xb3, 0xd, 0xc5, 0x3a, 0x3, 0x2b, 0x91, 0x61, 0x1b, 0xe3, 0x55, 0xed, 0x44, 0x8a, 0x25, 0xa5, 0x68, 0x3e, 0x61, 0xfa, 0x56, 0xce, 0xbc}}
+	return a, nil
+}
+
+var _transactionsFlowTokenTransfer_flowCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x94\xcb\x6e\xdb\x3c\x10\x85\xf7\x7a\x8a\xf3\x6b\x91\x48\xc0\x1f\x69\x53\x74\x61\xa4\x49\xd3\x16\xe9\xaa\x9b\xd4\x4d\xd7\x23\x69\x64\xb1\x91\x49\x81\x1c\xc5\x71\x03\xbf\x7b\x41\xea\x62\x3b\xa9\x8b\xc0\x06\x0c\xf3\x72\xe6\x70\xe6\x9b\xc9\x73\x2c\x1b\xe5\x20\x96\xb4\xa3\x52\x94\xd1\x50\x0e\x04\xe1\x75\xd7\x92\x30\x6a\x63\x41\x47\xfb\xd2\x90\x44\x79\x8e\xd2\xf4\x6d\x85\x82\xd1\x3b\xae\x50\x6c\x41\x7a\x6b\x34\x43\x0c\x1c\xeb\x0a\x62\x1e\x58\x3b\xff\x97\xb4\x91\x86\x2d\xa8\x2c\x4d\xaf\xc3\x65\x2f\x82\x86\x1c\x0a\x66\x0d\xc7\x82\xbe\xf3\x47\x2d\x97\xac\x1e\x79\xbc\x9c\x45\x79\xee\x4f\x2f\x1b\xc6\x46\x49\x53\x59\xda\x80\xd6\x5e\x04\xe4\x43\x34\x3c\x89\xa2\xb6\x66\x8d\x15\xcb\xcd\x3e\xc8\x66\x72\x28\x0d\xa3\x23\x4b\x6b\x16\xb6\xc1\x92\x5f\x39\x78\x54\x14\xa9\x75\x67\xac\x20\xbe\xed\xf5\x4a\x15\x2d\x2f\xbd\xfb\x78\xbf\xdc\x9a\xcd\xb8\x14\x1d\xdc\x4b\x06\x33\x0b\xfc\xb8\x55\x4f\xef\xdf\xfd\x0f\x31\x0b\xdc\x54\x95\x65\xe7\x52\x3c\x47\x11\x00\x8c\x0f\xb8\xa7\xbe\x15\x58\x76\xa6\xb7\xa5\xf7\xe4\x33\x60\xda\xca\x85\x67\x4c\xd9\xf2\x79\x21\xcb\x28\x58\xe9\xd5\x90\xf7\x9a\xad\xe5\x2a\x48\xb5\x2c\x3e\xb9\x12\xb4\x16\xf8\xf8\x7c\x64\x37\x0b\xcb\xbb\x21\x6a\x67\xb9\x23\xcb\x89\x53\x2b\xcd\x76\x01\xea\xa5\x49\x3e\x19\x6b\xcd\xe6\x9e\xda\x9e\x53\x9c\x8d\xa9\x9a\x8d\x8e\x66\xbf\xb2\x80\x60\xb9\x66\xcb\xda\x3b\x35\xc1\xe1\x20\x74\xee\xe0\xc4\x58\xae\xf0\xe8\x83\xcd\xf7\xbc\xb3\xb0\x72\xc7\x35\x3e\x8c\x87\x33\x7f\x94\x56\x9c\x15\x21\xee\x65\xf0\x70\x6c\xf9\xe7\x58\xd6\x14\x67\x73\x8e\x87\x77\x5c\x25\xbe\xa4\x0b\xe4\xa3\x48\x5e\x4f\xfb\x61\x3b\x9d\x43\xfb\xef\xf5\x35\x3a\xd2\xaa\x4c\xe2\xe5\x6c\x15\x95\x61\x07\x6d\x64\x70\x0c\xc2\x1c\x01\x41\x02\xa6\xf8\xc5\xa5\x80\x64\x24\x44\x1a\xc4\x47\xb2\xd3\x27\x2b\x8d\x2e\x49\x92\xf8\x84\x99\x0c\x71\x1a\xfd\xe5\xde\xfe\xe2\x81\xad\x75\xef\x04\x4a\x2b\x51\xd4\xaa\xdf\x9e\x04\x56\x73\x73\x04\xce\x21\xbe\x29\x43\x3a\x51\x2b\xeb\xe4\xbf\x38\x4d\x8f\x6a\x34\xa5\x6d\xea\x32\x9f\xaa\x37\x54\xc9\x71\x5b\x67\x33\x40\xb8\xbc\x98\x6b\x96\x4d\xfd\x35\x23\x3d\xfc\x0e\xcf\x1a\x99\xe2\x27\x2e\x7b\xe1\xb7\xf1\x62\xb9\x54\x9d\x62\x2d\xe7\x0e\x77\x43\x5b\xdb\xf9\x9a\xc7\x65\xec\x75\x3b\x10\x73\xd0\xbb\x89\x98\xe3\x6c\x66\x25\x75\x54\xa8\x56\x89\x62\x37\xc1\x74\xf6\x02\xfe\x29\xc6\xee\x2a\xc9\xbb\xbe\x68\x55\xb9\x2f\xd2\xb4\x77\x0a\x9a\xcf\x61\x52\x78\x54\x06\x71\xd0\x6c\xf9\xf5\xc3\x5e\x52\xa4\xf4\x5c\xbc\x38\x3a\x05\x80\x98\x4c\xcc\x77\xb1\x4a\xaf\x92\x34\x9d\xb1\xf0\xec\x75\x24\x0d\x4e\x5a\x8e\xd3\x93\x9a\x71\x86\x6f\xf4\xc0\x70\xbd\x65\x6c\x4d\x1f\x06\x87\x9f\xbd\x61\x74\xf8\xb9\x0b\x1a\x66\xd1\x38\x6d\xc8\xe1\x5f\x72\xaf\x1b\x64\x1c\xcc\x9d\x35\x1d\xdb\x76\x3b\x75\x8a\xeb\xb8\x54\xb5\xe2\x2a\xf4\x4c\xf6\x12\xce\x2f\xdc\x19\xa7\x86\xa3\x13\x56\x7a\x22\x55\xe9\x57\x74\x4c\x20\xcc\x1a\x07\x64\x64\xd5\x20\x36\x0e\x83\xcb\x8b\x63\x84\xd3\x08\x00\x76\xd1\x2e\xfa\x33\x00\x80\x1a\xee\x3d\xcb\x06\x00\x00"
+
+func transactionsFlowTokenTransfer_flowCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsFlowTokenTransfer_flowCdc,
+		"transactions/flow-token/transfer_flow.cdc",
+	)
+}
+
+func transactionsFlowTokenTransfer_flowCdc() (*asset, error) {
+	bytes, err := transactionsFlowTokenTransfer_flowCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/flow-token/transfer_flow.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xed, 0xda, 0x67, 0x82, 0x51, 0x68, 0xe8, 0x7f, 0x81, 0x22, 0x5, 0xa8, 0x36, 0x18, 0x56, 0x6d, 0x83, 0x93, 0xd, 0x51, 0xd0, 0x5b, 0xe8, 0x9f, 0x93, 0x2f, 0xf5, 0x7, 0xf5, 0x5d, 0x18, 0xf0}}
+	return a, nil
+}
+
+var _transactionsHybridCustodySell_item_in_child_from_parentCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x58\x5f\x6f\xdb\x38\x12\x7f\xf7\xa7\x98\xcb\x43\x2a\xdd\x25\x72\x71\x38\xdc\x83\x10\x27\xd7\x73\x91\xdb\x00\xd7\x20\xc8\xa6\xbd\x87\x45\x81\x65\xa4\x91\x4d\x54\x22\x05\x92\xb6\x6b\x04\xf9\xee\x87\xa1\x44\x8a\x94\xe5\xd4\x8b\xed\x3a\x8b\xda\xe4\xfc\xe7\x6f\xfe\x90\xbc\x69\xa5\x32\x70\x76\x2f\xc5\xed\x46\xac\xf8\x73\x8d\x4f\xf2\x1b\x8a\xb3\x99\xdb\xf9\x84\x86\x95\xcc\xb0\x2f\x1c\x77\x7a\x58\x3e\x42\x7d\x5b\xcb\x5d\xbf\xe4\xd7\x7e\xd9\x3f\x2b\x5e\x2e\x37\xda\xc8\x72\x1f\xac\xdf\xdf\x3e\xfd\x6a\xa4\xc2\x4a\x49\x61\xbe\xfc\xfd\x6c\x36\x9b\xcf\xe7\xb0\x54\x52\xeb\x4b\x56\x14\x72\x23\x0c\xdc\xdf\x3e\x41\xcd\xb5\xe1\x62\x05\x46\x31\xa1\x59\x61\xb8\x14\x44\x49\xff\xc3\x7f\xb9\x36\x1a\x98\xe8\x08\x65\xc1\x0c\x96\xc0\x05\x98\x35\x82\xe6\x2b\x81\xea\x9d\x86\x62\xcd\xeb\x12\x9c\xc8\x4a\x2a\xd0\xac\x46\x4f\xe6\x6d\x00\x59\x79\x46\x52\xd8\x32\x85\xc2\x78\xc6\x1d\x37\x6b\xab\x94\x68\x46\x7b\x4c\xc3\x33\x0a\xac\x78\xc1\x99\xda\x7b\x41\xac\xc6\x8c\x58\x66\x81\xed\xc9\x0c\x00\x3a\x9b\x3e\x94\xa5\x42\xad\x73\xe8\xbf\x5c\x74\x5b\xb2\xae\xd1\x92\x52\x78\xd8\x0a\x1f\x98\x59\xe7\x10\xfc\x18\xd3\x3d\x6c\x9e\x6b\x5e\x74\x64\xc3\xf7\x8e\x4a\x54\xe6\x69\xdf\xe2\x5d\x89\xc2\xf0\x8a\xa3\x22\x49\x8a\x8b\x55\xb7\x4d\x91\xb8\x33\xd8\xdc\x7d\xcc\xe1\xf3\x9d\x30\xff\xfc\x47\xbc\xfe\xa0\x78\x81\x39\x7c\xbe\xe5\xdf\xdd\x56\x41\x27\x69\x19\x3a\x41\x37\xce\x9c\xa6\xe1\x5a\x73\x29\x3e\x34\x14\x93\x98\x09\xbf\xb7\x5c\xed\x63\x1d\x0d\x53\xdf\xd0\xb4\x35\x2b\x50\xfb\x50\xfc\xd6\x7f\xfb\x3a\x4b\xe1\xc5\x0a\xae\xd1\x40\x55\xcb\xdd\x23\x16\xc8\xb7\xa8\x96\xac\xcd\x61\xc9\x5a\xf6\xcc\x6b\x6e\xf6\x57\xe7\x2f\x11\x18\x33\x47\xf7\x7a\xed\xd9\x5b\x25\xb7\xbc\x3c\x64\x65\x1b\xb3\x4e\xc6\xd8\xcf\xfe\xc7\xcd\xba\x54\x6c\x97\xc2\xf9\xcb\xc1\xe6\xd2\x47\x3d\x90\x3f\x60\x28\x87\x4e\x66\x8c\xed\x6c\xa9\x90\x19\x24\xb4\x72\xb1\x4a\xe1\x7c\xbc\x3f\xfc\xb0\x32\xb7\xac\xc3\xe8\x72\x63\x28\x24\x07\xd4\xdd\xd6\x57\x4f\x1b\x44\x72\xc9\x5a\x62\x39\x29\x3e\x5f\xbd\x03\x3d\x4a\x72\x20\xac\xcc\xec\x72\xab\x90\x40\x9e\xb0\xa2\x70\x5e\xf5\x10\xbc\x18\x42\xc8\x51\xa7\x70\xfe\xa1\xcb\x02\x77\x60\xf4\xd1\x58\x57\x99\x73\x01\x16\xf0\xdb\xd7\x78\x6b\x64\xf1\x04\x45\x6f\x12\x2c\x60\x29\x9b\x56\x6a\x6e\x90\x8c\x4b\x0e\x00\x9d\xc2\xcd\x0d\xb4\x4c\xf0\x22\x39\xbb\x13\x5b\x56\xf3\xd2\x96\x03\xa2\x86\x81\xcc\x81\xa0\x3c\x4b\x67\x5e\x13\x15\x1c\x29\x2a\xbe\xda\x28\x84\x21\xc6\xc0\x2b\x90\x02\xa1\x94\xa8\xc5\x3b\x03\x7b\x34\x80\xdf\xb9\x36\x9e\x91\x57\x94\xfb\x26\xa3\x93\x67\x2b\xcc\x9e\xa5\x52\x72\x77\xf5\xc6\xc1\x5e\x27\x95\x92\x4d\x0e\xc7\x29\x82\x0c\x4f\x61\xb1\x00\xc1\xeb\x20\xa2\xf4\x17\xa9\xd4\x6c\x8b\xc9\xd5\xe5\x58\x5e\x61\xa1\x36\x2c\x25\xe9\x05\x18\x79\xaa\xde\x48\x5d\x0c\xed\xae\xb2\x2c\x59\x0b\x8b\xce\xf7\x22\x40\x81\xb7\x8a\x6b\xbd\xc1\xab\xf3\x97\xe3\xea\x3a\x39\xaf\xd7\x49\xa4\xcb\xfd\x77\x92\x99\x07\x9c\xb1\xdd\x87\xd6\xb5\xa4\x53\xaf\x93\x09\x6f\x2e\x80\x99\xb7\xa2\x33\x14\xd4\x41\xc9\xab\xff\x36\x9f\xc3\xbf\xed\xd1\x03\x03\x85\x15\x2a\x14\x05\x82\x91\x71\x07\x1a\xa4\x79\x46\x8b\xf1\xc1\x1c\x58\xc4\x87\xdb\xe3\xe9\x4f\x16\x93\x89\x18\xff\x01\x10\x46\xbc\x61\x96\x7d\xa2\x3a\x2f\x56\x20\x15\x34\x5c\x5f\x9a\x7d\x8b\x65\x2c\x32\xf0\x78\x94\x6e\xff\x41\x03\x0c\xfc\x9c\x00\xae\x1a\x4d\xb4\x50\xd7\xf8\xcf\xc1\x26\x35\x33\xe8\x05\xd9\xe8\x8d\x9a\xc2\x24\x2c\x57\x68\xde\x28\x80\xc9\xdc\x02\xa3\x98\x57\xce\x1e\xb7\x37\x9c\x35\xd3\x1a\x95\x49\xa6\x34\x66\xc5\x1a\x8b\x6f\x94\x60\x0d\x6a\xcd\x56\x98\xc3\x74\x6c\x06\x77\x55\xcf\x3d\x11\x95\x03\xf8\x44\x73\x8b\xa7\xa6\xa4\x6c\x98\x60\x2b\x54\x6f\x81\x26\x9a\xba\xb2\x4f\x96\x21\x85\xf3\xa9\x65\x75\x14\x26\x93\xd4\xa7\x20\x64\x29\x37\x75\x09\x42\x1a\xe8\x6c\x8a\x73\x23\x12\x0b\xbd\xd8\xb3\x21\xe4\xe4\xa2\x75\xbe\x6f\x2c\xb0\x70\x1e\xf7\x2e\xf6\xeb\x09\x2b\x4b\x95\x47\xb3\xd4\x20\x84\x3e\x83\x41\xf7\x72\x34\x06\xda\x72\xae\xed\x34\x48\xa9\xba\xe2\x5b\x14\x40\xf2\x50\xeb\x89\xc3\x21\x9a\xfb\xdb\x27\xd7\x44\x14\x78\x94\xed\x0f\xcf\x0a\x6c\x11\x2c\xad\x70\x6e\x74\x30\xa9\x4d\x61\x39\x1c\x50\xfa\x8e\x47\xff\xfc\xd9\xe1\x24\x19\xc5\x53\x0a\xa3\xc8\x0e\x75\xf7\x11\x16\x51\x78\xb3\x15\x9a\x65\xb0\x7d\x2b\x15\x19\x90\x10\x76\xf3\xc8\xb4\x0b\x72\x89\x4a\x61\x1e\xf8\x14\xe0\xe1\x8d\xe8\x53\xf0\x1e\x7a\x51\xc1\x08\xe6\x42\xf5\x3c\x15\x46\x77\x38\xba\xc5\x82\x5a\x7d\x09\x2d\x33\xeb\x00\x28\x36\x29\x9d\x81\x5d\x09\x38\x70\xcc\xab\x4a\x8a\xc0\xc7\x3c\x0a\xc8\x05\x1c\xfa\x1a\xfb\xc2\xf4\x5f\x7e\xe2\xe0\x78\x73\x2c\x4e\x47\x83\x64\x73\x89\x15\x05\x6a\x4d\xfa\x6c\x7e\x4e\x44\xeb\x68\xa4\xc2\x2a\x16\x04\xec\xe4\x0a\x36\x61\xd5\x28\x4b\xfa\x36\xe8\x12\xc5\x48\x58\xa1\x81\x47\xb9\x67\x35\x55\x62\xe0\xa2\x92\xaa\x61\x04\x4e\xcf\xd6\x01\xd3\xc5\x05\x16\xc4\xd2\x9f\x5d\x12\x65\x75\x5c\xd4\xfb\x3a\xf7\xa3\x04\xf0\x6a\xdc\x67\x50\x35\x34\xf5\xd3\x4b\xd8\x44\x83\x8f\x0e\xe0\x5d\x98\xe8\x41\xe8\x69\x98\x37\xd2\xb0\xba\x8b\xc5\x7e\xb9\x31\xb0\x80\xf7\xd9\x7b\x4f\x41\x61\xc0\xaa\x22\xd3\xb6\xf8\x6b\x78\xf3\x82\x45\x7c\x13\x83\xcb\x83\x7b\x56\x24\x45\x54\x24\x7b\x30\xa3\x0f\xd5\xfd\xed\x53\xe2\xe4\xdc\x7d\x4c\x8f\xa1\x4f\x52\x13\xb7\xf7\xdc\xa0\x26\xde\x7d\x74\x37\xe5\x93\xbc\xed\x81\x26\x2a\x9b\x7d\x94\x48\x89\x1d\x66\xc3\x99\x3e\x44\x9b\x9f\xd4\x1b\xae\x1b\x66\x8a\xf5\x08\x56\x4b\x02\x28\xec\xd6\x68\xd6\xd8\x55\x03\xe2\xe0\x4d\x5b\x63\x83\xc2\x68\xbb\xe4\x1e\x29\x1e\x51\xcb\x7a\x8b\x8a\xba\xaf\x90\x26\xf3\x82\x78\x05\xbd\x45\xf6\x1d\x23\x49\x33\x4a\x7f\xc6\x85\x4e\x48\xf9\x55\xf4\xca\x91\x79\xd0\x5e\x27\x69\x78\xa9\x71\x51\x56\x6e\xff\x11\x2b\x58\x58\xc9\xaa\xd3\x4c\xfc\x3f\x96\x38\x84\xfc\xb3\x60\x94\xcd\x46\x82\x42\xa3\x38\x6e\xd1\xfa\xe3\x15\x9c\xa5\xc7\x95\xc3\x02\x12\xff\xe3\x11\x2b\x5b\xa3\x8e\xa8\x4d\xe9\x30\xfc\xaf\xa0\x35\xd0\x1f\x95\xd9\x4e\xd0\x9e\x8e\x7a\x50\x10\x7b\xee\x0b\xae\xbb\xd6\x65\xac\x6d\x51\x94\x27\xce\xf1\x1d\x53\xe2\x06\xa0\xdc\xa9\xcc\xdc\xca\x05\xb0\xfe\xe1\xc0\xed\x14\x1b\x03\x7f\x3d\x92\x19\xe9\x0f\xee\x00\xf4\x39\xcc\xba\xf1\xca\xdf\x4e\xd2\x15\x09\x7e\x9d\x1d\x7e\x9b\x0c\xcb\xb1\x08\x78\x2e\xfa\x1b\xc2\x31\x35\x60\x5e\x44\xb4\x2e\x3c\xd3\x46\xc2\xe5\xd8\x3b\xcf\x9c\x06\x29\x55\xc9\xe8\xb9\x80\x0e\x7c\xe2\x1d\x66\x74\xf4\xf3\x39\xfc\x82\x0a\x61\x87\xc0\x14\x42\xc3\xbe\x51\x83\x60\x50\x31\xae\x80\x69\xbd\x69\x5a\x2a\x03\x60\xd6\xcc\x00\xab\xeb\x78\x9c\x42\x0d\x3b\x5b\x4c\xd7\x6c\x8b\x63\xb9\x04\xf7\x70\x96\x92\x2e\x24\x36\x11\x7e\xf7\x93\xf3\xef\x11\xe3\xd4\x1b\xc2\x51\x38\x06\x4d\x25\x60\x49\x7f\xf6\x45\x21\xc6\x60\x07\x8e\xd7\x59\xff\xfa\x85\xc5\xc6\x60\x10\x55\x7a\x78\xb0\xd7\x38\x77\xcb\x39\x76\x31\xcc\x8a\xf0\xb6\x17\x3b\x27\x2a\xe3\xfa\xf2\xd0\x96\x73\x18\x37\xf9\x18\x45\x7d\x0d\xce\xe3\x8a\x3c\x26\xa1\xe7\xbd\xa1\x63\xc4\x12\x68\xfd\x81\xed\xa9\xfc\x7e\x61\x9b\x3a\x78\x39\xba\xfa\x97\x3f\xaf\xcc\x6e\x5d\x27\xe9\x21\x2f\xd5\x8e\x3c\xce\x99\x98\x28\x38\x25\x7d\xe0\x58\xb0\x69\x4f\xbd\x46\xb1\x32\x6b\x6a\x31\xef\xe1\xc6\xbe\x99\x4c\x13\xc6\x2a\x86\x47\x4c\xf7\x6d\xb4\x7f\xf0\x9a\x39\xee\xbb\x31\xbd\x7b\xdf\xec\xfe\xf5\x5b\xe9\x0c\x00\xe0\x75\xf6\x3a\xfb\xff\x00\x32\x4a\x6c\xbb\x6a\x17\x00\x00"
+
+func transactionsHybridCustodySell_item_in_child_from_parentCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsHybridCustodySell_item_in_child_from_parentCdc,
+		"transactions/hybrid-custody/sell_item_in_child_from_parent.cdc",
+	)
+}
+
+func transactionsHybridCustodySell_item_in_child_from_parentCdc() (*asset, error) {
+	bytes, err := transactionsHybridCustodySell_item_in_child_from_parentCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/hybrid-custody/sell_item_in_child_from_parent.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb, 0xb7, 0xa4, 0xc3, 0xfd, 0x26, 0x5d, 0xe4, 0xbe, 0x61, 0xc4, 0x86, 0x6e, 0xa5, 0xcc, 0xf9, 0x40, 0xd3, 0x87, 0x1d, 0x15, 0x69, 0xc7, 0xac, 0x12, 0xe2, 0xd7, 0xcb, 0x29, 0x4f, 0xb9, 0x18}}
+	return a, nil
+}
+
+var _transactionsHybridCustodySetupDevSetupSetup_nft_filter_and_factory_managerCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x58\x5f\x6f\xdb\x38\x12\x7f\xd7\xa7\x98\x33\x70\x81\x54\x38\x52\xd3\xa7\x83\x11\xa7\x97\x0b\x10\xf4\x1e\x1a\x04\xad\x17\xfb\xb0\x58\x14\x34\x39\x92\x89\xc8\xa4\x96\x1c\x25\x35\x02\x7f\xf7\x05\xa9\xff\x96\xec\x34\x4d\x17\x70\x51\x85\xf3\xf7\x47\xce\xfc\x46\x94\xdc\x16\xda\x10\xcc\x6e\x58\xc1\xd6\x32\x97\xb4\xbb\x95\x39\xa1\x99\x05\x13\x12\xc6\x49\x9b\x5d\x27\xba\xbb\x5d\xdd\xe8\x3c\x47\x4e\x52\xab\xd3\xd2\xfb\x72\x9d\x4b\x3e\xa5\x73\x6f\xf4\xa3\x14\x68\xae\x95\x38\xed\xac\x51\x1c\xc9\x26\x44\x9d\x9d\x56\xb7\xa5\xca\xe4\x3a\xc7\x95\x7e\x40\xd5\xb3\x1a\x2e\x07\xc9\x3b\x38\x3f\x3f\x87\x4f\x98\x17\x68\xe0\x33\xd2\x46\x0b\xeb\x97\xde\x25\x41\xe2\x7e\x09\x7c\x41\x2a\x8d\xb2\xc0\x80\x76\x05\x82\x14\xa8\x48\xa6\x12\x0d\xa4\xda\x00\x53\x70\x77\xbb\x82\x0e\x85\xb3\x09\x18\xe7\x68\x6d\xc8\xf2\x3c\x82\xb4\x54\x20\xd0\xc8\x47\xec\x94\x56\xbb\x02\xff\xdf\x3a\x0a\xbf\x01\xd7\x8a\x0c\xe3\x74\x2d\x84\x41\x6b\x17\x50\x3f\xcc\xa1\x93\xdd\xb1\x2d\x2e\xe0\x2b\x19\xa9\xb2\xa8\x79\x80\xe7\x00\x00\xc0\xf8\x24\x61\x76\x1d\xcf\x62\xae\x15\x67\x14\x3e\x49\xda\xe8\x92\xee\x0d\xa6\xf2\x7b\x78\x10\x20\x26\x5d\xd9\x87\x51\x14\x35\x16\xb3\x78\xd6\x3e\xf7\x83\xf6\x14\x3a\x08\xb3\x28\xd8\x07\x0e\x2c\xac\xd8\x03\x2a\x48\x8d\xde\x36\x59\xff\x46\x32\xb7\x50\x18\xf9\xc8\x08\x61\xeb\xb7\x75\x72\x5f\x86\x39\x7e\x03\xa9\x8a\x92\x46\x18\x2b\x88\x8f\xcc\x00\xab\xfc\x2f\xbd\x5e\xe0\x97\x93\x24\x43\x02\x23\x05\xe8\x14\xde\x7f\xf7\x6b\x32\x6d\x34\xe3\x1c\x55\x46\x9b\xab\x0b\x38\x3b\x6b\xd7\x4a\x4a\xff\xf3\xc7\xc5\x9f\xb0\x5c\xc2\xc5\x87\xf7\xf5\x0e\xba\x5f\xad\x00\xcb\x56\xd5\xe6\x92\x63\xe8\xb0\x2d\xe0\xc3\x1c\xca\x62\xa5\x17\xad\xb0\xf2\x1d\x79\xf3\x7d\x93\x0d\x2a\x5b\x1a\x04\x7c\x44\x05\x95\xc2\x74\x4a\xff\xfe\xb0\x5c\x5e\x8c\x42\x2f\x67\xef\xdb\x03\xac\x97\x1a\xff\xbd\x63\xae\x25\xfe\x00\xde\xf9\x72\x5d\x19\xa6\x2c\xf3\x5d\x07\xff\xcb\x35\x7f\x38\x28\xe2\xd5\x46\x5a\xa0\x9e\x12\x67\x0a\xd6\x08\xa5\x45\x01\xeb\x1d\x6c\xb5\x25\x10\xf8\x88\xb9\x2e\xd0\x58\x90\xdb\x22\xc7\xad\x2b\x50\x95\xc1\xa7\xdd\xda\x48\x71\x53\x5a\xd2\x62\x07\xcc\x02\x6d\x10\xac\x54\x59\x8e\x50\x18\x3c\x37\xf8\x57\x29\xad\x24\xec\x47\xf0\x61\x49\x83\x45\x2a\x0b\x48\x3d\xc1\xb8\x33\xf7\x39\x32\x47\x2d\xb0\x46\x7a\x42\xb7\x4d\x52\x3d\xa0\x80\x82\x19\x54\x04\x4c\x09\xe0\x1b\x99\x0b\x60\x9c\xeb\x52\x91\x8d\x9d\x2f\xf7\x0f\x6e\x0c\x32\x42\xd7\x8b\x23\x86\x82\xcf\x4c\xb1\x0c\x5d\x47\x8a\xbe\xd4\x07\x8e\xaf\xf3\x5c\x3f\xe5\xd2\x52\xc5\x74\x20\x55\x0d\x22\x53\x52\x65\x4d\x24\x08\x65\x0a\x0a\x51\xa0\x88\xe6\xee\x98\xa5\xca\x7c\xdc\x29\xd2\x9b\xc3\x71\xb2\x9b\x43\x8f\xbb\xa6\x48\x6e\x0e\x67\x7d\x95\x7a\x15\x48\xbb\xb4\x7c\xc8\xa3\x00\xbd\xd4\x81\x74\x00\x3a\xc7\xe0\x38\xa5\x76\xf0\x22\xfe\x76\x43\x6f\xb5\x81\xad\x36\x08\x52\xa5\x7a\x0e\x16\x11\x84\xe6\x16\x18\xc1\x86\xa8\xb0\x8b\x24\xe9\xaa\x22\xd6\x2a\xcd\xf5\x53\xac\x4d\x96\x38\xad\x64\xe3\x0b\xe3\x9c\x57\x95\xe1\x8a\x2d\x49\x82\x5e\x0d\x84\x2a\xa5\x9b\xa3\xcc\xd6\x13\x0e\xa8\xad\x6e\xc8\xc2\xa0\xab\x88\x90\x71\x4e\x0b\x60\x25\x6d\xc2\xaf\xa4\x0d\xcb\x70\xde\xe1\x93\x68\x23\x38\xbb\xae\x8e\x2f\x82\xe7\xa0\x6d\xa8\x9a\xda\x8f\x6e\xa3\xa3\xd5\x54\x66\xa5\x61\x7e\xf7\xea\x76\x69\xad\xbb\x47\x47\x26\x9c\x53\x6c\xab\xe0\xf1\x5a\x1b\xa3\x9f\x2e\xcf\xae\xd5\xee\x0b\x5a\x5d\x1a\x8e\x57\x35\x43\x8c\x82\xc5\x75\xc6\xf7\x8c\x36\x91\xe3\x1b\x25\xf3\x1e\xdf\xb8\x5f\x8e\x04\x29\x5c\x4e\x64\x1a\x73\x5f\xed\xf5\x5f\x75\xda\x61\x34\xb0\x1e\x64\x66\xd9\x23\x86\x97\xe7\xe9\x1c\x48\xbf\x94\x4c\xeb\x65\x1f\xf4\x91\xfe\xcb\x3b\xe4\x8d\xa9\x44\x1b\x67\x48\x97\x67\x63\x67\x75\x3e\x57\xe1\x58\x54\xb5\x82\xc7\x1c\xf3\x0d\xf2\x87\x30\x3a\x00\x3d\x8e\x52\xaa\xc2\x59\xd9\xcd\x69\x7f\x2f\x78\x69\x7c\x0c\xd4\xa6\x55\x9b\x4d\x93\xd6\x96\xf8\x4a\x80\xfd\x8d\x9c\x8f\x63\xd1\x02\x4e\x81\x18\x18\x4c\x1e\x04\xb3\x16\x0d\x85\xc1\x69\x04\xbf\xe4\x60\x86\xe9\x6f\xd1\x5a\x96\xe1\x62\xe2\x0d\x10\xa4\x05\xa5\xa9\xe6\xf3\xc2\xb8\x39\x91\xef\x66\xad\x79\xd4\xa5\xef\x4b\x7a\x50\xb5\xb0\x1c\x56\x6a\xdd\x43\xbe\xa9\x3f\x97\xc4\x08\x23\x38\x05\xe5\x47\xda\xab\x0d\xef\x7e\x1f\x3f\x42\xc1\x94\xe4\xe1\x04\x90\x26\x27\x87\x26\xd5\xa5\x12\xb3\x5e\xee\x49\xe2\x5e\x62\x1c\x37\x9f\x1b\xcc\x19\xa1\x80\x2a\x98\x44\xdb\xb0\x6b\xed\xa0\xb5\x19\x62\x8d\xcb\x42\x74\x6d\x1b\x3a\x5a\xae\x80\x1e\xbe\x96\xc6\xbf\x4b\xda\x08\xc3\x9e\x22\x38\x7b\x1e\x09\x3b\x66\xdf\x5f\x85\xd1\xc1\xb0\xa9\x9d\xb7\x1e\x9a\x60\x51\xf4\x8a\xa4\x4e\x06\xad\x4a\x65\x22\xf4\x60\xce\xc5\x3f\x15\xf8\x27\x76\xa3\x19\x94\x73\xf8\xf1\x9c\x4f\xcd\xdf\xb7\xed\xdc\x1b\x00\x8c\xb2\x3b\x91\xd0\xe1\x2c\x3b\x7c\x89\x79\xeb\x04\x7b\xe9\x2d\x61\xa2\xef\x7c\xe0\x1f\x98\x6a\x53\x73\x69\xe4\xa6\x9e\x6f\xde\x67\xb5\xb1\xff\x7d\x39\xa5\x28\x1a\x8d\xb7\x71\x52\x53\xa4\x7a\x62\xba\x3d\x8f\x9c\x55\xff\xed\x07\x24\xea\x97\x7e\xdd\x70\x1b\xb9\xfb\xc7\x66\xdb\x6b\xe0\xbd\x6a\xb4\x1d\x42\x18\xe8\xbf\x75\xb2\xbd\xf1\x50\x8e\x0d\xb6\x83\x82\x7a\xed\x58\xab\x8c\x4e\x8c\xb3\x51\x6e\xd7\x42\x0c\x87\xdb\xcf\xf7\xda\x91\x11\x77\x08\x49\x68\xac\x40\xe1\x77\x69\xe9\x60\xba\xdd\x68\x65\xc9\x94\x9c\xc6\x9f\x2e\xaa\x2b\x84\xeb\x79\x77\x0b\x81\xa2\x62\x28\x01\xcc\x64\x16\xfc\xf5\xb9\x99\x80\x07\x11\x5b\xff\x6e\xf2\x73\x58\xc2\x8d\xde\x16\xda\xdd\x08\x9d\xc7\xf0\x85\xaf\x1f\xe3\x4b\xc2\xe8\x6e\x10\x1d\x83\x7e\x6f\xf4\x3a\xc7\xad\x7b\x99\xaf\x50\xb9\xdb\xdc\x20\x7a\xf5\x61\x22\x93\xee\x3e\x7e\x77\xbb\x6a\xbf\xa6\x34\x37\x6e\x7f\x5f\x54\x6c\x8b\xb3\x2e\x46\x75\x5b\x8d\x99\x10\x2e\xd5\x90\x47\x01\x00\xc0\x3e\xd8\x07\x7f\x0f\x00\x6f\x69\x9d\x5c\x3b\x13\x00\x00"
+
+func transactionsHybridCustodySetupDevSetupSetup_nft_filter_and_factory_managerCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsHybridCustodySetupDevSetupSetup_nft_filter_and_factory_managerCdc,
+		"transactions/hybrid-custody/setup/dev-setup/setup_nft_filter_and_factory_manager.cdc",
+	)
+}
+
+func transactionsHybridCustodySetupDevSetupSetup_nft_filter_and_factory_managerCdc() (*asset, error) {
+	bytes, err := transactionsHybridCustodySetupDevSetupSetup_nft_filter_and_factory_managerCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/hybrid-custody/setup/dev-setup/setup_nft_filter_and_factory_manager.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa, 0xdc, 0xfc, 0xf7, 0x53, 0x40, 0xe2, 0xe, 0xee, 0xe4, 0x8e, 0xdb, 0x49, 0x46, 0xca, 0xff, 0x82, 0xbd, 0x39, 0x80, 0x54, 0x75, 0x75, 0x53, 0x3d, 0x23, 0xb6, 0x5a, 0x3c, 0xfa, 0x45, 0xaa}}
+	return a, nil
+}
+
+var _transactionsHybridCustodySetupLinkingRedeem_accountCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x54\x4d\x6b\xe3\x30\x10\xbd\xfb\x57\x4c\x73\x30\x32\xb8\xfe\x01\x26\x1f\x94\xc2\xb2\x3d\x74\x29\xbb\xb0\x77\x45\x1e\x27\x02\x5b\x32\xd2\x38\xdd\x10\xfc\xdf\x17\xd9\x52\x1c\x27\x6e\x53\x28\x0a\x44\xd6\x48\x6f\xde\x7b\x1a\x8d\xac\x1b\x6d\x08\x16\xaf\x48\xbc\xe0\xc4\xff\x4a\x7c\xb7\x8b\x28\x2c\xbb\xcf\xdf\x68\x75\x75\x40\xb3\x88\xce\xcb\x3f\x8f\x5b\x23\x8b\xe7\xd6\x92\x2e\x8e\xe3\xee\x67\xde\xf0\xad\xac\x24\x1d\x7f\xc8\x8a\xfa\x13\x64\xb8\xb2\x5c\x90\xd4\x8a\x89\xbd\xac\x8a\xa7\xa2\x30\x68\x6d\x0e\x7e\x92\x42\xd9\xef\xbd\x5e\xdf\x84\xc0\x1b\xa7\x7d\x0e\x6f\xed\xb6\x92\xc2\xcd\x37\x09\x9c\x22\x00\x80\xc6\x60\xc3\x0d\x32\x2e\x04\xe5\xc0\x5b\xda\xb3\x3f\xa4\x0d\xdf\x61\x0a\x67\x26\x12\x6d\x0a\x2f\x6a\xab\xff\x25\x10\x3f\x09\xa1\x5b\x45\x01\xc0\x8d\x03\x37\x3e\x4f\x3e\x1e\x3a\x2e\xe3\xd3\xf8\x31\x68\xc9\x86\xbf\x6e\xbd\x81\x15\x28\x59\x9d\x11\x64\x39\x55\x00\x0f\x7d\x1c\xe2\xd8\xaf\x3b\xd2\x61\x71\x4c\xec\xc6\x10\x87\x15\xec\x90\x3c\x37\x36\xc1\x7a\x48\x32\x11\x78\x48\xb4\xd9\x0e\xe9\x33\x6a\xfe\xb0\x4b\xf8\x90\x9c\x33\x75\xd1\x79\x2a\x4b\x70\x6e\x65\x76\xf0\x29\xdb\x6a\x63\xf4\xfb\x32\x9e\xdc\x67\xf6\xca\x15\xdf\xa1\x59\xb3\xd2\xe8\x3a\x87\xd9\xa0\x77\xda\xa5\x4a\x60\x35\x27\xae\x42\x82\x1a\x96\x8f\x57\xe7\x85\x41\x4e\xe8\x51\x3c\xe1\xdc\x3b\x31\x72\x76\x63\xc2\xd4\xf2\x03\xb2\xe5\x23\xd4\x29\x90\xfe\x02\xa7\x51\xb3\x1b\xa5\x36\x20\x40\xaa\x41\xfd\xc4\xd2\x90\x60\x87\xf4\xac\x15\x19\x5d\x55\x68\x2c\x2b\xb5\x2f\xbc\xbb\x99\xae\x64\xbb\x9f\xc8\x0a\xac\x90\x90\x4d\x05\x75\x93\xaf\x5b\x2a\xad\x6a\x5c\x95\xdb\x3d\x9b\x4d\x3a\x3e\x81\x24\xba\x83\x14\x44\x49\x6b\x5b\x5c\xf6\x4f\x63\x0e\x32\x81\xf8\x34\x9f\xca\xc8\x03\x27\x4c\xe7\x7d\x1e\x88\x74\x6b\x76\xd7\x9b\x3b\x34\x83\xdc\xc9\xb6\xaf\x28\x8a\x4f\xdf\x24\x96\xde\xa6\xa4\x1c\xee\xd8\x3e\x39\x33\xfb\xbe\x5c\xcd\x4b\xd7\x6c\x7e\xf1\x1a\x61\x75\x05\xe8\x4a\xac\x6f\x81\xc3\x53\x7f\x29\x50\x91\x2c\x25\x9a\xbe\x85\x65\x7c\xe8\x1f\x23\xb0\x43\x13\xbc\x81\xd5\x60\x5d\x0f\x9c\x89\x8a\xcb\x7a\xee\x4a\x7b\xe8\xdb\x1b\xf5\x7d\xe5\x83\x1b\x0d\xd1\x5e\x63\x0a\x97\xed\x3e\x0b\x93\x6e\xcd\xce\x9a\x52\x68\x8c\x3e\xc8\x02\x4d\x0e\x97\xed\x7c\x24\xed\xc6\x66\x03\x0d\x57\x52\xb0\x45\xbf\xc7\xd1\x77\x82\x7b\x31\x4a\x13\x94\xba\x55\xc5\xe2\xa2\x8a\x9d\xd2\x7a\x28\x9f\xa0\xf6\xaa\x45\x7d\x56\xc3\xdf\xeb\x5e\x1f\x30\x0f\x74\x94\xbe\xa5\xeb\x63\xee\xc6\xbc\x81\x4c\xf0\x26\x77\xfa\x92\x08\x00\xa0\x8b\xba\xe8\xff\x00\x49\x88\x1b\x11\x5a\x07\x00\x00"
+
+func transactionsHybridCustodySetupLinkingRedeem_accountCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsHybridCustodySetupLinkingRedeem_accountCdc,
+		"transactions/hybrid-custody/setup/linking/redeem_account.cdc",
+	)
+}
+
+func transactionsHybridCustodySetupLinkingRedeem_accountCdc() (*asset, error) {
+	bytes, err := transactionsHybridCustodySetupLinkingRedeem_accountCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/hybrid-custody/setup/linking/redeem_account.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb6, 0xc5, 0xff, 0xbc, 0x25, 0xc6, 0xdd, 0x23, 0xec, 0x88, 0xcb, 0x55, 0xc6, 0x70, 0xc5, 0x73, 0x71, 0x1a, 0x44, 0x97, 0x2b, 0x88, 0x93, 0xf9, 0xf3, 0x4c, 0xd8, 0xc6, 0x36, 0x42, 0x83, 0xf9}}
+	return a, nil
+}
+
+var _transactionsHybridCustodySetupLinkingSetup_owned_account_and_publish_to_parentCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x56\x5f\x6f\xe3\x36\x0c\x7f\xcf\xa7\x60\x33\x20\x73\x00\x9f\xf3\x6e\x34\x3d\x74\x3d\x74\x37\xac\x87\x15\x6d\x6f\xef\x8c\x4d\xdb\xc2\x29\x92\x21\xd1\xed\x05\x45\xbe\xfb\x20\xd9\x96\xed\xfc\x29\x82\xe1\x92\x20\x96\x45\x89\xfc\xfd\x48\x91\xe2\x6f\x28\xa5\x7e\xbb\xcd\x32\xdd\x28\x7e\x10\xea\x87\x50\xe5\x6c\x26\xb6\xb5\x36\x0c\xf3\x6f\xc4\x98\x23\xe3\xbf\x82\xde\xec\x3c\x4c\xbb\xd7\x27\xb2\x5a\xbe\x92\x99\x0f\xab\xbf\xee\x36\x46\xe4\x77\x8d\x65\x9d\xef\x86\xd5\x77\x58\xe3\x46\x48\xc1\xbb\x7b\xcc\x58\x9b\xd3\x22\x21\x99\xcc\x29\xc9\x17\x92\x54\x22\x6b\x67\x69\xb5\x5a\xc1\x4b\x25\x2c\xb0\x41\x65\x31\x63\xa1\x15\x64\x5a\x15\xa2\x6c\x0c\x59\x40\x05\xff\xbc\x29\xca\x3b\x3e\x20\x14\x70\x45\x60\x45\xa9\xc8\x80\x28\x40\x11\xe5\x94\xc7\x80\x2a\x87\xda\xe8\x8c\x28\xb7\xc0\x1a\x32\x43\xc8\x04\x08\x77\x95\x90\x61\xbb\xb7\xd7\x58\xa1\x4a\x18\x21\x6d\x49\x24\xdf\x50\x61\x49\xc6\xab\x1a\x49\x3d\x8f\xa4\x7d\x0c\xbb\x04\x59\x28\x8c\xde\x7a\x38\xa5\x78\x25\x05\x98\xe7\x86\xac\x25\x9b\xc0\xad\x37\x34\x28\x01\xdd\xe2\x9e\x80\x71\xac\x2b\x52\x50\x37\x1b\x29\x6c\x45\xb9\xc3\xed\x56\xd9\x9a\x32\x51\x08\xca\xa1\x46\x43\x8a\x01\xdb\x1d\x09\x38\xb5\xb3\x91\xab\xa2\x19\x74\x9f\x76\x65\x0a\xb7\x2d\x88\x38\x08\x8a\x96\x5d\x37\x7f\x6a\x81\x67\x76\x5e\xae\x70\x4b\x29\x3c\xb3\x11\xaa\xfc\x3c\x4c\xe7\x64\xb3\x13\xd3\x5c\x35\xdb\x8d\x42\x21\xbf\x3f\x3d\x04\xb1\x97\x2e\xe1\xdd\x3f\xfd\x5f\x6d\xc8\x21\x8e\x30\xcb\x38\x05\x6c\xb8\x8a\x9e\x59\x1b\x2c\x29\x9e\xf8\x78\x09\x8b\xce\x5d\xfd\x76\xf7\x75\xbe\xed\xcf\xc8\xc1\xf9\x28\x40\x30\xe4\x9a\xac\xfa\x9d\x81\x7e\x0a\xcb\x61\x97\x28\x9c\x23\x39\xb1\xad\xa1\x64\xa3\x8d\xd1\x6f\xd7\x8b\xc9\x29\x4f\xc6\xea\x6e\x22\x17\xe2\x14\xce\xaf\xe8\x40\x3f\x22\x57\x4b\x58\xaf\x41\x09\x39\xc2\xe9\x7e\x92\x7c\xfc\xf8\x0e\x6b\x58\xfb\x51\x92\x8d\x08\x26\x7d\x6c\x85\xb5\x0d\x5d\x1f\x78\x42\x2b\x36\x98\xb1\x8d\xe1\x6f\xda\xd9\x18\xfe\x52\x1b\xfd\xf3\x9c\x87\x6e\xa2\xe5\x91\x65\x3d\xc2\x0a\xd7\x9f\x0e\x98\xb4\x49\x32\xe6\xd3\x07\xa4\x05\x3c\xd5\x37\x71\x9e\xc5\x57\x8a\xae\x3f\x8d\xf5\xc7\xc0\xfa\x52\x5f\x05\xc5\xfb\x59\x18\xae\x56\x90\x55\x94\xfd\x00\xae\x90\xa1\x46\xae\x2c\xa0\x21\x40\x29\x87\x92\xe0\xb3\xbc\x26\x23\x77\x61\x5f\xa1\x0d\x64\xae\x32\x1c\x3b\xb7\x47\x5b\x12\x7b\x5f\x6a\x29\xc9\xd8\xa8\xd0\xc6\x45\xec\xe2\xc0\x4e\x23\x9a\x25\x39\x49\x62\x1a\xb9\x7b\x1f\x46\xe7\x31\xb4\x01\x5e\xbc\x4f\x6d\xfe\xe1\x0f\x21\x6e\x24\x75\x86\xe3\x0f\x40\x3d\xba\x4a\x91\xc5\x30\x2e\xd7\x49\x3f\xd8\xdf\x44\x97\xd1\x99\x7d\x00\xb6\x51\x5d\x35\xfa\x40\x57\x8b\x62\x1a\xc6\x63\x4d\xbd\x9e\xb0\xe4\xff\x78\xe7\xd7\x93\x1f\x2a\x95\xfb\x22\xa7\x70\x09\xd1\xb0\x69\xe4\xbc\x90\x5e\x7d\x5a\x1f\xd4\x15\x9f\xca\xc7\xca\xcd\x12\x7e\x59\xc5\x09\x58\xdc\xef\xf3\x67\xa8\x51\x89\x2c\x9a\xb7\xa8\xba\xc2\x02\x4a\x33\x14\xba\x51\xf9\x7c\x08\x57\x18\xac\x56\xf0\x4c\xec\xee\x21\xc8\x85\xad\x25\xee\x60\xdb\xb5\x08\x3e\xaf\x9c\x60\x6c\x3d\x6c\x74\x57\x2f\x6e\x09\xae\xda\x9a\xb7\x58\xf8\x0b\x61\xf4\x3a\xbe\x08\xe0\xea\x5c\x65\x0c\xab\x60\x0d\x93\xde\x24\xf9\xfa\xf2\xf2\x78\x2f\x24\x45\x8d\x91\xe9\xb0\xee\xfb\xd3\xc3\xd5\xf2\x48\x4d\x8f\xfd\x50\xc9\x97\x76\x3e\x6a\xef\x30\xf7\x7f\x15\x7b\xa0\x46\xd4\xae\xd5\x48\xfd\xcb\x55\x3c\xe8\x1f\x99\x9a\x9a\xf1\x4e\x4d\x2c\x71\xaf\xb3\xb3\x79\xae\x94\xfd\x49\x3c\xd4\xe9\xbe\x51\x82\xc5\x78\xee\xb8\xa5\x08\x0a\x1c\xa9\xee\xe6\x86\x35\x94\xc4\x9d\xff\xa3\xe9\x75\xbe\x9c\x26\x53\x49\x7c\xbd\x38\xdb\xd8\xdc\x44\xc7\xa2\x93\xb9\x6c\x2d\x99\x60\x29\xf1\x35\x39\x5a\xc6\xb0\x25\x6b\xb1\xa4\x14\xe6\x9d\xa8\xef\x78\x40\x58\x7f\xca\x4e\x14\xe9\xf9\x41\xc2\x14\x2d\xe7\x29\xa5\x71\x03\x72\x8a\xd1\xfb\x99\x6e\x6c\x3f\x61\xe4\xa7\x3e\x24\xd4\xae\x38\xc1\x27\x98\xdc\x75\xdd\xd0\x85\x8c\x56\x2b\xb8\x17\x0a\xa5\xdc\xf5\x4d\xdc\x61\xc3\x99\x1d\xb5\x80\xae\x75\x75\xfd\x67\x9f\x9e\x67\x7a\xbe\x60\xa3\x3d\x77\x9d\xfa\x17\xfd\xe8\xa5\x91\x6b\x9e\x14\x77\x3e\x4b\xbb\x3d\x71\x7f\x64\xd2\x7e\x10\x77\x7c\xd2\xee\xb9\x9c\x01\x00\xec\x67\xfb\xd9\x7f\x03\x00\x93\xe8\x52\x40\x27\x0c\x00\x00"
+
+func transactionsHybridCustodySetupLinkingSetup_owned_account_and_publish_to_parentCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsHybridCustodySetupLinkingSetup_owned_account_and_publish_to_parentCdc,
+		"transactions/hybrid-custody/setup/linking/setup_owned_account_and_publish_to_parent.cdc",
+	)
+}
+
+func transactionsHybridCustodySetupLinkingSetup_owned_account_and_publish_to_parentCdc() (*asset, error) {
+	bytes, err := transactionsHybridCustodySetupLinkingSetup_owned_account_and_publish_to_parentCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/hybrid-custody/setup/linking/setup_owned_account_and_publish_to_parent.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xb8, 0x3d, 0x65, 0x35, 0xdf, 0x7d, 0x99, 0xcd, 0xe1, 0xbc, 0xcb, 0x74, 0xc5, 0x79, 0xfe, 0x6c, 0x88, 0x49, 0x8d, 0x75, 0xc, 0x6d, 0xd1, 0xa5, 0xd8, 0xcf, 0x74, 0xf2, 0x0, 0xd5, 0x14, 0x2a}}
+	return a, nil
+}
+
+var _transactionsRemove_itemCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x4f\x6f\xd3\x40\x10\xc5\xef\xfe\x14\x8f\x1c\x2a\xfb\xe2\x48\x15\xe2\x10\x01\x15\x08\x21\x55\x02\x84\x4a\xc8\x89\x43\x27\xeb\xb1\xbd\xc8\xd9\xb1\x66\xc7\x09\x50\xe5\xbb\xa3\x35\x4e\xd3\x36\x44\xaa\xed\x83\x67\xed\xf7\xe6\x37\x7f\xfc\xa6\x17\x35\xcc\xbe\x7c\x5c\x7e\x33\x51\xae\x55\x82\xad\x2e\x67\x59\x36\x9f\xcf\xb1\x54\x0a\x91\x9c\x79\x09\x30\x41\x4d\xce\x77\xde\xc8\x18\xd6\x32\x94\x37\xb2\xa5\x0e\x52\xa3\xf3\xd1\x7c\x68\xb0\xfe\x3d\x7e\x39\x84\xb2\x0b\xac\x25\x3e\x3d\x0c\x11\x5b\x19\xba\x0a\xbd\xca\xd6\x57\xa3\xd3\x98\xeb\x76\x12\xdd\x70\x94\x41\x1d\x5f\x7f\xb8\x85\xb5\x64\x08\xcc\x55\x4c\xe9\xd7\x53\x4a\xae\xca\xa4\xc8\xec\x48\x97\x9f\x88\x17\xf8\x7e\x1d\xec\xd5\xcb\x02\x77\x59\x06\x00\x1d\x1b\xe2\x7d\x89\x0b\xd0\x60\x6d\xfe\xa4\xec\xf2\x26\x95\xc4\x13\x6f\x81\x8b\xbb\xa7\x3f\x1c\x83\xcf\x14\xa8\x61\xdd\xff\x73\xef\x95\x7b\x52\xce\xc9\xb9\x83\xf7\x7b\x51\x95\xdd\x8a\xba\x81\x0b\x5c\xbc\x73\x4e\x86\x60\x09\x07\xd3\x15\xb9\xab\xcb\x23\x12\xde\x20\xa9\xc7\x13\x6a\xb8\x5c\x8f\xfa\xd7\xcf\xe2\x3c\x8f\xf9\x36\xbf\xcf\x77\xb8\x6b\x95\xcd\x02\xe7\x25\xe9\x8d\x1a\xfe\x4a\xd6\x3e\xd2\x16\xb8\xba\x42\x4f\xc1\xbb\x7c\xb6\x6c\x19\xd1\x37\x81\x15\x95\x70\x44\x90\xa9\xbb\xa0\x90\xac\x71\xb4\xc3\xea\x12\xb2\xfe\xc9\xce\x40\x96\xc6\x8d\x9e\xac\xc5\x8f\xfc\x59\x04\x45\x89\xd9\x49\x05\xe9\x29\x9d\x04\x47\xf6\x08\x65\x33\x44\x83\x0f\xde\x3c\x75\xfe\xcf\xb8\x5b\x5e\x53\x57\x53\xeb\xb1\xf3\xd6\xc2\x5a\x1f\xb1\xa5\xa1\x33\xd4\x5e\xa3\xbd\x98\x15\xc5\xe8\x3f\x0d\x92\x7f\xb1\x1b\x8c\xcf\x8f\xa9\xd4\x87\xbd\xff\xdf\xe2\x9d\x1c\x15\x19\x00\xec\xb3\x7d\xf6\x77\x00\x66\x6e\xf1\xae\x6f\x03\x00\x00"
+
+func transactionsRemove_itemCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsRemove_itemCdc,
+		"transactions/remove_item.cdc",
+	)
+}
+
+func transactionsRemove_itemCdc() (*asset, error) {
+	bytes, err := transactionsRemove_itemCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/remove_item.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x90, 0xab, 0xc1, 0xe, 0x6, 0xfc, 0x9f, 0xbf, 0xf0, 0xa0, 0x27, 0xa, 0x86, 0xb9, 0xcb, 0x69, 0x94, 0xfd, 0xd5, 0x9e, 0xcb, 0xfc, 0x8c, 0xd6, 0x1e, 0x48, 0x3d, 0xc, 0x92, 0xb7, 0xb2, 0x7}}
+	return a, nil
+}
+
+var _transactionsRemove_itemsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x92\x41\x6f\xd3\x40\x10\x85\xef\xfe\x15\x8f\x1c\x2a\xfb\xe2\x48\x15\xe2\x10\x01\x15\x08\x21\x55\x02\x84\x4a\xc8\x05\x90\x3a\xd9\x8c\xe3\x45\xce\x8e\x35\x3b\x4e\x80\x2a\xff\x1d\xad\xeb\x26\x69\xdd\x4a\xb5\x7d\xf0\xae\xf6\xbd\xfd\x66\xde\xf8\x4d\x2b\x6a\x98\x7c\xf9\x38\xff\x66\xa2\x5c\xa9\x04\x5b\x9c\x4f\xb2\x6c\x3a\x9d\x62\xae\x14\x22\x39\xf3\x12\x60\x82\x8a\x9c\x6f\xbc\x91\x31\xac\x66\x28\x6f\x64\x4b\x0d\xa4\x42\xe4\x2d\x2b\x35\x68\x7c\x34\x1f\xd6\x11\x64\x90\xe0\x18\xcb\xbf\xfd\xd1\x61\x1f\xb2\x0b\xac\x25\x3e\x9d\x2e\x11\x6b\xe9\x9a\x55\x7f\x61\xab\xb2\xf5\xab\x5b\xfb\xeb\x41\x74\xc5\x51\x3a\x75\x7c\xf9\x21\x5e\xc3\x6a\x32\x04\xe6\x55\xe2\x59\x0e\x0c\xbc\x2a\x93\x3a\xb3\x23\x6e\x3e\x16\xcf\xf0\xe3\xfb\x65\xb0\x57\x2f\x7f\x15\xb8\xc9\x32\x00\x68\xd8\x10\x0f\x65\xcf\x40\x9d\xd5\xf9\x83\x56\x94\x57\xa9\x4c\x1e\x90\x0b\x9c\xdd\x3c\x3c\x70\x5c\x7c\xa6\x40\x6b\xd6\xfd\xad\x7b\xab\xdc\x92\x72\x4e\xce\xdd\x79\xbf\x17\x55\xd9\x2d\xa8\xe9\xb8\xc0\xd9\x3b\xe7\xa4\x0b\x96\x70\x30\x3c\x91\x9b\xaa\x3c\x22\xe1\x0d\x92\xba\xdf\xa1\x35\x97\xcb\x5e\xff\xfa\x59\x9c\x4f\x63\xbe\xcd\x0f\xf7\xdd\xbd\x95\xca\x66\x86\xa7\x25\xe9\x8f\xd6\xfc\x95\xac\xbe\xa7\x2d\x70\x71\x81\x96\x82\x77\xf9\x64\x5e\x33\xa2\x5f\x07\x56\xac\x84\x23\x82\x0c\xdd\x05\x85\x64\x8d\xa3\x1d\x16\xe7\x90\xe5\x6f\x76\x96\x26\x25\xa5\xdd\x92\xd5\xf8\x99\x3f\x8b\xa0\x28\x31\x19\x55\x90\xbe\xd2\x49\x70\x64\xf7\x50\x36\x5d\x34\xf8\xe0\xcd\x53\xe3\xff\xf5\xa3\xe5\x35\x75\x35\xb5\x1e\x3b\x6f\x35\xac\xf6\x11\x5b\xea\x1a\x43\xe5\x35\xda\x8b\x49\x51\xf4\xfe\x43\x90\xfc\x87\x5d\x67\x7c\x12\x53\x25\x8a\xd1\x84\xc1\x87\xf1\x66\x3c\x51\x3d\x12\x70\xa9\xa7\xa9\x8d\xa7\x76\x36\x76\x2c\x0e\x7e\xfb\x0c\x00\xf6\xd9\x3e\xfb\x3f\x00\xd9\xed\xa5\x41\xc7\x03\x00\x00"
+
+func transactionsRemove_itemsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsRemove_itemsCdc,
+		"transactions/remove_items.cdc",
+	)
+}
+
+func transactionsRemove_itemsCdc() (*asset, error) {
+	bytes, err := transactionsRemove_itemsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/remove_items.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2e, 0x61, 0xb3, 0x6b, 0xa5, 0x86, 0x9, 0x77, 0xc7, 0x12, 0x9c, 0xf, 0x1a, 0x13, 0x66, 0x26, 0x10, 0x1f, 0xa1, 0x32, 0xf5, 0x22, 0x64, 0xbd, 0x1, 0xa5, 0x89, 0xa4, 0x3e, 0x2e, 0xd3, 0xb0}}
+	return a, nil
+}
+
+var _transactionsSell_itemCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\xd1\x6f\xdb\xbc\x11\x7f\xf7\x5f\x71\xc9\x43\x2b\x6d\x8e\x5c\x0c\xc3\x1e\x8c\xb8\x59\xe7\xc2\x5b\x80\x35\x2d\x5a\xb7\x7b\xe8\x57\xe0\x63\xa4\x93\x45\x44\x22\x05\x92\xb2\xeb\xf5\xcb\xff\x3e\x1c\x25\x51\xa2\x24\xa7\x2e\xda\xd5\x69\x62\x91\xbc\xe3\xdd\xef\x8e\x77\xc7\x13\x2f\x4a\xa9\x0c\x5c\x6e\x2a\xb1\xe3\xf7\x39\x6e\xe5\x03\x8a\xcb\xd9\xe4\xf0\x1b\x34\x2c\x61\x86\x7d\xe2\x78\xd0\xdd\x9a\x3b\x29\x4e\x50\x9f\x22\xd8\x6c\x3f\x18\xa9\x30\x55\x52\x98\x4f\x7f\xb9\x9c\xcd\x16\x8b\x05\x6c\x15\x13\x9a\xc5\x86\x4b\x01\x95\xc6\x04\x8c\x84\x94\xc5\x3c\xe7\x86\x19\x04\x93\x21\xc4\x0a\x99\x9d\x97\xa9\x7d\xce\xb9\x36\x5c\xec\xa0\x12\x09\x2a\x3b\xa2\xf9\x4e\xa0\x7a\xae\x41\x1e\x04\x26\xa0\xdd\x36\xa0\x50\xcb\x4a\xc5\x18\xd9\xcd\x6e\x0d\xb0\x38\xc6\xd2\x68\x4b\x16\xa3\x32\x8c\x0b\x48\xd0\x30\x9e\x6b\x48\x95\x2c\x7a\xfc\xe6\x3c\xc2\x08\xae\x80\x48\xe9\x3f\xfc\xae\x59\x8e\xb7\x06\x8b\xdb\xd7\xbf\xc3\x15\xdc\xbe\x6e\x25\xba\xdb\x6c\xc1\x64\xcc\x00\xd7\x50\x56\x06\xa4\x00\x5a\x0a\xf7\x47\x3b\xad\x31\xcf\x51\x45\x3e\x8f\x77\x8a\xc7\x48\x6c\x5e\x15\xb2\x12\xc6\xb2\x22\x2b\x68\x08\x36\xdb\x10\xee\xab\x23\x2a\x10\x88\x89\x26\x48\x4a\x76\x84\x54\xd6\xda\x96\x95\x8a\x33\xa6\x91\x48\x08\x0b\x4c\xe0\x6e\xb3\x6d\xd8\xc7\x95\x36\xb2\x11\xf0\x6d\x49\xb0\xb1\x1c\xb4\x51\x5c\xec\x88\x8f\xc2\x52\xa1\x46\x61\x80\x27\x28\x0c\x4f\x39\xaa\x56\x8b\x84\x95\x65\xcb\x45\x16\x05\xd7\x9a\x4b\x51\x4b\x47\x72\xae\xdd\x18\x30\x3b\x58\xab\x7c\xe0\x79\x0e\xf7\x08\x86\x3d\xa0\x00\x76\x60\xc7\x56\x6d\x27\xa7\xb3\xa7\x6c\x41\xc0\xaf\x25\x57\x47\xe2\xfa\x51\xf0\xaf\x60\x78\x81\xda\xb0\xa2\x04\x66\xe0\x90\xf1\x38\xab\x8d\x8e\x89\x33\xf6\x3d\xc6\xb2\x40\xb0\x84\x98\x34\x6c\x0a\xa6\x1e\xd0\x94\x39\x8b\x51\xbf\x4a\x12\x85\x5a\x13\xcf\x7f\x73\x6d\xf1\x64\xf5\x10\x92\xb5\x99\x01\xa6\x10\x58\x9e\xcb\x43\xed\x65\x3b\x34\x16\xce\x4e\xd7\x68\x66\xd9\xde\xd6\x70\xec\xf8\x1e\x05\x88\xd4\x40\xc6\x34\x30\xd0\x55\x69\x3d\xbf\x41\xeb\xbd\x3c\xb2\xdc\x1c\xc9\xc9\xe9\x59\x80\xb2\x03\x1c\x75\x0d\x09\x4b\x12\x4c\x80\xd1\xde\x58\x7b\x43\x5c\x99\x68\x36\x33\x9d\xc3\x07\x33\x00\x80\xce\xa9\x96\xf0\xf1\x56\x98\xbf\xfd\x75\xee\x8d\x5b\x47\x59\xc2\xc7\x0d\xff\xda\x4e\xb5\x46\x5e\xc2\x07\x6b\xd9\x9b\x66\xd8\x69\x52\x5b\xcd\x27\xb2\xd0\x1d\xfd\x3d\x26\x00\x5c\xc2\xe7\xe6\xdb\x97\x7a\x8d\x48\xcd\xf6\x58\xe2\xad\xf3\x97\x76\xd7\x7a\xfa\xd4\xec\x2c\x84\x6f\x76\x81\xfd\x95\x13\xd8\xe4\xde\xef\x31\x46\xbe\xa7\x65\x6b\x56\xb2\x7b\x3a\xe7\xc7\xeb\x67\xdf\xbc\x50\x12\xb5\x8b\x1e\x5f\x3a\x62\x91\x9a\x77\x4a\xee\x79\x32\x20\x65\x95\xc9\x82\x61\x2c\x8a\xfe\xc3\x4d\x96\x28\x76\x08\xe1\xd9\xb7\xd1\xe4\x5a\xe6\x39\xda\x88\xd3\xe3\xdf\xc5\x8c\x25\xd4\x3c\xfd\x70\x15\xad\xad\x43\x92\x6b\x71\xb1\x0b\xe1\xd9\x70\xbe\x7b\xb0\x3c\xf7\x4c\x59\x0b\xae\x2b\xa3\x97\xf0\x79\xb4\xba\x9e\xfa\xe2\xd6\xf6\x2d\xd1\xa9\xb7\x84\xcf\x67\xc1\xf4\x65\x66\x19\x95\x0a\x4b\xa6\x30\x60\x71\xdc\xaa\xf1\x0f\xa9\x94\x3c\x7c\x62\x79\x85\x73\xb8\xd5\xba\x42\x12\x94\xed\xb0\xe3\xbb\x96\xc2\x28\xc2\x44\xcd\xe1\x5d\x75\x9f\x73\x9d\x75\x93\x73\xf8\xc0\xf6\xd8\xd0\x0f\x49\x39\xea\x10\x9e\xbd\x8a\x63\xf2\xb6\xd6\xde\xce\xe6\xf4\xd3\x1d\x27\x56\xaf\x82\x44\xa2\x16\xcf\x0d\xb0\x5c\x21\x4b\x8e\x90\xb1\x3d\x02\x83\x0e\x9d\x3e\x6d\x0d\x3a\x30\x10\x78\x00\x2c\x4a\x73\x9c\x5a\xc8\x53\x0a\xeb\x26\x22\x1b\xb2\x1d\x46\xf7\x56\xe7\xeb\x27\x4c\xf4\x32\xa0\x58\xbf\x84\xd3\x2b\x1a\x55\xdf\x31\x93\x85\xb0\x5a\x81\xe0\x39\x7c\x9b\xb9\x2d\x7f\x48\xbe\xb1\x8f\xc1\xf5\xd5\x68\xef\x3a\xe2\x75\x43\x41\x08\x4c\x5f\xc0\xdf\x87\xeb\x4e\x6c\xe0\x3d\x2c\x16\xa0\x09\x57\x4e\xa7\xae\x0f\xbf\xb7\xca\x03\x8d\xd6\x07\xd7\x57\x9d\x8c\x73\x30\xf2\x5c\x84\x46\xc0\xc4\x2d\x30\x25\x39\x54\x0c\xb1\x73\x28\x97\xc6\x3a\x4e\x4f\x00\x65\xfd\x31\x5e\xb3\x12\x56\xa4\x82\x89\x1c\x23\x8e\xda\xc9\xce\xc9\xaf\xaf\x9f\x7d\x3b\x2d\x6c\xcd\xe7\xf1\x65\xe0\xed\xd5\xfe\x3b\x4b\xc9\x11\x65\xe8\x8d\x8c\xa5\xb3\xaa\xeb\x2c\x98\xd0\x66\x0e\xcc\x3c\x85\x6d\xbd\xd0\x3a\x9f\xdb\xe4\xb1\x03\x79\xb1\x80\x7f\x36\xd9\xab\x68\x8a\x2d\xd8\x53\x79\xe6\xc0\xbd\xdb\x6c\x81\x89\x04\xa8\x30\x39\x96\xfd\x0c\x68\x8b\x2c\x2e\xc0\x64\x5c\x43\x2f\x19\x39\xe6\xe4\xa9\xb1\x0b\x91\xaf\x89\xf7\x0a\xbc\x9a\x2e\xa2\xaa\x2a\xdf\xa3\x0d\x1c\x2c\x36\x34\xb8\x51\xb2\xf0\x13\x81\x0f\x75\x5b\x88\x0d\x93\xc5\x28\xbb\xcc\x3d\x32\xd2\x8a\x48\x96\x40\xbf\xaf\x7d\x31\xee\x36\xdb\x2e\x96\x93\xa0\x2f\x83\xce\x26\x74\x7a\x6e\xe0\x3b\x04\xde\x5e\x37\x37\x50\x32\xc1\xe3\xe0\x72\x2d\xab\x3c\x01\x21\x09\x08\xa1\x8d\xaa\x62\x03\x7b\x96\x73\x5b\x68\x59\x40\x2d\xb8\x24\x5c\x5d\x34\xf6\x8a\xa9\xdf\x82\x91\x4a\xe1\x65\xef\x80\x10\xbc\x7b\x56\xe5\xe6\xff\x8d\xec\x0f\x01\xeb\x25\x16\x5f\xa4\xcd\xf6\x53\x2b\xee\x18\xdf\xf3\xe8\x7e\x18\xe6\x33\x50\x7e\x12\x64\x8d\x79\x1a\xb5\xe9\x17\x56\xf0\xf9\x8b\x3f\x35\x9d\x6d\xeb\x85\x6e\xe5\x62\x01\x6d\x86\x75\xe7\xaa\x2b\xe4\xda\x55\x96\x9f\x57\xda\x4c\x86\xaa\x1d\x9a\x27\xf2\x77\xe0\x3c\x22\x52\xcd\xa0\x7f\xf8\x99\xd6\xa8\x4c\x30\xde\xac\x49\x76\x41\x08\x17\x36\x49\xcd\xa1\x40\xad\xd9\x0e\x97\x70\xf9\x86\xca\x5a\xb1\x03\xa9\xa0\xe0\xfa\x8a\xfc\x36\x71\x16\x03\x6b\x32\x68\xb7\xab\x35\xb4\x43\x13\xd8\x52\x5d\x5e\x32\x93\xc1\x6f\xa7\x24\xed\xa3\x4f\xb5\x4f\xaf\x5e\x5b\xb3\xf2\x57\x96\x6c\x37\x60\x15\x75\xbb\x51\xb6\xc9\x30\x7e\x00\x6e\x0b\x0d\x85\x74\x0b\x63\x02\xf0\x6b\x73\x75\x70\x76\x38\x2e\xba\xaf\x10\xbb\xaa\xa7\x33\x6e\x9d\x4e\xa0\xec\x47\xfb\xa6\xf8\xec\x24\xe8\xca\x25\xfd\x64\x52\xda\xa1\xe9\x2d\x0d\x52\x69\x81\x5a\x0e\x62\x6b\xbb\xdc\x37\x37\x49\xd4\x13\x90\x8b\xd3\x22\x74\x45\x57\x53\x0a\x91\xc0\x05\x3b\xde\x63\x0f\x7f\x58\xf5\xd8\x75\xe2\x1e\x6d\x8c\xfc\x95\xa6\xf1\xa5\x69\xae\x0f\xbe\x20\x43\xd9\x46\x14\xf7\x0a\xd9\x83\x37\xfa\x78\x22\x09\x3a\x8b\x53\x62\x13\xb2\xab\x32\xe8\x16\xd6\xde\x20\x09\x4b\x7b\xff\x9b\x34\xef\x62\x41\xc7\x83\xa7\x53\xee\x42\x8e\x24\x24\xe4\x52\xec\x50\xd5\x91\x69\x0e\xb6\xd6\x68\x2a\x3e\x29\xd0\x31\xe2\xe9\x48\x55\xeb\xa7\xf0\xc7\x1f\x83\x89\x9b\xc8\xfa\x6b\x10\x52\x28\x4c\x59\xae\x71\x00\xdb\x90\xcf\x13\x4e\x66\xa5\xf9\x59\xb3\xf9\xf9\x84\x3e\xa7\x7d\xd4\x5b\x1a\xce\xc6\x16\x6a\x22\xd5\xf7\x54\xee\xc7\xa9\x2e\x0f\x30\x4d\xad\x1c\x68\x29\x7b\x51\x63\x14\xdd\x7b\x1b\x50\x40\xf0\xb6\xbb\x98\x9d\xa8\x66\x26\xb1\x6c\xaf\x0b\x3f\x09\x52\xe9\x6a\x36\x6f\x65\x38\x99\xf0\xea\x3d\x81\x81\xc2\x14\x15\x8a\x18\xdb\x42\xdd\xf5\xb2\x3a\xf6\xc3\xd8\x6a\xa4\x61\x79\xd3\x7f\x58\x57\x06\x56\xf0\x22\x7a\xe1\x56\x90\xc6\x98\xa6\x44\xba\xc7\x0f\xfd\x26\x02\xac\xfc\xa6\x02\x5c\x8d\x5a\x06\x1e\x17\xea\x7d\xac\x7a\x6a\x36\xb9\xe6\x6e\xb3\x0d\x5a\x3e\xb7\xaf\xc3\x0b\x47\x43\x17\x22\xf2\x6c\x38\x64\x68\xb2\xa6\x37\x77\xb7\xd9\x02\x2f\xca\x1c\x0b\x14\x4d\xdf\xad\xad\x13\xde\xd7\xc5\x8e\xa2\x13\x28\x64\x2f\xa7\xd6\x67\x29\xda\xa1\x2d\x2d\x75\x10\x46\x14\xbd\x18\x17\x3a\x98\x28\x03\xdf\xb7\x8d\x97\x97\x41\xd8\xbf\x84\xb6\x6a\xb8\xc6\xcc\x7b\x4c\x6b\x57\x69\xcb\x2c\xe2\xfe\x5d\x8e\x1e\x3f\xbf\x80\xf9\x28\x18\x65\x52\xdb\x57\x33\x8a\xe3\x1e\x7b\xad\x21\x8a\x41\xae\x3a\x77\xad\x45\x82\x83\x22\x12\x01\x08\x07\x6e\x32\x6a\x23\xda\x9a\x31\xe2\x49\x18\x5d\x86\xa7\xc5\x87\x15\x04\x9e\x2e\x74\x3d\x3c\x21\x78\x48\xe0\xb9\xa7\x5e\xd9\xd6\x66\x97\x9a\xd1\x11\x78\xbf\x71\xe5\x63\x47\x1f\xea\xd0\xbe\x7d\xfd\x16\xae\xe0\x13\x2a\x9e\xd6\x8d\x3d\x2a\x24\xda\xa6\xa1\x2d\x07\x6c\x35\xcc\x0d\xe8\xcc\x9e\x64\x1b\x4a\xf5\x88\x97\x57\x94\x45\xac\x2c\x51\x24\x67\xde\xc7\x6a\xa2\xe9\xc5\xf4\x69\x4b\x91\x65\xab\x97\x2b\x4e\xe6\xb3\x89\xe5\xf6\x87\x35\x3d\xb2\x96\x22\xae\x0c\xfc\xe9\xc4\xc9\x99\x64\x12\xce\xbe\x3f\x32\x3e\xaa\xc3\x91\x3f\x43\x70\x8e\x04\xe1\xc9\x84\xd8\x7e\x5b\x2c\xe0\x95\xc5\xd4\xda\x88\x78\xb9\xba\xa6\xe9\x3f\x9f\x6f\x88\xb3\x0d\xd0\x01\x3f\x2e\x4d\xe7\xb3\x53\x90\x4f\xab\x08\x57\x43\x70\x66\xd3\xe0\x0e\x53\x41\x77\xc1\x6e\xe3\xfb\xa0\x13\xf4\x93\x0d\xbd\x89\xd8\xff\x03\xed\xa3\x33\xb2\x01\xb5\xa2\xfb\x2d\xb0\xe1\x9b\x88\xe7\xda\xb5\xcf\xba\x42\xfc\xac\xcd\xc3\x8b\xcb\x91\xec\xed\x87\x82\x6a\xcc\x4c\x70\xf9\x86\x3d\x20\xe8\x4a\x61\x6f\x4b\xdb\xf4\xe6\x82\x1b\xce\x72\xfe\x5f\xea\x98\x67\xc8\x95\x13\xc3\x46\x2e\x36\x04\xa0\xd7\xb8\xb9\xb8\x0c\x7b\x66\x4a\xa5\xd7\xe1\xa4\xa8\x33\xd1\x7a\x1e\xc4\x9f\xc5\x02\xfe\x85\x0a\xe1\x80\xb6\x75\x5f\xb0\x07\x2a\xcf\x18\xa4\x8c\x04\xd1\xba\x2a\xec\xfb\x8d\xa6\xb5\x91\xe7\x4d\xc7\xbe\xeb\xfa\x1f\x2c\xc0\xd4\x60\x1c\xf2\x25\x3d\x5d\x0d\x70\xa4\xd4\xdb\x38\x32\xa9\x09\x69\x53\x04\xd4\xf7\x21\x8f\xf6\x89\xdb\xe3\xc9\x88\xb6\x43\xd3\xb4\x48\x83\x1e\x65\xf8\x4b\xef\x87\xfe\x01\x79\x9c\xf5\x6a\x65\xfc\x8a\x71\x65\xfa\x35\x66\x93\xdb\x29\xf1\xc1\x0a\xd6\xb2\x28\xa5\xe6\x06\xe9\x79\xa2\x79\xd1\xe5\x77\xa2\x3b\x41\x36\x41\x35\x1b\xf7\x49\x9b\x97\x39\xa7\x4e\x6f\x14\xf7\x8f\xa4\x0f\xa4\x5f\xe0\x35\x90\x37\x51\xa7\x37\xe7\xc7\x9c\x46\x19\xd7\x65\x1a\xcd\xd2\x2b\x94\xae\x94\xf1\xa7\x69\xfc\x1d\x3b\x52\xd9\x62\x1b\x20\xa4\xe0\x12\xa6\x18\xb5\xa1\xb4\x11\xa7\x7d\xf4\x17\x4d\x3b\x4d\x43\x32\x3d\x19\xe5\x28\x76\x26\xa3\xf6\xf3\x0b\xb8\xb1\x97\x89\x27\xd7\xfb\x1b\x76\x2f\x89\xda\x6f\x83\xf9\xd1\xdb\xa2\x61\x31\xe8\xaf\x6f\xdf\x1f\xd5\x7f\xdd\x54\x38\x03\x00\x78\x9c\x3d\xce\xfe\x37\x00\xe3\x63\xc0\x76\x55\x1e\x00\x00"
+
+func transactionsSell_itemCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsSell_itemCdc,
+		"transactions/sell_item.cdc",
+	)
+}
+
+func transactionsSell_itemCdc() (*asset, error) {
+	bytes, err := transactionsSell_itemCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/sell_item.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x91, 0x60, 0x42, 0x13, 0xa8, 0x45, 0xdd, 0xae, 0xba, 0xcb, 0x23, 0x1, 0xb1, 0xac, 0xb, 0x4e, 0xc9, 0x38, 0x1b, 0x4c, 0x35, 0x46, 0x67, 0xb9, 0x18, 0x7a, 0x11, 0x6a, 0xb1, 0x98, 0x10, 0x3}}
+	return a, nil
+}
+
+var _transactionsSell_item_and_replace_current_listingCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x5f\x73\xdb\xb8\x11\x7f\xd7\xa7\x58\xfb\x21\x47\xb6\x32\x75\xd3\xe9\xf4\x41\x63\xc7\x4d\xed\xaa\xd5\x4c\xcf\x97\x71\x94\xf4\x21\x97\x99\x83\xc9\xa5\x84\x9a\x04\x38\x00\x28\x45\xcd\xf9\xbb\x77\x16\x04\x41\x82\xa4\x1c\xa7\xf7\x47\xbe\x3b\x09\xd8\x5d\x2c\x7e\xbb\xd8\x5d\x2c\x78\x59\x49\x65\xe0\x7c\x55\x8b\x2d\x7f\x28\x70\x23\x1f\x51\x9c\xcf\x26\x87\x7f\x40\xc3\x32\x66\xd8\x07\x8e\x07\xdd\xd1\xdc\x49\x71\x82\xfb\x14\xc3\x6a\xf3\xce\x48\x85\xb9\x92\xc2\x7c\xf8\xd3\xf9\x6c\xb6\x58\x2c\x60\xa3\x98\xd0\x2c\x35\x5c\x0a\xa8\x35\x66\x60\x24\xe4\x2c\xe5\x05\x37\xcc\x20\x98\x1d\x42\xaa\x90\xd9\x79\x99\xdb\xdf\x05\xd7\x86\x8b\x2d\xd4\x22\x43\x65\x47\x34\xdf\x0a\x54\xdf\x69\x90\x07\x81\x19\x68\xbf\x0c\x28\xd4\xb2\x56\x29\x26\x76\xb1\xb5\x01\x96\xa6\x58\x19\x6d\xd9\x52\x54\x86\x71\x01\x19\x1a\xc6\x0b\x0d\xb9\x92\x65\x4f\xde\x9c\x27\x98\xc0\x05\x10\x2b\xfd\x0b\x3f\x6b\x56\xe0\xda\x60\xb9\xbe\xfd\x19\x2e\x60\x7d\xdb\x6a\x74\xb7\xda\x80\xd9\x31\x03\x5c\x43\x55\x1b\x90\x02\x88\x14\x1e\x8e\x76\x5a\x63\x51\xa0\x4a\x42\x19\x6f\x15\x4f\x91\xc4\xbc\x29\x65\x2d\x8c\x15\x45\x56\xd0\x10\xad\x36\x31\x3c\xd4\x47\x54\x20\x10\x33\x4d\x90\x54\xec\x08\xb9\x6c\x76\x5b\xd5\x2a\xdd\x31\x8d\xc4\x42\x58\x60\x06\x77\xab\x8d\x13\x9f\xd6\xda\x48\xa7\xe0\x8f\x15\xc1\xc6\x0a\xd0\x46\x71\xb1\x25\x39\x0a\x2b\x85\x1a\x85\x01\x9e\xa1\x30\x3c\xe7\xa8\xda\x5d\x64\xac\xaa\x5a\x29\xb2\x2c\xb9\xd6\x5c\x8a\x46\x3b\xd2\xf3\xc6\x8f\x01\xb3\x83\xcd\x96\x0f\xbc\x28\xe0\x01\xc1\xb0\x47\x14\xc0\x0e\xec\xd8\x6e\xdb\xeb\xe9\xed\x29\x5b\x10\xf0\x73\xc5\xd5\x91\xa4\xbe\x17\xfc\x33\x18\x5e\xa2\x36\xac\xac\x80\x19\x38\xec\x78\xba\x6b\x8c\x8e\x99\x37\xf6\x03\xa6\xb2\x44\xb0\x8c\x98\x39\x31\x25\x53\x8f\x68\xaa\x82\xa5\xa8\xdf\x64\x99\x42\xad\x49\xe6\xbf\xb8\xb6\x78\xb2\x66\x08\xc9\xda\xcc\x00\x53\x08\xac\x28\xe4\xa1\xf1\xb2\x2d\x1a\x0b\x67\xb7\xd7\x64\x66\xc5\xae\x1b\x38\xb6\x7c\x8f\x02\x44\x6e\x60\xc7\x34\x30\xd0\x75\x65\x3d\xdf\xa1\x75\x2f\x8f\xac\x30\x47\x72\x72\xfa\x2d\x40\xd9\x01\x8e\xba\x81\x84\x65\x19\x66\xc0\x68\x6d\x6c\xbc\x21\xad\x4d\x32\x9b\x99\xce\xe1\xa3\x19\x00\x40\xe7\x54\x4b\x78\xbf\x16\xe6\x2f\x7f\x9e\x07\xe3\xd6\x51\x96\xf0\x7e\xc5\x3f\xb7\x53\xad\x91\x97\xf0\xce\x5a\xf6\xda\x0d\xfb\x9d\x34\x56\x0b\x99\x2c\x74\xc7\x70\x8d\x09\x00\x97\xf0\xd1\x7d\xfb\xd4\xd0\x88\xdc\x6c\x8e\x15\xae\xbd\xbf\xb4\xab\x36\xd3\xa7\x66\x67\x31\x7c\x99\x59\x8a\x82\x80\x26\xd7\xbe\xc7\x14\xf9\x9e\x48\x6e\x58\xc5\x1e\xe8\x8c\x1f\x2f\x5f\x7d\x09\xc2\x48\xd2\x12\x3d\xbd\xf6\xcc\x22\x37\x6f\x95\xdc\xf3\x6c\xc0\xca\x6a\xb3\x8b\x86\x71\x28\xf9\x37\x37\xbb\x4c\xb1\x43\x0c\xaf\xbe\x8c\x26\x6f\x64\x51\xa0\x8d\x36\x3d\xf9\x5d\xbc\x58\x42\x23\x33\x0c\x55\xc9\x8d\x75\x46\x72\x2b\x2e\xb6\x73\x18\x4e\xdf\x63\x29\xf7\xed\x74\x0c\xaf\x86\xf3\xdd\x0f\xbb\xe4\x9e\x29\x6b\xdc\x9b\xda\xe8\x25\x7c\x1c\x51\x37\x53\x9f\x3c\x6d\xdf\x48\xdd\xee\x97\xf0\xf1\x45\x28\x7e\x6a\x8c\x50\x29\xac\x98\xc2\x88\xa5\x69\xbb\xcb\xbf\x49\xa5\xe4\xe1\x03\x2b\x6a\x9c\xc3\x5a\xeb\x1a\x49\x51\xb6\xc5\x4e\xee\x8d\x14\x46\x11\x64\x6a\x0e\x6f\xeb\x87\x82\xeb\x5d\x37\x39\x87\x77\x6c\x8f\x8e\x7f\xc8\xca\x51\xc7\xf0\xea\x4d\x9a\x92\x23\x7a\x57\xa0\xbf\xee\x84\xb1\x66\x16\x32\x89\x5a\x7c\x67\x80\x15\x0a\x59\x76\x84\x1d\xdb\x23\x30\xe8\x50\xe9\xf3\x36\xb6\x00\x06\x02\x0f\x80\x65\x65\x8e\x53\x84\x3c\xa7\x48\x6f\x12\x32\x2d\xdb\x62\xf2\x60\xf7\x7a\xf9\x8c\x69\x5e\x47\x14\xfe\x97\x70\x9a\xc2\x6d\xf1\x2d\x33\xbb\x18\xae\xae\x40\xf0\xa2\xbf\xaf\x6f\xd2\x6f\xec\x7a\x70\x79\x31\x5a\xbb\x09\x82\xdd\x50\x14\x03\xd3\x67\xf0\xd7\x21\xdd\x89\x05\x82\x1f\x8b\x05\x68\xc2\x95\xd3\x61\xec\xc3\x1f\x50\x05\xa0\x11\x7d\x74\x79\xd1\xe9\x38\x07\x23\x5f\x8a\xd0\x08\x98\xb4\x05\xa6\x22\x47\x4a\x21\xf5\x8e\xe4\x33\x5b\x27\xe9\x19\xa0\xac\x1f\xa6\x37\xac\x82\x2b\xda\x82\x49\xbc\x20\x8e\xda\xeb\xce\xc9\x9f\x2f\x5f\x7d\x39\xad\x6c\x23\xe7\xe9\x75\x14\xac\xd5\xfe\xf3\xa2\x4d\x8e\x38\xe3\x60\x64\xac\x9d\xdd\xba\xde\x45\x13\xbb\x99\x03\x33\xcf\x61\xdb\x10\x5a\xe7\xf3\x8b\x3c\x75\x20\x2f\x16\xf0\x0f\x97\xd0\x4a\x57\x7f\xc1\x9e\x2a\x36\x0f\xee\xdd\x6a\x03\x4c\x64\x40\xb5\xca\xb1\xea\x27\x45\x5b\x77\x71\x01\x66\xc7\x35\xf4\xf2\x93\x17\x4e\x9e\x9a\xfa\xc8\x79\x4b\xb2\xaf\x20\x28\xf3\x12\x2a\xb4\x8a\x3d\xda\x80\xc1\x52\x43\x83\x2b\x25\xcb\x30\x37\x84\x50\xb7\xb5\xd9\x30\x7f\x8c\x12\xce\x3c\x60\xa3\x5d\x11\xcb\x12\xe8\xbf\x97\xa1\x1a\x77\xab\x4d\x17\xe2\x49\xd1\xd7\x51\x67\x13\x3a\x3d\xd7\xf0\x15\x86\x60\xad\xeb\x6b\xa8\x98\xe0\x69\x74\x7e\x23\xeb\x22\x03\x21\x09\x08\xa1\x8d\xaa\x53\x03\x7b\x56\x70\x5b\x7b\x59\x40\x2d\xb8\xa4\x5c\x53\x47\xf6\xea\xab\x9f\xa2\xd1\x96\xe2\xf3\xde\x01\x21\x78\xf7\xac\x2e\xcc\xef\x8d\xec\x37\x01\x1b\x24\x94\x50\xa5\xd5\xe6\x43\xab\xee\x18\xdf\x97\xf1\x7d\x33\xcc\x2f\x40\x79\x0a\xe4\x76\x09\xff\x45\x63\x91\x27\x6d\xfa\x85\x2b\xf8\xf8\x29\x9c\x9a\xce\xb6\x0d\xa1\xa7\x5c\x2c\xa0\xcd\xb0\xfe\x7c\x75\x35\x5e\x4b\x65\xe5\x05\x95\xcf\x64\xc8\xda\xa2\x79\x26\x7f\x47\xde\x33\x12\xe5\x06\xc3\x20\xc0\xb4\x46\x65\x42\x07\x18\xaf\x9c\xa4\x3b\x4c\x1f\xa3\x38\x34\x79\x89\x5a\xb3\x2d\x2e\xe1\x7c\xe3\xaf\x3d\x36\x1d\x5b\x13\xd8\x28\x05\x4c\x78\x93\x82\xb5\x69\xb7\x75\xf9\xf0\x1f\x4c\x0d\x15\xed\x94\x4f\x2a\x66\x76\xf0\xd3\x29\x7d\xcf\x83\x85\xfd\x27\x49\xa5\x48\x99\x89\xce\x13\xe8\xa9\x50\xd6\xda\x00\x17\xdc\x70\x56\xf0\xff\xda\x6b\x20\x57\xbe\x5e\x38\x70\xb3\x6b\x82\x95\xd7\x24\xe7\x4a\x9b\xb3\x9e\xbd\x7b\xc7\x8b\xea\xad\x5e\x09\x79\xc3\xaa\xdf\xb2\x8a\xbc\x06\x5b\x09\xf8\xd5\x28\xd3\x11\xd4\xc0\x6d\x91\xa3\x90\x2e\x85\x4c\x00\x7e\x76\x37\x19\x6f\xfb\xe3\xa2\xfb\x0a\xa9\xaf\xb4\x3a\x87\x6a\x52\x19\x54\xfd\x4c\xe3\xea\xe1\x4e\x83\xae\x44\xd3\xcf\x26\xc4\x2d\x9a\x1e\x69\x94\x4b\x6b\x96\xe5\x20\xae\xb7\xe4\xa1\x8b\x91\x46\x3d\x05\xb9\x38\xad\xc2\x17\xcf\xe4\xca\x30\x52\xb8\x64\xc7\x07\xec\xe1\x0f\x57\x3d\x71\x9d\xba\x47\x1b\x9f\x7f\x4b\xd3\x84\xda\xb8\xdb\x4c\xa8\xc8\x50\xb7\x11\xc7\x83\x42\xf6\x18\x8c\x3e\x9d\x48\xc0\xde\xe2\x94\x54\x85\xec\x2a\x1c\xba\x14\xb6\x17\x5a\xc2\xd2\x5e\x47\x27\xcd\xbb\x58\x80\x54\xe4\x3a\x13\xee\x42\x8e\x24\x24\x14\x52\x6c\x51\x35\x51\x71\x0e\xb6\xce\x71\xd5\xa6\x14\xe8\x05\xf1\x7c\xb4\x55\xeb\xa7\xf0\xcb\x2f\x83\x89\xeb\x36\x34\x50\x18\xce\x59\xa1\x71\x00\xdb\x50\xce\x33\x4e\x66\xb5\xf9\xb5\x66\x0b\x43\x19\x7d\x4e\xfb\x68\x40\x1a\xcf\xc6\x16\x72\xd1\xf1\x6b\x5b\x9e\xf7\x42\x61\x97\x83\x98\xa6\x78\x04\x2d\x67\x2f\x6a\xf4\xd3\xb7\x0d\xb6\xbd\x05\x28\x20\x04\xcb\x9d\xcd\x4e\x54\x52\x93\x58\xb6\x57\x95\x5f\x09\x52\xe5\xeb\xc5\x80\x32\xee\x25\xdb\x67\x63\xfe\xdd\x6a\x03\xdd\x82\xd3\xa1\xfe\xb4\x5d\xe2\xe4\x44\xbc\xef\x87\xfc\xff\x23\xe0\x77\x2b\xfa\x90\x3f\x88\xf4\x46\x1a\x56\xb8\xe6\xcc\x4d\x6d\xe0\x0a\xbe\x4f\xbe\xf7\x14\x84\x3f\xe6\x39\x89\xd8\xe3\xbb\x7e\x87\x05\xae\xc2\x8e\x0b\x5c\x8c\xfa\x29\x81\x14\x6a\x0c\x5d\xf5\x40\x77\x57\xcc\xbb\xd5\x26\x6a\xe5\xac\x6f\xe3\x33\xcf\x43\x57\x43\x3a\x67\x70\xd8\xa1\xd9\xb9\xc6\x25\x81\xcc\xcb\xaa\xc0\x12\x85\x6b\x4a\xb6\x15\xd3\x7d\x53\xf6\x29\x8a\x07\x42\xf6\xaa\x8a\xe6\x64\x27\x5b\xb4\x45\xb6\x8e\x62\x4a\xa1\xd4\xc7\xd4\xd1\x44\x41\x7c\xdf\x76\xa5\x5e\x47\x71\x3c\x38\xd8\x04\x86\xef\x5a\xdd\x63\xde\x38\x6e\x5b\x70\x92\xf4\xaf\x4a\x0c\xe4\x85\xa5\xdc\x7b\xc1\xa8\x64\xb0\x4d\x47\xa3\x38\xee\xb1\xd7\x37\xa3\x88\xe8\xef\x29\xbe\xef\x4a\x70\x50\x7c\x24\x00\x1b\xa3\xaf\x6f\x9b\xea\x39\xe1\x59\x9c\x9c\xc7\xa7\xd5\x87\x2b\x88\x82\xbd\xd0\x45\xf9\x84\xe2\x31\x81\xe7\x7f\xf5\x0a\xd8\x36\xd7\x35\x82\x8e\xc0\xfb\x5d\xbd\x10\x3b\xfa\x50\xfb\xfa\xc7\xdb\x1f\xe1\x02\x3e\xa0\xe2\x79\xd3\xf5\xb4\x57\x01\xd7\x23\xb4\xa5\x90\xbd\x17\x70\x03\x7a\x67\xe3\x8a\x0d\xec\x7a\x24\x2b\x28\x4b\x13\x56\x55\x28\xb2\x17\xde\x4c\x1b\xa6\x69\x62\xfa\xb4\x65\xd8\xb2\xdd\x97\x2f\xcc\xe6\xb3\x09\x72\xfb\xc7\x5c\x03\xb1\xe5\x48\x6b\x03\x7f\x38\x71\x72\x26\x85\xc4\xb3\xaf\x8f\x8c\x8f\xea\x70\xe4\x8f\x10\xbd\x44\x83\xf8\x64\x7a\x6e\xbf\x2d\x16\xf0\xc6\x62\x6a\xed\x42\xb2\x7c\x95\xe5\x9a\xf3\x2f\x37\xc4\x8b\x0d\xd0\x01\x3f\xae\xc7\xe7\xb3\x53\x90\x4f\x6f\x11\x2e\x86\xe0\xcc\xa6\xc1\x8d\x87\xa9\x6f\x98\x9e\x5c\xa0\x8a\x62\x38\xb3\x15\x41\x3f\xf5\xfd\x40\x8d\x70\xb1\xa5\x98\x53\x72\x7d\x41\xbe\x9c\x0d\x33\x41\xe5\xe4\x8c\x52\x60\xd7\xd4\x68\xf3\x9a\x4b\x08\x6e\x45\x57\x17\xfc\x5e\xbd\xd5\x89\x94\xf8\x0d\x1d\xbd\x93\x49\xb2\xab\x06\xe8\xc1\xa0\xdf\x95\x1c\xbe\x17\x7d\xa7\x7d\xc2\x62\xa6\x4d\x91\x2f\x5a\x3c\x3e\x7b\x41\xb6\xfc\x81\x3d\x22\xe8\x5a\x61\x6f\x49\xfb\x34\xd1\xa5\xcd\x6c\x2a\x6f\xb2\x21\x00\xbd\x5e\x5a\x98\x3e\x73\x19\x34\x9b\x29\xfc\x4d\x3c\x10\x0c\x02\xe1\x62\x01\xff\x44\x85\x70\x40\xfb\xc0\x52\xb2\x47\x7a\xae\x61\x90\x33\x52\x44\xeb\xba\xb4\xaf\x50\xae\xdb\x54\x14\xee\x5d\xa5\x7b\x9b\x39\x58\x80\xa9\xe7\x3b\x94\x4b\xfb\xf4\xa5\xd1\x91\xda\x96\xee\x44\xd1\x36\x21\x77\xb5\x51\xf3\xb6\x10\xf0\x3e\x73\x91\x3f\x19\x5a\xb7\x68\x5c\xb7\x3a\xea\x71\xc6\xbf\xe9\x55\x3d\x3c\x9f\x4f\xb3\xde\x15\x02\x3f\x63\x5a\x9b\x7e\xe9\xed\x8a\x0c\xca\xc0\x70\x05\x37\xb2\xac\xa4\xe6\x06\xe9\xf7\x44\x3f\xa9\x2b\x34\x88\xef\x04\xdb\x04\xd7\xf8\xde\x4a\x5e\xe0\x6f\x1f\xee\xf9\x4d\xb7\x6f\x84\x77\xab\x8d\xe7\xd8\xb3\x8e\xd0\x1d\xd0\xf5\x2d\x5d\x42\x07\xd1\x80\xb2\xed\xdf\x47\x74\xa1\x05\xdc\x86\x7c\xf3\x2f\x0c\x8f\x22\x37\xf4\xd8\xd5\xd5\x55\x7e\xb6\x43\x73\xb1\x00\x65\xdf\x61\xc6\xca\x7b\x1a\xda\x9a\x1b\x5c\xdf\x92\x7b\x4f\xa8\x1f\x7a\xf7\x70\x2b\xaa\x1f\x8e\x22\x27\xeb\xde\xb5\xdb\x48\x47\x2f\x3e\x9e\xce\x42\xee\x79\xc0\x91\xcd\x4e\xad\x93\xf6\xa3\xe2\x08\xaa\xde\xdd\xc2\xb9\xf5\x12\x86\x61\x7e\xfe\x6b\xf0\x0d\xa7\x09\xf7\xb7\xec\x48\x35\xaa\xed\xfb\x91\x80\x25\x4c\x09\x6a\xf3\xa6\x53\xa7\xfd\x19\x12\x4d\x1f\x4c\xc7\x32\x3d\x99\x14\x28\xb6\x66\x47\xaf\x2e\xdf\xc3\x35\x65\x2d\x78\x96\x3e\x5c\xb0\x7b\x2e\x6d\xbf\x0d\xe6\x47\xef\xa6\xc3\xca\x3f\xa4\x6f\x5f\x52\x9b\xff\xfb\xa9\x78\x06\x00\xf0\x34\x7b\x9a\xfd\x6f\x00\x30\xcf\xe8\x56\x5f\x21\x00\x00"
+
+func transactionsSell_item_and_replace_current_listingCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsSell_item_and_replace_current_listingCdc,
+		"transactions/sell_item_and_replace_current_listing.cdc",
+	)
+}
+
+func transactionsSell_item_and_replace_current_listingCdc() (*asset, error) {
+	bytes, err := transactionsSell_item_and_replace_current_listingCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/sell_item_and_replace_current_listing.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xdd, 0xae, 0xc, 0x82, 0x55, 0x5d, 0xb9, 0x68, 0x24, 0x3b, 0xa6, 0x8e, 0xfc, 0xaa, 0x6c, 0xcc, 0x47, 0x2d, 0xc5, 0xb9, 0x36, 0x3f, 0x15, 0x4, 0xd7, 0xa5, 0x8c, 0x54, 0x11, 0x33, 0xe5, 0xf9}}
+	return a, nil
+}
+
+var _transactionsSell_item_with_marketplace_cutCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xb4\x59\x51\x6f\xdb\x38\x12\x7e\xf7\xaf\x98\xe4\xa1\x2b\xed\x39\x72\x71\x38\xdc\x83\x11\x37\xd7\x73\xe0\xbb\x00\xd7\x34\x68\xdd\xde\x43\xb7\xc0\x32\xd2\xd8\x26\x22\x91\x02\x49\x39\xf5\x75\xf3\xdf\x0f\x43\x49\xa4\x28\xc9\xa9\x8b\x76\x93\xec\xd6\x12\x39\xc3\xe1\x37\xc3\x99\x8f\x63\x5e\x94\x52\x19\x38\x5f\x55\x62\xcb\xef\x73\x5c\xcb\x07\x14\xe7\x93\xd1\xd7\x6f\xd0\xb0\x8c\x19\xf6\x91\xe3\xa3\xf6\x73\x6e\xa5\x38\x22\x7d\x4c\x60\xb5\x7e\x6f\xa4\xc2\x8d\x92\xc2\x7c\xfc\xeb\xf9\x64\x32\x9b\xcd\x60\xad\x98\xd0\x2c\x35\x5c\x0a\xa8\x34\x66\x60\x24\x6c\x58\xca\x73\x6e\x98\x41\x30\x3b\x84\x54\x21\xb3\xe3\x72\x63\x9f\x73\xae\x0d\x17\x5b\xa8\x44\x86\xca\xbe\xd1\x7c\x2b\x50\xfd\xa2\x41\x3e\x0a\xcc\x40\xbb\x65\x40\xa1\x96\x95\x4a\x31\xb1\x8b\xdd\x18\x60\x69\x8a\xa5\xd1\x56\x2c\x45\x65\x18\x17\x90\xa1\x61\x3c\xd7\xb0\x51\xb2\xe8\xe8\x9b\xf2\x04\x13\xb8\x00\x12\xa5\xff\xe0\x77\xcd\x72\xbc\x31\x58\xdc\x5c\xff\x0e\x17\x70\x73\xdd\x5a\x74\xbb\x5a\x83\xd9\x31\x03\x5c\x43\x59\x19\x90\x02\x68\x2a\xdc\x1f\xec\xb0\xc6\x3c\x47\x95\x84\x3a\xee\x14\x4f\x91\xd4\xbc\x2e\x64\x25\x8c\x55\x45\x5e\xd0\x10\xad\xd6\x31\xdc\x57\x07\x54\x20\x10\x33\x4d\x90\x94\xec\x00\x1b\x59\xef\xb6\xac\x54\xba\x63\x1a\x49\x84\xb0\xc0\x0c\x6e\x57\xeb\x46\x7d\x5a\x69\x23\x1b\x03\xdf\x96\x04\x1b\xcb\x41\x1b\xc5\xc5\x96\xf4\x28\x2c\x15\x6a\x14\x06\x78\x86\xc2\xf0\x0d\x47\xd5\xee\x22\x63\x65\xd9\x68\xc1\x2f\x25\x57\x07\xb2\xee\x83\xe0\x5f\xc0\xf0\x02\xb5\x61\x45\x09\xcc\xc0\xe3\x8e\xa7\xbb\xda\x29\x98\x39\x67\xdc\x63\x2a\x0b\x04\x2b\x88\x59\xa3\xa6\x60\xea\x01\xcd\x5d\xce\x52\x7c\xcf\x72\x5c\x56\xe6\x1d\xa6\xc8\xf7\xa8\x48\xf5\x1b\x3b\x5a\xd2\x68\x0d\x57\x5a\x19\x50\xcd\x84\xa3\x1a\xee\x50\xa5\x28\x0c\xdb\x5a\xf0\xfc\x53\xbb\x0b\xab\xa9\x24\x70\x5b\x5d\x59\xeb\x87\xc2\x2f\x98\x4c\xac\xfe\x9b\x5a\x66\xcb\xf7\x28\x40\x6c\x0c\xec\x98\x06\x06\xba\x2a\x6d\x90\x37\x2a\xdf\xc9\x03\xcb\xcd\x81\xe2\x99\x9e\x05\x28\xfb\x82\xa3\x86\x47\x9e\xe7\xc0\xb2\x0c\x33\x60\xda\xaf\x9f\x56\x26\x99\x4c\x8c\x8f\xed\x68\x02\x00\xe0\xe3\x67\x0e\x1f\x6e\x84\xf9\xfb\xdf\xa6\xc1\x7b\x1b\x13\x73\xf8\xb0\xe2\x5f\xda\xa1\xd6\x9f\x73\x78\x6f\x9d\x78\x55\xbf\xb6\x38\x1f\x42\x2d\xc7\xd1\x9e\xc3\xeb\x2c\x53\xa8\xf5\xb1\x89\x1e\xc6\x70\x75\xb1\x31\xeb\x43\x89\x37\x2e\x56\x5a\x33\xea\xe1\x63\xa3\x93\x18\xbe\xda\x09\x39\x1a\xd8\x74\xcc\x58\xb2\x92\xdd\xd3\xd9\x3e\x5c\xbe\xf8\x1a\xa4\x8f\xa4\x9d\xf4\xf4\xca\x49\x8a\x8d\xb9\x53\x72\xcf\xb3\x9e\x28\xab\xcc\x2e\xea\xe7\x9f\xe4\xbf\xdc\xec\x32\xc5\x1e\x63\x78\xf1\x75\x30\xb8\x94\x79\x8e\x36\xcb\x74\xf4\xfb\x3c\x31\x87\x5a\x67\x98\xa2\x92\xa5\x0d\xf2\xff\xd4\x21\x1e\xc3\x8b\xfe\xb8\x7f\xb0\x3a\xf7\x4c\x59\x57\x2e\x2b\xa3\xe7\xf0\x69\x30\xbb\x1e\xfa\xec\xe6\x76\xe2\x51\xfb\xed\xcd\xe1\xd3\x49\x30\x7d\x9e\x58\x45\xa5\xc2\x92\x29\x8c\x58\x9a\xb6\xdb\xf8\xa7\x54\x4a\x3e\x7e\x64\x79\x85\x53\xb8\xd1\xba\x42\x32\x94\x6d\xd1\xeb\x5d\x4a\x61\x14\x61\xa2\xa6\x70\x57\xdd\xe7\x5c\xef\xfc\xe0\x14\xde\xb3\x3d\x36\xf2\x7d\x51\x8e\x3a\x86\x17\xaf\xd3\x94\xb2\x16\xf9\xd9\x5a\x41\x7f\xfe\x3c\xb1\x7a\x14\x32\x89\x5a\xfc\x62\x80\xe5\x0a\x59\x76\x80\x1d\xdb\x23\x30\xf0\xa8\x74\x65\x6b\xb0\x81\x81\xc0\x47\xc0\xa2\x34\x87\xb1\x89\x7c\x43\x29\xdc\x24\xe4\x3b\xb6\xc5\xe4\xde\xee\xf5\xf2\x19\xd7\xbc\x8a\x28\xaf\xcf\xe1\xf8\x8c\x66\x8b\x77\xcc\xec\x62\x58\x2c\x40\xf0\xbc\xbb\xaf\xef\xb2\x6f\x18\x5b\x70\x79\x31\x58\xbb\xce\x9e\xfe\x55\x14\x03\xd3\x67\xf0\x8f\xfe\xbc\x23\x0b\x04\x0f\xb3\x19\x68\xc2\x95\x1b\x4a\xf0\x1d\xf8\x83\x59\x01\x68\x34\x3f\xba\xbc\xf0\x36\x4e\xc1\xc8\x53\x11\x1a\x00\x93\xb6\xc0\x94\x14\x48\x29\xa4\x2e\x90\x5c\xc9\xf2\x9a\x9e\x01\xca\xc6\x61\xba\x64\x25\x2c\x6a\x1f\x3b\x45\x1c\xb5\xb3\x9d\x53\x3c\x5f\xbe\xf8\x7a\xdc\xd8\x5a\xcf\xd3\xab\x28\x58\xab\xfd\x39\x69\x93\x03\xc9\x38\x78\x33\xb4\xce\x6e\x5d\xef\xa2\x91\xdd\x4c\x81\x99\xe7\xb0\xad\x27\xda\xe0\x73\x8b\x3c\x79\x90\x67\x33\xf8\x17\x1a\x8b\x62\xd1\x10\x2b\xd8\x13\x15\x73\xe0\x12\xfb\x60\x22\x03\x22\x21\x87\x12\xa9\x0c\x31\x03\x4c\x61\x4d\xa8\xb8\x00\xb3\xe3\x1a\x3a\xd5\xc8\x29\xa7\x48\x4d\x5d\x6a\xbc\x26\xdd\x0b\x08\xf8\x5b\x42\x0c\x2a\xdf\xa3\x4d\x18\x2c\x35\xf4\x72\xa5\x64\x11\x26\xfe\x10\xea\x96\x74\xf5\x8b\xc3\xa0\x9a\x4c\x03\x31\xda\x15\x89\xcc\x81\xfe\x7f\x19\x9a\x71\xbb\x5a\xfb\x1c\x4e\x86\xbe\x8a\xbc\x4f\xe8\xf4\x5c\xc1\x37\x04\x82\xb5\xae\xae\xa0\x64\x82\xa7\xd1\xf9\x52\x56\x79\x06\x42\x12\x10\x42\x1b\x55\xa5\x06\xf6\x2c\xe7\x96\x54\x59\x40\x2d\xb8\x64\x5c\x4d\x10\x3b\xc4\xe9\xb7\x68\xb0\xa5\xf8\xbc\x73\x40\x08\xde\x3d\xab\x72\xf3\x67\x23\xfb\x5d\xc0\x06\x05\x25\x34\x69\xb5\xfe\xd8\x9a\x3b\xc4\xf7\x34\xb9\xef\x86\xf9\x04\x94\x9f\x05\x59\x63\xbe\x49\xda\xb2\x0b\x0b\xf8\xf4\x39\x1c\x1a\xaf\xb2\xf5\x44\x37\x73\x36\x83\xb6\xb2\xba\x73\xe5\x99\x5c\x3b\xcb\xea\xf3\x7c\x66\x34\x4f\x6d\xd1\x3c\x53\xb4\x23\x17\x0e\x49\xcb\x74\xc3\x93\xcf\xb4\x46\x65\x42\xaf\xf7\x96\x6d\x6a\x5e\x14\xc3\x99\xad\x55\xa1\xb3\x0b\xd4\xda\xf2\xb8\xf3\x37\x5c\x6b\xa2\xe6\x52\x41\xc1\xf5\x05\x85\x72\xe6\x9c\x08\xd6\x8b\x8e\x6e\xd7\x9b\xb6\xaf\x46\xe0\x26\xda\x5f\x32\xb3\x83\xdf\x8e\xd9\x7f\xee\x6c\xe8\x78\x86\xf8\x50\x87\xc3\x2d\x59\xf9\x33\x69\xdc\x15\xd8\xdd\xbb\xd5\xa8\x12\xed\x30\x7d\x00\x6e\x49\x88\x42\xba\x8d\x31\x01\xf8\xa5\xb9\xa2\x38\x37\x1d\x66\xfe\x23\xa4\x8e\x09\x79\xc7\xd7\xa5\x06\xca\x6e\x25\x68\x08\xa9\xb7\xc0\x53\x28\xfd\x6c\xc1\xda\xa2\xe9\x4c\x8d\x36\xd2\x22\x36\xef\xe5\xdd\x76\x7a\x18\x0d\x64\x51\xc7\x40\x2e\x8e\x9b\xf0\xd5\x09\x35\x34\x89\x0c\x2e\xd8\xe1\x1e\x3b\xf8\xc3\xa2\xa3\xce\x9b\x7b\xb0\xf9\xf3\x67\xba\x26\xb4\xa6\xb9\x4a\x84\x86\xf4\x6d\x1b\x48\xdc\x2b\x64\x0f\xc1\xdb\xa7\x23\x05\xd2\x79\x9c\x8a\x9e\x90\x9e\x81\xd0\x15\xad\xbd\xa9\x12\x96\xb6\x32\x8e\xba\x77\x36\x03\xa9\x28\x74\x46\xc2\x85\x02\x49\x48\xc8\xa5\xd8\xa2\xaa\xb3\xd6\x14\x2c\x0f\x69\xd8\xa0\x14\xe8\x14\xf1\xcd\x60\xab\x36\x4e\xe1\x8f\x3f\x7a\x03\x57\x89\x8d\xd7\x28\xa6\x34\xb9\x61\xb9\xc6\x1e\x6c\x7d\x3d\xcf\x04\x99\xb5\xe6\x47\xdd\x16\x66\x1d\xfa\x3d\x1e\xa3\xc1\xd4\x78\x32\xf4\x50\x93\xc8\xbe\xb5\xe5\x69\x27\x61\xf9\x1a\xc1\x34\xb5\x74\xa0\x95\xec\x64\x8d\x41\xe6\xef\x2c\x40\x09\x21\x58\xee\x6c\x72\x84\xe9\x8c\x62\xd9\x5e\x25\x7e\x10\xa4\xd2\xf1\xb9\x60\x66\x3c\x5a\x0c\xeb\x35\x81\x81\xc2\x0d\x2a\x14\x29\xb6\x24\xde\xf5\xb4\xbc\xfa\xee\xde\x29\xb7\x1a\x69\x58\xde\x34\x27\x96\x95\x81\x05\xbc\x4c\x5e\xf6\x13\x16\x2c\x3a\x1a\x9a\xd2\x71\xbb\x5a\x47\xbe\x17\x11\x9f\x39\x19\xba\xe6\x50\x4c\xc2\xe3\x0e\xcd\xae\xe9\xae\xdd\xae\xd6\xc0\x8b\x32\xc7\x02\x45\xd3\x39\x6b\xab\xff\xbb\x9a\xc2\x28\x3a\x3b\x42\x76\x2a\x65\x7d\x0a\x92\x2d\x5a\xc2\xa8\xa3\x38\xa1\xbc\xc3\xb8\xd0\xd1\x08\xb9\x7b\xd7\xf6\x53\x5e\x45\x71\xdb\x3a\x68\x7f\xc8\x71\xae\xdf\xf2\x0e\x37\xb5\x93\x5b\xf2\x44\xda\xbf\xa9\x31\xd0\x17\xd2\x92\x0f\x82\x51\x31\xb4\x9d\x31\xa3\x38\xee\xb1\xd3\xf1\xa1\xec\xe1\x38\xb7\x6b\x0e\x12\x1c\x94\x4b\x08\x40\x78\xe4\x66\x47\x8d\x40\xcb\x04\x13\x9e\xc5\xc9\x79\x7c\xdc\x7c\x58\x40\x14\xec\x85\x2e\x7d\x47\x0c\x8f\x09\x3c\xf7\xd4\x21\x63\x6d\x5d\xa8\x15\x1d\x80\x77\xfb\x51\x21\x76\xf4\x4b\x3d\xd6\xb7\xd7\x6f\xe1\x02\x3e\xa2\xe2\x9b\xba\x13\x46\x5c\xa0\x6d\x98\xd9\x8a\x6e\x39\x2e\x37\xa0\x77\xf6\x0c\xda\x24\xa8\x07\xba\x02\xaa\x95\xb0\xb2\x44\x91\x9d\x78\xcb\xaa\x85\xc6\x27\xd3\x6f\xcb\x26\xe6\xed\xbe\x1c\xbf\x98\x4e\x46\xa6\xdb\x3f\x56\x50\x97\xc1\x4b\x50\xff\xf0\xd7\xb0\x99\x36\x2a\x1b\x4f\xbe\xfd\x66\x78\xb6\xfa\x6f\xfe\x72\xf2\xba\xdd\xb2\xd5\x7e\x9a\xcd\xe0\xb5\xc5\xcf\xfa\x83\x2c\x77\xec\xa3\xe9\x16\x9f\x0e\xfa\xc9\x60\x7b\x90\x7b\x94\x72\x3a\x39\x86\x6d\xb0\x2b\xb8\x18\xa0\x70\xd1\x9b\xf1\xeb\xb3\xdd\xc5\xc9\x38\xe8\x71\xbf\x6a\xf4\x33\xfb\x80\xf2\x7e\x8b\xe6\xd2\x21\xf5\x59\x1b\xca\x46\xcf\xa0\x7a\xf8\xfb\x7a\x5b\x12\x7a\x8d\xa5\x1f\xec\x0b\x8e\x94\x8b\xef\xe8\x46\x9d\x50\x40\xb6\x68\x82\x8e\x5a\xff\x4b\x8c\x5f\xb4\xeb\xc6\x79\x12\x7f\xd2\xe2\xf1\x99\x27\xf5\xfd\x1f\xca\xe6\x29\x33\xd1\xf9\x1b\xf6\x80\xa0\x2b\x85\x9d\x25\x6d\x13\x9d\x0b\x6e\x38\xcb\xf9\xff\xe8\x2b\x9d\x1d\x72\xe5\xcc\xb0\x29\x93\xf5\x01\xe8\xf4\x81\xce\xce\xe3\x8e\x9b\x66\x33\xf8\x37\x2a\x84\x47\xb4\x2d\x8d\x82\x3d\xd0\x9d\x86\xc1\x86\x91\x4e\xad\xab\xc2\x7e\xcb\xd1\x34\x3d\xf2\xbc\x69\xe6\xb3\xba\xe1\x4d\x2d\x7a\x8b\x15\xb5\x1e\xbb\x3a\xc9\x5c\x57\xfd\x0f\x54\x74\x9b\xc3\x41\xd6\xc2\xa6\x29\xff\xf5\x95\xc8\xc9\x51\x32\xef\x84\xb7\x67\x25\xb0\x80\x2d\x9a\xa6\x29\x1a\x0d\x4f\x40\x7b\xce\xe2\x9f\x72\x57\x3c\x21\x89\x74\x2e\xbd\x7f\x72\x26\x19\xc5\xe3\xd4\x7c\xf2\x03\xd9\xa2\xb9\x01\xe0\x17\x4c\x2b\xd3\x65\xce\x0d\xef\x21\x52\x00\x0b\x58\xca\xa2\x94\x9a\x1b\xa4\xe7\x91\x76\x8d\xe7\x3e\x24\x77\x44\x6c\x44\x6a\x32\xec\x0c\x37\x5f\x85\x1d\x4b\x30\x49\xda\xcd\x1a\x21\xa4\x21\x6d\x6d\x40\x9c\x43\x3f\x0d\x86\xb0\x36\x9b\x71\x7d\xb5\xc1\xe8\xcd\xb5\xc7\xfb\xe6\x3a\x1c\xa6\xf7\x77\xec\x40\x94\xce\xb6\x7c\x68\x83\x73\x18\x53\xd4\x06\x4c\x63\x4e\xfb\x18\x4e\x1a\x6f\xb2\xcc\x87\xed\x09\xff\x7d\x56\xfb\xa9\x37\x2e\x8b\x82\x12\xba\x14\xaf\x9b\x88\x79\x99\xbc\x0c\xa7\xb4\xdf\x7d\xd5\xff\xba\xa1\x78\x02\x00\xf0\x34\x79\x9a\xfc\x7f\x00\xf8\x72\x8b\xb3\xde\x1e\x00\x00"
+
+func transactionsSell_item_with_marketplace_cutCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsSell_item_with_marketplace_cutCdc,
+		"transactions/sell_item_with_marketplace_cut.cdc",
+	)
+}
+
+func transactionsSell_item_with_marketplace_cutCdc() (*asset, error) {
+	bytes, err := transactionsSell_item_with_marketplace_cutCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/sell_item_with_marketplace_cut.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xa5, 0x5a, 0x2, 0x96, 0xc8, 0xb3, 0xf4, 0x78, 0x6a, 0xd5, 0xd7, 0x5c, 0xaa, 0x7b, 0x32, 0x3, 0xd1, 0x6a, 0x14, 0xdc, 0xe9, 0x99, 0x82, 0x9, 0x2c, 0x4a, 0xf4, 0x92, 0xd3, 0x96, 0x10, 0x6e}}
+	return a, nil
+}
+
+var _transactionsSetup_accountCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\x4d\x6b\xdb\x40\x10\xbd\xeb\x57\xbc\xe6\xe0\x4a\xe0\x48\xd0\x63\xb0\x43\x8b\xa1\x90\x4b\x09\x34\xf4\x3e\xde\x8c\xaa\x85\xf5\xae\xd8\x19\x35\x18\xe3\xff\x5e\x56\x76\x24\xcb\x8e\x83\x91\x2e\xbb\x3b\xf3\xbe\x78\x76\xd3\x86\xa8\xb8\xfb\xf5\xf3\xe5\xb7\x86\xc8\x75\x0c\x5e\xff\x7c\xbb\xcb\xb2\xaa\xaa\xf0\xd2\x58\x81\x46\xf2\x42\x46\x6d\xf0\xb0\x5e\x94\x9c\x13\x68\xc3\x18\x17\x10\x59\x24\x74\xd1\x30\xac\x07\x79\x90\x31\xa1\xf3\x5a\x26\x94\xec\x14\x60\x97\x01\x40\x1b\xb9\xa5\xc8\x39\x19\xa3\x0f\xa0\x4e\x9b\xfc\x49\xa4\xe3\x84\x48\x7f\x79\x45\x2d\xad\xad\xb3\xba\x5d\x05\xaf\x31\x38\xc7\x71\x8e\xe7\x6e\xed\xac\x34\xe3\xe3\x1c\xc7\xf9\x02\xb3\x1f\x07\xc2\x02\xbb\xac\x67\x48\x7f\x55\xe1\xa9\xee\x95\x1e\xe5\xe0\x35\xb0\xf8\xaf\x0a\x72\x91\xe9\x75\x8b\x86\xfe\x31\xe8\xc4\xc8\xb0\x6b\xeb\xe4\x41\x4b\x39\x30\x94\xeb\x10\x63\x78\x5b\xcc\xce\x72\x2a\xc7\xc3\x63\x5e\xc7\xb0\x79\xc0\xf5\x89\xa3\xda\x67\xd2\xa6\xc0\x72\x09\x6f\xdd\xa9\xdc\xa3\xe4\x55\x64\xd2\xa4\xca\xf3\x1b\x78\xd3\xea\xf6\x23\x7d\xe9\x73\xac\x90\xe1\x09\x8b\xfb\x0b\x6e\xd3\x63\x8d\x57\x79\x01\x92\x2f\xf8\x7e\x3e\x77\x85\x60\x72\xa8\x2a\x48\x8a\xcb\x2a\x34\x9c\xa6\x3a\x99\x9a\x84\x96\xe6\xf3\xc5\xfd\xa8\x71\x0e\x0d\xb7\x26\x74\x11\x8c\x79\x0f\xa6\x4d\x45\x30\x30\x43\x11\x50\x87\x78\xd6\xc8\x4f\x82\xea\x7b\x64\x56\xd4\x62\x99\x2c\x68\x39\x00\x59\x96\x41\xbb\x4d\x7d\x5c\xcc\x76\xd7\xc5\x1e\x70\xf6\x8f\xf9\x84\xeb\xfd\xbb\xc9\xe4\xc5\x66\x31\xb9\xb9\x54\xd7\x5b\x97\x26\xff\xc0\xcd\x1c\xa4\x9f\x65\x7b\x18\xec\xcb\x37\x90\xec\x33\x00\xd8\x67\xfb\x0c\xff\x07\x00\x8c\x38\x15\x57\x09\x04\x00\x00"
+
+func transactionsSetup_accountCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsSetup_accountCdc,
+		"transactions/setup_account.cdc",
+	)
+}
+
+func transactionsSetup_accountCdc() (*asset, error) {
+	bytes, err := transactionsSetup_accountCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions/setup_account.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x92, 0xde, 0xf3, 0xd, 0x92, 0xe5, 0xde, 0x83, 0x90, 0x70, 0x64, 0x88, 0xc3, 0xb3, 0xee, 0xb2, 0x39, 0x66, 0xf6, 0x9d, 0x64, 0x3a, 0x16, 0x3b, 0x12, 0xba, 0x51, 0x61, 0x9b, 0xdd, 0x99, 0x44}}
+	return a, nil
+}
+
+var _transactionsV1Buy_itemCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x94\x54\x4d\x6f\xdb\x38\x10\xbd\xeb\x57\xcc\xfa\x10\x48\x45\x23\x77\x81\xc5\x1e\x84\xd8\x69\x9b\xd4\x40\x80\xad\x1b\x74\xbd\xd9\xf3\x84\x1a\xdb\x44\x28\x92\x20\x47\x76\x82\xa0\xff\x7d\x41\x99\xfa\x8a\xeb\x64\x6b\x01\x06\x41\xce\x0c\xdf\x7b\xf3\x38\xb2\xb2\xc6\x31\x4c\xbe\x3c\x62\x65\x15\xad\xcc\x03\xe9\x49\xd2\xee\x2e\x6a\xbd\x91\xf7\x47\xdb\x4b\xa3\x4f\x9c\xc4\x32\xcb\xc5\x6a\x10\xbd\x58\xfd\xcd\xc6\xd1\xda\x19\xcd\xfd\xf6\x57\x62\x2c\x91\xf1\x4e\xd2\xde\x4f\x92\x84\x1d\x6a\x8f\x82\xa5\xd1\xa9\x92\x9e\xa5\xde\x7c\x27\x6f\x6a\x27\xe8\xe6\xba\x80\x7f\x6e\x34\xff\xf9\xc7\x7b\xf0\x5d\xa9\x4f\x65\xe9\xc8\xfb\x02\xe2\x22\x83\xe7\x24\x01\x00\x50\xc4\x60\xf1\xa9\x22\xcd\x77\x58\x2b\x2e\xe0\xe3\xf3\x08\x6f\xde\x6c\xff\xe8\x82\xa9\x43\xfd\x9d\x04\xc9\x1d\xb9\x02\xce\x9e\x5f\xb2\xcc\xdb\xc3\x3e\xb1\x07\xd3\x24\x0c\x89\xe6\xfd\xf2\xb6\xbe\x57\x52\xf4\x59\x91\xdd\x71\xca\x5f\x87\x83\x36\xbe\x49\xb0\x8e\x2c\x3a\x4a\x51\x08\x2e\x00\x6b\xde\xa6\x9f\x8d\x73\x66\x7f\x87\xaa\xa6\x0c\xce\x3e\x09\x61\x6a\xcd\x81\x3d\xc4\x9f\x27\xb5\xce\x7b\x6c\x30\x83\x0d\x71\x8c\x4b\x8f\x04\xcc\x72\x81\x16\xef\xa5\x92\x2c\xc9\xe7\xf7\x4d\xf5\x8b\x37\xe9\xcc\xd3\xee\xbe\xf6\x7b\x3d\xe3\x16\x79\x3b\x4a\xc9\xe0\xf2\x12\x2c\x6a\x29\xd2\xc9\x95\xa9\x55\x09\xda\x30\x1c\xee\x87\x97\xd9\xb0\x76\xa6\x02\xeb\xcc\x4e\x96\x54\x02\x1e\x7a\x3e\xc9\x92\xae\x64\xc3\x3a\x6a\x0b\xb3\x97\x22\x44\x5e\x51\xe2\x9f\x39\xec\x68\x2b\x1b\xa1\x6d\xbf\x1e\xf3\xd2\xc0\xb7\xf5\x9a\x1c\xec\x25\x6f\x81\xb7\xc8\x70\x73\x0d\x52\x0f\xb0\x4f\xfa\x1a\xa1\xf3\xd6\x49\x41\x2d\xb6\x78\x5f\xbe\x21\xbe\x26\x46\xa9\x7c\x9a\xe5\x1e\x15\xdd\x86\xa8\x9e\x57\x48\xac\x50\xea\xc6\xb4\x30\x83\xe0\x84\xa6\xbb\xb8\xa1\xb6\x5b\x8d\x2f\xc6\x6e\xfd\x57\xf2\xb6\x74\xb8\xcf\xe0\x6c\xf8\xb8\x0f\xde\x9f\xa7\x41\xce\x02\xa6\xb1\xce\x34\x3e\x81\x26\xb5\x89\xc8\x92\x9f\x93\xbe\x42\x3d\xe8\xd2\xb0\x32\xec\x42\xde\xa1\x4f\x01\x23\x44\x8c\x03\x0d\x9a\x9e\x0c\x9f\x26\x5c\x9c\xf7\xdc\xf2\x7d\x84\x9c\x62\x15\x2c\x5d\x1c\xf4\x1a\xb4\x38\x48\x21\x8c\x52\xd4\x8c\x89\x6b\x64\xfc\x66\x19\x66\x2d\x8c\xe5\x62\x95\x3b\xf2\x46\xed\xe8\xca\x68\x76\x28\x38\x4c\x97\xd4\xc5\x96\xae\x9e\x2c\x15\x10\xfe\x2f\x3e\x0e\x52\x96\x8b\xd5\x3c\xcd\xde\xc3\x4e\xd2\x7e\x10\x32\x1a\x50\xf9\x72\xb1\xba\x1a\xdd\x3c\x4f\xb3\x53\x1a\x7d\x95\xde\x07\x13\xf6\x50\x21\x14\x9a\x64\xaf\x10\x81\xd9\x8b\x8d\xc0\x0c\xfd\x6f\xf0\x06\x8c\xa4\x57\xa7\x51\xf7\x78\x96\xb5\x96\x39\xf1\xca\x4f\x4e\xb9\x79\x3a\x06\x94\xdb\xee\x19\xff\x3f\x73\x2c\x17\xab\x01\x27\x70\x2d\x9e\xd6\x21\xa1\xc7\x51\x93\x38\xec\xe8\x91\x44\xcd\x34\x18\x65\xa1\xe3\x92\xa9\x0a\x46\x19\xbd\x1a\x5b\x3b\xb1\x45\x4f\xe3\x29\x14\xbd\x55\xc0\xc5\xf9\x91\xd7\xba\xc0\xec\x4d\xc9\xf2\x92\xac\xf1\x92\x53\x0e\xc6\x0e\xd5\x02\x86\x41\xde\xf4\x5d\xb7\x24\xe7\x8c\x2b\xe0\x4b\x03\x3d\xf0\x5c\xa3\x54\x54\x16\x5d\x80\x30\x95\xad\x19\x83\x8c\xa0\x64\x25\x99\x4a\xa0\x47\x41\x54\x52\x59\xc0\xef\x1f\x3e\x74\x91\xef\xa6\xdd\x72\x3a\x85\xcf\x04\x0f\x52\x97\x80\xba\x0c\xda\x3d\x09\x45\xa7\x26\x7c\x2e\x14\xa1\xae\xed\x2f\x8c\xb5\xa8\xf8\x74\x7a\x0e\xb7\xc6\x33\xb0\x01\xb1\x25\xf1\x00\x81\x29\x48\x1f\xe6\x58\xdf\xbc\xcb\xe4\x47\xf2\xdf\x00\x39\x53\x85\x78\x2b\x08\x00\x00"
+
+func transactionsV1Buy_itemCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1Buy_itemCdc,
+		"transactions-v1/buy_item.cdc",
+	)
+}
+
+func transactionsV1Buy_itemCdc() (*asset, error) {
+	bytes, err := transactionsV1Buy_itemCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/buy_item.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2b, 0x25, 0x99, 0xd1, 0x8b, 0x56, 0xb1, 0x4, 0xf7, 0x82, 0x52, 0xae, 0x1d, 0xa1, 0x17, 0x50, 0xc7, 0x5, 0x20, 0x49, 0xdb, 0xc5, 0x8, 0x33, 0xa, 0xc, 0x86, 0xd0, 0x42, 0xd5, 0xb5, 0xdc}}
+	return a, nil
+}
+
+var _transactionsV1Cleanup_itemCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x91\xdd\x8a\xdb\x30\x10\x85\xef\xfd\x14\x07\x5f\x2c\x36\x14\xef\x4d\xe9\x85\x69\xba\x6c\xbb\x14\x02\xa5\x2c\xfd\x79\x00\x65\x34\x4e\x45\x15\x49\x68\x46\x9b\x86\x90\x77\x2f\x89\xdd\xfc\x90\xa6\x95\x74\x31\x88\xf9\xce\x19\xce\xb8\x55\x8a\x59\x51\x7f\xfe\xf8\xed\xab\xc6\xcc\x43\x8e\x41\xeb\xaa\xd2\x6c\x82\x18\x52\x17\x43\xe3\x9d\xa8\x0b\xcb\x2f\x2c\xb1\x64\xe2\xf9\x53\x8f\xef\xf3\xa0\x6f\x5e\xbf\x82\x1c\x99\x47\x6b\x33\x8b\xf4\x98\x8a\x16\xdb\xaa\x02\x00\xcf\x7a\xd6\xd6\xe3\x6e\x7b\xe1\xd5\x9d\xca\xe7\xb2\xf0\x8e\x76\x23\x96\x32\x27\x93\xb9\x31\x44\xda\xe3\xee\x91\x28\x96\xa0\x7b\x55\x4c\x47\xd8\x0f\xdd\x49\x19\x33\x2c\x59\xa7\xbe\xe6\x6a\xb0\xb6\x23\x93\xcc\xc2\x79\xa7\x8e\xa5\x5b\xc4\x9c\xe3\xfa\xed\x7f\x87\x79\xd7\x1c\xfd\xfe\xdc\x7f\x13\xcf\x46\x7f\x5c\x20\x2d\x1e\x1e\x90\x4c\x70\xd4\xd4\x1f\x62\xf1\x16\x21\x2a\x46\x7f\x9c\x68\x0c\x39\xae\x90\x72\x7c\x71\x96\x2d\xcc\x98\x62\xdd\x1e\xb4\xa6\x4c\xf8\x17\x53\x51\x3e\xcb\xe0\xfe\x1e\xef\x19\x3f\x5d\xb0\x30\xc1\x22\x33\x6d\xc8\xf3\xad\x88\x3a\xf2\x6c\x42\x49\x7f\xdb\xe8\xd5\xd7\x85\x73\x8a\xa2\xb7\xa3\xef\x96\xac\x9f\x46\x7e\xfe\x24\x4d\xdb\x51\x0c\x6a\x5c\x90\x6b\xa3\x16\xb3\x19\x06\xe3\x85\xfb\xa3\xda\xfe\xd5\x13\x8f\xb5\x91\x43\x40\x52\x88\x58\x64\x28\xde\x6f\x90\x79\x15\x5f\xd8\xd6\x15\x00\xec\xaa\x5d\xf5\x7b\x00\xd1\x83\x08\x42\xb7\x02\x00\x00"
+
+func transactionsV1Cleanup_itemCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1Cleanup_itemCdc,
+		"transactions-v1/cleanup_item.cdc",
+	)
+}
+
+func transactionsV1Cleanup_itemCdc() (*asset, error) {
+	bytes, err := transactionsV1Cleanup_itemCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/cleanup_item.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9c, 0x4, 0x27, 0xf2, 0x96, 0xe8, 0xab, 0xaf, 0xc7, 0xed, 0x99, 0xc6, 0x5a, 0x3c, 0x50, 0x79, 0xe4, 0x58, 0x55, 0xec, 0xbd, 0xad, 0x76, 0x40, 0xa, 0xc8, 0x37, 0xe4, 0x44, 0x54, 0xc2, 0x75}}
+	return a, nil
+}
+
+var _transactionsV1Remove_itemCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xa4\x91\xcf\x4b\xfb\x40\x10\xc5\xef\xf9\x2b\x1e\x39\x94\xe4\xf0\xcd\xe9\x8b\x87\x60\x2d\x4a\x11\x0a\x2a\x52\x7f\xdc\xd7\xed\x24\x5d\x48\x76\xc2\xce\xc4\x5a\xa4\xff\xbb\x24\xfd\x65\xa8\xf5\x22\x13\xc2\x30\xcb\xfb\xbc\xb7\xb3\xae\x6e\x38\x28\xe2\x87\xdb\xe7\x27\xe5\x40\x45\x60\xaf\x71\x14\x69\x30\x5e\x8c\x55\xc7\x3e\xa9\x9c\xa8\xf3\xe5\x9c\x84\xdb\x60\x69\x36\xcd\xf1\x32\xf3\x7a\xf1\x3f\xc5\x67\x04\x00\xfd\xaf\x22\x85\x1c\x10\x39\x4c\xab\xcb\x64\x80\xcd\xe6\x54\xf3\x3b\xdd\x6d\x71\x29\x46\xc3\xd3\x63\x1b\xf5\xc0\x26\x50\x63\x02\x25\xc6\xda\x3d\xee\x86\x43\xe0\xd5\xab\xa9\x5a\x4a\x31\xba\xb6\x96\x5b\xaf\xfb\x14\x5d\x09\x55\x45\x76\x4c\x81\x31\x3a\x75\x3f\x31\x25\x65\x6f\xbd\xfe\xf2\x0f\xd1\xae\x92\x83\xd7\xbe\x8a\xc0\x75\x8e\x73\x82\xae\x33\x25\x3d\x1a\x5d\x0e\x94\x29\x26\x13\x34\xc6\x3b\x9b\xc4\xf7\x4e\xc4\xf9\x12\x1c\x50\x3b\xf9\xa7\xeb\x86\x16\x67\x81\x71\xda\x73\x36\xdb\x25\xd1\x07\xd9\x56\xe9\xfc\x0a\xb2\xf0\xfd\x66\x3f\xbd\xe5\xc9\x68\x60\xd0\xb0\xe8\x2f\xf4\x92\x74\x87\x9e\x4d\x25\x49\x33\xcb\x5e\x8d\xf3\x72\x6a\x94\x62\x3c\x46\x61\x2a\xa1\xfc\x40\xeb\xbe\x78\xa7\xc7\xca\x08\x3c\x2b\xa4\xb5\x96\x44\x8a\xb6\xaa\xd6\xd8\xa6\x5f\xc4\x11\x00\x6c\xa2\x4d\xf4\x35\x00\xda\x58\x00\xe5\xb1\x02\x00\x00"
+
+func transactionsV1Remove_itemCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1Remove_itemCdc,
+		"transactions-v1/remove_item.cdc",
+	)
+}
+
+func transactionsV1Remove_itemCdc() (*asset, error) {
+	bytes, err := transactionsV1Remove_itemCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/remove_item.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x59, 0xb4, 0x3d, 0x24, 0x55, 0x7e, 0x91, 0xd8, 0x15, 0x1f, 0x6e, 0xfd, 0x71, 0x4a, 0x6d, 0x93, 0xc6, 0xd2, 0x50, 0xeb, 0xf6, 0x18, 0xd2, 0x33, 0x4f, 0x3d, 0x35, 0x30, 0x3c, 0x1e, 0x1f, 0xa2}}
+	return a, nil
+}
+
+var _transactionsV1ScriptsV1Read_listing_detailsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x91\x5f\x6b\xc2\x30\x14\xc5\xdf\xfb\x29\x0e\x7d\x18\x0d\x8c\xfa\x32\xf6\x20\x73\x22\x93\x81\x30\x44\x9c\xfb\x00\x31\xbd\xb5\x17\x62\x52\x92\x5b\x7c\x10\xbf\xfb\x98\xfd\xa3\x65\x63\x04\x42\x08\x87\xf3\x3b\xf7\x5c\x3e\xd6\x3e\x08\xd2\xf5\xfb\xee\x53\x7c\xa0\x32\x78\x27\x69\x92\x4c\x26\x13\xec\x2a\x8e\x88\x26\x70\x2d\x08\x24\x4d\x70\x11\x52\x11\x0a\x12\xcd\x36\xa2\xf4\x01\x1a\x96\xa3\xb0\x3b\xe0\xc4\x52\xb1\x83\x46\x1c\x7c\x7e\x5c\x12\x6d\x0c\xc5\x98\x69\x6b\x15\xca\xc6\xe1\xa8\xd9\x65\xda\x18\xdf\x38\x99\x62\x51\x14\x81\x62\x7c\xec\x6d\xb6\x14\x7d\x13\x0c\xad\x96\x53\x7c\xad\x9c\x3c\x3f\xa9\x29\x46\xe1\xf2\x8f\x56\xb9\xec\x52\x9c\x13\x00\xb0\x24\x77\xe0\x2d\x95\x98\xe1\x40\xb2\x68\x39\x3d\x4f\xe5\x46\xd7\x7a\xcf\x96\x85\x29\xe6\x7b\x1f\x82\x3f\xbd\x3c\x9c\xc7\x80\xdb\x73\xd3\xec\x2d\x9b\xcb\x6b\x76\x65\xf4\xe7\x7f\xf5\x46\x4b\x35\xc8\x15\xe6\x73\xd4\xda\xb1\xc9\xd2\x37\xdf\xd8\x02\xce\x0b\x5a\x2e\xea\xab\xfb\x5d\x6c\x94\xc1\x1f\xa1\xdb\x4a\x52\x95\x0c\x93\xf5\x1d\xcf\xc6\x33\x76\x03\x74\x85\x64\x7f\x54\xf8\xeb\x4b\x0d\xd1\x6e\xc1\xd6\x7e\xb4\x44\x48\xa5\x05\xab\x65\xda\x6a\xaf\x57\xbb\xfe\x5e\x96\x1f\x48\xba\xfa\x33\x95\x5c\x92\xef\x01\x00\x8d\xe5\x80\x48\x45\x02\x00\x00"
+
+func transactionsV1ScriptsV1Read_listing_detailsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1ScriptsV1Read_listing_detailsCdc,
+		"transactions-v1/scripts-v1/read_listing_details.cdc",
+	)
+}
+
+func transactionsV1ScriptsV1Read_listing_detailsCdc() (*asset, error) {
+	bytes, err := transactionsV1ScriptsV1Read_listing_detailsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/scripts-v1/read_listing_details.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x9e, 0xf1, 0xbf, 0x24, 0x11, 0x7b, 0xf4, 0x17, 0xce, 0x18, 0x99, 0x99, 0x90, 0x76, 0x81, 0x33, 0xa3, 0x49, 0xb6, 0x9f, 0x53, 0xdd, 0x69, 0x43, 0x62, 0xa6, 0xe5, 0xe1, 0x77, 0xf5, 0x11, 0x40}}
+	return a, nil
+}
+
+var _transactionsV1ScriptsV1Read_storefront_idsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x8f\xbd\x6a\xeb\x40\x10\x85\x7b\x3d\xc5\x41\xc5\x45\x6a\xe4\xe6\x92\xc2\x84\x18\x13\x13\x30\x84\x60\x88\x53\x85\x14\xe3\xd5\x4a\x5a\x58\xef\x88\x99\x59\x42\x30\x7e\xf7\x10\xff\xc8\xa4\x09\xdb\x2c\xcc\x37\xdf\x9c\x13\xf6\x23\x8b\xa1\x7c\x79\xda\xbe\x1a\x8b\xef\x84\x93\x95\x45\x31\x9b\xcd\xb0\x1d\x82\x42\x9d\x84\xd1\x20\xde\xb2\x24\x05\x25\x90\x08\x7d\x81\x3b\x50\x8c\xb0\xc1\xe3\x39\xa8\x85\xd4\x63\xbd\x52\x74\x2c\x50\x8a\x1e\x36\x08\xe7\x7e\x00\xe1\xe6\xfd\xb1\x16\xe4\x9c\x57\xad\x28\xc6\x1a\x5d\x4e\xd8\x53\x48\x15\x39\xc7\x39\xd9\x1c\xcb\xb6\x15\xaf\x5a\xcf\xf1\xfe\xb6\x4e\x76\xf7\xff\x03\x87\x02\xc0\x25\x00\x7a\x6f\xcb\x33\x7b\xdd\xa9\x1b\x47\x23\xed\x42\x0c\x16\xbc\x36\x3b\x16\xe1\xcf\xfb\x7f\x87\x5f\x8d\x9a\xdb\x77\x93\x77\x31\xb8\xe3\x43\x75\xd2\x5e\xdf\xdf\xf4\x86\x6c\x98\xf0\x7a\xd1\xf4\xde\x2e\xa5\xd7\x2b\xad\xea\x69\xb4\x58\x60\xa4\x14\x5c\x55\x3e\x72\x8e\x2d\x12\x1b\xce\x81\x30\x9e\xce\x42\x27\x33\x3a\xe1\x3d\xa8\x6d\xc5\xab\x96\x75\x71\x2c\xbe\x07\x00\x93\xbd\xf2\x32\x8c\x01\x00\x00"
+
+func transactionsV1ScriptsV1Read_storefront_idsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1ScriptsV1Read_storefront_idsCdc,
+		"transactions-v1/scripts-v1/read_storefront_ids.cdc",
+	)
+}
+
+func transactionsV1ScriptsV1Read_storefront_idsCdc() (*asset, error) {
+	bytes, err := transactionsV1ScriptsV1Read_storefront_idsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/scripts-v1/read_storefront_ids.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xde, 0x28, 0x2b, 0x45, 0xc0, 0xc2, 0x2e, 0xa9, 0x93, 0xcc, 0xc4, 0x40, 0xc7, 0x95, 0x8f, 0xee, 0x5f, 0x7d, 0x71, 0x92, 0xeb, 0x2f, 0x6c, 0xd, 0xfb, 0x98, 0x85, 0xd4, 0xf9, 0xdf, 0x28, 0xda}}
+	return a, nil
+}
+
+var _transactionsV1ScriptsV1Verify_listed_nft_existsCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x7c\x92\x41\x8b\xdb\x30\x10\x85\xef\xfa\x15\xaf\x3e\x2c\x36\x94\xe4\x52\x7a\x08\x75\xc3\xb6\x8b\x21\x50\xcc\xb2\x75\x7f\x80\x2c\xcb\xf1\x50\x65\xc6\x48\x63\xf6\x10\xf2\xdf\x4b\xd7\xb1\x77\xd3\x96\xa2\x8b\xd0\x0c\xdf\x7b\x6f\x34\x74\x1a\x25\x2a\xb2\x5a\xb8\x9a\xf8\x48\x6d\xf0\x8d\xfc\xf4\x9c\x99\xb5\x52\x35\xdf\x55\xa2\xef\xa3\xb0\x66\xc6\x6c\xb7\x5b\x34\x03\x25\x24\x17\x69\x54\x44\xaf\x53\xe4\x04\x1d\x3c\x3a\xaf\x96\x42\x42\x2f\x11\x16\x81\x92\x12\x1f\xf1\x4c\x3a\x10\xc3\x22\xad\x9c\xdf\x14\x63\x9d\xf3\x29\xe5\x36\x84\x02\xfd\xc4\x38\x59\xe2\xdc\x3a\x27\x13\xeb\x0e\xf7\x5d\x17\x7d\x4a\xef\x17\xcc\x93\x4f\x32\x45\xe7\x0f\x0f\x3b\xfc\x38\xb0\x7e\xfc\x50\xec\xf0\x45\x24\xe0\x6c\x00\x20\x78\x7d\x23\xf0\xe4\x7b\x94\x38\x7a\xbd\x9f\x79\x0b\xb7\xd8\x38\x3b\xda\x96\x02\x29\xf9\xb4\x69\x25\x46\x79\xfe\x74\x77\xbe\x49\xb9\x79\xbd\x3e\x4e\x6d\x20\x77\xf9\x9c\xbf\x68\x2c\xe7\xff\xdd\x8f\x56\x87\xb5\xbd\xc0\x7e\x8f\xd1\x32\xb9\x3c\xfb\x2a\x53\xe8\xc0\xa2\x98\x75\x31\xbe\xd0\xdf\xd8\x46\x1f\xe5\x04\x3b\x47\xcf\x0a\xb3\x26\x5b\x66\x59\xde\x66\xbc\x06\xf8\x36\x57\xf3\x7f\x8c\xea\xaf\xa7\x62\xb5\xf6\x6a\xac\x96\x9b\xcf\x82\x0e\x56\x71\x78\xc8\xe6\xde\xd5\x04\xf7\xba\xc3\xdd\xf9\xcf\x5d\xd9\xd4\x55\x73\xd9\xa3\x5c\x18\x57\x53\x75\xd5\xe4\xd7\x04\xf3\x8e\x80\x7b\xc5\xbb\x12\x4c\xc1\x5c\xcc\xaf\x01\x00\x3e\xc3\x2e\x73\x7a\x02\x00\x00"
+
+func transactionsV1ScriptsV1Verify_listed_nft_existsCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1ScriptsV1Verify_listed_nft_existsCdc,
+		"transactions-v1/scripts-v1/verify_listed_nft_exists.cdc",
+	)
+}
+
+func transactionsV1ScriptsV1Verify_listed_nft_existsCdc() (*asset, error) {
+	bytes, err := transactionsV1ScriptsV1Verify_listed_nft_existsCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/scripts-v1/verify_listed_nft_exists.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0xf6, 0xfd, 0x64, 0x7a, 0x22, 0xc8, 0x5f, 0xc, 0x3b, 0x8, 0xf9, 0x1e, 0xf1, 0x23, 0x15, 0xd5, 0xf5, 0x5, 0xd5, 0x2d, 0x33, 0x90, 0x11, 0xd9, 0x44, 0x24, 0x83, 0xdb, 0xb4, 0x18, 0xbe, 0xed}}
+	return a, nil
+}
+
+var _transactionsV1Sell_itemCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\xac\x57\x4d\x6f\xe3\x36\x10\xbd\xfb\x57\xcc\xfa\xe0\x4a\x80\x57\xba\x2c\xf6\x20\xc4\x71\x5b\xa7\x06\x0c\x74\xd3\xa0\xeb\x4d\x0f\x45\x0f\x63\x79\x64\x13\x91\x49\x81\xa4\x9c\x18\xd9\xfc\xf7\x82\xfa\x24\xf5\x91\x0d\x90\x45\x8c\xc0\x21\x39\xc3\xf7\x66\xde\x0c\x27\xec\x94\x09\xa9\x61\xfa\xc7\x13\x9e\xb2\x94\xb6\xe2\x81\xf8\x74\x52\xaf\xae\x73\x7e\x60\xbb\xde\xf2\xad\xe0\x23\x3b\x95\x9b\xdb\xf5\xd6\x3a\xbd\xde\x7e\xd5\x42\x52\x22\x05\xd7\xed\xf2\x17\xd2\xb8\x47\x8d\xf7\x8c\x1e\xd5\x74\x32\xd1\x12\xb9\xc2\x58\x33\xc1\x3d\x85\x29\x6d\x34\x9d\x36\x37\x11\x7c\xdb\x70\xfd\xf9\xd3\x1c\xea\xb5\x3b\xc9\x62\x8a\xe0\xdb\x9a\x3d\x7d\xfe\xe4\xc3\xf3\x64\x02\x00\x90\x92\x06\xb2\x38\xfc\x4d\x31\xb1\x33\xc9\x08\x56\x98\xe1\x8e\xa5\x4c\x5f\xae\x66\xcf\x0e\xec\xa0\x3e\xf4\x72\xdd\xf5\x71\xbb\xde\xde\x49\x71\x66\xfb\x8e\x07\xcc\xf5\xd1\xeb\xd2\x0f\xfe\x61\xfa\xb8\x97\xf8\xe8\xc3\xec\xb9\xb7\xb9\x12\x69\x4a\x05\x2d\xeb\x1a\xd5\x04\x24\x82\xd2\xa7\x1d\xa4\x60\x25\x09\x35\xfd\xc9\x94\x66\xfc\xe0\xc3\xcc\xdd\x6d\xbf\x96\xdc\x33\x49\x19\x4a\xf2\x30\x8e\x6b\x77\xbf\x0b\x29\xc5\xe3\x3d\xa6\x39\xcd\x61\xa3\x54\x4e\xc6\x08\x0f\xd4\x72\x59\x09\xae\xa5\xc1\x26\xe7\x70\x97\xef\x52\xa6\x8e\xed\xe6\x1c\xbe\xe2\x99\x2a\xfb\xae\x29\x23\xe5\xc3\xec\xb7\x38\x16\x39\xd7\x4d\x06\x6a\x6a\x71\xc3\xf7\x06\x35\xfe\x95\x69\x58\x40\x2b\x8b\x40\x92\x12\xe9\x99\x8a\xdb\x31\xd6\x26\xfb\x9e\x59\xcb\x65\x4c\xdb\x4b\x46\x11\x98\xdf\x57\xbf\x5a\x26\xb7\xeb\xed\xb5\xe7\xcf\xe1\xcc\xe8\xd1\x3a\xe2\x08\x28\xb8\x5d\x6f\xdb\x48\x9b\x9b\xaf\x3d\xdf\x6f\x80\x99\xcf\x72\x09\x19\x72\x16\x7b\xd3\x2f\x4c\x29\xc6\x0f\x16\x54\x30\x8e\xa6\xfe\x2b\x44\x60\xd1\x59\x30\xcc\x50\x7d\x80\x1f\xc0\x68\x83\xa3\x28\x4d\x82\x21\x8d\xc2\x02\x4c\xea\x82\xd8\x0a\x70\x70\x20\xfd\x8a\x5e\xbd\x30\x33\x29\x8b\xc3\x21\x7f\x2d\x0d\x54\x8a\xa4\xf6\x46\x6f\x0e\xe2\x23\xc5\x0f\x26\xb6\x27\x52\x0a\x0f\x14\x41\x13\x1c\x21\xe1\xc4\xd4\x47\x7d\xc9\x68\x5f\x27\xb0\x30\x86\xda\x7a\xea\xb7\xe4\xce\x28\x81\x27\xba\x2e\x9a\x15\x66\x3f\xb3\x6e\x96\xb0\x00\xce\xd2\xe6\xb6\x30\x84\x02\x39\xb0\x04\xf4\x91\x24\x01\x53\x80\x1c\xe8\xa9\x2c\x19\x68\x42\x79\x09\xdb\xaf\x10\x37\x92\x87\x44\x48\x63\x59\x14\x22\x1e\x08\x32\xd4\xc7\xc6\xbd\xc9\x3e\x4f\x74\x8b\xa0\xad\x15\x35\x98\xac\xca\x8b\x49\x9a\x75\xd4\x4b\x84\xbc\x43\x7d\x8c\x3a\xca\xa9\x8f\x9b\xbd\x36\x57\x06\x91\x05\x90\xf1\x71\x08\xcf\x8d\x91\xf9\xb0\xa4\x68\x29\x27\xbc\xec\xc8\x8a\x3f\x2c\x2c\x77\x2d\xdc\x0b\xa0\x5a\xfe\xd4\xd4\xb8\x68\xcc\x8f\x2b\x04\x58\xf4\xb0\xf5\x2c\x76\x92\xf0\xc1\x59\x7d\x69\xfe\x7a\x69\x55\x16\x86\x6d\xc6\x51\x12\x70\xd1\x66\x9a\x91\x82\xb8\xe8\x9b\xfb\x2a\xbb\xa8\x87\xd3\x1b\x86\x20\xa4\x71\x34\x20\x17\x23\x24\x2e\x20\x15\xfc\x40\x12\xce\x98\xb2\xfd\x1c\x98\x69\xa0\x80\xc0\xe9\x11\x04\xa7\xc6\x11\x4b\x7a\x54\x0b\x9d\xc2\xf7\xef\x9d\x8d\x65\x5d\x69\xb0\x5c\x42\x82\xa9\xa2\x4e\x12\xbb\x7e\x5e\x11\x59\x81\xe6\xbd\x69\xf3\x9c\xdb\xcd\x67\x5c\xa3\xce\x51\x7f\xd2\xcf\x50\xd5\x66\x7e\x44\xd9\xee\x32\x2b\x91\xa7\x7b\xe0\xc2\xf4\x50\xc5\x0e\x1c\x6a\x4b\x4b\x9a\x53\x7f\xb8\x79\x5a\x8f\x33\x2c\x3a\x91\xfb\xe0\x88\x65\x53\x88\xc5\xc4\xd2\x3c\x55\xb0\x17\xa4\xf8\x2f\x1a\x30\x95\x84\xfb\x0b\x1c\xf1\x4c\x80\x60\xbd\xa7\xb5\x2d\x4b\x8c\x91\x6e\x62\xbe\x2b\xde\xd3\xab\xd1\x87\xf8\xda\x4b\xa4\x38\x45\x30\xb6\x5f\x3d\xa1\x26\x9a\x7e\x2d\x12\x57\x00\x61\x58\x3c\xb9\x95\xca\x2c\x53\xd0\xa2\x21\x50\xc1\x71\x0c\x1d\x9c\x0a\xcf\xd4\x4f\xed\xd5\xc7\x0e\xb0\xb2\x4e\xda\x05\xcf\x9f\xf7\x8c\xb4\x78\x13\x9d\x11\x71\x54\x94\xca\x8b\x00\xa1\x7c\xae\xec\x42\xab\x3b\xb0\x4d\x75\x56\x9e\x53\xae\xe4\xdc\x81\xa9\x98\x55\xe2\xb7\x15\xc9\xec\x79\x8c\x41\xe9\x65\xa8\x0e\xde\xc7\xb9\x8f\xa8\x62\xe4\x0d\x30\x98\x03\xea\xf1\x20\x97\xc7\xdc\x47\xc2\xea\x85\x45\x45\xb4\x4e\x61\xe1\x2a\xa1\x52\xec\x3b\x86\xcb\x81\xd8\xbc\x59\xe4\x8e\xa5\x3f\x30\x7a\x39\xd3\x85\xe3\xd0\x2a\xc7\x6a\x20\xab\x48\xd3\x13\xc5\xb9\xb6\x1b\x67\x21\x0c\x4c\x69\x95\x9b\x21\xb3\x03\xab\x5c\x77\x39\xc8\x6a\x72\x89\xc6\x87\x31\xb7\x10\xf0\x64\xea\x2e\x72\xff\xf9\x98\xf4\x53\xdf\x49\x46\x10\xdb\x31\x76\x31\xb8\xfd\xaa\xaa\x86\x68\xac\xc1\xb9\x70\x78\xa2\x5f\x9f\x91\xbb\xa7\x37\x37\x2d\xf6\xcd\x8d\xbb\x6d\xd6\xef\xf0\x72\x22\xae\xef\x31\x4f\x87\x3c\x97\xef\x46\xb1\xdb\xf3\x6e\xcc\x57\xb9\x56\x11\xfc\x5b\x7d\xfd\xaf\xd9\xf7\x27\x00\x00\x2f\x93\x97\xc9\xff\x03\x00\x37\x69\x55\x3d\x60\x0e\x00\x00"
+
+func transactionsV1Sell_itemCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1Sell_itemCdc,
+		"transactions-v1/sell_item.cdc",
+	)
+}
+
+func transactionsV1Sell_itemCdc() (*asset, error) {
+	bytes, err := transactionsV1Sell_itemCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/sell_item.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x2, 0x2a, 0xdb, 0x18, 0x31, 0xd0, 0x1b, 0xb0, 0xa7, 0x67, 0xc2, 0xec, 0x3d, 0xa1, 0xe8, 0xfb, 0x67, 0xc8, 0xff, 0x4e, 0x6d, 0x7b, 0x88, 0x59, 0xf, 0x94, 0x13, 0x10, 0x61, 0x71, 0xfa, 0xf8}}
+	return a, nil
+}
+
+var _transactionsV1Setup_accountCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x8c\x52\xcd\x6a\xe3\x30\x10\xbe\xeb\x29\x3e\x7a\xc8\x3a\x90\xda\xf7\x92\x14\x16\xc3\x42\x2f\x4b\x61\xfb\x02\x13\x75\xbc\x16\x28\x92\x91\xc6\x5b\x42\xf0\xbb\x2f\xb2\x5d\xff\x6c\x9a\x25\xc8\x07\xdb\xfa\x66\xbe\x9f\x19\x73\x6a\x7c\x10\x3c\xfc\xfc\xf1\xf6\x4b\x7c\xe0\x2a\x78\x27\x0f\x4a\x15\x05\xde\x6a\x13\x21\x81\x5c\x24\x2d\xc6\x3b\x18\x17\x85\xac\x8d\x90\x9a\x31\xa3\x11\x38\x46\xdf\x06\xcd\x30\x0e\xe4\x40\x5a\xfb\xd6\x49\xae\xd4\xb2\xfa\xa2\x00\xa0\x09\xdc\x50\xe0\x8c\xb4\x96\x27\x50\x2b\x75\xf6\x12\x63\xcb\xa9\x1d\xfd\xe6\x92\x1a\x3a\x1a\x6b\xe4\x5c\x7a\x27\xc1\x5b\xcb\x61\x87\xd7\xf6\x68\x4d\xac\xe7\xcb\x1d\x46\xfc\x16\x9b\xef\x03\xdb\x16\x17\xd5\x33\xa4\xa7\x28\xf0\x52\xf5\x32\x47\x2d\x78\xf7\x1c\xdd\x37\x01\xd9\xc0\xf4\x7e\x46\x4d\x7f\x18\xb4\x70\x31\xd5\x9a\x2a\x19\x90\x3c\x0e\x0c\xf9\xd1\x87\xe0\x3f\xf6\x9b\x55\x42\xf9\xfc\xfa\x9c\x55\xc1\x9f\x9e\x70\xeb\x7e\x54\xfa\x4a\x52\x6f\x71\x38\xc0\x19\xbb\x94\x3a\xca\x2d\x03\x93\x24\x45\x8e\x3f\xc0\xa7\x46\xce\xc8\xbf\x10\x97\x8e\x65\x41\x9c\xae\xb0\x7f\xfc\x87\x5a\xf7\xad\xe6\x1f\xd9\x76\x55\xbe\xfa\x28\x0a\xc4\x94\x84\x11\x88\x5f\x06\xb6\x42\xad\xf2\x48\xf8\x6c\xff\x38\x2b\xd8\x41\xfc\x7d\xf6\xaf\x5c\xeb\x4f\xd7\x4d\x9a\xb0\x86\x9e\x26\x8c\xca\x87\x5e\xcf\xa2\x15\x36\x03\x2e\xd6\xff\xc9\xa3\xdf\x15\x5d\x52\x83\x43\xf2\x22\xf9\xd4\xd3\x70\x9c\x4c\x98\xb4\x73\xfb\xcd\xe5\x96\xea\xa1\x4b\xf7\x9c\xad\x98\x3e\xcf\x1d\x5e\xaf\xea\xd6\x43\xb8\x56\x36\x3a\xcb\xbe\x70\xb2\x03\xc9\xed\x80\x07\x58\xbf\x5e\x13\x45\xa7\x00\xa0\x53\x9d\xfa\x3b\x00\x24\xb7\xbc\x8f\xe0\x03\x00\x00"
+
+func transactionsV1Setup_accountCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1Setup_accountCdc,
+		"transactions-v1/setup_account.cdc",
+	)
+}
+
+func transactionsV1Setup_accountCdc() (*asset, error) {
+	bytes, err := transactionsV1Setup_accountCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/setup_account.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x6c, 0xcb, 0xb5, 0xe7, 0x66, 0xbc, 0x7d, 0x48, 0xe6, 0x5b, 0xbe, 0x6b, 0x5b, 0xd8, 0xe9, 0x51, 0x5c, 0x98, 0xb3, 0xd6, 0xbb, 0x7e, 0x32, 0x29, 0x3f, 0xbd, 0xe0, 0xd5, 0x75, 0x5, 0xfa, 0x6a}}
+	return a, nil
+}
+
+var _transactionsV1UtilityMint_example_nftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x56\xcd\x6e\xe3\x36\x17\xdd\xeb\x29\xce\xe7\x85\xc7\xc6\x97\xb1\x5a\xa0\xe8\x42\x88\x33\x98\xc9\x34\x40\x17\x13\x0c\x32\xee\x6c\x02\x2f\x68\xe9\xda\x62\x4b\x93\x2a\x79\x65\xc7\x08\xf2\xee\x05\x45\xfd\x3a\x72\x02\x1b\xb2\x45\x9e\xfb\x77\x78\xee\x95\xe2\x38\xc6\x2a\x97\x0e\x2e\xb5\xb2\x60\x94\x8e\x1c\x38\x27\xdc\xdf\xad\xbe\x49\xcd\x64\x61\xc9\x99\xd2\xa6\x04\x36\xd8\x4b\xcd\x10\xd0\x74\xf4\x80\xc8\x5b\xff\xc9\xd8\x97\x8e\xb1\x21\xd8\x52\xe3\x28\x39\xaf\x1c\x88\x34\x35\xa5\x66\x70\x2e\x18\xb9\x08\x5e\xf7\x43\x97\x95\x03\xc7\xc6\x52\x06\xa9\x11\xfb\xbf\x62\x47\x71\x1b\x3c\x8a\xe4\xbe\x30\x96\x31\xb9\x37\xfa\xae\xd4\x3b\xb9\x51\xb4\x32\xff\x90\x9e\xb4\x3b\x7f\x3c\x89\x7d\xa1\xe8\xfe\x6e\xd5\xad\x7d\x23\x16\x99\x60\xf1\x53\xd2\xd1\x75\xcb\x67\x1e\x22\xb6\x42\x3b\x91\xb2\x34\x7a\x16\x01\x80\xa5\x54\x16\x92\x34\x27\xf8\x9c\x65\x96\x9c\xbb\xaa\xd6\xb5\xd8\x53\x82\x1f\x6c\xa5\xde\x85\x95\x8c\x02\x63\xd2\xe8\xe1\x06\xe7\xe5\x7e\xa3\x85\x54\xc3\xe5\xb4\x64\x97\xe0\xf1\xaf\x3b\xf9\xf4\xfb\x6f\xeb\xb0\x66\xcd\x49\x28\x3e\x7d\xed\x5c\x79\x48\xb0\x1a\x42\xbe\x90\xa6\xad\x4c\xa5\xb0\x92\x3c\xa6\x4e\x6e\x1d\xcd\xf1\x1c\x55\x40\xcf\xa4\x32\xa9\x50\x38\x08\x2b\xc5\x46\x11\xb6\xc6\xc2\x33\x2a\xf5\x6e\x48\xfe\x96\x2c\xe9\x94\x2a\x3b\x45\x5c\x6f\x24\x98\x76\x54\x2e\x7a\x47\xd0\xb8\x7f\x68\x0c\xbd\x12\xbc\x43\x4b\x29\xc9\x03\xd9\x0f\x0e\xa9\x51\x8a\x2a\x22\x5b\xaf\x2d\x97\xb7\xed\xde\x03\x6d\x13\x4c\x9f\xcf\xcf\x72\xf1\x50\x3b\x7a\x09\xb5\x14\x96\x0a\x61\x69\xe6\xe4\x4e\x93\x4d\x20\x4a\xce\x67\x5f\x8c\xb5\xe6\xf8\x53\xa8\x92\xe6\x98\x7e\x0e\xea\x6a\xcb\x6f\x82\x76\x79\x7c\x15\x2c\xb0\x44\xaf\x24\x2f\x64\x75\xa0\x5b\xa3\xd9\x8a\x94\xbd\x36\x66\x8d\x12\x57\xa7\x82\x12\x68\xa9\xae\x70\x90\x74\x0c\xb7\xfe\x7a\x3d\x90\x92\xa7\xa5\x2b\xc7\x87\xb8\x99\xcd\xe7\x10\xee\x7f\x78\x07\xf7\xa9\x4d\xd3\x7f\x3f\x7d\x42\x21\xb4\x4c\x67\x13\x0f\x7f\x08\x89\x59\x64\x86\x1c\xb4\x61\xd4\xa9\xe2\x95\x9b\x2a\xbb\xc9\xbc\x2b\x3a\x8e\xb1\xa9\x98\x81\x80\x3d\x3f\xa0\x91\x26\x96\x1a\x75\x97\xb5\x2e\x1c\xa9\xed\xa2\x16\xc7\x12\x81\xf4\x45\x0d\x5a\x04\xe7\xd7\xa3\xd2\xb8\x99\x6d\xad\xd9\x27\x7d\x8e\xc3\xcc\xf8\x11\x8c\xbf\x0b\xce\xe7\x17\xea\xae\x0f\xb0\x2b\xd9\x07\x24\x08\x0d\xb3\xf9\x9b\x52\x86\xf0\xa3\x83\xe0\x0a\x4a\xe5\x56\x52\x86\x42\x70\x7e\x56\x79\xd0\x44\xa3\xc5\xd0\xb9\x1f\x1c\x8a\x72\xa3\x64\xea\xc9\xeb\xe9\xe1\x4c\xf7\x6d\xe1\xe3\x32\xc5\x12\x3b\xe2\x3a\xc9\x59\x8b\x99\x2f\x52\x51\x88\x8d\x54\x92\x25\xb9\x96\x9c\x37\x14\x7d\x33\x6b\xc3\x35\x9f\xa1\x46\x17\x21\x5b\xcf\xd5\x00\x39\xef\x91\x75\x6b\x4a\x95\x55\xc2\xd8\x85\xc6\xaa\xba\x65\xf4\xbc\xd1\x95\x31\x09\xdc\x77\x4d\x85\xe7\x36\x82\x1f\x47\x0b\x45\x7a\xc7\x39\x96\xcb\xb1\x49\xd4\xec\x4e\xa7\x17\xc0\x83\x99\x54\x6f\x27\x98\x7c\xb6\x56\x9c\x50\xa3\x5d\x5e\x65\xbe\x21\xd0\xbf\xa5\x50\xd5\x48\xaa\xcd\x61\x49\x09\xa6\x0c\x19\xb1\x90\xca\x4d\xfa\xc9\xd2\x13\xa5\x25\x53\xbf\xbb\xe3\x18\x71\x8c\x5b\x4b\x82\x29\x9c\x78\xed\xa7\xb6\xef\x03\x0f\xc2\xa2\x3a\x39\x2c\xf1\xcb\xf9\x46\x88\x1f\xe6\xe8\xb0\x69\x1f\xaa\x9d\xd3\x1a\x4b\x3c\xae\xfb\x66\xc7\x5c\x2a\x7a\xab\x6e\xdc\xd4\xf1\x3a\x86\xe3\xd8\x5f\xab\x49\xb8\x69\x2d\x4e\x18\xa7\xef\xb1\xb2\x5e\xbf\x63\x7c\xdb\x68\xef\x34\x94\x67\x0f\x72\x26\xd0\x1d\xf1\xf5\xf4\xf9\x7d\x69\xd6\xf1\x9a\xcf\x90\x96\x1d\x71\xcd\x4c\x63\xfa\xbd\x95\xec\x6c\x3e\xe6\xa3\xaf\xdd\xae\xce\x53\xd7\xec\xb9\x38\x10\x1a\x6f\x48\x8d\xde\xca\x5d\xe9\xdf\x02\x04\xe3\x62\xac\xb3\xe6\xef\x1e\x8f\x5e\x7f\xa2\x28\x48\x67\xa3\x15\x8d\x1e\xf2\xc5\xda\x9b\xee\x4a\xc6\x99\xbf\xba\x64\x97\x96\x9c\x54\x9d\x52\x9f\xe5\x45\xe0\xe0\xcd\x61\xa4\xf1\x2e\x68\xc1\x7f\x5f\x91\xfd\x6a\xa1\x51\x7d\xf8\xfd\x3f\x7e\xed\x03\x5e\xa2\x01\x81\x7e\x56\xb7\x63\x43\x68\xdf\x89\x85\x71\x92\x21\xb9\x19\x28\xed\xe0\x7b\xfd\x88\x6f\xf4\x59\x3d\x38\x32\x3f\x79\xae\x3f\xf6\x1f\x25\xd5\xcf\xfd\xdd\xaa\x23\xba\x7b\x91\xf2\xd7\xab\xe8\x22\x29\xbd\x9b\x21\xaa\xf7\x6e\xd5\xfe\x1d\x22\xfa\xcd\xbd\x46\x1c\xb7\xf7\xd1\x6b\x0e\xdf\x18\xff\x8b\x9a\x8a\x19\xfb\xf7\x93\x04\xd7\x1f\xdb\x32\xe7\x11\x00\xbc\x44\xd1\xcb\x7f\x03\x00\x40\x1c\xd9\xff\x3c\x0b\x00\x00"
+
+func transactionsV1UtilityMint_example_nftCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1UtilityMint_example_nftCdc,
+		"transactions-v1/utility/mint_example_nft.cdc",
+	)
+}
+
+func transactionsV1UtilityMint_example_nftCdc() (*asset, error) {
+	bytes, err := transactionsV1UtilityMint_example_nftCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/utility/mint_example_nft.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x7d, 0x52, 0x85, 0xe5, 0x89, 0xa2, 0xb5, 0x12, 0x1, 0x93, 0x4b, 0x96, 0x2a, 0xfa, 0xfa, 0xb9, 0x8d, 0x68, 0x45, 0x18, 0xff, 0x77, 0x45, 0xe1, 0x37, 0x4c, 0x47, 0x12, 0xa3, 0x6b, 0xd8, 0x6f}}
+	return a, nil
+}
+
+var _transactionsV1UtilitySetup_account_for_example_nftCdc = "\x1f\x8b\x08\x00\x00\x00\x00\x00\x00\xff\x84\x93\x4f\x6f\x1a\x3d\x10\xc6\xef\xfb\x29\x9e\x70\x88\x16\x89\xc0\x1d\x91\xe4\x7d\x4b\x83\xd4\x43\x51\x94\x6c\x73\x1f\xcc\x90\xb5\x6a\xec\x95\x3d\x86\xa2\x28\xdf\xbd\xf2\x12\xf6\x0f\x49\x13\xc9\x42\xac\x3d\x33\xfe\xcd\xf3\x8c\x27\x93\x09\x8a\x52\x07\x88\x27\x1b\x48\x89\x76\x16\x3a\x60\x5f\x92\x80\x2c\x48\x29\x17\xad\x60\xef\xa2\x59\xc3\x47\x9b\xa5\x0c\x71\x08\x2c\xd0\x12\xd8\x6c\x10\x2b\x88\x83\x67\xc5\x7a\xc7\x58\x2e\x8a\x90\x65\x7a\x5b\x39\x2f\x18\x2c\x9d\x5d\x44\xfb\xac\x57\x86\x0b\xf7\x9b\xed\xa0\x39\xb9\xfb\x43\xdb\xca\xf0\x72\x51\xb4\x7b\x3f\x59\x68\x4d\x42\x4f\x9a\xf7\x61\x90\x65\x5d\xa8\x97\x2c\x03\x80\xca\x73\x45\x9e\xf3\xa0\x9f\x2d\xfb\x29\x28\x4a\x99\x7f\x73\xde\xbb\xfd\x13\x99\xc8\x23\xfc\x08\x21\xf2\xa3\x38\x4f\xcf\x3c\xa7\x8a\x56\xda\x68\x39\xcc\x9d\x15\xef\x8c\x61\x3f\xc2\x7d\x5c\x19\x1d\xca\xf6\x70\x84\x47\xda\xf1\x5b\xfe\x2f\x5b\x9d\x9f\x0f\x71\xf9\xff\x51\x88\x21\x5e\x6a\x8c\xb4\x9a\x3f\x86\x05\x2a\xd5\xae\x49\xbf\x93\x10\xae\xd1\xf6\x37\xf6\x1c\x9c\xd9\x71\x8d\x40\x4a\x52\x77\x79\xda\x8b\x5e\x71\x71\xa8\x78\x0a\xab\xcd\x08\x3b\xcd\xfb\xe3\x67\xfa\x9d\xf5\xc4\x18\x2f\x17\xc5\xbc\x77\xc5\x4d\x3e\x1c\x82\xc2\x05\xbe\x88\xbb\x6d\x30\xd3\xba\xbd\x45\x45\x56\xab\x7c\x90\xc2\x1f\x8e\x60\x1e\x6b\xc7\x01\xd6\x09\xde\x50\xf1\xae\x4c\x4d\x37\x18\x66\x4d\xb5\xc9\x04\x0f\x2c\xd1\x5b\x30\x79\x73\x80\xde\x40\x4a\x6e\x06\x86\x8c\x67\x5a\x1f\x50\x52\x00\x75\xd4\x69\xf2\xf5\x06\x47\x0f\xc7\xe1\xe8\xd5\x78\x55\xbb\x38\xbb\xec\x28\xd7\x32\xdc\xe4\x1b\xef\xb6\xd3\x33\x9d\x4f\xb9\xf7\x24\xe5\x10\x17\xd7\x49\xc8\x8e\x43\x69\xf9\x1a\xb2\xd9\x7a\xed\x75\x30\xf7\x4c\xc2\x20\x58\xde\x83\xb7\x95\x1c\x3e\x42\xed\xfb\x8b\xd9\x55\xd7\x5c\x55\x97\xb8\x4b\xb9\x2d\x6d\x6e\x37\xd2\xb1\xf2\xbf\x4e\xfc\x72\x51\x24\xeb\x7a\x18\x81\x76\x0c\x2d\xe9\x19\x75\x34\x6c\x22\xce\x74\x4a\xd1\xf9\xec\xaa\x25\x1a\x41\xdc\xa7\xca\xf4\x2e\x53\xa7\x9e\xeb\x31\x57\x50\xcd\x98\x63\xe3\x7c\x6d\x62\x5b\xea\x9c\xa1\x09\xd6\x1c\xc6\xf1\xf4\x52\xf2\xb3\xbb\xeb\x6d\x75\xbc\xfa\x63\x11\xe7\x54\xe1\xfa\xc3\xa2\xa7\x2e\x75\x7a\xc6\xff\x1c\x86\xcf\x9a\xfd\x0c\xf9\x3d\xf0\x9c\xaa\x11\x48\xa6\xf8\xa2\x87\xd7\xec\x35\xfb\x3b\x00\xa7\x28\x04\xb0\x2e\x05\x00\x00"
+
+func transactionsV1UtilitySetup_account_for_example_nftCdcBytes() ([]byte, error) {
+	return bindataRead(
+		_transactionsV1UtilitySetup_account_for_example_nftCdc,
+		"transactions-v1/utility/setup_account_for_example_nft.cdc",
+	)
+}
+
+func transactionsV1UtilitySetup_account_for_example_nftCdc() (*asset, error) {
+	bytes, err := transactionsV1UtilitySetup_account_for_example_nftCdcBytes()
+	if err != nil {
+		return nil, err
+	}
+
+	info := bindataFileInfo{name: "transactions-v1/utility/setup_account_for_example_nft.cdc", size: 0, mode: os.FileMode(0), modTime: time.Unix(0, 0)}
+	a := &asset{bytes: bytes, info: info, digest: [32]uint8{0x19, 0xd1, 0x77, 0xc, 0xab, 0x2a, 0x8c, 0xa8, 0x1b, 0x6f, 0xda, 0x9e, 0xf6, 0x80, 0xba, 0xcb, 0x54, 0x41, 0x1c, 0x16, 0x77, 0x65, 0xde, 0xae, 0x56, 0xce, 0xc7, 0x8, 0x5b, 0x8a, 0x9e, 0x80}}
+	return a, nil
+}
+
+// Asset loads and returns the asset for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func Asset(name string) ([]byte, error) {
+	canonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[canonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("Asset %s can't read by error: %v", name, err)
+		}
+		return a.bytes, nil
+	}
+	return nil, fmt.Errorf("Asset %s not found", name)
+}
+
+// AssetString returns the asset contents as a string (instead of a []byte).
+func AssetString(name string) (string, error) {
+	data, err := Asset(name)
+	return string(data), err
+}
+
+// MustAsset is like Asset but panics when Asset would return an error.
+// It simplifies safe initialization of global variables.
+func MustAsset(name string) []byte {
+	a, err := Asset(name)
+	if err != nil {
+		panic("asset: Asset(" + name + "): " + err.Error())
+	}
+
+	return a
+}
+
+// MustAssetString is like AssetString but panics when Asset would return an
+// error. It simplifies safe initialization of global variables.
+func MustAssetString(name string) string {
+	return string(MustAsset(name))
+}
+
+// AssetInfo loads and returns the asset info for the given name.
+// It returns an error if the asset could not be found or
+// could not be loaded.
+func AssetInfo(name string) (os.FileInfo, error) {
+	canonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[canonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return nil, fmt.Errorf("AssetInfo %s can't read by error: %v", name, err)
+		}
+		return a.info, nil
+	}
+	return nil, fmt.Errorf("AssetInfo %s not found", name)
+}
+
+// AssetDigest returns the digest of the file with the given name. It returns an
+// error if the asset could not be found or the digest could not be loaded.
+func AssetDigest(name string) ([sha256.Size]byte, error) {
+	canonicalName := strings.Replace(name, "\\", "/", -1)
+	if f, ok := _bindata[canonicalName]; ok {
+		a, err := f()
+		if err != nil {
+			return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s can't read by error: %v", name, err)
+		}
+		return a.digest, nil
+	}
+	return [sha256.Size]byte{}, fmt.Errorf("AssetDigest %s not found", name)
+}
+
+// Digests returns a map of all known files and their checksums.
+func Digests() (map[string][sha256.Size]byte, error) {
+	mp := make(map[string][sha256.Size]byte, len(_bindata))
+	for name := range _bindata {
+		a, err := _bindata[name]()
+		if err != nil {
+			return nil, err
+		}
+		mp[name] = a.digest
+	}
+	return mp, nil
+}
+
+// AssetNames returns the names of the assets.
+func AssetNames() []string {
+	names := make([]string, 0, len(_bindata))
+	for name := range _bindata {
+		names = append(names, name)
+	}
+	return names
+}
+
+// _bindata is a table, holding each asset generator, mapped to its name.
+var _bindata = map[string]func() (*asset, error){
+	"scripts/check_sale_cut_receivers.cdc":                                                    scriptsCheck_sale_cut_receiversCdc,
+	"scripts/example-nft/get_ids.cdc":                                                         scriptsExampleNftGet_idsCdc,
+	"scripts/example-token/get_balance.cdc":                                                   scriptsExampleTokenGet_balanceCdc,
+	"scripts/get_existing_listing_ids.cdc":                                                    scriptsGet_existing_listing_idsCdc,
+	"scripts/has_listing_become_ghosted.cdc":                                                  scriptsHas_listing_become_ghostedCdc,
+	"scripts/inspect_listing.cdc":                                                             scriptsInspect_listingCdc,
+	"scripts/is_ghost_listing.cdc":                                                            scriptsIs_ghost_listingCdc,
+	"scripts/read_all_unique_ghost_listings.cdc":                                              scriptsRead_all_unique_ghost_listingsCdc,
+	"scripts/read_all_unique_ghost_listings_v2.cdc":                                           scriptsRead_all_unique_ghost_listings_v2Cdc,
+	"scripts/read_allowed_commission_receivers.cdc":                                           scriptsRead_allowed_commission_receiversCdc,
+	"scripts/read_duplicate_listing_ids.cdc":                                                  scriptsRead_duplicate_listing_idsCdc,
+	"scripts/read_listing_details.cdc":                                                        scriptsRead_listing_detailsCdc,
+	"scripts/read_listings_details.cdc":                                                       scriptsRead_listings_detailsCdc,
+	"scripts/read_storefront_ids.cdc":                                                         scriptsRead_storefront_idsCdc,
+	"transactions/buy_item.cdc":                                                               transactionsBuy_itemCdc,
+	"transactions/cleanup_expired_listings.cdc":                                               transactionsCleanup_expired_listingsCdc,
+	"transactions/cleanup_ghost_listing.cdc":                                                  transactionsCleanup_ghost_listingCdc,
+	"transactions/cleanup_purchased_listings.cdc":                                             transactionsCleanup_purchased_listingsCdc,
+	"transactions/example-nft/burn_nft.cdc":                                                   transactionsExampleNftBurn_nftCdc,
+	"transactions/example-nft/mint_nft.cdc":                                                   transactionsExampleNftMint_nftCdc,
+	"transactions/example-nft/setup_account.cdc":                                              transactionsExampleNftSetup_accountCdc,
+	"transactions/example-nft/transfer_nft.cdc":                                               transactionsExampleNftTransfer_nftCdc,
+	"transactions/example-token/mint_tokens.cdc":                                              transactionsExampleTokenMint_tokensCdc,
+	"transactions/example-token/setup_account.cdc":                                            transactionsExampleTokenSetup_accountCdc,
+	"transactions/flow-token/transfer_flow.cdc":                                               transactionsFlowTokenTransfer_flowCdc,
+	"transactions/hybrid-custody/sell_item_in_child_from_parent.cdc":                          transactionsHybridCustodySell_item_in_child_from_parentCdc,
+	"transactions/hybrid-custody/setup/dev-setup/setup_nft_filter_and_factory_manager.cdc":    transactionsHybridCustodySetupDevSetupSetup_nft_filter_and_factory_managerCdc,
+	"transactions/hybrid-custody/setup/linking/redeem_account.cdc":                            transactionsHybridCustodySetupLinkingRedeem_accountCdc,
+	"transactions/hybrid-custody/setup/linking/setup_owned_account_and_publish_to_parent.cdc": transactionsHybridCustodySetupLinkingSetup_owned_account_and_publish_to_parentCdc,
+	"transactions/remove_item.cdc":                                                            transactionsRemove_itemCdc,
+	"transactions/remove_items.cdc":                                                           transactionsRemove_itemsCdc,
+	"transactions/sell_item.cdc":                                                              transactionsSell_itemCdc,
+	"transactions/sell_item_and_replace_current_listing.cdc":                                  transactionsSell_item_and_replace_current_listingCdc,
+	"transactions/sell_item_with_marketplace_cut.cdc":                                         transactionsSell_item_with_marketplace_cutCdc,
+	"transactions/setup_account.cdc":                                                          transactionsSetup_accountCdc,
+	"transactions-v1/buy_item.cdc":                                                            transactionsV1Buy_itemCdc,
+	"transactions-v1/cleanup_item.cdc":                                                        transactionsV1Cleanup_itemCdc,
+	"transactions-v1/remove_item.cdc":                                                         transactionsV1Remove_itemCdc,
+	"transactions-v1/scripts-v1/read_listing_details.cdc":                                     transactionsV1ScriptsV1Read_listing_detailsCdc,
+	"transactions-v1/scripts-v1/read_storefront_ids.cdc":                                      transactionsV1ScriptsV1Read_storefront_idsCdc,
+	"transactions-v1/scripts-v1/verify_listed_nft_exists.cdc":                                 transactionsV1ScriptsV1Verify_listed_nft_existsCdc,
+	"transactions-v1/sell_item.cdc":                                                           transactionsV1Sell_itemCdc,
+	"transactions-v1/setup_account.cdc":                                                       transactionsV1Setup_accountCdc,
+	"transactions-v1/utility/mint_example_nft.cdc":                                            transactionsV1UtilityMint_example_nftCdc,
+	"transactions-v1/utility/setup_account_for_example_nft.cdc":                               transactionsV1UtilitySetup_account_for_example_nftCdc,
+}
+
+// AssetDebug is true if the assets were built with the debug flag enabled.
+const AssetDebug = false
+
+// AssetDir returns the file names below a certain
+// directory embedded in the file by go-bindata.
+// For example if you run go-bindata on data/... and data contains the
+// following hierarchy:
+//     data/
+//       foo.txt
+//       img/
+//         a.png
+//         b.png
+// then AssetDir("data") would return []string{"foo.txt", "img"},
+// AssetDir("data/img") would return []string{"a.png", "b.png"},
+// AssetDir("foo.txt") and AssetDir("notexist") would return an error, and
+// AssetDir("") will return []string{"data"}.
+func AssetDir(name string) ([]string, error) {
+	node := _bintree
+	if len(name) != 0 {
+		canonicalName := strings.Replace(name, "\\", "/", -1)
+		pathList := strings.Split(canonicalName, "/")
+		for _, p := range pathList {
+			node = node.Children[p]
+			if node == nil {
+				return nil, fmt.Errorf("Asset %s not found", name)
+			}
+		}
+	}
+	if node.Func != nil {
+		return nil, fmt.Errorf("Asset %s not found", name)
+	}
+	rv := make([]string, 0, len(node.Children))
+	for childName := range node.Children {
+		rv = append(rv, childName)
+	}
+	return rv, nil
+}
+
+type bintree struct {
+	Func     func() (*asset, error)
+	Children map[string]*bintree
+}
+
+var _bintree = &bintree{nil, map[string]*bintree{
+	"scripts": {nil, map[string]*bintree{
+		"check_sale_cut_receivers.cdc": {scriptsCheck_sale_cut_receiversCdc, map[string]*bintree{}},
+		"example-nft": {nil, map[string]*bintree{
+			"get_ids.cdc": {scriptsExampleNftGet_idsCdc, map[string]*bintree{}},
+		}},
+		"example-token": {nil, map[string]*bintree{
+			"get_balance.cdc": {scriptsExampleTokenGet_balanceCdc, map[string]*bintree{}},
+		}},
+		"get_existing_listing_ids.cdc": {scriptsGet_existing_listing_idsCdc, map[string]*bintree{}},
+		"has_listing_become_ghosted.cdc": {scriptsHas_listing_become_ghostedCdc, map[string]*bintree{}},
+		"inspect_listing.cdc": {scriptsInspect_listingCdc, map[string]*bintree{}},
+		"is_ghost_listing.cdc": {scriptsIs_ghost_listingCdc, map[string]*bintree{}},
+		"read_all_unique_ghost_listings.cdc": {scriptsRead_all_unique_ghost_listingsCdc, map[string]*bintree{}},
+		"read_all_unique_ghost_listings_v2.cdc": {scriptsRead_all_unique_ghost_listings_v2Cdc, map[string]*bintree{}},
+		"read_allowed_commission_receivers.cdc": {scriptsRead_allowed_commission_receiversCdc, map[string]*bintree{}},
+		"read_duplicate_listing_ids.cdc": {scriptsRead_duplicate_listing_idsCdc, map[string]*bintree{}},
+		"read_listing_details.cdc": {scriptsRead_listing_detailsCdc, map[string]*bintree{}},
+		"read_listings_details.cdc": {scriptsRead_listings_detailsCdc, map[string]*bintree{}},
+		"read_storefront_ids.cdc": {scriptsRead_storefront_idsCdc, map[string]*bintree{}},
+	}},
+	"transactions": {nil, map[string]*bintree{
+		"buy_item.cdc": {transactionsBuy_itemCdc, map[string]*bintree{}},
+		"cleanup_expired_listings.cdc": {transactionsCleanup_expired_listingsCdc, map[string]*bintree{}},
+		"cleanup_ghost_listing.cdc": {transactionsCleanup_ghost_listingCdc, map[string]*bintree{}},
+		"cleanup_purchased_listings.cdc": {transactionsCleanup_purchased_listingsCdc, map[string]*bintree{}},
+		"example-nft": {nil, map[string]*bintree{
+			"burn_nft.cdc": {transactionsExampleNftBurn_nftCdc, map[string]*bintree{}},
+			"mint_nft.cdc": {transactionsExampleNftMint_nftCdc, map[string]*bintree{}},
+			"setup_account.cdc": {transactionsExampleNftSetup_accountCdc, map[string]*bintree{}},
+			"transfer_nft.cdc": {transactionsExampleNftTransfer_nftCdc, map[string]*bintree{}},
+		}},
+		"example-token": {nil, map[string]*bintree{
+			"mint_tokens.cdc": {transactionsExampleTokenMint_tokensCdc, map[string]*bintree{}},
+			"setup_account.cdc": {transactionsExampleTokenSetup_accountCdc, map[string]*bintree{}},
+		}},
+		"flow-token": {nil, map[string]*bintree{
+			"transfer_flow.cdc": {transactionsFlowTokenTransfer_flowCdc, map[string]*bintree{}},
+		}},
+		"hybrid-custody": {nil, map[string]*bintree{
+			"sell_item_in_child_from_parent.cdc": {transactionsHybridCustodySell_item_in_child_from_parentCdc, map[string]*bintree{}},
+			"setup": {nil, map[string]*bintree{
+				"dev-setup": {nil, map[string]*bintree{
+					"setup_nft_filter_and_factory_manager.cdc": {transactionsHybridCustodySetupDevSetupSetup_nft_filter_and_factory_managerCdc, map[string]*bintree{}},
+				}},
+				"linking": {nil, map[string]*bintree{
+					"redeem_account.cdc": {transactionsHybridCustodySetupLinkingRedeem_accountCdc, map[string]*bintree{}},
+					"setup_owned_account_and_publish_to_parent.cdc": {transactionsHybridCustodySetupLinkingSetup_owned_account_and_publish_to_parentCdc, map[string]*bintree{}},
+				}},
+			}},
+		}},
+		"remove_item.cdc": {transactionsRemove_itemCdc, map[string]*bintree{}},
+		"remove_items.cdc": {transactionsRemove_itemsCdc, map[string]*bintree{}},
+		"sell_item.cdc": {transactionsSell_itemCdc, map[string]*bintree{}},
+		"sell_item_and_replace_current_listing.cdc": {transactionsSell_item_and_replace_current_listingCdc, map[string]*bintree{}},
+		"sell_item_with_marketplace_cut.cdc": {transactionsSell_item_with_marketplace_cutCdc, map[string]*bintree{}},
+		"setup_account.cdc": {transactionsSetup_accountCdc, map[string]*bintree{}},
+	}},
+	"transactions-v1": {nil, map[string]*bintree{
+		"buy_item.cdc": {transactionsV1Buy_itemCdc, map[string]*bintree{}},
+		"cleanup_item.cdc": {transactionsV1Cleanup_itemCdc, map[string]*bintree{}},
+		"remove_item.cdc": {transactionsV1Remove_itemCdc, map[string]*bintree{}},
+		"scripts-v1": {nil, map[string]*bintree{
+			"read_listing_details.cdc": {transactionsV1ScriptsV1Read_listing_detailsCdc, map[string]*bintree{}},
+			"read_storefront_ids.cdc": {transactionsV1ScriptsV1Read_storefront_idsCdc, map[string]*bintree{}},
+			"verify_listed_nft_exists.cdc": {transactionsV1ScriptsV1Verify_listed_nft_existsCdc, map[string]*bintree{}},
+		}},
+		"sell_item.cdc": {transactionsV1Sell_itemCdc, map[string]*bintree{}},
+		"setup_account.cdc": {transactionsV1Setup_accountCdc, map[string]*bintree{}},
+		"utility": {nil, map[string]*bintree{
+			"mint_example_nft.cdc": {transactionsV1UtilityMint_example_nftCdc, map[string]*bintree{}},
+			"setup_account_for_example_nft.cdc": {transactionsV1UtilitySetup_account_for_example_nftCdc, map[string]*bintree{}},
+		}},
+	}},
+}}
+
+// RestoreAsset restores an asset under the given directory.
+func RestoreAsset(dir, name string) error {
+	data, err := Asset(name)
+	if err != nil {
+		return err
+	}
+	info, err := AssetInfo(name)
+	if err != nil {
+		return err
+	}
+	err = os.MkdirAll(_filePath(dir, filepath.Dir(name)), os.FileMode(0755))
+	if err != nil {
+		return err
+	}
+	err = ioutil.WriteFile(_filePath(dir, name), data, info.Mode())
+	if err != nil {
+		return err
+	}
+	return os.Chtimes(_filePath(dir, name), info.ModTime(), info.ModTime())
+}
+
+// RestoreAssets restores an asset under the given directory recursively.
+func RestoreAssets(dir, name string) error {
+	children, err := AssetDir(name)
+	// File
+	if err != nil {
+		return RestoreAsset(dir, name)
+	}
+	// Dir
+	for _, child := range children {
+		err = RestoreAssets(dir, filepath.Join(name, child))
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func _filePath(dir, name string) string {
+	canonicalName := strings.Replace(name, "\\", "/", -1)
+	return filepath.Join(append([]string{dir}, strings.Split(canonicalName, "/")...)...)
+}
@@ -0,0 +1,91 @@
+// Package testfixtures holds the accounts, types, listings and fake script
+// executor shared by the tests of the storefront packages.
+package testfixtures
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+)
+
+var (
+	// StorefrontContract is the emulator address of NFTStorefrontV2 and of
+	// the contracts declaring ExampleNFT, FlowToken and ExampleToken.
+	StorefrontContract = cadence.BytesToAddress([]byte{0xf8, 0xd6, 0xe0, 0x58, 0x6b, 0x0a, 0x20, 0xc7})
+	Seller             = cadence.BytesToAddress([]byte{0x01})
+	Creator            = cadence.BytesToAddress([]byte{0x02})
+	Marketplace        = cadence.BytesToAddress([]byte{0x03})
+
+	ExampleNFT   = ResourceType("ExampleNFT", "ExampleNFT.NFT")
+	FlowToken    = ResourceType("FlowToken", "FlowToken.Vault")
+	ExampleToken = ResourceType("ExampleToken", "ExampleToken.Vault")
+)
+
+// ResourceType returns a resource type declared by a contract deployed to
+// StorefrontContract.
+func ResourceType(location, qualifiedIdentifier string) cadence.Type {
+	return cadence.NewResourceType(cadenceconv.Location(StorefrontContract, location), qualifiedIdentifier, nil, nil)
+}
+
+// UFix64 parses a UFix64 literal such as 10.0.
+func UFix64(t testing.TB, literal string) cadence.UFix64 {
+	t.Helper()
+
+	value, err := cadence.NewUFix64(literal)
+	require.NoError(t, err)
+	return value
+}
+
+// Fix64 parses a Fix64 literal such as -12.5.
+func Fix64(t testing.TB, literal string) cadence.Fix64 {
+	t.Helper()
+
+	value, err := cadence.NewFix64(literal)
+	require.NoError(t, err)
+	return value
+}
+
+// Listing returns listing id+100 of ExampleNFT id in FlowToken at the given
+// price, paying the commission to Marketplace and the rest to Seller, with
+// receivers that can all be borrowed.
+func Listing(t testing.TB, id uint64, price, commission string) receivers.ListingReceivers {
+	t.Helper()
+
+	salePrice := UFix64(t, price)
+	commissionAmount := UFix64(t, commission)
+	return receivers.ListingReceivers{
+		ListingResourceID: id + 100,
+		Details: nftstorefrontv2.ListingDetails{
+			NFTType:              ExampleNFT,
+			NFTID:                id,
+			SalePaymentVaultType: FlowToken,
+			SalePrice:            salePrice,
+			SaleCuts: []nftstorefrontv2.SaleCut{
+				{Receiver: cadence.NewCapability(1, Seller, nil), Amount: salePrice - commissionAmount},
+			},
+			CommissionAmount: commissionAmount,
+		},
+		SaleCutReceivers:         []bool{true},
+		CommissionReceivers:      []cadence.Address{Marketplace},
+		CommissionReceiversValid: []bool{true},
+	}
+}
+
+// Executor is a flowclient.ScriptExecutor recording the arguments of the
+// scripts it executes and answering with Result.
+type Executor struct {
+	Result func(code []byte, arguments []cadence.Value) (cadence.Value, error)
+	// Calls are the arguments of each execution.
+	Calls [][]cadence.Value
+}
+
+func (e *Executor) ExecuteScriptAtLatestBlock(_ context.Context, code []byte, arguments []cadence.Value) (cadence.Value, error) {
+	e.Calls = append(e.Calls, arguments)
+	return e.Result(code, arguments)
+}
@@ -0,0 +1,122 @@
+package lint
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+)
+
+// operations maps the account operations to the entitlement they require.
+// The members of the removed account types are included so that the
+// suggested replacement of AuthAccount has the right entitlements.
+var operations = map[string]string{
+	"storage.borrow": "BorrowValue",
+	"storage.save":   "SaveValue",
+	"storage.load":   "LoadValue",
+	"storage.copy":   "CopyValue",
+
+	"capabilities.storage.issue":             "IssueStorageCapabilityController",
+	"capabilities.storage.getController":     "GetStorageCapabilityController",
+	"capabilities.storage.getControllers":    "GetStorageCapabilityController",
+	"capabilities.storage.forEachController": "GetStorageCapabilityController",
+	"capabilities.account.issue":             "IssueAccountCapabilityController",
+	"capabilities.account.getController":     "GetAccountCapabilityController",
+	"capabilities.account.getControllers":    "GetAccountCapabilityController",
+	"capabilities.account.forEachController": "GetAccountCapabilityController",
+	"capabilities.publish":                   "PublishCapability",
+	"capabilities.unpublish":                 "UnpublishCapability",
+
+	"inbox.publish":   "PublishInboxCapability",
+	"inbox.unpublish": "UnpublishInboxCapability",
+	"inbox.claim":     "ClaimInboxCapability",
+
+	"contracts.add":    "AddContract",
+	"contracts.update": "UpdateContract",
+	"contracts.remove": "RemoveContract",
+
+	"keys.add":    "AddKey",
+	"keys.revoke": "RevokeKey",
+
+	"borrow": "BorrowValue",
+	"save":   "SaveValue",
+	"load":   "LoadValue",
+	"copy":   "CopyValue",
+	"link":   "IssueStorageCapabilityController,PublishCapability",
+	"unlink": "UnpublishCapability",
+}
+
+// groups are the entitlements that imply others.
+var groups = map[string][]string{
+	"Storage":             {"SaveValue", "LoadValue", "CopyValue", "BorrowValue"},
+	"Contracts":           {"AddContract", "UpdateContract", "RemoveContract"},
+	"Keys":                {"AddKey", "RevokeKey"},
+	"Inbox":               {"PublishInboxCapability", "UnpublishInboxCapability", "ClaimInboxCapability"},
+	"Capabilities":        {"StorageCapabilities", "AccountCapabilities", "PublishCapability", "UnpublishCapability"},
+	"StorageCapabilities": {"GetStorageCapabilityController", "IssueStorageCapabilityController"},
+	"AccountCapabilities": {"GetAccountCapabilityController", "IssueAccountCapabilityController"},
+}
+
+var accountOperation = regexp.MustCompile(`^\.((?:storage|capabilities\.storage|capabilities\.account|capabilities|inbox|contracts|keys)\.\w+|borrow|save|load|copy|link|unlink)\b`)
+
+// requiredEntitlements returns the entitlements needed by the operations
+// performed on the named account, sorted.
+func requiredEntitlements(lines []string, name string) []string {
+	receiver := regexp.MustCompile(`\b` + regexp.QuoteMeta(name) + `\b`)
+
+	seen := make(map[string]bool)
+	var required []string
+	for _, line := range lines {
+		for _, loc := range receiver.FindAllStringIndex(line, -1) {
+			match := accountOperation.FindStringSubmatch(line[loc[1]:])
+			if match == nil {
+				continue
+			}
+			entitlements, ok := operations[match[1]]
+			if !ok {
+				continue
+			}
+			for _, e := range strings.Split(entitlements, ",") {
+				if !seen[e] {
+					seen[e] = true
+					required = append(required, e)
+				}
+			}
+		}
+	}
+	sort.Strings(required)
+	return required
+}
+
+// missingEntitlements returns the required entitlements that are neither
+// granted nor implied by a granted entitlement.
+func missingEntitlements(granted, required []string) []string {
+	implied := make(map[string]bool)
+	var imply func(string)
+	imply = func(e string) {
+		if implied[e] {
+			return
+		}
+		implied[e] = true
+		for _, member := range groups[e] {
+			imply(member)
+		}
+	}
+	for _, e := range granted {
+		imply(e)
+	}
+
+	var missing []string
+	for _, e := range required {
+		if !implied[e] {
+			missing = append(missing, e)
+		}
+	}
+	return missing
+}
+
+func authorization(entitlements []string) string {
+	if len(entitlements) == 0 {
+		return "&Account"
+	}
+	return "auth(" + strings.Join(entitlements, ", ") + ") &Account"
+}
@@ -0,0 +1,255 @@
+// Package lint finds syntax in Cadence files that was removed or changed in
+// Cadence 1.0, and account references that lack the entitlements the code
+// needs.
+//
+// The checks work on the source text rather than on the syntax tree, since
+// files written for earlier Cadence versions do not parse.
+package lint
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/assets"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+)
+
+// Rule identifies a check.
+type Rule string
+
+const (
+	RuleAuthAccount        Rule = "auth-account"
+	RulePublicAccount      Rule = "public-account"
+	RulePub                Rule = "pub"
+	RulePriv               Rule = "priv"
+	RuleAccountStorage     Rule = "account-storage"
+	RuleAccountCapability  Rule = "account-capability"
+	RuleRestrictedType     Rule = "restricted-type"
+	RuleDestructor         Rule = "destructor"
+	RuleMissingEntitlement Rule = "missing-entitlement"
+)
+
+// Finding is a single problem found in a file.
+type Finding struct {
+	File       string `json:"file"`
+	Line       int    `json:"line"`
+	Rule       Rule   `json:"rule"`
+	Message    string `json:"message"`
+	Suggestion string `json:"suggestion"`
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s:%d: %s: %s; use %s", f.File, f.Line, f.Rule, f.Message, f.Suggestion)
+}
+
+// Embedded lints the embedded contracts, scripts and transactions.
+func Embedded() []Finding {
+	var findings []Finding
+	for _, name := range assets.AssetNames() {
+		findings = append(findings, File("contracts/"+name, assets.MustAsset(name))...)
+	}
+	for _, name := range templates.AssetNames() {
+		findings = append(findings, File(name, templates.MustAsset(name))...)
+	}
+	return findings
+}
+
+type pattern struct {
+	rule       Rule
+	expression *regexp.Regexp
+	message    string
+	suggest    func(match []string) string
+}
+
+var patterns = []pattern{
+	{
+		rule:       RuleAuthAccount,
+		expression: regexp.MustCompile(`\bAuthAccount\b`),
+		message:    "AuthAccount was removed",
+		// The suggestion is replaced with the entitlements the account needs
+		// in File.
+		suggest: func([]string) string { return "auth(...) &Account" },
+	},
+	{
+		rule:       RulePublicAccount,
+		expression: regexp.MustCompile(`\bPublicAccount\b`),
+		message:    "PublicAccount was removed",
+		suggest:    func([]string) string { return "&Account" },
+	},
+	{
+		rule:       RulePub,
+		expression: regexp.MustCompile(`\bpub(\(set\))?\s+(?:fun|let|var|resource|struct|contract|event|enum|attachment|init)\b`),
+		message:    "pub was removed",
+		suggest:    func([]string) string { return "access(all)" },
+	},
+	{
+		rule:       RulePriv,
+		expression: regexp.MustCompile(`\bpriv\s+(?:fun|let|var|resource|struct|event|enum|init)\b`),
+		message:    "priv was removed",
+		suggest:    func([]string) string { return "access(self)" },
+	},
+	{
+		rule:       RuleRestrictedType,
+		expression: regexp.MustCompile(`&\s*([A-Za-z_][\w.]*)\s*\{([^{}]*)\}`),
+		message:    "restricted types were removed",
+		suggest: func(match []string) string {
+			return "&" + match[1] + " or &{" + strings.TrimSpace(match[2]) + "}"
+		},
+	},
+	{
+		rule:       RuleDestructor,
+		expression: regexp.MustCompile(`\bdestroy\s*\(\s*\)\s*\{`),
+		message:    "custom destructors were removed",
+		suggest:    func([]string) string { return "a ResourceDestroyed event" },
+	},
+}
+
+// accountMembers are the members of the removed account types that moved to
+// the storage and capabilities members of Account.
+var accountMembers = map[string]struct {
+	rule        Rule
+	replacement string
+}{
+	"borrow":        {RuleAccountStorage, "storage.borrow"},
+	"save":          {RuleAccountStorage, "storage.save"},
+	"load":          {RuleAccountStorage, "storage.load"},
+	"copy":          {RuleAccountStorage, "storage.copy"},
+	"type":          {RuleAccountStorage, "storage.type"},
+	"link":          {RuleAccountCapability, "capabilities.storage.issue and capabilities.publish"},
+	"unlink":        {RuleAccountCapability, "capabilities.unpublish"},
+	"getCapability": {RuleAccountCapability, "capabilities.get"},
+	"getLinkTarget": {RuleAccountCapability, "capabilities.storage.getControllers"},
+}
+
+var (
+	accountParameter = regexp.MustCompile(`\b(\w+)\s*:\s*(?:(AuthAccount)\b|(?:auth\s*\(([^)]*)\)\s*)?&\s*Account\b)`)
+	accountMember    = regexp.MustCompile(`\b(\w+|getAccount\([^()]*\))\.(\w+)\b`)
+)
+
+// File lints a single file.
+func File(name string, code []byte) []Finding {
+	lines := strings.Split(mask(string(code)), "\n")
+
+	var findings []Finding
+	add := func(line int, rule Rule, message, suggestion string) {
+		findings = append(findings, Finding{
+			File:       name,
+			Line:       line + 1,
+			Rule:       rule,
+			Message:    message,
+			Suggestion: suggestion,
+		})
+	}
+
+	accounts := accountParameters(lines)
+
+	for i, line := range lines {
+		for _, p := range patterns {
+			for _, match := range p.expression.FindAllStringSubmatch(line, -1) {
+				add(i, p.rule, p.message, p.suggest(match))
+			}
+		}
+
+		for _, match := range accountMember.FindAllStringSubmatch(line, -1) {
+			receiver, member := match[1], match[2]
+			if _, ok := accounts[receiver]; !ok && !strings.HasPrefix(receiver, "getAccount(") {
+				continue
+			}
+			if m, ok := accountMembers[member]; ok {
+				add(i, m.rule, fmt.Sprintf("%s.%s was removed", receiver, member), receiver+"."+m.replacement)
+			}
+		}
+	}
+
+	// Replace the placeholder suggestion of AuthAccount and report missing
+	// entitlements of references to Account.
+	for _, account := range accounts {
+		required := requiredEntitlements(lines, account.name)
+		switch {
+		case account.legacy:
+			for j := range findings {
+				if findings[j].Rule == RuleAuthAccount && findings[j].Line == account.line+1 {
+					findings[j].Suggestion = authorization(required)
+				}
+			}
+		default:
+			missing := missingEntitlements(account.entitlements, required)
+			if len(missing) > 0 {
+				add(account.line, RuleMissingEntitlement,
+					fmt.Sprintf("%s is used with %s but not authorized for it", account.name, strings.Join(missing, ", ")),
+					authorization(append(account.entitlements, missing...)))
+			}
+		}
+	}
+
+	sort.SliceStable(findings, func(i, j int) bool {
+		return findings[i].Line < findings[j].Line
+	})
+	return findings
+}
+
+type account struct {
+	name         string
+	line         int
+	legacy       bool
+	entitlements []string
+}
+
+func accountParameters(lines []string) map[string]account {
+	accounts := make(map[string]account)
+	for i, line := range lines {
+		for _, match := range accountParameter.FindAllStringSubmatch(line, -1) {
+			a := account{name: match[1], line: i, legacy: match[2] != ""}
+			for _, e := range strings.Split(match[3], ",") {
+				if e = strings.TrimSpace(e); e != "" {
+					a.entitlements = append(a.entitlements, e)
+				}
+			}
+			accounts[a.name] = a
+		}
+	}
+	return accounts
+}
+
+// mask replaces comments and the contents of string literals with spaces,
+// keeping line breaks, so that the checks only see code.
+func mask(code string) string {
+	b := []byte(code)
+	for i := 0; i < len(b); i++ {
+		switch {
+		case b[i] == '/' && i+1 < len(b) && b[i+1] == '/':
+			for ; i < len(b) && b[i] != '\n'; i++ {
+				b[i] = ' '
+			}
+		case b[i] == '/' && i+1 < len(b) && b[i+1] == '*':
+			depth := 0
+			for ; i < len(b); i++ {
+				if b[i] == '/' && i+1 < len(b) && b[i+1] == '*' {
+					depth++
+				} else if b[i] == '*' && i+1 < len(b) && b[i+1] == '/' {
+					depth--
+					b[i], b[i+1] = ' ', ' '
+					i++
+					if depth == 0 {
+						break
+					}
+					continue
+				}
+				if b[i] != '\n' {
+					b[i] = ' '
+				}
+			}
+		case b[i] == '"':
+			for i++; i < len(b) && b[i] != '"' && b[i] != '\n'; i++ {
+				if b[i] == '\\' && i+1 < len(b) {
+					b[i] = ' '
+					i++
+				}
+				b[i] = ' '
+			}
+		}
+	}
+	return string(b)
+}
@@ -0,0 +1,109 @@
+package lint_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/lint"
+)
+
+func TestLegacyTransaction(t *testing.T) {
+	code := []byte(`import "NFTStorefrontV2"
+
+transaction(address: Address) {
+    // acct.borrow in a comment is ignored
+    prepare(acct: AuthAccount) {
+        let storefront = acct.borrow<&NFTStorefrontV2.Storefront>(from: NFTStorefrontV2.StorefrontStoragePath)
+        acct.link<&NFTStorefrontV2.Storefront{NFTStorefrontV2.StorefrontPublic}>(/public/storefront, target: /storage/storefront)
+        let cap = getAccount(address).getCapability<&{NFTStorefrontV2.StorefrontPublic}>(/public/storefront)
+        log("pub fun in a string is ignored")
+    }
+}
+`)
+
+	assert.Equal(t, []lint.Finding{
+		{
+			File:       "t.cdc",
+			Line:       5,
+			Rule:       lint.RuleAuthAccount,
+			Message:    "AuthAccount was removed",
+			Suggestion: "auth(BorrowValue, IssueStorageCapabilityController, PublishCapability) &Account",
+		},
+		{
+			File:       "t.cdc",
+			Line:       6,
+			Rule:       lint.RuleAccountStorage,
+			Message:    "acct.borrow was removed",
+			Suggestion: "acct.storage.borrow",
+		},
+		{
+			File:       "t.cdc",
+			Line:       7,
+			Rule:       lint.RuleRestrictedType,
+			Message:    "restricted types were removed",
+			Suggestion: "&NFTStorefrontV2.Storefront or &{NFTStorefrontV2.StorefrontPublic}",
+		},
+		{
+			File:       "t.cdc",
+			Line:       7,
+			Rule:       lint.RuleAccountCapability,
+			Message:    "acct.link was removed",
+			Suggestion: "acct.capabilities.storage.issue and capabilities.publish",
+		},
+		{
+			File:       "t.cdc",
+			Line:       8,
+			Rule:       lint.RuleAccountCapability,
+			Message:    "getAccount(address).getCapability was removed",
+			Suggestion: "getAccount(address).capabilities.get",
+		},
+	}, lint.File("t.cdc", code))
+}
+
+func TestLegacyContract(t *testing.T) {
+	code := []byte(`pub contract C {
+    pub(set) var count: UInt64
+    priv let secret: String
+    pub resource R {
+        destroy() {}
+    }
+    init() {
+        self.count = 0
+        self.secret = ""
+    }
+}
+`)
+
+	var rules []lint.Rule
+	for _, finding := range lint.File("c.cdc", code) {
+		rules = append(rules, finding.Rule)
+	}
+	assert.Equal(t, []lint.Rule{lint.RulePub, lint.RulePub, lint.RulePriv, lint.RulePub, lint.RuleDestructor}, rules)
+}
+
+func TestMissingEntitlements(t *testing.T) {
+	code := []byte(`transaction {
+    prepare(acct: auth(BorrowValue) &Account, other: auth(Storage) &Account) {
+        acct.storage.save(<-create R(), to: /storage/r)
+        let cap = acct.capabilities.storage.issue<&R>(/storage/r)
+        acct.capabilities.publish(cap, at: /public/r)
+        other.storage.load<@R>(from: /storage/r)
+    }
+}
+`)
+
+	assert.Equal(t, []lint.Finding{
+		{
+			File:       "t.cdc",
+			Line:       2,
+			Rule:       lint.RuleMissingEntitlement,
+			Message:    "acct is used with IssueStorageCapabilityController, PublishCapability, SaveValue but not authorized for it",
+			Suggestion: "auth(BorrowValue, IssueStorageCapabilityController, PublishCapability, SaveValue) &Account",
+		},
+	}, lint.File("t.cdc", code))
+}
+
+func TestEmbedded(t *testing.T) {
+	assert.Empty(t, lint.Embedded())
+}
@@ -0,0 +1,126 @@
+// Package listings reads the listings of NFTStorefrontV2 storefronts.
+//
+// A Reader lists the listing resource IDs of a storefront with
+// scripts/read_storefront_ids.cdc, then reads their details in batches with
+// scripts/read_listings_details.cdc, so that storefronts with many listings
+// stay within the computation limit of a script.
+package listings
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+)
+
+const (
+	filenameReadStorefrontIDs   = "scripts/read_storefront_ids.cdc"
+	filenameReadListingsDetails = "scripts/read_listings_details.cdc"
+)
+
+// DefaultBatchSize is the default number of listings read by a script
+// execution.
+const DefaultBatchSize = 100
+
+// Listing is a listing of a storefront.
+type Listing struct {
+	ListingResourceID uint64
+	Details           nftstorefrontv2.ListingDetails
+}
+
+// Expired reports whether the listing is expired at the given time.
+func (l Listing) Expired(now time.Time) bool {
+	return l.Details.Expiry <= uint64(now.Unix())
+}
+
+// Reader reads the listings of storefronts.
+type Reader struct {
+	executor            flowclient.ScriptExecutor
+	readStorefrontIDs   []byte
+	readListingsDetails []byte
+	// BatchSize is the number of listings read by a script execution.
+	BatchSize int
+}
+
+// NewReader returns a reader of the storefronts of the given network,
+// executing scripts with executor.
+func NewReader(config *flowconfig.Config, network string, executor flowclient.ScriptExecutor) (*Reader, error) {
+	readStorefrontIDs, err := config.ResolveImports(templates.MustAsset(filenameReadStorefrontIDs), network)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filenameReadStorefrontIDs, err)
+	}
+	readListingsDetails, err := config.ResolveImports(templates.MustAsset(filenameReadListingsDetails), network)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filenameReadListingsDetails, err)
+	}
+	return &Reader{
+		executor:            executor,
+		readStorefrontIDs:   readStorefrontIDs,
+		readListingsDetails: readListingsDetails,
+		BatchSize:           DefaultBatchSize,
+	}, nil
+}
+
+// ReadListingsDetailsArguments returns the arguments of
+// scripts/read_listings_details.cdc reading the given listings of a
+// storefront.
+func ReadListingsDetailsArguments(storefront cadence.Address, listingResourceIDs []uint64) []cadence.Value {
+	ids := make([]cadence.Value, len(listingResourceIDs))
+	for i, id := range listingResourceIDs {
+		ids[i] = cadence.NewUInt64(id)
+	}
+	return []cadence.Value{
+		storefront,
+		cadence.NewArray(ids).WithType(cadence.NewVariableSizedArrayType(cadence.UInt64Type)),
+	}
+}
+
+// Listings returns the listings of a storefront, in increasing order of
+// listing resource ID.
+func (r *Reader) Listings(ctx context.Context, storefront cadence.Address) ([]Listing, error) {
+	value, err := r.executor.ExecuteScriptAtLatestBlock(ctx, r.readStorefrontIDs, []cadence.Value{storefront})
+	if err != nil {
+		return nil, fmt.Errorf("read listing IDs of %s: %w", storefront, err)
+	}
+	ids, err := cadenceconv.ArrayOf(cadenceconv.UInt64)(value)
+	if err != nil {
+		return nil, fmt.Errorf("read listing IDs of %s: %w", storefront, err)
+	}
+	sort.Slice(ids, func(i, j int) bool { return ids[i] < ids[j] })
+
+	batchSize := r.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	var listings []Listing
+	decode := cadenceconv.DictionaryOf(cadenceconv.UInt64, nftstorefrontv2.DecodeListingDetails)
+	for start := 0; start < len(ids); start += batchSize {
+		batch := ids[start:min(start+batchSize, len(ids))]
+
+		value, err := r.executor.ExecuteScriptAtLatestBlock(ctx, r.readListingsDetails, ReadListingsDetailsArguments(storefront, batch))
+		if err != nil {
+			return nil, fmt.Errorf("read listings of %s: %w", storefront, err)
+		}
+		details, err := decode(value)
+		if err != nil {
+			return nil, fmt.Errorf("read listings of %s: %w", storefront, err)
+		}
+
+		// Listings removed since their IDs were read are left out.
+		for _, id := range batch {
+			if d, ok := details[id]; ok {
+				listings = append(listings, Listing{ListingResourceID: id, Details: d})
+			}
+		}
+	}
+	return listings, nil
+}
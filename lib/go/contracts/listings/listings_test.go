@@ -0,0 +1,93 @@
+package listings_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/listings"
+)
+
+var (
+	seller = testfixtures.Seller
+
+	now = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+)
+
+func listing(id uint64, expiry time.Time) listings.Listing {
+	return listings.Listing{
+		ListingResourceID: id,
+		Details: nftstorefrontv2.ListingDetails{
+			NFTType:              testfixtures.ExampleNFT,
+			NFTID:                id,
+			SalePaymentVaultType: testfixtures.FlowToken,
+			SalePrice:            cadence.UFix64(id * 1_00000000),
+			Expiry:               uint64(expiry.Unix()),
+		},
+	}
+}
+
+// executor returns the listing IDs of the storefront, then the details of
+// the given listings as the scripts would.
+func executor(storefront []listings.Listing) *testfixtures.Executor {
+	return &testfixtures.Executor{Result: func(_ []byte, args []cadence.Value) (cadence.Value, error) {
+		if len(args) == 1 {
+			var ids []cadence.Value
+			for _, l := range storefront {
+				ids = append(ids, cadence.NewUInt64(l.ListingResourceID))
+			}
+			return cadence.NewArray(ids), nil
+		}
+
+		var pairs []cadence.KeyValuePair
+		for _, id := range args[1].(cadence.Array).Values {
+			for _, l := range storefront {
+				if cadence.NewUInt64(l.ListingResourceID) == id {
+					pairs = append(pairs, cadence.KeyValuePair{Key: id, Value: l.Details.Encode(testfixtures.StorefrontContract)})
+				}
+			}
+		}
+		return cadence.NewDictionary(pairs), nil
+	}}
+}
+
+func TestReaderListings(t *testing.T) {
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+
+	var storefront []listings.Listing
+	for _, id := range []uint64{5, 1, 2, 3, 4, 6, 8} {
+		storefront = append(storefront, listing(id, now.Add(time.Hour)))
+	}
+	e := executor(storefront)
+	reader, err := listings.NewReader(config, "emulator", e)
+	require.NoError(t, err)
+	reader.BatchSize = 4
+
+	read, err := reader.Listings(context.Background(), seller)
+	require.NoError(t, err)
+	var ids []uint64
+	for _, l := range read {
+		ids = append(ids, l.ListingResourceID)
+	}
+	assert.Equal(t, []uint64{1, 2, 3, 4, 5, 6, 8}, ids)
+	assert.Equal(t, cadence.UFix64(5_00000000), read[4].Details.SalePrice)
+
+	assert.Equal(t, [][]cadence.Value{
+		{seller},
+		listings.ReadListingsDetailsArguments(seller, []uint64{1, 2, 3, 4}),
+		listings.ReadListingsDetailsArguments(seller, []uint64{5, 6, 8}),
+	}, e.Calls)
+}
+
+func TestExpired(t *testing.T) {
+	assert.False(t, listing(1, now.Add(time.Second)).Expired(now))
+	assert.True(t, listing(1, now).Expired(now))
+}
@@ -0,0 +1,94 @@
+package payouts
+
+import (
+	"encoding/csv"
+	"io"
+	"strconv"
+	"time"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/parquet"
+)
+
+// columns are the columns of the exported files, in order.
+var columns = []parquet.Column{
+	{Name: "time", Kind: parquet.Timestamp},
+	{Name: "block_height", Kind: parquet.Uint64},
+	{Name: "transaction_id", Kind: parquet.String},
+	{Name: "listing_resource_id", Kind: parquet.Uint64},
+	{Name: "storefront_address", Kind: parquet.String},
+	{Name: "nft_type", Kind: parquet.String},
+	{Name: "nft_id", Kind: parquet.Uint64},
+	{Name: "token_type", Kind: parquet.String},
+	{Name: "sale_price", Kind: parquet.Decimal, Scale: 8},
+	{Name: "kind", Kind: parquet.String},
+	{Name: "receiver", Kind: parquet.String},
+	{Name: "amount", Kind: parquet.Decimal, Scale: 8},
+	{Name: "paid", Kind: parquet.Boolean},
+}
+
+func (l Line) values() []interface{} {
+	return []interface{}{
+		l.Time,
+		l.Height,
+		l.TransactionID.String(),
+		l.ListingResourceID,
+		l.StorefrontAddress.String(),
+		l.NFTType,
+		l.NFTID,
+		l.TokenType,
+		uint64(l.SalePrice),
+		string(l.Kind),
+		l.Receiver.String(),
+		uint64(l.Amount),
+		l.Paid,
+	}
+}
+
+// WriteCSV writes the lines as CSV with a header row. Amounts are written as
+// UFix64 literals, such as 10.00000000, and times in RFC 3339 format.
+func WriteCSV(w io.Writer, lines []Line) error {
+	writer := csv.NewWriter(w)
+
+	header := make([]string, len(columns))
+	for i, column := range columns {
+		header[i] = column.Name
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+
+	for _, line := range lines {
+		record := []string{
+			line.Time.UTC().Format(time.RFC3339),
+			strconv.FormatUint(line.Height, 10),
+			line.TransactionID.String(),
+			strconv.FormatUint(line.ListingResourceID, 10),
+			line.StorefrontAddress.String(),
+			line.NFTType,
+			strconv.FormatUint(line.NFTID, 10),
+			line.TokenType,
+			line.SalePrice.String(),
+			string(line.Kind),
+			line.Receiver.String(),
+			line.Amount.String(),
+			strconv.FormatBool(line.Paid),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteParquet writes the lines as a Parquet file with the columns of
+// WriteCSV. Amounts are DECIMAL(20, 8) values and times millisecond
+// timestamps.
+func WriteParquet(w io.Writer, lines []Line) error {
+	rows := make([][]interface{}, len(lines))
+	for i, line := range lines {
+		rows[i] = line.values()
+	}
+	return parquet.Write(w, columns, rows)
+}
@@ -0,0 +1,309 @@
+// Package payouts turns NFTStorefrontV2 purchases into payout lines for
+// reconciliation: one line for the commission, one for each sale cut of the
+// listing, and one for the residual paid to the first receiver when other
+// receivers could not be paid.
+//
+// ListingCompleted events do not carry the sale cuts, so a Collector reads
+// the details of each listing when it sees its ListingAvailable event, or
+// is given them with SetListing. The lines can be written as CSV or Parquet.
+package payouts
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/blocks"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+)
+
+const filenameReadListingDetails = "scripts/read_listing_details.cdc"
+
+// Kind is the kind of a payout line.
+type Kind string
+
+const (
+	// KindSeller is a sale cut paid to the storefront owner.
+	KindSeller Kind = "seller"
+	// KindRoyalty is a sale cut paid to another account, such as the
+	// creator of the NFT.
+	KindRoyalty Kind = "royalty"
+	// KindCommission is the commission paid to the marketplace.
+	KindCommission Kind = "commission"
+	// KindResidual is what is left of the payment once the commission and
+	// the sale cuts are paid, deposited to the first receiver that could be
+	// paid. It is the amount of the unpaid cuts.
+	KindResidual Kind = "residual"
+)
+
+// Line is a payout of a purchase.
+type Line struct {
+	Time              time.Time
+	Height            uint64
+	TransactionID     flowclient.Identifier
+	ListingResourceID uint64
+	StorefrontAddress cadence.Address
+	// NFTType and TokenType are type identifiers.
+	NFTType   string
+	NFTID     uint64
+	TokenType string
+	SalePrice cadence.UFix64
+
+	Kind     Kind
+	Receiver cadence.Address
+	Amount   cadence.UFix64
+	// Paid is false for sale cuts whose receiver could not be borrowed, for
+	// which the contract emitted UnpaidReceiver.
+	Paid bool
+}
+
+type listing struct {
+	storefront cadence.Address
+	details    *nftstorefrontv2.ListingDetails
+}
+
+// Collector collects the payout lines of the events it is fed.
+type Collector struct {
+	availableType string
+	completedType string
+	unpaidType    string
+
+	executor flowclient.ScriptExecutor
+	script   []byte
+
+	listings map[uint64]listing
+	// unpaid are the UnpaidReceiver events of the current transaction not
+	// yet matched with a purchase.
+	unpaid   []nftstorefrontv2.UnpaidReceiver
+	unpaidTx flowclient.Identifier
+
+	blocks.Cursor
+	lines   []Line
+	missing []uint64
+}
+
+var _ flowclient.Consumer = (*Collector)(nil)
+
+// New returns a collector of the purchases of the NFTStorefrontV2 contract
+// of the given network. Unless executor is nil, the collector reads the
+// details of the listings it sees created with it.
+func New(config *flowconfig.Config, network string, executor flowclient.ScriptExecutor) (*Collector, error) {
+	contract, err := config.Address("NFTStorefrontV2", network)
+	if err != nil {
+		return nil, err
+	}
+	script, err := config.ResolveImports(templates.MustAsset(filenameReadListingDetails), network)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filenameReadListingDetails, err)
+	}
+	return NewForContract(contract, executor, script), nil
+}
+
+// NewForContract returns a collector of the purchases of the NFTStorefrontV2
+// contract deployed to the given address, reading listing details with the
+// given script, scripts/read_listing_details.cdc with resolved imports.
+func NewForContract(contract cadence.Address, executor flowclient.ScriptExecutor, readListingDetails []byte) *Collector {
+	return &Collector{
+		availableType: nftstorefrontv2.ListingAvailableEventType(contract),
+		completedType: nftstorefrontv2.ListingCompletedEventType(contract),
+		unpaidType:    nftstorefrontv2.UnpaidReceiverEventType(contract),
+		executor:      executor,
+		script:        readListingDetails,
+		listings:      map[uint64]listing{},
+	}
+}
+
+// EventTypes returns the types of the events the collector needs.
+func (c *Collector) EventTypes() []string {
+	return []string{c.availableType, c.unpaidType, c.completedType}
+}
+
+// SetListing sets the storefront and details of a listing, for example
+// read when it was created.
+func (c *Collector) SetListing(listingResourceID uint64, storefront cadence.Address, details nftstorefrontv2.ListingDetails) {
+	c.listings[listingResourceID] = listing{storefront: storefront, details: &details}
+}
+
+// AddBlock collects the payout lines of a block. Blocks must be added in
+// order of height, with their events in the order they were emitted.
+func (c *Collector) AddBlock(ctx context.Context, block flowclient.BlockEvents) error {
+	if err := blocks.Advance(&c.Cursor, block); err != nil {
+		return err
+	}
+
+	for _, event := range block.Events {
+		var err error
+		switch event.Type {
+		case c.availableType:
+			err = c.addAvailable(ctx, event)
+		case c.unpaidType:
+			err = c.addUnpaid(event)
+		case c.completedType:
+			err = c.addCompleted(block, event)
+		}
+		if err != nil {
+			return fmt.Errorf("block %d: %w", block.Height, err)
+		}
+	}
+	return nil
+}
+
+func (c *Collector) addAvailable(ctx context.Context, event flowclient.Event) error {
+	available, err := nftstorefrontv2.DecodeListingAvailable(event.Value)
+	if err != nil {
+		return err
+	}
+	if _, ok := c.listings[available.ListingResourceID]; ok {
+		return nil
+	}
+
+	l := listing{storefront: available.StorefrontAddress}
+	if c.executor != nil {
+		result, err := c.executor.ExecuteScriptAtLatestBlock(ctx, c.script, []cadence.Value{
+			available.StorefrontAddress,
+			cadence.NewUInt64(available.ListingResourceID),
+		})
+		// The listing may be gone already when reading past events, in
+		// which case its purchase is reported as missing.
+		if err == nil {
+			details, err := nftstorefrontv2.DecodeListingDetails(result)
+			if err != nil {
+				return fmt.Errorf("listing %d: %w", available.ListingResourceID, err)
+			}
+			l.details = &details
+		} else if ctx.Err() != nil {
+			return ctx.Err()
+		}
+	}
+	c.listings[available.ListingResourceID] = l
+	return nil
+}
+
+func (c *Collector) addUnpaid(event flowclient.Event) error {
+	unpaid, err := nftstorefrontv2.DecodeUnpaidReceiver(event.Value)
+	if err != nil {
+		return err
+	}
+	if event.TransactionID != c.unpaidTx {
+		c.unpaid, c.unpaidTx = nil, event.TransactionID
+	}
+	c.unpaid = append(c.unpaid, unpaid)
+	return nil
+}
+
+// takeUnpaid returns whether the transaction emitted UnpaidReceiver for the
+// given sale cut, consuming the event.
+func (c *Collector) takeUnpaid(txID flowclient.Identifier, receiver cadence.Address, amount cadence.UFix64) bool {
+	if txID != c.unpaidTx {
+		return false
+	}
+	for i, unpaid := range c.unpaid {
+		if unpaid.Receiver == receiver && unpaid.EntitledSaleCut == amount {
+			c.unpaid = append(c.unpaid[:i], c.unpaid[i+1:]...)
+			return true
+		}
+	}
+	return false
+}
+
+func (c *Collector) addCompleted(block flowclient.BlockEvents, event flowclient.Event) error {
+	completed, err := nftstorefrontv2.DecodeListingCompleted(event.Value)
+	if err != nil {
+		return err
+	}
+	l, ok := c.listings[completed.ListingResourceID]
+	delete(c.listings, completed.ListingResourceID)
+	if !completed.Purchased {
+		return nil
+	}
+	// The UnpaidReceiver events of a purchase precede its ListingCompleted
+	// event, so those left are not for the next purchase.
+	defer func() { c.unpaid = nil }()
+
+	if !ok || l.details == nil {
+		c.missing = append(c.missing, completed.ListingResourceID)
+		return nil
+	}
+
+	line := Line{
+		Time:              block.BlockTimestamp,
+		Height:            block.Height,
+		TransactionID:     event.TransactionID,
+		ListingResourceID: completed.ListingResourceID,
+		StorefrontAddress: l.storefront,
+		NFTType:           cadenceconv.TypeID(completed.NFTType),
+		NFTID:             completed.NFTID,
+		TokenType:         cadenceconv.TypeID(completed.SalePaymentVaultType),
+		SalePrice:         completed.SalePrice,
+		Paid:              true,
+	}
+
+	remaining := completed.SalePrice
+	pay := func(amount cadence.UFix64) error {
+		if amount > remaining {
+			return fmt.Errorf("listing %d: payouts exceed the sale price %s", completed.ListingResourceID, completed.SalePrice)
+		}
+		remaining -= amount
+		return nil
+	}
+
+	if completed.CommissionReceiver != nil && completed.CommissionAmount > 0 {
+		commission := line
+		commission.Kind = KindCommission
+		commission.Receiver = *completed.CommissionReceiver
+		commission.Amount = completed.CommissionAmount
+		if err := pay(commission.Amount); err != nil {
+			return err
+		}
+		c.lines = append(c.lines, commission)
+	}
+
+	var residualReceiver *cadence.Address
+	for _, cut := range l.details.SaleCuts {
+		payout := line
+		payout.Kind = KindRoyalty
+		if cut.Receiver.Address == l.storefront {
+			payout.Kind = KindSeller
+		}
+		payout.Receiver = cut.Receiver.Address
+		payout.Amount = cut.Amount
+		payout.Paid = !c.takeUnpaid(event.TransactionID, payout.Receiver, payout.Amount)
+		if payout.Paid {
+			if err := pay(payout.Amount); err != nil {
+				return err
+			}
+			if residualReceiver == nil {
+				residualReceiver = &cut.Receiver.Address
+			}
+		}
+		c.lines = append(c.lines, payout)
+	}
+
+	if remaining > 0 && residualReceiver != nil {
+		residual := line
+		residual.Kind = KindResidual
+		residual.Receiver = *residualReceiver
+		residual.Amount = remaining
+		c.lines = append(c.lines, residual)
+	}
+	return nil
+}
+
+// Lines returns the payout lines collected so far, in the order of the
+// purchases.
+func (c *Collector) Lines() []Line {
+	return append([]Line(nil), c.lines...)
+}
+
+// Missing returns the IDs of the purchased listings whose details are
+// unknown, such as those created before the first block added, and so have
+// no payout lines.
+func (c *Collector) Missing() []uint64 {
+	return append([]uint64(nil), c.missing...)
+}
@@ -0,0 +1,297 @@
+package payouts_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"encoding/csv"
+	"fmt"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"github.com/xitongsys/parquet-go-source/buffer"
+	format "github.com/xitongsys/parquet-go/parquet"
+	"github.com/xitongsys/parquet-go/reader"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/payouts"
+)
+
+var (
+	storefrontContract = testfixtures.StorefrontContract
+	ufix64             = testfixtures.UFix64
+	seller             = testfixtures.Seller
+	creator            = testfixtures.Creator
+	marketplace        = testfixtures.Marketplace
+
+	start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	exampleNFT = testfixtures.ExampleNFT
+	flowToken  = testfixtures.FlowToken
+
+	script = []byte("access(all) fun main(storefront: Address, listingResourceID: UInt64) {}")
+)
+
+type listing struct {
+	id    uint64
+	price string
+	cuts  []nftstorefrontv2.SaleCut
+}
+
+func cut(t *testing.T, receiver cadence.Address, amount string) nftstorefrontv2.SaleCut {
+	t.Helper()
+
+	return nftstorefrontv2.SaleCut{
+		Receiver: cadence.NewCapability(1, receiver, &cadence.ReferenceType{Authorization: cadence.UnauthorizedAccess, Type: cadence.AnyResourceType}),
+		Amount:   ufix64(t, amount),
+	}
+}
+
+func (l listing) details(t *testing.T) nftstorefrontv2.ListingDetails {
+	return nftstorefrontv2.ListingDetails{
+		NFTType:              exampleNFT,
+		NFTID:                l.id * 10,
+		SalePaymentVaultType: flowToken,
+		SalePrice:            ufix64(t, l.price),
+		SaleCuts:             l.cuts,
+	}
+}
+
+func available(t *testing.T, l listing) flowclient.Event {
+	t.Helper()
+
+	return flowclient.Event{
+		Type: nftstorefrontv2.ListingAvailableEventType(storefrontContract),
+		Value: nftstorefrontv2.ListingAvailable{
+			StorefrontAddress:    seller,
+			ListingResourceID:    l.id,
+			NFTType:              exampleNFT,
+			NFTID:                l.id * 10,
+			SalePaymentVaultType: flowToken,
+			SalePrice:            ufix64(t, l.price),
+		}.Encode(storefrontContract),
+	}
+}
+
+func completed(t *testing.T, tx byte, l listing, commission string) flowclient.Event {
+	t.Helper()
+
+	event := nftstorefrontv2.ListingCompleted{
+		ListingResourceID:    l.id,
+		Purchased:            true,
+		NFTType:              exampleNFT,
+		NFTID:                l.id * 10,
+		SalePaymentVaultType: flowToken,
+		SalePrice:            ufix64(t, l.price),
+		CommissionAmount:     ufix64(t, commission),
+	}
+	if event.CommissionAmount > 0 {
+		event.CommissionReceiver = &marketplace
+	}
+	return flowclient.Event{
+		Type:          nftstorefrontv2.ListingCompletedEventType(storefrontContract),
+		TransactionID: flowclient.Identifier{tx},
+		Value:         event.Encode(storefrontContract),
+	}
+}
+
+func unpaid(t *testing.T, tx byte, receiver cadence.Address, amount string) flowclient.Event {
+	t.Helper()
+
+	return flowclient.Event{
+		Type:          nftstorefrontv2.UnpaidReceiverEventType(storefrontContract),
+		TransactionID: flowclient.Identifier{tx},
+		Value: nftstorefrontv2.UnpaidReceiver{
+			Receiver:        receiver,
+			EntitledSaleCut: ufix64(t, amount),
+		}.Encode(storefrontContract),
+	}
+}
+
+// recorded returns a fake replaying these blocks:
+//
+//	10 at 0h: listings 1, 2 and 3, the details of 3 cannot be read
+//	11 at 1h: listing 1 purchased for 10.0 with a commission of 0.5
+//	12 at 2h: listing 2 purchased for 20.0 without paying the creator,
+//	          listing 3 purchased and listing 4, created before block 10,
+//	          purchased
+func recorded(t *testing.T) *flowclient.Fake {
+	t.Helper()
+
+	l1 := listing{1, "10.0", []nftstorefrontv2.SaleCut{cut(t, seller, "8.5"), cut(t, creator, "1.0")}}
+	l2 := listing{2, "20.0", []nftstorefrontv2.SaleCut{cut(t, seller, "18.0"), cut(t, creator, "2.0")}}
+	l3 := listing{3, "5.0", nil}
+	l4 := listing{4, "3.0", []nftstorefrontv2.SaleCut{cut(t, seller, "3.0")}}
+
+	var fixtures flowclient.Fixtures
+	for _, block := range []flowclient.BlockEvents{
+		{
+			Height:         10,
+			BlockTimestamp: start,
+			Events:         []flowclient.Event{available(t, l1), available(t, l2), available(t, l3)},
+		},
+		{
+			Height:         11,
+			BlockTimestamp: start.Add(time.Hour),
+			Events:         []flowclient.Event{completed(t, 1, l1, "0.5")},
+		},
+		{
+			Height:         12,
+			BlockTimestamp: start.Add(2 * time.Hour),
+			Events: []flowclient.Event{
+				unpaid(t, 2, creator, "2.0"),
+				completed(t, 2, l2, "0.0"),
+				completed(t, 3, l3, "0.0"),
+				completed(t, 4, l4, "0.0"),
+			},
+		},
+	} {
+		for i := range block.Events {
+			block.Events[i].EventIndex = i
+		}
+		require.NoError(t, fixtures.AddBlock(block))
+	}
+
+	for _, l := range []listing{l1, l2} {
+		arguments := []cadence.Value{seller, cadence.NewUInt64(l.id)}
+		require.NoError(t, fixtures.AddScript(script, arguments, l.details(t).Encode(storefrontContract), nil))
+	}
+	arguments := []cadence.Value{seller, cadence.NewUInt64(3)}
+	require.NoError(t, fixtures.AddScript(script, arguments, nil, fmt.Errorf("listing 3 not found")))
+
+	fake, err := flowclient.NewFake(&fixtures)
+	require.NoError(t, err)
+	return fake
+}
+
+func collect(t *testing.T) *payouts.Collector {
+	t.Helper()
+
+	fake := recorded(t)
+	collector := payouts.NewForContract(storefrontContract, fake, script)
+	collector.SetListing(4, seller, listing{4, "3.0", []nftstorefrontv2.SaleCut{cut(t, seller, "3.0")}}.details(t))
+	require.NoError(t, flowclient.Feed(context.Background(), fake, collector, 1, 12))
+	return collector
+}
+
+type payout struct {
+	listing  uint64
+	kind     payouts.Kind
+	receiver cadence.Address
+	amount   string
+	paid     bool
+}
+
+func TestCollector(t *testing.T) {
+	collector := collect(t)
+
+	var got []payout
+	for _, line := range collector.Lines() {
+		got = append(got, payout{line.ListingResourceID, line.Kind, line.Receiver, line.Amount.String(), line.Paid})
+	}
+	assert.Equal(t, []payout{
+		{1, payouts.KindCommission, marketplace, "0.50000000", true},
+		{1, payouts.KindSeller, seller, "8.50000000", true},
+		{1, payouts.KindRoyalty, creator, "1.00000000", true},
+		// The creator could not be paid, so their cut went to the seller.
+		{2, payouts.KindSeller, seller, "18.00000000", true},
+		{2, payouts.KindRoyalty, creator, "2.00000000", false},
+		{2, payouts.KindResidual, seller, "2.00000000", true},
+		{4, payouts.KindSeller, seller, "3.00000000", true},
+	}, got)
+	assert.Equal(t, []uint64{3}, collector.Missing())
+
+	line := collector.Lines()[0]
+	assert.Equal(t, start.Add(time.Hour), line.Time.UTC())
+	assert.Equal(t, uint64(11), line.Height)
+	assert.Equal(t, flowclient.Identifier{1}, line.TransactionID)
+	assert.Equal(t, seller, line.StorefrontAddress)
+	assert.Equal(t, "A.f8d6e0586b0a20c7.ExampleNFT.NFT", line.NFTType)
+	assert.Equal(t, uint64(10), line.NFTID)
+	assert.Equal(t, "A.f8d6e0586b0a20c7.FlowToken.Vault", line.TokenType)
+	assert.Equal(t, ufix64(t, "10.0"), line.SalePrice)
+}
+
+func TestAddBlockErrors(t *testing.T) {
+	collector := payouts.NewForContract(storefrontContract, nil, nil)
+	l := listing{1, "1.0", []nftstorefrontv2.SaleCut{cut(t, seller, "2.0")}}
+	collector.SetListing(1, seller, l.details(t))
+
+	err := collector.AddBlock(context.Background(), flowclient.BlockEvents{Height: 2, Events: []flowclient.Event{completed(t, 1, l, "0.0")}})
+	assert.EqualError(t, err, "block 2: listing 1: payouts exceed the sale price 1.00000000")
+
+	require.NoError(t, collector.AddBlock(context.Background(), flowclient.BlockEvents{Height: 3}))
+	err = collector.AddBlock(context.Background(), flowclient.BlockEvents{Height: 1})
+	assert.EqualError(t, err, "block 1 added after block 3")
+}
+
+func TestWriteCSV(t *testing.T) {
+	var buf bytes.Buffer
+	require.NoError(t, payouts.WriteCSV(&buf, collect(t).Lines()))
+
+	records, err := csv.NewReader(&buf).ReadAll()
+	require.NoError(t, err)
+	require.Len(t, records, 8)
+	assert.Equal(t, []string{
+		"time", "block_height", "transaction_id", "listing_resource_id", "storefront_address", "nft_type", "nft_id",
+		"token_type", "sale_price", "kind", "receiver", "amount", "paid",
+	}, records[0])
+	assert.Equal(t, []string{
+		"2024-01-01T02:00:00Z", "12", flowclient.Identifier{2}.String(), "2", "0x0000000000000001",
+		"A.f8d6e0586b0a20c7.ExampleNFT.NFT", "20", "A.f8d6e0586b0a20c7.FlowToken.Vault", "20.00000000",
+		"royalty", "0x0000000000000002", "2.00000000", "false",
+	}, records[5])
+}
+
+// readParquet reads a file written by payouts.WriteParquet with
+// parquet-go.
+func readParquet(t *testing.T, lines []payouts.Line) *reader.ParquetReader {
+	t.Helper()
+
+	var buf bytes.Buffer
+	require.NoError(t, payouts.WriteParquet(&buf, lines))
+
+	f, err := buffer.NewBufferFile(buf.Bytes())
+	require.NoError(t, err)
+	r, err := reader.NewParquetColumnReader(f, 1)
+	require.NoError(t, err)
+	return r
+}
+
+func TestWriteParquet(t *testing.T) {
+	lines := collect(t).Lines()
+	r := readParquet(t, lines)
+
+	assert.EqualValues(t, len(lines), r.GetNumRows())
+	schema := r.Footer.Schema
+	require.Len(t, schema, 14)
+
+	// The amount column is DECIMAL(20, 8) of 9 bytes. The reader
+	// capitalizes the names of the file.
+	amount := schema[12]
+	assert.Equal(t, "amount", r.SchemaHandler.GetExName(12))
+	assert.Equal(t, format.Type_FIXED_LEN_BYTE_ARRAY, amount.GetType())
+	assert.EqualValues(t, 9, amount.GetTypeLength())
+	assert.EqualValues(t, 8, amount.GetScale())
+
+	values, _, _, err := r.ReadColumnByIndex(11, int64(len(lines)))
+	require.NoError(t, err)
+	require.Len(t, values, len(lines))
+	for i, line := range lines {
+		value := []byte(values[i].(string))
+		require.Len(t, value, 9)
+		assert.Equal(t, byte(0), value[0])
+		assert.Equal(t, uint64(line.Amount), binary.BigEndian.Uint64(value[1:]))
+	}
+}
+
+func TestWriteParquetEmpty(t *testing.T) {
+	r := readParquet(t, nil)
+	assert.EqualValues(t, 0, r.GetNumRows())
+	assert.Len(t, r.Footer.Schema, 14)
+}
@@ -0,0 +1,327 @@
+// Package receivers checks that the sale cut receivers and allowed
+// commission receivers of open NFTStorefrontV2 listings can still be
+// borrowed.
+//
+// Receiver capabilities are checked when a listing is created, but they can
+// be unlinked afterwards. A purchase then emits UnpaidReceiver for the sale
+// cuts it cannot pay, or aborts when no sale cut or no allowed commission
+// receiver can be paid. A Checker finds these listings so that their sellers
+// can relist them with transactions/sell_item_and_replace_current_listing.cdc
+// before a buyer turns up.
+package receivers
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+)
+
+const (
+	filenameReadStorefrontIDs = "scripts/read_storefront_ids.cdc"
+	filenameCheckReceivers    = "scripts/check_sale_cut_receivers.cdc"
+	filenameRelist            = "transactions/sell_item_and_replace_current_listing.cdc"
+)
+
+// listingReceiversQualifiedIdentifier is the qualified identifier of the
+// struct returned by the script. It is declared by the script, so its
+// location is the script's.
+const listingReceiversQualifiedIdentifier = "ListingReceivers"
+
+// DefaultBatchSize is the default number of listings checked by a script
+// execution.
+const DefaultBatchSize = 100
+
+// CheckReceiversScript returns the script checking the receivers of
+// listings, with its imports resolved for the given network.
+func CheckReceiversScript(config *flowconfig.Config, network string) ([]byte, error) {
+	return config.ResolveImports(templates.MustAsset(filenameCheckReceivers), network)
+}
+
+// CheckReceiversArguments returns the arguments of the script checking the
+// receivers of the given listings of a storefront.
+func CheckReceiversArguments(storefront cadence.Address, listingResourceIDs []uint64) []cadence.Value {
+	ids := make([]cadence.Value, len(listingResourceIDs))
+	for i, id := range listingResourceIDs {
+		ids[i] = cadence.NewUInt64(id)
+	}
+	return []cadence.Value{
+		storefront,
+		cadence.NewArray(ids).WithType(cadence.NewVariableSizedArrayType(cadence.UInt64Type)),
+	}
+}
+
+// ListingReceivers is whether the receivers of an open listing can be
+// borrowed.
+type ListingReceivers struct {
+	ListingResourceID uint64
+	Details           nftstorefrontv2.ListingDetails
+	// SaleCutReceivers is whether the receiver of each sale cut can be
+	// borrowed, in the order of Details.SaleCuts.
+	SaleCutReceivers []bool
+	// CommissionReceivers are the addresses of the capabilities allowed to
+	// receive the commission, or nil if any recipient is allowed, and
+	// CommissionReceiversValid whether each can be borrowed.
+	CommissionReceivers      []cadence.Address
+	CommissionReceiversValid []bool
+}
+
+// DecodeListingReceivers decodes an element of the result of the script
+// checking the receivers of listings.
+func DecodeListingReceivers(value cadence.Value) (ListingReceivers, error) {
+	var result ListingReceivers
+
+	fields, err := cadenceconv.Fields(value, listingReceiversQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	result.ListingResourceID, err = cadenceconv.Field(fields, "listingResourceID", cadenceconv.UInt64)
+	if err != nil {
+		return result, fmt.Errorf("%s: %w", listingReceiversQualifiedIdentifier, err)
+	}
+
+	result.Details, err = cadenceconv.Field(fields, "details", nftstorefrontv2.DecodeListingDetails)
+	if err != nil {
+		return result, fmt.Errorf("%s: %w", listingReceiversQualifiedIdentifier, err)
+	}
+
+	result.SaleCutReceivers, err = cadenceconv.Field(fields, "saleCutReceivers", cadenceconv.ArrayOf(cadenceconv.Bool))
+	if err != nil {
+		return result, fmt.Errorf("%s: %w", listingReceiversQualifiedIdentifier, err)
+	}
+
+	commissionReceivers, err := cadenceconv.Field(fields, "commissionReceivers", cadenceconv.OptionalOf(cadenceconv.ArrayOf(cadenceconv.Address)))
+	if err != nil {
+		return result, fmt.Errorf("%s: %w", listingReceiversQualifiedIdentifier, err)
+	}
+	valid, err := cadenceconv.Field(fields, "commissionReceiversValid", cadenceconv.OptionalOf(cadenceconv.ArrayOf(cadenceconv.Bool)))
+	if err != nil {
+		return result, fmt.Errorf("%s: %w", listingReceiversQualifiedIdentifier, err)
+	}
+	if commissionReceivers != nil {
+		result.CommissionReceivers = append([]cadence.Address{}, *commissionReceivers...)
+	}
+	if valid != nil {
+		result.CommissionReceiversValid = append([]bool{}, *valid...)
+	}
+
+	if len(result.SaleCutReceivers) != len(result.Details.SaleCuts) {
+		return result, fmt.Errorf("%s: %d sale cut checks for %d sale cuts", listingReceiversQualifiedIdentifier, len(result.SaleCutReceivers), len(result.Details.SaleCuts))
+	}
+	if len(result.CommissionReceivers) != len(result.CommissionReceiversValid) {
+		return result, fmt.Errorf("%s: %d commission receiver checks for %d commission receivers", listingReceiversQualifiedIdentifier, len(result.CommissionReceiversValid), len(result.CommissionReceivers))
+	}
+
+	return result, nil
+}
+
+// Problem identifies why a purchase of a listing would not pay out as
+// listed.
+type Problem string
+
+const (
+	// ProblemSaleCutReceiver means that the receiver of a sale cut cannot
+	// be borrowed: a purchase would emit UnpaidReceiver and pay the cut to
+	// the first receiver that can be borrowed.
+	ProblemSaleCutReceiver Problem = "sale-cut-receiver"
+	// ProblemNoSaleCutReceiver means that no sale cut receiver can be
+	// borrowed: purchases abort.
+	ProblemNoSaleCutReceiver Problem = "no-sale-cut-receiver"
+	// ProblemCommissionReceiver means that an allowed commission receiver
+	// cannot be borrowed: purchases facilitated by that marketplace abort.
+	ProblemCommissionReceiver Problem = "commission-receiver"
+	// ProblemNoCommissionReceiver means that no allowed commission receiver
+	// can be borrowed: purchases abort.
+	ProblemNoCommissionReceiver Problem = "no-commission-receiver"
+)
+
+// Blocking reports whether the problem makes every purchase abort.
+func (p Problem) Blocking() bool {
+	return p == ProblemNoSaleCutReceiver || p == ProblemNoCommissionReceiver
+}
+
+// Finding is a problem found with a listing.
+type Finding struct {
+	Problem Problem
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s", f.Problem, f.Message)
+}
+
+// Check returns the problems of the listing.
+func (l ListingReceivers) Check() []Finding {
+	var findings []Finding
+
+	paid := 0
+	for i, cut := range l.Details.SaleCuts {
+		if l.SaleCutReceivers[i] {
+			paid++
+			continue
+		}
+		findings = append(findings, Finding{ProblemSaleCutReceiver, fmt.Sprintf("the receiver of the sale cut of %s to %s cannot be borrowed", cut.Amount, cut.Receiver.Address)})
+	}
+	if paid == 0 && len(l.Details.SaleCuts) > 0 {
+		findings = append(findings, Finding{ProblemNoSaleCutReceiver, "no sale cut receiver can be borrowed"})
+	}
+
+	// The allowed commission receivers are only checked when there is a
+	// commission to pay.
+	if l.CommissionReceivers == nil || l.Details.CommissionAmount == 0 {
+		return findings
+	}
+	valid := 0
+	for i, receiver := range l.CommissionReceivers {
+		if l.CommissionReceiversValid[i] {
+			valid++
+			continue
+		}
+		findings = append(findings, Finding{ProblemCommissionReceiver, fmt.Sprintf("the commission receiver of %s cannot be borrowed", receiver)})
+	}
+	if valid == 0 {
+		findings = append(findings, Finding{ProblemNoCommissionReceiver, "no allowed commission receiver can be borrowed"})
+	}
+	return findings
+}
+
+// RelistArguments returns the arguments of
+// transactions/sell_item_and_replace_current_listing.cdc relisting the NFT
+// with the same price, custom ID, commission, expiry and commission
+// receivers. The transaction replaces the listing and creates sale cuts from
+// the current receivers of the seller and royalties of the NFT.
+func (l ListingReceivers) RelistArguments() ([]cadence.Value, error) {
+	if l.Details.NFTType == nil || l.Details.SalePaymentVaultType == nil {
+		return nil, fmt.Errorf("listing %d has no NFT or payment type", l.ListingResourceID)
+	}
+
+	var customID cadence.Optional
+	if l.Details.CustomID != nil {
+		customID = cadence.NewOptional(cadence.String(*l.Details.CustomID))
+	}
+
+	marketplaces := make([]cadence.Value, 0, len(l.CommissionReceivers))
+	for _, address := range l.CommissionReceivers {
+		marketplaces = append(marketplaces, address)
+	}
+
+	values := []cadence.Value{
+		cadence.NewUInt64(l.Details.NFTID),
+		l.Details.SalePrice,
+		customID,
+		l.Details.CommissionAmount,
+		cadence.NewUInt64(l.Details.Expiry),
+		cadence.NewArray(marketplaces).WithType(cadence.NewVariableSizedArrayType(cadence.AddressType)),
+		cadence.String(l.Details.NFTType.ID()),
+		cadence.String(l.Details.SalePaymentVaultType.ID()),
+	}
+
+	if err := arguments.Check(filenameRelist, values); err != nil {
+		return nil, err
+	}
+	return values, nil
+}
+
+// Report is the result of checking an open listing.
+type Report struct {
+	Storefront cadence.Address
+	Listing    ListingReceivers
+	Findings   []Finding
+}
+
+// Healthy reports whether no problem was found.
+func (r *Report) Healthy() bool {
+	return len(r.Findings) == 0
+}
+
+// Blocked reports whether every purchase of the listing would abort.
+func (r *Report) Blocked() bool {
+	for _, f := range r.Findings {
+		if f.Problem.Blocking() {
+			return true
+		}
+	}
+	return false
+}
+
+// Checker checks the open listings of storefronts.
+type Checker struct {
+	executor          flowclient.ScriptExecutor
+	readStorefrontIDs []byte
+	checkReceivers    []byte
+	// BatchSize is the number of listings checked by a script execution.
+	BatchSize int
+}
+
+// NewChecker returns a checker of the storefronts of the given network,
+// executing scripts with executor.
+func NewChecker(config *flowconfig.Config, network string, executor flowclient.ScriptExecutor) (*Checker, error) {
+	readStorefrontIDs, err := config.ResolveImports(templates.MustAsset(filenameReadStorefrontIDs), network)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filenameReadStorefrontIDs, err)
+	}
+	checkReceivers, err := CheckReceiversScript(config, network)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filenameCheckReceivers, err)
+	}
+	return &Checker{
+		executor:          executor,
+		readStorefrontIDs: readStorefrontIDs,
+		checkReceivers:    checkReceivers,
+		BatchSize:         DefaultBatchSize,
+	}, nil
+}
+
+// CheckStorefront checks the open listings of a storefront, in the order of
+// its listing IDs. Purchased and expired listings are skipped.
+func (c *Checker) CheckStorefront(ctx context.Context, storefront cadence.Address) ([]Report, error) {
+	value, err := c.executor.ExecuteScriptAtLatestBlock(ctx, c.readStorefrontIDs, []cadence.Value{storefront})
+	if err != nil {
+		return nil, fmt.Errorf("read listing IDs of %s: %w", storefront, err)
+	}
+	ids, err := cadenceconv.ArrayOf(cadenceconv.UInt64)(value)
+	if err != nil {
+		return nil, fmt.Errorf("read listing IDs of %s: %w", storefront, err)
+	}
+	return c.CheckListings(ctx, storefront, ids)
+}
+
+// CheckListings checks the given listings of a storefront. Missing,
+// purchased and expired listings are skipped.
+func (c *Checker) CheckListings(ctx context.Context, storefront cadence.Address, listingResourceIDs []uint64) ([]Report, error) {
+	batchSize := c.BatchSize
+	if batchSize <= 0 {
+		batchSize = DefaultBatchSize
+	}
+
+	var reports []Report
+	for start := 0; start < len(listingResourceIDs); start += batchSize {
+		batch := listingResourceIDs[start:min(start+batchSize, len(listingResourceIDs))]
+
+		value, err := c.executor.ExecuteScriptAtLatestBlock(ctx, c.checkReceivers, CheckReceiversArguments(storefront, batch))
+		if err != nil {
+			return nil, fmt.Errorf("check listings of %s: %w", storefront, err)
+		}
+		listings, err := cadenceconv.ArrayOf(DecodeListingReceivers)(value)
+		if err != nil {
+			return nil, fmt.Errorf("check listings of %s: %w", storefront, err)
+		}
+
+		for _, listing := range listings {
+			reports = append(reports, Report{
+				Storefront: storefront,
+				Listing:    listing,
+				Findings:   listing.Check(),
+			})
+		}
+	}
+	return reports, nil
+}
@@ -0,0 +1,253 @@
+package receivers_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+)
+
+var (
+	storefrontContract = testfixtures.StorefrontContract
+	seller             = testfixtures.Seller
+	creator            = testfixtures.Creator
+	marketplace        = testfixtures.Marketplace
+	otherMarketplace   = cadence.BytesToAddress([]byte{0x04})
+)
+
+// healthy returns a listing of 10.0 with a commission of 1.0 paying 8.0 to
+// the seller and 1.0 to the creator, whose receivers can all be borrowed.
+func healthy(id uint64) receivers.ListingReceivers {
+	customID := "dapp"
+	return receivers.ListingReceivers{
+		ListingResourceID: id,
+		Details: nftstorefrontv2.ListingDetails{
+			NFTType:              testfixtures.ExampleNFT,
+			NFTID:                id * 10,
+			SalePaymentVaultType: testfixtures.FlowToken,
+			SalePrice:            cadence.UFix64(10_00000000),
+			SaleCuts: []nftstorefrontv2.SaleCut{
+				{Receiver: cadence.NewCapability(1, seller, nil), Amount: cadence.UFix64(8_00000000)},
+				{Receiver: cadence.NewCapability(2, creator, nil), Amount: cadence.UFix64(1_00000000)},
+			},
+			CustomID:         &customID,
+			CommissionAmount: cadence.UFix64(1_00000000),
+			Expiry:           1_700_000_000,
+		},
+		SaleCutReceivers:         []bool{true, true},
+		CommissionReceivers:      []cadence.Address{marketplace, otherMarketplace},
+		CommissionReceiversValid: []bool{true, true},
+	}
+}
+
+func problems(findings []receivers.Finding) []receivers.Problem {
+	var result []receivers.Problem
+	for _, f := range findings {
+		result = append(result, f.Problem)
+	}
+	return result
+}
+
+func TestCheck(t *testing.T) {
+	for name, test := range map[string]struct {
+		modify   func(*receivers.ListingReceivers)
+		problems []receivers.Problem
+	}{
+		"healthy": {
+			modify: func(*receivers.ListingReceivers) {},
+		},
+		"unlinked royalty receiver": {
+			modify:   func(l *receivers.ListingReceivers) { l.SaleCutReceivers[1] = false },
+			problems: []receivers.Problem{receivers.ProblemSaleCutReceiver},
+		},
+		"no sale cut receiver": {
+			modify: func(l *receivers.ListingReceivers) { l.SaleCutReceivers = []bool{false, false} },
+			problems: []receivers.Problem{
+				receivers.ProblemSaleCutReceiver,
+				receivers.ProblemSaleCutReceiver,
+				receivers.ProblemNoSaleCutReceiver,
+			},
+		},
+		"unlinked commission receiver": {
+			modify:   func(l *receivers.ListingReceivers) { l.CommissionReceiversValid[0] = false },
+			problems: []receivers.Problem{receivers.ProblemCommissionReceiver},
+		},
+		"no commission receiver": {
+			modify: func(l *receivers.ListingReceivers) { l.CommissionReceiversValid = []bool{false, false} },
+			problems: []receivers.Problem{
+				receivers.ProblemCommissionReceiver,
+				receivers.ProblemCommissionReceiver,
+				receivers.ProblemNoCommissionReceiver,
+			},
+		},
+		"no commission": {
+			modify: func(l *receivers.ListingReceivers) {
+				l.Details.CommissionAmount = 0
+				l.CommissionReceiversValid = []bool{false, false}
+			},
+		},
+		"any commission receiver": {
+			modify: func(l *receivers.ListingReceivers) {
+				l.CommissionReceivers, l.CommissionReceiversValid = nil, nil
+			},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			listing := healthy(1)
+			test.modify(&listing)
+			assert.Equal(t, test.problems, problems(listing.Check()))
+		})
+	}
+}
+
+func TestFindingString(t *testing.T) {
+	listing := healthy(1)
+	listing.SaleCutReceivers[1] = false
+	findings := listing.Check()
+	require.Len(t, findings, 1)
+	assert.Equal(t, "sale-cut-receiver: the receiver of the sale cut of 1.00000000 to 0x0000000000000002 cannot be borrowed", findings[0].String())
+}
+
+func TestRelistArguments(t *testing.T) {
+	values, err := healthy(1).RelistArguments()
+	require.NoError(t, err)
+	require.NoError(t, arguments.Check("transactions/sell_item_and_replace_current_listing.cdc", values))
+
+	assert.Equal(t, cadence.NewUInt64(10), values[0])
+	assert.Equal(t, cadence.UFix64(10_00000000), values[1])
+	assert.Equal(t, cadence.NewOptional(cadence.String("dapp")), values[2])
+	assert.Equal(t, cadence.NewUInt64(1_700_000_000), values[4])
+	assert.Equal(t, []cadence.Value{marketplace, otherMarketplace}, values[5].(cadence.Array).Values)
+	assert.Equal(t, cadence.String("A.f8d6e0586b0a20c7.ExampleNFT.NFT"), values[6])
+	assert.Equal(t, cadence.String("A.f8d6e0586b0a20c7.FlowToken.Vault"), values[7])
+
+	listing := healthy(1)
+	listing.Details.CustomID = nil
+	listing.CommissionReceivers, listing.CommissionReceiversValid = nil, nil
+	values, err = listing.RelistArguments()
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewOptional(nil), values[2])
+	assert.Empty(t, values[5].(cadence.Array).Values)
+
+	_, err = receivers.ListingReceivers{ListingResourceID: 1}.RelistArguments()
+	assert.EqualError(t, err, "listing 1 has no NFT or payment type")
+}
+
+// encode encodes a listing the way the script returns it.
+func encode(l receivers.ListingReceivers) cadence.Value {
+	bools := func(values []bool) cadence.Value {
+		elements := make([]cadence.Value, len(values))
+		for i, v := range values {
+			elements[i] = cadence.NewBool(v)
+		}
+		return cadence.NewArray(elements).WithType(cadence.NewVariableSizedArrayType(cadence.BoolType))
+	}
+
+	commissionReceivers := cadence.NewOptional(nil)
+	commissionReceiversValid := cadence.NewOptional(nil)
+	if l.CommissionReceivers != nil {
+		addresses := make([]cadence.Value, len(l.CommissionReceivers))
+		for i, address := range l.CommissionReceivers {
+			addresses[i] = address
+		}
+		commissionReceivers = cadence.NewOptional(cadence.NewArray(addresses).WithType(cadence.NewVariableSizedArrayType(cadence.AddressType)))
+		commissionReceiversValid = cadence.NewOptional(bools(l.CommissionReceiversValid))
+	}
+
+	optional := func(t cadence.Type) cadence.Type { return cadence.NewOptionalType(t) }
+	structType := cadence.NewStructType(nil, "ListingReceivers", []cadence.Field{
+		{Identifier: "listingResourceID", Type: cadence.UInt64Type},
+		{Identifier: "details", Type: nftstorefrontv2.ListingDetailsType(storefrontContract)},
+		{Identifier: "saleCutReceivers", Type: cadence.NewVariableSizedArrayType(cadence.BoolType)},
+		{Identifier: "commissionReceivers", Type: optional(cadence.NewVariableSizedArrayType(cadence.AddressType))},
+		{Identifier: "commissionReceiversValid", Type: optional(cadence.NewVariableSizedArrayType(cadence.BoolType))},
+	}, nil)
+
+	return cadence.NewStruct([]cadence.Value{
+		cadence.NewUInt64(l.ListingResourceID),
+		l.Details.Encode(storefrontContract),
+		bools(l.SaleCutReceivers),
+		commissionReceivers,
+		commissionReceiversValid,
+	}).WithType(structType)
+}
+
+func TestDecodeListingReceivers(t *testing.T) {
+	listing := healthy(1)
+	decoded, err := receivers.DecodeListingReceivers(encode(listing))
+	require.NoError(t, err)
+	assert.Equal(t, listing, decoded)
+
+	listing.CommissionReceivers, listing.CommissionReceiversValid = nil, nil
+	decoded, err = receivers.DecodeListingReceivers(encode(listing))
+	require.NoError(t, err)
+	assert.Equal(t, listing, decoded)
+
+	listing.SaleCutReceivers = []bool{true}
+	_, err = receivers.DecodeListingReceivers(encode(listing))
+	assert.EqualError(t, err, "ListingReceivers: 1 sale cut checks for 2 sale cuts")
+}
+
+// executor returns the listing IDs of the storefront, then checks them as
+// the script would, with the given listings.
+func executor(listingIDs []uint64, listings map[uint64]receivers.ListingReceivers) *testfixtures.Executor {
+	return &testfixtures.Executor{Result: func(_ []byte, args []cadence.Value) (cadence.Value, error) {
+		if len(args) == 1 {
+			ids := make([]cadence.Value, len(listingIDs))
+			for i, id := range listingIDs {
+				ids[i] = cadence.NewUInt64(id)
+			}
+			return cadence.NewArray(ids), nil
+		}
+
+		var result []cadence.Value
+		for _, id := range args[1].(cadence.Array).Values {
+			if listing, ok := listings[uint64(id.(cadence.UInt64))]; ok {
+				result = append(result, encode(listing))
+			}
+		}
+		return cadence.NewArray(result), nil
+	}}
+}
+
+func TestCheckStorefront(t *testing.T) {
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+
+	broken := healthy(2)
+	broken.SaleCutReceivers = []bool{false, false}
+	// Listing 3 was purchased, so the script skips it.
+	e := executor([]uint64{1, 2, 3}, map[uint64]receivers.ListingReceivers{1: healthy(1), 2: broken})
+
+	checker, err := receivers.NewChecker(config, "emulator", e)
+	require.NoError(t, err)
+	checker.BatchSize = 2
+
+	reports, err := checker.CheckStorefront(context.Background(), seller)
+	require.NoError(t, err)
+	require.Len(t, reports, 2)
+
+	assert.Equal(t, [][]cadence.Value{
+		{seller},
+		receivers.CheckReceiversArguments(seller, []uint64{1, 2}),
+		receivers.CheckReceiversArguments(seller, []uint64{3}),
+	}, e.Calls)
+
+	assert.True(t, reports[0].Healthy())
+	assert.Equal(t, seller, reports[0].Storefront)
+	assert.False(t, reports[1].Healthy())
+	assert.True(t, reports[1].Blocked())
+	assert.Equal(t, uint64(2), reports[1].Listing.ListingResourceID)
+
+	script, err := receivers.CheckReceiversScript(config, "emulator")
+	require.NoError(t, err)
+	assert.Contains(t, string(script), "import NFTStorefrontV2 from 0xf8d6e0586b0a20c7")
+}
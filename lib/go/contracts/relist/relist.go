@@ -0,0 +1,186 @@
+// Package relist renews NFTStorefrontV2 listings before they expire.
+//
+// A listing can no longer be purchased once the timestamp of the current
+// block reaches its expiry. A Scheduler watches the listings of storefronts
+// and, for each one expiring within its window, applies the Policy of the
+// seller: let it lapse, extend it at the same price, or extend it at a
+// decayed price, so that repeated renewals lower the price like a Dutch
+// auction. Renewals are transactions/sell_item_and_replace_current_listing.cdc
+// transactions pushed to a Queue, to be signed by the seller.
+package relist
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/reprice"
+)
+
+// Decision is what a policy does to a listing nearing expiry.
+type Decision struct {
+	// Relist is false if the listing lapses.
+	Relist bool
+	// Price and Expiry are those of the replacing listing if Relist is true.
+	// Expiry is a Unix timestamp.
+	Price  cadence.UFix64
+	Expiry uint64
+}
+
+// Policy decides whether and how listings nearing expiry are renewed.
+type Policy interface {
+	Decide(details nftstorefrontv2.ListingDetails) (Decision, error)
+}
+
+// Lapse lets listings expire.
+type Lapse struct{}
+
+func (Lapse) Decide(nftstorefrontv2.ListingDetails) (Decision, error) {
+	return Decision{}, nil
+}
+
+func (Lapse) String() string {
+	return "lapse"
+}
+
+// extend returns the expiry of a listing extended by the given duration.
+func extend(details nftstorefrontv2.ListingDetails, duration time.Duration) (uint64, error) {
+	seconds := uint64(duration / time.Second)
+	if seconds == 0 {
+		return 0, fmt.Errorf("extension %s is shorter than a second", duration)
+	}
+	if details.Expiry > ^uint64(0)-seconds {
+		return 0, fmt.Errorf("expiry %d extended by %s overflows UInt64", details.Expiry, duration)
+	}
+	return details.Expiry + seconds, nil
+}
+
+// Extend renews listings at the same price, expiring Duration after the
+// current expiry.
+type Extend struct {
+	Duration time.Duration
+}
+
+func (e Extend) Decide(details nftstorefrontv2.ListingDetails) (Decision, error) {
+	expiry, err := extend(details, e.Duration)
+	if err != nil {
+		return Decision{}, err
+	}
+	return Decision{Relist: true, Price: details.SalePrice, Expiry: expiry}, nil
+}
+
+func (e Extend) String() string {
+	return "extend:" + e.Duration.String()
+}
+
+// Decay renews listings expiring Duration after the current expiry, at a
+// price changed by a negative percentage, for example -10 for 10% lower at
+// each renewal, and rounded with Rounding. The price never goes below
+// Floor: the listings at the floor are renewed at the same price, or lapse
+// if LapseAtFloor is true.
+type Decay struct {
+	Duration     time.Duration
+	Change       cadence.Fix64
+	Rounding     reprice.Rounding
+	Floor        cadence.UFix64
+	LapseAtFloor bool
+}
+
+func (d Decay) Decide(details nftstorefrontv2.ListingDetails) (Decision, error) {
+	if d.Change >= 0 {
+		return Decision{}, fmt.Errorf("decay change must be negative, got %s%%", d.Change)
+	}
+
+	price := details.SalePrice
+	if price <= d.Floor {
+		if d.LapseAtFloor {
+			return Decision{}, nil
+		}
+	} else {
+		decayed, _, err := reprice.Percentage{Change: d.Change, Rounding: d.Rounding}.Price(details)
+		if err != nil {
+			return Decision{}, err
+		}
+		price = max(decayed, d.Floor)
+	}
+	if price == 0 {
+		return Decision{}, fmt.Errorf("decayed price is zero")
+	}
+	if price < details.CommissionAmount {
+		return Decision{}, fmt.Errorf("decayed price %s is below the commission %s", price, details.CommissionAmount)
+	}
+
+	expiry, err := extend(details, d.Duration)
+	if err != nil {
+		return Decision{}, err
+	}
+	return Decision{Relist: true, Price: price, Expiry: expiry}, nil
+}
+
+func (d Decay) String() string {
+	s := fmt.Sprintf("decay:%s:%s:%s", d.Duration, d.Change, d.Floor)
+	if d.LapseAtFloor {
+		s += ":lapse"
+	}
+	return s
+}
+
+// ParsePolicy parses a policy written as
+//
+//	lapse
+//	extend:DURATION
+//	decay:DURATION:CHANGE:FLOOR[:lapse]
+//
+// where DURATION is a time.Duration, CHANGE a negative percentage and FLOOR
+// a price, for example extend:168h or decay:24h:-10:5.0:lapse. Decayed prices
+// are rounded down to the given rounding step, or to the UFix64 precision if
+// it is zero.
+func ParsePolicy(s string, step cadence.UFix64) (Policy, error) {
+	parts := strings.Split(s, ":")
+	switch parts[0] {
+	case "lapse":
+		if len(parts) == 1 {
+			return Lapse{}, nil
+		}
+
+	case "extend":
+		if len(parts) == 2 {
+			duration, err := time.ParseDuration(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("policy %q: %w", s, err)
+			}
+			return Extend{Duration: duration}, nil
+		}
+
+	case "decay":
+		if len(parts) == 4 || len(parts) == 5 && parts[4] == "lapse" {
+			duration, err := time.ParseDuration(parts[1])
+			if err != nil {
+				return nil, fmt.Errorf("policy %q: %w", s, err)
+			}
+			change, err := reprice.ParsePercentage(parts[2])
+			if err != nil {
+				return nil, fmt.Errorf("policy %q: invalid change %q: %w", s, parts[2], err)
+			}
+			if change >= 0 {
+				return nil, fmt.Errorf("policy %q: decay change must be negative, got %s%%", s, change)
+			}
+			floor, err := cadenceconv.ParseUFix64(parts[3])
+			if err != nil {
+				return nil, fmt.Errorf("policy %q: invalid floor %q: %w", s, parts[3], err)
+			}
+			return Decay{
+				Duration:     duration,
+				Change:       change,
+				Rounding:     reprice.Rounding{Mode: reprice.RoundDown, Step: step},
+				Floor:        floor,
+				LapseAtFloor: len(parts) == 5,
+			}, nil
+		}
+	}
+	return nil, fmt.Errorf("invalid policy %q: expected lapse, extend:DURATION or decay:DURATION:CHANGE:FLOOR[:lapse]", s)
+}
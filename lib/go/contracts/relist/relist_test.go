@@ -0,0 +1,295 @@
+package relist_test
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+	"github.com/onflow/nft-storefront/lib/go/contracts/relist"
+	"github.com/onflow/nft-storefront/lib/go/contracts/reprice"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+var (
+	seller      = testfixtures.Seller
+	otherSeller = testfixtures.Creator
+	ufix64      = testfixtures.UFix64
+
+	now = time.Unix(1_700_000_000, 0)
+)
+
+// listing returns a listing of NFT id at the given price with a commission
+// of 0.5, expiring at the given time.
+func listing(t *testing.T, id uint64, price string, expiry time.Time) receivers.ListingReceivers {
+	t.Helper()
+
+	l := testfixtures.Listing(t, id, price, "0.5")
+	l.Details.Expiry = uint64(expiry.Unix())
+	return l
+}
+
+func TestPolicies(t *testing.T) {
+	t.Parallel()
+
+	details := listing(t, 1, "10.0", now).Details
+	expiry := uint64(now.Add(24 * time.Hour).Unix())
+
+	decision, err := relist.Lapse{}.Decide(details)
+	require.NoError(t, err)
+	assert.False(t, decision.Relist)
+
+	decision, err = relist.Extend{Duration: 24 * time.Hour}.Decide(details)
+	require.NoError(t, err)
+	assert.Equal(t, relist.Decision{Relist: true, Price: details.SalePrice, Expiry: expiry}, decision)
+
+	_, err = relist.Extend{Duration: time.Millisecond}.Decide(details)
+	assert.EqualError(t, err, "extension 1ms is shorter than a second")
+
+	decay := relist.Decay{
+		Duration: 24 * time.Hour,
+		Change:   -10_00000000,
+		Rounding: reprice.Rounding{Step: ufix64(t, "0.01")},
+		Floor:    ufix64(t, "8.5"),
+	}
+	for _, tc := range []struct {
+		price, want string
+	}{
+		{"10.0", "9.00000000"},
+		{"9.99", "8.99000000"},
+		{"9.0", "8.50000000"},
+		{"8.5", "8.50000000"},
+		{"8.0", "8.00000000"},
+	} {
+		details := details
+		details.SalePrice = ufix64(t, tc.price)
+		decision, err := decay.Decide(details)
+		require.NoError(t, err, tc.price)
+		assert.Equal(t, relist.Decision{Relist: true, Price: ufix64(t, tc.want), Expiry: expiry}, decision, tc.price)
+	}
+
+	decay.LapseAtFloor = true
+	details.SalePrice = ufix64(t, "8.5")
+	decision, err = decay.Decide(details)
+	require.NoError(t, err)
+	assert.False(t, decision.Relist)
+
+	_, err = relist.Decay{Duration: time.Hour, Change: 5_00000000}.Decide(details)
+	assert.EqualError(t, err, "decay change must be negative, got 5.00000000%")
+
+	details.SalePrice = ufix64(t, "0.6")
+	_, err = relist.Decay{Duration: time.Hour, Change: -50_00000000}.Decide(details)
+	assert.EqualError(t, err, "decayed price 0.30000000 is below the commission 0.50000000")
+}
+
+func TestParsePolicy(t *testing.T) {
+	t.Parallel()
+
+	step := ufix64(t, "0.01")
+	for _, tc := range []struct {
+		s    string
+		want relist.Policy
+	}{
+		{"lapse", relist.Lapse{}},
+		{"extend:168h", relist.Extend{Duration: 168 * time.Hour}},
+		{"decay:24h:-10:5.0", relist.Decay{
+			Duration: 24 * time.Hour,
+			Change:   -10_00000000,
+			Rounding: reprice.Rounding{Mode: reprice.RoundDown, Step: step},
+			Floor:    5_00000000,
+		}},
+		{"decay:24h:-10:5", relist.Decay{
+			Duration: 24 * time.Hour,
+			Change:   -10_00000000,
+			Rounding: reprice.Rounding{Mode: reprice.RoundDown, Step: step},
+			Floor:    5_00000000,
+		}},
+		{"decay:24h:-12.5:0.0:lapse", relist.Decay{
+			Duration:     24 * time.Hour,
+			Change:       -12_50000000,
+			Rounding:     reprice.Rounding{Mode: reprice.RoundDown, Step: step},
+			LapseAtFloor: true,
+		}},
+	} {
+		policy, err := relist.ParsePolicy(tc.s, step)
+		require.NoError(t, err, tc.s)
+		assert.Equal(t, tc.want, policy, tc.s)
+	}
+
+	for _, s := range []string{"", "lapse:1h", "extend", "extend:forever", "decay:24h:-10", "decay:24h:x:5.0", "decay:24h:10:5.0", "decay:24h:-10:5.0:never"} {
+		_, err := relist.ParsePolicy(s, 0)
+		assert.Error(t, err, s)
+	}
+}
+
+type reader map[cadence.Address][]receivers.ListingReceivers
+
+func (r reader) CheckStorefront(_ context.Context, storefront cadence.Address) ([]receivers.Report, error) {
+	listings, ok := r[storefront]
+	if !ok {
+		return nil, errors.New("no storefront")
+	}
+	reports := make([]receivers.Report, len(listings))
+	for i, listing := range listings {
+		reports[i] = receivers.Report{Listing: listing}
+	}
+	return reports, nil
+}
+
+func TestScheduler(t *testing.T) {
+	t.Parallel()
+
+	listings := reader{
+		seller: {
+			listing(t, 1, "10.0", now.Add(30*time.Minute)),
+			listing(t, 2, "10.0", now.Add(2*time.Hour)),
+			listing(t, 3, "0.8", now.Add(time.Minute)),
+		},
+		otherSeller: {
+			listing(t, 4, "10.0", now.Add(time.Minute)),
+		},
+	}
+
+	queue := &relist.MemoryQueue{}
+	scheduler := relist.NewScheduler(listings, queue, relist.Lapse{})
+	scheduler.Policies[seller] = relist.Decay{Duration: 24 * time.Hour, Change: -50_00000000}
+
+	result, err := scheduler.Tick(context.Background(), []cadence.Address{seller, otherSeller}, now)
+	require.NoError(t, err)
+
+	// Listing 2 expires after the window, listing 3 is decayed below its
+	// commission and listing 4 of the other seller lapses.
+	require.Len(t, result.Queued, 1)
+	assert.Equal(t, uint64(101), result.Queued[0].Listing.ListingResourceID)
+	assert.Equal(t, ufix64(t, "5.0"), result.Queued[0].Decision.Price)
+	assert.Equal(t, []uint64{104}, result.Lapsed)
+	require.Contains(t, result.Failed, uint64(103))
+	assert.EqualError(t, result.Failed[103], "listing 103: decayed price 0.40000000 is below the commission 0.50000000")
+	assert.Equal(t, 1, queue.Len())
+
+	args, err := result.Queued[0].Arguments()
+	require.NoError(t, err)
+	assert.Equal(t, cadence.NewUInt64(1), args[0])
+	assert.Equal(t, ufix64(t, "5.0"), args[1])
+	assert.Equal(t, cadence.NewUInt64(uint64(now.Add(24*time.Hour+30*time.Minute).Unix())), args[4])
+
+	// Listings are queued or lapsed once, and failed ones retried.
+	result, err = scheduler.Tick(context.Background(), []cadence.Address{seller, otherSeller}, now.Add(time.Minute))
+	require.NoError(t, err)
+	assert.Empty(t, result.Queued)
+	assert.Empty(t, result.Lapsed)
+	assert.Contains(t, result.Failed, uint64(103))
+
+	// Listing 2 enters the window.
+	result, err = scheduler.Tick(context.Background(), []cadence.Address{seller}, now.Add(90*time.Minute))
+	require.NoError(t, err)
+	require.Len(t, result.Queued, 1)
+	assert.Equal(t, uint64(102), result.Queued[0].Listing.ListingResourceID)
+	assert.Equal(t, 2, queue.Len())
+
+	job, ok := queue.Pop()
+	require.True(t, ok)
+	assert.Equal(t, uint64(101), job.Listing.ListingResourceID)
+
+	_, err = scheduler.Tick(context.Background(), []cadence.Address{testfixtures.Marketplace}, now)
+	assert.EqualError(t, err, "no storefront")
+}
+
+func TestSchedulerOtherListings(t *testing.T) {
+	t.Parallel()
+
+	// NFT 1 is also listed in another payment token, expiring later.
+	other := listing(t, 1, "8.0", now.Add(2*time.Hour))
+	other.ListingResourceID = 201
+	other.Details.SalePaymentVaultType = testfixtures.ExampleToken
+	listings := reader{seller: {
+		listing(t, 1, "10.0", now.Add(time.Minute)),
+		other,
+		listing(t, 2, "10.0", now.Add(time.Minute)),
+	}}
+
+	queue := &relist.MemoryQueue{}
+	scheduler := relist.NewScheduler(listings, queue, relist.Extend{Duration: time.Hour})
+
+	result, err := scheduler.Tick(context.Background(), []cadence.Address{seller}, now)
+	require.NoError(t, err)
+	require.Len(t, result.Queued, 1)
+	assert.Equal(t, uint64(102), result.Queued[0].Listing.ListingResourceID)
+	require.Contains(t, result.Failed, uint64(101))
+	assert.EqualError(t, result.Failed[101], "listing 101: renewing would also remove listings [201] of NFT 1")
+
+	// Once the other listing is removed, the listing is renewed.
+	listings[seller] = []receivers.ListingReceivers{listings[seller][0], listings[seller][2]}
+	result, err = scheduler.Tick(context.Background(), []cadence.Address{seller}, now.Add(time.Second))
+	require.NoError(t, err)
+	require.Len(t, result.Queued, 1)
+	assert.Equal(t, uint64(101), result.Queued[0].Listing.ListingResourceID)
+	assert.Empty(t, result.Failed)
+}
+
+func TestSchedulerBuild(t *testing.T) {
+	t.Parallel()
+
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+
+	listings := reader{seller: {
+		listing(t, 1, "10.0", now.Add(time.Minute)),
+		listing(t, 2, "20.0", now.Add(time.Minute)),
+	}}
+	jobs := make(chan relist.Job, 2)
+	scheduler := relist.NewScheduler(listings, relist.NewChannelQueue(jobs), relist.Extend{Duration: time.Hour})
+	scheduler.Builder = txbuilder.New(config, "emulator")
+	// The roles are read for each transaction, as the sequence number of
+	// the proposal key advances once the previous one is sealed.
+	sequenceNumber := uint64(7)
+	scheduler.Roles = func(_ context.Context, seller cadence.Address) (txbuilder.Roles, error) {
+		return txbuilder.Roles{Proposer: flowclient.ProposalKey{Address: seller, SequenceNumber: sequenceNumber}, Payer: seller}, nil
+	}
+
+	_, err = scheduler.Tick(context.Background(), []cadence.Address{seller}, now)
+	require.NoError(t, err)
+	require.Len(t, jobs, 2)
+
+	for i := uint64(0); i < 2; i++ {
+		tx, err := scheduler.Build(context.Background(), <-jobs)
+		require.NoError(t, err)
+		assert.Contains(t, string(tx.Script), "getExistingListingIDs")
+		assert.Equal(t, cadence.NewUInt64(i+1), tx.Arguments[0])
+		assert.Equal(t, 7+i, tx.ProposalKey.SequenceNumber)
+		assert.Equal(t, []cadence.Address{seller}, tx.Authorizers)
+		sequenceNumber++
+	}
+
+	_, err = relist.NewScheduler(listings, relist.NewChannelQueue(jobs), nil).Build(context.Background(), relist.Job{})
+	assert.EqualError(t, err, "scheduler has no Builder or Roles")
+}
+
+func TestRun(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	scheduler := relist.NewScheduler(reader{}, &relist.MemoryQueue{}, nil)
+	err := scheduler.Run(ctx, nil, 0, nil)
+	assert.EqualError(t, err, "interval must be positive, got 0s")
+
+	ticks := 0
+	err = scheduler.Run(ctx, nil, time.Millisecond, func(relist.Result) {
+		ticks++
+		if ticks == 3 {
+			cancel()
+		}
+	})
+	assert.ErrorIs(t, err, context.Canceled)
+	assert.Equal(t, 3, ticks)
+}
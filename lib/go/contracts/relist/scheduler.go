@@ -0,0 +1,319 @@
+package relist
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+const filenameRelist = "transactions/sell_item_and_replace_current_listing.cdc"
+
+// DefaultWindow is the default time before their expiry listings are
+// renewed.
+const DefaultWindow = time.Hour
+
+// ListingReader reads the open listings of a storefront with their
+// receivers. It is implemented by receivers.Checker, which leaves out
+// purchased and expired listings.
+type ListingReader interface {
+	CheckStorefront(ctx context.Context, storefront cadence.Address) ([]receivers.Report, error)
+}
+
+// Job is the renewal of a listing. Its transaction is built by
+// Scheduler.Build when it is sent.
+type Job struct {
+	Seller   cadence.Address
+	Listing  receivers.ListingReceivers
+	Decision Decision
+}
+
+// Arguments returns the arguments of
+// transactions/sell_item_and_replace_current_listing.cdc replacing the
+// listing with one at the decided price and expiry.
+func (j Job) Arguments() ([]cadence.Value, error) {
+	listing := j.Listing
+	listing.Details.SalePrice = j.Decision.Price
+	listing.Details.Expiry = j.Decision.Expiry
+	return listing.RelistArguments()
+}
+
+// Queue is pushed the jobs of a Scheduler.
+type Queue interface {
+	Push(ctx context.Context, job Job) error
+}
+
+// QueueFunc is a function used as a Queue.
+type QueueFunc func(ctx context.Context, job Job) error
+
+func (f QueueFunc) Push(ctx context.Context, job Job) error {
+	return f(ctx, job)
+}
+
+// NewChannelQueue returns a queue sending the jobs to the given channel,
+// waiting for them to be received or for the context to be done.
+func NewChannelQueue(jobs chan<- Job) Queue {
+	return QueueFunc(func(ctx context.Context, job Job) error {
+		select {
+		case jobs <- job:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// MemoryQueue is a first-in first-out Queue kept in memory. It is safe for
+// concurrent use.
+type MemoryQueue struct {
+	mu   sync.Mutex
+	jobs []Job
+}
+
+// Push appends the job.
+func (q *MemoryQueue) Push(_ context.Context, job Job) error {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	q.jobs = append(q.jobs, job)
+	return nil
+}
+
+// Pop removes and returns the oldest job, or false if the queue is empty.
+func (q *MemoryQueue) Pop() (Job, bool) {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	if len(q.jobs) == 0 {
+		return Job{}, false
+	}
+	job := q.jobs[0]
+	q.jobs = q.jobs[1:]
+	return job, true
+}
+
+// Len returns the number of jobs in the queue.
+func (q *MemoryQueue) Len() int {
+	q.mu.Lock()
+	defer q.mu.Unlock()
+
+	return len(q.jobs)
+}
+
+// RolesFunc returns the roles of the transactions renewing the listings of
+// a seller, for example with the latest block as reference block and the
+// current sequence number of the seller's proposal key.
+type RolesFunc func(ctx context.Context, seller cadence.Address) (txbuilder.Roles, error)
+
+// Result is the outcome of a Tick.
+type Result struct {
+	// Queued are the jobs pushed to the queue.
+	Queued []Job
+	// Lapsed are the listings left to expire, by listing resource ID.
+	Lapsed []uint64
+	// Failed are the errors of the listings that could not be renewed, by
+	// listing resource ID, including those of NFTs with other open
+	// listings. They are retried at the next tick.
+	Failed map[uint64]error
+}
+
+// Scheduler renews the listings of storefronts nearing expiry according to
+// the policies of their sellers. It is not safe for concurrent use.
+type Scheduler struct {
+	reader ListingReader
+	queue  Queue
+
+	// Default is the policy of the sellers without one in Policies.
+	Default  Policy
+	Policies map[cadence.Address]Policy
+	// Window is the time before their expiry listings are renewed.
+	Window time.Duration
+
+	// Builder and Roles build the transactions of the jobs in Build.
+	Builder *txbuilder.Builder
+	Roles   RolesFunc
+
+	// handled are the listings already queued or lapsed, by storefront.
+	handled map[cadence.Address]map[uint64]bool
+}
+
+// NewScheduler returns a scheduler reading listings with reader, pushing
+// jobs to queue, and applying the default policy to every seller.
+func NewScheduler(reader ListingReader, queue Queue, defaultPolicy Policy) *Scheduler {
+	return &Scheduler{
+		reader:   reader,
+		queue:    queue,
+		Default:  defaultPolicy,
+		Policies: map[cadence.Address]Policy{},
+		Window:   DefaultWindow,
+		handled:  map[cadence.Address]map[uint64]bool{},
+	}
+}
+
+// Policy returns the policy of a seller.
+func (s *Scheduler) Policy(seller cadence.Address) Policy {
+	if policy, ok := s.Policies[seller]; ok {
+		return policy
+	}
+	if s.Default == nil {
+		return Lapse{}
+	}
+	return s.Default
+}
+
+// Tick applies the policies to the listings of the storefronts expiring
+// within the window after now. Each listing is queued or lapsed once;
+// listings whose policy fails are reported in Result.Failed and retried at
+// the next tick. Renewing a listing replaces every listing of its NFT, so
+// the listings of NFTs with other open listings fail too. The error is that
+// of reading listings or pushing jobs.
+func (s *Scheduler) Tick(ctx context.Context, storefronts []cadence.Address, now time.Time) (Result, error) {
+	result := Result{Failed: map[uint64]error{}}
+	deadline := uint64(now.Add(s.Window).Unix())
+
+	for _, storefront := range storefronts {
+		reports, err := s.reader.CheckStorefront(ctx, storefront)
+		if err != nil {
+			return result, err
+		}
+
+		listed := map[nft][]uint64{}
+		for _, report := range reports {
+			key := nftOf(report.Listing.Details)
+			listed[key] = append(listed[key], report.Listing.ListingResourceID)
+		}
+
+		handled := map[uint64]bool{}
+		var jobs []Job
+		for _, report := range reports {
+			listing := report.Listing
+			id := listing.ListingResourceID
+			if s.handled[storefront][id] {
+				handled[id] = true
+				continue
+			}
+			if listing.Details.Expiry > deadline {
+				continue
+			}
+
+			decision, err := s.Policy(storefront).Decide(listing.Details)
+			if err != nil {
+				result.Failed[id] = fmt.Errorf("listing %d: %w", id, err)
+				continue
+			}
+			if !decision.Relist {
+				handled[id] = true
+				result.Lapsed = append(result.Lapsed, id)
+				continue
+			}
+
+			if others := otherListings(listed[nftOf(listing.Details)], id); len(others) > 0 {
+				result.Failed[id] = fmt.Errorf("listing %d: renewing would also remove listings %v of NFT %d", id, others, listing.Details.NFTID)
+				continue
+			}
+
+			job := Job{Seller: storefront, Listing: listing, Decision: decision}
+			if _, err := job.Arguments(); err != nil {
+				result.Failed[id] = fmt.Errorf("listing %d: %w", id, err)
+				continue
+			}
+			jobs = append(jobs, job)
+		}
+
+		for _, job := range jobs {
+			if err := s.queue.Push(ctx, job); err != nil {
+				return result, fmt.Errorf("queue listing %d: %w", job.Listing.ListingResourceID, err)
+			}
+			handled[job.Listing.ListingResourceID] = true
+			result.Queued = append(result.Queued, job)
+		}
+
+		// Listings no longer read, because they were replaced, purchased
+		// or have expired, are forgotten.
+		s.handled[storefront] = handled
+	}
+	return result, nil
+}
+
+// nft identifies the NFT of a listing.
+type nft struct {
+	typeID string
+	id     uint64
+}
+
+func nftOf(details nftstorefrontv2.ListingDetails) nft {
+	return nft{cadenceconv.TypeID(details.NFTType), details.NFTID}
+}
+
+// otherListings returns the listings other than the given one.
+func otherListings(listingResourceIDs []uint64, listingResourceID uint64) []uint64 {
+	var others []uint64
+	for _, id := range listingResourceIDs {
+		if id != listingResourceID {
+			others = append(others, id)
+		}
+	}
+	return others
+}
+
+// Build returns the unsigned transaction renewing the listing of a job,
+// with the roles returned by Roles for its seller. It is called when the
+// job is sent rather than when it is queued, so that the transaction has a
+// recent reference block and the current sequence number of the proposal
+// key: the transactions of a seller are sent one at a time, each sealed
+// before the next is built.
+func (s *Scheduler) Build(ctx context.Context, job Job) (*txbuilder.Transaction, error) {
+	if s.Builder == nil || s.Roles == nil {
+		return nil, fmt.Errorf("scheduler has no Builder or Roles")
+	}
+
+	args, err := job.Arguments()
+	if err != nil {
+		return nil, err
+	}
+	roles, err := s.Roles(ctx, job.Seller)
+	if err != nil {
+		return nil, fmt.Errorf("roles of %s: %w", job.Seller, err)
+	}
+	roles.Authorizers = []cadence.Address{job.Seller}
+
+	tx, err := s.Builder.Build(filenameRelist, args, roles)
+	if err != nil {
+		return nil, fmt.Errorf("relist listing %d: %w", job.Listing.ListingResourceID, err)
+	}
+	return tx, nil
+}
+
+// Run ticks at the given interval until the context is done or a tick
+// fails, calling report, if not nil, with the result of each tick.
+func (s *Scheduler) Run(ctx context.Context, storefronts []cadence.Address, interval time.Duration, report func(Result)) error {
+	if interval <= 0 {
+		return fmt.Errorf("interval must be positive, got %s", interval)
+	}
+
+	timer := time.NewTimer(0)
+	defer timer.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return ctx.Err()
+		case <-timer.C:
+		}
+
+		result, err := s.Tick(ctx, storefronts, time.Now())
+		if err != nil {
+			return err
+		}
+		if report != nil {
+			report(result)
+		}
+		timer.Reset(interval)
+	}
+}
@@ -0,0 +1,169 @@
+// Package reprice reprices and delists the open listings of a storefront in
+// bulk.
+//
+// A Rule computes the new price of each listing: a percentage change, a
+// price relative to the floor of the listings of the same NFT type and
+// payment token, or a fixed table of prices by NFT ID. Prices are computed
+// on the fixed-point representation of UFix64, so that no float rounding
+// creeps in. Plan turns the listings into Changes, and Build turns the
+// Changes into transactions/sell_item_and_replace_current_listing.cdc and
+// transactions/remove_item.cdc transactions. WriteDiff prints the
+// ListingDetails before and after the changes, for dry runs.
+package reprice
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+)
+
+// ufix64Factor is the factor of the fixed-point representation of UFix64
+// and Fix64 values.
+const ufix64Factor = 100_000_000
+
+// RoundingMode is how a computed price is rounded to a multiple of the
+// rounding step.
+type RoundingMode string
+
+const (
+	// RoundDown rounds towards zero. It is the default.
+	RoundDown RoundingMode = "down"
+	// RoundHalfUp rounds to the nearest multiple, halves up.
+	RoundHalfUp RoundingMode = "half-up"
+	// RoundUp rounds away from zero.
+	RoundUp RoundingMode = "up"
+)
+
+// Rounding rounds computed prices.
+type Rounding struct {
+	// Mode is RoundDown if empty.
+	Mode RoundingMode
+	// Step is the multiple prices are rounded to, for example 0.01, or zero
+	// for the UFix64 precision of 0.00000001.
+	Step cadence.UFix64
+}
+
+// scale returns price × (100 + percent) / 100, rounded. percent is a Fix64
+// so that changes such as -12.5 are exact.
+func scale(price cadence.UFix64, percent cadence.Fix64, rounding Rounding) (cadence.UFix64, error) {
+	hundred := big.NewInt(100 * ufix64Factor)
+	numerator := new(big.Int).Add(hundred, big.NewInt(int64(percent)))
+	if numerator.Sign() <= 0 {
+		return 0, fmt.Errorf("change %s%% leaves no price", percent)
+	}
+
+	step := uint64(rounding.Step)
+	if step == 0 {
+		step = 1
+	}
+	// price × numerator / (hundred × step) is the number of steps.
+	dividend := new(big.Int).Mul(new(big.Int).SetUint64(uint64(price)), numerator)
+	divisor := new(big.Int).Mul(hundred, new(big.Int).SetUint64(step))
+	steps, remainder := new(big.Int).QuoRem(dividend, divisor, new(big.Int))
+
+	switch rounding.Mode {
+	case RoundDown, "":
+	case RoundUp:
+		if remainder.Sign() > 0 {
+			steps.Add(steps, big.NewInt(1))
+		}
+	case RoundHalfUp:
+		if new(big.Int).Lsh(remainder, 1).Cmp(divisor) >= 0 {
+			steps.Add(steps, big.NewInt(1))
+		}
+	default:
+		return 0, fmt.Errorf("unknown rounding mode %q", rounding.Mode)
+	}
+
+	result := steps.Mul(steps, new(big.Int).SetUint64(step))
+	if !result.IsUint64() {
+		return 0, fmt.Errorf("%s changed by %s%% overflows UFix64", price, percent)
+	}
+	return cadence.UFix64(result.Uint64()), nil
+}
+
+// ParsePercentage parses a percentage such as 10, -12.5 or 0.25.
+func ParsePercentage(s string) (cadence.Fix64, error) {
+	return cadenceconv.ParseFix64(s)
+}
+
+// Rule computes the new prices of listings.
+type Rule interface {
+	// Price returns the new price of a listing, or false to leave it
+	// unchanged.
+	Price(details nftstorefrontv2.ListingDetails) (cadence.UFix64, bool, error)
+}
+
+// Percentage changes prices by a percentage, for example 10 for a 10%
+// increase or -12.5 for a 12.5% decrease.
+type Percentage struct {
+	Change   cadence.Fix64
+	Rounding Rounding
+}
+
+func (p Percentage) Price(details nftstorefrontv2.ListingDetails) (cadence.UFix64, bool, error) {
+	price, err := scale(details.SalePrice, p.Change, p.Rounding)
+	if err != nil {
+		return 0, false, err
+	}
+	return price, true, nil
+}
+
+// Market is the listings of an NFT type for a payment token.
+type Market struct {
+	// NFTType and TokenType are type identifiers.
+	NFTType   string
+	TokenType string
+}
+
+func marketOf(details nftstorefrontv2.ListingDetails) Market {
+	return Market{NFTType: cadenceconv.TypeID(details.NFTType), TokenType: cadenceconv.TypeID(details.SalePaymentVaultType)}
+}
+
+// Floors returns the lowest price of the listings of each market.
+func Floors(listings []nftstorefrontv2.ListingDetails) map[Market]cadence.UFix64 {
+	floors := map[Market]cadence.UFix64{}
+	for _, details := range listings {
+		market := marketOf(details)
+		if floor, ok := floors[market]; !ok || details.SalePrice < floor {
+			floors[market] = details.SalePrice
+		}
+	}
+	return floors
+}
+
+// FloorRelative prices listings at a percentage of the floor price of their
+// market, for example 5 for 5% above the floor.
+type FloorRelative struct {
+	// Floors are the floor prices by market, for example computed with
+	// Floors or taken from a marketplace.
+	Floors   map[Market]cadence.UFix64
+	Change   cadence.Fix64
+	Rounding Rounding
+}
+
+func (f FloorRelative) Price(details nftstorefrontv2.ListingDetails) (cadence.UFix64, bool, error) {
+	market := marketOf(details)
+	floor, ok := f.Floors[market]
+	if !ok {
+		return 0, false, fmt.Errorf("no floor price for %s in %s", market.NFTType, market.TokenType)
+	}
+	price, err := scale(floor, f.Change, f.Rounding)
+	if err != nil {
+		return 0, false, err
+	}
+	return price, true, nil
+}
+
+// FixedTable prices the listed NFTs by ID. The listings of the NFTs missing
+// from the table are left unchanged.
+type FixedTable map[uint64]cadence.UFix64
+
+func (t FixedTable) Price(details nftstorefrontv2.ListingDetails) (cadence.UFix64, bool, error) {
+	price, ok := t[details.NFTID]
+	return price, ok, nil
+}
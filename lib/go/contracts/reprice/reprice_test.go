@@ -0,0 +1,261 @@
+package reprice_test
+
+import (
+	"bytes"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+	"github.com/onflow/nft-storefront/lib/go/contracts/reprice"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+var (
+	seller      = testfixtures.Seller
+	creator     = testfixtures.Creator
+	marketplace = testfixtures.Marketplace
+	ufix64      = testfixtures.UFix64
+	fix64       = testfixtures.Fix64
+)
+
+// listing returns a listing of NFT id at the given price with a commission
+// of 1.0, a royalty of 10% of the price net of the commission, and the rest
+// to the seller.
+func listing(t *testing.T, id uint64, price string) receivers.ListingReceivers {
+	t.Helper()
+
+	l := testfixtures.Listing(t, id, price, "1.0")
+	net := l.Details.SaleCuts[0].Amount
+	royalty := net / 10
+	l.Details.SaleCuts = []nftstorefrontv2.SaleCut{
+		{Receiver: cadence.NewCapability(2, creator, nil), Amount: royalty},
+		{Receiver: cadence.NewCapability(1, seller, nil), Amount: net - royalty},
+	}
+	l.Details.Expiry = 1_700_000_000
+	l.SaleCutReceivers = []bool{true, true}
+	return l
+}
+
+func price(t *testing.T, rule reprice.Rule, listingPrice string) string {
+	t.Helper()
+
+	details := listing(t, 1, "10.0").Details
+	details.SalePrice = ufix64(t, listingPrice)
+	p, ok, err := rule.Price(details)
+	require.NoError(t, err)
+	require.True(t, ok)
+	return p.String()
+}
+
+func TestPercentage(t *testing.T) {
+	assert.Equal(t, "11.00000000", price(t, reprice.Percentage{Change: fix64(t, "10.0")}, "10.0"))
+	assert.Equal(t, "8.75000000", price(t, reprice.Percentage{Change: fix64(t, "-12.5")}, "10.0"))
+	// 0.00000003 × 1.5 = 0.000000045, exactly.
+	assert.Equal(t, "0.00000004", price(t, reprice.Percentage{Change: fix64(t, "50.0")}, "0.00000003"))
+	assert.Equal(t, "0.00000005", price(t, reprice.Percentage{Change: fix64(t, "50.0"), Rounding: reprice.Rounding{Mode: reprice.RoundHalfUp}}, "0.00000003"))
+	assert.Equal(t, "0.00000005", price(t, reprice.Percentage{Change: fix64(t, "50.0"), Rounding: reprice.Rounding{Mode: reprice.RoundUp}}, "0.00000003"))
+	// A third off 10.0 is 6.666...: rounded to cents.
+	third := fix64(t, "-33.33333333")
+	assert.Equal(t, "6.66000000", price(t, reprice.Percentage{Change: third, Rounding: reprice.Rounding{Step: ufix64(t, "0.01")}}, "10.0"))
+	assert.Equal(t, "6.67000000", price(t, reprice.Percentage{Change: third, Rounding: reprice.Rounding{Mode: reprice.RoundHalfUp, Step: ufix64(t, "0.01")}}, "10.0"))
+	// Beyond the 53 bits of a float64 mantissa.
+	assert.Equal(t, "100000000000.00000001", price(t, reprice.Percentage{Change: fix64(t, "0.0")}, "100000000000.00000001"))
+	assert.Equal(t, "110000000000.00000001", price(t, reprice.Percentage{Change: fix64(t, "10.0")}, "100000000000.00000001"))
+
+	_, _, err := reprice.Percentage{Change: fix64(t, "-100.0")}.Price(listing(t, 1, "10.0").Details)
+	assert.EqualError(t, err, "change -100.00000000% leaves no price")
+	_, _, err = reprice.Percentage{Change: fix64(t, "100.0")}.Price(listing(t, 1, "100000000000.0").Details)
+	assert.EqualError(t, err, "100000000000.00000000 changed by 100.00000000% overflows UFix64")
+	_, _, err = reprice.Percentage{Change: fix64(t, "1.0"), Rounding: reprice.Rounding{Mode: "sideways"}}.Price(listing(t, 1, "10.0").Details)
+	assert.EqualError(t, err, `unknown rounding mode "sideways"`)
+}
+
+func TestParsePercentage(t *testing.T) {
+	for s, want := range map[string]string{"10": "10.0", "-10": "-10.0", "-12.5": "-12.5", "0.25": "0.25"} {
+		percentage, err := reprice.ParsePercentage(s)
+		require.NoError(t, err, s)
+		assert.Equal(t, fix64(t, want), percentage, s)
+	}
+
+	_, err := reprice.ParsePercentage("ten")
+	assert.Error(t, err)
+}
+
+func TestFloorRelative(t *testing.T) {
+	listings := []nftstorefrontv2.ListingDetails{
+		listing(t, 1, "12.0").Details,
+		listing(t, 2, "9.5").Details,
+		listing(t, 3, "11.0").Details,
+	}
+	other := listing(t, 4, "1.0").Details
+	other.SalePaymentVaultType = testfixtures.ExampleToken
+	listings = append(listings, other)
+
+	floors := reprice.Floors(listings)
+	flow := reprice.Market{NFTType: "A.f8d6e0586b0a20c7.ExampleNFT.NFT", TokenType: "A.f8d6e0586b0a20c7.FlowToken.Vault"}
+	assert.Equal(t, map[reprice.Market]cadence.UFix64{
+		flow: ufix64(t, "9.5"),
+		{NFTType: flow.NFTType, TokenType: "A.f8d6e0586b0a20c7.ExampleToken.Vault"}: ufix64(t, "1.0"),
+	}, floors)
+
+	rule := reprice.FloorRelative{Floors: floors, Change: fix64(t, "5.0")}
+	assert.Equal(t, "9.97500000", price(t, rule, "12.0"))
+
+	_, _, err := reprice.FloorRelative{}.Price(listings[0])
+	assert.EqualError(t, err, "no floor price for A.f8d6e0586b0a20c7.ExampleNFT.NFT in A.f8d6e0586b0a20c7.FlowToken.Vault")
+}
+
+func TestFixedTable(t *testing.T) {
+	table := reprice.FixedTable{1: ufix64(t, "15.0")}
+	assert.Equal(t, "15.00000000", price(t, table, "10.0"))
+
+	_, ok, err := table.Price(listing(t, 2, "10.0").Details)
+	require.NoError(t, err)
+	assert.False(t, ok)
+}
+
+func TestPlan(t *testing.T) {
+	listings := []receivers.ListingReceivers{
+		listing(t, 1, "10.0"),
+		listing(t, 2, "20.0"),
+		listing(t, 3, "2.0"),
+		listing(t, 4, "30.0"),
+		listing(t, 5, "40.0"),
+	}
+	rule := reprice.FixedTable{
+		1: ufix64(t, "12.0"),
+		2: ufix64(t, "20.0"),
+		3: ufix64(t, "0.5"),
+		4: 0,
+		5: ufix64(t, "1.0"),
+	}
+
+	changes := reprice.Plan(listings, rule, reprice.NFTIDs(5))
+	require.Len(t, changes, 5)
+
+	assert.Equal(t, reprice.Reprice, changes[0].Action)
+	assert.Equal(t, ufix64(t, "12.0"), changes[0].Price)
+	assert.NoError(t, changes[0].Err)
+
+	assert.Equal(t, reprice.Keep, changes[1].Action)
+	assert.NoError(t, changes[1].Err)
+
+	assert.Equal(t, reprice.Keep, changes[2].Action)
+	assert.EqualError(t, changes[2].Err, "new price 0.50000000 is below the commission 1.00000000")
+
+	assert.Equal(t, reprice.Keep, changes[3].Action)
+	assert.EqualError(t, changes[3].Err, "new price is zero")
+
+	assert.Equal(t, reprice.Delist, changes[4].Action)
+
+	changes = reprice.Plan(listings, nil, reprice.PricedBelow(ufix64(t, "15.0")))
+	assert.Equal(t, reprice.Delist, changes[0].Action)
+	assert.Equal(t, reprice.Keep, changes[1].Action)
+	assert.Equal(t, reprice.Delist, changes[2].Action)
+
+	changes = reprice.Plan(listings, nil, reprice.ExpiringBefore(1_700_000_001))
+	assert.Equal(t, reprice.Delist, changes[0].Action)
+}
+
+func TestPlanOtherListings(t *testing.T) {
+	// Two listings of NFT 1, in different payment tokens.
+	other := listing(t, 1, "8.0")
+	other.ListingResourceID = 201
+	other.Details.SalePaymentVaultType = testfixtures.ExampleToken
+	listings := []receivers.ListingReceivers{listing(t, 1, "10.0"), other, listing(t, 2, "20.0")}
+	rule := reprice.FixedTable{1: ufix64(t, "12.0"), 2: ufix64(t, "21.0")}
+
+	changes := reprice.Plan(listings, rule, nil)
+	require.Len(t, changes, 3)
+	assert.Equal(t, reprice.Keep, changes[0].Action)
+	assert.EqualError(t, changes[0].Err, "replacing the listing would also remove listings [201] of NFT 1")
+	assert.Equal(t, reprice.Keep, changes[1].Action)
+	assert.EqualError(t, changes[1].Err, "replacing the listing would also remove listings [101] of NFT 1")
+	assert.Equal(t, reprice.Reprice, changes[2].Action)
+
+	// Once the other listing is delisted, the first one can be replaced.
+	changes = reprice.Plan(listings, rule, reprice.PricedBelow(ufix64(t, "9.0")))
+	assert.Equal(t, reprice.Reprice, changes[0].Action)
+	assert.NoError(t, changes[0].Err)
+	assert.Equal(t, reprice.Delist, changes[1].Action)
+	assert.Equal(t, reprice.Reprice, changes[2].Action)
+}
+
+func TestAfter(t *testing.T) {
+	change := reprice.Plan([]receivers.ListingReceivers{listing(t, 1, "10.0")}, reprice.FixedTable{1: ufix64(t, "21.0")}, nil)[0]
+
+	after := change.After()
+	require.NotNil(t, after)
+	assert.Equal(t, ufix64(t, "21.0"), after.SalePrice)
+	// The royalty stays 10% of the price net of the commission.
+	assert.Equal(t, ufix64(t, "2.0"), after.SaleCuts[0].Amount)
+	assert.Equal(t, ufix64(t, "18.0"), after.SaleCuts[1].Amount)
+	assert.Equal(t, creator, after.SaleCuts[0].Receiver.Address)
+	// The listing is not modified.
+	assert.Equal(t, ufix64(t, "0.9"), change.Before().SaleCuts[0].Amount)
+
+	assert.Equal(t, []reprice.FieldChange{
+		{Field: "salePrice", Before: "10.00000000", After: "21.00000000"},
+		{Field: "saleCuts[0].amount", Before: "0.90000000", After: "2.00000000"},
+		{Field: "saleCuts[1].amount", Before: "8.10000000", After: "18.00000000"},
+	}, change.Diff())
+
+	change.Action = reprice.Delist
+	assert.Nil(t, change.After())
+	assert.Empty(t, change.Diff())
+}
+
+func TestWriteDiff(t *testing.T) {
+	listings := []receivers.ListingReceivers{listing(t, 1, "10.0"), listing(t, 2, "2.0"), listing(t, 3, "3.0"), listing(t, 4, "4.0")}
+	changes := reprice.Plan(listings, reprice.FixedTable{1: ufix64(t, "21.0"), 2: ufix64(t, "0.5")}, reprice.NFTIDs(3))
+
+	var b bytes.Buffer
+	require.NoError(t, reprice.WriteDiff(&b, changes))
+	assert.Equal(t, `listing 101 (A.f8d6e0586b0a20c7.ExampleNFT.NFT #1): reprice
+  salePrice: 10.00000000 -> 21.00000000
+  saleCuts[0].amount: 0.90000000 -> 2.00000000
+  saleCuts[1].amount: 8.10000000 -> 18.00000000
+listing 102 (A.f8d6e0586b0a20c7.ExampleNFT.NFT #2): kept: new price 0.50000000 is below the commission 1.00000000
+listing 103 (A.f8d6e0586b0a20c7.ExampleNFT.NFT #3): delist at 3.00000000
+1 repriced, 1 delisted, 2 kept
+`, b.String())
+}
+
+func TestBuild(t *testing.T) {
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+	builder := txbuilder.New(config, "emulator")
+
+	listings := []receivers.ListingReceivers{listing(t, 1, "10.0"), listing(t, 2, "2.0"), listing(t, 3, "3.0")}
+	changes := reprice.Plan(listings, reprice.FixedTable{1: ufix64(t, "21.0")}, reprice.NFTIDs(3))
+
+	transactions, err := reprice.Build(builder, seller, changes, txbuilder.Roles{
+		Proposer: flowclient.ProposalKey{Address: seller, SequenceNumber: 7},
+		Payer:    seller,
+	})
+	require.NoError(t, err)
+	require.Len(t, transactions, 2)
+
+	// The delisting is sent first.
+	assert.Equal(t, []cadence.Value{cadence.NewUInt64(103)}, transactions[0].Arguments)
+	assert.Equal(t, uint64(7), transactions[0].ProposalKey.SequenceNumber)
+	assert.Equal(t, []cadence.Address{seller}, transactions[0].Authorizers)
+
+	assert.Contains(t, string(transactions[1].Script), "getExistingListingIDs")
+	assert.Equal(t, cadence.NewUInt64(1), transactions[1].Arguments[0])
+	assert.Equal(t, ufix64(t, "21.0"), transactions[1].Arguments[1])
+	assert.Equal(t, []cadence.Value{marketplace}, transactions[1].Arguments[5].(cadence.Array).Values)
+	assert.Equal(t, uint64(8), transactions[1].ProposalKey.SequenceNumber)
+
+	_, err = changes[1].RelistArguments()
+	assert.EqualError(t, err, "listing 102 is not repriced")
+	_, err = changes[0].RemoveItemArguments()
+	assert.EqualError(t, err, "listing 101 is not delisted")
+}
@@ -0,0 +1,332 @@
+package reprice
+
+import (
+	"fmt"
+	"io"
+	"math/big"
+	"strconv"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+const (
+	filenameRemoveItem = "transactions/remove_item.cdc"
+	filenameRelist     = "transactions/sell_item_and_replace_current_listing.cdc"
+)
+
+// Filter selects listings to delist.
+type Filter func(details nftstorefrontv2.ListingDetails) bool
+
+// PricedBelow selects the listings priced below the given price.
+func PricedBelow(price cadence.UFix64) Filter {
+	return func(details nftstorefrontv2.ListingDetails) bool {
+		return details.SalePrice < price
+	}
+}
+
+// ExpiringBefore selects the listings expiring before the given Unix
+// timestamp.
+func ExpiringBefore(timestamp uint64) Filter {
+	return func(details nftstorefrontv2.ListingDetails) bool {
+		return details.Expiry < timestamp
+	}
+}
+
+// NFTIDs selects the listings of the given NFTs.
+func NFTIDs(ids ...uint64) Filter {
+	selected := make(map[uint64]bool, len(ids))
+	for _, id := range ids {
+		selected[id] = true
+	}
+	return func(details nftstorefrontv2.ListingDetails) bool {
+		return selected[details.NFTID]
+	}
+}
+
+// Action is what a change does to a listing.
+type Action string
+
+const (
+	Keep    Action = "keep"
+	Reprice Action = "reprice"
+	Delist  Action = "delist"
+)
+
+// Change is the planned change of a listing.
+type Change struct {
+	Listing receivers.ListingReceivers
+	Action  Action
+	// Price is the new price if Action is Reprice.
+	Price cadence.UFix64
+	// Err is why a listing the rule priced is kept.
+	Err error
+}
+
+// Before returns the details of the listing.
+func (c Change) Before() nftstorefrontv2.ListingDetails {
+	return c.Listing.Details
+}
+
+// After returns the predicted details of the listing replacing the current
+// one, or nil if it is delisted. They are the current details if the
+// listing is kept.
+//
+// The replacing listing is created by sell_item_and_replace_current_listing
+// from the royalties of the NFT: the sale cuts before the last one, the
+// royalties, are predicted to keep their share of the price net of the
+// commission, and the last one, the seller's, to receive the rest.
+func (c Change) After() *nftstorefrontv2.ListingDetails {
+	after := c.Listing.Details
+	switch c.Action {
+	case Delist:
+		return nil
+	case Reprice:
+	default:
+		return &after
+	}
+
+	before := c.Listing.Details
+	after.SalePrice = c.Price
+	after.SaleCuts = make([]nftstorefrontv2.SaleCut, len(before.SaleCuts))
+	copy(after.SaleCuts, before.SaleCuts)
+	if len(after.SaleCuts) == 0 {
+		return &after
+	}
+
+	net := new(big.Int).SetUint64(uint64(c.Price - before.CommissionAmount))
+	netBefore := new(big.Int).SetUint64(uint64(before.SalePrice - before.CommissionAmount))
+	var royalties cadence.UFix64
+	last := len(after.SaleCuts) - 1
+	for i := range after.SaleCuts[:last] {
+		var amount cadence.UFix64
+		if netBefore.Sign() > 0 {
+			share := new(big.Int).Mul(new(big.Int).SetUint64(uint64(before.SaleCuts[i].Amount)), net)
+			amount = cadence.UFix64(share.Quo(share, netBefore).Uint64())
+		}
+		after.SaleCuts[i].Amount = amount
+		royalties += amount
+	}
+	after.SaleCuts[last].Amount = c.Price - before.CommissionAmount - royalties
+	return &after
+}
+
+// Plan plans the change of each listing: the listings selected by delist
+// are delisted, and the others repriced by rule. Either may be nil. A
+// listing is kept if the rule leaves its price unchanged, or with Err set if
+// its new price would be zero or below its commission.
+//
+// Repricing replaces every listing of the NFT, including those in other
+// payment tokens, so a listing is also kept with Err set if the NFT has
+// other listings that are not delisted.
+func Plan(listings []receivers.ListingReceivers, rule Rule, delist Filter) []Change {
+	changes := make([]Change, len(listings))
+	for i, listing := range listings {
+		changes[i] = Change{Listing: listing, Action: Keep}
+
+		details := listing.Details
+		if delist != nil && delist(details) {
+			changes[i].Action = Delist
+			continue
+		}
+		if rule == nil {
+			continue
+		}
+
+		price, ok, err := rule.Price(details)
+		switch {
+		case err != nil:
+			changes[i].Err = err
+		case !ok || price == details.SalePrice:
+		case price == 0:
+			changes[i].Err = fmt.Errorf("new price is zero")
+		case price < details.CommissionAmount:
+			changes[i].Err = fmt.Errorf("new price %s is below the commission %s", price, details.CommissionAmount)
+		default:
+			changes[i].Action = Reprice
+			changes[i].Price = price
+		}
+	}
+
+	// The listings remaining of each NFT once the delistings are applied.
+	remaining := map[nft][]uint64{}
+	for _, change := range changes {
+		if change.Action != Delist {
+			key := nftOf(change.Listing.Details)
+			remaining[key] = append(remaining[key], change.Listing.ListingResourceID)
+		}
+	}
+	for i, change := range changes {
+		if change.Action != Reprice {
+			continue
+		}
+		var others []uint64
+		for _, id := range remaining[nftOf(change.Listing.Details)] {
+			if id != change.Listing.ListingResourceID {
+				others = append(others, id)
+			}
+		}
+		if len(others) > 0 {
+			changes[i] = Change{
+				Listing: change.Listing,
+				Action:  Keep,
+				Err:     fmt.Errorf("replacing the listing would also remove listings %v of NFT %d", others, change.Listing.Details.NFTID),
+			}
+		}
+	}
+	return changes
+}
+
+// nft identifies the NFT of a listing.
+type nft struct {
+	typeID string
+	id     uint64
+}
+
+func nftOf(details nftstorefrontv2.ListingDetails) nft {
+	return nft{cadenceconv.TypeID(details.NFTType), details.NFTID}
+}
+
+// RelistArguments returns the arguments of
+// transactions/sell_item_and_replace_current_listing.cdc replacing the
+// listing of a Reprice change.
+func (c Change) RelistArguments() ([]cadence.Value, error) {
+	if c.Action != Reprice {
+		return nil, fmt.Errorf("listing %d is not repriced", c.Listing.ListingResourceID)
+	}
+	listing := c.Listing
+	listing.Details.SalePrice = c.Price
+	return listing.RelistArguments()
+}
+
+// RemoveItemArguments returns the arguments of
+// transactions/remove_item.cdc removing the listing of a Delist change.
+func (c Change) RemoveItemArguments() ([]cadence.Value, error) {
+	if c.Action != Delist {
+		return nil, fmt.Errorf("listing %d is not delisted", c.Listing.ListingResourceID)
+	}
+	return []cadence.Value{cadence.NewUInt64(c.Listing.ListingResourceID)}, nil
+}
+
+// Order returns the delistings and then the repricings of the changes, the
+// order their transactions are sent in so that no delisting fails on a
+// listing removed by a repricing.
+func Order(changes []Change) []Change {
+	var ordered []Change
+	for _, action := range []Action{Delist, Reprice} {
+		for _, change := range changes {
+			if change.Action == action {
+				ordered = append(ordered, change)
+			}
+		}
+	}
+	return ordered
+}
+
+// Build returns the unsigned transactions applying the changes planned by
+// Plan, one per listing delisted or repriced, in the order of Order. The
+// storefront owner authorizes them, and they use consecutive sequence
+// numbers of the proposal key from roles.Proposer.SequenceNumber. They must
+// be sent in order, each one sealed before the next is sent: Flow does
+// not order pending transactions by sequence number, and one executed
+// before its predecessor fails the sequence number check.
+func Build(builder *txbuilder.Builder, storefront cadence.Address, changes []Change, roles txbuilder.Roles) ([]*txbuilder.Transaction, error) {
+	roles.Authorizers = []cadence.Address{storefront}
+
+	var transactions []*txbuilder.Transaction
+	for _, change := range Order(changes) {
+		var (
+			name string
+			args []cadence.Value
+			err  error
+		)
+		switch change.Action {
+		case Delist:
+			name = filenameRemoveItem
+			args, err = change.RemoveItemArguments()
+		case Reprice:
+			name = filenameRelist
+			args, err = change.RelistArguments()
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		txRoles := roles
+		txRoles.Proposer.SequenceNumber = roles.Proposer.SequenceNumber + uint64(len(transactions))
+		tx, err := builder.Build(name, args, txRoles)
+		if err != nil {
+			return nil, fmt.Errorf("%s listing %d: %w", change.Action, change.Listing.ListingResourceID, err)
+		}
+		transactions = append(transactions, tx)
+	}
+	return transactions, nil
+}
+
+// FieldChange is a field of ListingDetails changed by a Change.
+type FieldChange struct {
+	// Field is the name of the field in the contract, for example
+	// salePrice or saleCuts[0].amount.
+	Field  string
+	Before string
+	After  string
+}
+
+// Diff returns the fields of ListingDetails the change modifies: the price
+// and the amounts of the sale cuts. A delisting changes no field.
+func (c Change) Diff() []FieldChange {
+	after := c.After()
+	if after == nil {
+		return nil
+	}
+	before := c.Before()
+
+	var fields []FieldChange
+	add := func(field, b, a string) {
+		if b != a {
+			fields = append(fields, FieldChange{field, b, a})
+		}
+	}
+	add("salePrice", before.SalePrice.String(), after.SalePrice.String())
+	for i := range before.SaleCuts {
+		add("saleCuts["+strconv.Itoa(i)+"].amount", before.SaleCuts[i].Amount.String(), after.SaleCuts[i].Amount.String())
+	}
+	return fields
+}
+
+// WriteDiff writes the listings delisted or repriced with the fields that
+// change, and the listings the rule could not reprice.
+func WriteDiff(w io.Writer, changes []Change) error {
+	counts := map[Action]int{}
+	for _, change := range changes {
+		counts[change.Action]++
+		details := change.Before()
+		header := fmt.Sprintf("listing %d (%s #%d)", change.Listing.ListingResourceID, cadenceconv.TypeID(details.NFTType), details.NFTID)
+
+		var err error
+		switch {
+		case change.Err != nil:
+			_, err = fmt.Fprintf(w, "%s: kept: %s\n", header, change.Err)
+		case change.Action == Delist:
+			_, err = fmt.Fprintf(w, "%s: delist at %s\n", header, details.SalePrice)
+		case change.Action == Reprice:
+			if _, err = fmt.Fprintf(w, "%s: reprice\n", header); err != nil {
+				return err
+			}
+			for _, field := range change.Diff() {
+				if _, err = fmt.Fprintf(w, "  %s: %s -> %s\n", field.Field, field.Before, field.After); err != nil {
+					return err
+				}
+			}
+		}
+		if err != nil {
+			return err
+		}
+	}
+	_, err := fmt.Fprintf(w, "%d repriced, %d delisted, %d kept\n", counts[Reprice], counts[Delist], counts[Keep])
+	return err
+}
@@ -0,0 +1,211 @@
+// Package safety detects storefronts and listings posing as NFTStorefrontV2
+// ones, such as those of contracts/utility/test/MaliciousStorefrontV2.cdc,
+// and listings whose NFT is no longer the one listed. Marketplaces run the
+// check before surfacing a listing.
+package safety
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+)
+
+const filenameInspectListing = "scripts/inspect_listing.cdc"
+
+// inspectionQualifiedIdentifier is the qualified identifier of the struct
+// returned by the script. It is declared by the script, so its location is
+// the script's.
+const inspectionQualifiedIdentifier = "ListingInspection"
+
+// InspectListingScript returns the script inspecting a listing, with its
+// imports resolved for the given network.
+func InspectListingScript(config *flowconfig.Config, network string) ([]byte, error) {
+	return config.ResolveImports(templates.MustAsset(filenameInspectListing), network)
+}
+
+// InspectListingArguments returns the arguments of the script inspecting
+// the given listing.
+func InspectListingArguments(storefront cadence.Address, listingResourceID uint64) []cadence.Value {
+	return []cadence.Value{storefront, cadence.NewUInt64(listingResourceID)}
+}
+
+// Inspection is the result of the script inspecting a listing.
+type Inspection struct {
+	// StorefrontType is the type of the storefront reference, or nil if
+	// the account publishes no storefront.
+	StorefrontType cadence.Type
+	// ListingType is the type of the listing reference, or nil if the
+	// listing was not borrowed.
+	ListingType cadence.Type
+	Details     *nftstorefrontv2.ListingDetails
+	// NFTType and NFTID describe the NFT the listing would sell, if its
+	// provider still holds it.
+	NFTType cadence.Type
+	NFTID   *uint64
+}
+
+// DecodeInspection decodes the result of the script inspecting a listing.
+func DecodeInspection(value cadence.Value) (Inspection, error) {
+	var result Inspection
+
+	fields, err := cadenceconv.Fields(value, inspectionQualifiedIdentifier)
+	if err != nil {
+		return result, err
+	}
+
+	for _, f := range []struct {
+		name   string
+		target *cadence.Type
+	}{
+		{"storefrontType", &result.StorefrontType},
+		{"listingType", &result.ListingType},
+		{"nftType", &result.NFTType},
+	} {
+		t, err := cadenceconv.Field(fields, f.name, cadenceconv.OptionalOf(cadenceconv.Type))
+		if err != nil {
+			return result, fmt.Errorf("%s: %w", inspectionQualifiedIdentifier, err)
+		}
+		if t != nil {
+			*f.target = *t
+		}
+	}
+
+	result.Details, err = cadenceconv.Field(fields, "details", cadenceconv.OptionalOf(nftstorefrontv2.DecodeListingDetails))
+	if err != nil {
+		return result, fmt.Errorf("%s: %w", inspectionQualifiedIdentifier, err)
+	}
+
+	result.NFTID, err = cadenceconv.Field(fields, "nftID", cadenceconv.OptionalOf(cadenceconv.UInt64))
+	if err != nil {
+		return result, fmt.Errorf("%s: %w", inspectionQualifiedIdentifier, err)
+	}
+
+	return result, nil
+}
+
+// Problem identifies why a listing must not be surfaced.
+type Problem string
+
+const (
+	// ProblemNoStorefront means that the account publishes no storefront.
+	ProblemNoStorefront Problem = "no-storefront"
+	// ProblemStorefrontType means that the published storefront is not a
+	// NFTStorefrontV2.Storefront of the canonical contract.
+	ProblemStorefrontType Problem = "storefront-type"
+	// ProblemNoListing means that the storefront has no such listing.
+	ProblemNoListing Problem = "no-listing"
+	// ProblemListingType means that the listing is not a
+	// NFTStorefrontV2.Listing of the canonical contract.
+	ProblemListingType Problem = "listing-type"
+	// ProblemNFTMissing means that the provider of the listing no longer
+	// holds the listed NFT: the listing is a ghost listing.
+	ProblemNFTMissing Problem = "nft-missing"
+	// ProblemNFTMismatch means that the NFT held is not the one described by
+	// the listing details.
+	ProblemNFTMismatch Problem = "nft-mismatch"
+)
+
+// Finding is a problem found with a listing.
+type Finding struct {
+	Problem Problem
+	Message string
+}
+
+func (f Finding) String() string {
+	return fmt.Sprintf("%s: %s", f.Problem, f.Message)
+}
+
+// Check returns the problems of an inspected listing. contract is the
+// address of the canonical NFTStorefrontV2 contract.
+func (i Inspection) Check(contract cadence.Address) []Finding {
+	if i.StorefrontType == nil {
+		return []Finding{{ProblemNoStorefront, "the account publishes no storefront"}}
+	}
+
+	storefrontType := typeID(contract, "NFTStorefrontV2.Storefront")
+	if id := referencedTypeID(i.StorefrontType); id != storefrontType {
+		return []Finding{{ProblemStorefrontType, fmt.Sprintf("storefront is a %s, not a %s", id, storefrontType)}}
+	}
+
+	if i.ListingType == nil || i.Details == nil {
+		return []Finding{{ProblemNoListing, "the storefront has no such listing"}}
+	}
+
+	listingType := typeID(contract, "NFTStorefrontV2.Listing")
+	if id := referencedTypeID(i.ListingType); id != listingType {
+		return []Finding{{ProblemListingType, fmt.Sprintf("listing is a %s, not a %s", id, listingType)}}
+	}
+
+	if i.NFTType == nil || i.NFTID == nil {
+		return []Finding{{ProblemNFTMissing, fmt.Sprintf("the seller no longer holds NFT %d", i.Details.NFTID)}}
+	}
+
+	var findings []Finding
+	if held, listed := referencedTypeID(i.NFTType), referencedTypeID(i.Details.NFTType); held != listed {
+		findings = append(findings, Finding{ProblemNFTMismatch, fmt.Sprintf("listed NFT type is %s, held NFT type is %s", listed, held)})
+	}
+	if *i.NFTID != i.Details.NFTID {
+		findings = append(findings, Finding{ProblemNFTMismatch, fmt.Sprintf("listed NFT ID is %d, held NFT ID is %d", i.Details.NFTID, *i.NFTID)})
+	}
+	return findings
+}
+
+func typeID(contract cadence.Address, qualifiedIdentifier string) string {
+	return string(cadenceconv.Location(contract, "NFTStorefrontV2").TypeID(nil, qualifiedIdentifier))
+}
+
+// referencedTypeID returns the ID of the given type, or of the type it
+// references: the script returns the types of references.
+func referencedTypeID(t cadence.Type) string {
+	if reference, ok := t.(*cadence.ReferenceType); ok {
+		t = reference.Type
+	}
+	return t.ID()
+}
+
+// Report is the result of checking a listing.
+type Report struct {
+	Inspection Inspection
+	Findings   []Finding
+}
+
+// Safe reports whether no problem was found.
+func (r *Report) Safe() bool {
+	return len(r.Findings) == 0
+}
+
+// CheckListing inspects the given listing on the given network and checks
+// it against the NFTStorefrontV2 contract of that network.
+func CheckListing(ctx context.Context, executor flowclient.ScriptExecutor, config *flowconfig.Config, network string, storefront cadence.Address, listingResourceID uint64) (*Report, error) {
+	contract, err := config.Address("NFTStorefrontV2", network)
+	if err != nil {
+		return nil, err
+	}
+
+	code, err := InspectListingScript(config, network)
+	if err != nil {
+		return nil, err
+	}
+
+	value, err := executor.ExecuteScriptAtLatestBlock(ctx, code, InspectListingArguments(storefront, listingResourceID))
+	if err != nil {
+		return nil, fmt.Errorf("inspect listing %d of %s: %w", listingResourceID, storefront, err)
+	}
+
+	inspection, err := DecodeInspection(value)
+	if err != nil {
+		return nil, fmt.Errorf("inspect listing %d of %s: %w", listingResourceID, storefront, err)
+	}
+
+	return &Report{
+		Inspection: inspection,
+		Findings:   inspection.Check(contract),
+	}, nil
+}
@@ -0,0 +1,209 @@
+package safety_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/cadenceconv"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/safety"
+)
+
+var (
+	storefrontContract = testfixtures.StorefrontContract
+	maliciousContract  = cadence.BytesToAddress([]byte{0x01})
+	seller             = cadence.BytesToAddress([]byte{0x02})
+)
+
+func resourceType(contract cadence.Address, location, qualifiedIdentifier string) cadence.Type {
+	return cadence.NewResourceType(cadenceconv.Location(contract, location), qualifiedIdentifier, nil, nil)
+}
+
+func reference(t cadence.Type) cadence.Type {
+	return cadence.NewReferenceType(cadence.UnauthorizedAccess, t)
+}
+
+func genuine() safety.Inspection {
+	nftType := resourceType(storefrontContract, "ExampleNFT", "ExampleNFT.NFT")
+	nftID := uint64(1)
+	return safety.Inspection{
+		StorefrontType: reference(resourceType(storefrontContract, "NFTStorefrontV2", "NFTStorefrontV2.Storefront")),
+		ListingType:    reference(resourceType(storefrontContract, "NFTStorefrontV2", "NFTStorefrontV2.Listing")),
+		Details: &nftstorefrontv2.ListingDetails{
+			StorefrontID:         3,
+			NFTType:              nftType,
+			NFTID:                nftID,
+			SalePaymentVaultType: resourceType(storefrontContract, "FlowToken", "FlowToken.Vault"),
+			SalePrice:            cadence.UFix64(10_00000000),
+			SaleCuts: []nftstorefrontv2.SaleCut{
+				{
+					Receiver: cadence.NewCapability(1, seller, nil),
+					Amount:   cadence.UFix64(10_00000000),
+				},
+			},
+		},
+		NFTType: reference(nftType),
+		NFTID:   &nftID,
+	}
+}
+
+func problems(findings []safety.Finding) []safety.Problem {
+	var result []safety.Problem
+	for _, f := range findings {
+		result = append(result, f.Problem)
+	}
+	return result
+}
+
+func TestCheck(t *testing.T) {
+	otherID := uint64(2)
+
+	for name, test := range map[string]struct {
+		modify   func(*safety.Inspection)
+		problems []safety.Problem
+	}{
+		"genuine": {
+			modify: func(*safety.Inspection) {},
+		},
+		"no storefront": {
+			modify:   func(i *safety.Inspection) { *i = safety.Inspection{} },
+			problems: []safety.Problem{safety.ProblemNoStorefront},
+		},
+		"malicious storefront": {
+			modify: func(i *safety.Inspection) {
+				*i = safety.Inspection{
+					StorefrontType: reference(resourceType(maliciousContract, "MaliciousStorefrontV2", "MaliciousStorefrontV2.Storefront")),
+				}
+			},
+			problems: []safety.Problem{safety.ProblemStorefrontType},
+		},
+		"storefront of another contract": {
+			modify: func(i *safety.Inspection) {
+				i.StorefrontType = reference(resourceType(maliciousContract, "NFTStorefrontV2", "NFTStorefrontV2.Storefront"))
+			},
+			problems: []safety.Problem{safety.ProblemStorefrontType},
+		},
+		"no listing": {
+			modify: func(i *safety.Inspection) {
+				i.ListingType, i.Details, i.NFTType, i.NFTID = nil, nil, nil, nil
+			},
+			problems: []safety.Problem{safety.ProblemNoListing},
+		},
+		"malicious listing": {
+			modify: func(i *safety.Inspection) {
+				i.ListingType = reference(resourceType(maliciousContract, "MaliciousStorefrontV2", "MaliciousStorefrontV2.Listing"))
+			},
+			problems: []safety.Problem{safety.ProblemListingType},
+		},
+		"ghost listing": {
+			modify: func(i *safety.Inspection) {
+				i.NFTType, i.NFTID = nil, nil
+			},
+			problems: []safety.Problem{safety.ProblemNFTMissing},
+		},
+		"other NFT": {
+			modify: func(i *safety.Inspection) {
+				i.NFTType = reference(resourceType(maliciousContract, "ExampleNFT", "ExampleNFT.NFT"))
+				i.NFTID = &otherID
+			},
+			problems: []safety.Problem{safety.ProblemNFTMismatch, safety.ProblemNFTMismatch},
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			inspection := genuine()
+			test.modify(&inspection)
+			assert.Equal(t, test.problems, problems(inspection.Check(storefrontContract)))
+		})
+	}
+}
+
+func TestFindingString(t *testing.T) {
+	findings := safety.Inspection{}.Check(storefrontContract)
+	require.Len(t, findings, 1)
+	assert.Equal(t, "no-storefront: the account publishes no storefront", findings[0].String())
+}
+
+// encode encodes an inspection the way the script returns it.
+func encode(i safety.Inspection) cadence.Value {
+	optionalType := func(t cadence.Type) cadence.Value {
+		if t == nil {
+			return cadence.NewOptional(nil)
+		}
+		return cadence.NewOptional(cadence.NewTypeValue(t))
+	}
+
+	details := cadence.NewOptional(nil)
+	if i.Details != nil {
+		details = cadence.NewOptional(i.Details.Encode(storefrontContract))
+	}
+	nftID := cadence.NewOptional(nil)
+	if i.NFTID != nil {
+		nftID = cadence.NewOptional(cadence.NewUInt64(*i.NFTID))
+	}
+
+	optional := func(t cadence.Type) cadence.Type { return cadence.NewOptionalType(t) }
+	structType := cadence.NewStructType(nil, "ListingInspection", []cadence.Field{
+		{Identifier: "storefrontType", Type: optional(cadence.MetaType)},
+		{Identifier: "listingType", Type: optional(cadence.MetaType)},
+		{Identifier: "details", Type: optional(nftstorefrontv2.ListingDetailsType(storefrontContract))},
+		{Identifier: "nftType", Type: optional(cadence.MetaType)},
+		{Identifier: "nftID", Type: optional(cadence.UInt64Type)},
+	}, nil)
+
+	return cadence.NewStruct([]cadence.Value{
+		optionalType(i.StorefrontType),
+		optionalType(i.ListingType),
+		details,
+		optionalType(i.NFTType),
+		nftID,
+	}).WithType(structType)
+}
+
+func TestDecodeInspection(t *testing.T) {
+	for name, inspection := range map[string]safety.Inspection{
+		"genuine":       genuine(),
+		"no storefront": {},
+	} {
+		t.Run(name, func(t *testing.T) {
+			decoded, err := safety.DecodeInspection(encode(inspection))
+			require.NoError(t, err)
+			assert.Equal(t, inspection, decoded)
+		})
+	}
+
+	_, err := safety.DecodeInspection(genuine().Details.Encode(storefrontContract))
+	assert.EqualError(t, err, "expected ListingInspection, got A.f8d6e0586b0a20c7.NFTStorefrontV2.ListingDetails")
+}
+
+func TestCheckListing(t *testing.T) {
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+
+	var code []byte
+	result := encode(genuine())
+	e := &testfixtures.Executor{Result: func(c []byte, _ []cadence.Value) (cadence.Value, error) {
+		code = c
+		return result, nil
+	}}
+	report, err := safety.CheckListing(context.Background(), e, config, "emulator", seller, 42)
+	require.NoError(t, err)
+
+	assert.True(t, report.Safe())
+	assert.Equal(t, genuine(), report.Inspection)
+	assert.Contains(t, string(code), `import NFTStorefrontV2 from 0xf8d6e0586b0a20c7`)
+	assert.Equal(t, [][]cadence.Value{safety.InspectListingArguments(seller, 42)}, e.Calls)
+
+	result = encode(safety.Inspection{
+		StorefrontType: reference(resourceType(maliciousContract, "MaliciousStorefrontV2", "MaliciousStorefrontV2.Storefront")),
+	})
+	report, err = safety.CheckListing(context.Background(), e, config, "emulator", seller, 42)
+	require.NoError(t, err)
+	assert.False(t, report.Safe())
+	assert.Equal(t, []safety.Problem{safety.ProblemStorefrontType}, problems(report.Findings))
+}
@@ -0,0 +1,191 @@
+package sponsor
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+// maxRequestSize bounds the size of signables, which embed the script.
+const maxRequestSize = 1 << 20
+
+// Signable is the request FCL sends to the payer authorization service.
+type Signable struct {
+	// Message is the hex-encoded message to sign: TransactionDomainTag
+	// followed by the envelope message.
+	Message string  `json:"message"`
+	Voucher Voucher `json:"voucher"`
+}
+
+// Voucher is a transaction as encoded by FCL.
+type Voucher struct {
+	Cadence      string            `json:"cadence"`
+	RefBlock     string            `json:"refBlock"`
+	ComputeLimit uint64            `json:"computeLimit"`
+	Arguments    []json.RawMessage `json:"arguments"`
+	ProposalKey  struct {
+		Address     string `json:"address"`
+		KeyID       uint32 `json:"keyId"`
+		SequenceNum uint64 `json:"sequenceNum"`
+	} `json:"proposalKey"`
+	Payer        string             `json:"payer"`
+	Authorizers  []string           `json:"authorizers"`
+	PayloadSigs  []VoucherSignature `json:"payloadSigs"`
+	EnvelopeSigs []VoucherSignature `json:"envelopeSigs"`
+}
+
+// VoucherSignature is a signature of a voucher.
+type VoucherSignature struct {
+	Address string  `json:"address"`
+	KeyID   uint32  `json:"keyId"`
+	Sig     *string `json:"sig"`
+}
+
+// CompositeSignature is the response of the payer authorization service.
+type CompositeSignature struct {
+	FType     string `json:"f_type"`
+	FVsn      string `json:"f_vsn"`
+	Addr      string `json:"addr"`
+	KeyID     uint32 `json:"keyId"`
+	Signature string `json:"signature"`
+}
+
+// Transaction returns the transaction of the voucher.
+func (v *Voucher) Transaction() (*txbuilder.Transaction, error) {
+	tx := &txbuilder.Transaction{}
+	tx.Script = []byte(v.Cadence)
+	tx.GasLimit = v.ComputeLimit
+
+	var err error
+	if tx.ReferenceBlockID, err = flowclient.HexToIdentifier(v.RefBlock); err != nil {
+		return nil, fmt.Errorf("reference block: %w", err)
+	}
+
+	// The arguments are decoded for the checks of the co-signer, but signed
+	// as FCL encoded them.
+	tx.EncodedArguments = [][]byte{}
+	for i, argument := range v.Arguments {
+		value, err := jsoncdc.Decode(nil, argument)
+		if err != nil {
+			return nil, fmt.Errorf("argument %d: %w", i, err)
+		}
+		tx.Arguments = append(tx.Arguments, value)
+		tx.EncodedArguments = append(tx.EncodedArguments, argument)
+	}
+
+	if tx.ProposalKey.Address, err = flowclient.HexToAddress(v.ProposalKey.Address); err != nil {
+		return nil, fmt.Errorf("proposer: %w", err)
+	}
+	tx.ProposalKey.KeyIndex = v.ProposalKey.KeyID
+	tx.ProposalKey.SequenceNumber = v.ProposalKey.SequenceNum
+
+	if tx.Payer, err = flowclient.HexToAddress(v.Payer); err != nil {
+		return nil, fmt.Errorf("payer: %w", err)
+	}
+	for _, authorizer := range v.Authorizers {
+		address, err := flowclient.HexToAddress(authorizer)
+		if err != nil {
+			return nil, fmt.Errorf("authorizer: %w", err)
+		}
+		tx.Authorizers = append(tx.Authorizers, address)
+	}
+
+	// FCL lists every signer, with a nil signature until it signs.
+	add := func(signatures []VoucherSignature, add func(cadence.Address, uint32, []byte)) error {
+		for _, s := range signatures {
+			if s.Sig == nil {
+				continue
+			}
+			address, err := flowclient.HexToAddress(s.Address)
+			if err != nil {
+				return err
+			}
+			signature, err := hex.DecodeString(*s.Sig)
+			if err != nil {
+				return fmt.Errorf("signature of %s: %w", s.Address, err)
+			}
+			add(address, s.KeyID, signature)
+		}
+		return nil
+	}
+	if err := add(v.PayloadSigs, tx.AddPayloadSignature); err != nil {
+		return nil, fmt.Errorf("payload signature: %w", err)
+	}
+	if err := add(v.EnvelopeSigs, tx.AddEnvelopeSignature); err != nil {
+		return nil, fmt.Errorf("envelope signature: %w", err)
+	}
+
+	return tx, nil
+}
+
+// Handler returns an HTTP handler for FCL payer authorization: it co-signs
+// the signables POSTed to it and responds with the composite signature of
+// the marketplace. Refused transactions get a 403 response.
+func (c *CoSigner) Handler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodPost {
+			w.Header().Set("Allow", http.MethodPost)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var signable Signable
+		if err := json.NewDecoder(http.MaxBytesReader(w, r.Body, maxRequestSize)).Decode(&signable); err != nil {
+			http.Error(w, fmt.Sprintf("invalid signable: %s", err), http.StatusBadRequest)
+			return
+		}
+
+		signature, err := c.SignSignable(&signable)
+		if errors.Is(err, ErrRefused) {
+			http.Error(w, err.Error(), http.StatusForbidden)
+			return
+		}
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(signature)
+	})
+}
+
+// SignSignable co-signs the transaction of a signable, checking that its
+// message is the envelope of the voucher.
+func (c *CoSigner) SignSignable(signable *Signable) (*CompositeSignature, error) {
+	tx, err := signable.Voucher.Transaction()
+	if err != nil {
+		return nil, fmt.Errorf("invalid voucher: %w", err)
+	}
+
+	envelope, err := tx.EnvelopeMessage()
+	if err != nil {
+		return nil, fmt.Errorf("invalid voucher: %w", err)
+	}
+	message := hex.EncodeToString(append(append([]byte{}, txbuilder.TransactionDomainTag...), envelope...))
+	if !strings.EqualFold(strings.TrimPrefix(signable.Message, "0x"), message) {
+		return nil, fmt.Errorf("message is not the envelope of the voucher")
+	}
+
+	if err := c.CoSign(tx); err != nil {
+		return nil, err
+	}
+
+	signature := tx.EnvelopeSignatures[len(tx.EnvelopeSignatures)-1]
+	return &CompositeSignature{
+		FType:     "CompositeSignature",
+		FVsn:      "1.0.0",
+		Addr:      signature.Address.String(),
+		KeyID:     signature.KeyIndex,
+		Signature: hex.EncodeToString(signature.Signature),
+	}, nil
+}
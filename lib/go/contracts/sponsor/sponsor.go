@@ -0,0 +1,134 @@
+// Package sponsor builds and co-signs purchases whose fees a marketplace
+// pays: the marketplace account is the payer of transactions/buy_item.cdc and
+// the buyer its sole authorizer.
+//
+// The buyer proposes and signs the payload, then the marketplace checks the
+// transaction with CoSigner and signs the envelope. CoSigner only pays for
+// the embedded purchase transaction, with the marketplace as commission
+// recipient, so that a leaked endpoint cannot be used to pay for arbitrary
+// transactions.
+package sponsor
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+const filenameBuyItem = "transactions/buy_item.cdc"
+
+// Purchase is a purchase of a listing.
+type Purchase struct {
+	ListingResourceID uint64
+	StorefrontAddress cadence.Address
+	// NFTTypeIdentifier is the type identifier of the NFT, for example
+	// A.0b2a3299cc857e29.TopShot.NFT.
+	NFTTypeIdentifier string
+}
+
+// Arguments returns the arguments of buy_item.cdc for a purchase whose
+// commission the marketplace receives.
+func (p Purchase) Arguments(marketplace cadence.Address) []cadence.Value {
+	return []cadence.Value{
+		cadence.NewUInt64(p.ListingResourceID),
+		p.StorefrontAddress,
+		cadence.NewOptional(marketplace),
+		cadence.String(p.NFTTypeIdentifier),
+	}
+}
+
+// BuildPurchase returns the unsigned purchase transaction proposed by the
+// buyer's key and paid by the marketplace.
+func BuildPurchase(builder *txbuilder.Builder, purchase Purchase, buyer flowclient.ProposalKey, marketplace cadence.Address, referenceBlockID flowclient.Identifier) (*txbuilder.Transaction, error) {
+	return builder.Build(filenameBuyItem, purchase.Arguments(marketplace), txbuilder.Roles{
+		ReferenceBlockID: referenceBlockID,
+		Proposer:         buyer,
+		Payer:            marketplace,
+		Authorizers:      []cadence.Address{buyer.Address},
+	})
+}
+
+// ErrRefused is returned when the marketplace must not pay for a
+// transaction.
+var ErrRefused = errors.New("refusing to pay for transaction")
+
+// CoSigner signs the envelope of sponsored purchases as the marketplace.
+type CoSigner struct {
+	script  []byte
+	account txbuilder.AccountSigner
+	// MaxGasLimit is the highest computation limit paid for, or zero for
+	// txbuilder.DefaultGasLimit.
+	MaxGasLimit uint64
+}
+
+// NewCoSigner returns a co-signer paying with the given key of the
+// marketplace account for purchases on the given network.
+func NewCoSigner(config *flowconfig.Config, network string, marketplace txbuilder.AccountSigner) (*CoSigner, error) {
+	script, err := config.ResolveImports(templates.MustAsset(filenameBuyItem), network)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", filenameBuyItem, err)
+	}
+	return &CoSigner{script: script, account: marketplace}, nil
+}
+
+// Check returns an error if the marketplace must not pay for the
+// transaction.
+func (c *CoSigner) Check(tx *txbuilder.Transaction) error {
+	marketplace := c.account.Address
+
+	if !bytes.Equal(tx.Script, c.script) {
+		return fmt.Errorf("script is not %s", filenameBuyItem)
+	}
+	if tx.Payer != marketplace {
+		return fmt.Errorf("payer is %s, not the marketplace %s", tx.Payer, marketplace)
+	}
+	if len(tx.Authorizers) != 1 {
+		return fmt.Errorf("expected the buyer as sole authorizer, got %d authorizers", len(tx.Authorizers))
+	}
+	if tx.Authorizers[0] == marketplace {
+		return fmt.Errorf("the marketplace cannot authorize a purchase it pays for")
+	}
+
+	maxGasLimit := c.MaxGasLimit
+	if maxGasLimit == 0 {
+		maxGasLimit = txbuilder.DefaultGasLimit
+	}
+	if tx.GasLimit > maxGasLimit {
+		return fmt.Errorf("gas limit %d is greater than %d", tx.GasLimit, maxGasLimit)
+	}
+
+	if len(tx.EnvelopeSignatures) > 0 {
+		return fmt.Errorf("the envelope is already signed")
+	}
+	for _, s := range tx.PayloadSignatures {
+		if s.Address == marketplace {
+			return fmt.Errorf("the payload is signed by the marketplace")
+		}
+	}
+
+	if err := arguments.Check(filenameBuyItem, tx.Arguments); err != nil {
+		return err
+	}
+	recipient, ok := tx.Arguments[2].(cadence.Optional)
+	if !ok || recipient.Value == nil || recipient.Value != cadence.Value(marketplace) {
+		return fmt.Errorf("commission recipient is %s, not the marketplace %s", tx.Arguments[2], marketplace)
+	}
+
+	return nil
+}
+
+// CoSign checks the transaction and signs its envelope as the marketplace.
+func (c *CoSigner) CoSign(tx *txbuilder.Transaction) error {
+	if err := c.Check(tx); err != nil {
+		return fmt.Errorf("%w: %w", ErrRefused, err)
+	}
+	return tx.SignEnvelope(c.account.Address, c.account.KeyIndex, c.account.Signer)
+}
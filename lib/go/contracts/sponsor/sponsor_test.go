@@ -0,0 +1,271 @@
+package sponsor_test
+
+import (
+	"bytes"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"encoding/hex"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/sponsor"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+var (
+	marketplace = cadence.BytesToAddress([]byte{0x01})
+	buyer       = cadence.BytesToAddress([]byte{0x02})
+	seller      = cadence.BytesToAddress([]byte{0x03})
+)
+
+const privateKey = "4f3a1c5b2d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708"
+
+var purchase = sponsor.Purchase{
+	ListingResourceID: 42,
+	StorefrontAddress: seller,
+	NFTTypeIdentifier: "A.631e88ae7f1d7c20.ExampleNFT.NFT",
+}
+
+type fixture struct {
+	builder  *txbuilder.Builder
+	signer   *txbuilder.InMemorySigner
+	coSigner *sponsor.CoSigner
+}
+
+func setup(t *testing.T) fixture {
+	t.Helper()
+
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+	signer, err := txbuilder.NewInMemorySigner(privateKey, flowclient.ECDSA_P256, flowclient.SHA3_256)
+	require.NoError(t, err)
+	coSigner, err := sponsor.NewCoSigner(config, "testnet", txbuilder.AccountSigner{Address: marketplace, Signer: signer})
+	require.NoError(t, err)
+
+	return fixture{builder: txbuilder.New(config, "testnet"), signer: signer, coSigner: coSigner}
+}
+
+// signedPurchase returns a purchase signed by the buyer.
+func (f fixture) signedPurchase(t *testing.T) *txbuilder.Transaction {
+	t.Helper()
+
+	tx, err := sponsor.BuildPurchase(f.builder, purchase, flowclient.ProposalKey{Address: buyer, SequenceNumber: 3}, marketplace, flowclient.Identifier{0xaa})
+	require.NoError(t, err)
+	require.NoError(t, tx.SignPayload(buyer, 0, f.signer))
+	return tx
+}
+
+func TestBuildPurchase(t *testing.T) {
+	f := setup(t)
+
+	tx, err := sponsor.BuildPurchase(f.builder, purchase, flowclient.ProposalKey{Address: buyer, SequenceNumber: 3}, marketplace, flowclient.Identifier{0xaa})
+	require.NoError(t, err)
+	assert.Contains(t, string(tx.Script), "import NFTStorefrontV2 from 0x2d55b98eb200daef")
+	assert.Equal(t, marketplace, tx.Payer)
+	assert.Equal(t, buyer, tx.ProposalKey.Address)
+	assert.Equal(t, []cadence.Address{buyer}, tx.Authorizers)
+	assert.Equal(t, purchase.Arguments(marketplace), tx.Arguments)
+}
+
+func TestCoSign(t *testing.T) {
+	f := setup(t)
+
+	tx := f.signedPurchase(t)
+	require.NoError(t, f.coSigner.CoSign(tx))
+	require.Len(t, tx.EnvelopeSignatures, 1)
+	assert.Equal(t, marketplace, tx.EnvelopeSignatures[0].Address)
+	assert.Len(t, tx.EnvelopeSignatures[0].Signature, 64)
+}
+
+func TestCoSignRefused(t *testing.T) {
+	f := setup(t)
+
+	for name, test := range map[string]struct {
+		modify func(tx *txbuilder.Transaction)
+		err    string
+	}{
+		"other script": {
+			func(tx *txbuilder.Transaction) { tx.Script = append(tx.Script, '\n') },
+			"script is not transactions/buy_item.cdc",
+		},
+		"other commission recipient": {
+			func(tx *txbuilder.Transaction) { tx.Arguments[2] = cadence.NewOptional(buyer) },
+			"commission recipient is 0x0000000000000002, not the marketplace 0x0000000000000001",
+		},
+		"no commission recipient": {
+			func(tx *txbuilder.Transaction) { tx.Arguments[2] = cadence.NewOptional(nil) },
+			"commission recipient is nil, not the marketplace 0x0000000000000001",
+		},
+		"invalid arguments": {
+			func(tx *txbuilder.Transaction) { tx.Arguments = tx.Arguments[:2] },
+			"expected 4 arguments, got 2",
+		},
+		"other payer": {
+			func(tx *txbuilder.Transaction) { tx.Payer = buyer },
+			"payer is 0x0000000000000002, not the marketplace 0x0000000000000001",
+		},
+		"several authorizers": {
+			func(tx *txbuilder.Transaction) { tx.Authorizers = append(tx.Authorizers, seller) },
+			"expected the buyer as sole authorizer, got 2 authorizers",
+		},
+		"marketplace authorizer": {
+			func(tx *txbuilder.Transaction) { tx.Authorizers = []cadence.Address{marketplace} },
+			"the marketplace cannot authorize a purchase it pays for",
+		},
+		"gas limit": {
+			func(tx *txbuilder.Transaction) { tx.GasLimit = 10000 },
+			"gas limit 10000 is greater than 9999",
+		},
+		"signed envelope": {
+			func(tx *txbuilder.Transaction) { tx.AddEnvelopeSignature(marketplace, 0, []byte{1}) },
+			"the envelope is already signed",
+		},
+		"payload signed by marketplace": {
+			func(tx *txbuilder.Transaction) { tx.AddPayloadSignature(marketplace, 0, []byte{1}) },
+			"the payload is signed by the marketplace",
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			tx := f.signedPurchase(t)
+			test.modify(tx)
+
+			err := f.coSigner.CoSign(tx)
+			assert.ErrorIs(t, err, sponsor.ErrRefused)
+			assert.ErrorContains(t, err, test.err)
+		})
+	}
+}
+
+func signable(t *testing.T, tx *txbuilder.Transaction) sponsor.Signable {
+	t.Helper()
+
+	envelope, err := tx.EnvelopeMessage()
+	require.NoError(t, err)
+
+	var voucher sponsor.Voucher
+	voucher.Cadence = string(tx.Script)
+	voucher.RefBlock = tx.ReferenceBlockID.String()
+	voucher.ComputeLimit = tx.GasLimit
+	for i, argument := range tx.Arguments {
+		if tx.EncodedArguments != nil {
+			voucher.Arguments = append(voucher.Arguments, tx.EncodedArguments[i])
+			continue
+		}
+		encoded, err := jsoncdc.Encode(argument)
+		require.NoError(t, err)
+		voucher.Arguments = append(voucher.Arguments, encoded)
+	}
+	voucher.ProposalKey.Address = tx.ProposalKey.Address.String()
+	voucher.ProposalKey.KeyID = tx.ProposalKey.KeyIndex
+	voucher.ProposalKey.SequenceNum = tx.ProposalKey.SequenceNumber
+	voucher.Payer = tx.Payer.String()
+	voucher.Authorizers = []string{buyer.String()}
+	for _, s := range tx.PayloadSignatures {
+		sig := hex.EncodeToString(s.Signature)
+		voucher.PayloadSigs = append(voucher.PayloadSigs, sponsor.VoucherSignature{Address: s.Address.String(), KeyID: s.KeyIndex, Sig: &sig})
+	}
+	voucher.EnvelopeSigs = []sponsor.VoucherSignature{{Address: marketplace.String()}}
+
+	return sponsor.Signable{
+		Message: hex.EncodeToString(append(append([]byte{}, txbuilder.TransactionDomainTag...), envelope...)),
+		Voucher: voucher,
+	}
+}
+
+func post(t *testing.T, handler http.Handler, body any) *httptest.ResponseRecorder {
+	t.Helper()
+
+	encoded, err := json.Marshal(body)
+	require.NoError(t, err)
+	recorder := httptest.NewRecorder()
+	handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodPost, "/", bytes.NewReader(encoded)))
+	return recorder
+}
+
+// verify reports whether signature is the signature of the transaction
+// message by signer, with ECDSA_P256 and SHA3_256.
+func verify(signer *txbuilder.InMemorySigner, message, signature []byte) bool {
+	publicKey := signer.PublicKey()
+	key := &ecdsa.PublicKey{
+		Curve: elliptic.P256(),
+		X:     new(big.Int).SetBytes(publicKey[:32]),
+		Y:     new(big.Int).SetBytes(publicKey[32:]),
+	}
+	digest := sha3.Sum256(append(append([]byte{}, txbuilder.TransactionDomainTag...), message...))
+	return ecdsa.Verify(key, digest[:], new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:]))
+}
+
+func TestHandler(t *testing.T) {
+	f := setup(t)
+	handler := f.coSigner.Handler()
+
+	tx := f.signedPurchase(t)
+	response := post(t, handler, signable(t, tx))
+	require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+
+	var signature sponsor.CompositeSignature
+	require.NoError(t, json.NewDecoder(response.Body).Decode(&signature))
+	assert.Equal(t, "CompositeSignature", signature.FType)
+	assert.Equal(t, marketplace.String(), signature.Addr)
+	assert.Equal(t, uint32(0), signature.KeyID)
+	decoded, err := hex.DecodeString(signature.Signature)
+	require.NoError(t, err)
+	assert.Len(t, decoded, 64)
+
+	t.Run("FCL arguments", func(t *testing.T) {
+		// FCL puts the type before the value, unlike the Cadence encoder:
+		// the co-signer must sign the arguments as they were sent.
+		tx, err := sponsor.BuildPurchase(f.builder, purchase, flowclient.ProposalKey{Address: buyer, SequenceNumber: 3}, marketplace, flowclient.Identifier{0xaa})
+		require.NoError(t, err)
+		tx.EncodedArguments = [][]byte{
+			[]byte(`{"type":"UInt64","value":"42"}`),
+			[]byte(`{"type":"Address","value":"0x0000000000000003"}`),
+			[]byte(`{"type":"Optional","value":{"type":"Address","value":"0x0000000000000001"}}`),
+			[]byte(`{"type":"String","value":"A.631e88ae7f1d7c20.ExampleNFT.NFT"}`),
+		}
+		require.NoError(t, tx.SignPayload(buyer, 0, f.signer))
+
+		response := post(t, handler, signable(t, tx))
+		require.Equal(t, http.StatusOK, response.Code, response.Body.String())
+		require.NoError(t, json.NewDecoder(response.Body).Decode(&signature))
+		decoded, err := hex.DecodeString(signature.Signature)
+		require.NoError(t, err)
+		tx.AddEnvelopeSignature(marketplace, 0, decoded)
+
+		envelope, err := tx.EnvelopeMessage()
+		require.NoError(t, err)
+		assert.True(t, verify(f.signer, envelope, decoded))
+	})
+
+	t.Run("refused", func(t *testing.T) {
+		tx := f.signedPurchase(t)
+		tx.Arguments[2] = cadence.NewOptional(nil)
+		response := post(t, handler, signable(t, tx))
+		assert.Equal(t, http.StatusForbidden, response.Code)
+	})
+
+	t.Run("message mismatch", func(t *testing.T) {
+		s := signable(t, f.signedPurchase(t))
+		s.Message = "00"
+		response := post(t, handler, s)
+		assert.Equal(t, http.StatusBadRequest, response.Code)
+		assert.Contains(t, response.Body.String(), "message is not the envelope of the voucher")
+	})
+
+	t.Run("method", func(t *testing.T) {
+		recorder := httptest.NewRecorder()
+		handler.ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, "/", nil))
+		assert.Equal(t, http.StatusMethodNotAllowed, recorder.Code)
+	})
+}
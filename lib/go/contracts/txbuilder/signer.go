@@ -0,0 +1,143 @@
+package txbuilder
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"hash"
+	"math/big"
+	"strings"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secp256k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+)
+
+// Signer signs transaction messages with an account key. Implementations
+// may keep the key elsewhere, such as in a KMS or a hardware wallet.
+type Signer interface {
+	// Sign hashes the message with the hash algorithm of the key and returns
+	// the signature in the Flow format: r and s, each padded to 32 bytes.
+	Sign(message []byte) ([]byte, error)
+}
+
+// InMemorySigner is a Signer holding an ECDSA P-256 or secp256k1 private
+// key.
+type InMemorySigner struct {
+	sigAlgo  flowclient.SignatureAlgorithm
+	hashAlgo flowclient.HashAlgorithm
+
+	p256      *ecdsa.PrivateKey
+	secp256k1 *secp256k1.PrivateKey
+}
+
+var _ Signer = (*InMemorySigner)(nil)
+
+// NewInMemorySigner returns a signer for the given 32-byte private key,
+// hex-encoded with or without the 0x prefix.
+func NewInMemorySigner(privateKey string, sigAlgo flowclient.SignatureAlgorithm, hashAlgo flowclient.HashAlgorithm) (*InMemorySigner, error) {
+	if _, err := newHasher(hashAlgo); err != nil {
+		return nil, err
+	}
+
+	d, err := hex.DecodeString(strings.TrimPrefix(privateKey, "0x"))
+	if err != nil {
+		return nil, fmt.Errorf("invalid private key: %w", err)
+	}
+	if len(d) != 32 {
+		return nil, fmt.Errorf("invalid private key: expected 32 bytes, got %d", len(d))
+	}
+
+	signer := &InMemorySigner{sigAlgo: sigAlgo, hashAlgo: hashAlgo}
+	switch sigAlgo {
+	case flowclient.ECDSA_P256:
+		signer.p256, err = p256PrivateKey(d)
+		if err != nil {
+			return nil, err
+		}
+	case flowclient.ECDSA_secp256k1:
+		var scalar secp256k1.ModNScalar
+		if overflow := scalar.SetByteSlice(d); overflow || scalar.IsZero() {
+			return nil, fmt.Errorf("invalid private key: out of range")
+		}
+		signer.secp256k1 = secp256k1.NewPrivateKey(&scalar)
+	default:
+		return nil, fmt.Errorf("unsupported signature algorithm %s", sigAlgo)
+	}
+	return signer, nil
+}
+
+func p256PrivateKey(d []byte) (*ecdsa.PrivateKey, error) {
+	curve := elliptic.P256()
+	k := new(big.Int).SetBytes(d)
+	if k.Sign() == 0 || k.Cmp(curve.Params().N) >= 0 {
+		return nil, fmt.Errorf("invalid private key: out of range")
+	}
+
+	key := &ecdsa.PrivateKey{D: k}
+	key.Curve = curve
+	key.X, key.Y = curve.ScalarBaseMult(d)
+	return key, nil
+}
+
+// PublicKey returns the public key in the format of account keys: the X and
+// Y coordinates, each padded to 32 bytes.
+func (s *InMemorySigner) PublicKey() []byte {
+	if s.secp256k1 != nil {
+		// Drop the 0x04 prefix of the uncompressed encoding.
+		return s.secp256k1.PubKey().SerializeUncompressed()[1:]
+	}
+	return append(pad32(s.p256.X), pad32(s.p256.Y)...)
+}
+
+// AccountKey returns the account key of the signer, with the given weight,
+// for example to add it to an account.
+func (s *InMemorySigner) AccountKey(weight int) flowclient.AccountKey {
+	return flowclient.AccountKey{
+		PublicKey: s.PublicKey(),
+		SigAlgo:   s.sigAlgo,
+		HashAlgo:  s.hashAlgo,
+		Weight:    weight,
+	}
+}
+
+func (s *InMemorySigner) Sign(message []byte) ([]byte, error) {
+	h, err := newHasher(s.hashAlgo)
+	if err != nil {
+		return nil, err
+	}
+	h.Write(message)
+	digest := h.Sum(nil)
+
+	if s.secp256k1 != nil {
+		// The compact signature is a recovery code followed by r and s.
+		return secp256k1ecdsa.SignCompact(s.secp256k1, digest, false)[1:], nil
+	}
+
+	r, sig, err := ecdsa.Sign(rand.Reader, s.p256, digest)
+	if err != nil {
+		return nil, err
+	}
+	return append(pad32(r), pad32(sig)...), nil
+}
+
+func newHasher(hashAlgo flowclient.HashAlgorithm) (hash.Hash, error) {
+	switch hashAlgo {
+	case flowclient.SHA2_256:
+		return sha256.New(), nil
+	case flowclient.SHA3_256:
+		return sha3.New256(), nil
+	default:
+		return nil, fmt.Errorf("unsupported hash algorithm %s", hashAlgo)
+	}
+}
+
+func pad32(v *big.Int) []byte {
+	b := make([]byte, 32)
+	return v.FillBytes(b)
+}
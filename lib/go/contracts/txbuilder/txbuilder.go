@@ -0,0 +1,336 @@
+// Package txbuilder builds storefront transactions from the embedded
+// templates, with the roles of a Flow transaction: a proposer key, a payer
+// and authorizers, each possibly a different account.
+//
+// Transactions are encoded in the canonical RLP form of the Access API, so
+// that payload and envelope messages can be signed externally, and signed
+// with pluggable Signers.
+package txbuilder
+
+import (
+	"bytes"
+	"fmt"
+	"sort"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/rlp"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/templates"
+)
+
+// DefaultGasLimit is the computation limit of transactions built without
+// one. It is the maximum accepted by the network.
+const DefaultGasLimit uint64 = 9999
+
+// TransactionDomainTag prefixes the payload and envelope messages when they
+// are signed: the UTF-8 bytes of "FLOW-V0.0-transaction", right-padded with
+// zeros to 32 bytes.
+var TransactionDomainTag = domainTag("FLOW-V0.0-transaction")
+
+func domainTag(tag string) []byte {
+	b := make([]byte, 32)
+	copy(b, tag)
+	return b
+}
+
+// Roles are the accounts a transaction is built for.
+type Roles struct {
+	// ReferenceBlockID is the ID of a recent block. The transaction expires
+	// 600 blocks after it.
+	ReferenceBlockID flowclient.Identifier
+	// GasLimit is the computation limit, or zero for DefaultGasLimit.
+	GasLimit uint64
+	// Proposer is the key whose sequence number the transaction uses.
+	Proposer flowclient.ProposalKey
+	// Payer is the account paying the fees.
+	Payer cadence.Address
+	// Authorizers are the accounts the transaction acts on, in the order of
+	// the parameters of its prepare block.
+	Authorizers []cadence.Address
+}
+
+// Builder builds transactions from the embedded templates, with their
+// imports resolved for a network.
+type Builder struct {
+	config  *flowconfig.Config
+	network string
+}
+
+// New returns a builder for the given network of the configuration.
+func New(config *flowconfig.Config, network string) *Builder {
+	return &Builder{config: config, network: network}
+}
+
+// Build returns the unsigned transaction running the embedded template with
+// the given name, for example transactions/sell_item.cdc, with the given
+// arguments.
+func (b *Builder) Build(name string, args []cadence.Value, roles Roles) (*Transaction, error) {
+	signature, err := arguments.Lookup(name)
+	if err != nil {
+		return nil, err
+	}
+	if signature.Kind != arguments.Transaction {
+		return nil, fmt.Errorf("%s is a %s, not a transaction", name, signature.Kind)
+	}
+	if err := signature.Check(args); err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	script, err := b.config.ResolveImports(templates.MustAsset(name), b.network)
+	if err != nil {
+		return nil, fmt.Errorf("%s: %w", name, err)
+	}
+
+	return NewTransaction(script, args, roles)
+}
+
+// NewTransaction returns the unsigned transaction running the given script.
+func NewTransaction(script []byte, args []cadence.Value, roles Roles) (*Transaction, error) {
+	if roles.Proposer.Address == (cadence.Address{}) {
+		return nil, fmt.Errorf("missing proposer")
+	}
+	if roles.Payer == (cadence.Address{}) {
+		return nil, fmt.Errorf("missing payer")
+	}
+
+	gasLimit := roles.GasLimit
+	if gasLimit == 0 {
+		gasLimit = DefaultGasLimit
+	}
+
+	return &Transaction{
+		Transaction: flowclient.Transaction{
+			Script:           script,
+			Arguments:        args,
+			ReferenceBlockID: roles.ReferenceBlockID,
+			GasLimit:         gasLimit,
+			ProposalKey:      roles.Proposer,
+			Payer:            roles.Payer,
+			Authorizers:      roles.Authorizers,
+		},
+	}, nil
+}
+
+// Transaction is a transaction being signed.
+//
+// The proposer and the authorizers sign the payload, unless they are the
+// payer, then the payer signs the envelope, which covers the payload
+// signatures.
+type Transaction struct {
+	flowclient.Transaction
+
+	// EncodedArguments, if set, are the JSON-Cadence encodings of Arguments
+	// as the transaction was received. They are signed as they are, since
+	// re-encoding Arguments can order or format them differently.
+	EncodedArguments [][]byte
+}
+
+// signers returns the index of each signing account: the proposer, the payer
+// and then the authorizers, each counted once.
+func (tx *Transaction) signers() map[cadence.Address]int {
+	signers := map[cadence.Address]int{}
+	add := func(address cadence.Address) {
+		if _, ok := signers[address]; !ok {
+			signers[address] = len(signers)
+		}
+	}
+
+	add(tx.ProposalKey.Address)
+	add(tx.Payer)
+	for _, authorizer := range tx.Authorizers {
+		add(authorizer)
+	}
+	return signers
+}
+
+func (tx *Transaction) payload() ([]byte, error) {
+	encodedArguments := tx.EncodedArguments
+	if encodedArguments == nil {
+		encodedArguments = make([][]byte, len(tx.Arguments))
+		for i, argument := range tx.Arguments {
+			encoded, err := jsoncdc.Encode(argument)
+			if err != nil {
+				return nil, fmt.Errorf("argument %d: %w", i, err)
+			}
+			// The canonical form has no trailing newline.
+			encodedArguments[i] = bytes.TrimSuffix(encoded, []byte{'\n'})
+		}
+	}
+
+	authorizers := make([][]byte, len(tx.Authorizers))
+	for i, authorizer := range tx.Authorizers {
+		authorizers[i] = authorizer.Bytes()
+	}
+
+	return rlp.List(
+		rlp.Bytes(tx.Script),
+		rlp.BytesList(encodedArguments),
+		rlp.Bytes(tx.ReferenceBlockID[:]),
+		rlp.Uint(tx.GasLimit),
+		rlp.Bytes(tx.ProposalKey.Address.Bytes()),
+		rlp.Uint(uint64(tx.ProposalKey.KeyIndex)),
+		rlp.Uint(tx.ProposalKey.SequenceNumber),
+		rlp.Bytes(tx.Payer.Bytes()),
+		rlp.BytesList(authorizers),
+	), nil
+}
+
+func (tx *Transaction) signatures(signatures []flowclient.Signature) ([]byte, error) {
+	signers := tx.signers()
+
+	items := make([][]byte, len(signatures))
+	for i, s := range signatures {
+		index, ok := signers[s.Address]
+		if !ok {
+			return nil, fmt.Errorf("%s signed but is neither proposer, payer nor authorizer", s.Address)
+		}
+		items[i] = rlp.List(
+			rlp.Uint(uint64(index)),
+			rlp.Uint(uint64(s.KeyIndex)),
+			rlp.Bytes(s.Signature),
+		)
+	}
+	return rlp.List(items...), nil
+}
+
+// PayloadMessage returns the RLP-encoded payload. The proposer and the
+// authorizers sign it prefixed with TransactionDomainTag.
+func (tx *Transaction) PayloadMessage() ([]byte, error) {
+	return tx.payload()
+}
+
+// EnvelopeMessage returns the RLP-encoded payload and payload signatures.
+// The payer signs them prefixed with TransactionDomainTag.
+func (tx *Transaction) EnvelopeMessage() ([]byte, error) {
+	payload, err := tx.payload()
+	if err != nil {
+		return nil, err
+	}
+	signatures, err := tx.signatures(tx.PayloadSignatures)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.List(payload, signatures), nil
+}
+
+// Encode returns the RLP-encoded transaction with all its signatures.
+func (tx *Transaction) Encode() ([]byte, error) {
+	payload, err := tx.payload()
+	if err != nil {
+		return nil, err
+	}
+	payloadSignatures, err := tx.signatures(tx.PayloadSignatures)
+	if err != nil {
+		return nil, err
+	}
+	envelopeSignatures, err := tx.signatures(tx.EnvelopeSignatures)
+	if err != nil {
+		return nil, err
+	}
+	return rlp.List(payload, payloadSignatures, envelopeSignatures), nil
+}
+
+// ID returns the ID of the transaction, which depends on its signatures.
+func (tx *Transaction) ID() (flowclient.Identifier, error) {
+	encoded, err := tx.Encode()
+	if err != nil {
+		return flowclient.Identifier{}, err
+	}
+	return flowclient.Identifier(sha3.Sum256(encoded)), nil
+}
+
+// AddPayloadSignature adds a signature of the payload message, for example
+// one produced externally.
+func (tx *Transaction) AddPayloadSignature(address cadence.Address, keyIndex uint32, signature []byte) {
+	tx.PayloadSignatures = tx.addSignature(tx.PayloadSignatures, address, keyIndex, signature)
+}
+
+// AddEnvelopeSignature adds a signature of the envelope message, for
+// example one produced externally.
+func (tx *Transaction) AddEnvelopeSignature(address cadence.Address, keyIndex uint32, signature []byte) {
+	tx.EnvelopeSignatures = tx.addSignature(tx.EnvelopeSignatures, address, keyIndex, signature)
+}
+
+// addSignature adds a signature, keeping the signatures sorted by signer
+// index and key index as the canonical form requires.
+func (tx *Transaction) addSignature(signatures []flowclient.Signature, address cadence.Address, keyIndex uint32, signature []byte) []flowclient.Signature {
+	signatures = append(signatures, flowclient.Signature{
+		Address:   address,
+		KeyIndex:  keyIndex,
+		Signature: signature,
+	})
+
+	signers := tx.signers()
+	sort.SliceStable(signatures, func(i, j int) bool {
+		a, b := signatures[i], signatures[j]
+		if signers[a.Address] != signers[b.Address] {
+			return signers[a.Address] < signers[b.Address]
+		}
+		return a.KeyIndex < b.KeyIndex
+	})
+	return signatures
+}
+
+// SignPayload signs the payload with the given account key.
+func (tx *Transaction) SignPayload(address cadence.Address, keyIndex uint32, signer Signer) error {
+	message, err := tx.PayloadMessage()
+	if err != nil {
+		return err
+	}
+	signature, err := signer.Sign(append(append([]byte{}, TransactionDomainTag...), message...))
+	if err != nil {
+		return fmt.Errorf("failed to sign payload as %s: %w", address, err)
+	}
+	tx.AddPayloadSignature(address, keyIndex, signature)
+	return nil
+}
+
+// SignEnvelope signs the envelope with the given account key. It must be
+// called once all payload signatures are added.
+func (tx *Transaction) SignEnvelope(address cadence.Address, keyIndex uint32, signer Signer) error {
+	message, err := tx.EnvelopeMessage()
+	if err != nil {
+		return err
+	}
+	signature, err := signer.Sign(append(append([]byte{}, TransactionDomainTag...), message...))
+	if err != nil {
+		return fmt.Errorf("failed to sign envelope as %s: %w", address, err)
+	}
+	tx.AddEnvelopeSignature(address, keyIndex, signature)
+	return nil
+}
+
+// AccountSigner is a Signer for a key of an account.
+type AccountSigner struct {
+	Address  cadence.Address
+	KeyIndex uint32
+	Signer   Signer
+}
+
+// Sign signs the transaction with the given account keys: the payload with
+// the keys of accounts other than the payer, then the envelope with the keys
+// of the payer.
+func (tx *Transaction) Sign(signers ...AccountSigner) error {
+	for _, s := range signers {
+		if s.Address == tx.Payer {
+			continue
+		}
+		if err := tx.SignPayload(s.Address, s.KeyIndex, s.Signer); err != nil {
+			return err
+		}
+	}
+	for _, s := range signers {
+		if s.Address != tx.Payer {
+			continue
+		}
+		if err := tx.SignEnvelope(s.Address, s.KeyIndex, s.Signer); err != nil {
+			return err
+		}
+	}
+	return nil
+}
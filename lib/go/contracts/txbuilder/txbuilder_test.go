@@ -0,0 +1,186 @@
+package txbuilder_test
+
+import (
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/sha256"
+	"encoding/hex"
+	"math/big"
+	"testing"
+
+	"github.com/decred/dcrd/dcrec/secp256k1/v4"
+	secp256k1ecdsa "github.com/decred/dcrd/dcrec/secp256k1/v4/ecdsa"
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"golang.org/x/crypto/sha3"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+)
+
+var (
+	payer  = cadence.BytesToAddress([]byte{0x01})
+	seller = cadence.BytesToAddress([]byte{0x02})
+)
+
+const privateKey = "4f3a1c5b2d6e7f8091a2b3c4d5e6f708192a3b4c5d6e7f8091a2b3c4d5e6f708"
+
+func roles() txbuilder.Roles {
+	return txbuilder.Roles{
+		ReferenceBlockID: flowclient.Identifier{0xaa, 0xbb},
+		Proposer:         flowclient.ProposalKey{Address: seller, KeyIndex: 1, SequenceNumber: 7},
+		Payer:            payer,
+		Authorizers:      []cadence.Address{seller},
+	}
+}
+
+// TestEncoding checks the encoding against the one of the Flow Go SDK.
+func TestEncoding(t *testing.T) {
+	tx, err := txbuilder.NewTransaction(
+		[]byte("transaction(listingResourceID: UInt64) { prepare(acct: &Account) {} }"),
+		[]cadence.Value{cadence.NewUInt64(42)},
+		roles(),
+	)
+	require.NoError(t, err)
+
+	payload, err := tx.PayloadMessage()
+	require.NoError(t, err)
+	assert.Equal(t, "f8a9b8457472616e73616374696f6e286c697374696e675265736f7572636549443a2055496e74363429207b207072657061726528616363743a20264163636f756e7429207b7d207ddf9e7b2276616c7565223a223432222c2274797065223a2255496e743634227da0aabb00000000000000000000000000000000000000000000000000000000000082270f8800000000000000020107880000000000000001c9880000000000000002", hex.EncodeToString(payload))
+
+	tx.AddPayloadSignature(seller, 1, []byte{1, 2, 3})
+	envelope, err := tx.EnvelopeMessage()
+	require.NoError(t, err)
+	assert.Equal(t, "f8b3"+hex.EncodeToString(payload)+"c7c6800183010203", hex.EncodeToString(envelope))
+
+	tx.AddEnvelopeSignature(payer, 0, []byte{4, 5, 6})
+	id, err := tx.ID()
+	require.NoError(t, err)
+	assert.Equal(t, "3bbc23a94f09186cfbd90888b437fbc6e1f1511600feed0525adcf8387c19e8e", id.String())
+}
+
+func TestBuild(t *testing.T) {
+	config, err := flowconfig.Load("../../../../flow.json")
+	require.NoError(t, err)
+	builder := txbuilder.New(config, "testnet")
+
+	tx, err := builder.Build("transactions/remove_item.cdc", []cadence.Value{cadence.NewUInt64(42)}, roles())
+	require.NoError(t, err)
+	assert.Contains(t, string(tx.Script), "import NFTStorefrontV2 from 0x2d55b98eb200daef")
+	assert.Equal(t, txbuilder.DefaultGasLimit, tx.GasLimit)
+	assert.Equal(t, roles().Proposer, tx.ProposalKey)
+	assert.Equal(t, payer, tx.Payer)
+	assert.Equal(t, []cadence.Address{seller}, tx.Authorizers)
+
+	_, err = builder.Build("transactions/remove_item.cdc", []cadence.Value{cadence.String("42")}, roles())
+	assert.EqualError(t, err, "transactions/remove_item.cdc: argument 0 (listingResourceID: UInt64): expected UInt64, got String")
+
+	_, err = builder.Build("scripts/read_listing_details.cdc", nil, roles())
+	assert.EqualError(t, err, "scripts/read_listing_details.cdc is a script, not a transaction")
+
+	withoutPayer := roles()
+	withoutPayer.Payer = cadence.Address{}
+	_, err = builder.Build("transactions/remove_item.cdc", []cadence.Value{cadence.NewUInt64(42)}, withoutPayer)
+	assert.EqualError(t, err, "missing payer")
+}
+
+func hashMessage(t *testing.T, hashAlgo flowclient.HashAlgorithm, message []byte) []byte {
+	t.Helper()
+
+	message = append(append([]byte{}, txbuilder.TransactionDomainTag...), message...)
+	switch hashAlgo {
+	case flowclient.SHA2_256:
+		digest := sha256.Sum256(message)
+		return digest[:]
+	case flowclient.SHA3_256:
+		digest := sha3.Sum256(message)
+		return digest[:]
+	}
+	t.Fatalf("unexpected hash algorithm %s", hashAlgo)
+	return nil
+}
+
+func verify(t *testing.T, signer *txbuilder.InMemorySigner, sigAlgo flowclient.SignatureAlgorithm, digest, signature []byte) bool {
+	t.Helper()
+
+	require.Len(t, signature, 64)
+	publicKey := signer.PublicKey()
+	require.Len(t, publicKey, 64)
+
+	switch sigAlgo {
+	case flowclient.ECDSA_P256:
+		key := &ecdsa.PublicKey{
+			Curve: elliptic.P256(),
+			X:     new(big.Int).SetBytes(publicKey[:32]),
+			Y:     new(big.Int).SetBytes(publicKey[32:]),
+		}
+		return ecdsa.Verify(key, digest, new(big.Int).SetBytes(signature[:32]), new(big.Int).SetBytes(signature[32:]))
+	case flowclient.ECDSA_secp256k1:
+		key, err := secp256k1.ParsePubKey(append([]byte{0x04}, publicKey...))
+		require.NoError(t, err)
+		var r, s secp256k1.ModNScalar
+		r.SetByteSlice(signature[:32])
+		s.SetByteSlice(signature[32:])
+		return secp256k1ecdsa.NewSignature(&r, &s).Verify(digest, key)
+	}
+	t.Fatalf("unexpected signature algorithm %s", sigAlgo)
+	return false
+}
+
+func TestSign(t *testing.T) {
+	for _, algorithms := range []struct {
+		sigAlgo  flowclient.SignatureAlgorithm
+		hashAlgo flowclient.HashAlgorithm
+	}{
+		{flowclient.ECDSA_P256, flowclient.SHA3_256},
+		{flowclient.ECDSA_P256, flowclient.SHA2_256},
+		{flowclient.ECDSA_secp256k1, flowclient.SHA3_256},
+		{flowclient.ECDSA_secp256k1, flowclient.SHA2_256},
+	} {
+		t.Run(string(algorithms.sigAlgo)+"/"+string(algorithms.hashAlgo), func(t *testing.T) {
+			signer, err := txbuilder.NewInMemorySigner(privateKey, algorithms.sigAlgo, algorithms.hashAlgo)
+			require.NoError(t, err)
+
+			tx, err := txbuilder.NewTransaction([]byte("transaction { prepare(acct: &Account) {} }"), nil, roles())
+			require.NoError(t, err)
+
+			require.NoError(t, tx.Sign(
+				txbuilder.AccountSigner{Address: payer, Signer: signer},
+				txbuilder.AccountSigner{Address: seller, KeyIndex: 1, Signer: signer},
+			))
+
+			require.Len(t, tx.PayloadSignatures, 1)
+			assert.Equal(t, seller, tx.PayloadSignatures[0].Address)
+			payload, err := tx.PayloadMessage()
+			require.NoError(t, err)
+			assert.True(t, verify(t, signer, algorithms.sigAlgo, hashMessage(t, algorithms.hashAlgo, payload), tx.PayloadSignatures[0].Signature))
+
+			require.Len(t, tx.EnvelopeSignatures, 1)
+			assert.Equal(t, payer, tx.EnvelopeSignatures[0].Address)
+			envelope, err := tx.EnvelopeMessage()
+			require.NoError(t, err)
+			assert.True(t, verify(t, signer, algorithms.sigAlgo, hashMessage(t, algorithms.hashAlgo, envelope), tx.EnvelopeSignatures[0].Signature))
+		})
+	}
+}
+
+func TestInMemorySignerErrors(t *testing.T) {
+	_, err := txbuilder.NewInMemorySigner("abcd", flowclient.ECDSA_P256, flowclient.SHA3_256)
+	assert.EqualError(t, err, "invalid private key: expected 32 bytes, got 2")
+
+	_, err = txbuilder.NewInMemorySigner(privateKey, "BLS_BLS12_381", flowclient.SHA3_256)
+	assert.EqualError(t, err, "unsupported signature algorithm BLS_BLS12_381")
+
+	_, err = txbuilder.NewInMemorySigner(privateKey, flowclient.ECDSA_P256, "KMAC128")
+	assert.EqualError(t, err, "unsupported hash algorithm KMAC128")
+}
+
+func TestSignatureOfUnknownAccount(t *testing.T) {
+	tx, err := txbuilder.NewTransaction([]byte("transaction {}"), nil, roles())
+	require.NoError(t, err)
+
+	tx.AddPayloadSignature(cadence.BytesToAddress([]byte{0x03}), 0, []byte{1})
+	_, err = tx.EnvelopeMessage()
+	assert.EqualError(t, err, "0x0000000000000003 signed but is neither proposer, payer nor authorizer")
+}
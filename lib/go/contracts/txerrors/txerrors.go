@@ -0,0 +1,260 @@
+// Package txerrors classifies the failures of storefront transactions.
+//
+// NFTStorefrontV2 and the transaction templates fail with panics and
+// conditions whose messages are prefixed with the failing function, such as
+// "NFTStorefrontV2.Listing.purchase: The Listing has already been
+// purchased". Classify turns the error message of a failed transaction into
+// an *Error wrapping one of the sentinel errors below, with the listing ID,
+// price and types the message mentions, so that callers can branch with
+// errors.Is and errors.As instead of matching strings.
+package txerrors
+
+import (
+	"errors"
+	"regexp"
+	"strconv"
+	"strings"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+)
+
+var (
+	// ErrAlreadyPurchased is returned when the listing was purchased by an
+	// earlier transaction.
+	ErrAlreadyPurchased = errors.New("listing already purchased")
+	// ErrExpired is returned when the listing expired.
+	ErrExpired = errors.New("listing expired")
+	// ErrWrongVaultType is returned when the payment is not in the token
+	// the listing is sold for.
+	ErrWrongVaultType = errors.New("wrong payment vault type")
+	// ErrPriceMismatch is returned when the payment is not the price of
+	// the listing, for example because the seller changed it.
+	ErrPriceMismatch = errors.New("payment does not match the price")
+	// ErrCommissionNotAuthorised is returned when the commission recipient
+	// is not one of the marketplaces the listing allows.
+	ErrCommissionNotAuthorised = errors.New("commission recipient not authorised")
+	// ErrCommissionRecipientRequired is returned when a listing with a
+	// commission is purchased without a commission recipient.
+	ErrCommissionRecipientRequired = errors.New("commission recipient required")
+	// ErrInvalidCommissionRecipient is returned when the commission
+	// recipient cannot receive the payment token.
+	ErrInvalidCommissionRecipient = errors.New("invalid commission recipient")
+	// ErrListingNotFound is returned when the storefront has no listing with
+	// the given ID.
+	ErrListingNotFound = errors.New("listing not found")
+	// ErrStorefrontNotFound is returned when the account publishes no
+	// storefront.
+	ErrStorefrontNotFound = errors.New("storefront not found")
+	// ErrNFTMismatch is returned when the provider of a listing no longer
+	// holds the listed NFT, making it a ghost listing.
+	ErrNFTMismatch = errors.New("nft does not match the listing")
+	// ErrNFTMissing is returned when an NFT being listed is not in the
+	// collection of the seller.
+	ErrNFTMissing = errors.New("nft missing from collection")
+	// ErrNotPurchased is returned when cleaning up a purchased listing that
+	// was not purchased.
+	ErrNotPurchased = errors.New("listing not purchased")
+	// ErrNotGhostListing is returned when cleaning up a ghost listing whose
+	// NFT is still available.
+	ErrNotGhostListing = errors.New("not a ghost listing")
+	// ErrNoPaymentReceivers is returned when no sale cut receiver of the
+	// listing can receive the payment.
+	ErrNoPaymentReceivers = errors.New("no valid payment receivers")
+	// ErrZeroPrice is returned when listing for a zero price.
+	ErrZeroPrice = errors.New("zero price")
+	// ErrExpiryInPast is returned when listing with an expiry in the past.
+	ErrExpiryInPast = errors.New("expiry in the past")
+	// ErrComputationLimitExceeded is returned when the transaction ran out
+	// of computation before completing, for example because it lists too
+	// many NFTs at once.
+	ErrComputationLimitExceeded = errors.New("computation limit exceeded")
+)
+
+// Error is a classified transaction failure. It unwraps to its Kind.
+type Error struct {
+	// Kind is one of the sentinel errors of the package.
+	Kind error
+	// Function is the contract function that failed, such as
+	// NFTStorefrontV2.Listing.purchase, or empty if the transaction itself
+	// failed.
+	Function string
+	// Message is the panic or condition message, including Function.
+	Message string
+
+	// The fields below are set when the message mentions them.
+
+	ListingResourceID *uint64
+	NFTID             *uint64
+	StorefrontAddress *cadence.Address
+	// ExpectedPrice is the price of the listing.
+	ExpectedPrice *cadence.UFix64
+	// ProvidedType and ExpectedType are the type identifiers of the payment
+	// vault or NFT provided and of the one the listing expects.
+	ProvidedType string
+	ExpectedType string
+}
+
+func (e *Error) Error() string {
+	return e.Message
+}
+
+func (e *Error) Unwrap() error {
+	return e.Kind
+}
+
+type pattern struct {
+	kind       error
+	expression *regexp.Regexp
+}
+
+// patterns match the messages of NFTStorefrontV2.cdc and of the transaction
+// templates. Their named groups set the fields of the same name of Error.
+var patterns = []pattern{
+	{
+		kind:       ErrAlreadyPurchased,
+		expression: regexp.MustCompile(`The Listing has already been purchased|Cannot cleanup listing with id (?P<listing>\d+) because it is already purchased`),
+	},
+	{
+		kind:       ErrExpired,
+		expression: regexp.MustCompile(`The Listing is expired`),
+	},
+	{
+		// The contract labels the NFT ID as the listing ID, and omits the
+		// closing bracket after the payment type.
+		kind:       ErrWrongVaultType,
+		expression: regexp.MustCompile(`Cannot purchase the listing with ID (?P<nft>\d+)\. The fungible token used as payment <(?P<provided>[^\s>]+?)>? is not the requested type <(?P<expected>[^\s>]+?)>?\.?$`),
+	},
+	{
+		kind:       ErrPriceMismatch,
+		expression: regexp.MustCompile(`Cannot purchase the listing with ID (?P<nft>\d+)\. The payment vault does not contain the requested price of (?P<price>\d+\.\d+?)\.?$`),
+	},
+	{
+		kind:       ErrCommissionNotAuthorised,
+		expression: regexp.MustCompile(`not authorised to receive the commission`),
+	},
+	{
+		kind:       ErrCommissionRecipientRequired,
+		expression: regexp.MustCompile(`Commission recipient can't be nil|Commission recipient can not be empty`),
+	},
+	{
+		kind:       ErrInvalidCommissionRecipient,
+		expression: regexp.MustCompile(`commission recipient capability is invalid|commission recipient type does not have a valid type|Commission Recipient doesn't have a receiving capability|Unable to borrow the commission receiver`),
+	},
+	{
+		kind:       ErrListingNotFound,
+		expression: regexp.MustCompile(`Cannot remove listing with ID (?P<listing>\d+)|Could not get a listing with ID (?P<listing>\d+) from the storefront in account (?P<storefront>0x[0-9a-fA-F]+)|Cannot cleanup non-existent listing with ID (?P<listing>\d+)|Could not find listing with id (?P<listing>\d+)`),
+	},
+	{
+		kind:       ErrStorefrontNotFound,
+		expression: regexp.MustCompile(`Could not get a Storefront from the provided address (?P<storefront>0x[0-9a-fA-F]+)|does not store an NFT Storefront V2 object`),
+	},
+	{
+		kind:       ErrNFTMismatch,
+		expression: regexp.MustCompile(`The type of the NFT provided by the seller <(?P<provided>[^>]+)> does not match the type in the listing details <(?P<expected>[^>]+)>|The ID \d+ of the NFT provided by the seller does not match the ID (?P<nft>\d+)`),
+	},
+	{
+		kind:       ErrNFTMissing,
+		expression: regexp.MustCompile(`NFT with ID (?P<nft>\d+) does not exist in the provided collection|Could not borrow a reference to the desired NFT with ID (?P<nft>\d+)`),
+	},
+	{
+		kind:       ErrNotPurchased,
+		expression: regexp.MustCompile(`Cannot cleanup listing with ID (?P<listing>\d+) because it has not been purchased yet`),
+	},
+	{
+		kind:       ErrNotGhostListing,
+		expression: regexp.MustCompile(`Cannot cleanup listing with id (?P<listing>\d+) because it is not a ghost listing`),
+	},
+	{
+		kind:       ErrNoPaymentReceivers,
+		expression: regexp.MustCompile(`No valid payment receivers`),
+	},
+	{
+		kind:       ErrZeroPrice,
+		expression: regexp.MustCompile(`The Listing must have non-zero price`),
+	},
+	{
+		kind:       ErrExpiryInPast,
+		expression: regexp.MustCompile(`The given expiry timestamp \d+ must be in the future`),
+	},
+	{
+		// Reported by the execution environment rather than by Cadence, so
+		// the message has no "error: panic" line.
+		kind:       ErrComputationLimitExceeded,
+		expression: regexp.MustCompile(`computation exceeds limit \(\d+\)`),
+	},
+}
+
+// failure matches the line of a Cadence runtime error holding the message.
+var failure = regexp.MustCompile(`(?m)error: (?:panic|pre-condition failed|post-condition failed|assertion failed): (.*?)\s*$`)
+
+var function = regexp.MustCompile(`^(NFTStorefrontV2\.[\w.]+): `)
+
+// Classify returns the classified failure of the given transaction error
+// message, or nil if it is not a known storefront failure.
+func Classify(message string) *Error {
+	if match := failure.FindStringSubmatch(message); match != nil {
+		message = match[1]
+	} else {
+		message = strings.TrimSpace(message)
+	}
+
+	for _, p := range patterns {
+		match := p.expression.FindStringSubmatch(message)
+		if match == nil {
+			continue
+		}
+
+		e := &Error{Kind: p.kind, Message: message}
+		if m := function.FindStringSubmatch(message); m != nil {
+			e.Function = m[1]
+		}
+		for i, name := range p.expression.SubexpNames() {
+			if name != "" && match[i] != "" {
+				e.set(name, match[i])
+			}
+		}
+		return e
+	}
+	return nil
+}
+
+func (e *Error) set(name, value string) {
+	switch name {
+	case "listing":
+		if id, err := strconv.ParseUint(value, 10, 64); err == nil {
+			e.ListingResourceID = &id
+		}
+	case "nft":
+		if id, err := strconv.ParseUint(value, 10, 64); err == nil {
+			e.NFTID = &id
+		}
+	case "storefront":
+		if address, err := flowclient.HexToAddress(value); err == nil {
+			e.StorefrontAddress = &address
+		}
+	case "price":
+		if price, err := cadence.NewUFix64(value); err == nil {
+			e.ExpectedPrice = &price
+		}
+	case "provided":
+		e.ProvidedType = value
+	case "expected":
+		e.ExpectedType = value
+	}
+}
+
+// FromResult returns the error the transaction failed with: an *Error if
+// Classify recognises it, the unclassified error otherwise, or nil if the
+// transaction succeeded.
+func FromResult(result *flowclient.TransactionResult) error {
+	err := result.Err()
+	if err == nil {
+		return nil
+	}
+	if e := Classify(result.ErrorMessage); e != nil {
+		return e
+	}
+	return err
+}
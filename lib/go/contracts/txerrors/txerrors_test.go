@@ -0,0 +1,171 @@
+package txerrors_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txerrors"
+)
+
+// runtimeError wraps a message the way the Access API reports a failed
+// transaction.
+func runtimeError(kind, message string) string {
+	return "[Error Code: 1101] error caused by: 1 error occurred:\n" +
+		"\t* transaction execute failed: [Error Code: 1101] cadence runtime error: Execution failed:\n" +
+		"error: " + kind + ": " + message + "\n" +
+		"   --> f8d6e0586b0a20c7.NFTStorefrontV2:394:16\n" +
+		"    |\n" +
+		"394 |                 self.details.purchased == false:\n"
+}
+
+func uint64Pointer(v uint64) *uint64 {
+	return &v
+}
+
+func TestClassify(t *testing.T) {
+	price, err := cadence.NewUFix64("10.00000000")
+	require.NoError(t, err)
+	storefront := cadence.BytesToAddress([]byte{0x01, 0xcf})
+
+	for name, test := range map[string]struct {
+		message  string
+		expected *txerrors.Error
+	}{
+		"already purchased": {
+			runtimeError("pre-condition failed", "NFTStorefrontV2.Listing.purchase: The Listing has already been purchased"),
+			&txerrors.Error{
+				Kind:     txerrors.ErrAlreadyPurchased,
+				Function: "NFTStorefrontV2.Listing.purchase",
+				Message:  "NFTStorefrontV2.Listing.purchase: The Listing has already been purchased",
+			},
+		},
+		"expired": {
+			runtimeError("pre-condition failed", "NFTStorefrontV2.Listing.purchase: Cannot purchase the listing! The Listing is expired"),
+			&txerrors.Error{
+				Kind:     txerrors.ErrExpired,
+				Function: "NFTStorefrontV2.Listing.purchase",
+				Message:  "NFTStorefrontV2.Listing.purchase: Cannot purchase the listing! The Listing is expired",
+			},
+		},
+		"wrong vault type": {
+			runtimeError("pre-condition failed", "NFTStorefrontV2.Listing.purchase: Cannot purchase the listing with ID 7. The fungible token used as payment <A.0ae53cb6e3f42a79.FlowToken.Vault is not the requested type <A.f8d6e0586b0a20c7.ExampleToken.Vault."),
+			&txerrors.Error{
+				Kind:         txerrors.ErrWrongVaultType,
+				Function:     "NFTStorefrontV2.Listing.purchase",
+				Message:      "NFTStorefrontV2.Listing.purchase: Cannot purchase the listing with ID 7. The fungible token used as payment <A.0ae53cb6e3f42a79.FlowToken.Vault is not the requested type <A.f8d6e0586b0a20c7.ExampleToken.Vault.",
+				NFTID:        uint64Pointer(7),
+				ProvidedType: "A.0ae53cb6e3f42a79.FlowToken.Vault",
+				ExpectedType: "A.f8d6e0586b0a20c7.ExampleToken.Vault",
+			},
+		},
+		"price mismatch": {
+			runtimeError("pre-condition failed", "NFTStorefrontV2.Listing.purchase: Cannot purchase the listing with ID 7. The payment vault does not contain the requested price of 10.00000000."),
+			&txerrors.Error{
+				Kind:          txerrors.ErrPriceMismatch,
+				Function:      "NFTStorefrontV2.Listing.purchase",
+				Message:       "NFTStorefrontV2.Listing.purchase: Cannot purchase the listing with ID 7. The payment vault does not contain the requested price of 10.00000000.",
+				NFTID:         uint64Pointer(7),
+				ExpectedPrice: &price,
+			},
+		},
+		"commission not authorised": {
+			runtimeError("assertion failed", "NFTStorefrontV2.Listing.purchase: Cannot purchase! A given recipient is not authorised to receive the commission!"),
+			&txerrors.Error{
+				Kind:     txerrors.ErrCommissionNotAuthorised,
+				Function: "NFTStorefrontV2.Listing.purchase",
+				Message:  "NFTStorefrontV2.Listing.purchase: Cannot purchase! A given recipient is not authorised to receive the commission!",
+			},
+		},
+		"commission recipient required": {
+			runtimeError("panic", "Commission recipient can not be empty when commission amount is non zero"),
+			&txerrors.Error{
+				Kind:    txerrors.ErrCommissionRecipientRequired,
+				Message: "Commission recipient can not be empty when commission amount is non zero",
+			},
+		},
+		"remove missing listing": {
+			runtimeError("panic", "NFTStorefrontV2.Storefront.removeListing: Cannot remove listing with ID 42 because it doesn't exist in the storefront!"),
+			&txerrors.Error{
+				Kind:              txerrors.ErrListingNotFound,
+				Function:          "NFTStorefrontV2.Storefront.removeListing",
+				Message:           "NFTStorefrontV2.Storefront.removeListing: Cannot remove listing with ID 42 because it doesn't exist in the storefront!",
+				ListingResourceID: uint64Pointer(42),
+			},
+		},
+		"buy missing listing": {
+			runtimeError("panic", "Could not get a listing with ID 42 from the storefront in account 0x00000000000001cf"),
+			&txerrors.Error{
+				Kind:              txerrors.ErrListingNotFound,
+				Message:           "Could not get a listing with ID 42 from the storefront in account 0x00000000000001cf",
+				ListingResourceID: uint64Pointer(42),
+				StorefrontAddress: &storefront,
+			},
+		},
+		"ghost listing already purchased": {
+			runtimeError("assertion failed", "NFTStorefrontV2.Storefront.cleanupGhostListings: Cannot cleanup listing with id 42 because it is already purchased!"),
+			&txerrors.Error{
+				Kind:              txerrors.ErrAlreadyPurchased,
+				Function:          "NFTStorefrontV2.Storefront.cleanupGhostListings",
+				Message:           "NFTStorefrontV2.Storefront.cleanupGhostListings: Cannot cleanup listing with id 42 because it is already purchased!",
+				ListingResourceID: uint64Pointer(42),
+			},
+		},
+		"nft mismatch": {
+			runtimeError("assertion failed", "NFTStorefrontV2.Listing.purchase: The ID 8 of the NFT provided by the seller does not match the ID 7 in the listing details!"),
+			&txerrors.Error{
+				Kind:     txerrors.ErrNFTMismatch,
+				Function: "NFTStorefrontV2.Listing.purchase",
+				Message:  "NFTStorefrontV2.Listing.purchase: The ID 8 of the NFT provided by the seller does not match the ID 7 in the listing details!",
+				NFTID:    uint64Pointer(7),
+			},
+		},
+		"not purchased": {
+			"NFTStorefrontV2.Storefront.cleanupPurchasedListings: Cannot cleanup listing with ID 42 because it has not been purchased yet!",
+			&txerrors.Error{
+				Kind:              txerrors.ErrNotPurchased,
+				Function:          "NFTStorefrontV2.Storefront.cleanupPurchasedListings",
+				Message:           "NFTStorefrontV2.Storefront.cleanupPurchasedListings: Cannot cleanup listing with ID 42 because it has not been purchased yet!",
+				ListingResourceID: uint64Pointer(42),
+			},
+		},
+		"computation limit exceeded": {
+			"[Error Code: 1110] computation exceeds limit (9999)",
+			&txerrors.Error{
+				Kind:    txerrors.ErrComputationLimitExceeded,
+				Message: "[Error Code: 1110] computation exceeds limit (9999)",
+			},
+		},
+		"unknown": {
+			runtimeError("panic", "something else"),
+			nil,
+		},
+	} {
+		t.Run(name, func(t *testing.T) {
+			assert.Equal(t, test.expected, txerrors.Classify(test.message))
+		})
+	}
+}
+
+func TestFromResult(t *testing.T) {
+	assert.NoError(t, txerrors.FromResult(&flowclient.TransactionResult{Status: flowclient.StatusSealed}))
+
+	err := txerrors.FromResult(&flowclient.TransactionResult{
+		Status:       flowclient.StatusSealed,
+		ErrorMessage: runtimeError("pre-condition failed", "NFTStorefrontV2.Listing.purchase: Cannot purchase the listing! The Listing is expired"),
+	})
+	assert.ErrorIs(t, err, txerrors.ErrExpired)
+	assert.EqualError(t, err, "NFTStorefrontV2.Listing.purchase: Cannot purchase the listing! The Listing is expired")
+
+	var e *txerrors.Error
+	require.True(t, errors.As(err, &e))
+	assert.Equal(t, "NFTStorefrontV2.Listing.purchase", e.Function)
+
+	err = txerrors.FromResult(&flowclient.TransactionResult{Status: flowclient.StatusSealed, ErrorMessage: "out of gas"})
+	assert.EqualError(t, err, "out of gas")
+	assert.False(t, errors.As(err, &e))
+}
@@ -0,0 +1,158 @@
+package unpaid
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/bits"
+	"net/http"
+	"sort"
+	"sync"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+)
+
+// Balance is the amount a receiver was not paid in a token.
+type Balance struct {
+	// TokenType is the type identifier of the vault, or the empty string for
+	// the alerts of unknown purchases.
+	TokenType string
+	Amount    cadence.UFix64
+	// Count is the number of unpaid sale cuts.
+	Count int
+}
+
+// Ledger is a Sink keeping the unpaid amounts of each receiver. It is safe
+// for concurrent use.
+type Ledger struct {
+	mu       sync.Mutex
+	balances map[cadence.Address]map[string]*Balance
+	alerts   map[cadence.Address][]Alert
+}
+
+// NewLedger returns an empty ledger.
+func NewLedger() *Ledger {
+	return &Ledger{
+		balances: map[cadence.Address]map[string]*Balance{},
+		alerts:   map[cadence.Address][]Alert{},
+	}
+}
+
+// Send records the alert.
+func (l *Ledger) Send(_ context.Context, alert Alert) error {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	balances, ok := l.balances[alert.Receiver]
+	if !ok {
+		balances = map[string]*Balance{}
+		l.balances[alert.Receiver] = balances
+	}
+	balance, ok := balances[alert.TokenType()]
+	if !ok {
+		balance = &Balance{TokenType: alert.TokenType()}
+		balances[balance.TokenType] = balance
+	}
+
+	amount, overflow := bits.Add64(uint64(balance.Amount), uint64(alert.Amount), 0)
+	if overflow != 0 {
+		return fmt.Errorf("unpaid amount of %s overflows UFix64", alert.Receiver)
+	}
+	balance.Amount = cadence.UFix64(amount)
+	balance.Count++
+	l.alerts[alert.Receiver] = append(l.alerts[alert.Receiver], alert)
+	return nil
+}
+
+// Receivers returns the receivers with unpaid sale cuts, in increasing order.
+func (l *Ledger) Receivers() []cadence.Address {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	receivers := make([]cadence.Address, 0, len(l.balances))
+	for receiver := range l.balances {
+		receivers = append(receivers, receiver)
+	}
+	sort.Slice(receivers, func(i, j int) bool { return receivers[i].Hex() < receivers[j].Hex() })
+	return receivers
+}
+
+// Balances returns the unpaid amounts of a receiver, ordered by token type.
+func (l *Ledger) Balances(receiver cadence.Address) []Balance {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	balances := make([]Balance, 0, len(l.balances[receiver]))
+	for _, balance := range l.balances[receiver] {
+		balances = append(balances, *balance)
+	}
+	sort.Slice(balances, func(i, j int) bool { return balances[i].TokenType < balances[j].TokenType })
+	return balances
+}
+
+// Alerts returns the alerts of a receiver, in the order they were recorded.
+func (l *Ledger) Alerts(receiver cadence.Address) []Alert {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+
+	return append([]Alert(nil), l.alerts[receiver]...)
+}
+
+// Handler returns an HTTP handler serving the balances and alerts of the
+// receiver given by the receiver query parameter as JSON, or the balances of
+// every receiver without it.
+func (l *Ledger) Handler() http.Handler {
+	type balanceJSON struct {
+		TokenType string `json:"tokenType"`
+		Amount    string `json:"amount"`
+		Count     int    `json:"count"`
+	}
+	type receiverJSON struct {
+		Receiver string        `json:"receiver"`
+		Balances []balanceJSON `json:"balances"`
+		Alerts   []alertJSON   `json:"alerts,omitempty"`
+	}
+
+	receiverJSONOf := func(receiver cadence.Address, withAlerts bool) receiverJSON {
+		result := receiverJSON{Receiver: receiver.String(), Balances: []balanceJSON{}}
+		for _, balance := range l.Balances(receiver) {
+			result.Balances = append(result.Balances, balanceJSON{balance.TokenType, balance.Amount.String(), balance.Count})
+		}
+		if withAlerts {
+			result.Alerts = []alertJSON{}
+			for _, alert := range l.Alerts(receiver) {
+				result.Alerts = append(result.Alerts, newAlertJSON(alert))
+			}
+		}
+		return result
+	}
+
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodGet {
+			w.Header().Set("Allow", http.MethodGet)
+			http.Error(w, "method not allowed", http.StatusMethodNotAllowed)
+			return
+		}
+
+		var result interface{}
+		if query := r.URL.Query().Get("receiver"); query != "" {
+			receiver, err := flowclient.HexToAddress(query)
+			if err != nil {
+				http.Error(w, fmt.Sprintf("invalid receiver: %s", err), http.StatusBadRequest)
+				return
+			}
+			result = receiverJSONOf(receiver, true)
+		} else {
+			receivers := []receiverJSON{}
+			for _, receiver := range l.Receivers() {
+				receivers = append(receivers, receiverJSONOf(receiver, false))
+			}
+			result = receivers
+		}
+
+		w.Header().Set("Content-Type", "application/json")
+		_ = json.NewEncoder(w).Encode(result)
+	})
+}
@@ -0,0 +1,118 @@
+package unpaid
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"sync"
+	"time"
+)
+
+// Sink is sent the alerts of a Monitor.
+type Sink interface {
+	Send(ctx context.Context, alert Alert) error
+}
+
+// SinkFunc is a function used as a Sink.
+type SinkFunc func(ctx context.Context, alert Alert) error
+
+func (f SinkFunc) Send(ctx context.Context, alert Alert) error {
+	return f(ctx, alert)
+}
+
+// NewChannelSink returns a sink sending the alerts to the given channel,
+// waiting for them to be received or for the context to be done.
+func NewChannelSink(alerts chan<- Alert) Sink {
+	return SinkFunc(func(ctx context.Context, alert Alert) error {
+		select {
+		case alerts <- alert:
+			return nil
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	})
+}
+
+// NewLogSink returns a sink writing one line per alert to w.
+func NewLogSink(w io.Writer) Sink {
+	var mu sync.Mutex
+	return SinkFunc(func(_ context.Context, alert Alert) error {
+		mu.Lock()
+		defer mu.Unlock()
+
+		_, err := fmt.Fprintf(w, "%s unpaid receiver: %s\n", alert.Time.UTC().Format(time.RFC3339), alert)
+		return err
+	})
+}
+
+// NewWebhookSink returns a sink posting the alerts as JSON to the given URL
+// with the given client, or http.DefaultClient if nil. Responses other than
+// 2xx are errors.
+func NewWebhookSink(url string, client *http.Client) Sink {
+	if client == nil {
+		client = http.DefaultClient
+	}
+	return SinkFunc(func(ctx context.Context, alert Alert) error {
+		body, err := json.Marshal(newAlertJSON(alert))
+		if err != nil {
+			return err
+		}
+
+		request, err := http.NewRequestWithContext(ctx, http.MethodPost, url, bytes.NewReader(body))
+		if err != nil {
+			return err
+		}
+		request.Header.Set("Content-Type", "application/json")
+
+		response, err := client.Do(request)
+		if err != nil {
+			return err
+		}
+		defer response.Body.Close()
+		_, _ = io.Copy(io.Discard, response.Body)
+
+		if response.StatusCode/100 != 2 {
+			return fmt.Errorf("POST %s: %s", url, response.Status)
+		}
+		return nil
+	})
+}
+
+// alertJSON is the JSON encoding of an alert, sent by webhook sinks and
+// served by ledgers.
+type alertJSON struct {
+	Time          time.Time `json:"time"`
+	Height        uint64    `json:"height"`
+	TransactionID string    `json:"transactionId"`
+	Receiver      string    `json:"receiver"`
+	Amount        string    `json:"amount"`
+	TokenType     string    `json:"tokenType,omitempty"`
+	// The fields of the purchase are omitted if it is unknown.
+	ListingResourceID *uint64 `json:"listingResourceId,omitempty"`
+	NFTType           string  `json:"nftType,omitempty"`
+	NFTID             *uint64 `json:"nftId,omitempty"`
+	SalePrice         string  `json:"salePrice,omitempty"`
+}
+
+func newAlertJSON(alert Alert) alertJSON {
+	result := alertJSON{
+		Time:          alert.Time.UTC(),
+		Height:        alert.Height,
+		TransactionID: alert.TransactionID.String(),
+		Receiver:      alert.Receiver.String(),
+		Amount:        alert.Amount.String(),
+		TokenType:     alert.TokenType(),
+	}
+	if purchase := alert.Purchase; purchase != nil {
+		result.ListingResourceID = &purchase.ListingResourceID
+		if purchase.NFTType != nil {
+			result.NFTType = purchase.NFTType.ID()
+		}
+		result.NFTID = &purchase.NFTID
+		result.SalePrice = purchase.SalePrice.String()
+	}
+	return result
+}
@@ -0,0 +1,153 @@
+// Package unpaid monitors the sale cuts NFTStorefrontV2 could not pay.
+//
+// When the receiver capability of a sale cut cannot be borrowed at purchase
+// time, the contract emits UnpaidReceiver and deposits the cut to the first
+// receiver that could be paid, so royalty holders lose it without notice. A
+// Monitor links each UnpaidReceiver event to the ListingCompleted event of
+// the same transaction and sends an Alert to its sinks: a webhook, a log, a
+// channel or a Ledger keeping the unpaid amounts of each receiver.
+package unpaid
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/onflow/cadence"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/blocks"
+)
+
+// Alert is a sale cut that was not paid.
+type Alert struct {
+	Time          time.Time
+	Height        uint64
+	TransactionID flowclient.Identifier
+	// Receiver is the address of the receiver capability of the sale cut,
+	// and Amount the cut it was entitled to.
+	Receiver cadence.Address
+	Amount   cadence.UFix64
+	// Purchase is the ListingCompleted event of the purchase, or nil if the
+	// transaction emitted none.
+	Purchase *nftstorefrontv2.ListingCompleted
+}
+
+// TokenType returns the type identifier of the vault the sale cut was to be
+// paid in, or the empty string if the purchase is unknown.
+func (a Alert) TokenType() string {
+	if a.Purchase == nil || a.Purchase.SalePaymentVaultType == nil {
+		return ""
+	}
+	return a.Purchase.SalePaymentVaultType.ID()
+}
+
+func (a Alert) String() string {
+	s := fmt.Sprintf("%s not paid %s", a.Receiver, a.Amount)
+	if a.Purchase != nil {
+		s += fmt.Sprintf(" of %s for listing %d", a.TokenType(), a.Purchase.ListingResourceID)
+		if a.Purchase.NFTType != nil {
+			s += fmt.Sprintf(" (%s #%d)", a.Purchase.NFTType.ID(), a.Purchase.NFTID)
+		}
+	}
+	return fmt.Sprintf("%s at height %d in transaction %s", s, a.Height, a.TransactionID)
+}
+
+// Monitor sends alerts for the UnpaidReceiver events it is fed.
+type Monitor struct {
+	unpaidType    string
+	completedType string
+	sinks         []Sink
+
+	// SinkError is called with the errors of the sinks. If nil, AddBlock
+	// returns them once every sink was sent the alerts of the block.
+	SinkError func(Sink, Alert, error)
+
+	blocks.Cursor
+	pending []Alert
+}
+
+var _ flowclient.Consumer = (*Monitor)(nil)
+
+// New returns a monitor of the NFTStorefrontV2 contract deployed to the given
+// address sending alerts to the given sinks, in order.
+func New(storefrontContract cadence.Address, sinks ...Sink) *Monitor {
+	return &Monitor{
+		unpaidType:    nftstorefrontv2.UnpaidReceiverEventType(storefrontContract),
+		completedType: nftstorefrontv2.ListingCompletedEventType(storefrontContract),
+		sinks:         sinks,
+	}
+}
+
+// EventTypes returns the types of the events the monitor needs.
+func (m *Monitor) EventTypes() []string {
+	return []string{m.unpaidType, m.completedType}
+}
+
+// AddBlock sends the alerts of a block. Blocks must be added in order of
+// height, with their events in the order they were emitted.
+func (m *Monitor) AddBlock(ctx context.Context, block flowclient.BlockEvents) error {
+	if err := blocks.Advance(&m.Cursor, block); err != nil {
+		return err
+	}
+
+	var alerts []Alert
+	for _, event := range block.Events {
+		// The UnpaidReceiver events of a purchase precede its
+		// ListingCompleted event in the same transaction.
+		if len(m.pending) > 0 && event.TransactionID != m.pending[0].TransactionID {
+			alerts = append(alerts, m.pending...)
+			m.pending = nil
+		}
+
+		switch event.Type {
+		case m.unpaidType:
+			unpaid, err := nftstorefrontv2.DecodeUnpaidReceiver(event.Value)
+			if err != nil {
+				return fmt.Errorf("block %d: %w", block.Height, err)
+			}
+			m.pending = append(m.pending, Alert{
+				Time:          block.BlockTimestamp,
+				Height:        block.Height,
+				TransactionID: event.TransactionID,
+				Receiver:      unpaid.Receiver,
+				Amount:        unpaid.EntitledSaleCut,
+			})
+
+		case m.completedType:
+			if len(m.pending) == 0 {
+				continue
+			}
+			completed, err := nftstorefrontv2.DecodeListingCompleted(event.Value)
+			if err != nil {
+				return fmt.Errorf("block %d: %w", block.Height, err)
+			}
+			for i := range m.pending {
+				m.pending[i].Purchase = &completed
+			}
+			alerts = append(alerts, m.pending...)
+			m.pending = nil
+		}
+	}
+	alerts = append(alerts, m.pending...)
+	m.pending = nil
+
+	var errs []error
+	for _, alert := range alerts {
+		for _, sink := range m.sinks {
+			if err := sink.Send(ctx, alert); err != nil {
+				if ctx.Err() != nil {
+					return ctx.Err()
+				}
+				if m.SinkError != nil {
+					m.SinkError(sink, alert, err)
+					continue
+				}
+				errs = append(errs, fmt.Errorf("alert of height %d: %w", alert.Height, err))
+			}
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -0,0 +1,283 @@
+package unpaid_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/testfixtures"
+	"github.com/onflow/nft-storefront/lib/go/contracts/unpaid"
+)
+
+var (
+	storefrontContract = testfixtures.StorefrontContract
+	ufix64             = testfixtures.UFix64
+	creator            = testfixtures.Creator
+	agent              = cadence.BytesToAddress([]byte{0x03})
+
+	start = time.Date(2024, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	exampleNFT = testfixtures.ExampleNFT
+	flowToken  = testfixtures.FlowToken
+)
+
+func completed(t *testing.T, tx byte, listingResourceID uint64, price string) flowclient.Event {
+	t.Helper()
+
+	return flowclient.Event{
+		Type:          nftstorefrontv2.ListingCompletedEventType(storefrontContract),
+		TransactionID: flowclient.Identifier{tx},
+		Value: nftstorefrontv2.ListingCompleted{
+			ListingResourceID:    listingResourceID,
+			Purchased:            true,
+			NFTType:              exampleNFT,
+			NFTID:                listingResourceID * 10,
+			SalePaymentVaultType: flowToken,
+			SalePrice:            ufix64(t, price),
+		}.Encode(storefrontContract),
+	}
+}
+
+func unpaidReceiver(t *testing.T, tx byte, receiver cadence.Address, amount string) flowclient.Event {
+	t.Helper()
+
+	return flowclient.Event{
+		Type:          nftstorefrontv2.UnpaidReceiverEventType(storefrontContract),
+		TransactionID: flowclient.Identifier{tx},
+		Value: nftstorefrontv2.UnpaidReceiver{
+			Receiver:        receiver,
+			EntitledSaleCut: ufix64(t, amount),
+		}.Encode(storefrontContract),
+	}
+}
+
+// recorded returns a fake replaying these blocks:
+//
+//	10 at 0h: listing 1 purchased without paying the creator 2.0 and the
+//	          agent 1.0, listing 2 purchased paying every receiver
+//	11 at 1h: listing 3 purchased without paying the creator 0.5
+func recorded(t *testing.T) *flowclient.Fake {
+	t.Helper()
+
+	var fixtures flowclient.Fixtures
+	for _, block := range []flowclient.BlockEvents{
+		{
+			Height:         10,
+			BlockTimestamp: start,
+			Events: []flowclient.Event{
+				unpaidReceiver(t, 1, creator, "2.0"),
+				unpaidReceiver(t, 1, agent, "1.0"),
+				completed(t, 1, 1, "20.0"),
+				completed(t, 2, 2, "5.0"),
+			},
+		},
+		{
+			Height:         11,
+			BlockTimestamp: start.Add(time.Hour),
+			Events:         []flowclient.Event{unpaidReceiver(t, 3, creator, "0.5"), completed(t, 3, 3, "5.0")},
+		},
+	} {
+		for i := range block.Events {
+			block.Events[i].EventIndex = i
+		}
+		require.NoError(t, fixtures.AddBlock(block))
+	}
+
+	fake, err := flowclient.NewFake(&fixtures)
+	require.NoError(t, err)
+	return fake
+}
+
+func TestMonitor(t *testing.T) {
+	alerts := make(chan unpaid.Alert, 10)
+	var log bytes.Buffer
+	ledger := unpaid.NewLedger()
+
+	monitor := unpaid.New(storefrontContract, ledger, unpaid.NewChannelSink(alerts), unpaid.NewLogSink(&log))
+	require.NoError(t, flowclient.Feed(context.Background(), recorded(t), monitor, 1, 11))
+	assert.Equal(t, uint64(11), monitor.Height())
+	close(alerts)
+
+	var received []unpaid.Alert
+	for alert := range alerts {
+		received = append(received, alert)
+	}
+	require.Len(t, received, 3)
+
+	alert := received[0]
+	assert.Equal(t, start, alert.Time.UTC())
+	assert.Equal(t, uint64(10), alert.Height)
+	assert.Equal(t, flowclient.Identifier{1}, alert.TransactionID)
+	assert.Equal(t, creator, alert.Receiver)
+	assert.Equal(t, ufix64(t, "2.0"), alert.Amount)
+	require.NotNil(t, alert.Purchase)
+	assert.Equal(t, uint64(1), alert.Purchase.ListingResourceID)
+	assert.Equal(t, "A.f8d6e0586b0a20c7.FlowToken.Vault", alert.TokenType())
+
+	assert.Equal(t, agent, received[1].Receiver)
+	assert.Equal(t, uint64(1), received[1].Purchase.ListingResourceID)
+	assert.Equal(t, uint64(3), received[2].Purchase.ListingResourceID)
+
+	lines := strings.Split(strings.TrimSpace(log.String()), "\n")
+	require.Len(t, lines, 3)
+	assert.Equal(t,
+		"2024-01-01T00:00:00Z unpaid receiver: 0x0000000000000002 not paid 2.00000000 of A.f8d6e0586b0a20c7.FlowToken.Vault"+
+			" for listing 1 (A.f8d6e0586b0a20c7.ExampleNFT.NFT #10) at height 10 in transaction "+flowclient.Identifier{1}.String(),
+		lines[0])
+
+	assert.Equal(t, []cadence.Address{creator, agent}, ledger.Receivers())
+	assert.Equal(t, []unpaid.Balance{{TokenType: "A.f8d6e0586b0a20c7.FlowToken.Vault", Amount: ufix64(t, "2.5"), Count: 2}}, ledger.Balances(creator))
+	assert.Len(t, ledger.Alerts(creator), 2)
+	assert.Empty(t, ledger.Balances(storefrontContract))
+}
+
+func TestUnmatchedUnpaidReceiver(t *testing.T) {
+	ledger := unpaid.NewLedger()
+	monitor := unpaid.New(storefrontContract, ledger)
+
+	require.NoError(t, monitor.AddBlock(context.Background(), flowclient.BlockEvents{
+		Height: 1,
+		Events: []flowclient.Event{unpaidReceiver(t, 1, creator, "1.0"), completed(t, 2, 1, "5.0")},
+	}))
+
+	alerts := ledger.Alerts(creator)
+	require.Len(t, alerts, 1)
+	assert.Nil(t, alerts[0].Purchase)
+	assert.Equal(t, []unpaid.Balance{{Amount: ufix64(t, "1.0"), Count: 1}}, ledger.Balances(creator))
+}
+
+func TestSinkErrors(t *testing.T) {
+	failing := unpaid.SinkFunc(func(context.Context, unpaid.Alert) error { return errors.New("unavailable") })
+	ledger := unpaid.NewLedger()
+	monitor := unpaid.New(storefrontContract, failing, ledger)
+
+	err := flowclient.Feed(context.Background(), recorded(t), monitor, 1, 10)
+	assert.EqualError(t, err, "alert of height 10: unavailable\nalert of height 10: unavailable")
+	// The other sinks were sent the alerts.
+	assert.Len(t, ledger.Receivers(), 2)
+
+	var failed []cadence.Address
+	monitor = unpaid.New(storefrontContract, failing)
+	monitor.SinkError = func(_ unpaid.Sink, alert unpaid.Alert, err error) {
+		failed = append(failed, alert.Receiver)
+	}
+	require.NoError(t, flowclient.Feed(context.Background(), recorded(t), monitor, 1, 11))
+	assert.Equal(t, []cadence.Address{creator, agent, creator}, failed)
+
+	err = monitor.AddBlock(context.Background(), flowclient.BlockEvents{Height: 5})
+	assert.EqualError(t, err, "block 5 added after block 11")
+}
+
+func TestWebhookSink(t *testing.T) {
+	var mu sync.Mutex
+	var bodies []map[string]interface{}
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		assert.Equal(t, http.MethodPost, r.Method)
+		assert.Equal(t, "application/json", r.Header.Get("Content-Type"))
+
+		var body map[string]interface{}
+		assert.NoError(t, json.NewDecoder(r.Body).Decode(&body))
+		mu.Lock()
+		bodies = append(bodies, body)
+		mu.Unlock()
+	}))
+	defer server.Close()
+
+	monitor := unpaid.New(storefrontContract, unpaid.NewWebhookSink(server.URL, server.Client()))
+	require.NoError(t, flowclient.Feed(context.Background(), recorded(t), monitor, 10, 10))
+
+	require.Len(t, bodies, 2)
+	assert.Equal(t, map[string]interface{}{
+		"time":              "2024-01-01T00:00:00Z",
+		"height":            float64(10),
+		"transactionId":     flowclient.Identifier{1}.String(),
+		"receiver":          "0x0000000000000002",
+		"amount":            "2.00000000",
+		"tokenType":         "A.f8d6e0586b0a20c7.FlowToken.Vault",
+		"listingResourceId": float64(1),
+		"nftType":           "A.f8d6e0586b0a20c7.ExampleNFT.NFT",
+		"nftId":             float64(10),
+		"salePrice":         "20.00000000",
+	}, bodies[0])
+
+	sink := unpaid.NewWebhookSink(server.URL+"/missing", nil)
+	server.Config.Handler = http.NotFoundHandler()
+	err := sink.Send(context.Background(), unpaid.Alert{})
+	assert.EqualError(t, err, "POST "+server.URL+"/missing: 404 Not Found")
+}
+
+func TestWatch(t *testing.T) {
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	alerts := make(chan unpaid.Alert)
+	monitor := unpaid.New(storefrontContract, unpaid.NewChannelSink(alerts))
+
+	done := make(chan error)
+	go func() { done <- flowclient.Watch(ctx, recorded(t), monitor, 10, time.Millisecond) }()
+
+	for i := 0; i < 3; i++ {
+		<-alerts
+	}
+	cancel()
+	assert.ErrorIs(t, <-done, context.Canceled)
+	assert.Equal(t, uint64(11), monitor.Height())
+
+	err := flowclient.Watch(context.Background(), recorded(t), monitor, 10, 0)
+	assert.EqualError(t, err, "interval must be positive, got 0s")
+}
+
+func TestLedgerHandler(t *testing.T) {
+	ledger := unpaid.NewLedger()
+	require.NoError(t, flowclient.Feed(context.Background(), recorded(t), unpaid.New(storefrontContract, ledger), 1, 11))
+
+	get := func(target string) (int, string) {
+		recorder := httptest.NewRecorder()
+		ledger.Handler().ServeHTTP(recorder, httptest.NewRequest(http.MethodGet, target, nil))
+		body, err := io.ReadAll(recorder.Body)
+		require.NoError(t, err)
+		return recorder.Code, string(body)
+	}
+
+	code, body := get("/?receiver=0x03")
+	assert.Equal(t, http.StatusOK, code)
+	var receiver struct {
+		Receiver string
+		Balances []struct {
+			TokenType string
+			Amount    string
+			Count     int
+		}
+		Alerts []map[string]interface{}
+	}
+	require.NoError(t, json.Unmarshal([]byte(body), &receiver))
+	assert.Equal(t, "0x0000000000000003", receiver.Receiver)
+	require.Len(t, receiver.Balances, 1)
+	assert.Equal(t, "1.00000000", receiver.Balances[0].Amount)
+	require.Len(t, receiver.Alerts, 1)
+	assert.Equal(t, float64(1), receiver.Alerts[0]["listingResourceId"])
+
+	code, body = get("/")
+	assert.Equal(t, http.StatusOK, code)
+	assert.JSONEq(t, `[
+		{"receiver": "0x0000000000000002", "balances": [{"tokenType": "A.f8d6e0586b0a20c7.FlowToken.Vault", "amount": "2.50000000", "count": 2}]},
+		{"receiver": "0x0000000000000003", "balances": [{"tokenType": "A.f8d6e0586b0a20c7.FlowToken.Vault", "amount": "1.00000000", "count": 1}]}
+	]`, body)
+
+	code, _ = get("/?receiver=xyz")
+	assert.Equal(t, http.StatusBadRequest, code)
+}
@@ -0,0 +1,56 @@
+package versions
+
+import (
+	"regexp"
+)
+
+// Features describes the capabilities of a contract version that integrations
+// commonly need to branch on.
+type Features struct {
+	// HasEntitlements is set for Cadence 1.0 sources, which guard
+	// createListing and removeListing with entitlements.
+	HasEntitlements bool
+	// HasListingBecomeGhosted is set when Listing exposes the deprecated,
+	// inverted hasListingBecomeGhosted function.
+	HasListingBecomeGhosted bool
+	// HasIsGhostListing is set when Listing exposes isGhostListing.
+	HasIsGhostListing bool
+	// HasGhostListingCleanup is set when Storefront exposes cleanupGhostListings.
+	HasGhostListingCleanup bool
+	// HasExistingListingIDs is set when Storefront exposes getExistingListingIDs.
+	HasExistingListingIDs bool
+	// HasCommissionReceivers is set when the ListingAvailable event carries
+	// the addresses of the allowed commission receivers.
+	HasCommissionReceivers bool
+}
+
+var (
+	entitlementDeclaration  = regexp.MustCompile(`(?m)^\s*access\(\w+\)\s+entitlement\s+\w+`)
+	hasListingBecomeGhosted = functionDeclaration("hasListingBecomeGhosted")
+	isGhostListing          = functionDeclaration("isGhostListing")
+	cleanupGhostListings    = functionDeclaration("cleanupGhostListings")
+	getExistingListingIDs   = functionDeclaration("getExistingListingIDs")
+	listingAvailableEvent   = regexp.MustCompile(`event\s+ListingAvailable\s*\(([^)]*)\)`)
+	commissionReceivers     = regexp.MustCompile(`\bcommissionReceivers\s*:`)
+)
+
+func functionDeclaration(name string) *regexp.Regexp {
+	return regexp.MustCompile(`\bfun\s+` + name + `\s*\(`)
+}
+
+// DetectFeatures reports the features present in the given contract source.
+func DetectFeatures(code []byte) Features {
+	features := Features{
+		HasEntitlements:         entitlementDeclaration.Match(code),
+		HasListingBecomeGhosted: hasListingBecomeGhosted.Match(code),
+		HasIsGhostListing:       isGhostListing.Match(code),
+		HasGhostListingCleanup:  cleanupGhostListings.Match(code),
+		HasExistingListingIDs:   getExistingListingIDs.Match(code),
+	}
+
+	if event := listingAvailableEvent.FindSubmatch(code); event != nil {
+		features.HasCommissionReceivers = commissionReceivers.Match(event[1])
+	}
+
+	return features
+}
@@ -0,0 +1,207 @@
+// Package versions catalogs released sources of the NFTStorefront and
+// NFTStorefrontV2 contracts so that deployed code can be matched to a release.
+package versions
+
+import (
+	"bytes"
+	"crypto/sha256"
+	"embed"
+	"encoding/hex"
+	"fmt"
+	"io/fs"
+	"path"
+	"regexp"
+	"sort"
+	"strings"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/internal/assets"
+)
+
+const (
+	NFTStorefront   = "NFTStorefront"
+	NFTStorefrontV2 = "NFTStorefrontV2"
+)
+
+// Unreleased is the tag of the contract sources of this module that do not
+// match any release.
+const Unreleased = "unreleased"
+
+// releases holds the sources of the tagged releases, laid out as described
+// by AddReleases. They are exported from the tags with make releases.
+//
+//go:embed releases
+var releases embed.FS
+
+var embedded = []struct {
+	contract string
+	filename string
+}{
+	{NFTStorefront, "NFTStorefront.cdc"},
+	{NFTStorefrontV2, "NFTStorefrontV2.cdc"},
+}
+
+var (
+	importDeclaration = regexp.MustCompile(`(?m)^[ \t]*import[ \t]+(?:"(\w+)"|(\w+)[ \t]+from[ \t]+\S+)[ \t]*$`)
+	trailingSpace     = regexp.MustCompile(`(?m)[ \t]+$`)
+)
+
+// Digest identifies a contract source independently of its import addresses.
+type Digest [sha256.Size]byte
+
+func (d Digest) String() string {
+	return hex.EncodeToString(d[:])
+}
+
+// DigestOf returns the digest of the given contract source.
+//
+// Import declarations are reduced to the imported contract name, so the
+// embedded source, the source returned by contracts.NFTStorefrontV2 and the
+// code deployed on any network all share the same digest.
+func DigestOf(code []byte) Digest {
+	return sha256.Sum256(normalize(code))
+}
+
+func normalize(code []byte) []byte {
+	code = bytes.ReplaceAll(code, []byte("\r\n"), []byte("\n"))
+	code = importDeclaration.ReplaceAllFunc(code, func(decl []byte) []byte {
+		match := importDeclaration.FindSubmatch(decl)
+		name := match[1]
+		if len(name) == 0 {
+			name = match[2]
+		}
+		return append([]byte("import "), name...)
+	})
+	code = trailingSpace.ReplaceAll(code, nil)
+	return bytes.TrimSpace(code)
+}
+
+// Release is a known version of a storefront contract.
+type Release struct {
+	Contract string
+	Tag      string
+	Digest   Digest
+	Features Features
+}
+
+// Catalog holds the known releases of the storefront contracts.
+type Catalog struct {
+	releases []Release
+	byDigest map[Digest]int
+}
+
+// NewCatalog returns a catalog containing the released sources embedded in
+// this module, and the contract sources of this module as Unreleased unless
+// they are identical to a release.
+func NewCatalog() *Catalog {
+	c := &Catalog{byDigest: make(map[Digest]int)}
+
+	tags, err := fs.Sub(releases, "releases")
+	if err != nil {
+		panic(err)
+	}
+	if _, err := c.AddReleases(tags); err != nil {
+		panic(err)
+	}
+
+	for _, e := range embedded {
+		code := assets.MustAsset(e.filename)
+		if _, ok := c.Identify(code); ok {
+			continue
+		}
+		if _, err := c.Add(e.contract, Unreleased, code); err != nil {
+			panic(err)
+		}
+	}
+	return c
+}
+
+// Add registers the source of a released contract version.
+func (c *Catalog) Add(contract, tag string, code []byte) (Release, error) {
+	if _, ok := c.Release(contract, tag); ok {
+		return Release{}, fmt.Errorf("release %s of %s is already registered", tag, contract)
+	}
+
+	digest := DigestOf(code)
+	if i, ok := c.byDigest[digest]; ok {
+		existing := c.releases[i]
+		return Release{}, fmt.Errorf("source of %s %s is identical to %s %s", contract, tag, existing.Contract, existing.Tag)
+	}
+
+	release := Release{
+		Contract: contract,
+		Tag:      tag,
+		Digest:   digest,
+		Features: DetectFeatures(code),
+	}
+	c.byDigest[digest] = len(c.releases)
+	c.releases = append(c.releases, release)
+
+	return release, nil
+}
+
+// AddReleases registers the released sources found in fsys, laid out as
+// TAG/CONTRACT.cdc, for example v1.0.0/NFTStorefrontV2.cdc. Such a tree can
+// be exported from the tags of the repository with
+//
+//	git archive v1.0.0 contracts | tar -x --strip-components 1 -C releases/v1.0.0
+//
+// Files other than NFTStorefront.cdc and NFTStorefrontV2.cdc are ignored.
+func (c *Catalog) AddReleases(fsys fs.FS) ([]Release, error) {
+	files, err := fs.Glob(fsys, "*/*.cdc")
+	if err != nil {
+		return nil, err
+	}
+
+	var releases []Release
+	for _, file := range files {
+		tag, name := path.Split(file)
+		contract := strings.TrimSuffix(name, ".cdc")
+		if contract != NFTStorefront && contract != NFTStorefrontV2 {
+			continue
+		}
+
+		code, err := fs.ReadFile(fsys, file)
+		if err != nil {
+			return nil, err
+		}
+		release, err := c.Add(contract, path.Clean(tag), code)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", file, err)
+		}
+		releases = append(releases, release)
+	}
+	return releases, nil
+}
+
+// Releases returns the registered releases of the given contract, ordered by tag.
+func (c *Catalog) Releases(contract string) []Release {
+	var releases []Release
+	for _, r := range c.releases {
+		if r.Contract == contract {
+			releases = append(releases, r)
+		}
+	}
+	sort.Slice(releases, func(i, j int) bool {
+		return releases[i].Tag < releases[j].Tag
+	})
+	return releases
+}
+
+// Release returns the release of the given contract with the given tag.
+func (c *Catalog) Release(contract, tag string) (Release, bool) {
+	for _, r := range c.releases {
+		if r.Contract == contract && r.Tag == tag {
+			return r, true
+		}
+	}
+	return Release{}, false
+}
+
+// Identify returns the release whose source matches the given deployed code.
+func (c *Catalog) Identify(code []byte) (Release, bool) {
+	i, ok := c.byDigest[DigestOf(code)]
+	if !ok {
+		return Release{}, false
+	}
+	return c.releases[i], true
+}
@@ -0,0 +1,133 @@
+package versions_test
+
+import (
+	"strings"
+	"testing"
+	"testing/fstest"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts"
+	"github.com/onflow/nft-storefront/lib/go/contracts/versions"
+)
+
+const (
+	ftAddress  = "ee82856bf20e2aa6"
+	nftAddress = "f8d6e0586b0a20c7"
+)
+
+func TestIdentifyEmbeddedSource(t *testing.T) {
+	catalog := versions.NewCatalog()
+
+	release, ok := catalog.Identify(contracts.NFTStorefrontV2(ftAddress, nftAddress))
+	require.True(t, ok)
+
+	assert.Equal(t, versions.NFTStorefrontV2, release.Contract)
+	assert.Equal(t, versions.Features{
+		HasEntitlements:         true,
+		HasListingBecomeGhosted: true,
+		HasIsGhostListing:       true,
+		HasGhostListingCleanup:  true,
+		HasExistingListingIDs:   true,
+		HasCommissionReceivers:  true,
+	}, release.Features)
+}
+
+func TestIdentifyUnknownSource(t *testing.T) {
+	catalog := versions.NewCatalog()
+
+	code := string(contracts.NFTStorefrontV2(ftAddress, nftAddress))
+	code = strings.Replace(code, "access(all) contract NFTStorefrontV2 {", "access(all) contract NFTStorefrontV2 {\n    access(all) let extra: Int", 1)
+
+	_, ok := catalog.Identify([]byte(code))
+	assert.False(t, ok)
+}
+
+func TestAddRelease(t *testing.T) {
+	catalog := versions.NewCatalog()
+	known := len(catalog.Releases(versions.NFTStorefrontV2))
+
+	code := `
+access(all) contract NFTStorefrontV2 {
+    access(all) event ListingAvailable(storefrontAddress: Address, commissionReceiver: Address?)
+
+    access(all) resource Listing {
+        access(all) view fun hasListingBecomeGhosted(): Bool {
+            return true
+        }
+    }
+}
+`
+	release, err := catalog.Add(versions.NFTStorefrontV2, "v1.0.0", []byte(code))
+	require.NoError(t, err)
+
+	assert.Equal(t, versions.Features{HasListingBecomeGhosted: true}, release.Features)
+
+	identified, ok := catalog.Identify([]byte(code))
+	require.True(t, ok)
+	assert.Equal(t, release, identified)
+
+	assert.Len(t, catalog.Releases(versions.NFTStorefrontV2), known+1)
+
+	_, err = catalog.Add(versions.NFTStorefrontV2, "v1.0.0", []byte(code+"\n// changed"))
+	assert.Error(t, err)
+
+	_, err = catalog.Add(versions.NFTStorefrontV2, "v1.0.1", []byte(code))
+	assert.Error(t, err)
+}
+
+func TestAddReleases(t *testing.T) {
+	catalog := versions.NewCatalog()
+
+	v1 := `
+import NonFungibleToken from 0x1d7e57aa55817448
+
+pub contract NFTStorefrontV2 {
+    pub event ListingAvailable(storefrontAddress: Address, commissionReceivers: [Address]?)
+
+    pub resource Listing {
+        pub fun hasListingBecomeGhosted(): Bool {
+            return true
+        }
+    }
+}
+`
+	releases, err := catalog.AddReleases(fstest.MapFS{
+		"v1.0.0/NFTStorefrontV2.cdc": {Data: []byte(v1)},
+		"v1.0.0/NFTStorefront.cdc":   {Data: []byte("pub contract NFTStorefront {}")},
+		"v1.0.0/utility/Other.cdc":   {Data: []byte("pub contract Other {}")},
+		"v1.0.0/README.md":           {Data: []byte("# v1.0.0")},
+	})
+	require.NoError(t, err)
+	assert.Len(t, releases, 2)
+
+	release, ok := catalog.Identify([]byte(strings.Replace(v1, "0x1d7e57aa55817448", "0x631e88ae7f1d7c20", 1)))
+	require.True(t, ok)
+	assert.Equal(t, versions.NFTStorefrontV2, release.Contract)
+	assert.Equal(t, "v1.0.0", release.Tag)
+	assert.False(t, release.Features.HasIsGhostListing)
+	assert.False(t, release.Features.HasEntitlements)
+	assert.True(t, release.Features.HasListingBecomeGhosted)
+	assert.True(t, release.Features.HasCommissionReceivers)
+
+	assert.Contains(t, tags(catalog.Releases(versions.NFTStorefront)), "v1.0.0")
+
+	_, err = catalog.AddReleases(fstest.MapFS{"v1.0.1/NFTStorefrontV2.cdc": {Data: []byte(v1)}})
+	assert.EqualError(t, err, "v1.0.1/NFTStorefrontV2.cdc: source of NFTStorefrontV2 v1.0.1 is identical to NFTStorefrontV2 v1.0.0")
+}
+
+func tags(releases []versions.Release) []string {
+	var result []string
+	for _, r := range releases {
+		result = append(result, r.Tag)
+	}
+	return result
+}
+
+func TestDigestIgnoresImportAddresses(t *testing.T) {
+	local := []byte("import \"FungibleToken\"\naccess(all) contract C {}\n")
+	deployed := []byte("import FungibleToken from 0xf233dcee88fe0abe\r\naccess(all) contract C {}")
+
+	assert.Equal(t, versions.DigestOf(local), versions.DigestOf(deployed))
+}
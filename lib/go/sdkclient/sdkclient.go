@@ -0,0 +1,163 @@
+// Package sdkclient implements flowclient.Client with the Flow Go SDK.
+//
+// It lives in its own module so that the contracts module does not depend on
+// the SDK.
+package sdkclient
+
+import (
+	"context"
+
+	"github.com/onflow/cadence"
+	flow "github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/access"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+)
+
+// Client is a flowclient.Client backed by an SDK access client, such as the
+// one returned by github.com/onflow/flow-go-sdk/access/grpc.NewClient.
+type Client struct {
+	client access.Client
+}
+
+var _ flowclient.Client = (*Client)(nil)
+
+// New returns a Client backed by the given SDK access client.
+func New(client access.Client) *Client {
+	return &Client{client: client}
+}
+
+func (c *Client) ExecuteScriptAtLatestBlock(ctx context.Context, code []byte, arguments []cadence.Value) (cadence.Value, error) {
+	return c.client.ExecuteScriptAtLatestBlock(ctx, code, arguments)
+}
+
+func (c *Client) SendTransaction(ctx context.Context, tx flowclient.Transaction) (flowclient.Identifier, error) {
+	sdkTx, err := Transaction(tx)
+	if err != nil {
+		return flowclient.Identifier{}, err
+	}
+	if err := c.client.SendTransaction(ctx, *sdkTx); err != nil {
+		return flowclient.Identifier{}, err
+	}
+	return flowclient.Identifier(sdkTx.ID()), nil
+}
+
+func (c *Client) GetTransactionResult(ctx context.Context, id flowclient.Identifier) (*flowclient.TransactionResult, error) {
+	result, err := c.client.GetTransactionResult(ctx, flow.Identifier(id))
+	if err != nil {
+		return nil, err
+	}
+	return TransactionResult(id, result), nil
+}
+
+func (c *Client) GetLatestBlockHeight(ctx context.Context) (uint64, error) {
+	header, err := c.client.GetLatestBlockHeader(ctx, true)
+	if err != nil {
+		return 0, err
+	}
+	return header.Height, nil
+}
+
+func (c *Client) GetEventsForHeightRange(ctx context.Context, eventType string, startHeight, endHeight uint64) ([]flowclient.BlockEvents, error) {
+	blocks, err := c.client.GetEventsForHeightRange(ctx, eventType, startHeight, endHeight)
+	if err != nil {
+		return nil, err
+	}
+
+	result := make([]flowclient.BlockEvents, len(blocks))
+	for i, block := range blocks {
+		result[i] = flowclient.BlockEvents{
+			BlockID:        flowclient.Identifier(block.BlockID),
+			Height:         block.Height,
+			BlockTimestamp: block.BlockTimestamp,
+			Events:         Events(block.Events),
+		}
+	}
+	return result, nil
+}
+
+func (c *Client) GetAccount(ctx context.Context, address cadence.Address) (*flowclient.Account, error) {
+	account, err := c.client.GetAccountAtLatestBlock(ctx, flow.Address(address))
+	if err != nil {
+		return nil, err
+	}
+	return Account(account), nil
+}
+
+// Transaction converts a transaction to an SDK transaction.
+func Transaction(tx flowclient.Transaction) (*flow.Transaction, error) {
+	sdkTx := flow.NewTransaction().
+		SetScript(tx.Script).
+		SetReferenceBlockID(flow.Identifier(tx.ReferenceBlockID)).
+		SetComputeLimit(tx.GasLimit).
+		SetProposalKey(flow.Address(tx.ProposalKey.Address), tx.ProposalKey.KeyIndex, tx.ProposalKey.SequenceNumber).
+		SetPayer(flow.Address(tx.Payer))
+
+	for _, argument := range tx.Arguments {
+		if err := sdkTx.AddArgument(argument); err != nil {
+			return nil, err
+		}
+	}
+	for _, authorizer := range tx.Authorizers {
+		sdkTx.AddAuthorizer(flow.Address(authorizer))
+	}
+	for _, s := range tx.PayloadSignatures {
+		sdkTx.AddPayloadSignature(flow.Address(s.Address), s.KeyIndex, s.Signature)
+	}
+	for _, s := range tx.EnvelopeSignatures {
+		sdkTx.AddEnvelopeSignature(flow.Address(s.Address), s.KeyIndex, s.Signature)
+	}
+	return sdkTx, nil
+}
+
+// TransactionResult converts an SDK transaction result.
+func TransactionResult(id flowclient.Identifier, result *flow.TransactionResult) *flowclient.TransactionResult {
+	converted := &flowclient.TransactionResult{
+		TransactionID:    id,
+		Status:           flowclient.TransactionStatus(result.Status.String()),
+		Events:           Events(result.Events),
+		BlockID:          flowclient.Identifier(result.BlockID),
+		BlockHeight:      result.BlockHeight,
+		ComputationUsage: result.ComputationUsage,
+	}
+	if result.Error != nil {
+		converted.ErrorMessage = result.Error.Error()
+	}
+	return converted
+}
+
+// Events converts SDK events.
+func Events(events []flow.Event) []flowclient.Event {
+	result := make([]flowclient.Event, len(events))
+	for i, event := range events {
+		result[i] = flowclient.Event{
+			Type:             event.Type,
+			TransactionID:    flowclient.Identifier(event.TransactionID),
+			TransactionIndex: event.TransactionIndex,
+			EventIndex:       event.EventIndex,
+			Value:            event.Value,
+		}
+	}
+	return result
+}
+
+// Account converts an SDK account.
+func Account(account *flow.Account) *flowclient.Account {
+	converted := &flowclient.Account{
+		Address:   cadence.Address(account.Address),
+		Balance:   account.Balance,
+		Contracts: account.Contracts,
+	}
+	for _, key := range account.Keys {
+		converted.Keys = append(converted.Keys, flowclient.AccountKey{
+			Index:          key.Index,
+			PublicKey:      key.PublicKey.Encode(),
+			SigAlgo:        flowclient.SignatureAlgorithm(key.SigAlgo.String()),
+			HashAlgo:       flowclient.HashAlgorithm(key.HashAlgo.String()),
+			Weight:         key.Weight,
+			SequenceNumber: key.SequenceNumber,
+			Revoked:        key.Revoked,
+		})
+	}
+	return converted
+}
@@ -0,0 +1,153 @@
+package sdkclient_test
+
+import (
+	"errors"
+	"testing"
+
+	"github.com/onflow/cadence"
+	flow "github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txbuilder"
+	"github.com/onflow/nft-storefront/lib/go/sdkclient"
+)
+
+func TestTransaction(t *testing.T) {
+	payer := cadence.BytesToAddress([]byte{0x01})
+	seller := cadence.BytesToAddress([]byte{0x02})
+
+	tx := flowclient.Transaction{
+		Script:           []byte("transaction(id: UInt64) { prepare(acct: &Account) {} }"),
+		Arguments:        []cadence.Value{cadence.NewUInt64(42)},
+		ReferenceBlockID: flowclient.Identifier{0xaa},
+		GasLimit:         9999,
+		ProposalKey:      flowclient.ProposalKey{Address: seller, KeyIndex: 1, SequenceNumber: 7},
+		Payer:            payer,
+		Authorizers:      []cadence.Address{seller},
+		PayloadSignatures: []flowclient.Signature{
+			{Address: seller, KeyIndex: 1, Signature: []byte{1}},
+		},
+		EnvelopeSignatures: []flowclient.Signature{
+			{Address: payer, Signature: []byte{2}},
+		},
+	}
+
+	sdkTx, err := sdkclient.Transaction(tx)
+	require.NoError(t, err)
+
+	expected := flow.NewTransaction().
+		SetScript(tx.Script).
+		SetReferenceBlockID(flow.Identifier{0xaa}).
+		SetComputeLimit(9999).
+		SetProposalKey(flow.Address(seller), 1, 7).
+		SetPayer(flow.Address(payer)).
+		AddAuthorizer(flow.Address(seller))
+	require.NoError(t, expected.AddArgument(cadence.NewUInt64(42)))
+	expected.AddPayloadSignature(flow.Address(seller), 1, []byte{1})
+	expected.AddEnvelopeSignature(flow.Address(payer), 0, []byte{2})
+
+	assert.Equal(t, expected.ID(), sdkTx.ID())
+	assert.Equal(t, expected.PayloadSignatures, sdkTx.PayloadSignatures)
+	assert.Equal(t, expected.EnvelopeSignatures, sdkTx.EnvelopeSignatures)
+}
+
+func TestTransactionResult(t *testing.T) {
+	id := flowclient.Identifier{0x01}
+	result := sdkclient.TransactionResult(id, &flow.TransactionResult{
+		Status:      flow.TransactionStatusSealed,
+		Error:       errors.New("[Error Code: 1101] panic"),
+		BlockHeight: 10,
+		Events: []flow.Event{
+			{Type: "A.01.Contract.Event", EventIndex: 2},
+		},
+	})
+
+	assert.Equal(t, id, result.TransactionID)
+	assert.Equal(t, flowclient.StatusSealed, result.Status)
+	assert.EqualError(t, result.Err(), "[Error Code: 1101] panic")
+	assert.Equal(t, uint64(10), result.BlockHeight)
+	require.Len(t, result.Events, 1)
+	assert.Equal(t, "A.01.Contract.Event", result.Events[0].Type)
+	assert.Equal(t, 2, result.Events[0].EventIndex)
+}
+
+func TestAccount(t *testing.T) {
+	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_secp256k1, make([]byte, crypto.MinSeedLength))
+	require.NoError(t, err)
+
+	account := sdkclient.Account(&flow.Account{
+		Address: flow.HexToAddress("01"),
+		Balance: 100,
+		Keys: []*flow.AccountKey{
+			{
+				Index:          1,
+				PublicKey:      privateKey.PublicKey(),
+				SigAlgo:        crypto.ECDSA_secp256k1,
+				HashAlgo:       crypto.SHA2_256,
+				Weight:         1000,
+				SequenceNumber: 3,
+			},
+		},
+	})
+
+	assert.Equal(t, cadence.BytesToAddress([]byte{0x01}), account.Address)
+	require.Len(t, account.Keys, 1)
+	assert.Equal(t, flowclient.ECDSA_secp256k1, account.Keys[0].SigAlgo)
+	assert.Equal(t, flowclient.SHA2_256, account.Keys[0].HashAlgo)
+	assert.Equal(t, privateKey.PublicKey().Encode(), account.Keys[0].PublicKey)
+}
+
+// TestSignedTransaction checks that transactions signed with package
+// txbuilder have the same ID and valid signatures for the SDK.
+func TestSignedTransaction(t *testing.T) {
+	payer := cadence.BytesToAddress([]byte{0x01})
+	seller := cadence.BytesToAddress([]byte{0x02})
+
+	for _, sigAlgo := range []crypto.SignatureAlgorithm{crypto.ECDSA_P256, crypto.ECDSA_secp256k1} {
+		t.Run(sigAlgo.String(), func(t *testing.T) {
+			privateKey, err := crypto.GeneratePrivateKey(sigAlgo, make([]byte, crypto.MinSeedLength))
+			require.NoError(t, err)
+			signer, err := txbuilder.NewInMemorySigner(privateKey.String(), flowclient.SignatureAlgorithm(sigAlgo.String()), flowclient.SHA3_256)
+			require.NoError(t, err)
+			assert.Equal(t, privateKey.PublicKey().Encode(), signer.PublicKey())
+
+			tx, err := txbuilder.NewTransaction(
+				[]byte("transaction(id: UInt64) { prepare(acct: &Account) {} }"),
+				[]cadence.Value{cadence.NewUInt64(42)},
+				txbuilder.Roles{
+					Proposer:    flowclient.ProposalKey{Address: seller, KeyIndex: 1, SequenceNumber: 7},
+					Payer:       payer,
+					Authorizers: []cadence.Address{seller},
+				},
+			)
+			require.NoError(t, err)
+			require.NoError(t, tx.Sign(
+				txbuilder.AccountSigner{Address: seller, KeyIndex: 1, Signer: signer},
+				txbuilder.AccountSigner{Address: payer, Signer: signer},
+			))
+
+			sdkTx, err := sdkclient.Transaction(tx.Transaction)
+			require.NoError(t, err)
+
+			id, err := tx.ID()
+			require.NoError(t, err)
+			assert.Equal(t, flowclient.Identifier(sdkTx.ID()), id)
+
+			hasher, err := crypto.NewHasher(crypto.SHA3_256)
+			require.NoError(t, err)
+
+			payload := append(append([]byte{}, flow.TransactionDomainTag[:]...), sdkTx.PayloadMessage()...)
+			valid, err := privateKey.PublicKey().Verify(sdkTx.PayloadSignatures[0].Signature, payload, hasher)
+			require.NoError(t, err)
+			assert.True(t, valid)
+
+			envelope := append(append([]byte{}, flow.TransactionDomainTag[:]...), sdkTx.EnvelopeMessage()...)
+			valid, err = privateKey.PublicKey().Verify(sdkTx.EnvelopeSignatures[0].Signature, envelope, hasher)
+			require.NoError(t, err)
+			assert.True(t, valid)
+		})
+	}
+}
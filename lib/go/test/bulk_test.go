@@ -0,0 +1,94 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bulk"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowclient"
+	"github.com/onflow/nft-storefront/lib/go/contracts/txerrors"
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+func TestBulkListing(t *testing.T) {
+	h := harness.New(t)
+	seller, other := h.CreateAccount(), h.CreateAccount()
+
+	planner, err := bulk.NewPlanner(h.Config(), harness.Network, bulk.Options{
+		NFTTypeIdentifier:   h.NFTType(),
+		TokenTypeIdentifier: h.TokenType(),
+		CommissionAmount:    harness.UFix64("1.0"),
+	})
+	require.NoError(t, err)
+
+	customID := "dapp"
+	rows := []bulk.Row{
+		{NFTID: h.MintNFT(seller), Price: harness.UFix64("10.0"), Expiry: harness.DefaultExpiry},
+		// Not in the collection of the seller, so skipped.
+		{NFTID: h.MintNFT(other), Price: harness.UFix64("11.0"), Expiry: harness.DefaultExpiry},
+		{NFTID: h.MintNFT(seller), Price: harness.UFix64("12.0"), Expiry: harness.DefaultExpiry, CustomID: &customID},
+	}
+	batches, err := planner.Plan(rows)
+	require.NoError(t, err)
+	require.Len(t, batches, 1)
+
+	args, err := planner.Arguments(batches[0])
+	require.NoError(t, err)
+	result := h.SendCode(planner.Transaction(), []*harness.Account{seller}, args...)
+
+	sealed := &flowclient.TransactionResult{Status: flowclient.StatusSealed}
+	for _, event := range result.Events {
+		sealed.Events = append(sealed.Events, flowclient.Event{Type: event.Type, Value: event.Value})
+	}
+	results, err := planner.Match(batches[0], cadence.Address(seller.Address), sealed)
+	require.NoError(t, err)
+	require.Len(t, results, 3)
+
+	assert.True(t, results[0].Listed())
+	assert.ErrorIs(t, results[1].Err, txerrors.ErrNFTMissing)
+	assert.True(t, results[2].Listed())
+	assert.ElementsMatch(t, []uint64{results[0].ListingResourceID, results[2].ListingResourceID}, h.ListingIDs(seller))
+
+	details := h.ListingDetails(seller, results[2].ListingResourceID)
+	assert.Equal(t, rows[2].NFTID, details.NFTID)
+	assert.Equal(t, harness.UFix64("12.0"), details.SalePrice)
+	assert.Equal(t, &customID, details.CustomID)
+}
+
+// TestBulkComputation checks that the default estimates of bulk.Budget
+// cover the computation of bulk listing transactions.
+func TestBulkComputation(t *testing.T) {
+	h := harness.New(t)
+	seller := h.CreateAccount()
+
+	planner, err := bulk.NewPlanner(h.Config(), harness.Network, bulk.Options{
+		NFTTypeIdentifier:   h.NFTType(),
+		TokenTypeIdentifier: h.TokenType(),
+		CommissionAmount:    harness.UFix64("1.0"),
+	})
+	require.NoError(t, err)
+
+	var usage []uint64
+	for _, n := range []int{1, 16} {
+		var rows []bulk.Row
+		for i := 0; i < n; i++ {
+			rows = append(rows, bulk.Row{NFTID: h.MintNFT(seller), Price: harness.UFix64("10.0"), Expiry: harness.DefaultExpiry})
+		}
+		batches, err := planner.Plan(rows)
+		require.NoError(t, err)
+		require.Len(t, batches, 1)
+
+		args, err := planner.Arguments(batches[0])
+		require.NoError(t, err)
+		result := h.SendCode(planner.Transaction(), []*harness.Account{seller}, args...)
+		assert.LessOrEqual(t, result.ComputationUsed, planner.Budget.Estimate(n), "%d listings", n)
+		usage = append(usage, result.ComputationUsed)
+	}
+
+	perListing := (usage[1] - usage[0]) / 15
+	assert.LessOrEqual(t, perListing, bulk.DefaultListingComputation)
+	assert.LessOrEqual(t, usage[0]-perListing, bulk.DefaultBaseComputation)
+}
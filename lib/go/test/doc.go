@@ -0,0 +1,5 @@
+// Package test holds the end-to-end tests of the storefront contracts and
+// of the shipped transactions and scripts. They are the Go counterparts of
+// the tests/*.cdc scenarios and run on an in-process emulator through
+// package harness.
+package test
@@ -0,0 +1,57 @@
+package test
+
+import (
+	"sort"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/duplicates"
+	"github.com/onflow/nft-storefront/lib/go/contracts/listings"
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+func readListings(t *testing.T, h *harness.Harness, seller *harness.Account) []listings.Listing {
+	t.Helper()
+
+	ids := h.ListingIDs(seller)
+	value := h.Script("scripts/read_listings_details.cdc", listings.ReadListingsDetailsArguments(cadence.Address(seller.Address), ids)...)
+
+	var read []listings.Listing
+	for _, pair := range value.(cadence.Dictionary).Pairs {
+		details, err := nftstorefrontv2.DecodeListingDetails(pair.Value)
+		require.NoError(t, err)
+		read = append(read, listings.Listing{ListingResourceID: uint64(pair.Key.(cadence.UInt64)), Details: details})
+	}
+	sort.Slice(read, func(i, j int) bool { return read[i].ListingResourceID < read[j].ListingResourceID })
+	return read
+}
+
+func TestCollapseDuplicateListings(t *testing.T) {
+	h := harness.New(t)
+	seller := h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	first := h.Sell(seller, nftID, harness.UFix64("10.0"))
+	second := h.Sell(seller, nftID, harness.UFix64("12.0"))
+	third := h.List(seller, harness.Listing{NFTID: nftID, Price: harness.UFix64("11.0"), TokenType: h.FlowTokenType()})
+	other := h.Sell(seller, h.MintNFT(seller), harness.UFix64("5.0"))
+
+	groups := duplicates.GroupListings(readListings(t, h, seller))
+	require.Len(t, groups, 2)
+	group := groups[0]
+	if group.NFTID != nftID {
+		group = groups[1]
+	}
+	assert.Len(t, group.Variants(), 3)
+
+	remove, err := group.Collapse(second)
+	require.NoError(t, err)
+	assert.ElementsMatch(t, []uint64{first, third}, remove)
+
+	h.Send("transactions/remove_items.cdc", []*harness.Account{seller}, duplicates.RemoveItemsArguments(remove)...)
+	assert.ElementsMatch(t, []uint64{second, other}, h.ListingIDs(seller))
+}
@@ -0,0 +1,448 @@
+// Package harness runs the contracts, transactions and scripts shipped with
+// this repository on an in-process Flow emulator, so that applications built
+// on the storefront can be tested end-to-end in Go:
+//
+//	h := harness.New(t)
+//	seller, buyer := h.CreateAccount(), h.CreateAccount()
+//	listingID := h.Sell(seller, h.MintNFT(seller), harness.UFix64("10.0"))
+//	h.Buy(buyer, listingID)
+//
+// The shipped files are taken from the embedded sources of the contracts
+// module, with their string imports resolved to the emulator accounts.
+package harness
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"path"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	jsoncdc "github.com/onflow/cadence/encoding/json"
+	"github.com/onflow/flow-emulator/adapters"
+	"github.com/onflow/flow-emulator/emulator"
+	"github.com/onflow/flow-emulator/types"
+	sdk "github.com/onflow/flow-go-sdk"
+	"github.com/onflow/flow-go-sdk/crypto"
+	"github.com/onflow/flow-go-sdk/templates"
+	"github.com/rs/zerolog"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/arguments"
+	"github.com/onflow/nft-storefront/lib/go/contracts/assets"
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+)
+
+const (
+	// Network is the network of Config the contracts are aliased for.
+	Network = "emulator"
+
+	// fungibleTokenAddress and flowTokenAddress are the accounts the
+	// emulator bootstraps the fungible token contracts to. Burner and the
+	// non-fungible token standard are bootstrapped to the service account.
+	fungibleTokenAddress = "ee82856bf20e2aa6"
+	flowTokenAddress     = "0ae53cb6e3f42a79"
+
+	computeLimit = 9999
+)
+
+// resolveContractView is the MetadataViews function the shipped transactions
+// resolve the collection and vault data of types with. It is missing from
+// the MetadataViews the emulator bootstraps, and added to it by New.
+const resolveContractView = `
+    access(all) fun resolveContractViewFromTypeIdentifier(
+        resourceTypeIdentifier: String,
+        viewType: Type
+    ): AnyStruct? {
+        if let resourceType = CompositeType(resourceTypeIdentifier) {
+            if let address = resourceType.address {
+                if let contractName = resourceType.contractName {
+                    if let resolver = getAccount(address).contracts.borrow<&{ViewResolver}>(name: contractName) {
+                        return resolver.resolveContractView(resourceType: resourceType, viewType: viewType)
+                    }
+                }
+            }
+        }
+        return nil
+    }
+`
+
+const updateContract = `
+transaction(name: String, code: String) {
+    prepare(signer: auth(UpdateContract) &Account) {
+        signer.contracts.update(name: name, code: code.utf8)
+    }
+}
+`
+
+// DefaultBalance is the amount of FLOW and of ExampleToken given to the
+// accounts created by CreateAccount.
+var DefaultBalance = UFix64("100.0")
+
+// Account is an emulator account the harness can sign for.
+type Account struct {
+	Address sdk.Address
+
+	keyIndex uint32
+	signer   crypto.Signer
+}
+
+// Harness is an emulator with the example and storefront contracts
+// deployed.
+type Harness struct {
+	// ExampleNFT, ExampleToken and Storefront are the accounts the
+	// contracts of the same name are deployed to. Storefront holds both
+	// NFTStorefrontV2 and NFTStorefront.
+	ExampleNFT   *Account
+	ExampleToken *Account
+	Storefront   *Account
+
+	t        testing.TB
+	ctx      context.Context
+	emulator *emulator.Blockchain
+	adapter  *adapters.SDKAdapter
+	config   *flowconfig.Config
+	service  *Account
+	clock    *clock
+	accounts int
+	minted   int
+	// listings maps the listings created through the harness to the
+	// storefront holding them.
+	listings map[uint64]sdk.Address
+}
+
+// New starts an emulator and deploys ExampleNFT, ExampleToken,
+// NFTStorefrontV2 and NFTStorefront to it. Storage limits and transaction
+// fees are disabled.
+func New(t testing.TB) *Harness {
+	t.Helper()
+
+	b, err := emulator.New(
+		emulator.WithLogger(zerolog.Nop()),
+		emulator.WithServerLogger(zerolog.Nop()),
+		emulator.WithStorageLimitEnabled(false),
+		emulator.WithTransactionFeesEnabled(false),
+	)
+	require.NoError(t, err)
+
+	logger := zerolog.Nop()
+	h := &Harness{
+		t:        t,
+		ctx:      context.Background(),
+		emulator: b,
+		adapter:  adapters.NewSDKAdapter(&logger, b),
+		clock:    &clock{},
+		listings: make(map[uint64]sdk.Address),
+	}
+	b.SetClock(h.clock)
+
+	serviceKey := b.ServiceKey()
+	signer, err := serviceKey.Signer()
+	require.NoError(t, err)
+	h.service = &Account{Address: serviceKey.Address, keyIndex: serviceKey.Index, signer: signer}
+
+	service := serviceKey.Address.Hex()
+	h.config = &flowconfig.Config{Contracts: make(map[string]flowconfig.Contract)}
+	for contract, address := range map[string]string{
+		"Burner":                     service,
+		"NonFungibleToken":           service,
+		"MetadataViews":              service,
+		"ViewResolver":               service,
+		"FungibleToken":              fungibleTokenAddress,
+		"FungibleTokenMetadataViews": fungibleTokenAddress,
+		"FlowToken":                  flowTokenAddress,
+	} {
+		h.alias(contract, address)
+	}
+	h.updateMetadataViews()
+
+	h.ExampleNFT = h.Deploy("contracts/utility/ExampleNFT.cdc")
+	h.ExampleToken = h.Deploy("contracts/utility/ExampleToken.cdc")
+	h.Storefront = h.Deploy("contracts/NFTStorefrontV2.cdc", "contracts/NFTStorefront.cdc")
+	return h
+}
+
+func (h *Harness) alias(contract, address string) {
+	h.config.Contracts[contract] = flowconfig.Contract{
+		Aliases: map[string]string{Network: address},
+	}
+}
+
+// updateMetadataViews adds resolveContractView to the MetadataViews contract
+// of the service account if it is missing.
+func (h *Harness) updateMetadataViews() {
+	h.t.Helper()
+
+	account, err := h.adapter.GetAccount(h.ctx, h.service.Address)
+	require.NoError(h.t, err)
+	code := string(account.Contracts["MetadataViews"])
+	if strings.Contains(code, "fun resolveContractViewFromTypeIdentifier(") {
+		return
+	}
+
+	end := strings.LastIndex(code, "}")
+	require.NotEqual(h.t, -1, end, "MetadataViews has no body")
+	code = code[:end] + resolveContractView + code[end:]
+	h.SendCode([]byte(updateContract), []*Account{h.service}, cadence.String("MetadataViews"), cadence.String(code))
+}
+
+// Service returns the service account of the emulator. It proposes and
+// pays for every transaction sent by the harness.
+func (h *Harness) Service() *Account {
+	return h.service
+}
+
+// Now returns the time of the blocks the next transactions are executed
+// in.
+func (h *Harness) Now() time.Time {
+	return h.clock.Now()
+}
+
+// MoveTime moves the time of the emulator forward, for example to let
+// listings expire.
+func (h *Harness) MoveTime(d time.Duration) {
+	h.t.Helper()
+
+	h.clock.offset += d
+	// The timestamp of a block is taken when the previous block is
+	// committed.
+	_, err := h.emulator.CommitBlock()
+	require.NoError(h.t, err)
+}
+
+// clock is the system clock moved forward by MoveTime.
+type clock struct {
+	offset time.Duration
+}
+
+func (c *clock) Now() time.Time {
+	return time.Now().UTC().Add(c.offset)
+}
+
+// Address returns the address of the given contract.
+func (h *Harness) Address(contract string) cadence.Address {
+	h.t.Helper()
+
+	address, err := h.config.Address(contract, Network)
+	require.NoError(h.t, err)
+	return address
+}
+
+// Code returns the source of the given shipped file, for example
+// transactions/sell_item.cdc, with its imports resolved.
+func (h *Harness) Code(name string) []byte {
+	h.t.Helper()
+
+	f, ok := assets.Lookup(name)
+	require.True(h.t, ok, "unknown file %s", name)
+	return h.Resolve(f.Code())
+}
+
+// Config returns the configuration aliasing the contracts to the emulator
+// accounts, for code that resolves imports itself.
+func (h *Harness) Config() *flowconfig.Config {
+	return h.config
+}
+
+// Resolve resolves the string imports of the given code to the emulator
+// accounts.
+func (h *Harness) Resolve(code []byte) []byte {
+	h.t.Helper()
+
+	resolved, err := h.config.ResolveImports(code, Network)
+	require.NoError(h.t, err)
+	return resolved
+}
+
+// Deploy creates an account with the given shipped contracts, for example
+// contracts/utility/test/MaliciousStorefrontV2.cdc. The contracts can then
+// be imported by the code run through the harness.
+func (h *Harness) Deploy(names ...string) *Account {
+	h.t.Helper()
+
+	var contracts []templates.Contract
+	for _, name := range names {
+		contracts = append(contracts, templates.Contract{
+			Name:   strings.TrimSuffix(path.Base(name), ".cdc"),
+			Source: string(h.Code(name)),
+		})
+	}
+
+	account := h.newAccount(contracts)
+	for _, contract := range contracts {
+		h.alias(contract.Name, account.Address.Hex())
+	}
+	return account
+}
+
+// CreateAccount creates an account with an ExampleNFT collection, an
+// ExampleToken vault and a storefront, and funds it with DefaultBalance
+// FLOW and ExampleToken.
+func (h *Harness) CreateAccount() *Account {
+	h.t.Helper()
+
+	account := h.newAccount(nil)
+	for _, name := range []string{
+		"transactions/example-nft/setup_account.cdc",
+		"transactions/example-token/setup_account.cdc",
+		"transactions/setup_account.cdc",
+	} {
+		h.Send(name, []*Account{account})
+	}
+
+	address := cadence.Address(account.Address)
+	h.Send("transactions/flow-token/transfer_flow.cdc", []*Account{h.service}, DefaultBalance, address)
+	h.Send("transactions/example-token/mint_tokens.cdc", []*Account{h.ExampleToken}, address, DefaultBalance)
+	return account
+}
+
+func (h *Harness) newAccount(contracts []templates.Contract) *Account {
+	h.t.Helper()
+
+	// Keys are derived from a counter so that runs are reproducible.
+	h.accounts++
+	seed := make([]byte, crypto.MinSeedLength)
+	binary.BigEndian.PutUint64(seed, uint64(h.accounts))
+	privateKey, err := crypto.GeneratePrivateKey(crypto.ECDSA_P256, seed)
+	require.NoError(h.t, err)
+
+	key := sdk.NewAccountKey().
+		FromPrivateKey(privateKey).
+		SetHashAlgo(crypto.SHA3_256).
+		SetWeight(sdk.AccountKeyWeightThreshold)
+	address, err := h.adapter.CreateAccount(h.ctx, []*sdk.AccountKey{key}, contracts)
+	require.NoError(h.t, err)
+
+	signer, err := crypto.NewInMemorySigner(privateKey, crypto.SHA3_256)
+	require.NoError(h.t, err)
+	return &Account{Address: address, signer: signer}
+}
+
+// Send runs the given shipped transaction, authorized by the signers, and
+// fails the test if it is rejected. The arguments are checked against the
+// parameters of the transaction first.
+func (h *Harness) Send(name string, signers []*Account, args ...cadence.Value) *types.TransactionResult {
+	h.t.Helper()
+
+	result := h.TrySend(name, signers, args...)
+	require.NoError(h.t, result.Error, name)
+	return result
+}
+
+// TrySend runs the given shipped transaction like Send, but returns the
+// result of a rejected transaction instead of failing the test.
+func (h *Harness) TrySend(name string, signers []*Account, args ...cadence.Value) *types.TransactionResult {
+	h.t.Helper()
+
+	require.NoError(h.t, arguments.Check(name, args))
+	return h.TrySendCode(h.Code(name), signers, args...)
+}
+
+// SendCode runs the given transaction code, authorized by the signers, and
+// fails the test if it is rejected.
+func (h *Harness) SendCode(code []byte, signers []*Account, args ...cadence.Value) *types.TransactionResult {
+	h.t.Helper()
+
+	result := h.TrySendCode(code, signers, args...)
+	require.NoError(h.t, result.Error)
+	return result
+}
+
+// TrySendCode runs the given transaction code and returns its result. The
+// service account proposes and pays for the transaction.
+func (h *Harness) TrySendCode(code []byte, signers []*Account, args ...cadence.Value) *types.TransactionResult {
+	h.t.Helper()
+
+	header, _, err := h.adapter.GetLatestBlockHeader(h.ctx, true)
+	require.NoError(h.t, err)
+
+	serviceKey := h.emulator.ServiceKey()
+	tx := sdk.NewTransaction().
+		SetScript(code).
+		SetComputeLimit(computeLimit).
+		SetReferenceBlockID(header.ID).
+		SetProposalKey(serviceKey.Address, serviceKey.Index, serviceKey.SequenceNumber).
+		SetPayer(serviceKey.Address)
+	for _, arg := range args {
+		require.NoError(h.t, tx.AddArgument(arg))
+	}
+	for _, signer := range signers {
+		tx.AddAuthorizer(signer.Address)
+	}
+
+	// The service account signs the envelope, and with it its own
+	// authorization.
+	for _, signer := range signers {
+		if signer.Address == h.service.Address {
+			continue
+		}
+		require.NoError(h.t, tx.SignPayload(signer.Address, signer.keyIndex, signer.signer))
+	}
+	require.NoError(h.t, tx.SignEnvelope(h.service.Address, h.service.keyIndex, h.service.signer))
+
+	require.NoError(h.t, h.adapter.SendTransaction(h.ctx, *tx))
+	_, results, err := h.emulator.ExecuteAndCommitBlock()
+	require.NoError(h.t, err)
+	require.Len(h.t, results, 1)
+	return results[0]
+}
+
+// Script runs the given shipped script and returns its result, failing the
+// test if it panics. The arguments are checked against the parameters of
+// the script first.
+func (h *Harness) Script(name string, args ...cadence.Value) cadence.Value {
+	h.t.Helper()
+
+	result := h.TryScript(name, args...)
+	require.NoError(h.t, result.Error, name)
+	return result.Value
+}
+
+// TryScript runs the given shipped script like Script, but returns the
+// result of a failed script instead of failing the test.
+func (h *Harness) TryScript(name string, args ...cadence.Value) *types.ScriptResult {
+	h.t.Helper()
+
+	require.NoError(h.t, arguments.Check(name, args))
+	return h.TryScriptCode(h.Code(name), args...)
+}
+
+// TryScriptCode runs the given script code and returns its result.
+func (h *Harness) TryScriptCode(code []byte, args ...cadence.Value) *types.ScriptResult {
+	h.t.Helper()
+
+	encoded := make([][]byte, 0, len(args))
+	for _, arg := range args {
+		argument, err := jsoncdc.Encode(arg)
+		require.NoError(h.t, err)
+		encoded = append(encoded, argument)
+	}
+
+	result, err := h.emulator.ExecuteScript(code, encoded)
+	require.NoError(h.t, err)
+	return result
+}
+
+// Events returns the events of the given type emitted by a transaction.
+func Events(result *types.TransactionResult, eventType string) []cadence.Event {
+	var events []cadence.Event
+	for _, event := range result.Events {
+		if event.Type == eventType {
+			events = append(events, event.Value)
+		}
+	}
+	return events
+}
+
+// UFix64 parses a UFix64 literal such as "10.0". It panics if the literal is
+// invalid.
+func UFix64(literal string) cadence.UFix64 {
+	value, err := cadence.NewUFix64(literal)
+	if err != nil {
+		panic(fmt.Sprintf("invalid UFix64 %q: %s", literal, err))
+	}
+	return value
+}
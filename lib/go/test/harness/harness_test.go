@@ -0,0 +1,29 @@
+package harness_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+func TestSellAndBuy(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer := h.CreateAccount(), h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	listingID := h.Sell(seller, nftID, harness.UFix64("10.0"))
+
+	details := h.ListingDetails(seller, listingID)
+	assert.Equal(t, nftID, details.NFTID)
+	assert.False(t, details.Purchased)
+	assert.Equal(t, []uint64{listingID}, h.ListingIDs(seller))
+
+	h.Buy(buyer, listingID)
+
+	assert.Equal(t, []uint64{nftID}, h.NFTIDs(buyer))
+	assert.Empty(t, h.NFTIDs(seller))
+	assert.Equal(t, harness.UFix64("110.0"), h.Balance(seller))
+	assert.Equal(t, harness.UFix64("90.0"), h.Balance(buyer))
+}
@@ -0,0 +1,229 @@
+package harness
+
+import (
+	"fmt"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	sdk "github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+)
+
+// DefaultExpiry is the expiry of the listings created by Sell,
+// 2100-01-01T00:00:00Z.
+const DefaultExpiry uint64 = 4102444800
+
+// Listing describes a listing to create with List.
+type Listing struct {
+	NFTID uint64
+	Price cadence.UFix64
+	// CustomID is the identifier of the dApp creating the listing, if any.
+	CustomID   *string
+	Commission cadence.UFix64
+	// Expiry is a Unix timestamp. DefaultExpiry is used if it is zero.
+	Expiry uint64
+	// Marketplaces are the accounts allowed to receive the commission. Any
+	// account may receive it if there are none.
+	Marketplaces []sdk.Address
+	// NFTType and TokenType are type identifiers. ExampleNFT.NFT and
+	// ExampleToken.Vault are used if they are empty.
+	NFTType   string
+	TokenType string
+}
+
+// NFTType returns the type identifier of ExampleNFT.NFT.
+func (h *Harness) NFTType() string {
+	return h.typeIdentifier("ExampleNFT", "NFT")
+}
+
+// TokenType returns the type identifier of ExampleToken.Vault.
+func (h *Harness) TokenType() string {
+	return h.typeIdentifier("ExampleToken", "Vault")
+}
+
+// FlowTokenType returns the type identifier of FlowToken.Vault.
+func (h *Harness) FlowTokenType() string {
+	return h.typeIdentifier("FlowToken", "Vault")
+}
+
+func (h *Harness) typeIdentifier(contract, name string) string {
+	return fmt.Sprintf("A.%s.%s.%s", h.Address(contract).Hex(), contract, name)
+}
+
+// MintNFT mints an ExampleNFT without royalties to the owner and returns its
+// ID.
+func (h *Harness) MintNFT(owner *Account) uint64 {
+	h.t.Helper()
+
+	h.minted++
+	name := fmt.Sprintf("NFT %d", h.minted)
+	result := h.Send("transactions/example-nft/mint_nft.cdc", []*Account{h.ExampleNFT},
+		cadence.Address(owner.Address),
+		cadence.String(name),
+		cadence.String("nft description"),
+		cadence.String("https://example.com/nft.png"),
+		cadence.NewArray(nil).WithType(cadence.NewVariableSizedArrayType(cadence.UFix64Type)),
+		cadence.NewArray(nil).WithType(cadence.NewVariableSizedArrayType(cadence.StringType)),
+		cadence.NewArray(nil).WithType(cadence.NewVariableSizedArrayType(cadence.AddressType)),
+	)
+
+	events := Events(result, fmt.Sprintf("A.%s.ExampleNFT.Minted", h.ExampleNFT.Address.Hex()))
+	require.Len(h.t, events, 1)
+	id, ok := cadence.SearchFieldByName(events[0], "newID").(cadence.UInt64)
+	require.True(h.t, ok)
+	return uint64(id)
+}
+
+// Sell lists the NFT for the price in ExampleToken, without commission, and
+// returns the ID of the listing.
+func (h *Harness) Sell(seller *Account, nftID uint64, price cadence.UFix64) uint64 {
+	h.t.Helper()
+
+	return h.List(seller, Listing{NFTID: nftID, Price: price})
+}
+
+// List creates the listing with transactions/sell_item.cdc and returns its
+// ID.
+func (h *Harness) List(seller *Account, listing Listing) uint64 {
+	h.t.Helper()
+
+	result := h.Send("transactions/sell_item.cdc", []*Account{seller}, h.SellItemArguments(listing)...)
+
+	events := Events(result, nftstorefrontv2.ListingAvailableEventType(h.Address("NFTStorefrontV2")))
+	require.Len(h.t, events, 1)
+	available, err := nftstorefrontv2.DecodeListingAvailable(events[0])
+	require.NoError(h.t, err)
+
+	h.listings[available.ListingResourceID] = seller.Address
+	return available.ListingResourceID
+}
+
+// SellItemArguments returns the arguments of transactions/sell_item.cdc for
+// the listing.
+func (h *Harness) SellItemArguments(listing Listing) []cadence.Value {
+	customID := cadence.NewOptional(nil)
+	if listing.CustomID != nil {
+		customID = cadence.NewOptional(cadence.String(*listing.CustomID))
+	}
+
+	expiry := listing.Expiry
+	if expiry == 0 {
+		expiry = DefaultExpiry
+	}
+
+	marketplaces := make([]cadence.Value, 0, len(listing.Marketplaces))
+	for _, address := range listing.Marketplaces {
+		marketplaces = append(marketplaces, cadence.Address(address))
+	}
+
+	nftType := listing.NFTType
+	if nftType == "" {
+		nftType = h.NFTType()
+	}
+	tokenType := listing.TokenType
+	if tokenType == "" {
+		tokenType = h.TokenType()
+	}
+
+	return []cadence.Value{
+		cadence.NewUInt64(listing.NFTID),
+		listing.Price,
+		customID,
+		listing.Commission,
+		cadence.NewUInt64(expiry),
+		cadence.NewArray(marketplaces).WithType(cadence.NewVariableSizedArrayType(cadence.AddressType)),
+		cadence.String(nftType),
+		cadence.String(tokenType),
+	}
+}
+
+// Buy purchases a listing created through the harness, without commission
+// recipient.
+func (h *Harness) Buy(buyer *Account, listingID uint64) {
+	h.t.Helper()
+
+	h.Send("transactions/buy_item.cdc", []*Account{buyer}, h.BuyItemArguments(listingID, nil)...)
+}
+
+// BuyItemArguments returns the arguments of transactions/buy_item.cdc for a
+// listing created through the harness.
+func (h *Harness) BuyItemArguments(listingID uint64, commissionRecipient *Account) []cadence.Value {
+	h.t.Helper()
+
+	recipient := cadence.NewOptional(nil)
+	if commissionRecipient != nil {
+		recipient = cadence.NewOptional(cadence.Address(commissionRecipient.Address))
+	}
+
+	return []cadence.Value{
+		cadence.NewUInt64(listingID),
+		cadence.Address(h.Seller(listingID)),
+		recipient,
+		cadence.String(h.NFTType()),
+	}
+}
+
+// Seller returns the storefront holding a listing created through the
+// harness.
+func (h *Harness) Seller(listingID uint64) sdk.Address {
+	h.t.Helper()
+
+	seller, ok := h.listings[listingID]
+	require.True(h.t, ok, "listing %d was not created through the harness", listingID)
+	return seller
+}
+
+// ListingDetails returns the details of a listing in the seller's
+// storefront.
+func (h *Harness) ListingDetails(seller *Account, listingID uint64) nftstorefrontv2.ListingDetails {
+	h.t.Helper()
+
+	value := h.Script("scripts/read_listing_details.cdc", cadence.Address(seller.Address), cadence.NewUInt64(listingID))
+	details, err := nftstorefrontv2.DecodeListingDetails(value)
+	require.NoError(h.t, err)
+	return details
+}
+
+// ListingIDs returns the IDs of the listings in the account's storefront.
+func (h *Harness) ListingIDs(account *Account) []uint64 {
+	h.t.Helper()
+
+	value := h.Script("scripts/read_storefront_ids.cdc", cadence.Address(account.Address))
+	return h.uint64s(value)
+}
+
+// NFTIDs returns the IDs of the ExampleNFTs owned by the account.
+func (h *Harness) NFTIDs(account *Account) []uint64 {
+	h.t.Helper()
+
+	path := cadence.Path{Domain: common.PathDomainPublic, Identifier: "exampleNFTCollection"}
+	value := h.Script("scripts/example-nft/get_ids.cdc", cadence.Address(account.Address), path)
+	return h.uint64s(value)
+}
+
+// Balance returns the ExampleToken balance of the account.
+func (h *Harness) Balance(account *Account) cadence.UFix64 {
+	h.t.Helper()
+
+	value := h.Script("scripts/example-token/get_balance.cdc", cadence.Address(account.Address))
+	balance, ok := value.(cadence.UFix64)
+	require.True(h.t, ok, "balance is %s", value)
+	return balance
+}
+
+func (h *Harness) uint64s(value cadence.Value) []uint64 {
+	h.t.Helper()
+
+	array, ok := value.(cadence.Array)
+	require.True(h.t, ok, "%s is not an array", value)
+
+	ids := make([]uint64, 0, len(array.Values))
+	for _, element := range array.Values {
+		id, ok := element.(cadence.UInt64)
+		require.True(h.t, ok, "%s is not a UInt64", element)
+		ids = append(ids, uint64(id))
+	}
+	return ids
+}
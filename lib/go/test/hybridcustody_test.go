@@ -0,0 +1,66 @@
+package test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/onflow/cadence/common"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/flowconfig"
+	"github.com/onflow/nft-storefront/lib/go/contracts/hybridcustody"
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+func TestSellItemInChildFromParent(t *testing.T) {
+	h := harness.New(t)
+
+	// The test HybridCustody stands in for the other hybrid custody
+	// contracts, which the transaction does not import.
+	h.Deploy("contracts/utility/test/HybridCustody.cdc")
+	config := h.Config()
+	for _, contract := range []string{"CapabilityFactory", "CapabilityFilter", "CapabilityDelegator"} {
+		config.Contracts[contract] = flowconfig.Contract{
+			Aliases: map[string]string{harness.Network: h.Address("HybridCustody").Hex()},
+		}
+	}
+
+	parent, child, buyer := h.CreateAccount(), h.CreateAccount(), h.CreateAccount()
+	link, err := os.ReadFile("../../../tests/transactions/link_hybrid_custody_child.cdc")
+	require.NoError(t, err)
+	h.SendCode(h.Resolve(link), []*harness.Account{parent, child})
+	nftID := h.MintNFT(child)
+
+	code, err := hybridcustody.SellItemInChildFromParentTransaction(config, harness.Network)
+	require.NoError(t, err)
+	args, err := hybridcustody.SellItemInChildFromParentArguments{
+		ChildAddress:          cadence.Address(child.Address),
+		CollectionStoragePath: cadence.Path{Domain: common.PathDomainStorage, Identifier: "exampleNFTCollection"},
+		CollectionPublicPath:  cadence.Path{Domain: common.PathDomainPublic, Identifier: "exampleNFTCollection"},
+		NFTTypeIdentifier:     h.NFTType(),
+		SaleItemID:            nftID,
+		SaleItemPrice:         harness.UFix64("10.0"),
+		Expiry:                harness.DefaultExpiry,
+	}.Values()
+	require.NoError(t, err)
+	h.SendCode(code, []*harness.Account{parent}, args...)
+
+	listingIDs := h.ListingIDs(parent)
+	require.Len(t, listingIDs, 1)
+	details := h.ListingDetails(parent, listingIDs[0])
+	assert.Equal(t, nftID, details.NFTID)
+	assert.Equal(t, h.FlowTokenType(), details.SalePaymentVaultType.ID())
+
+	// The NFT is withdrawn from the child account when the listing is
+	// purchased.
+	h.Send("transactions/buy_item.cdc", []*harness.Account{buyer},
+		cadence.NewUInt64(listingIDs[0]),
+		cadence.Address(parent.Address),
+		cadence.NewOptional(nil),
+		cadence.String(h.NFTType()),
+	)
+	assert.Empty(t, h.NFTIDs(child))
+	assert.Contains(t, h.NFTIDs(buyer), nftID)
+}
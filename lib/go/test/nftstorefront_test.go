@@ -0,0 +1,104 @@
+package test
+
+import (
+	"os"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefront"
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+// sellV1 lists the NFT in the seller's NFTStorefront with
+// transactions-v1/sell_item.cdc and returns the ID of the listing.
+func sellV1(t *testing.T, h *harness.Harness, seller *harness.Account, nftID uint64, price cadence.UFix64) uint64 {
+	t.Helper()
+
+	result := h.Send("transactions-v1/sell_item.cdc", []*harness.Account{seller}, cadence.NewUInt64(nftID), price)
+
+	available := harness.Events(result, nftstorefront.ListingAvailableEventType(h.Address("NFTStorefront")))
+	require.Len(t, available, 1)
+	event, err := nftstorefront.DecodeListingAvailable(available[0])
+	require.NoError(t, err)
+	return event.ListingResourceID
+}
+
+func listingIDsV1(t *testing.T, h *harness.Harness, account *harness.Account) []uint64 {
+	t.Helper()
+
+	return uint64s(t, h.Script("transactions-v1/scripts-v1/read_storefront_ids.cdc", cadence.Address(account.Address)))
+}
+
+func newV1Account(h *harness.Harness) *harness.Account {
+	account := h.CreateAccount()
+	h.Send("transactions-v1/setup_account.cdc", []*harness.Account{account})
+	return account
+}
+
+func TestV1BuyItem(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer := newV1Account(h), newV1Account(h)
+
+	nftID := h.MintNFT(seller)
+	listingID := sellV1(t, h, seller, nftID, harness.UFix64("10.0"))
+	assert.Equal(t, []uint64{listingID}, listingIDsV1(t, h, seller))
+
+	exists := h.Script("transactions-v1/scripts-v1/verify_listed_nft_exists.cdc", cadence.Address(seller.Address), cadence.NewUInt64(listingID))
+	assert.Equal(t, cadence.NewBool(true), exists)
+	h.Script("transactions-v1/scripts-v1/read_listing_details.cdc", cadence.Address(seller.Address), cadence.NewUInt64(listingID))
+
+	// An unpurchased listing cannot be cleaned up.
+	result := h.TrySend("transactions-v1/cleanup_item.cdc", []*harness.Account{buyer}, cadence.NewUInt64(listingID), cadence.Address(seller.Address))
+	assert.Error(t, result.Error)
+
+	h.Send("transactions-v1/buy_item.cdc", []*harness.Account{buyer}, cadence.NewUInt64(listingID), cadence.Address(seller.Address))
+
+	assert.Equal(t, []uint64{nftID}, h.NFTIDs(buyer))
+	assert.Equal(t, harness.UFix64("110.0"), h.Balance(seller))
+	assert.Empty(t, listingIDsV1(t, h, seller))
+}
+
+func TestV1RemoveItem(t *testing.T) {
+	h := harness.New(t)
+	seller := newV1Account(h)
+
+	nftID := h.MintNFT(seller)
+	listingID := sellV1(t, h, seller, nftID, harness.UFix64("10.0"))
+
+	result := h.Send("transactions-v1/remove_item.cdc", []*harness.Account{seller}, cadence.NewUInt64(listingID))
+
+	completed := harness.Events(result, nftstorefront.ListingCompletedEventType(h.Address("NFTStorefront")))
+	require.Len(t, completed, 1)
+	event, err := nftstorefront.DecodeListingCompleted(completed[0])
+	require.NoError(t, err)
+	assert.Equal(t, listingID, event.ListingResourceID)
+	assert.False(t, event.Purchased)
+	assert.Equal(t, h.NFTType(), event.NFTType.ID())
+	assert.Equal(t, nftID, event.NFTID)
+
+	assert.Empty(t, listingIDsV1(t, h, seller))
+}
+
+func TestV1MaliciousListing(t *testing.T) {
+	h := harness.New(t)
+	buyer := newV1Account(h)
+
+	h.Deploy("contracts/utility/test/MaliciousStorefrontV1.cdc")
+	code, err := os.ReadFile("../../../tests/transactions/create_malicious_listing_v1.cdc")
+	require.NoError(t, err)
+	result := h.SendCode(h.Resolve(code), []*harness.Account{h.ExampleNFT})
+
+	available := harness.Events(result, nftstorefront.ListingAvailableEventType(h.Address("NFTStorefront")))
+	require.Len(t, available, 1)
+	event, err := nftstorefront.DecodeListingAvailable(available[0])
+	require.NoError(t, err)
+
+	result = h.TrySend("transactions-v1/buy_item.cdc", []*harness.Account{buyer},
+		cadence.NewUInt64(event.ListingResourceID),
+		cadence.Address(h.ExampleNFT.Address),
+	)
+	assert.ErrorContains(t, result.Error, "Cannot borrow a non-NFTStorefront.Listing!")
+}
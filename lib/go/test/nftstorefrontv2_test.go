@@ -0,0 +1,296 @@
+package test
+
+import (
+	"os"
+	"testing"
+	"time"
+
+	"github.com/onflow/cadence"
+	sdk "github.com/onflow/flow-go-sdk"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+func uint64s(t *testing.T, value cadence.Value) []uint64 {
+	t.Helper()
+
+	if optional, ok := value.(cadence.Optional); ok {
+		value = optional.Value
+	}
+	array, ok := value.(cadence.Array)
+	require.True(t, ok, "%s is not an array", value)
+
+	var result []uint64
+	for _, element := range array.Values {
+		result = append(result, uint64(element.(cadence.UInt64)))
+	}
+	return result
+}
+
+func customID(id string) *string {
+	return &id
+}
+
+func TestV2BuyItem(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer, marketplace := h.CreateAccount(), h.CreateAccount(), h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	listingID := h.List(seller, harness.Listing{
+		NFTID:        nftID,
+		Price:        harness.UFix64("10.0"),
+		CustomID:     customID("Custom"),
+		Commission:   harness.UFix64("0.1"),
+		Marketplaces: []sdk.Address{marketplace.Address},
+	})
+
+	receivers := h.Script("scripts/read_allowed_commission_receivers.cdc", cadence.Address(seller.Address), cadence.NewUInt64(listingID))
+	require.IsType(t, cadence.Optional{}, receivers)
+	assert.Len(t, receivers.(cadence.Optional).Value.(cadence.Array).Values, 1)
+
+	details := h.ListingDetails(seller, listingID)
+	assert.Equal(t, nftID, details.NFTID)
+	assert.Equal(t, h.NFTType(), details.NFTType.ID())
+	assert.Equal(t, h.TokenType(), details.SalePaymentVaultType.ID())
+	assert.Equal(t, harness.UFix64("10.0"), details.SalePrice)
+	assert.Equal(t, customID("Custom"), details.CustomID)
+
+	duplicates := h.Script("scripts/read_duplicate_listing_ids.cdc",
+		cadence.Address(seller.Address), cadence.NewUInt64(nftID), cadence.NewUInt64(listingID), cadence.String(h.NFTType()))
+	assert.Empty(t, uint64s(t, duplicates))
+
+	result := h.Send("transactions/buy_item.cdc", []*harness.Account{buyer}, h.BuyItemArguments(listingID, marketplace)...)
+
+	completed := harness.Events(result, nftstorefrontv2.ListingCompletedEventType(h.Address("NFTStorefrontV2")))
+	require.Len(t, completed, 1)
+	event, err := nftstorefrontv2.DecodeListingCompleted(completed[0])
+	require.NoError(t, err)
+	assert.True(t, event.Purchased)
+	require.NotNil(t, event.CommissionReceiver)
+	assert.Equal(t, cadence.Address(marketplace.Address), *event.CommissionReceiver)
+
+	assert.Equal(t, []uint64{nftID}, h.NFTIDs(buyer))
+	assert.Equal(t, harness.UFix64("109.9"), h.Balance(seller))
+	assert.Equal(t, harness.UFix64("100.1"), h.Balance(marketplace))
+	assert.Equal(t, harness.UFix64("90.0"), h.Balance(buyer))
+
+	// The purchased listing stays in the storefront until it is cleaned
+	// up, which anyone can do.
+	assert.Equal(t, []uint64{listingID}, h.ListingIDs(seller))
+	h.Send("transactions/cleanup_purchased_listings.cdc", []*harness.Account{buyer},
+		cadence.Address(seller.Address), cadence.NewUInt64(listingID))
+	assert.Empty(t, h.ListingIDs(seller))
+}
+
+func TestV2CommissionRecipientNotAllowed(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer, marketplace := h.CreateAccount(), h.CreateAccount(), h.CreateAccount()
+
+	listingID := h.List(seller, harness.Listing{
+		NFTID:        h.MintNFT(seller),
+		Price:        harness.UFix64("10.0"),
+		Commission:   harness.UFix64("0.1"),
+		Marketplaces: []sdk.Address{marketplace.Address},
+	})
+
+	result := h.TrySend("transactions/buy_item.cdc", []*harness.Account{buyer}, h.BuyItemArguments(listingID, buyer)...)
+	assert.Error(t, result.Error)
+	assert.Equal(t, harness.UFix64("100.0"), h.Balance(buyer))
+
+	result = h.TrySend("transactions/buy_item.cdc", []*harness.Account{buyer}, h.BuyItemArguments(listingID, nil)...)
+	assert.ErrorContains(t, result.Error, "Commission recipient can not be empty when commission amount is non zero")
+}
+
+func TestV2CleanupGhostListing(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer := h.CreateAccount(), h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	listingID := h.List(seller, harness.Listing{NFTID: nftID, Price: harness.UFix64("10.0"), CustomID: customID("Custom")})
+
+	storefront := cadence.Address(seller.Address)
+	assert.Equal(t, cadence.NewBool(true), h.Script("scripts/has_listing_become_ghosted.cdc", storefront, cadence.NewUInt64(listingID)))
+
+	h.Send("transactions/example-nft/burn_nft.cdc", []*harness.Account{seller}, cadence.NewUInt64(nftID))
+
+	// has_listing_become_ghosted returns whether the NFT is still
+	// available.
+	assert.Equal(t, cadence.NewBool(false), h.Script("scripts/has_listing_become_ghosted.cdc", storefront, cadence.NewUInt64(listingID)))
+	assert.Equal(t, []uint64{listingID}, uint64s(t, h.Script("scripts/read_all_unique_ghost_listings.cdc", storefront)))
+
+	h.Send("transactions/cleanup_ghost_listing.cdc", []*harness.Account{buyer}, cadence.NewUInt64(listingID), storefront)
+	assert.Empty(t, h.ListingIDs(seller))
+}
+
+func TestV2CleanupGhostListingRemovesDuplicates(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer := h.CreateAccount(), h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	listing := harness.Listing{NFTID: nftID, Price: harness.UFix64("10.0"), CustomID: customID("Custom"), Commission: harness.UFix64("0.1")}
+	primaryID := h.List(seller, listing)
+	duplicateID := h.List(seller, listing)
+	assert.ElementsMatch(t, []uint64{primaryID, duplicateID}, h.ListingIDs(seller))
+
+	storefront := cadence.Address(seller.Address)
+	existing := func() []uint64 {
+		return uint64s(t, h.Script("scripts/get_existing_listing_ids.cdc", storefront, cadence.String(h.NFTType()), cadence.NewUInt64(nftID)))
+	}
+	assert.Len(t, existing(), 2)
+
+	duplicates := h.Script("scripts/read_duplicate_listing_ids.cdc",
+		storefront, cadence.NewUInt64(nftID), cadence.NewUInt64(primaryID), cadence.String(h.NFTType()))
+	assert.Equal(t, []uint64{duplicateID}, uint64s(t, duplicates))
+
+	h.Send("transactions/example-nft/burn_nft.cdc", []*harness.Account{seller}, cadence.NewUInt64(nftID))
+	h.Send("transactions/cleanup_ghost_listing.cdc", []*harness.Account{buyer}, cadence.NewUInt64(primaryID), storefront)
+
+	// Cleaning up a ghost listing removes its duplicates and clears the
+	// listed NFT entry.
+	assert.Empty(t, h.ListingIDs(seller))
+	assert.Empty(t, existing())
+}
+
+func TestV2IsGhostListing(t *testing.T) {
+	h := harness.New(t)
+	seller := h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	listingID := h.Sell(seller, nftID, harness.UFix64("10.0"))
+
+	storefront := cadence.Address(seller.Address)
+	assert.Equal(t, cadence.NewBool(false), h.Script("scripts/is_ghost_listing.cdc", storefront, cadence.NewUInt64(listingID)))
+	assert.Empty(t, uint64s(t, h.Script("scripts/read_all_unique_ghost_listings_v2.cdc", storefront)))
+
+	h.Send("transactions/example-nft/burn_nft.cdc", []*harness.Account{seller}, cadence.NewUInt64(nftID))
+
+	assert.Equal(t, cadence.NewBool(true), h.Script("scripts/is_ghost_listing.cdc", storefront, cadence.NewUInt64(listingID)))
+	assert.Equal(t, []uint64{listingID}, uint64s(t, h.Script("scripts/read_all_unique_ghost_listings_v2.cdc", storefront)))
+}
+
+func TestV2SellItemWithMarketplaceCut(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer, marketplace := h.CreateAccount(), h.CreateAccount(), h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	result := h.Send("transactions/sell_item_with_marketplace_cut.cdc", []*harness.Account{seller},
+		cadence.NewUInt64(nftID),
+		harness.UFix64("10.0"),
+		cadence.NewOptional(cadence.String("Custom1")),
+		cadence.NewUInt64(harness.DefaultExpiry),
+		cadence.Address(marketplace.Address),
+		harness.UFix64("0.1"),
+		cadence.String(h.NFTType()),
+		cadence.String(h.FlowTokenType()),
+	)
+
+	available := harness.Events(result, nftstorefrontv2.ListingAvailableEventType(h.Address("NFTStorefrontV2")))
+	require.Len(t, available, 1)
+	event, err := nftstorefrontv2.DecodeListingAvailable(available[0])
+	require.NoError(t, err)
+	assert.Equal(t, []uint64{event.ListingResourceID}, h.ListingIDs(seller))
+
+	details := h.ListingDetails(seller, event.ListingResourceID)
+	assert.Equal(t, h.FlowTokenType(), details.SalePaymentVaultType.ID())
+	require.Len(t, details.SaleCuts, 2)
+	assert.Equal(t, harness.UFix64("9.0"), details.SaleCuts[0].Amount)
+	assert.Equal(t, harness.UFix64("1.0"), details.SaleCuts[1].Amount)
+
+	// Buying with the storefront's buy_item pays in FLOW.
+	h.Send("transactions/buy_item.cdc", []*harness.Account{buyer},
+		cadence.NewUInt64(event.ListingResourceID),
+		cadence.Address(seller.Address),
+		cadence.NewOptional(nil),
+		cadence.String(h.NFTType()),
+	)
+	assert.Equal(t, []uint64{nftID}, h.NFTIDs(buyer))
+}
+
+func TestV2ReplaceAndExpireListing(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer := h.CreateAccount(), h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	originalID := h.Sell(seller, nftID, harness.UFix64("10.0"))
+
+	listing := harness.Listing{
+		NFTID:        nftID,
+		Price:        harness.UFix64("10.0"),
+		CustomID:     customID("Custom1"),
+		Commission:   harness.UFix64("0.1"),
+		Expiry:       uint64(h.Now().Unix()) + 1000,
+		Marketplaces: []sdk.Address{seller.Address},
+	}
+	h.Send("transactions/sell_item_and_replace_current_listing.cdc", []*harness.Account{seller}, h.SellItemArguments(listing)...)
+
+	listingIDs := h.ListingIDs(seller)
+	require.Len(t, listingIDs, 1)
+	assert.NotEqual(t, originalID, listingIDs[0])
+
+	cleanup := []cadence.Value{cadence.NewUInt64(0), cadence.NewUInt64(0), cadence.Address(seller.Address)}
+	h.Send("transactions/cleanup_expired_listings.cdc", []*harness.Account{buyer}, cleanup...)
+	assert.Equal(t, listingIDs, h.ListingIDs(seller))
+
+	h.MoveTime(2000 * time.Second)
+
+	h.Send("transactions/cleanup_expired_listings.cdc", []*harness.Account{buyer}, cleanup...)
+	assert.Empty(t, h.ListingIDs(seller))
+}
+
+func TestV2RemoveItem(t *testing.T) {
+	h := harness.New(t)
+	seller := h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	listingID := h.List(seller, harness.Listing{
+		NFTID:     nftID,
+		Price:     harness.UFix64("10.0"),
+		CustomID:  customID("Custom1"),
+		TokenType: h.FlowTokenType(),
+	})
+
+	result := h.Send("transactions/remove_item.cdc", []*harness.Account{seller}, cadence.NewUInt64(listingID))
+
+	completed := harness.Events(result, nftstorefrontv2.ListingCompletedEventType(h.Address("NFTStorefrontV2")))
+	require.Len(t, completed, 1)
+	event, err := nftstorefrontv2.DecodeListingCompleted(completed[0])
+	require.NoError(t, err)
+	assert.Equal(t, listingID, event.ListingResourceID)
+	assert.False(t, event.Purchased)
+	assert.Equal(t, h.NFTType(), event.NFTType.ID())
+	assert.Equal(t, nftID, event.NFTID)
+	assert.Equal(t, h.FlowTokenType(), event.SalePaymentVaultType.ID())
+	assert.Equal(t, harness.UFix64("10.0"), event.SalePrice)
+	assert.Equal(t, customID("Custom1"), event.CustomID)
+	assert.Equal(t, harness.UFix64("0.0"), event.CommissionAmount)
+	assert.Nil(t, event.CommissionReceiver)
+	assert.Equal(t, harness.DefaultExpiry, event.Expiry)
+
+	assert.Empty(t, h.ListingIDs(seller))
+}
+
+func TestV2MaliciousListing(t *testing.T) {
+	h := harness.New(t)
+	buyer := h.CreateAccount()
+
+	h.Deploy("contracts/utility/test/MaliciousStorefrontV2.cdc")
+	code, err := os.ReadFile("../../../tests/transactions/create_malicious_listing_v2.cdc")
+	require.NoError(t, err)
+	result := h.SendCode(h.Resolve(code), []*harness.Account{h.ExampleNFT})
+
+	available := harness.Events(result, nftstorefrontv2.ListingAvailableEventType(h.Address("NFTStorefrontV2")))
+	require.Len(t, available, 1)
+	event, err := nftstorefrontv2.DecodeListingAvailable(available[0])
+	require.NoError(t, err)
+
+	result = h.TrySend("transactions/buy_item.cdc", []*harness.Account{buyer},
+		cadence.NewUInt64(event.ListingResourceID),
+		cadence.Address(h.ExampleNFT.Address),
+		cadence.NewOptional(cadence.Address(h.ExampleNFT.Address)),
+		cadence.String(h.NFTType()),
+	)
+	assert.ErrorContains(t, result.Error, "Cannot borrow a non-NFTStorefrontV2.Listing!")
+}
@@ -0,0 +1,63 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/receivers"
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+// deleteTokenReceivers deletes the capabilities of the ExampleToken vault
+// of the signer, so that sale cuts paid to it can no longer be borrowed.
+const deleteTokenReceivers = `
+import "ExampleToken"
+
+transaction {
+    prepare(acct: auth(GetStorageCapabilityController) &Account) {
+        for controller in acct.capabilities.storage.getControllers(forPath: ExampleToken.VaultStoragePath) {
+            controller.delete()
+        }
+    }
+}
+`
+
+func checkReceivers(t *testing.T, h *harness.Harness, seller *harness.Account, listingIDs ...uint64) []receivers.ListingReceivers {
+	t.Helper()
+
+	value := h.Script("scripts/check_sale_cut_receivers.cdc", receivers.CheckReceiversArguments(cadence.Address(seller.Address), listingIDs)...)
+	var result []receivers.ListingReceivers
+	for _, element := range value.(cadence.Array).Values {
+		listing, err := receivers.DecodeListingReceivers(element)
+		require.NoError(t, err)
+		result = append(result, listing)
+	}
+	return result
+}
+
+func TestCheckSaleCutReceivers(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer := h.CreateAccount(), h.CreateAccount()
+
+	listingID := h.Sell(seller, h.MintNFT(seller), harness.UFix64("10.0"))
+	purchasedID := h.Sell(seller, h.MintNFT(seller), harness.UFix64("10.0"))
+	h.Buy(buyer, purchasedID)
+
+	// Purchased and missing listings are skipped.
+	listings := checkReceivers(t, h, seller, listingID, purchasedID, listingID+1000)
+	require.Len(t, listings, 1)
+	assert.Equal(t, listingID, listings[0].ListingResourceID)
+	assert.Empty(t, listings[0].Check())
+
+	h.SendCode(h.Resolve([]byte(deleteTokenReceivers)), []*harness.Account{seller})
+
+	listings = checkReceivers(t, h, seller, listingID)
+	require.Len(t, listings, 1)
+	assert.Equal(t, []bool{false}, listings[0].SaleCutReceivers)
+	findings := listings[0].Check()
+	require.Len(t, findings, 2)
+	assert.Equal(t, receivers.ProblemNoSaleCutReceiver, findings[1].Problem)
+}
@@ -0,0 +1,85 @@
+package test
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/bindings/nftstorefrontv2"
+	"github.com/onflow/nft-storefront/lib/go/contracts/safety"
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+func inspect(t *testing.T, h *harness.Harness, storefront *harness.Account, listingID uint64) []safety.Finding {
+	t.Helper()
+
+	value := h.Script("scripts/inspect_listing.cdc", safety.InspectListingArguments(cadence.Address(storefront.Address), listingID)...)
+	inspection, err := safety.DecodeInspection(value)
+	require.NoError(t, err)
+	return inspection.Check(h.Address("NFTStorefrontV2"))
+}
+
+func problems(findings []safety.Finding) []safety.Problem {
+	var result []safety.Problem
+	for _, f := range findings {
+		result = append(result, f.Problem)
+	}
+	return result
+}
+
+func TestInspectListing(t *testing.T) {
+	h := harness.New(t)
+	seller := h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	listingID := h.Sell(seller, nftID, harness.UFix64("10.0"))
+
+	assert.Empty(t, inspect(t, h, seller, listingID))
+	assert.Equal(t, []safety.Problem{safety.ProblemNoListing}, problems(inspect(t, h, seller, listingID+1)))
+
+	// Burning the NFT turns the listing into a ghost listing.
+	h.Send("transactions/example-nft/burn_nft.cdc", []*harness.Account{seller}, cadence.NewUInt64(nftID))
+	assert.Equal(t, []safety.Problem{safety.ProblemNFTMissing}, problems(inspect(t, h, seller, listingID)))
+}
+
+func TestInspectCounterfeitNFT(t *testing.T) {
+	h := harness.New(t)
+	seller := h.CreateAccount()
+
+	nftID := h.MintNFT(seller)
+	listingID := h.Sell(seller, nftID, harness.UFix64("10.0"))
+
+	// The collection the listing's provider targets is replaced with one
+	// holding an NFT of another type under the listed ID.
+	malicious := h.Deploy("contracts/utility/test/MaliciousCollection.cdc")
+	code, err := os.ReadFile("../../../tests/transactions/swap_in_malicious_collection.cdc")
+	require.NoError(t, err)
+	h.SendCode(h.Resolve(code), []*harness.Account{seller}, cadence.NewUInt64(nftID))
+
+	findings := inspect(t, h, seller, listingID)
+	assert.Equal(t, []safety.Finding{{
+		Problem: safety.ProblemNFTMismatch,
+		Message: fmt.Sprintf("listed NFT type is %s, held NFT type is A.%s.MaliciousCollection.NFT", h.NFTType(), malicious.Address.Hex()),
+	}}, findings)
+}
+
+func TestInspectMaliciousListing(t *testing.T) {
+	h := harness.New(t)
+
+	h.Deploy("contracts/utility/test/MaliciousStorefrontV2.cdc")
+	code, err := os.ReadFile("../../../tests/transactions/create_malicious_listing_v2.cdc")
+	require.NoError(t, err)
+	result := h.SendCode(h.Resolve(code), []*harness.Account{h.ExampleNFT})
+
+	available := harness.Events(result, nftstorefrontv2.ListingAvailableEventType(h.Address("NFTStorefrontV2")))
+	require.Len(t, available, 1)
+	event, err := nftstorefrontv2.DecodeListingAvailable(available[0])
+	require.NoError(t, err)
+
+	findings := inspect(t, h, h.ExampleNFT, event.ListingResourceID)
+	assert.Equal(t, []safety.Problem{safety.ProblemStorefrontType}, problems(findings))
+}
@@ -0,0 +1,47 @@
+package test
+
+import (
+	"testing"
+
+	"github.com/onflow/cadence"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/onflow/nft-storefront/lib/go/contracts/txerrors"
+	"github.com/onflow/nft-storefront/lib/go/test/harness"
+)
+
+func classify(t *testing.T, err error) *txerrors.Error {
+	t.Helper()
+
+	require.Error(t, err)
+	e := txerrors.Classify(err.Error())
+	require.NotNil(t, e, err.Error())
+	return e
+}
+
+func TestClassifyFailures(t *testing.T) {
+	h := harness.New(t)
+	seller, buyer := h.CreateAccount(), h.CreateAccount()
+
+	listingID := h.Sell(seller, h.MintNFT(seller), harness.UFix64("10.0"))
+	h.Buy(buyer, listingID)
+
+	result := h.TrySend("transactions/buy_item.cdc", []*harness.Account{buyer}, h.BuyItemArguments(listingID, nil)...)
+	assert.ErrorIs(t, classify(t, result.Error), txerrors.ErrAlreadyPurchased)
+
+	args := h.BuyItemArguments(listingID, nil)
+	args[0] = cadence.NewUInt64(listingID + 1000)
+	result = h.TrySend("transactions/buy_item.cdc", []*harness.Account{buyer}, args...)
+	e := classify(t, result.Error)
+	assert.ErrorIs(t, e, txerrors.ErrListingNotFound)
+	require.NotNil(t, e.ListingResourceID)
+	assert.Equal(t, listingID+1000, *e.ListingResourceID)
+	require.NotNil(t, e.StorefrontAddress)
+	assert.Equal(t, cadence.Address(seller.Address), *e.StorefrontAddress)
+
+	result = h.TrySend("transactions/remove_item.cdc", []*harness.Account{seller}, cadence.NewUInt64(listingID+1000))
+	e = classify(t, result.Error)
+	assert.ErrorIs(t, e, txerrors.ErrListingNotFound)
+	assert.Equal(t, "NFTStorefrontV2.Storefront.removeListing", e.Function)
+}